@@ -11,7 +11,16 @@ import (
 	"github.com/mjwhitta/win/types"
 )
 
-var advapi32 *windows.LazyDLL = windows.NewLazySystemDLL("Advapi32")
+var (
+	advapi32 *windows.LazyDLL = windows.NewLazySystemDLL("Advapi32")
+
+	procLookupPrivilegeDisplayNameW = advapi32.NewProc(
+		"LookupPrivilegeDisplayNameW",
+	)
+	procLookupPrivilegeNameW = advapi32.NewProc(
+		"LookupPrivilegeNameW",
+	)
+)
 
 // LookupPrivilegeDisplayName from winbase.h
 func LookupPrivilegeDisplayName(
@@ -23,7 +32,7 @@ func LookupPrivilegeDisplayName(
 	var b []uint16
 	var e error
 	var langID uint32
-	var proc string = "LookupPrivilegeDisplayNameW"
+	var proc *windows.LazyProc = procLookupPrivilegeDisplayNameW
 	var success uintptr
 	var tmp string
 
@@ -33,7 +42,11 @@ func LookupPrivilegeDisplayName(
 		b = make([]uint16, 1)
 	}
 
-	success, _, e = advapi32.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(
 		types.LpCwstr(system),
 		types.LpCwstr(name),
 		uintptr(unsafe.Pointer(&b[0])),
@@ -41,7 +54,7 @@ func LookupPrivilegeDisplayName(
 		uintptr(unsafe.Pointer(&langID)),
 	)
 	if success == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	tmp = windows.UTF16ToString(b)
@@ -59,7 +72,7 @@ func LookupPrivilegeName(
 ) error {
 	var b []uint16
 	var e error
-	var proc string = "LookupPrivilegeNameW"
+	var proc *windows.LazyProc = procLookupPrivilegeNameW
 	var success uintptr
 	var tmp string
 
@@ -69,14 +82,18 @@ func LookupPrivilegeName(
 		b = make([]uint16, 1)
 	}
 
-	success, _, e = advapi32.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(
 		types.LpCwstr(system),
 		uintptr(unsafe.Pointer(&luid)),
 		uintptr(unsafe.Pointer(&b[0])),
 		uintptr(unsafe.Pointer(nameLen)),
 	)
 	if success == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	tmp = windows.UTF16ToString(b)
@@ -1,3 +1,3 @@
 package api
 
-//go:generate go run ../tools/defines.go
+//go:generate go run ../tools
@@ -0,0 +1,109 @@
+//go:build windows
+
+package api
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/sys/windows"
+)
+
+// Callbacks are only created once, as windows.NewCallback() can
+// only create a limited number of them. Go pointers can't be held by
+// C, so state is passed to callbacks as a handle to the table below,
+// rather than as a pointer.
+var (
+	// Callback state, indexed by handle
+	cbHandles sync.Map
+	// Last handle used, so 0 is never a valid handle
+	cbLast atomic.Uintptr
+	// Callback for enumerations that only provide a name, such as
+	// EnumWindowStationsW() and EnumDesktopsW()
+	enumNameCallback uintptr = windows.NewCallback(
+		func(name *uint16, h uintptr) uintptr {
+			return appendName(h, name)
+		},
+	)
+	// Callback for CertEnumPhysicalStore()
+	enumPhysicalStoreCallback uintptr = windows.NewCallback(
+		func(
+			_ uintptr,
+			_ uintptr,
+			name *uint16,
+			_ uintptr,
+			_ uintptr,
+			h uintptr,
+		) uintptr {
+			return appendName(h, name)
+		},
+	)
+	// Callback for CertEnumSystemStore(), the store is a name as
+	// CERT_SYSTEM_STORE_RELOCATE_FLAG is never used
+	enumSystemStoreCallback uintptr = windows.NewCallback(
+		func(
+			name *uint16,
+			_ uintptr,
+			_ uintptr,
+			_ uintptr,
+			h uintptr,
+		) uintptr {
+			return appendName(h, name)
+		},
+	)
+)
+
+// appendName will append a name to the list of names stored for a
+// handle. It returns TRUE to continue enumerating, or FALSE if the
+// handle is unknown.
+func appendName(h uintptr, name *uint16) uintptr {
+	var names *[]string
+	var ok bool
+	var v any
+
+	if v, ok = cbHandles.Load(h); !ok {
+		return 0
+	}
+
+	if names, ok = v.(*[]string); !ok {
+		return 0
+	}
+
+	if name != nil {
+		*names = append(*names, windows.UTF16PtrToString(name))
+	}
+
+	return 1
+}
+
+// deleteHandle will remove the callback state for a handle.
+func deleteHandle(h uintptr) {
+	cbHandles.Delete(h)
+}
+
+// enumNames will call an enumeration func with a callback that
+// collects names, and return the names.
+func enumNames(enum func(h uintptr) error) ([]string, error) {
+	var e error
+	var h uintptr
+	var names []string
+
+	h = newHandle(&names)
+	defer deleteHandle(h)
+
+	if e = enum(h); e != nil {
+		return nil, e
+	}
+
+	return names, nil
+}
+
+// newHandle will store callback state and return its handle, which
+// must be deleted with deleteHandle() when the callback is done.
+func newHandle(v any) uintptr {
+	var h uintptr = cbLast.Add(1)
+
+	cbHandles.Store(h, v)
+
+	return h
+}
@@ -0,0 +1,46 @@
+package api
+
+import "strconv"
+
+// Constant describes a generated constant, so names and values can
+// be looked up at runtime. Values are the values of the C constants,
+// with integers in decimal and lists comma-separated.
+type Constant struct {
+	Arch   string // GOARCH, only if the value differs by arch
+	C      string // C name, if known
+	Go     string // Go name
+	Header string // Header of origin, empty for global constants
+	Type   string // Go type
+	Value  string // Value
+}
+
+// Constants will return every generated constant, sorted by header
+// and name.
+func Constants() []Constant {
+	return append([]Constant{}, constants...)
+}
+
+// Uint will return the value of an integer constant. Negative values
+// are returned in two's complement.
+func (c Constant) Uint() (uint64, bool) {
+	var e error
+	var n int64
+	var u uint64
+
+	switch c.Type {
+	case "int":
+		if n, e = strconv.ParseInt(c.Value, 10, 64); e != nil {
+			return 0, false
+		}
+
+		return uint64(n), true
+	case "uint64", "uintptr":
+		if u, e = strconv.ParseUint(c.Value, 10, 64); e != nil {
+			return 0, false
+		}
+
+		return u, true
+	}
+
+	return 0, false
+}
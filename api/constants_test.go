@@ -0,0 +1,30 @@
+package api
+
+import "testing"
+
+func TestConstants(t *testing.T) {
+	var archs = map[string]map[string]string{}
+
+	for _, c := range Constants() {
+		if c.C == "" {
+			t.Errorf("%s: missing C name", c.Go)
+		}
+
+		if c.Arch == "" {
+			continue
+		}
+
+		if _, ok := archs[c.C]; !ok {
+			archs[c.C] = map[string]string{}
+		}
+
+		archs[c.C][c.Arch] = c.Value
+	}
+
+	// Constants that differ by arch have a value for each arch
+	if v := archs["MEMORY_ALLOCATION_ALIGNMENT"]; len(v) != 3 {
+		t.Errorf("got %v, want a value for each arch", v)
+	} else if (v["386"] != "8") || (v["amd64"] != "16") {
+		t.Errorf("got %v, want 8 for 386 and 16 for amd64", v)
+	}
+}
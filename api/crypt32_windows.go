@@ -3,15 +3,34 @@
 package api
 
 import (
+	"unsafe"
+
 	"golang.org/x/sys/windows"
 
 	"github.com/mjwhitta/errors"
 	"github.com/mjwhitta/win/types"
 )
 
-var crypt32 *windows.LazyDLL = windows.NewLazySystemDLL("crypt32")
+var (
+	crypt32 *windows.LazyDLL = windows.NewLazySystemDLL("crypt32")
+
+	procCertEnumPhysicalStore = crypt32.NewProc(
+		"CertEnumPhysicalStore",
+	)
+	procCertEnumSystemStore = crypt32.NewProc(
+		"CertEnumSystemStore",
+	)
+	procCertGetCertificateContextProperty = crypt32.NewProc(
+		"CertGetCertificateContextProperty",
+	)
+	procPFXExportCertStoreEx = crypt32.NewProc("PFXExportCertStoreEx")
+)
 
 // CertEnumPhysicalStore from wincrypt.h
+//
+// This function accepts an enumeration function, which can be
+// created with windows.NewCallback(). EnumPhysicalStores() is simpler
+// to use.
 func CertEnumPhysicalStore(
 	store string,
 	dwFlags uintptr,
@@ -20,22 +39,30 @@ func CertEnumPhysicalStore(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "CertEnumPhysicalStore"
+	var proc *windows.LazyProc = procCertEnumPhysicalStore
 
-	ok, _, e = crypt32.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		types.LpCwstr(store),
 		dwFlags,
 		pvArg,
 		pfnEnum,
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
 }
 
 // CertEnumSystemStore from wincrypt.h
+//
+// This function accepts an enumeration function, which can be
+// created with windows.NewCallback(). EnumSystemStores() is simpler
+// to use.
 func CertEnumSystemStore(
 	dwFlags uintptr,
 	pvSystemStoreLocationPara uintptr,
@@ -44,16 +71,114 @@ func CertEnumSystemStore(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "CertEnumSystemStore"
+	var proc *windows.LazyProc = procCertEnumSystemStore
 
-	ok, _, e = crypt32.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		dwFlags,
 		pvSystemStoreLocationPara,
 		pvArg,
 		pfnEnum,
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// CertGetCertificateContextProperty from wincrypt.h
+func CertGetCertificateContextProperty(
+	ctx *windows.CertContext,
+	propID uintptr,
+	data *byte,
+	dataLen *uint32,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc
+
+	// Name is too long for a one-line declaration
+	proc = procCertGetCertificateContextProperty
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		uintptr(unsafe.Pointer(ctx)),
+		propID,
+		uintptr(unsafe.Pointer(data)),
+		uintptr(unsafe.Pointer(dataLen)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// EnumPhysicalStores will return the names of the physical stores
+// of a system store, such as "My", in a location, such as
+// WincryptCertSystemStoreCurrentUser.
+func EnumPhysicalStores(
+	store string,
+	location uintptr,
+) ([]string, error) {
+	return enumNames(
+		func(h uintptr) error {
+			return CertEnumPhysicalStore(
+				store,
+				location,
+				h,
+				enumPhysicalStoreCallback,
+			)
+		},
+	)
+}
+
+// EnumSystemStores will return the names of the system stores in a
+// location, such as WincryptCertSystemStoreCurrentUser.
+func EnumSystemStores(location uintptr) ([]string, error) {
+	return enumNames(
+		func(h uintptr) error {
+			return CertEnumSystemStore(
+				location,
+				0,
+				h,
+				enumSystemStoreCallback,
+			)
+		},
+	)
+}
+
+// PFXExportCertStoreEx from wincrypt.h
+func PFXExportCertStoreEx(
+	store windows.Handle,
+	pfx *windows.CryptDataBlob,
+	password *uint16,
+	flags uintptr,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procPFXExportCertStoreEx
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		uintptr(store),
+		uintptr(unsafe.Pointer(pfx)),
+		uintptr(unsafe.Pointer(password)),
+		0,
+		flags,
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
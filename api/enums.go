@@ -0,0 +1,117 @@
+package api
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// enumName is a named value of a generated enum or flag type.
+type enumName struct {
+	Name  string
+	Value uintptr
+}
+
+// enumTable is used by generated enum and flag types for reverse
+// lookups by value.
+type enumTable struct {
+	flags  bool
+	names  []enumName
+	sorted []enumName
+	typ    string
+}
+
+func newEnumTable(
+	typ string,
+	flags bool,
+	names []enumName,
+) *enumTable {
+	var t *enumTable = &enumTable{
+		flags: flags,
+		names: names,
+		typ:   typ,
+	}
+
+	// Flags are decoded with the most bits first, so combined flags
+	// win over their parts, and aliases after the first are ignored
+	t.sorted = append([]enumName{}, names...)
+	sort.SliceStable(
+		t.sorted,
+		func(i int, j int) bool {
+			var l int = bits.OnesCount64(uint64(t.sorted[i].Value))
+			var r int = bits.OnesCount64(uint64(t.sorted[j].Value))
+
+			return l > r
+		},
+	)
+
+	return t
+}
+
+// decode will return the names of the flags set in v, in order of
+// value. Any bits without a name are returned as a single hex value.
+func (t *enumTable) decode(v uintptr) []string {
+	var out []string
+	var remaining uintptr = v
+	var set []enumName
+
+	for _, n := range t.sorted {
+		if (n.Value == 0) || ((v & n.Value) != n.Value) {
+			continue
+		}
+
+		if (remaining & n.Value) == 0 {
+			continue
+		}
+
+		set = append(set, n)
+		remaining &^= n.Value
+	}
+
+	sort.SliceStable(
+		set,
+		func(i int, j int) bool {
+			return set[i].Value < set[j].Value
+		},
+	)
+
+	for _, n := range set {
+		out = append(out, n.Name)
+	}
+
+	if remaining != 0 {
+		out = append(out, fmt.Sprintf("0x%x", remaining))
+	}
+
+	return out
+}
+
+// lookup will return the first name with a value of v.
+func (t *enumTable) lookup(v uintptr) (string, bool) {
+	for _, n := range t.names {
+		if n.Value == v {
+			return n.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// str will return the name of v, or the names of the flags set in v
+// joined with "|".
+func (t *enumTable) str(v uintptr) string {
+	if name, ok := t.lookup(v); ok {
+		return name
+	}
+
+	if t.flags {
+		if v == 0 {
+			return "0"
+		}
+
+		return strings.Join(t.decode(v), "|")
+	}
+
+	return fmt.Sprintf("%s(%d)", t.typ, v)
+}
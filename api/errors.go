@@ -0,0 +1,268 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+)
+
+// HRESULT is a COM-style result code, from winerror.h.
+type HRESULT uint32
+
+// NTStatus is an NTSTATUS code, as returned by ntdll, from
+// ntstatus.h. Returned errors can be compared to the named codes,
+// such as StatusAccessDenied, with errors.Is().
+type NTStatus uint32
+
+// Win32Error is a Win32 error code, as returned by GetLastError(),
+// from winerror.h and the WinHTTP and WinINet headers.
+type Win32Error uint32
+
+// errCode is a named error code.
+type errCode struct {
+	Code uint32
+	Name string
+}
+
+// errMapping maps an NTSTATUS code to a Win32 error code.
+type errMapping struct {
+	NT    uint32
+	Win32 uint32
+}
+
+const (
+	facilityNTWin32 uint32 = 0x7
+	facilityWin32   uint32 = 0x7
+	hresultNTBit    uint32 = 0x10000000
+	// Returned by RtlNtStatusToDosError() if there is no mapping
+	errorMRMidNotFound uint32 = 317
+)
+
+// errString will return the name and message of an error code. The
+// message is from the system on Windows. If there is none, only the
+// name is returned.
+func errString(kind string, name string, code uint32) string {
+	var msg string = sysMessage(kind, code)
+
+	if msg == "" {
+		return name
+	}
+
+	return name + ": " + msg
+}
+
+// lookupErr will return the first name for an error code, which is
+// the canonical name if there are several, such as S_OK.
+func lookupErr(codes []errCode, code uint32) (string, bool) {
+	var i int = sort.Search(
+		len(codes),
+		func(i int) bool {
+			return codes[i].Code >= code
+		},
+	)
+
+	if (i < len(codes)) && (codes[i].Code == code) {
+		return codes[i].Name, true
+	}
+
+	return "", false
+}
+
+// Code will return the code portion of the HRESULT.
+func (hr HRESULT) Code() uint16 {
+	return uint16(hr & 0xffff) //nolint:mnd // Low 16 bits
+}
+
+// Error will return the symbolic name and message of the HRESULT.
+// HRESULTs wrapping a Win32 error or NTSTATUS are described using
+// the wrapped code, if not otherwise known.
+func (hr HRESULT) Error() string {
+	if name, ok := lookupErr(hresultCodes, uint32(hr)); ok {
+		return errString("HRESULT", name, uint32(hr))
+	}
+
+	if s, ok := hr.NTStatus(); ok {
+		return fmt.Sprintf(
+			"HRESULT 0x%08x: %s",
+			uint32(hr),
+			s.Error(),
+		)
+	}
+
+	if code, ok := hr.Win32(); ok {
+		return fmt.Sprintf(
+			"HRESULT 0x%08x: %s",
+			uint32(hr),
+			code.Error(),
+		)
+	}
+
+	return fmt.Sprintf(
+		"HRESULT 0x%08x (facility %d, code %d)",
+		uint32(hr),
+		hr.Facility(),
+		hr.Code(),
+	)
+}
+
+// Facility will return the facility portion of the HRESULT.
+func (hr HRESULT) Facility() uint16 {
+	//nolint:mnd // Bits 16-26
+	return uint16((uint32(hr) >> 16) & 0x7ff)
+}
+
+// Failed will return true if the HRESULT represents a failure.
+func (hr HRESULT) Failed() bool {
+	return int32(hr) < 0
+}
+
+// Name will return the symbolic name of the HRESULT, if known.
+func (hr HRESULT) Name() string {
+	var name string
+
+	name, _ = lookupErr(hresultCodes, uint32(hr))
+
+	return name
+}
+
+// NTStatus will return the NTSTATUS wrapped by the HRESULT, if any,
+// like HRESULT_FROM_NT() in reverse.
+func (hr HRESULT) NTStatus() (NTStatus, bool) {
+	if (uint32(hr) & hresultNTBit) == 0 {
+		return 0, false
+	}
+
+	return NTStatus(uint32(hr) &^ hresultNTBit), true
+}
+
+// Succeeded will return true if the HRESULT represents success.
+func (hr HRESULT) Succeeded() bool {
+	return int32(hr) >= 0
+}
+
+// Win32 will return the Win32 error wrapped by the HRESULT, if any,
+// like HRESULT_FROM_WIN32() in reverse.
+func (hr HRESULT) Win32() (Win32Error, bool) {
+	if !hr.Failed() || (uint32(hr.Facility()) != facilityWin32) {
+		return 0, false
+	}
+
+	if (uint32(hr) & hresultNTBit) != 0 {
+		return 0, false
+	}
+
+	return Win32Error(hr.Code()), true
+}
+
+// Error will return the symbolic name and message of the NTSTATUS.
+func (s NTStatus) Error() string {
+	if name, ok := lookupErr(ntstatusCodes, uint32(s)); ok {
+		return errString("NTSTATUS", name, uint32(s))
+	}
+
+	return fmt.Sprintf("NTSTATUS 0x%08x", uint32(s))
+}
+
+// Facility will return the facility portion of the NTSTATUS.
+func (s NTStatus) Facility() uint16 {
+	//nolint:mnd // Bits 16-27
+	return uint16((uint32(s) >> 16) & 0xfff)
+}
+
+// HRESULT will convert the NTSTATUS to an HRESULT, like
+// HRESULT_FROM_NT().
+func (s NTStatus) HRESULT() HRESULT {
+	return HRESULT(uint32(s) | hresultNTBit)
+}
+
+// IsError will return true if the NTSTATUS has error severity.
+func (s NTStatus) IsError() bool {
+	return s.severity() == 3 //nolint:mnd // STATUS_SEVERITY_ERROR
+}
+
+// IsInformational will return true if the NTSTATUS has
+// informational severity.
+func (s NTStatus) IsInformational() bool {
+	return s.severity() == 1 //nolint:mnd // Informational
+}
+
+// IsSuccess will return true if the NTSTATUS has success or
+// informational severity, like NT_SUCCESS().
+func (s NTStatus) IsSuccess() bool {
+	return int32(s) >= 0
+}
+
+// IsWarning will return true if the NTSTATUS has warning severity.
+func (s NTStatus) IsWarning() bool {
+	return s.severity() == 2 //nolint:mnd // STATUS_SEVERITY_WARNING
+}
+
+// Name will return the symbolic name of the NTSTATUS, if known.
+func (s NTStatus) Name() string {
+	var name string
+
+	name, _ = lookupErr(ntstatusCodes, uint32(s))
+
+	return name
+}
+
+func (s NTStatus) severity() uint32 {
+	return uint32(s) >> 30 //nolint:mnd // Top 2 bits
+}
+
+// Win32 will map the NTSTATUS to a Win32 error code, in the style
+// of RtlNtStatusToDosError(). Codes are mapped by the documented
+// exceptions, else by matching names (STATUS_X to ERROR_X), so codes
+// without a documented mapping are an approximation.
+// ERROR_MR_MID_NOT_FOUND is returned if there is no mapping.
+func (s NTStatus) Win32() Win32Error {
+	var code uint32 = uint32(s)
+	var i int = sort.Search(
+		len(ntstatusWin32),
+		func(i int) bool {
+			return ntstatusWin32[i].NT >= code
+		},
+	)
+
+	if (i < len(ntstatusWin32)) && (ntstatusWin32[i].NT == code) {
+		return Win32Error(ntstatusWin32[i].Win32)
+	}
+
+	// Win32 errors wrapped as an NTSTATUS
+	if s.IsError() && (uint32(s.Facility()) == facilityNTWin32) {
+		return Win32Error(code & 0xffff) //nolint:mnd // Low 16 bits
+	}
+
+	return Win32Error(errorMRMidNotFound)
+}
+
+// Error will return the symbolic name and message of the Win32
+// error.
+func (code Win32Error) Error() string {
+	if name, ok := lookupErr(win32Codes, uint32(code)); ok {
+		return errString("WIN32", name, uint32(code))
+	}
+
+	return fmt.Sprintf("Win32 error %d", uint32(code))
+}
+
+// HRESULT will convert the Win32 error to an HRESULT, like
+// HRESULT_FROM_WIN32().
+func (code Win32Error) HRESULT() HRESULT {
+	if code == 0 {
+		return 0
+	}
+
+	//nolint:mnd // SEVERITY_ERROR, FACILITY_WIN32 and low 16 bits
+	return HRESULT(
+		0x80000000 | (facilityWin32 << 16) | (uint32(code) & 0xffff),
+	)
+}
+
+// Name will return the symbolic name of the Win32 error, if known.
+func (code Win32Error) Name() string {
+	var name string
+
+	name, _ = lookupErr(win32Codes, uint32(code))
+
+	return name
+}
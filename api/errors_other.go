@@ -0,0 +1,9 @@
+//go:build !windows
+
+package api
+
+// sysMessage will return an empty string, as there are no system
+// messages on this OS.
+func sysMessage(_ string, _ uint32) string {
+	return ""
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestErrorNames(t *testing.T) {
+	var tests = []struct {
+		err  error
+		name string
+	}{
+		{HRESULT(0), "S_OK"},
+		{HRESULT(0x80070005), "E_ACCESSDENIED"},
+		{StatusAccessDenied, "STATUS_ACCESS_DENIED"},
+		{NTStatus(0), "STATUS_SUCCESS"},
+		{Win32Error(0), "ERROR_SUCCESS"},
+		{Win32Error(5), "ERROR_ACCESS_DENIED"},
+		{Win32Error(1168), "ERROR_NOT_FOUND"},
+	}
+
+	for _, test := range tests {
+		var got string = test.err.Error()
+
+		if !strings.HasPrefix(got+": ", test.name+": ") {
+			t.Errorf("got %q, want %s", got, test.name)
+		}
+
+		// Without a system message, only the name
+		if (runtime.GOOS != "windows") && (got != test.name) {
+			t.Errorf("got %q, want %s", got, test.name)
+		}
+	}
+}
+
+func TestErrorUnknown(t *testing.T) {
+	//nolint:lll // Table of errors
+	var tests = map[error]string{
+		HRESULT(0x80040200):   "HRESULT 0x80040200 (facility 4, code 512)",
+		NTStatus(0xC0FFFFFF):  "NTSTATUS 0xc0ffffff",
+		Win32Error(0xfffffff): "Win32 error 268435455",
+	}
+
+	for err, want := range tests {
+		if got := err.Error(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestNTStatusWin32(t *testing.T) {
+	// From the documented RtlNtStatusToDosError() mappings
+	var tests = map[NTStatus]Win32Error{
+		// Matching names
+		StatusSuccess:          0,
+		StatusAccessDenied:     5,
+		StatusInvalidHandle:    6,
+		StatusInvalidParameter: 87,
+		StatusSharingViolation: 32,
+		// Known exceptions
+		StatusAccessViolation:       998,
+		StatusBufferOverflow:        234,
+		StatusBufferTooSmall:        122,
+		StatusCannotDelete:          5,
+		StatusConflictingAddresses:  487,
+		StatusDeletePending:         5,
+		StatusDirectoryNotEmpty:     145,
+		StatusEndOfFile:             38,
+		StatusFileIsADirectory:      5,
+		StatusInfoLengthMismatch:    24,
+		StatusInsufficientResources: 1450,
+		StatusInvalidCid:            87,
+		StatusInvalidDeviceRequest:  1,
+		StatusInvalidInfoClass:      87,
+		StatusInvalidParameter1:     87,
+		StatusInvalidParameter12:    87,
+		StatusInvalidParameterMix:   87,
+		StatusNotADirectory:         267,
+		StatusNotImplemented:        1,
+		StatusNoMemory:              8,
+		StatusNoSuchFile:            2,
+		StatusObjectNameCollision:   183,
+		StatusObjectNameInvalid:     123,
+		StatusObjectNameNotFound:    2,
+		StatusObjectPathInvalid:     161,
+		StatusObjectPathNotFound:    3,
+		StatusObjectPathSyntaxBad:   161,
+		StatusObjectTypeMismatch:    6,
+		StatusPending:               997,
+		StatusProcessIsTerminating:  5,
+		StatusTimeout:               258,
+		StatusUnsuccessful:          31,
+		// Win32 errors wrapped as an NTSTATUS
+		NTStatus(0xC0070005): 5,
+		// No mapping is ERROR_MR_MID_NOT_FOUND
+		NTStatus(0xC0FFFFFF): 317,
+	}
+
+	for s, want := range tests {
+		if got := s.Win32(); got != want {
+			t.Errorf("0x%08X: got %d, want %d", uint32(s), got, want)
+		}
+	}
+}
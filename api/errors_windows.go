@@ -0,0 +1,65 @@
+//go:build windows
+
+package api
+
+import (
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// maxMessage is the size of the FormatMessage buffer, in UTF-16
+// characters, which fits any system message.
+const maxMessage int = 1024
+
+// formatMessage will return the message for a code from the system
+// message table, or from the DLL's if provided.
+func formatMessage(code uint32, dll *windows.LazyDLL) string {
+	var buf []uint16 = make([]uint16, maxMessage)
+	var e error
+	var flags uint32 = windows.FORMAT_MESSAGE_FROM_SYSTEM |
+		windows.FORMAT_MESSAGE_IGNORE_INSERTS
+	var mod uintptr
+	var n uint32
+
+	if dll != nil {
+		if e = dll.Load(); e != nil {
+			return ""
+		}
+
+		flags |= windows.FORMAT_MESSAGE_FROM_HMODULE
+		mod = dll.Handle()
+	}
+
+	n, e = windows.FormatMessage(flags, mod, code, 0, buf, nil)
+	if (e != nil) || (n == 0) {
+		return ""
+	}
+
+	return strings.TrimSpace(windows.UTF16ToString(buf[:n]))
+}
+
+// sysMessage will return the system message for an error code, if
+// any. NTSTATUS messages are in ntdll.dll and WinHTTP and WinINet
+// messages are in their DLLs.
+func sysMessage(kind string, code uint32) string {
+	var last uintptr = max(WinhttpErrorLast, WininetInternetErrorLast)
+	var msg string
+
+	switch kind {
+	case "NTSTATUS":
+		return formatMessage(code, ntdll)
+	case "WIN32":
+		// WinHTTP and WinINet share INTERNET_ERROR_BASE
+		if (uintptr(code) >= WininetInternetErrorBase) &&
+			(uintptr(code) <= last) {
+			for _, dll := range []*windows.LazyDLL{wininet, winhttp} {
+				if msg = formatMessage(code, dll); msg != "" {
+					return msg
+				}
+			}
+		}
+	}
+
+	return formatMessage(code, nil)
+}
@@ -0,0 +1,12756 @@
+// Code generated by tools/errors.go; DO NOT EDIT.
+
+package api
+
+// hresultCodes contains HRESULT codes from ntstatus.h, winerror.h,
+// winhttp.h, wininet.h, sorted by code
+var hresultCodes = []errCode{
+	{0x00000000, "S_OK"},
+	{0x00000000, "SEC_E_OK"},
+	{0x00000000, "SCARD_S_SUCCESS"},
+	{0x00000001, "S_FALSE"},
+	{0x00030200, "STG_S_CONVERTED"},
+	{0x00030201, "STG_S_BLOCK"},
+	{0x00030202, "STG_S_RETRYNOW"},
+	{0x00030203, "STG_S_MONITORING"},
+	{0x00030204, "STG_S_MULTIPLEOPENS"},
+	{0x00030205, "STG_S_CONSOLIDATIONFAILED"},
+	{0x00030206, "STG_S_CANNOTCONSOLIDATE"},
+	{0x00030207, "STG_S_POWER_CYCLE_REQUIRED"},
+	{0x00040000, "OLE_S_USEREG"},
+	{0x00040001, "OLE_S_STATIC"},
+	{0x00040002, "OLE_S_MAC_CLIPFORMAT"},
+	{0x00040100, "DRAGDROP_S_DROP"},
+	{0x00040101, "DRAGDROP_S_CANCEL"},
+	{0x00040102, "DRAGDROP_S_USEDEFAULTCURSORS"},
+	{0x00040130, "DATA_S_SAMEFORMATETC"},
+	{0x00040140, "VIEW_S_ALREADY_FROZEN"},
+	{0x00040170, "CACHE_S_FORMATETC_NOTSUPPORTED"},
+	{0x00040171, "CACHE_S_SAMECACHE"},
+	{0x00040172, "CACHE_S_SOMECACHES_NOTUPDATED"},
+	{0x00040180, "OLEOBJ_S_INVALIDVERB"},
+	{0x00040181, "OLEOBJ_S_CANNOT_DOVERB_NOW"},
+	{0x00040182, "OLEOBJ_S_INVALIDHWND"},
+	{0x000401A0, "INPLACE_S_TRUNCATED"},
+	{0x000401C0, "CONVERT10_S_NO_PRESENTATION"},
+	{0x000401E2, "MK_S_REDUCED_TO_SELF"},
+	{0x000401E4, "MK_S_ME"},
+	{0x000401E5, "MK_S_HIM"},
+	{0x000401E6, "MK_S_US"},
+	{0x000401E7, "MK_S_MONIKERALREADYREGISTERED"},
+	{0x00040200, "EVENT_S_SOME_SUBSCRIBERS_FAILED"},
+	{0x00040202, "EVENT_S_NOSUBSCRIBERS"},
+	{0x00040252, "TPC_S_TRUNCATED"},
+	{0x00040253, "TPC_S_INTERRUPTED"},
+	{0x00040254, "TPC_S_NO_DATA_TO_PROCESS"},
+	{0x00041300, "SCHED_S_TASK_READY"},
+	{0x00041301, "SCHED_S_TASK_RUNNING"},
+	{0x00041302, "SCHED_S_TASK_DISABLED"},
+	{0x00041303, "SCHED_S_TASK_HAS_NOT_RUN"},
+	{0x00041304, "SCHED_S_TASK_NO_MORE_RUNS"},
+	{0x00041305, "SCHED_S_TASK_NOT_SCHEDULED"},
+	{0x00041306, "SCHED_S_TASK_TERMINATED"},
+	{0x00041307, "SCHED_S_TASK_NO_VALID_TRIGGERS"},
+	{0x00041308, "SCHED_S_EVENT_TRIGGER"},
+	{0x0004131B, "SCHED_S_SOME_TRIGGERS_FAILED"},
+	{0x0004131C, "SCHED_S_BATCH_LOGON_PROBLEM"},
+	{0x00041325, "SCHED_S_TASK_QUEUED"},
+	{0x0004D000, "XACT_S_ASYNC"},
+	{0x0004D001, "XACT_S_DEFECT"},
+	{0x0004D002, "XACT_S_READONLY"},
+	{0x0004D003, "XACT_S_SOMENORETAIN"},
+	{0x0004D004, "XACT_S_OKINFORM"},
+	{0x0004D005, "XACT_S_MADECHANGESCONTENT"},
+	{0x0004D006, "XACT_S_MADECHANGESINFORM"},
+	{0x0004D007, "XACT_S_ALLNORETAIN"},
+	{0x0004D008, "XACT_S_ABORTING"},
+	{0x0004D009, "XACT_S_SINGLEPHASE"},
+	{0x0004D00A, "XACT_S_LOCALLY_OK"},
+	{0x0004D010, "XACT_S_LASTRESOURCEMANAGER"},
+	{0x00080012, "CO_S_NOTALLINTERFACES"},
+	{0x00080013, "CO_S_MACHINENAMENOTFOUND"},
+	{0x00090312, "SEC_I_CONTINUE_NEEDED"},
+	{0x00090313, "SEC_I_COMPLETE_NEEDED"},
+	{0x00090314, "SEC_I_COMPLETE_AND_CONTINUE"},
+	{0x00090315, "SEC_I_LOCAL_LOGON"},
+	{0x00090316, "SEC_I_GENERIC_EXTENSION_RECEIVED"},
+	{0x00090317, "SEC_I_CONTEXT_EXPIRED"},
+	{0x00090320, "SEC_I_INCOMPLETE_CREDENTIALS"},
+	{0x00090321, "SEC_I_RENEGOTIATE"},
+	{0x00090323, "SEC_I_NO_LSA_CONTEXT"},
+	{0x0009035C, "SEC_I_SIGNATURE_NEEDED"},
+	{0x00090360, "SEC_I_NO_RENEGOTIATION"},
+	{0x00090364, "SEC_I_MESSAGE_FRAGMENT"},
+	{0x00090366, "SEC_I_CONTINUE_NEEDED_MESSAGE_OK"},
+	{0x00090368, "SEC_I_ASYNC_CALL_PENDING"},
+	{0x00091012, "CRYPT_I_NEW_PROTECTION_REQUIRED"},
+	{0x001B0000, "WER_S_REPORT_DEBUG"},
+	{0x001B0001, "WER_S_REPORT_UPLOADED"},
+	{0x001B0002, "WER_S_REPORT_QUEUED"},
+	{0x001B0003, "WER_S_DISABLED"},
+	{0x001B0004, "WER_S_SUSPENDED_UPLOAD"},
+	{0x001B0005, "WER_S_DISABLED_QUEUE"},
+	{0x001B0006, "WER_S_DISABLED_ARCHIVE"},
+	{0x001B0007, "WER_S_REPORT_ASYNC"},
+	{0x001B0008, "WER_S_IGNORE_ASSERT_INSTANCE"},
+	{0x001B0009, "WER_S_IGNORE_ALL_ASSERTS"},
+	{0x001B000A, "WER_S_ASSERT_CONTINUE"},
+	{0x001B000B, "WER_S_THROTTLED"},
+	{0x001B000C, "WER_S_REPORT_UPLOADED_CAB"},
+	{0x001F0001, "ERROR_FLT_IO_COMPLETE"},
+	{0x00261001, "ERROR_MONITOR_NO_DESCRIPTOR"},
+	{0x00261002, "ERROR_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT"},
+	{0x00262307, "ERROR_GRAPHICS_MODE_NOT_PINNED"},
+	{0x0026231E, "ERROR_GRAPHICS_NO_PREFERRED_MODE"},
+	{0x0026234B, "ERROR_GRAPHICS_DATASET_IS_EMPTY"},
+	{0x0026234C, "ERROR_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET"},
+	{0x00262351, "ERROR_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED"},
+	{0x00263005, "DWM_S_GDI_REDIRECTION_SURFACE"},
+	{0x00263008, "DWM_S_GDI_REDIRECTION_SURFACE_BLT_VIA_GDI"},
+	{0x0027000D, "NAP_S_CERT_ALREADY_PRESENT"},
+	{0x00270258, "S_STORE_LAUNCHED_FOR_REMEDIATION"},
+	{0x00270259, "S_APPLICATION_ACTIVATION_ERROR_HANDLED_BY_DIALOG"},
+	{0x00300100, "PLA_S_PROPERTY_IGNORED"},
+	{0x003D0000, "WS_S_ASYNC"},
+	{0x003D0001, "WS_S_END"},
+	{0x00630001, "PEER_S_GRAPH_DATA_CREATED"},
+	{0x00630002, "PEER_S_NO_EVENT_DATA"},
+	{0x00630005, "PEER_S_NO_CONNECTIVITY"},
+	{0x00630006, "PEER_S_ALREADY_A_MEMBER"},
+	{0x00632000, "PEER_S_ALREADY_CONNECTED"},
+	{0x00636000, "PEER_S_SUBSCRIPTION_EXISTS"},
+	{0x00670013, "STATEREPOSITORY_TRANSACTION_CALLER_ID_CHANGED"},
+	{0x00670014, "STATEREPOSITORY_TRANSACTION_IN_PROGRESS"},
+	{0x00E70001, "ERROR_SPACES_POOL_WAS_DELETED"},
+	{0x087A0001, "DXGI_STATUS_OCCLUDED"},
+	{0x087A0002, "DXGI_STATUS_CLIPPED"},
+	{0x087A0004, "DXGI_STATUS_NO_REDIRECTION"},
+	{0x087A0005, "DXGI_STATUS_NO_DESKTOP_ACCESS"},
+	{0x087A0006, "DXGI_STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE"},
+	{0x087A0007, "DXGI_STATUS_MODE_CHANGED"},
+	{0x087A0008, "DXGI_STATUS_MODE_CHANGE_IN_PROGRESS"},
+	{0x087A0009, "DXGI_STATUS_UNOCCLUDED"},
+	{0x087A000A, "DXGI_STATUS_DDA_WAS_STILL_DRAWING"},
+	{0x087A002F, "DXGI_STATUS_PRESENT_REQUIRED"},
+	{0x4026200A, "ERROR_GRAPHICS_PARTIAL_DATA_POPULATED"},
+	{0x40262201, "ERROR_GRAPHICS_SKIP_ALLOCATION_PREPARATION"},
+	{0x4026242F, "ERROR_GRAPHICS_UNKNOWN_CHILD_STATUS"},
+	{0x40262437, "ERROR_GRAPHICS_LEADLINK_START_DEFERRED"},
+	{0x40262439, "ERROR_GRAPHICS_POLLING_TOO_FREQUENTLY"},
+	{0x4026243A, "ERROR_GRAPHICS_START_DEFERRED"},
+	{0x4026243C, "ERROR_GRAPHICS_DEPENDABLE_CHILD_STATUS"},
+	{0x40290423, "TPM_E_PCP_UNSUPPORTED_PSS_SALT"},
+	{0x8000000A, "E_PENDING"},
+	{0x8000000B, "E_BOUNDS"},
+	{0x8000000C, "E_CHANGED_STATE"},
+	{0x8000000D, "E_ILLEGAL_STATE_CHANGE"},
+	{0x8000000E, "E_ILLEGAL_METHOD_CALL"},
+	{0x8000000F, "RO_E_METADATA_NAME_NOT_FOUND"},
+	{0x80000010, "RO_E_METADATA_NAME_IS_NAMESPACE"},
+	{0x80000011, "RO_E_METADATA_INVALID_TYPE_FORMAT"},
+	{0x80000012, "RO_E_INVALID_METADATA_FILE"},
+	{0x80000013, "RO_E_CLOSED"},
+	{0x80000014, "RO_E_EXCLUSIVE_WRITE"},
+	{0x80000015, "RO_E_CHANGE_NOTIFICATION_IN_PROGRESS"},
+	{0x80000016, "RO_E_ERROR_STRING_NOT_FOUND"},
+	{0x80000017, "E_STRING_NOT_NULL_TERMINATED"},
+	{0x80000018, "E_ILLEGAL_DELEGATE_ASSIGNMENT"},
+	{0x80000019, "E_ASYNC_OPERATION_NOT_STARTED"},
+	{0x8000001A, "E_APPLICATION_EXITING"},
+	{0x8000001B, "E_APPLICATION_VIEW_EXITING"},
+	{0x8000001C, "RO_E_MUST_BE_AGILE"},
+	{0x8000001D, "RO_E_UNSUPPORTED_FROM_MTA"},
+	{0x8000001E, "RO_E_COMMITTED"},
+	{0x8000001F, "RO_E_BLOCKED_CROSS_ASTA_CALL"},
+	{0x80000020, "RO_E_CANNOT_ACTIVATE_FULL_TRUST_SERVER"},
+	{0x80000021, "RO_E_CANNOT_ACTIVATE_UNIVERSAL_APPLICATION_SERVER"},
+	{0x80004001, "E_NOTIMPL"},
+	{0x80004002, "E_NOINTERFACE"},
+	{0x80004003, "E_POINTER"},
+	{0x80004004, "E_ABORT"},
+	{0x80004005, "E_FAIL"},
+	{0x80004006, "CO_E_INIT_TLS"},
+	{0x80004007, "CO_E_INIT_SHARED_ALLOCATOR"},
+	{0x80004008, "CO_E_INIT_MEMORY_ALLOCATOR"},
+	{0x80004009, "CO_E_INIT_CLASS_CACHE"},
+	{0x8000400A, "CO_E_INIT_RPC_CHANNEL"},
+	{0x8000400B, "CO_E_INIT_TLS_SET_CHANNEL_CONTROL"},
+	{0x8000400C, "CO_E_INIT_TLS_CHANNEL_CONTROL"},
+	{0x8000400D, "CO_E_INIT_UNACCEPTED_USER_ALLOCATOR"},
+	{0x8000400E, "CO_E_INIT_SCM_MUTEX_EXISTS"},
+	{0x8000400F, "CO_E_INIT_SCM_FILE_MAPPING_EXISTS"},
+	{0x80004010, "CO_E_INIT_SCM_MAP_VIEW_OF_FILE"},
+	{0x80004011, "CO_E_INIT_SCM_EXEC_FAILURE"},
+	{0x80004012, "CO_E_INIT_ONLY_SINGLE_THREADED"},
+	{0x80004013, "CO_E_CANT_REMOTE"},
+	{0x80004014, "CO_E_BAD_SERVER_NAME"},
+	{0x80004015, "CO_E_WRONG_SERVER_IDENTITY"},
+	{0x80004016, "CO_E_OLE1DDE_DISABLED"},
+	{0x80004017, "CO_E_RUNAS_SYNTAX"},
+	{0x80004018, "CO_E_CREATEPROCESS_FAILURE"},
+	{0x80004019, "CO_E_RUNAS_CREATEPROCESS_FAILURE"},
+	{0x8000401A, "CO_E_RUNAS_LOGON_FAILURE"},
+	{0x8000401B, "CO_E_LAUNCH_PERMSSION_DENIED"},
+	{0x8000401C, "CO_E_START_SERVICE_FAILURE"},
+	{0x8000401D, "CO_E_REMOTE_COMMUNICATION_FAILURE"},
+	{0x8000401E, "CO_E_SERVER_START_TIMEOUT"},
+	{0x8000401F, "CO_E_CLSREG_INCONSISTENT"},
+	{0x80004020, "CO_E_IIDREG_INCONSISTENT"},
+	{0x80004021, "CO_E_NOT_SUPPORTED"},
+	{0x80004022, "CO_E_RELOAD_DLL"},
+	{0x80004023, "CO_E_MSI_ERROR"},
+	{0x80004024, "CO_E_ATTEMPT_TO_CREATE_OUTSIDE_CLIENT_CONTEXT"},
+	{0x80004025, "CO_E_SERVER_PAUSED"},
+	{0x80004026, "CO_E_SERVER_NOT_PAUSED"},
+	{0x80004027, "CO_E_CLASS_DISABLED"},
+	{0x80004028, "CO_E_CLRNOTAVAILABLE"},
+	{0x80004029, "CO_E_ASYNC_WORK_REJECTED"},
+	{0x8000402A, "CO_E_SERVER_INIT_TIMEOUT"},
+	{0x8000402B, "CO_E_NO_SECCTX_IN_ACTIVATE"},
+	{0x80004030, "CO_E_TRACKER_CONFIG"},
+	{0x80004031, "CO_E_THREADPOOL_CONFIG"},
+	{0x80004032, "CO_E_SXS_CONFIG"},
+	{0x80004033, "CO_E_MALFORMED_SPN"},
+	{0x80004034, "CO_E_UNREVOKED_REGISTRATION_ON_APARTMENT_SHUTDOWN"},
+	{0x80004035, "CO_E_PREMATURE_STUB_RUNDOWN"},
+	{0x8000FFFF, "E_UNEXPECTED"},
+	{0x80010001, "RPC_E_CALL_REJECTED"},
+	{0x80010002, "RPC_E_CALL_CANCELED"},
+	{0x80010003, "RPC_E_CANTPOST_INSENDCALL"},
+	{0x80010004, "RPC_E_CANTCALLOUT_INASYNCCALL"},
+	{0x80010005, "RPC_E_CANTCALLOUT_INEXTERNALCALL"},
+	{0x80010006, "RPC_E_CONNECTION_TERMINATED"},
+	{0x80010007, "RPC_E_SERVER_DIED"},
+	{0x80010008, "RPC_E_CLIENT_DIED"},
+	{0x80010009, "RPC_E_INVALID_DATAPACKET"},
+	{0x8001000A, "RPC_E_CANTTRANSMIT_CALL"},
+	{0x8001000B, "RPC_E_CLIENT_CANTMARSHAL_DATA"},
+	{0x8001000C, "RPC_E_CLIENT_CANTUNMARSHAL_DATA"},
+	{0x8001000D, "RPC_E_SERVER_CANTMARSHAL_DATA"},
+	{0x8001000E, "RPC_E_SERVER_CANTUNMARSHAL_DATA"},
+	{0x8001000F, "RPC_E_INVALID_DATA"},
+	{0x80010010, "RPC_E_INVALID_PARAMETER"},
+	{0x80010011, "RPC_E_CANTCALLOUT_AGAIN"},
+	{0x80010012, "RPC_E_SERVER_DIED_DNE"},
+	{0x80010100, "RPC_E_SYS_CALL_FAILED"},
+	{0x80010101, "RPC_E_OUT_OF_RESOURCES"},
+	{0x80010102, "RPC_E_ATTEMPTED_MULTITHREAD"},
+	{0x80010103, "RPC_E_NOT_REGISTERED"},
+	{0x80010104, "RPC_E_FAULT"},
+	{0x80010105, "RPC_E_SERVERFAULT"},
+	{0x80010106, "RPC_E_CHANGED_MODE"},
+	{0x80010107, "RPC_E_INVALIDMETHOD"},
+	{0x80010108, "RPC_E_DISCONNECTED"},
+	{0x80010109, "RPC_E_RETRY"},
+	{0x8001010A, "RPC_E_SERVERCALL_RETRYLATER"},
+	{0x8001010B, "RPC_E_SERVERCALL_REJECTED"},
+	{0x8001010C, "RPC_E_INVALID_CALLDATA"},
+	{0x8001010D, "RPC_E_CANTCALLOUT_ININPUTSYNCCALL"},
+	{0x8001010E, "RPC_E_WRONG_THREAD"},
+	{0x8001010F, "RPC_E_THREAD_NOT_INIT"},
+	{0x80010110, "RPC_E_VERSION_MISMATCH"},
+	{0x80010111, "RPC_E_INVALID_HEADER"},
+	{0x80010112, "RPC_E_INVALID_EXTENSION"},
+	{0x80010113, "RPC_E_INVALID_IPID"},
+	{0x80010114, "RPC_E_INVALID_OBJECT"},
+	{0x80010115, "RPC_S_CALLPENDING"},
+	{0x80010116, "RPC_S_WAITONTIMER"},
+	{0x80010117, "RPC_E_CALL_COMPLETE"},
+	{0x80010118, "RPC_E_UNSECURE_CALL"},
+	{0x80010119, "RPC_E_TOO_LATE"},
+	{0x8001011A, "RPC_E_NO_GOOD_SECURITY_PACKAGES"},
+	{0x8001011B, "RPC_E_ACCESS_DENIED"},
+	{0x8001011C, "RPC_E_REMOTE_DISABLED"},
+	{0x8001011D, "RPC_E_INVALID_OBJREF"},
+	{0x8001011E, "RPC_E_NO_CONTEXT"},
+	{0x8001011F, "RPC_E_TIMEOUT"},
+	{0x80010120, "RPC_E_NO_SYNC"},
+	{0x80010121, "RPC_E_FULLSIC_REQUIRED"},
+	{0x80010122, "RPC_E_INVALID_STD_NAME"},
+	{0x80010123, "CO_E_FAILEDTOIMPERSONATE"},
+	{0x80010124, "CO_E_FAILEDTOGETSECCTX"},
+	{0x80010125, "CO_E_FAILEDTOOPENTHREADTOKEN"},
+	{0x80010126, "CO_E_FAILEDTOGETTOKENINFO"},
+	{0x80010127, "CO_E_TRUSTEEDOESNTMATCHCLIENT"},
+	{0x80010128, "CO_E_FAILEDTOQUERYCLIENTBLANKET"},
+	{0x80010129, "CO_E_FAILEDTOSETDACL"},
+	{0x8001012A, "CO_E_ACCESSCHECKFAILED"},
+	{0x8001012B, "CO_E_NETACCESSAPIFAILED"},
+	{0x8001012C, "CO_E_WRONGTRUSTEENAMESYNTAX"},
+	{0x8001012D, "CO_E_INVALIDSID"},
+	{0x8001012E, "CO_E_CONVERSIONFAILED"},
+	{0x8001012F, "CO_E_NOMATCHINGSIDFOUND"},
+	{0x80010130, "CO_E_LOOKUPACCSIDFAILED"},
+	{0x80010131, "CO_E_NOMATCHINGNAMEFOUND"},
+	{0x80010132, "CO_E_LOOKUPACCNAMEFAILED"},
+	{0x80010133, "CO_E_SETSERLHNDLFAILED"},
+	{0x80010134, "CO_E_FAILEDTOGETWINDIR"},
+	{0x80010135, "CO_E_PATHTOOLONG"},
+	{0x80010136, "CO_E_FAILEDTOGENUUID"},
+	{0x80010137, "CO_E_FAILEDTOCREATEFILE"},
+	{0x80010138, "CO_E_FAILEDTOCLOSEHANDLE"},
+	{0x80010139, "CO_E_EXCEEDSYSACLLIMIT"},
+	{0x8001013A, "CO_E_ACESINWRONGORDER"},
+	{0x8001013B, "CO_E_INCOMPATIBLESTREAMVERSION"},
+	{0x8001013C, "CO_E_FAILEDTOOPENPROCESSTOKEN"},
+	{0x8001013D, "CO_E_DECODEFAILED"},
+	{0x8001013F, "CO_E_ACNOTINITIALIZED"},
+	{0x80010140, "CO_E_CANCEL_DISABLED"},
+	{0x8001FFFF, "RPC_E_UNEXPECTED"},
+	{0x80020001, "DISP_E_UNKNOWNINTERFACE"},
+	{0x80020003, "DISP_E_MEMBERNOTFOUND"},
+	{0x80020004, "DISP_E_PARAMNOTFOUND"},
+	{0x80020005, "DISP_E_TYPEMISMATCH"},
+	{0x80020006, "DISP_E_UNKNOWNNAME"},
+	{0x80020007, "DISP_E_NONAMEDARGS"},
+	{0x80020008, "DISP_E_BADVARTYPE"},
+	{0x80020009, "DISP_E_EXCEPTION"},
+	{0x8002000A, "DISP_E_OVERFLOW"},
+	{0x8002000B, "DISP_E_BADINDEX"},
+	{0x8002000C, "DISP_E_UNKNOWNLCID"},
+	{0x8002000D, "DISP_E_ARRAYISLOCKED"},
+	{0x8002000E, "DISP_E_BADPARAMCOUNT"},
+	{0x8002000F, "DISP_E_PARAMNOTOPTIONAL"},
+	{0x80020010, "DISP_E_BADCALLEE"},
+	{0x80020011, "DISP_E_NOTACOLLECTION"},
+	{0x80020012, "DISP_E_DIVBYZERO"},
+	{0x80020013, "DISP_E_BUFFERTOOSMALL"},
+	{0x80028016, "TYPE_E_BUFFERTOOSMALL"},
+	{0x80028017, "TYPE_E_FIELDNOTFOUND"},
+	{0x80028018, "TYPE_E_INVDATAREAD"},
+	{0x80028019, "TYPE_E_UNSUPFORMAT"},
+	{0x8002801C, "TYPE_E_REGISTRYACCESS"},
+	{0x8002801D, "TYPE_E_LIBNOTREGISTERED"},
+	{0x80028027, "TYPE_E_UNDEFINEDTYPE"},
+	{0x80028028, "TYPE_E_QUALIFIEDNAMEDISALLOWED"},
+	{0x80028029, "TYPE_E_INVALIDSTATE"},
+	{0x8002802A, "TYPE_E_WRONGTYPEKIND"},
+	{0x8002802B, "TYPE_E_ELEMENTNOTFOUND"},
+	{0x8002802C, "TYPE_E_AMBIGUOUSNAME"},
+	{0x8002802D, "TYPE_E_NAMECONFLICT"},
+	{0x8002802E, "TYPE_E_UNKNOWNLCID"},
+	{0x8002802F, "TYPE_E_DLLFUNCTIONNOTFOUND"},
+	{0x800288BD, "TYPE_E_BADMODULEKIND"},
+	{0x800288C5, "TYPE_E_SIZETOOBIG"},
+	{0x800288C6, "TYPE_E_DUPLICATEID"},
+	{0x800288CF, "TYPE_E_INVALIDID"},
+	{0x80028CA0, "TYPE_E_TYPEMISMATCH"},
+	{0x80028CA1, "TYPE_E_OUTOFBOUNDS"},
+	{0x80028CA2, "TYPE_E_IOERROR"},
+	{0x80028CA3, "TYPE_E_CANTCREATETMPFILE"},
+	{0x80029C4A, "TYPE_E_CANTLOADLIBRARY"},
+	{0x80029C83, "TYPE_E_INCONSISTENTPROPFUNCS"},
+	{0x80029C84, "TYPE_E_CIRCULARTYPE"},
+	{0x80030001, "STG_E_INVALIDFUNCTION"},
+	{0x80030002, "STG_E_FILENOTFOUND"},
+	{0x80030003, "STG_E_PATHNOTFOUND"},
+	{0x80030004, "STG_E_TOOMANYOPENFILES"},
+	{0x80030005, "STG_E_ACCESSDENIED"},
+	{0x80030006, "STG_E_INVALIDHANDLE"},
+	{0x80030008, "STG_E_INSUFFICIENTMEMORY"},
+	{0x80030009, "STG_E_INVALIDPOINTER"},
+	{0x80030012, "STG_E_NOMOREFILES"},
+	{0x80030013, "STG_E_DISKISWRITEPROTECTED"},
+	{0x80030019, "STG_E_SEEKERROR"},
+	{0x8003001D, "STG_E_WRITEFAULT"},
+	{0x8003001E, "STG_E_READFAULT"},
+	{0x80030020, "STG_E_SHAREVIOLATION"},
+	{0x80030021, "STG_E_LOCKVIOLATION"},
+	{0x80030050, "STG_E_FILEALREADYEXISTS"},
+	{0x80030057, "STG_E_INVALIDPARAMETER"},
+	{0x80030070, "STG_E_MEDIUMFULL"},
+	{0x800300F0, "STG_E_PROPSETMISMATCHED"},
+	{0x800300FA, "STG_E_ABNORMALAPIEXIT"},
+	{0x800300FB, "STG_E_INVALIDHEADER"},
+	{0x800300FC, "STG_E_INVALIDNAME"},
+	{0x800300FD, "STG_E_UNKNOWN"},
+	{0x800300FE, "STG_E_UNIMPLEMENTEDFUNCTION"},
+	{0x800300FF, "STG_E_INVALIDFLAG"},
+	{0x80030100, "STG_E_INUSE"},
+	{0x80030101, "STG_E_NOTCURRENT"},
+	{0x80030102, "STG_E_REVERTED"},
+	{0x80030103, "STG_E_CANTSAVE"},
+	{0x80030104, "STG_E_OLDFORMAT"},
+	{0x80030105, "STG_E_OLDDLL"},
+	{0x80030106, "STG_E_SHAREREQUIRED"},
+	{0x80030107, "STG_E_NOTFILEBASEDSTORAGE"},
+	{0x80030108, "STG_E_EXTANTMARSHALLINGS"},
+	{0x80030109, "STG_E_DOCFILECORRUPT"},
+	{0x80030110, "STG_E_BADBASEADDRESS"},
+	{0x80030111, "STG_E_DOCFILETOOLARGE"},
+	{0x80030112, "STG_E_NOTSIMPLEFORMAT"},
+	{0x80030201, "STG_E_INCOMPLETE"},
+	{0x80030202, "STG_E_TERMINATED"},
+	{0x80030208, "STG_E_FIRMWARE_SLOT_INVALID"},
+	{0x80030209, "STG_E_FIRMWARE_IMAGE_INVALID"},
+	{0x8003020A, "STG_E_DEVICE_UNRESPONSIVE"},
+	{0x80030305, "STG_E_STATUS_COPY_PROTECTION_FAILURE"},
+	{0x80030306, "STG_E_CSS_AUTHENTICATION_FAILURE"},
+	{0x80030307, "STG_E_CSS_KEY_NOT_PRESENT"},
+	{0x80030308, "STG_E_CSS_KEY_NOT_ESTABLISHED"},
+	{0x80030309, "STG_E_CSS_SCRAMBLED_SECTOR"},
+	{0x8003030A, "STG_E_CSS_REGION_MISMATCH"},
+	{0x8003030B, "STG_E_RESETS_EXHAUSTED"},
+	{0x80040000, "OLE_E_OLEVERB"},
+	{0x80040001, "OLE_E_ADVF"},
+	{0x80040002, "OLE_E_ENUM_NOMORE"},
+	{0x80040003, "OLE_E_ADVISENOTSUPPORTED"},
+	{0x80040004, "OLE_E_NOCONNECTION"},
+	{0x80040005, "OLE_E_NOTRUNNING"},
+	{0x80040006, "OLE_E_NOCACHE"},
+	{0x80040007, "OLE_E_BLANK"},
+	{0x80040008, "OLE_E_CLASSDIFF"},
+	{0x80040009, "OLE_E_CANT_GETMONIKER"},
+	{0x8004000A, "OLE_E_CANT_BINDTOSOURCE"},
+	{0x8004000B, "OLE_E_STATIC"},
+	{0x8004000C, "OLE_E_PROMPTSAVECANCELLED"},
+	{0x8004000D, "OLE_E_INVALIDRECT"},
+	{0x8004000E, "OLE_E_WRONGCOMPOBJ"},
+	{0x8004000F, "OLE_E_INVALIDHWND"},
+	{0x80040010, "OLE_E_NOT_INPLACEACTIVE"},
+	{0x80040011, "OLE_E_CANTCONVERT"},
+	{0x80040012, "OLE_E_NOSTORAGE"},
+	{0x80040064, "DV_E_FORMATETC"},
+	{0x80040065, "DV_E_DVTARGETDEVICE"},
+	{0x80040066, "DV_E_STGMEDIUM"},
+	{0x80040067, "DV_E_STATDATA"},
+	{0x80040068, "DV_E_LINDEX"},
+	{0x80040069, "DV_E_TYMED"},
+	{0x8004006A, "DV_E_CLIPFORMAT"},
+	{0x8004006B, "DV_E_DVASPECT"},
+	{0x8004006C, "DV_E_DVTARGETDEVICE_SIZE"},
+	{0x8004006D, "DV_E_NOIVIEWOBJECT"},
+	{0x80040100, "DRAGDROP_E_NOTREGISTERED"},
+	{0x80040101, "DRAGDROP_E_ALREADYREGISTERED"},
+	{0x80040102, "DRAGDROP_E_INVALIDHWND"},
+	{0x80040103, "DRAGDROP_E_CONCURRENT_DRAG_ATTEMPTED"},
+	{0x80040110, "CLASS_E_NOAGGREGATION"},
+	{0x80040111, "CLASS_E_CLASSNOTAVAILABLE"},
+	{0x80040112, "CLASS_E_NOTLICENSED"},
+	{0x80040140, "VIEW_E_DRAW"},
+	{0x80040150, "REGDB_E_READREGDB"},
+	{0x80040151, "REGDB_E_WRITEREGDB"},
+	{0x80040152, "REGDB_E_KEYMISSING"},
+	{0x80040153, "REGDB_E_INVALIDVALUE"},
+	{0x80040154, "REGDB_E_CLASSNOTREG"},
+	{0x80040155, "REGDB_E_IIDNOTREG"},
+	{0x80040156, "REGDB_E_BADTHREADINGMODEL"},
+	{0x80040157, "REGDB_E_PACKAGEPOLICYVIOLATION"},
+	{0x80040160, "CAT_E_CATIDNOEXIST"},
+	{0x80040161, "CAT_E_NODESCRIPTION"},
+	{0x80040164, "CS_E_PACKAGE_NOTFOUND"},
+	{0x80040165, "CS_E_NOT_DELETABLE"},
+	{0x80040166, "CS_E_CLASS_NOTFOUND"},
+	{0x80040167, "CS_E_INVALID_VERSION"},
+	{0x80040168, "CS_E_NO_CLASSSTORE"},
+	{0x80040169, "CS_E_OBJECT_NOTFOUND"},
+	{0x8004016A, "CS_E_OBJECT_ALREADY_EXISTS"},
+	{0x8004016B, "CS_E_INVALID_PATH"},
+	{0x8004016C, "CS_E_NETWORK_ERROR"},
+	{0x8004016D, "CS_E_ADMIN_LIMIT_EXCEEDED"},
+	{0x8004016E, "CS_E_SCHEMA_MISMATCH"},
+	{0x8004016F, "CS_E_INTERNAL_ERROR"},
+	{0x80040170, "CACHE_E_NOCACHE_UPDATED"},
+	{0x80040180, "OLEOBJ_E_NOVERBS"},
+	{0x80040181, "OLEOBJ_E_INVALIDVERB"},
+	{0x800401A0, "INPLACE_E_NOTUNDOABLE"},
+	{0x800401A1, "INPLACE_E_NOTOOLSPACE"},
+	{0x800401C0, "CONVERT10_E_OLESTREAM_GET"},
+	{0x800401C1, "CONVERT10_E_OLESTREAM_PUT"},
+	{0x800401C2, "CONVERT10_E_OLESTREAM_FMT"},
+	{0x800401C3, "CONVERT10_E_OLESTREAM_BITMAP_TO_DIB"},
+	{0x800401C4, "CONVERT10_E_STG_FMT"},
+	{0x800401C5, "CONVERT10_E_STG_NO_STD_STREAM"},
+	{0x800401C6, "CONVERT10_E_STG_DIB_TO_BITMAP"},
+	{0x800401D0, "CLIPBRD_E_CANT_OPEN"},
+	{0x800401D1, "CLIPBRD_E_CANT_EMPTY"},
+	{0x800401D2, "CLIPBRD_E_CANT_SET"},
+	{0x800401D3, "CLIPBRD_E_BAD_DATA"},
+	{0x800401D4, "CLIPBRD_E_CANT_CLOSE"},
+	{0x800401E0, "MK_E_CONNECTMANUALLY"},
+	{0x800401E1, "MK_E_EXCEEDEDDEADLINE"},
+	{0x800401E2, "MK_E_NEEDGENERIC"},
+	{0x800401E3, "MK_E_UNAVAILABLE"},
+	{0x800401E4, "MK_E_SYNTAX"},
+	{0x800401E5, "MK_E_NOOBJECT"},
+	{0x800401E6, "MK_E_INVALIDEXTENSION"},
+	{0x800401E7, "MK_E_INTERMEDIATEINTERFACENOTSUPPORTED"},
+	{0x800401E8, "MK_E_NOTBINDABLE"},
+	{0x800401E9, "MK_E_NOTBOUND"},
+	{0x800401EA, "MK_E_CANTOPENFILE"},
+	{0x800401EB, "MK_E_MUSTBOTHERUSER"},
+	{0x800401EC, "MK_E_NOINVERSE"},
+	{0x800401ED, "MK_E_NOSTORAGE"},
+	{0x800401EE, "MK_E_NOPREFIX"},
+	{0x800401EF, "MK_E_ENUMERATION_FAILED"},
+	{0x800401F0, "CO_E_NOTINITIALIZED"},
+	{0x800401F1, "CO_E_ALREADYINITIALIZED"},
+	{0x800401F2, "CO_E_CANTDETERMINECLASS"},
+	{0x800401F3, "CO_E_CLASSSTRING"},
+	{0x800401F4, "CO_E_IIDSTRING"},
+	{0x800401F5, "CO_E_APPNOTFOUND"},
+	{0x800401F6, "CO_E_APPSINGLEUSE"},
+	{0x800401F7, "CO_E_ERRORINAPP"},
+	{0x800401F8, "CO_E_DLLNOTFOUND"},
+	{0x800401F9, "CO_E_ERRORINDLL"},
+	{0x800401FA, "CO_E_WRONGOSFORAPP"},
+	{0x800401FB, "CO_E_OBJNOTREG"},
+	{0x800401FC, "CO_E_OBJISREG"},
+	{0x800401FD, "CO_E_OBJNOTCONNECTED"},
+	{0x800401FE, "CO_E_APPDIDNTREG"},
+	{0x800401FF, "CO_E_RELEASED"},
+	{0x80040201, "EVENT_E_ALL_SUBSCRIBERS_FAILED"},
+	{0x80040203, "EVENT_E_QUERYSYNTAX"},
+	{0x80040204, "EVENT_E_QUERYFIELD"},
+	{0x80040205, "EVENT_E_INTERNALEXCEPTION"},
+	{0x80040206, "EVENT_E_INTERNALERROR"},
+	{0x80040207, "EVENT_E_INVALID_PER_USER_SID"},
+	{0x80040208, "EVENT_E_USER_EXCEPTION"},
+	{0x80040209, "EVENT_E_TOO_MANY_METHODS"},
+	{0x8004020A, "EVENT_E_MISSING_EVENTCLASS"},
+	{0x8004020B, "EVENT_E_NOT_ALL_REMOVED"},
+	{0x8004020C, "EVENT_E_COMPLUS_NOT_INSTALLED"},
+	{0x8004020D, "EVENT_E_CANT_MODIFY_OR_DELETE_UNCONFIGURED_OBJECT"},
+	{0x8004020E, "EVENT_E_CANT_MODIFY_OR_DELETE_CONFIGURED_OBJECT"},
+	{0x8004020F, "EVENT_E_INVALID_EVENT_CLASS_PARTITION"},
+	{0x80040210, "EVENT_E_PER_USER_SID_NOT_LOGGED_ON"},
+	{0x80040212, "TPC_E_NO_DEFAULT_TABLET"},
+	{0x80040219, "TPC_E_INVALID_INPUT_RECT"},
+	{0x8004021B, "TPC_E_UNKNOWN_PROPERTY"},
+	{0x80040222, "TPC_E_INVALID_STROKE"},
+	{0x80040223, "TPC_E_INITIALIZE_FAIL"},
+	{0x80040232, "TPC_E_NOT_RELEVANT"},
+	{0x80040233, "TPC_E_INVALID_PACKET_DESCRIPTION"},
+	{0x80040235, "TPC_E_RECOGNIZER_NOT_REGISTERED"},
+	{0x80040236, "TPC_E_INVALID_RIGHTS"},
+	{0x80040237, "TPC_E_OUT_OF_ORDER_CALL"},
+	{0x80040238, "TPC_E_QUEUE_FULL"},
+	{0x80040239, "TPC_E_INVALID_CONFIGURATION"},
+	{0x8004023A, "TPC_E_INVALID_DATA_FROM_RECOGNIZER"},
+	{0x80040241, "TPC_E_INVALID_PROPERTY"},
+	{0x80041309, "SCHED_E_TRIGGER_NOT_FOUND"},
+	{0x8004130A, "SCHED_E_TASK_NOT_READY"},
+	{0x8004130B, "SCHED_E_TASK_NOT_RUNNING"},
+	{0x8004130C, "SCHED_E_SERVICE_NOT_INSTALLED"},
+	{0x8004130D, "SCHED_E_CANNOT_OPEN_TASK"},
+	{0x8004130E, "SCHED_E_INVALID_TASK"},
+	{0x8004130F, "SCHED_E_ACCOUNT_INFORMATION_NOT_SET"},
+	{0x80041310, "SCHED_E_ACCOUNT_NAME_NOT_FOUND"},
+	{0x80041311, "SCHED_E_ACCOUNT_DBASE_CORRUPT"},
+	{0x80041312, "SCHED_E_NO_SECURITY_SERVICES"},
+	{0x80041313, "SCHED_E_UNKNOWN_OBJECT_VERSION"},
+	{0x80041314, "SCHED_E_UNSUPPORTED_ACCOUNT_OPTION"},
+	{0x80041315, "SCHED_E_SERVICE_NOT_RUNNING"},
+	{0x80041316, "SCHED_E_UNEXPECTEDNODE"},
+	{0x80041317, "SCHED_E_NAMESPACE"},
+	{0x80041318, "SCHED_E_INVALIDVALUE"},
+	{0x80041319, "SCHED_E_MISSINGNODE"},
+	{0x8004131A, "SCHED_E_MALFORMEDXML"},
+	{0x8004131D, "SCHED_E_TOO_MANY_NODES"},
+	{0x8004131E, "SCHED_E_PAST_END_BOUNDARY"},
+	{0x8004131F, "SCHED_E_ALREADY_RUNNING"},
+	{0x80041320, "SCHED_E_USER_NOT_LOGGED_ON"},
+	{0x80041321, "SCHED_E_INVALID_TASK_HASH"},
+	{0x80041322, "SCHED_E_SERVICE_NOT_AVAILABLE"},
+	{0x80041323, "SCHED_E_SERVICE_TOO_BUSY"},
+	{0x80041324, "SCHED_E_TASK_ATTEMPTED"},
+	{0x80041326, "SCHED_E_TASK_DISABLED"},
+	{0x80041327, "SCHED_E_TASK_NOT_V1_COMPAT"},
+	{0x80041328, "SCHED_E_START_ON_DEMAND"},
+	{0x80041329, "SCHED_E_TASK_NOT_UBPM_COMPAT"},
+	{0x80041330, "SCHED_E_DEPRECATED_FEATURE_USED"},
+	{0x8004D000, "XACT_E_ALREADYOTHERSINGLEPHASE"},
+	{0x8004D001, "XACT_E_CANTRETAIN"},
+	{0x8004D002, "XACT_E_COMMITFAILED"},
+	{0x8004D003, "XACT_E_COMMITPREVENTED"},
+	{0x8004D004, "XACT_E_HEURISTICABORT"},
+	{0x8004D005, "XACT_E_HEURISTICCOMMIT"},
+	{0x8004D006, "XACT_E_HEURISTICDAMAGE"},
+	{0x8004D007, "XACT_E_HEURISTICDANGER"},
+	{0x8004D008, "XACT_E_ISOLATIONLEVEL"},
+	{0x8004D009, "XACT_E_NOASYNC"},
+	{0x8004D00A, "XACT_E_NOENLIST"},
+	{0x8004D00B, "XACT_E_NOISORETAIN"},
+	{0x8004D00C, "XACT_E_NORESOURCE"},
+	{0x8004D00D, "XACT_E_NOTCURRENT"},
+	{0x8004D00E, "XACT_E_NOTRANSACTION"},
+	{0x8004D00F, "XACT_E_NOTSUPPORTED"},
+	{0x8004D010, "XACT_E_UNKNOWNRMGRID"},
+	{0x8004D011, "XACT_E_WRONGSTATE"},
+	{0x8004D012, "XACT_E_WRONGUOW"},
+	{0x8004D013, "XACT_E_XTIONEXISTS"},
+	{0x8004D014, "XACT_E_NOIMPORTOBJECT"},
+	{0x8004D015, "XACT_E_INVALIDCOOKIE"},
+	{0x8004D016, "XACT_E_INDOUBT"},
+	{0x8004D017, "XACT_E_NOTIMEOUT"},
+	{0x8004D018, "XACT_E_ALREADYINPROGRESS"},
+	{0x8004D019, "XACT_E_ABORTED"},
+	{0x8004D01A, "XACT_E_LOGFULL"},
+	{0x8004D01B, "XACT_E_TMNOTAVAILABLE"},
+	{0x8004D01C, "XACT_E_CONNECTION_DOWN"},
+	{0x8004D01D, "XACT_E_CONNECTION_DENIED"},
+	{0x8004D01E, "XACT_E_REENLISTTIMEOUT"},
+	{0x8004D01F, "XACT_E_TIP_CONNECT_FAILED"},
+	{0x8004D020, "XACT_E_TIP_PROTOCOL_ERROR"},
+	{0x8004D021, "XACT_E_TIP_PULL_FAILED"},
+	{0x8004D022, "XACT_E_DEST_TMNOTAVAILABLE"},
+	{0x8004D023, "XACT_E_TIP_DISABLED"},
+	{0x8004D024, "XACT_E_NETWORK_TX_DISABLED"},
+	{0x8004D025, "XACT_E_PARTNER_NETWORK_TX_DISABLED"},
+	{0x8004D026, "XACT_E_XA_TX_DISABLED"},
+	{0x8004D027, "XACT_E_UNABLE_TO_READ_DTC_CONFIG"},
+	{0x8004D028, "XACT_E_UNABLE_TO_LOAD_DTC_PROXY"},
+	{0x8004D029, "XACT_E_ABORTING"},
+	{0x8004D02A, "XACT_E_PUSH_COMM_FAILURE"},
+	{0x8004D02B, "XACT_E_PULL_COMM_FAILURE"},
+	{0x8004D02C, "XACT_E_LU_TX_DISABLED"},
+	{0x8004D080, "XACT_E_CLERKNOTFOUND"},
+	{0x8004D081, "XACT_E_CLERKEXISTS"},
+	{0x8004D082, "XACT_E_RECOVERYINPROGRESS"},
+	{0x8004D083, "XACT_E_TRANSACTIONCLOSED"},
+	{0x8004D084, "XACT_E_INVALIDLSN"},
+	{0x8004D085, "XACT_E_REPLAYREQUEST"},
+	{0x8004E002, "CONTEXT_E_ABORTED"},
+	{0x8004E003, "CONTEXT_E_ABORTING"},
+	{0x8004E004, "CONTEXT_E_NOCONTEXT"},
+	{0x8004E005, "CONTEXT_E_WOULD_DEADLOCK"},
+	{0x8004E006, "CONTEXT_E_SYNCH_TIMEOUT"},
+	{0x8004E007, "CONTEXT_E_OLDREF"},
+	{0x8004E00C, "CONTEXT_E_ROLENOTFOUND"},
+	{0x8004E00F, "CONTEXT_E_TMNOTAVAILABLE"},
+	{0x8004E021, "CO_E_ACTIVATIONFAILED"},
+	{0x8004E022, "CO_E_ACTIVATIONFAILED_EVENTLOGGED"},
+	{0x8004E023, "CO_E_ACTIVATIONFAILED_CATALOGERROR"},
+	{0x8004E024, "CO_E_ACTIVATIONFAILED_TIMEOUT"},
+	{0x8004E025, "CO_E_INITIALIZATIONFAILED"},
+	{0x8004E026, "CONTEXT_E_NOJIT"},
+	{0x8004E027, "CONTEXT_E_NOTRANSACTION"},
+	{0x8004E028, "CO_E_THREADINGMODEL_CHANGED"},
+	{0x8004E029, "CO_E_NOIISINTRINSICS"},
+	{0x8004E02A, "CO_E_NOCOOKIES"},
+	{0x8004E02B, "CO_E_DBERROR"},
+	{0x8004E02C, "CO_E_NOTPOOLED"},
+	{0x8004E02D, "CO_E_NOTCONSTRUCTED"},
+	{0x8004E02E, "CO_E_NOSYNCHRONIZATION"},
+	{0x8004E02F, "CO_E_ISOLEVELMISMATCH"},
+	{0x8004E030, "CO_E_CALL_OUT_OF_TX_SCOPE_NOT_ALLOWED"},
+	{0x8004E031, "CO_E_EXIT_TRANSACTION_SCOPE_NOT_CALLED"},
+	{0x80070005, "E_ACCESSDENIED"},
+	{0x80070006, "E_HANDLE"},
+	{0x8007000E, "E_OUTOFMEMORY"},
+	{0x80070057, "E_INVALIDARG"},
+	{0x8007007A, "E_NOT_SUFFICIENT_BUFFER"},
+	{0x800701A6, "E_TIME_SENSITIVE_THREAD"},
+	{0x800701AB, "E_NO_TASK_QUEUE"},
+	{0x80070490, "E_NOT_SET"},
+	{0x8007139F, "E_NOT_VALID_STATE"},
+	{0x80072EE1, "WININET_E_OUT_OF_HANDLES"},
+	{0x80072EE2, "WININET_E_TIMEOUT"},
+	{0x80072EE3, "WININET_E_EXTENDED_ERROR"},
+	{0x80072EE4, "WININET_E_INTERNAL_ERROR"},
+	{0x80072EE5, "WININET_E_INVALID_URL"},
+	{0x80072EE6, "WININET_E_UNRECOGNIZED_SCHEME"},
+	{0x80072EE7, "WININET_E_NAME_NOT_RESOLVED"},
+	{0x80072EE8, "WININET_E_PROTOCOL_NOT_FOUND"},
+	{0x80072EE9, "WININET_E_INVALID_OPTION"},
+	{0x80072EEA, "WININET_E_BAD_OPTION_LENGTH"},
+	{0x80072EEB, "WININET_E_OPTION_NOT_SETTABLE"},
+	{0x80072EEC, "WININET_E_SHUTDOWN"},
+	{0x80072EED, "WININET_E_INCORRECT_USER_NAME"},
+	{0x80072EEE, "WININET_E_INCORRECT_PASSWORD"},
+	{0x80072EEF, "WININET_E_LOGIN_FAILURE"},
+	{0x80072EF0, "WININET_E_INVALID_OPERATION"},
+	{0x80072EF1, "WININET_E_OPERATION_CANCELLED"},
+	{0x80072EF2, "WININET_E_INCORRECT_HANDLE_TYPE"},
+	{0x80072EF3, "WININET_E_INCORRECT_HANDLE_STATE"},
+	{0x80072EF4, "WININET_E_NOT_PROXY_REQUEST"},
+	{0x80072EF5, "WININET_E_REGISTRY_VALUE_NOT_FOUND"},
+	{0x80072EF6, "WININET_E_BAD_REGISTRY_PARAMETER"},
+	{0x80072EF7, "WININET_E_NO_DIRECT_ACCESS"},
+	{0x80072EF8, "WININET_E_NO_CONTEXT"},
+	{0x80072EF9, "WININET_E_NO_CALLBACK"},
+	{0x80072EFA, "WININET_E_REQUEST_PENDING"},
+	{0x80072EFB, "WININET_E_INCORRECT_FORMAT"},
+	{0x80072EFC, "WININET_E_ITEM_NOT_FOUND"},
+	{0x80072EFD, "WININET_E_CANNOT_CONNECT"},
+	{0x80072EFE, "WININET_E_CONNECTION_ABORTED"},
+	{0x80072EFF, "WININET_E_CONNECTION_RESET"},
+	{0x80072F00, "WININET_E_FORCE_RETRY"},
+	{0x80072F01, "WININET_E_INVALID_PROXY_REQUEST"},
+	{0x80072F02, "WININET_E_NEED_UI"},
+	{0x80072F04, "WININET_E_HANDLE_EXISTS"},
+	{0x80072F05, "WININET_E_SEC_CERT_DATE_INVALID"},
+	{0x80072F06, "WININET_E_SEC_CERT_CN_INVALID"},
+	{0x80072F07, "WININET_E_HTTP_TO_HTTPS_ON_REDIR"},
+	{0x80072F08, "WININET_E_HTTPS_TO_HTTP_ON_REDIR"},
+	{0x80072F09, "WININET_E_MIXED_SECURITY"},
+	{0x80072F0A, "WININET_E_CHG_POST_IS_NON_SECURE"},
+	{0x80072F0B, "WININET_E_POST_IS_NON_SECURE"},
+	{0x80072F0C, "WININET_E_CLIENT_AUTH_CERT_NEEDED"},
+	{0x80072F0D, "WININET_E_INVALID_CA"},
+	{0x80072F0E, "WININET_E_CLIENT_AUTH_NOT_SETUP"},
+	{0x80072F0F, "WININET_E_ASYNC_THREAD_FAILED"},
+	{0x80072F10, "WININET_E_REDIRECT_SCHEME_CHANGE"},
+	{0x80072F11, "WININET_E_DIALOG_PENDING"},
+	{0x80072F12, "WININET_E_RETRY_DIALOG"},
+	{0x80072F13, "WININET_E_NO_NEW_CONTAINERS"},
+	{0x80072F14, "WININET_E_HTTPS_HTTP_SUBMIT_REDIR"},
+	{0x80072F17, "WININET_E_SEC_CERT_ERRORS"},
+	{0x80072F19, "WININET_E_SEC_CERT_REV_FAILED"},
+	{0x80072F76, "WININET_E_HEADER_NOT_FOUND"},
+	{0x80072F77, "WININET_E_DOWNLEVEL_SERVER"},
+	{0x80072F78, "WININET_E_INVALID_SERVER_RESPONSE"},
+	{0x80072F79, "WININET_E_INVALID_HEADER"},
+	{0x80072F7A, "WININET_E_INVALID_QUERY_REQUEST"},
+	{0x80072F7B, "WININET_E_HEADER_ALREADY_EXISTS"},
+	{0x80072F7C, "WININET_E_REDIRECT_FAILED"},
+	{0x80072F7D, "WININET_E_SECURITY_CHANNEL_ERROR"},
+	{0x80072F7E, "WININET_E_UNABLE_TO_CACHE_FILE"},
+	{0x80072F7F, "WININET_E_TCPIP_NOT_INSTALLED"},
+	{0x80072F80, "WININET_E_NOT_REDIRECTED"},
+	{0x80072F81, "WININET_E_COOKIE_NEEDS_CONFIRMATION"},
+	{0x80072F82, "WININET_E_COOKIE_DECLINED"},
+	{0x80072F83, "WININET_E_DISCONNECTED"},
+	{0x80072F84, "WININET_E_SERVER_UNREACHABLE"},
+	{0x80072F85, "WININET_E_PROXY_SERVER_UNREACHABLE"},
+	{0x80072F86, "WININET_E_BAD_AUTO_PROXY_SCRIPT"},
+	{0x80072F87, "WININET_E_UNABLE_TO_DOWNLOAD_SCRIPT"},
+	{0x80072F88, "WININET_E_REDIRECT_NEEDS_CONFIRMATION"},
+	{0x80072F89, "WININET_E_SEC_INVALID_CERT"},
+	{0x80072F8A, "WININET_E_SEC_CERT_REVOKED"},
+	{0x80072F8B, "WININET_E_FAILED_DUETOSECURITYCHECK"},
+	{0x80072F8C, "WININET_E_NOT_INITIALIZED"},
+	{0x80072F8E, "WININET_E_LOGIN_FAILURE_DISPLAY_ENTITY_BODY"},
+	{0x80072F8F, "WININET_E_DECODING_FAILED"},
+	{0x80080001, "CO_E_CLASS_CREATE_FAILED"},
+	{0x80080002, "CO_E_SCM_ERROR"},
+	{0x80080003, "CO_E_SCM_RPC_FAILURE"},
+	{0x80080004, "CO_E_BAD_PATH"},
+	{0x80080005, "CO_E_SERVER_EXEC_FAILURE"},
+	{0x80080006, "CO_E_OBJSRV_RPC_FAILURE"},
+	{0x80080007, "MK_E_NO_NORMALIZED"},
+	{0x80080008, "CO_E_SERVER_STOPPING"},
+	{0x80080009, "MEM_E_INVALID_ROOT"},
+	{0x80080010, "MEM_E_INVALID_LINK"},
+	{0x80080011, "MEM_E_INVALID_SIZE"},
+	{0x80080015, "CO_E_MISSING_DISPLAYNAME"},
+	{0x80080016, "CO_E_RUNAS_VALUE_MUST_BE_AAA"},
+	{0x80080017, "CO_E_ELEVATION_DISABLED"},
+	{0x80080200, "APPX_E_PACKAGING_INTERNAL"},
+	{0x80080201, "APPX_E_INTERLEAVING_NOT_ALLOWED"},
+	{0x80080202, "APPX_E_RELATIONSHIPS_NOT_ALLOWED"},
+	{0x80080203, "APPX_E_MISSING_REQUIRED_FILE"},
+	{0x80080204, "APPX_E_INVALID_MANIFEST"},
+	{0x80080205, "APPX_E_INVALID_BLOCKMAP"},
+	{0x80080206, "APPX_E_CORRUPT_CONTENT"},
+	{0x80080207, "APPX_E_BLOCK_HASH_INVALID"},
+	{0x80080208, "APPX_E_REQUESTED_RANGE_TOO_LARGE"},
+	{0x80080209, "APPX_E_INVALID_SIP_CLIENT_DATA"},
+	{0x8008020A, "APPX_E_INVALID_KEY_INFO"},
+	{0x8008020B, "APPX_E_INVALID_CONTENTGROUPMAP"},
+	{0x8008020C, "APPX_E_INVALID_APPINSTALLER"},
+	{0x8008020D, "APPX_E_DELTA_BASELINE_VERSION_MISMATCH"},
+	{0x8008020E, "APPX_E_DELTA_PACKAGE_MISSING_FILE"},
+	{0x8008020F, "APPX_E_INVALID_DELTA_PACKAGE"},
+	{0x80080210, "APPX_E_DELTA_APPENDED_PACKAGE_NOT_ALLOWED"},
+	{0x80080211, "APPX_E_INVALID_PACKAGING_LAYOUT"},
+	{0x80080212, "APPX_E_INVALID_PACKAGESIGNCONFIG"},
+	{0x80080213, "APPX_E_RESOURCESPRI_NOT_ALLOWED"},
+	{0x80080214, "APPX_E_FILE_COMPRESSION_MISMATCH"},
+	{0x80080215, "APPX_E_INVALID_PAYLOAD_PACKAGE_EXTENSION"},
+	{0x80080216, "APPX_E_INVALID_ENCRYPTION_EXCLUSION_FILE_LIST"},
+	{0x80080300, "BT_E_SPURIOUS_ACTIVATION"},
+	{0x80090001, "NTE_BAD_UID"},
+	{0x80090002, "NTE_BAD_HASH"},
+	{0x80090003, "NTE_BAD_KEY"},
+	{0x80090004, "NTE_BAD_LEN"},
+	{0x80090005, "NTE_BAD_DATA"},
+	{0x80090006, "NTE_BAD_SIGNATURE"},
+	{0x80090007, "NTE_BAD_VER"},
+	{0x80090008, "NTE_BAD_ALGID"},
+	{0x80090009, "NTE_BAD_FLAGS"},
+	{0x8009000A, "NTE_BAD_TYPE"},
+	{0x8009000B, "NTE_BAD_KEY_STATE"},
+	{0x8009000C, "NTE_BAD_HASH_STATE"},
+	{0x8009000D, "NTE_NO_KEY"},
+	{0x8009000E, "NTE_NO_MEMORY"},
+	{0x8009000F, "NTE_EXISTS"},
+	{0x80090010, "NTE_PERM"},
+	{0x80090011, "NTE_NOT_FOUND"},
+	{0x80090012, "NTE_DOUBLE_ENCRYPT"},
+	{0x80090013, "NTE_BAD_PROVIDER"},
+	{0x80090014, "NTE_BAD_PROV_TYPE"},
+	{0x80090015, "NTE_BAD_PUBLIC_KEY"},
+	{0x80090016, "NTE_BAD_KEYSET"},
+	{0x80090017, "NTE_PROV_TYPE_NOT_DEF"},
+	{0x80090018, "NTE_PROV_TYPE_ENTRY_BAD"},
+	{0x80090019, "NTE_KEYSET_NOT_DEF"},
+	{0x8009001A, "NTE_KEYSET_ENTRY_BAD"},
+	{0x8009001B, "NTE_PROV_TYPE_NO_MATCH"},
+	{0x8009001C, "NTE_SIGNATURE_FILE_BAD"},
+	{0x8009001D, "NTE_PROVIDER_DLL_FAIL"},
+	{0x8009001E, "NTE_PROV_DLL_NOT_FOUND"},
+	{0x8009001F, "NTE_BAD_KEYSET_PARAM"},
+	{0x80090020, "NTE_FAIL"},
+	{0x80090021, "NTE_SYS_ERR"},
+	{0x80090022, "NTE_SILENT_CONTEXT"},
+	{0x80090023, "NTE_TOKEN_KEYSET_STORAGE_FULL"},
+	{0x80090024, "NTE_TEMPORARY_PROFILE"},
+	{0x80090025, "NTE_FIXEDPARAMETER"},
+	{0x80090026, "NTE_INVALID_HANDLE"},
+	{0x80090027, "NTE_INVALID_PARAMETER"},
+	{0x80090028, "NTE_BUFFER_TOO_SMALL"},
+	{0x80090029, "NTE_NOT_SUPPORTED"},
+	{0x8009002A, "NTE_NO_MORE_ITEMS"},
+	{0x8009002B, "NTE_BUFFERS_OVERLAP"},
+	{0x8009002C, "NTE_DECRYPTION_FAILURE"},
+	{0x8009002D, "NTE_INTERNAL_ERROR"},
+	{0x8009002E, "NTE_UI_REQUIRED"},
+	{0x8009002F, "NTE_HMAC_NOT_SUPPORTED"},
+	{0x80090030, "NTE_DEVICE_NOT_READY"},
+	{0x80090031, "NTE_AUTHENTICATION_IGNORED"},
+	{0x80090032, "NTE_VALIDATION_FAILED"},
+	{0x80090033, "NTE_INCORRECT_PASSWORD"},
+	{0x80090034, "NTE_ENCRYPTION_FAILURE"},
+	{0x80090035, "NTE_DEVICE_NOT_FOUND"},
+	{0x80090036, "NTE_USER_CANCELLED"},
+	{0x80090037, "NTE_PASSWORD_CHANGE_REQUIRED"},
+	{0x80090038, "NTE_NOT_ACTIVE_CONSOLE"},
+	{0x80090300, "SEC_E_INSUFFICIENT_MEMORY"},
+	{0x80090301, "SEC_E_INVALID_HANDLE"},
+	{0x80090302, "SEC_E_UNSUPPORTED_FUNCTION"},
+	{0x80090302, "SEC_E_NOT_SUPPORTED"},
+	{0x80090303, "SEC_E_TARGET_UNKNOWN"},
+	{0x80090304, "SEC_E_INTERNAL_ERROR"},
+	{0x80090304, "SEC_E_NO_SPM"},
+	{0x80090305, "SEC_E_SECPKG_NOT_FOUND"},
+	{0x80090306, "SEC_E_NOT_OWNER"},
+	{0x80090307, "SEC_E_CANNOT_INSTALL"},
+	{0x80090308, "SEC_E_INVALID_TOKEN"},
+	{0x80090309, "SEC_E_CANNOT_PACK"},
+	{0x8009030A, "SEC_E_QOP_NOT_SUPPORTED"},
+	{0x8009030B, "SEC_E_NO_IMPERSONATION"},
+	{0x8009030C, "SEC_E_LOGON_DENIED"},
+	{0x8009030D, "SEC_E_UNKNOWN_CREDENTIALS"},
+	{0x8009030E, "SEC_E_NO_CREDENTIALS"},
+	{0x8009030F, "SEC_E_MESSAGE_ALTERED"},
+	{0x80090310, "SEC_E_OUT_OF_SEQUENCE"},
+	{0x80090311, "SEC_E_NO_AUTHENTICATING_AUTHORITY"},
+	{0x80090316, "SEC_E_BAD_PKGID"},
+	{0x80090317, "SEC_E_CONTEXT_EXPIRED"},
+	{0x80090318, "SEC_E_INCOMPLETE_MESSAGE"},
+	{0x80090320, "SEC_E_INCOMPLETE_CREDENTIALS"},
+	{0x80090321, "SEC_E_BUFFER_TOO_SMALL"},
+	{0x80090322, "SEC_E_WRONG_PRINCIPAL"},
+	{0x80090324, "SEC_E_TIME_SKEW"},
+	{0x80090325, "SEC_E_UNTRUSTED_ROOT"},
+	{0x80090326, "SEC_E_ILLEGAL_MESSAGE"},
+	{0x80090327, "SEC_E_CERT_UNKNOWN"},
+	{0x80090328, "SEC_E_CERT_EXPIRED"},
+	{0x80090329, "SEC_E_ENCRYPT_FAILURE"},
+	{0x80090330, "SEC_E_DECRYPT_FAILURE"},
+	{0x80090331, "SEC_E_ALGORITHM_MISMATCH"},
+	{0x80090332, "SEC_E_SECURITY_QOS_FAILED"},
+	{0x80090333, "SEC_E_UNFINISHED_CONTEXT_DELETED"},
+	{0x80090334, "SEC_E_NO_TGT_REPLY"},
+	{0x80090335, "SEC_E_NO_IP_ADDRESSES"},
+	{0x80090336, "SEC_E_WRONG_CREDENTIAL_HANDLE"},
+	{0x80090337, "SEC_E_CRYPTO_SYSTEM_INVALID"},
+	{0x80090338, "SEC_E_MAX_REFERRALS_EXCEEDED"},
+	{0x80090339, "SEC_E_MUST_BE_KDC"},
+	{0x8009033A, "SEC_E_STRONG_CRYPTO_NOT_SUPPORTED"},
+	{0x8009033B, "SEC_E_TOO_MANY_PRINCIPALS"},
+	{0x8009033C, "SEC_E_NO_PA_DATA"},
+	{0x8009033D, "SEC_E_PKINIT_NAME_MISMATCH"},
+	{0x8009033E, "SEC_E_SMARTCARD_LOGON_REQUIRED"},
+	{0x8009033F, "SEC_E_SHUTDOWN_IN_PROGRESS"},
+	{0x80090340, "SEC_E_KDC_INVALID_REQUEST"},
+	{0x80090341, "SEC_E_KDC_UNABLE_TO_REFER"},
+	{0x80090342, "SEC_E_KDC_UNKNOWN_ETYPE"},
+	{0x80090343, "SEC_E_UNSUPPORTED_PREAUTH"},
+	{0x80090345, "SEC_E_DELEGATION_REQUIRED"},
+	{0x80090346, "SEC_E_BAD_BINDINGS"},
+	{0x80090347, "SEC_E_MULTIPLE_ACCOUNTS"},
+	{0x80090348, "SEC_E_NO_KERB_KEY"},
+	{0x80090349, "SEC_E_CERT_WRONG_USAGE"},
+	{0x80090350, "SEC_E_DOWNGRADE_DETECTED"},
+	{0x80090351, "SEC_E_SMARTCARD_CERT_REVOKED"},
+	{0x80090352, "SEC_E_ISSUING_CA_UNTRUSTED"},
+	{0x80090353, "SEC_E_REVOCATION_OFFLINE_C"},
+	{0x80090354, "SEC_E_PKINIT_CLIENT_FAILURE"},
+	{0x80090355, "SEC_E_SMARTCARD_CERT_EXPIRED"},
+	{0x80090356, "SEC_E_NO_S4U_PROT_SUPPORT"},
+	{0x80090357, "SEC_E_CROSSREALM_DELEGATION_FAILURE"},
+	{0x80090358, "SEC_E_REVOCATION_OFFLINE_KDC"},
+	{0x80090359, "SEC_E_ISSUING_CA_UNTRUSTED_KDC"},
+	{0x8009035A, "SEC_E_KDC_CERT_EXPIRED"},
+	{0x8009035B, "SEC_E_KDC_CERT_REVOKED"},
+	{0x8009035D, "SEC_E_INVALID_PARAMETER"},
+	{0x8009035E, "SEC_E_DELEGATION_POLICY"},
+	{0x8009035F, "SEC_E_POLICY_NLTM_ONLY"},
+	{0x80090361, "SEC_E_NO_CONTEXT"},
+	{0x80090362, "SEC_E_PKU2U_CERT_FAILURE"},
+	{0x80090363, "SEC_E_MUTUAL_AUTH_FAILED"},
+	{0x80090365, "SEC_E_ONLY_HTTPS_ALLOWED"},
+	{0x80090367, "SEC_E_APPLICATION_PROTOCOL_MISMATCH"},
+	{0x80090369, "SEC_E_INVALID_UPN_NAME"},
+	{0x8009036A, "SEC_E_EXT_BUFFER_TOO_SMALL"},
+	{0x8009036B, "SEC_E_INSUFFICIENT_BUFFERS"},
+	{0x80091001, "CRYPT_E_MSG_ERROR"},
+	{0x80091002, "CRYPT_E_UNKNOWN_ALGO"},
+	{0x80091003, "CRYPT_E_OID_FORMAT"},
+	{0x80091004, "CRYPT_E_INVALID_MSG_TYPE"},
+	{0x80091005, "CRYPT_E_UNEXPECTED_ENCODING"},
+	{0x80091006, "CRYPT_E_AUTH_ATTR_MISSING"},
+	{0x80091007, "CRYPT_E_HASH_VALUE"},
+	{0x80091008, "CRYPT_E_INVALID_INDEX"},
+	{0x80091009, "CRYPT_E_ALREADY_DECRYPTED"},
+	{0x8009100A, "CRYPT_E_NOT_DECRYPTED"},
+	{0x8009100B, "CRYPT_E_RECIPIENT_NOT_FOUND"},
+	{0x8009100C, "CRYPT_E_CONTROL_TYPE"},
+	{0x8009100D, "CRYPT_E_ISSUER_SERIALNUMBER"},
+	{0x8009100E, "CRYPT_E_SIGNER_NOT_FOUND"},
+	{0x8009100F, "CRYPT_E_ATTRIBUTES_MISSING"},
+	{0x80091010, "CRYPT_E_STREAM_MSG_NOT_READY"},
+	{0x80091011, "CRYPT_E_STREAM_INSUFFICIENT_DATA"},
+	{0x80092001, "CRYPT_E_BAD_LEN"},
+	{0x80092002, "CRYPT_E_BAD_ENCODE"},
+	{0x80092003, "CRYPT_E_FILE_ERROR"},
+	{0x80092004, "CRYPT_E_NOT_FOUND"},
+	{0x80092005, "CRYPT_E_EXISTS"},
+	{0x80092006, "CRYPT_E_NO_PROVIDER"},
+	{0x80092007, "CRYPT_E_SELF_SIGNED"},
+	{0x80092008, "CRYPT_E_DELETED_PREV"},
+	{0x80092009, "CRYPT_E_NO_MATCH"},
+	{0x8009200A, "CRYPT_E_UNEXPECTED_MSG_TYPE"},
+	{0x8009200B, "CRYPT_E_NO_KEY_PROPERTY"},
+	{0x8009200C, "CRYPT_E_NO_DECRYPT_CERT"},
+	{0x8009200D, "CRYPT_E_BAD_MSG"},
+	{0x8009200E, "CRYPT_E_NO_SIGNER"},
+	{0x8009200F, "CRYPT_E_PENDING_CLOSE"},
+	{0x80092010, "CRYPT_E_REVOKED"},
+	{0x80092011, "CRYPT_E_NO_REVOCATION_DLL"},
+	{0x80092012, "CRYPT_E_NO_REVOCATION_CHECK"},
+	{0x80092013, "CRYPT_E_REVOCATION_OFFLINE"},
+	{0x80092014, "CRYPT_E_NOT_IN_REVOCATION_DATABASE"},
+	{0x80092020, "CRYPT_E_INVALID_NUMERIC_STRING"},
+	{0x80092021, "CRYPT_E_INVALID_PRINTABLE_STRING"},
+	{0x80092022, "CRYPT_E_INVALID_IA5_STRING"},
+	{0x80092023, "CRYPT_E_INVALID_X500_STRING"},
+	{0x80092024, "CRYPT_E_NOT_CHAR_STRING"},
+	{0x80092025, "CRYPT_E_FILERESIZED"},
+	{0x80092026, "CRYPT_E_SECURITY_SETTINGS"},
+	{0x80092027, "CRYPT_E_NO_VERIFY_USAGE_DLL"},
+	{0x80092028, "CRYPT_E_NO_VERIFY_USAGE_CHECK"},
+	{0x80092029, "CRYPT_E_VERIFY_USAGE_OFFLINE"},
+	{0x8009202A, "CRYPT_E_NOT_IN_CTL"},
+	{0x8009202B, "CRYPT_E_NO_TRUSTED_SIGNER"},
+	{0x8009202C, "CRYPT_E_MISSING_PUBKEY_PARA"},
+	{0x8009202D, "CRYPT_E_OBJECT_LOCATOR_OBJECT_NOT_FOUND"},
+	{0x80093000, "CRYPT_E_OSS_ERROR"},
+	{0x80093001, "OSS_MORE_BUF"},
+	{0x80093002, "OSS_NEGATIVE_UINTEGER"},
+	{0x80093003, "OSS_PDU_RANGE"},
+	{0x80093004, "OSS_MORE_INPUT"},
+	{0x80093005, "OSS_DATA_ERROR"},
+	{0x80093006, "OSS_BAD_ARG"},
+	{0x80093007, "OSS_BAD_VERSION"},
+	{0x80093008, "OSS_OUT_MEMORY"},
+	{0x80093009, "OSS_PDU_MISMATCH"},
+	{0x8009300A, "OSS_LIMITED"},
+	{0x8009300B, "OSS_BAD_PTR"},
+	{0x8009300C, "OSS_BAD_TIME"},
+	{0x8009300D, "OSS_INDEFINITE_NOT_SUPPORTED"},
+	{0x8009300E, "OSS_MEM_ERROR"},
+	{0x8009300F, "OSS_BAD_TABLE"},
+	{0x80093010, "OSS_TOO_LONG"},
+	{0x80093011, "OSS_CONSTRAINT_VIOLATED"},
+	{0x80093012, "OSS_FATAL_ERROR"},
+	{0x80093013, "OSS_ACCESS_SERIALIZATION_ERROR"},
+	{0x80093014, "OSS_NULL_TBL"},
+	{0x80093015, "OSS_NULL_FCN"},
+	{0x80093016, "OSS_BAD_ENCRULES"},
+	{0x80093017, "OSS_UNAVAIL_ENCRULES"},
+	{0x80093018, "OSS_CANT_OPEN_TRACE_WINDOW"},
+	{0x80093019, "OSS_UNIMPLEMENTED"},
+	{0x8009301A, "OSS_OID_DLL_NOT_LINKED"},
+	{0x8009301B, "OSS_CANT_OPEN_TRACE_FILE"},
+	{0x8009301C, "OSS_TRACE_FILE_ALREADY_OPEN"},
+	{0x8009301D, "OSS_TABLE_MISMATCH"},
+	{0x8009301E, "OSS_TYPE_NOT_SUPPORTED"},
+	{0x8009301F, "OSS_REAL_DLL_NOT_LINKED"},
+	{0x80093020, "OSS_REAL_CODE_NOT_LINKED"},
+	{0x80093021, "OSS_OUT_OF_RANGE"},
+	{0x80093022, "OSS_COPIER_DLL_NOT_LINKED"},
+	{0x80093023, "OSS_CONSTRAINT_DLL_NOT_LINKED"},
+	{0x80093024, "OSS_COMPARATOR_DLL_NOT_LINKED"},
+	{0x80093025, "OSS_COMPARATOR_CODE_NOT_LINKED"},
+	{0x80093026, "OSS_MEM_MGR_DLL_NOT_LINKED"},
+	{0x80093027, "OSS_PDV_DLL_NOT_LINKED"},
+	{0x80093028, "OSS_PDV_CODE_NOT_LINKED"},
+	{0x80093029, "OSS_API_DLL_NOT_LINKED"},
+	{0x8009302A, "OSS_BERDER_DLL_NOT_LINKED"},
+	{0x8009302B, "OSS_PER_DLL_NOT_LINKED"},
+	{0x8009302C, "OSS_OPEN_TYPE_ERROR"},
+	{0x8009302D, "OSS_MUTEX_NOT_CREATED"},
+	{0x8009302E, "OSS_CANT_CLOSE_TRACE_FILE"},
+	{0x80093100, "CRYPT_E_ASN1_ERROR"},
+	{0x80093101, "CRYPT_E_ASN1_INTERNAL"},
+	{0x80093102, "CRYPT_E_ASN1_EOD"},
+	{0x80093103, "CRYPT_E_ASN1_CORRUPT"},
+	{0x80093104, "CRYPT_E_ASN1_LARGE"},
+	{0x80093105, "CRYPT_E_ASN1_CONSTRAINT"},
+	{0x80093106, "CRYPT_E_ASN1_MEMORY"},
+	{0x80093107, "CRYPT_E_ASN1_OVERFLOW"},
+	{0x80093108, "CRYPT_E_ASN1_BADPDU"},
+	{0x80093109, "CRYPT_E_ASN1_BADARGS"},
+	{0x8009310A, "CRYPT_E_ASN1_BADREAL"},
+	{0x8009310B, "CRYPT_E_ASN1_BADTAG"},
+	{0x8009310C, "CRYPT_E_ASN1_CHOICE"},
+	{0x8009310D, "CRYPT_E_ASN1_RULE"},
+	{0x8009310E, "CRYPT_E_ASN1_UTF8"},
+	{0x80093133, "CRYPT_E_ASN1_PDU_TYPE"},
+	{0x80093134, "CRYPT_E_ASN1_NYI"},
+	{0x80093201, "CRYPT_E_ASN1_EXTENDED"},
+	{0x80093202, "CRYPT_E_ASN1_NOEOD"},
+	{0x80094001, "CERTSRV_E_BAD_REQUESTSUBJECT"},
+	{0x80094002, "CERTSRV_E_NO_REQUEST"},
+	{0x80094003, "CERTSRV_E_BAD_REQUESTSTATUS"},
+	{0x80094004, "CERTSRV_E_PROPERTY_EMPTY"},
+	{0x80094005, "CERTSRV_E_INVALID_CA_CERTIFICATE"},
+	{0x80094006, "CERTSRV_E_SERVER_SUSPENDED"},
+	{0x80094007, "CERTSRV_E_ENCODING_LENGTH"},
+	{0x80094008, "CERTSRV_E_ROLECONFLICT"},
+	{0x80094009, "CERTSRV_E_RESTRICTEDOFFICER"},
+	{0x8009400A, "CERTSRV_E_KEY_ARCHIVAL_NOT_CONFIGURED"},
+	{0x8009400B, "CERTSRV_E_NO_VALID_KRA"},
+	{0x8009400C, "CERTSRV_E_BAD_REQUEST_KEY_ARCHIVAL"},
+	{0x8009400D, "CERTSRV_E_NO_CAADMIN_DEFINED"},
+	{0x8009400E, "CERTSRV_E_BAD_RENEWAL_CERT_ATTRIBUTE"},
+	{0x8009400F, "CERTSRV_E_NO_DB_SESSIONS"},
+	{0x80094010, "CERTSRV_E_ALIGNMENT_FAULT"},
+	{0x80094011, "CERTSRV_E_ENROLL_DENIED"},
+	{0x80094012, "CERTSRV_E_TEMPLATE_DENIED"},
+	{0x80094013, "CERTSRV_E_DOWNLEVEL_DC_SSL_OR_UPGRADE"},
+	{0x80094014, "CERTSRV_E_ADMIN_DENIED_REQUEST"},
+	{0x80094015, "CERTSRV_E_NO_POLICY_SERVER"},
+	{0x80094016, "CERTSRV_E_WEAK_SIGNATURE_OR_KEY"},
+	{0x80094017, "CERTSRV_E_KEY_ATTESTATION_NOT_SUPPORTED"},
+	{0x80094018, "CERTSRV_E_ENCRYPTION_CERT_REQUIRED"},
+	{0x80094800, "CERTSRV_E_UNSUPPORTED_CERT_TYPE"},
+	{0x80094801, "CERTSRV_E_NO_CERT_TYPE"},
+	{0x80094802, "CERTSRV_E_TEMPLATE_CONFLICT"},
+	{0x80094803, "CERTSRV_E_SUBJECT_ALT_NAME_REQUIRED"},
+	{0x80094804, "CERTSRV_E_ARCHIVED_KEY_REQUIRED"},
+	{0x80094805, "CERTSRV_E_SMIME_REQUIRED"},
+	{0x80094806, "CERTSRV_E_BAD_RENEWAL_SUBJECT"},
+	{0x80094807, "CERTSRV_E_BAD_TEMPLATE_VERSION"},
+	{0x80094808, "CERTSRV_E_TEMPLATE_POLICY_REQUIRED"},
+	{0x80094809, "CERTSRV_E_SIGNATURE_POLICY_REQUIRED"},
+	{0x8009480A, "CERTSRV_E_SIGNATURE_COUNT"},
+	{0x8009480B, "CERTSRV_E_SIGNATURE_REJECTED"},
+	{0x8009480C, "CERTSRV_E_ISSUANCE_POLICY_REQUIRED"},
+	{0x8009480D, "CERTSRV_E_SUBJECT_UPN_REQUIRED"},
+	{0x8009480E, "CERTSRV_E_SUBJECT_DIRECTORY_GUID_REQUIRED"},
+	{0x8009480F, "CERTSRV_E_SUBJECT_DNS_REQUIRED"},
+	{0x80094810, "CERTSRV_E_ARCHIVED_KEY_UNEXPECTED"},
+	{0x80094811, "CERTSRV_E_KEY_LENGTH"},
+	{0x80094812, "CERTSRV_E_SUBJECT_EMAIL_REQUIRED"},
+	{0x80094813, "CERTSRV_E_UNKNOWN_CERT_TYPE"},
+	{0x80094814, "CERTSRV_E_CERT_TYPE_OVERLAP"},
+	{0x80094815, "CERTSRV_E_TOO_MANY_SIGNATURES"},
+	{0x80094816, "CERTSRV_E_RENEWAL_BAD_PUBLIC_KEY"},
+	{0x80094817, "CERTSRV_E_INVALID_EK"},
+	{0x80094818, "CERTSRV_E_INVALID_IDBINDING"},
+	{0x80094819, "CERTSRV_E_INVALID_ATTESTATION"},
+	{0x8009481A, "CERTSRV_E_KEY_ATTESTATION"},
+	{0x8009481B, "CERTSRV_E_CORRUPT_KEY_ATTESTATION"},
+	{0x8009481C, "CERTSRV_E_EXPIRED_CHALLENGE"},
+	{0x8009481D, "CERTSRV_E_INVALID_RESPONSE"},
+	{0x8009481E, "CERTSRV_E_INVALID_REQUESTID"},
+	{0x8009481F, "CERTSRV_E_REQUEST_PRECERTIFICATE_MISMATCH"},
+	{0x80094820, "CERTSRV_E_PENDING_CLIENT_RESPONSE"},
+	{0x80095000, "XENROLL_E_KEY_NOT_EXPORTABLE"},
+	{0x80095001, "XENROLL_E_CANNOT_ADD_ROOT_CERT"},
+	{0x80095002, "XENROLL_E_RESPONSE_KA_HASH_NOT_FOUND"},
+	{0x80095003, "XENROLL_E_RESPONSE_UNEXPECTED_KA_HASH"},
+	{0x80095004, "XENROLL_E_RESPONSE_KA_HASH_MISMATCH"},
+	{0x80095005, "XENROLL_E_KEYSPEC_SMIME_MISMATCH"},
+	{0x80096001, "TRUST_E_SYSTEM_ERROR"},
+	{0x80096002, "TRUST_E_NO_SIGNER_CERT"},
+	{0x80096003, "TRUST_E_COUNTER_SIGNER"},
+	{0x80096004, "TRUST_E_CERT_SIGNATURE"},
+	{0x80096005, "TRUST_E_TIME_STAMP"},
+	{0x80096010, "TRUST_E_BAD_DIGEST"},
+	{0x80096011, "TRUST_E_MALFORMED_SIGNATURE"},
+	{0x80096019, "TRUST_E_BASIC_CONSTRAINTS"},
+	{0x8009601E, "TRUST_E_FINANCIAL_CRITERIA"},
+	{0x80097001, "MSSIPOTF_E_OUTOFMEMRANGE"},
+	{0x80097002, "MSSIPOTF_E_CANTGETOBJECT"},
+	{0x80097003, "MSSIPOTF_E_NOHEADTABLE"},
+	{0x80097004, "MSSIPOTF_E_BAD_MAGICNUMBER"},
+	{0x80097005, "MSSIPOTF_E_BAD_OFFSET_TABLE"},
+	{0x80097006, "MSSIPOTF_E_TABLE_TAGORDER"},
+	{0x80097007, "MSSIPOTF_E_TABLE_LONGWORD"},
+	{0x80097008, "MSSIPOTF_E_BAD_FIRST_TABLE_PLACEMENT"},
+	{0x80097009, "MSSIPOTF_E_TABLES_OVERLAP"},
+	{0x8009700A, "MSSIPOTF_E_TABLE_PADBYTES"},
+	{0x8009700B, "MSSIPOTF_E_FILETOOSMALL"},
+	{0x8009700C, "MSSIPOTF_E_TABLE_CHECKSUM"},
+	{0x8009700D, "MSSIPOTF_E_FILE_CHECKSUM"},
+	{0x80097010, "MSSIPOTF_E_FAILED_POLICY"},
+	{0x80097011, "MSSIPOTF_E_FAILED_HINTS_CHECK"},
+	{0x80097012, "MSSIPOTF_E_NOT_OPENTYPE"},
+	{0x80097013, "MSSIPOTF_E_FILE"},
+	{0x80097014, "MSSIPOTF_E_CRYPT"},
+	{0x80097015, "MSSIPOTF_E_BADVERSION"},
+	{0x80097016, "MSSIPOTF_E_DSIG_STRUCTURE"},
+	{0x80097017, "MSSIPOTF_E_PCONST_CHECK"},
+	{0x80097018, "MSSIPOTF_E_STRUCTURE"},
+	{0x80097019, "ERROR_CRED_REQUIRES_CONFIRMATION"},
+	{0x800B0001, "TRUST_E_PROVIDER_UNKNOWN"},
+	{0x800B0002, "TRUST_E_ACTION_UNKNOWN"},
+	{0x800B0003, "TRUST_E_SUBJECT_FORM_UNKNOWN"},
+	{0x800B0004, "TRUST_E_SUBJECT_NOT_TRUSTED"},
+	{0x800B0005, "DIGSIG_E_ENCODE"},
+	{0x800B0006, "DIGSIG_E_DECODE"},
+	{0x800B0007, "DIGSIG_E_EXTENSIBILITY"},
+	{0x800B0008, "DIGSIG_E_CRYPTO"},
+	{0x800B0009, "PERSIST_E_SIZEDEFINITE"},
+	{0x800B000A, "PERSIST_E_SIZEINDEFINITE"},
+	{0x800B000B, "PERSIST_E_NOTSELFSIZING"},
+	{0x800B0100, "TRUST_E_NOSIGNATURE"},
+	{0x800B0101, "CERT_E_EXPIRED"},
+	{0x800B0102, "CERT_E_VALIDITYPERIODNESTING"},
+	{0x800B0103, "CERT_E_ROLE"},
+	{0x800B0104, "CERT_E_PATHLENCONST"},
+	{0x800B0105, "CERT_E_CRITICAL"},
+	{0x800B0106, "CERT_E_PURPOSE"},
+	{0x800B0107, "CERT_E_ISSUERCHAINING"},
+	{0x800B0108, "CERT_E_MALFORMED"},
+	{0x800B0109, "CERT_E_UNTRUSTEDROOT"},
+	{0x800B010A, "CERT_E_CHAINING"},
+	{0x800B010B, "TRUST_E_FAIL"},
+	{0x800B010C, "CERT_E_REVOKED"},
+	{0x800B010D, "CERT_E_UNTRUSTEDTESTROOT"},
+	{0x800B010E, "CERT_E_REVOCATION_FAILURE"},
+	{0x800B010F, "CERT_E_CN_NO_MATCH"},
+	{0x800B0110, "CERT_E_WRONG_USAGE"},
+	{0x800B0111, "TRUST_E_EXPLICIT_DISTRUST"},
+	{0x800B0112, "CERT_E_UNTRUSTEDCA"},
+	{0x800B0113, "CERT_E_INVALID_POLICY"},
+	{0x800B0114, "CERT_E_INVALID_NAME"},
+	{0x800C0002, "INET_E_INVALID_URL"},
+	{0x800C0003, "INET_E_NO_SESSION"},
+	{0x800C0004, "INET_E_CANNOT_CONNECT"},
+	{0x800C0005, "INET_E_RESOURCE_NOT_FOUND"},
+	{0x800C0006, "INET_E_OBJECT_NOT_FOUND"},
+	{0x800C0007, "INET_E_DATA_NOT_AVAILABLE"},
+	{0x800C0008, "INET_E_DOWNLOAD_FAILURE"},
+	{0x800C0009, "INET_E_AUTHENTICATION_REQUIRED"},
+	{0x800C000A, "INET_E_NO_VALID_MEDIA"},
+	{0x800C000B, "INET_E_CONNECTION_TIMEOUT"},
+	{0x800C000C, "INET_E_INVALID_REQUEST"},
+	{0x800C000D, "INET_E_UNKNOWN_PROTOCOL"},
+	{0x800C000E, "INET_E_SECURITY_PROBLEM"},
+	{0x800C000F, "INET_E_CANNOT_LOAD_DATA"},
+	{0x800C0010, "INET_E_CANNOT_INSTANTIATE_OBJECT"},
+	{0x800C0014, "INET_E_REDIRECT_FAILED"},
+	{0x800C0015, "INET_E_REDIRECT_TO_DIR"},
+	{0x800C0019, "INET_E_INVALID_CERTIFICATE"},
+	{0x800F0000, "SPAPI_E_EXPECTED_SECTION_NAME"},
+	{0x800F0001, "SPAPI_E_BAD_SECTION_NAME_LINE"},
+	{0x800F0002, "SPAPI_E_SECTION_NAME_TOO_LONG"},
+	{0x800F0003, "SPAPI_E_GENERAL_SYNTAX"},
+	{0x800F0100, "SPAPI_E_WRONG_INF_STYLE"},
+	{0x800F0101, "SPAPI_E_SECTION_NOT_FOUND"},
+	{0x800F0102, "SPAPI_E_LINE_NOT_FOUND"},
+	{0x800F0103, "SPAPI_E_NO_BACKUP"},
+	{0x800F0200, "SPAPI_E_NO_ASSOCIATED_CLASS"},
+	{0x800F0201, "SPAPI_E_CLASS_MISMATCH"},
+	{0x800F0202, "SPAPI_E_DUPLICATE_FOUND"},
+	{0x800F0203, "SPAPI_E_NO_DRIVER_SELECTED"},
+	{0x800F0204, "SPAPI_E_KEY_DOES_NOT_EXIST"},
+	{0x800F0205, "SPAPI_E_INVALID_DEVINST_NAME"},
+	{0x800F0206, "SPAPI_E_INVALID_CLASS"},
+	{0x800F0207, "SPAPI_E_DEVINST_ALREADY_EXISTS"},
+	{0x800F0208, "SPAPI_E_DEVINFO_NOT_REGISTERED"},
+	{0x800F0209, "SPAPI_E_INVALID_REG_PROPERTY"},
+	{0x800F020A, "SPAPI_E_NO_INF"},
+	{0x800F020B, "SPAPI_E_NO_SUCH_DEVINST"},
+	{0x800F020C, "SPAPI_E_CANT_LOAD_CLASS_ICON"},
+	{0x800F020D, "SPAPI_E_INVALID_CLASS_INSTALLER"},
+	{0x800F020E, "SPAPI_E_DI_DO_DEFAULT"},
+	{0x800F020F, "SPAPI_E_DI_NOFILECOPY"},
+	{0x800F0210, "SPAPI_E_INVALID_HWPROFILE"},
+	{0x800F0211, "SPAPI_E_NO_DEVICE_SELECTED"},
+	{0x800F0212, "SPAPI_E_DEVINFO_LIST_LOCKED"},
+	{0x800F0213, "SPAPI_E_DEVINFO_DATA_LOCKED"},
+	{0x800F0214, "SPAPI_E_DI_BAD_PATH"},
+	{0x800F0215, "SPAPI_E_NO_CLASSINSTALL_PARAMS"},
+	{0x800F0216, "SPAPI_E_FILEQUEUE_LOCKED"},
+	{0x800F0217, "SPAPI_E_BAD_SERVICE_INSTALLSECT"},
+	{0x800F0218, "SPAPI_E_NO_CLASS_DRIVER_LIST"},
+	{0x800F0219, "SPAPI_E_NO_ASSOCIATED_SERVICE"},
+	{0x800F021A, "SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE"},
+	{0x800F021B, "SPAPI_E_DEVICE_INTERFACE_ACTIVE"},
+	{0x800F021C, "SPAPI_E_DEVICE_INTERFACE_REMOVED"},
+	{0x800F021D, "SPAPI_E_BAD_INTERFACE_INSTALLSECT"},
+	{0x800F021E, "SPAPI_E_NO_SUCH_INTERFACE_CLASS"},
+	{0x800F021F, "SPAPI_E_INVALID_REFERENCE_STRING"},
+	{0x800F0220, "SPAPI_E_INVALID_MACHINENAME"},
+	{0x800F0221, "SPAPI_E_REMOTE_COMM_FAILURE"},
+	{0x800F0222, "SPAPI_E_MACHINE_UNAVAILABLE"},
+	{0x800F0223, "SPAPI_E_NO_CONFIGMGR_SERVICES"},
+	{0x800F0224, "SPAPI_E_INVALID_PROPPAGE_PROVIDER"},
+	{0x800F0225, "SPAPI_E_NO_SUCH_DEVICE_INTERFACE"},
+	{0x800F0226, "SPAPI_E_DI_POSTPROCESSING_REQUIRED"},
+	{0x800F0227, "SPAPI_E_INVALID_COINSTALLER"},
+	{0x800F0228, "SPAPI_E_NO_COMPAT_DRIVERS"},
+	{0x800F0229, "SPAPI_E_NO_DEVICE_ICON"},
+	{0x800F022A, "SPAPI_E_INVALID_INF_LOGCONFIG"},
+	{0x800F022B, "SPAPI_E_DI_DONT_INSTALL"},
+	{0x800F022C, "SPAPI_E_INVALID_FILTER_DRIVER"},
+	{0x800F022D, "SPAPI_E_NON_WINDOWS_NT_DRIVER"},
+	{0x800F022E, "SPAPI_E_NON_WINDOWS_DRIVER"},
+	{0x800F022F, "SPAPI_E_NO_CATALOG_FOR_OEM_INF"},
+	{0x800F0230, "SPAPI_E_DEVINSTALL_QUEUE_NONNATIVE"},
+	{0x800F0231, "SPAPI_E_NOT_DISABLEABLE"},
+	{0x800F0232, "SPAPI_E_CANT_REMOVE_DEVINST"},
+	{0x800F0233, "SPAPI_E_INVALID_TARGET"},
+	{0x800F0234, "SPAPI_E_DRIVER_NONNATIVE"},
+	{0x800F0235, "SPAPI_E_IN_WOW64"},
+	{0x800F0236, "SPAPI_E_SET_SYSTEM_RESTORE_POINT"},
+	{0x800F0237, "SPAPI_E_INCORRECTLY_COPIED_INF"},
+	{0x800F0238, "SPAPI_E_SCE_DISABLED"},
+	{0x800F0239, "SPAPI_E_UNKNOWN_EXCEPTION"},
+	{0x800F023A, "SPAPI_E_PNP_REGISTRY_ERROR"},
+	{0x800F023B, "SPAPI_E_REMOTE_REQUEST_UNSUPPORTED"},
+	{0x800F023C, "SPAPI_E_NOT_AN_INSTALLED_OEM_INF"},
+	{0x800F023D, "SPAPI_E_INF_IN_USE_BY_DEVICES"},
+	{0x800F023E, "SPAPI_E_DI_FUNCTION_OBSOLETE"},
+	{0x800F023F, "SPAPI_E_NO_AUTHENTICODE_CATALOG"},
+	{0x800F0240, "SPAPI_E_AUTHENTICODE_DISALLOWED"},
+	{0x800F0241, "SPAPI_E_AUTHENTICODE_TRUSTED_PUBLISHER"},
+	{0x800F0242, "SPAPI_E_AUTHENTICODE_TRUST_NOT_ESTABLISHED"},
+	{0x800F0243, "SPAPI_E_AUTHENTICODE_PUBLISHER_NOT_TRUSTED"},
+	{0x800F0244, "SPAPI_E_SIGNATURE_OSATTRIBUTE_MISMATCH"},
+	{0x800F0245, "SPAPI_E_ONLY_VALIDATE_VIA_AUTHENTICODE"},
+	{0x800F0246, "SPAPI_E_DEVICE_INSTALLER_NOT_READY"},
+	{0x800F0247, "SPAPI_E_DRIVER_STORE_ADD_FAILED"},
+	{0x800F0248, "SPAPI_E_DEVICE_INSTALL_BLOCKED"},
+	{0x800F0249, "SPAPI_E_DRIVER_INSTALL_BLOCKED"},
+	{0x800F024A, "SPAPI_E_WRONG_INF_TYPE"},
+	{0x800F024B, "SPAPI_E_FILE_HASH_NOT_IN_CATALOG"},
+	{0x800F024C, "SPAPI_E_DRIVER_STORE_DELETE_FAILED"},
+	{0x800F0300, "SPAPI_E_UNRECOVERABLE_STACK_OVERFLOW"},
+	{0x800F1000, "SPAPI_E_ERROR_NOT_INSTALLED"},
+	{0x80100001, "SCARD_F_INTERNAL_ERROR"},
+	{0x80100002, "SCARD_E_CANCELLED"},
+	{0x80100003, "SCARD_E_INVALID_HANDLE"},
+	{0x80100004, "SCARD_E_INVALID_PARAMETER"},
+	{0x80100005, "SCARD_E_INVALID_TARGET"},
+	{0x80100006, "SCARD_E_NO_MEMORY"},
+	{0x80100007, "SCARD_F_WAITED_TOO_LONG"},
+	{0x80100008, "SCARD_E_INSUFFICIENT_BUFFER"},
+	{0x80100009, "SCARD_E_UNKNOWN_READER"},
+	{0x8010000A, "SCARD_E_TIMEOUT"},
+	{0x8010000B, "SCARD_E_SHARING_VIOLATION"},
+	{0x8010000C, "SCARD_E_NO_SMARTCARD"},
+	{0x8010000D, "SCARD_E_UNKNOWN_CARD"},
+	{0x8010000E, "SCARD_E_CANT_DISPOSE"},
+	{0x8010000F, "SCARD_E_PROTO_MISMATCH"},
+	{0x80100010, "SCARD_E_NOT_READY"},
+	{0x80100011, "SCARD_E_INVALID_VALUE"},
+	{0x80100012, "SCARD_E_SYSTEM_CANCELLED"},
+	{0x80100013, "SCARD_F_COMM_ERROR"},
+	{0x80100014, "SCARD_F_UNKNOWN_ERROR"},
+	{0x80100015, "SCARD_E_INVALID_ATR"},
+	{0x80100016, "SCARD_E_NOT_TRANSACTED"},
+	{0x80100017, "SCARD_E_READER_UNAVAILABLE"},
+	{0x80100018, "SCARD_P_SHUTDOWN"},
+	{0x80100019, "SCARD_E_PCI_TOO_SMALL"},
+	{0x8010001A, "SCARD_E_READER_UNSUPPORTED"},
+	{0x8010001B, "SCARD_E_DUPLICATE_READER"},
+	{0x8010001C, "SCARD_E_CARD_UNSUPPORTED"},
+	{0x8010001D, "SCARD_E_NO_SERVICE"},
+	{0x8010001E, "SCARD_E_SERVICE_STOPPED"},
+	{0x8010001F, "SCARD_E_UNEXPECTED"},
+	{0x80100020, "SCARD_E_ICC_INSTALLATION"},
+	{0x80100021, "SCARD_E_ICC_CREATEORDER"},
+	{0x80100022, "SCARD_E_UNSUPPORTED_FEATURE"},
+	{0x80100023, "SCARD_E_DIR_NOT_FOUND"},
+	{0x80100024, "SCARD_E_FILE_NOT_FOUND"},
+	{0x80100025, "SCARD_E_NO_DIR"},
+	{0x80100026, "SCARD_E_NO_FILE"},
+	{0x80100027, "SCARD_E_NO_ACCESS"},
+	{0x80100028, "SCARD_E_WRITE_TOO_MANY"},
+	{0x80100029, "SCARD_E_BAD_SEEK"},
+	{0x8010002A, "SCARD_E_INVALID_CHV"},
+	{0x8010002B, "SCARD_E_UNKNOWN_RES_MNG"},
+	{0x8010002C, "SCARD_E_NO_SUCH_CERTIFICATE"},
+	{0x8010002D, "SCARD_E_CERTIFICATE_UNAVAILABLE"},
+	{0x8010002E, "SCARD_E_NO_READERS_AVAILABLE"},
+	{0x8010002F, "SCARD_E_COMM_DATA_LOST"},
+	{0x80100030, "SCARD_E_NO_KEY_CONTAINER"},
+	{0x80100031, "SCARD_E_SERVER_TOO_BUSY"},
+	{0x80100032, "SCARD_E_PIN_CACHE_EXPIRED"},
+	{0x80100033, "SCARD_E_NO_PIN_CACHE"},
+	{0x80100034, "SCARD_E_READ_ONLY_CARD"},
+	{0x80100065, "SCARD_W_UNSUPPORTED_CARD"},
+	{0x80100066, "SCARD_W_UNRESPONSIVE_CARD"},
+	{0x80100067, "SCARD_W_UNPOWERED_CARD"},
+	{0x80100068, "SCARD_W_RESET_CARD"},
+	{0x80100069, "SCARD_W_REMOVED_CARD"},
+	{0x8010006A, "SCARD_W_SECURITY_VIOLATION"},
+	{0x8010006B, "SCARD_W_WRONG_CHV"},
+	{0x8010006C, "SCARD_W_CHV_BLOCKED"},
+	{0x8010006D, "SCARD_W_EOF"},
+	{0x8010006E, "SCARD_W_CANCELLED_BY_USER"},
+	{0x8010006F, "SCARD_W_CARD_NOT_AUTHENTICATED"},
+	{0x80100070, "SCARD_W_CACHE_ITEM_NOT_FOUND"},
+	{0x80100071, "SCARD_W_CACHE_ITEM_STALE"},
+	{0x80100072, "SCARD_W_CACHE_ITEM_TOO_BIG"},
+	{0x80110401, "COMADMIN_E_OBJECTERRORS"},
+	{0x80110402, "COMADMIN_E_OBJECTINVALID"},
+	{0x80110403, "COMADMIN_E_KEYMISSING"},
+	{0x80110404, "COMADMIN_E_ALREADYINSTALLED"},
+	{0x80110407, "COMADMIN_E_APP_FILE_WRITEFAIL"},
+	{0x80110408, "COMADMIN_E_APP_FILE_READFAIL"},
+	{0x80110409, "COMADMIN_E_APP_FILE_VERSION"},
+	{0x8011040A, "COMADMIN_E_BADPATH"},
+	{0x8011040B, "COMADMIN_E_APPLICATIONEXISTS"},
+	{0x8011040C, "COMADMIN_E_ROLEEXISTS"},
+	{0x8011040D, "COMADMIN_E_CANTCOPYFILE"},
+	{0x8011040F, "COMADMIN_E_NOUSER"},
+	{0x80110410, "COMADMIN_E_INVALIDUSERIDS"},
+	{0x80110411, "COMADMIN_E_NOREGISTRYCLSID"},
+	{0x80110412, "COMADMIN_E_BADREGISTRYPROGID"},
+	{0x80110413, "COMADMIN_E_AUTHENTICATIONLEVEL"},
+	{0x80110414, "COMADMIN_E_USERPASSWDNOTVALID"},
+	{0x80110418, "COMADMIN_E_CLSIDORIIDMISMATCH"},
+	{0x80110419, "COMADMIN_E_REMOTEINTERFACE"},
+	{0x8011041A, "COMADMIN_E_DLLREGISTERSERVER"},
+	{0x8011041B, "COMADMIN_E_NOSERVERSHARE"},
+	{0x8011041D, "COMADMIN_E_DLLLOADFAILED"},
+	{0x8011041E, "COMADMIN_E_BADREGISTRYLIBID"},
+	{0x8011041F, "COMADMIN_E_APPDIRNOTFOUND"},
+	{0x80110423, "COMADMIN_E_REGISTRARFAILED"},
+	{0x80110424, "COMADMIN_E_COMPFILE_DOESNOTEXIST"},
+	{0x80110425, "COMADMIN_E_COMPFILE_LOADDLLFAIL"},
+	{0x80110426, "COMADMIN_E_COMPFILE_GETCLASSOBJ"},
+	{0x80110427, "COMADMIN_E_COMPFILE_CLASSNOTAVAIL"},
+	{0x80110428, "COMADMIN_E_COMPFILE_BADTLB"},
+	{0x80110429, "COMADMIN_E_COMPFILE_NOTINSTALLABLE"},
+	{0x8011042A, "COMADMIN_E_NOTCHANGEABLE"},
+	{0x8011042B, "COMADMIN_E_NOTDELETEABLE"},
+	{0x8011042C, "COMADMIN_E_SESSION"},
+	{0x8011042D, "COMADMIN_E_COMP_MOVE_LOCKED"},
+	{0x8011042E, "COMADMIN_E_COMP_MOVE_BAD_DEST"},
+	{0x80110430, "COMADMIN_E_REGISTERTLB"},
+	{0x80110433, "COMADMIN_E_SYSTEMAPP"},
+	{0x80110434, "COMADMIN_E_COMPFILE_NOREGISTRAR"},
+	{0x80110435, "COMADMIN_E_COREQCOMPINSTALLED"},
+	{0x80110436, "COMADMIN_E_SERVICENOTINSTALLED"},
+	{0x80110437, "COMADMIN_E_PROPERTYSAVEFAILED"},
+	{0x80110438, "COMADMIN_E_OBJECTEXISTS"},
+	{0x80110439, "COMADMIN_E_COMPONENTEXISTS"},
+	{0x8011043B, "COMADMIN_E_REGFILE_CORRUPT"},
+	{0x8011043C, "COMADMIN_E_PROPERTY_OVERFLOW"},
+	{0x8011043E, "COMADMIN_E_NOTINREGISTRY"},
+	{0x8011043F, "COMADMIN_E_OBJECTNOTPOOLABLE"},
+	{0x80110446, "COMADMIN_E_APPLID_MATCHES_CLSID"},
+	{0x80110447, "COMADMIN_E_ROLE_DOES_NOT_EXIST"},
+	{0x80110448, "COMADMIN_E_START_APP_NEEDS_COMPONENTS"},
+	{0x80110449, "COMADMIN_E_REQUIRES_DIFFERENT_PLATFORM"},
+	{0x8011044A, "COMADMIN_E_CAN_NOT_EXPORT_APP_PROXY"},
+	{0x8011044B, "COMADMIN_E_CAN_NOT_START_APP"},
+	{0x8011044C, "COMADMIN_E_CAN_NOT_EXPORT_SYS_APP"},
+	{0x8011044D, "COMADMIN_E_CANT_SUBSCRIBE_TO_COMPONENT"},
+	{0x8011044E, "COMADMIN_E_EVENTCLASS_CANT_BE_SUBSCRIBER"},
+	{0x8011044F, "COMADMIN_E_LIB_APP_PROXY_INCOMPATIBLE"},
+	{0x80110450, "COMADMIN_E_BASE_PARTITION_ONLY"},
+	{0x80110451, "COMADMIN_E_START_APP_DISABLED"},
+	{0x80110457, "COMADMIN_E_CAT_DUPLICATE_PARTITION_NAME"},
+	{0x80110458, "COMADMIN_E_CAT_INVALID_PARTITION_NAME"},
+	{0x80110459, "COMADMIN_E_CAT_PARTITION_IN_USE"},
+	{0x8011045A, "COMADMIN_E_FILE_PARTITION_DUPLICATE_FILES"},
+	{0x8011045B, "COMADMIN_E_CAT_IMPORTED_COMPONENTS_NOT_ALLOWED"},
+	{0x8011045C, "COMADMIN_E_AMBIGUOUS_APPLICATION_NAME"},
+	{0x8011045D, "COMADMIN_E_AMBIGUOUS_PARTITION_NAME"},
+	{0x80110472, "COMADMIN_E_REGDB_NOTINITIALIZED"},
+	{0x80110473, "COMADMIN_E_REGDB_NOTOPEN"},
+	{0x80110474, "COMADMIN_E_REGDB_SYSTEMERR"},
+	{0x80110475, "COMADMIN_E_REGDB_ALREADYRUNNING"},
+	{0x80110480, "COMADMIN_E_MIG_VERSIONNOTSUPPORTED"},
+	{0x80110481, "COMADMIN_E_MIG_SCHEMANOTFOUND"},
+	{0x80110482, "COMADMIN_E_CAT_BITNESSMISMATCH"},
+	{0x80110483, "COMADMIN_E_CAT_UNACCEPTABLEBITNESS"},
+	{0x80110484, "COMADMIN_E_CAT_WRONGAPPBITNESS"},
+	{0x80110485, "COMADMIN_E_CAT_PAUSE_RESUME_NOT_SUPPORTED"},
+	{0x80110486, "COMADMIN_E_CAT_SERVERFAULT"},
+	{0x80110600, "COMQC_E_APPLICATION_NOT_QUEUED"},
+	{0x80110601, "COMQC_E_NO_QUEUEABLE_INTERFACES"},
+	{0x80110602, "COMQC_E_QUEUING_SERVICE_NOT_AVAILABLE"},
+	{0x80110603, "COMQC_E_NO_IPERSISTSTREAM"},
+	{0x80110604, "COMQC_E_BAD_MESSAGE"},
+	{0x80110605, "COMQC_E_UNAUTHENTICATED"},
+	{0x80110606, "COMQC_E_UNTRUSTED_ENQUEUER"},
+	{0x80110701, "MSDTC_E_DUPLICATE_RESOURCE"},
+	{0x80110808, "COMADMIN_E_OBJECT_PARENT_MISSING"},
+	{0x80110809, "COMADMIN_E_OBJECT_DOES_NOT_EXIST"},
+	{0x8011080A, "COMADMIN_E_APP_NOT_RUNNING"},
+	{0x8011080B, "COMADMIN_E_INVALID_PARTITION"},
+	{0x8011080D, "COMADMIN_E_SVCAPP_NOT_POOLABLE_OR_RECYCLABLE"},
+	{0x8011080E, "COMADMIN_E_USER_IN_SET"},
+	{0x8011080F, "COMADMIN_E_CANTRECYCLELIBRARYAPPS"},
+	{0x80110811, "COMADMIN_E_CANTRECYCLESERVICEAPPS"},
+	{0x80110812, "COMADMIN_E_PROCESSALREADYRECYCLED"},
+	{0x80110813, "COMADMIN_E_PAUSEDPROCESSMAYNOTBERECYCLED"},
+	{0x80110814, "COMADMIN_E_CANTMAKEINPROCSERVICE"},
+	{0x80110815, "COMADMIN_E_PROGIDINUSEBYCLSID"},
+	{0x80110816, "COMADMIN_E_DEFAULT_PARTITION_NOT_IN_SET"},
+	{0x80110817, "COMADMIN_E_RECYCLEDPROCESSMAYNOTBEPAUSED"},
+	{0x80110818, "COMADMIN_E_PARTITION_ACCESSDENIED"},
+	{0x80110819, "COMADMIN_E_PARTITION_MSI_ONLY"},
+	{0x8011081A, "COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_1_0_FORMAT"},
+	{0x8011081B, "COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_NONBASE_PARTITIONS"},
+	{0x8011081C, "COMADMIN_E_COMP_MOVE_SOURCE"},
+	{0x8011081D, "COMADMIN_E_COMP_MOVE_DEST"},
+	{0x8011081E, "COMADMIN_E_COMP_MOVE_PRIVATE"},
+	{0x8011081F, "COMADMIN_E_BASEPARTITION_REQUIRED_IN_SET"},
+	{0x80110820, "COMADMIN_E_CANNOT_ALIAS_EVENTCLASS"},
+	{0x80110821, "COMADMIN_E_PRIVATE_ACCESSDENIED"},
+	{0x80110822, "COMADMIN_E_SAFERINVALID"},
+	{0x80110823, "COMADMIN_E_REGISTRY_ACCESSDENIED"},
+	{0x80110824, "COMADMIN_E_PARTITIONS_DISABLED"},
+	{0x80190001, "HTTP_E_STATUS_UNEXPECTED"},
+	{0x80190003, "HTTP_E_STATUS_UNEXPECTED_REDIRECTION"},
+	{0x80190004, "HTTP_E_STATUS_UNEXPECTED_CLIENT_ERROR"},
+	{0x80190005, "HTTP_E_STATUS_UNEXPECTED_SERVER_ERROR"},
+	{0x8019012C, "HTTP_E_STATUS_AMBIGUOUS"},
+	{0x8019012D, "HTTP_E_STATUS_MOVED"},
+	{0x8019012E, "HTTP_E_STATUS_REDIRECT"},
+	{0x8019012F, "HTTP_E_STATUS_REDIRECT_METHOD"},
+	{0x80190130, "HTTP_E_STATUS_NOT_MODIFIED"},
+	{0x80190131, "HTTP_E_STATUS_USE_PROXY"},
+	{0x80190133, "HTTP_E_STATUS_REDIRECT_KEEP_VERB"},
+	{0x80190190, "HTTP_E_STATUS_BAD_REQUEST"},
+	{0x80190191, "HTTP_E_STATUS_DENIED"},
+	{0x80190192, "HTTP_E_STATUS_PAYMENT_REQ"},
+	{0x80190193, "HTTP_E_STATUS_FORBIDDEN"},
+	{0x80190194, "HTTP_E_STATUS_NOT_FOUND"},
+	{0x80190195, "HTTP_E_STATUS_BAD_METHOD"},
+	{0x80190196, "HTTP_E_STATUS_NONE_ACCEPTABLE"},
+	{0x80190197, "HTTP_E_STATUS_PROXY_AUTH_REQ"},
+	{0x80190198, "HTTP_E_STATUS_REQUEST_TIMEOUT"},
+	{0x80190199, "HTTP_E_STATUS_CONFLICT"},
+	{0x8019019A, "HTTP_E_STATUS_GONE"},
+	{0x8019019B, "HTTP_E_STATUS_LENGTH_REQUIRED"},
+	{0x8019019C, "HTTP_E_STATUS_PRECOND_FAILED"},
+	{0x8019019D, "HTTP_E_STATUS_REQUEST_TOO_LARGE"},
+	{0x8019019E, "HTTP_E_STATUS_URI_TOO_LONG"},
+	{0x8019019F, "HTTP_E_STATUS_UNSUPPORTED_MEDIA"},
+	{0x801901A0, "HTTP_E_STATUS_RANGE_NOT_SATISFIABLE"},
+	{0x801901A1, "HTTP_E_STATUS_EXPECTATION_FAILED"},
+	{0x801901F4, "HTTP_E_STATUS_SERVER_ERROR"},
+	{0x801901F5, "HTTP_E_STATUS_NOT_SUPPORTED"},
+	{0x801901F6, "HTTP_E_STATUS_BAD_GATEWAY"},
+	{0x801901F7, "HTTP_E_STATUS_SERVICE_UNAVAIL"},
+	{0x801901F8, "HTTP_E_STATUS_GATEWAY_TIMEOUT"},
+	{0x801901F9, "HTTP_E_STATUS_VERSION_NOT_SUP"},
+	{0x801B8000, "WER_E_CRASH_FAILURE"},
+	{0x801B8001, "WER_E_CANCELED"},
+	{0x801B8002, "WER_E_NETWORK_FAILURE"},
+	{0x801B8003, "WER_E_NOT_INITIALIZED"},
+	{0x801B8004, "WER_E_ALREADY_REPORTING"},
+	{0x801B8005, "WER_E_DUMP_THROTTLED"},
+	{0x801B8006, "WER_E_INSUFFICIENT_CONSENT"},
+	{0x801B8007, "WER_E_TOO_HEAVY"},
+	{0x801F0001, "ERROR_FLT_NO_HANDLER_DEFINED"},
+	{0x801F0002, "ERROR_FLT_CONTEXT_ALREADY_DEFINED"},
+	{0x801F0003, "ERROR_FLT_INVALID_ASYNCHRONOUS_REQUEST"},
+	{0x801F0004, "ERROR_FLT_DISALLOW_FAST_IO"},
+	{0x801F0005, "ERROR_FLT_INVALID_NAME_REQUEST"},
+	{0x801F0006, "ERROR_FLT_NOT_SAFE_TO_POST_OPERATION"},
+	{0x801F0007, "ERROR_FLT_NOT_INITIALIZED"},
+	{0x801F0008, "ERROR_FLT_FILTER_NOT_READY"},
+	{0x801F0009, "ERROR_FLT_POST_OPERATION_CLEANUP"},
+	{0x801F000A, "ERROR_FLT_INTERNAL_ERROR"},
+	{0x801F000B, "ERROR_FLT_DELETING_OBJECT"},
+	{0x801F000C, "ERROR_FLT_MUST_BE_NONPAGED_POOL"},
+	{0x801F000D, "ERROR_FLT_DUPLICATE_ENTRY"},
+	{0x801F000E, "ERROR_FLT_CBDQ_DISABLED"},
+	{0x801F000F, "ERROR_FLT_DO_NOT_ATTACH"},
+	{0x801F0010, "ERROR_FLT_DO_NOT_DETACH"},
+	{0x801F0011, "ERROR_FLT_INSTANCE_ALTITUDE_COLLISION"},
+	{0x801F0012, "ERROR_FLT_INSTANCE_NAME_COLLISION"},
+	{0x801F0013, "ERROR_FLT_FILTER_NOT_FOUND"},
+	{0x801F0014, "ERROR_FLT_VOLUME_NOT_FOUND"},
+	{0x801F0015, "ERROR_FLT_INSTANCE_NOT_FOUND"},
+	{0x801F0016, "ERROR_FLT_CONTEXT_ALLOCATION_NOT_FOUND"},
+	{0x801F0017, "ERROR_FLT_INVALID_CONTEXT_REGISTRATION"},
+	{0x801F0018, "ERROR_FLT_NAME_CACHE_MISS"},
+	{0x801F0019, "ERROR_FLT_NO_DEVICE_OBJECT"},
+	{0x801F001A, "ERROR_FLT_VOLUME_ALREADY_MOUNTED"},
+	{0x801F001B, "ERROR_FLT_ALREADY_ENLISTED"},
+	{0x801F001C, "ERROR_FLT_CONTEXT_ALREADY_LINKED"},
+	{0x801F0020, "ERROR_FLT_NO_WAITER_FOR_REPLY"},
+	{0x801F0023, "ERROR_FLT_REGISTRATION_BUSY"},
+	{0x80260001, "ERROR_HUNG_DISPLAY_DRIVER_THREAD"},
+	{0x80263001, "DWM_E_COMPOSITIONDISABLED"},
+	{0x80263002, "DWM_E_REMOTING_NOT_SUPPORTED"},
+	{0x80263003, "DWM_E_NO_REDIRECTION_SURFACE_AVAILABLE"},
+	{0x80263004, "DWM_E_NOT_QUEUING_PRESENTS"},
+	{0x80263005, "DWM_E_ADAPTER_NOT_FOUND"},
+	{0x80263007, "DWM_E_TEXTURE_TOO_LARGE"},
+	{0x80270001, "NAP_E_INVALID_PACKET"},
+	{0x80270002, "NAP_E_MISSING_SOH"},
+	{0x80270003, "NAP_E_CONFLICTING_ID"},
+	{0x80270004, "NAP_E_NO_CACHED_SOH"},
+	{0x80270005, "NAP_E_STILL_BOUND"},
+	{0x80270006, "NAP_E_NOT_REGISTERED"},
+	{0x80270007, "NAP_E_NOT_INITIALIZED"},
+	{0x80270008, "NAP_E_MISMATCHED_ID"},
+	{0x80270009, "NAP_E_NOT_PENDING"},
+	{0x8027000A, "NAP_E_ID_NOT_FOUND"},
+	{0x8027000B, "NAP_E_MAXSIZE_TOO_SMALL"},
+	{0x8027000C, "NAP_E_SERVICE_NOT_RUNNING"},
+	{0x8027000E, "NAP_E_ENTITY_DISABLED"},
+	{0x8027000F, "NAP_E_NETSH_GROUPPOLICY_ERROR"},
+	{0x80270010, "NAP_E_TOO_MANY_CALLS"},
+	{0x80270011, "NAP_E_SHV_CONFIG_EXISTED"},
+	{0x80270012, "NAP_E_SHV_CONFIG_NOT_FOUND"},
+	{0x80270013, "NAP_E_SHV_TIMEOUT"},
+	{0x80270220, "FA_E_MAX_PERSISTED_ITEMS_REACHED"},
+	{0x80270222, "FA_E_HOMEGROUP_NOT_AVAILABLE"},
+	{0x80270250, "E_MONITOR_RESOLUTION_TOO_LOW"},
+	{0x80270251, "E_ELEVATED_ACTIVATION_NOT_SUPPORTED"},
+	{0x80270252, "E_UAC_DISABLED"},
+	{0x80270253, "E_FULL_ADMIN_NOT_SUPPORTED"},
+	{0x80270254, "E_APPLICATION_NOT_REGISTERED"},
+	{0x80270255, "E_MULTIPLE_EXTENSIONS_FOR_APPLICATION"},
+	{0x80270256, "E_MULTIPLE_PACKAGES_FOR_FAMILY"},
+	{0x80270257, "E_APPLICATION_MANAGER_NOT_RUNNING"},
+	{0x8027025A, "E_APPLICATION_ACTIVATION_TIMED_OUT"},
+	{0x8027025B, "E_APPLICATION_ACTIVATION_EXEC_FAILURE"},
+	{0x8027025C, "E_APPLICATION_TEMPORARY_LICENSE_ERROR"},
+	{0x8027025D, "E_APPLICATION_TRIAL_LICENSE_EXPIRED"},
+	{0x80270260, "E_SKYDRIVE_ROOT_TARGET_FILE_SYSTEM_NOT_SUPPORTED"},
+	{0x80270261, "E_SKYDRIVE_ROOT_TARGET_OVERLAP"},
+	{0x80270262, "E_SKYDRIVE_ROOT_TARGET_CANNOT_INDEX"},
+	{0x80270263, "E_SKYDRIVE_FILE_NOT_UPLOADED"},
+	{0x80270264, "E_SKYDRIVE_UPDATE_AVAILABILITY_FAIL"},
+	{0x80270265, "E_SKYDRIVE_ROOT_TARGET_VOLUME_ROOT_NOT_SUPPORTED"},
+	{0x80280000, "TPM_E_ERROR_MASK"},
+	{0x80280001, "TPM_E_AUTHFAIL"},
+	{0x80280002, "TPM_E_BADINDEX"},
+	{0x80280003, "TPM_E_BAD_PARAMETER"},
+	{0x80280004, "TPM_E_AUDITFAILURE"},
+	{0x80280005, "TPM_E_CLEAR_DISABLED"},
+	{0x80280006, "TPM_E_DEACTIVATED"},
+	{0x80280007, "TPM_E_DISABLED"},
+	{0x80280008, "TPM_E_DISABLED_CMD"},
+	{0x80280009, "TPM_E_FAIL"},
+	{0x8028000A, "TPM_E_BAD_ORDINAL"},
+	{0x8028000B, "TPM_E_INSTALL_DISABLED"},
+	{0x8028000C, "TPM_E_INVALID_KEYHANDLE"},
+	{0x8028000D, "TPM_E_KEYNOTFOUND"},
+	{0x8028000E, "TPM_E_INAPPROPRIATE_ENC"},
+	{0x8028000F, "TPM_E_MIGRATEFAIL"},
+	{0x80280010, "TPM_E_INVALID_PCR_INFO"},
+	{0x80280011, "TPM_E_NOSPACE"},
+	{0x80280012, "TPM_E_NOSRK"},
+	{0x80280013, "TPM_E_NOTSEALED_BLOB"},
+	{0x80280014, "TPM_E_OWNER_SET"},
+	{0x80280015, "TPM_E_RESOURCES"},
+	{0x80280016, "TPM_E_SHORTRANDOM"},
+	{0x80280017, "TPM_E_SIZE"},
+	{0x80280018, "TPM_E_WRONGPCRVAL"},
+	{0x80280019, "TPM_E_BAD_PARAM_SIZE"},
+	{0x8028001A, "TPM_E_SHA_THREAD"},
+	{0x8028001B, "TPM_E_SHA_ERROR"},
+	{0x8028001C, "TPM_E_FAILEDSELFTEST"},
+	{0x8028001D, "TPM_E_AUTH2FAIL"},
+	{0x8028001E, "TPM_E_BADTAG"},
+	{0x8028001F, "TPM_E_IOERROR"},
+	{0x80280020, "TPM_E_ENCRYPT_ERROR"},
+	{0x80280021, "TPM_E_DECRYPT_ERROR"},
+	{0x80280022, "TPM_E_INVALID_AUTHHANDLE"},
+	{0x80280023, "TPM_E_NO_ENDORSEMENT"},
+	{0x80280024, "TPM_E_INVALID_KEYUSAGE"},
+	{0x80280025, "TPM_E_WRONG_ENTITYTYPE"},
+	{0x80280026, "TPM_E_INVALID_POSTINIT"},
+	{0x80280027, "TPM_E_INAPPROPRIATE_SIG"},
+	{0x80280028, "TPM_E_BAD_KEY_PROPERTY"},
+	{0x80280029, "TPM_E_BAD_MIGRATION"},
+	{0x8028002A, "TPM_E_BAD_SCHEME"},
+	{0x8028002B, "TPM_E_BAD_DATASIZE"},
+	{0x8028002C, "TPM_E_BAD_MODE"},
+	{0x8028002D, "TPM_E_BAD_PRESENCE"},
+	{0x8028002E, "TPM_E_BAD_VERSION"},
+	{0x8028002F, "TPM_E_NO_WRAP_TRANSPORT"},
+	{0x80280030, "TPM_E_AUDITFAIL_UNSUCCESSFUL"},
+	{0x80280031, "TPM_E_AUDITFAIL_SUCCESSFUL"},
+	{0x80280032, "TPM_E_NOTRESETABLE"},
+	{0x80280033, "TPM_E_NOTLOCAL"},
+	{0x80280034, "TPM_E_BAD_TYPE"},
+	{0x80280035, "TPM_E_INVALID_RESOURCE"},
+	{0x80280036, "TPM_E_NOTFIPS"},
+	{0x80280037, "TPM_E_INVALID_FAMILY"},
+	{0x80280038, "TPM_E_NO_NV_PERMISSION"},
+	{0x80280039, "TPM_E_REQUIRES_SIGN"},
+	{0x8028003A, "TPM_E_KEY_NOTSUPPORTED"},
+	{0x8028003B, "TPM_E_AUTH_CONFLICT"},
+	{0x8028003C, "TPM_E_AREA_LOCKED"},
+	{0x8028003D, "TPM_E_BAD_LOCALITY"},
+	{0x8028003E, "TPM_E_READ_ONLY"},
+	{0x8028003F, "TPM_E_PER_NOWRITE"},
+	{0x80280040, "TPM_E_FAMILYCOUNT"},
+	{0x80280041, "TPM_E_WRITE_LOCKED"},
+	{0x80280042, "TPM_E_BAD_ATTRIBUTES"},
+	{0x80280043, "TPM_E_INVALID_STRUCTURE"},
+	{0x80280044, "TPM_E_KEY_OWNER_CONTROL"},
+	{0x80280045, "TPM_E_BAD_COUNTER"},
+	{0x80280046, "TPM_E_NOT_FULLWRITE"},
+	{0x80280047, "TPM_E_CONTEXT_GAP"},
+	{0x80280048, "TPM_E_MAXNVWRITES"},
+	{0x80280049, "TPM_E_NOOPERATOR"},
+	{0x8028004A, "TPM_E_RESOURCEMISSING"},
+	{0x8028004B, "TPM_E_DELEGATE_LOCK"},
+	{0x8028004C, "TPM_E_DELEGATE_FAMILY"},
+	{0x8028004D, "TPM_E_DELEGATE_ADMIN"},
+	{0x8028004E, "TPM_E_TRANSPORT_NOTEXCLUSIVE"},
+	{0x8028004F, "TPM_E_OWNER_CONTROL"},
+	{0x80280050, "TPM_E_DAA_RESOURCES"},
+	{0x80280051, "TPM_E_DAA_INPUT_DATA0"},
+	{0x80280052, "TPM_E_DAA_INPUT_DATA1"},
+	{0x80280053, "TPM_E_DAA_ISSUER_SETTINGS"},
+	{0x80280054, "TPM_E_DAA_TPM_SETTINGS"},
+	{0x80280055, "TPM_E_DAA_STAGE"},
+	{0x80280056, "TPM_E_DAA_ISSUER_VALIDITY"},
+	{0x80280057, "TPM_E_DAA_WRONG_W"},
+	{0x80280058, "TPM_E_BAD_HANDLE"},
+	{0x80280059, "TPM_E_BAD_DELEGATE"},
+	{0x8028005A, "TPM_E_BADCONTEXT"},
+	{0x8028005B, "TPM_E_TOOMANYCONTEXTS"},
+	{0x8028005C, "TPM_E_MA_TICKET_SIGNATURE"},
+	{0x8028005D, "TPM_E_MA_DESTINATION"},
+	{0x8028005E, "TPM_E_MA_SOURCE"},
+	{0x8028005F, "TPM_E_MA_AUTHORITY"},
+	{0x80280061, "TPM_E_PERMANENTEK"},
+	{0x80280062, "TPM_E_BAD_SIGNATURE"},
+	{0x80280063, "TPM_E_NOCONTEXTSPACE"},
+	{0x80280081, "TPM_20_E_ASYMMETRIC"},
+	{0x80280082, "TPM_20_E_ATTRIBUTES"},
+	{0x80280083, "TPM_20_E_HASH"},
+	{0x80280084, "TPM_20_E_VALUE"},
+	{0x80280085, "TPM_20_E_HIERARCHY"},
+	{0x80280087, "TPM_20_E_KEY_SIZE"},
+	{0x80280088, "TPM_20_E_MGF"},
+	{0x80280089, "TPM_20_E_MODE"},
+	{0x8028008A, "TPM_20_E_TYPE"},
+	{0x8028008B, "TPM_20_E_HANDLE"},
+	{0x8028008C, "TPM_20_E_KDF"},
+	{0x8028008D, "TPM_20_E_RANGE"},
+	{0x8028008E, "TPM_20_E_AUTH_FAIL"},
+	{0x8028008F, "TPM_20_E_NONCE"},
+	{0x80280090, "TPM_20_E_PP"},
+	{0x80280092, "TPM_20_E_SCHEME"},
+	{0x80280095, "TPM_20_E_SIZE"},
+	{0x80280096, "TPM_20_E_SYMMETRIC"},
+	{0x80280097, "TPM_20_E_TAG"},
+	{0x80280098, "TPM_20_E_SELECTOR"},
+	{0x8028009A, "TPM_20_E_INSUFFICIENT"},
+	{0x8028009B, "TPM_20_E_SIGNATURE"},
+	{0x8028009C, "TPM_20_E_KEY"},
+	{0x8028009D, "TPM_20_E_POLICY_FAIL"},
+	{0x8028009F, "TPM_20_E_INTEGRITY"},
+	{0x802800A0, "TPM_20_E_TICKET"},
+	{0x802800A1, "TPM_20_E_RESERVED_BITS"},
+	{0x802800A2, "TPM_20_E_BAD_AUTH"},
+	{0x802800A3, "TPM_20_E_EXPIRED"},
+	{0x802800A4, "TPM_20_E_POLICY_CC"},
+	{0x802800A5, "TPM_20_E_BINDING"},
+	{0x802800A6, "TPM_20_E_CURVE"},
+	{0x802800A7, "TPM_20_E_ECC_POINT"},
+	{0x80280100, "TPM_20_E_INITIALIZE"},
+	{0x80280101, "TPM_20_E_FAILURE"},
+	{0x80280103, "TPM_20_E_SEQUENCE"},
+	{0x8028010B, "TPM_20_E_PRIVATE"},
+	{0x80280119, "TPM_20_E_HMAC"},
+	{0x80280120, "TPM_20_E_DISABLED"},
+	{0x80280121, "TPM_20_E_EXCLUSIVE"},
+	{0x80280123, "TPM_20_E_ECC_CURVE"},
+	{0x80280124, "TPM_20_E_AUTH_TYPE"},
+	{0x80280125, "TPM_20_E_AUTH_MISSING"},
+	{0x80280126, "TPM_20_E_POLICY"},
+	{0x80280127, "TPM_20_E_PCR"},
+	{0x80280128, "TPM_20_E_PCR_CHANGED"},
+	{0x8028012D, "TPM_20_E_UPGRADE"},
+	{0x8028012E, "TPM_20_E_TOO_MANY_CONTEXTS"},
+	{0x8028012F, "TPM_20_E_AUTH_UNAVAILABLE"},
+	{0x80280130, "TPM_20_E_REBOOT"},
+	{0x80280131, "TPM_20_E_UNBALANCED"},
+	{0x80280142, "TPM_20_E_COMMAND_SIZE"},
+	{0x80280143, "TPM_20_E_COMMAND_CODE"},
+	{0x80280144, "TPM_20_E_AUTHSIZE"},
+	{0x80280145, "TPM_20_E_AUTH_CONTEXT"},
+	{0x80280146, "TPM_20_E_NV_RANGE"},
+	{0x80280147, "TPM_20_E_NV_SIZE"},
+	{0x80280148, "TPM_20_E_NV_LOCKED"},
+	{0x80280149, "TPM_20_E_NV_AUTHORIZATION"},
+	{0x8028014A, "TPM_20_E_NV_UNINITIALIZED"},
+	{0x8028014B, "TPM_20_E_NV_SPACE"},
+	{0x8028014C, "TPM_20_E_NV_DEFINED"},
+	{0x80280150, "TPM_20_E_BAD_CONTEXT"},
+	{0x80280151, "TPM_20_E_CPHASH"},
+	{0x80280152, "TPM_20_E_PARENT"},
+	{0x80280153, "TPM_20_E_NEEDS_TEST"},
+	{0x80280154, "TPM_20_E_NO_RESULT"},
+	{0x80280155, "TPM_20_E_SENSITIVE"},
+	{0x80280400, "TPM_E_COMMAND_BLOCKED"},
+	{0x80280401, "TPM_E_INVALID_HANDLE"},
+	{0x80280402, "TPM_E_DUPLICATE_VHANDLE"},
+	{0x80280403, "TPM_E_EMBEDDED_COMMAND_BLOCKED"},
+	{0x80280404, "TPM_E_EMBEDDED_COMMAND_UNSUPPORTED"},
+	{0x80280800, "TPM_E_RETRY"},
+	{0x80280801, "TPM_E_NEEDS_SELFTEST"},
+	{0x80280802, "TPM_E_DOING_SELFTEST"},
+	{0x80280803, "TPM_E_DEFEND_LOCK_RUNNING"},
+	{0x80280901, "TPM_20_E_CONTEXT_GAP"},
+	{0x80280902, "TPM_20_E_OBJECT_MEMORY"},
+	{0x80280903, "TPM_20_E_SESSION_MEMORY"},
+	{0x80280904, "TPM_20_E_MEMORY"},
+	{0x80280905, "TPM_20_E_SESSION_HANDLES"},
+	{0x80280906, "TPM_20_E_OBJECT_HANDLES"},
+	{0x80280907, "TPM_20_E_LOCALITY"},
+	{0x80280908, "TPM_20_E_YIELDED"},
+	{0x80280909, "TPM_20_E_CANCELED"},
+	{0x8028090A, "TPM_20_E_TESTING"},
+	{0x80280920, "TPM_20_E_NV_RATE"},
+	{0x80280921, "TPM_20_E_LOCKOUT"},
+	{0x80280922, "TPM_20_E_RETRY"},
+	{0x80280923, "TPM_20_E_NV_UNAVAILABLE"},
+	{0x80284001, "TBS_E_INTERNAL_ERROR"},
+	{0x80284002, "TBS_E_BAD_PARAMETER"},
+	{0x80284003, "TBS_E_INVALID_OUTPUT_POINTER"},
+	{0x80284004, "TBS_E_INVALID_CONTEXT"},
+	{0x80284005, "TBS_E_INSUFFICIENT_BUFFER"},
+	{0x80284006, "TBS_E_IOERROR"},
+	{0x80284007, "TBS_E_INVALID_CONTEXT_PARAM"},
+	{0x80284008, "TBS_E_SERVICE_NOT_RUNNING"},
+	{0x80284009, "TBS_E_TOO_MANY_TBS_CONTEXTS"},
+	{0x8028400A, "TBS_E_TOO_MANY_RESOURCES"},
+	{0x8028400B, "TBS_E_SERVICE_START_PENDING"},
+	{0x8028400C, "TBS_E_PPI_NOT_SUPPORTED"},
+	{0x8028400D, "TBS_E_COMMAND_CANCELED"},
+	{0x8028400E, "TBS_E_BUFFER_TOO_LARGE"},
+	{0x8028400F, "TBS_E_TPM_NOT_FOUND"},
+	{0x80284010, "TBS_E_SERVICE_DISABLED"},
+	{0x80284011, "TBS_E_NO_EVENT_LOG"},
+	{0x80284012, "TBS_E_ACCESS_DENIED"},
+	{0x80284013, "TBS_E_PROVISIONING_NOT_ALLOWED"},
+	{0x80284014, "TBS_E_PPI_FUNCTION_UNSUPPORTED"},
+	{0x80284015, "TBS_E_OWNERAUTH_NOT_FOUND"},
+	{0x80284016, "TBS_E_PROVISIONING_INCOMPLETE"},
+	{0x80290100, "TPMAPI_E_INVALID_STATE"},
+	{0x80290101, "TPMAPI_E_NOT_ENOUGH_DATA"},
+	{0x80290102, "TPMAPI_E_TOO_MUCH_DATA"},
+	{0x80290103, "TPMAPI_E_INVALID_OUTPUT_POINTER"},
+	{0x80290104, "TPMAPI_E_INVALID_PARAMETER"},
+	{0x80290105, "TPMAPI_E_OUT_OF_MEMORY"},
+	{0x80290106, "TPMAPI_E_BUFFER_TOO_SMALL"},
+	{0x80290107, "TPMAPI_E_INTERNAL_ERROR"},
+	{0x80290108, "TPMAPI_E_ACCESS_DENIED"},
+	{0x80290109, "TPMAPI_E_AUTHORIZATION_FAILED"},
+	{0x8029010A, "TPMAPI_E_INVALID_CONTEXT_HANDLE"},
+	{0x8029010B, "TPMAPI_E_TBS_COMMUNICATION_ERROR"},
+	{0x8029010C, "TPMAPI_E_TPM_COMMAND_ERROR"},
+	{0x8029010D, "TPMAPI_E_MESSAGE_TOO_LARGE"},
+	{0x8029010E, "TPMAPI_E_INVALID_ENCODING"},
+	{0x8029010F, "TPMAPI_E_INVALID_KEY_SIZE"},
+	{0x80290110, "TPMAPI_E_ENCRYPTION_FAILED"},
+	{0x80290111, "TPMAPI_E_INVALID_KEY_PARAMS"},
+	{0x80290112, "TPMAPI_E_INVALID_MIGRATION_AUTHORIZATION_BLOB"},
+	{0x80290113, "TPMAPI_E_INVALID_PCR_INDEX"},
+	{0x80290114, "TPMAPI_E_INVALID_DELEGATE_BLOB"},
+	{0x80290115, "TPMAPI_E_INVALID_CONTEXT_PARAMS"},
+	{0x80290116, "TPMAPI_E_INVALID_KEY_BLOB"},
+	{0x80290117, "TPMAPI_E_INVALID_PCR_DATA"},
+	{0x80290118, "TPMAPI_E_INVALID_OWNER_AUTH"},
+	{0x80290119, "TPMAPI_E_FIPS_RNG_CHECK_FAILED"},
+	{0x8029011A, "TPMAPI_E_EMPTY_TCG_LOG"},
+	{0x8029011B, "TPMAPI_E_INVALID_TCG_LOG_ENTRY"},
+	{0x8029011C, "TPMAPI_E_TCG_SEPARATOR_ABSENT"},
+	{0x8029011D, "TPMAPI_E_TCG_INVALID_DIGEST_ENTRY"},
+	{0x8029011E, "TPMAPI_E_POLICY_DENIES_OPERATION"},
+	{0x8029011F, "TPMAPI_E_NV_BITS_NOT_DEFINED"},
+	{0x80290120, "TPMAPI_E_NV_BITS_NOT_READY"},
+	{0x80290121, "TPMAPI_E_SEALING_KEY_NOT_AVAILABLE"},
+	{0x80290122, "TPMAPI_E_NO_AUTHORIZATION_CHAIN_FOUND"},
+	{0x80290123, "TPMAPI_E_SVN_COUNTER_NOT_AVAILABLE"},
+	{0x80290124, "TPMAPI_E_OWNER_AUTH_NOT_NULL"},
+	{0x80290125, "TPMAPI_E_ENDORSEMENT_AUTH_NOT_NULL"},
+	{0x80290126, "TPMAPI_E_AUTHORIZATION_REVOKED"},
+	{0x80290127, "TPMAPI_E_MALFORMED_AUTHORIZATION_KEY"},
+	{0x80290128, "TPMAPI_E_AUTHORIZING_KEY_NOT_SUPPORTED"},
+	{0x80290129, "TPMAPI_E_INVALID_AUTHORIZATION_SIGNATURE"},
+	{0x8029012A, "TPMAPI_E_MALFORMED_AUTHORIZATION_POLICY"},
+	{0x8029012B, "TPMAPI_E_MALFORMED_AUTHORIZATION_OTHER"},
+	{0x8029012C, "TPMAPI_E_SEALING_KEY_CHANGED"},
+	{0x80290200, "TBSIMP_E_BUFFER_TOO_SMALL"},
+	{0x80290201, "TBSIMP_E_CLEANUP_FAILED"},
+	{0x80290202, "TBSIMP_E_INVALID_CONTEXT_HANDLE"},
+	{0x80290203, "TBSIMP_E_INVALID_CONTEXT_PARAM"},
+	{0x80290204, "TBSIMP_E_TPM_ERROR"},
+	{0x80290205, "TBSIMP_E_HASH_BAD_KEY"},
+	{0x80290206, "TBSIMP_E_DUPLICATE_VHANDLE"},
+	{0x80290207, "TBSIMP_E_INVALID_OUTPUT_POINTER"},
+	{0x80290208, "TBSIMP_E_INVALID_PARAMETER"},
+	{0x80290209, "TBSIMP_E_RPC_INIT_FAILED"},
+	{0x8029020A, "TBSIMP_E_SCHEDULER_NOT_RUNNING"},
+	{0x8029020B, "TBSIMP_E_COMMAND_CANCELED"},
+	{0x8029020C, "TBSIMP_E_OUT_OF_MEMORY"},
+	{0x8029020D, "TBSIMP_E_LIST_NO_MORE_ITEMS"},
+	{0x8029020E, "TBSIMP_E_LIST_NOT_FOUND"},
+	{0x8029020F, "TBSIMP_E_NOT_ENOUGH_SPACE"},
+	{0x80290210, "TBSIMP_E_NOT_ENOUGH_TPM_CONTEXTS"},
+	{0x80290211, "TBSIMP_E_COMMAND_FAILED"},
+	{0x80290212, "TBSIMP_E_UNKNOWN_ORDINAL"},
+	{0x80290213, "TBSIMP_E_RESOURCE_EXPIRED"},
+	{0x80290214, "TBSIMP_E_INVALID_RESOURCE"},
+	{0x80290215, "TBSIMP_E_NOTHING_TO_UNLOAD"},
+	{0x80290216, "TBSIMP_E_HASH_TABLE_FULL"},
+	{0x80290217, "TBSIMP_E_TOO_MANY_TBS_CONTEXTS"},
+	{0x80290218, "TBSIMP_E_TOO_MANY_RESOURCES"},
+	{0x80290219, "TBSIMP_E_PPI_NOT_SUPPORTED"},
+	{0x8029021A, "TBSIMP_E_TPM_INCOMPATIBLE"},
+	{0x8029021B, "TBSIMP_E_NO_EVENT_LOG"},
+	{0x80290300, "TPM_E_PPI_ACPI_FAILURE"},
+	{0x80290301, "TPM_E_PPI_USER_ABORT"},
+	{0x80290302, "TPM_E_PPI_BIOS_FAILURE"},
+	{0x80290303, "TPM_E_PPI_NOT_SUPPORTED"},
+	{0x80290304, "TPM_E_PPI_BLOCKED_IN_BIOS"},
+	{0x80290400, "TPM_E_PCP_ERROR_MASK"},
+	{0x80290401, "TPM_E_PCP_DEVICE_NOT_READY"},
+	{0x80290402, "TPM_E_PCP_INVALID_HANDLE"},
+	{0x80290403, "TPM_E_PCP_INVALID_PARAMETER"},
+	{0x80290404, "TPM_E_PCP_FLAG_NOT_SUPPORTED"},
+	{0x80290405, "TPM_E_PCP_NOT_SUPPORTED"},
+	{0x80290406, "TPM_E_PCP_BUFFER_TOO_SMALL"},
+	{0x80290407, "TPM_E_PCP_INTERNAL_ERROR"},
+	{0x80290408, "TPM_E_PCP_AUTHENTICATION_FAILED"},
+	{0x80290409, "TPM_E_PCP_AUTHENTICATION_IGNORED"},
+	{0x8029040A, "TPM_E_PCP_POLICY_NOT_FOUND"},
+	{0x8029040B, "TPM_E_PCP_PROFILE_NOT_FOUND"},
+	{0x8029040C, "TPM_E_PCP_VALIDATION_FAILED"},
+	{0x8029040E, "TPM_E_PCP_WRONG_PARENT"},
+	{0x8029040F, "TPM_E_KEY_NOT_LOADED"},
+	{0x80290410, "TPM_E_NO_KEY_CERTIFICATION"},
+	{0x80290411, "TPM_E_KEY_NOT_FINALIZED"},
+	{0x80290412, "TPM_E_ATTESTATION_CHALLENGE_NOT_SET"},
+	{0x80290413, "TPM_E_NOT_PCR_BOUND"},
+	{0x80290414, "TPM_E_KEY_ALREADY_FINALIZED"},
+	{0x80290415, "TPM_E_KEY_USAGE_POLICY_NOT_SUPPORTED"},
+	{0x80290416, "TPM_E_KEY_USAGE_POLICY_INVALID"},
+	{0x80290417, "TPM_E_SOFT_KEY_ERROR"},
+	{0x80290418, "TPM_E_KEY_NOT_AUTHENTICATED"},
+	{0x80290419, "TPM_E_PCP_KEY_NOT_AIK"},
+	{0x8029041A, "TPM_E_KEY_NOT_SIGNING_KEY"},
+	{0x8029041B, "TPM_E_LOCKED_OUT"},
+	{0x8029041C, "TPM_E_CLAIM_TYPE_NOT_SUPPORTED"},
+	{0x8029041D, "TPM_E_VERSION_NOT_SUPPORTED"},
+	{0x8029041E, "TPM_E_BUFFER_LENGTH_MISMATCH"},
+	{0x8029041F, "TPM_E_PCP_IFX_RSA_KEY_CREATION_BLOCKED"},
+	{0x80290420, "TPM_E_PCP_TICKET_MISSING"},
+	{0x80290421, "TPM_E_PCP_RAW_POLICY_NOT_SUPPORTED"},
+	{0x80290422, "TPM_E_PCP_KEY_HANDLE_INVALIDATED"},
+	{0x80290500, "TPM_E_ZERO_EXHAUST_ENABLED"},
+	{0x802A0001, "UI_E_CREATE_FAILED"},
+	{0x802A0002, "UI_E_SHUTDOWN_CALLED"},
+	{0x802A0003, "UI_E_ILLEGAL_REENTRANCY"},
+	{0x802A0004, "UI_E_OBJECT_SEALED"},
+	{0x802A0005, "UI_E_VALUE_NOT_SET"},
+	{0x802A0006, "UI_E_VALUE_NOT_DETERMINED"},
+	{0x802A0007, "UI_E_INVALID_OUTPUT"},
+	{0x802A0008, "UI_E_BOOLEAN_EXPECTED"},
+	{0x802A0009, "UI_E_DIFFERENT_OWNER"},
+	{0x802A000A, "UI_E_AMBIGUOUS_MATCH"},
+	{0x802A000B, "UI_E_FP_OVERFLOW"},
+	{0x802A000C, "UI_E_WRONG_THREAD"},
+	{0x802A0101, "UI_E_STORYBOARD_ACTIVE"},
+	{0x802A0102, "UI_E_STORYBOARD_NOT_PLAYING"},
+	{0x802A0103, "UI_E_START_KEYFRAME_AFTER_END"},
+	{0x802A0104, "UI_E_END_KEYFRAME_NOT_DETERMINED"},
+	{0x802A0105, "UI_E_LOOPS_OVERLAP"},
+	{0x802A0106, "UI_E_TRANSITION_ALREADY_USED"},
+	{0x802A0107, "UI_E_TRANSITION_NOT_IN_STORYBOARD"},
+	{0x802A0108, "UI_E_TRANSITION_ECLIPSED"},
+	{0x802A0109, "UI_E_TIME_BEFORE_LAST_UPDATE"},
+	{0x802A010A, "UI_E_TIMER_CLIENT_ALREADY_CONNECTED"},
+	{0x802A010B, "UI_E_INVALID_DIMENSION"},
+	{0x802A010C, "UI_E_PRIMITIVE_OUT_OF_BOUNDS"},
+	{0x802A0201, "UI_E_WINDOW_CLOSED"},
+	{0x80300002, "PLA_E_DCS_NOT_FOUND"},
+	{0x80300045, "PLA_E_TOO_MANY_FOLDERS"},
+	{0x80300070, "PLA_E_NO_MIN_DISK"},
+	{0x803000AA, "PLA_E_DCS_IN_USE"},
+	{0x803000B7, "PLA_E_DCS_ALREADY_EXISTS"},
+	{0x80300101, "PLA_E_PROPERTY_CONFLICT"},
+	{0x80300102, "PLA_E_DCS_SINGLETON_REQUIRED"},
+	{0x80300103, "PLA_E_CREDENTIALS_REQUIRED"},
+	{0x80300104, "PLA_E_DCS_NOT_RUNNING"},
+	{0x80300105, "PLA_E_CONFLICT_INCL_EXCL_API"},
+	{0x80300106, "PLA_E_NETWORK_EXE_NOT_VALID"},
+	{0x80300107, "PLA_E_EXE_ALREADY_CONFIGURED"},
+	{0x80300108, "PLA_E_EXE_PATH_NOT_VALID"},
+	{0x80300109, "PLA_E_DC_ALREADY_EXISTS"},
+	{0x8030010A, "PLA_E_DCS_START_WAIT_TIMEOUT"},
+	{0x8030010B, "PLA_E_DC_START_WAIT_TIMEOUT"},
+	{0x8030010C, "PLA_E_REPORT_WAIT_TIMEOUT"},
+	{0x8030010D, "PLA_E_NO_DUPLICATES"},
+	{0x8030010E, "PLA_E_EXE_FULL_PATH_REQUIRED"},
+	{0x8030010F, "PLA_E_INVALID_SESSION_NAME"},
+	{0x80300110, "PLA_E_PLA_CHANNEL_NOT_ENABLED"},
+	{0x80300111, "PLA_E_TASKSCHED_CHANNEL_NOT_ENABLED"},
+	{0x80300112, "PLA_E_RULES_MANAGER_FAILED"},
+	{0x80300113, "PLA_E_CABAPI_FAILURE"},
+	{0x80310000, "FVE_E_LOCKED_VOLUME"},
+	{0x80310001, "FVE_E_NOT_ENCRYPTED"},
+	{0x80310002, "FVE_E_NO_TPM_BIOS"},
+	{0x80310003, "FVE_E_NO_MBR_METRIC"},
+	{0x80310004, "FVE_E_NO_BOOTSECTOR_METRIC"},
+	{0x80310005, "FVE_E_NO_BOOTMGR_METRIC"},
+	{0x80310006, "FVE_E_WRONG_BOOTMGR"},
+	{0x80310007, "FVE_E_SECURE_KEY_REQUIRED"},
+	{0x80310008, "FVE_E_NOT_ACTIVATED"},
+	{0x80310009, "FVE_E_ACTION_NOT_ALLOWED"},
+	{0x8031000A, "FVE_E_AD_SCHEMA_NOT_INSTALLED"},
+	{0x8031000B, "FVE_E_AD_INVALID_DATATYPE"},
+	{0x8031000C, "FVE_E_AD_INVALID_DATASIZE"},
+	{0x8031000D, "FVE_E_AD_NO_VALUES"},
+	{0x8031000E, "FVE_E_AD_ATTR_NOT_SET"},
+	{0x8031000F, "FVE_E_AD_GUID_NOT_FOUND"},
+	{0x80310010, "FVE_E_BAD_INFORMATION"},
+	{0x80310011, "FVE_E_TOO_SMALL"},
+	{0x80310012, "FVE_E_SYSTEM_VOLUME"},
+	{0x80310013, "FVE_E_FAILED_WRONG_FS"},
+	{0x80310014, "FVE_E_BAD_PARTITION_SIZE"},
+	{0x80310015, "FVE_E_NOT_SUPPORTED"},
+	{0x80310016, "FVE_E_BAD_DATA"},
+	{0x80310017, "FVE_E_VOLUME_NOT_BOUND"},
+	{0x80310018, "FVE_E_TPM_NOT_OWNED"},
+	{0x80310019, "FVE_E_NOT_DATA_VOLUME"},
+	{0x8031001A, "FVE_E_AD_INSUFFICIENT_BUFFER"},
+	{0x8031001B, "FVE_E_CONV_READ"},
+	{0x8031001C, "FVE_E_CONV_WRITE"},
+	{0x8031001D, "FVE_E_KEY_REQUIRED"},
+	{0x8031001E, "FVE_E_CLUSTERING_NOT_SUPPORTED"},
+	{0x8031001F, "FVE_E_VOLUME_BOUND_ALREADY"},
+	{0x80310020, "FVE_E_OS_NOT_PROTECTED"},
+	{0x80310021, "FVE_E_PROTECTION_DISABLED"},
+	{0x80310022, "FVE_E_RECOVERY_KEY_REQUIRED"},
+	{0x80310023, "FVE_E_FOREIGN_VOLUME"},
+	{0x80310024, "FVE_E_OVERLAPPED_UPDATE"},
+	{0x80310025, "FVE_E_TPM_SRK_AUTH_NOT_ZERO"},
+	{0x80310026, "FVE_E_FAILED_SECTOR_SIZE"},
+	{0x80310027, "FVE_E_FAILED_AUTHENTICATION"},
+	{0x80310028, "FVE_E_NOT_OS_VOLUME"},
+	{0x80310029, "FVE_E_AUTOUNLOCK_ENABLED"},
+	{0x8031002A, "FVE_E_WRONG_BOOTSECTOR"},
+	{0x8031002B, "FVE_E_WRONG_SYSTEM_FS"},
+	{0x8031002C, "FVE_E_POLICY_PASSWORD_REQUIRED"},
+	{0x8031002D, "FVE_E_CANNOT_SET_FVEK_ENCRYPTED"},
+	{0x8031002E, "FVE_E_CANNOT_ENCRYPT_NO_KEY"},
+	{0x80310030, "FVE_E_BOOTABLE_CDDVD"},
+	{0x80310031, "FVE_E_PROTECTOR_EXISTS"},
+	{0x80310032, "FVE_E_RELATIVE_PATH"},
+	{0x80310033, "FVE_E_PROTECTOR_NOT_FOUND"},
+	{0x80310034, "FVE_E_INVALID_KEY_FORMAT"},
+	{0x80310035, "FVE_E_INVALID_PASSWORD_FORMAT"},
+	{0x80310036, "FVE_E_FIPS_RNG_CHECK_FAILED"},
+	{0x80310037, "FVE_E_FIPS_PREVENTS_RECOVERY_PASSWORD"},
+	{0x80310038, "FVE_E_FIPS_PREVENTS_EXTERNAL_KEY_EXPORT"},
+	{0x80310039, "FVE_E_NOT_DECRYPTED"},
+	{0x8031003A, "FVE_E_INVALID_PROTECTOR_TYPE"},
+	{0x8031003B, "FVE_E_NO_PROTECTORS_TO_TEST"},
+	{0x8031003C, "FVE_E_KEYFILE_NOT_FOUND"},
+	{0x8031003D, "FVE_E_KEYFILE_INVALID"},
+	{0x8031003E, "FVE_E_KEYFILE_NO_VMK"},
+	{0x8031003F, "FVE_E_TPM_DISABLED"},
+	{0x80310040, "FVE_E_NOT_ALLOWED_IN_SAFE_MODE"},
+	{0x80310041, "FVE_E_TPM_INVALID_PCR"},
+	{0x80310042, "FVE_E_TPM_NO_VMK"},
+	{0x80310043, "FVE_E_PIN_INVALID"},
+	{0x80310044, "FVE_E_AUTH_INVALID_APPLICATION"},
+	{0x80310045, "FVE_E_AUTH_INVALID_CONFIG"},
+	{0x80310046, "FVE_E_FIPS_DISABLE_PROTECTION_NOT_ALLOWED"},
+	{0x80310047, "FVE_E_FS_NOT_EXTENDED"},
+	{0x80310048, "FVE_E_FIRMWARE_TYPE_NOT_SUPPORTED"},
+	{0x80310049, "FVE_E_NO_LICENSE"},
+	{0x8031004A, "FVE_E_NOT_ON_STACK"},
+	{0x8031004B, "FVE_E_FS_MOUNTED"},
+	{0x8031004C, "FVE_E_TOKEN_NOT_IMPERSONATED"},
+	{0x8031004D, "FVE_E_DRY_RUN_FAILED"},
+	{0x8031004E, "FVE_E_REBOOT_REQUIRED"},
+	{0x8031004F, "FVE_E_DEBUGGER_ENABLED"},
+	{0x80310050, "FVE_E_RAW_ACCESS"},
+	{0x80310051, "FVE_E_RAW_BLOCKED"},
+	{0x80310052, "FVE_E_BCD_APPLICATIONS_PATH_INCORRECT"},
+	{0x80310053, "FVE_E_NOT_ALLOWED_IN_VERSION"},
+	{0x80310054, "FVE_E_NO_AUTOUNLOCK_MASTER_KEY"},
+	{0x80310055, "FVE_E_MOR_FAILED"},
+	{0x80310056, "FVE_E_HIDDEN_VOLUME"},
+	{0x80310057, "FVE_E_TRANSIENT_STATE"},
+	{0x80310058, "FVE_E_PUBKEY_NOT_ALLOWED"},
+	{0x80310059, "FVE_E_VOLUME_HANDLE_OPEN"},
+	{0x8031005A, "FVE_E_NO_FEATURE_LICENSE"},
+	{0x8031005B, "FVE_E_INVALID_STARTUP_OPTIONS"},
+	{0x8031005C, "FVE_E_POLICY_RECOVERY_PASSWORD_NOT_ALLOWED"},
+	{0x8031005D, "FVE_E_POLICY_RECOVERY_PASSWORD_REQUIRED"},
+	{0x8031005E, "FVE_E_POLICY_RECOVERY_KEY_NOT_ALLOWED"},
+	{0x8031005F, "FVE_E_POLICY_RECOVERY_KEY_REQUIRED"},
+	{0x80310060, "FVE_E_POLICY_STARTUP_PIN_NOT_ALLOWED"},
+	{0x80310061, "FVE_E_POLICY_STARTUP_PIN_REQUIRED"},
+	{0x80310062, "FVE_E_POLICY_STARTUP_KEY_NOT_ALLOWED"},
+	{0x80310063, "FVE_E_POLICY_STARTUP_KEY_REQUIRED"},
+	{0x80310064, "FVE_E_POLICY_STARTUP_PIN_KEY_NOT_ALLOWED"},
+	{0x80310065, "FVE_E_POLICY_STARTUP_PIN_KEY_REQUIRED"},
+	{0x80310066, "FVE_E_POLICY_STARTUP_TPM_NOT_ALLOWED"},
+	{0x80310067, "FVE_E_POLICY_STARTUP_TPM_REQUIRED"},
+	{0x80310068, "FVE_E_POLICY_INVALID_PIN_LENGTH"},
+	{0x80310069, "FVE_E_KEY_PROTECTOR_NOT_SUPPORTED"},
+	{0x8031006A, "FVE_E_POLICY_PASSPHRASE_NOT_ALLOWED"},
+	{0x8031006B, "FVE_E_POLICY_PASSPHRASE_REQUIRED"},
+	{0x8031006C, "FVE_E_FIPS_PREVENTS_PASSPHRASE"},
+	{0x8031006D, "FVE_E_OS_VOLUME_PASSPHRASE_NOT_ALLOWED"},
+	{0x8031006E, "FVE_E_INVALID_BITLOCKER_OID"},
+	{0x8031006F, "FVE_E_VOLUME_TOO_SMALL"},
+	{0x80310070, "FVE_E_DV_NOT_SUPPORTED_ON_FS"},
+	{0x80310071, "FVE_E_DV_NOT_ALLOWED_BY_GP"},
+	{0x80310072, "FVE_E_POLICY_USER_CERTIFICATE_NOT_ALLOWED"},
+	{0x80310073, "FVE_E_POLICY_USER_CERTIFICATE_REQUIRED"},
+	{0x80310074, "FVE_E_POLICY_USER_CERT_MUST_BE_HW"},
+	{0x80310075, "FVE_E_POLICY_USER_CONFIGURE_FDV_AUTOUNLOCK_NOT_ALLOWED"},
+	{0x80310076, "FVE_E_POLICY_USER_CONFIGURE_RDV_AUTOUNLOCK_NOT_ALLOWED"},
+	{0x80310077, "FVE_E_POLICY_USER_CONFIGURE_RDV_NOT_ALLOWED"},
+	{0x80310078, "FVE_E_POLICY_USER_ENABLE_RDV_NOT_ALLOWED"},
+	{0x80310079, "FVE_E_POLICY_USER_DISABLE_RDV_NOT_ALLOWED"},
+	{0x80310080, "FVE_E_POLICY_INVALID_PASSPHRASE_LENGTH"},
+	{0x80310081, "FVE_E_POLICY_PASSPHRASE_TOO_SIMPLE"},
+	{0x80310082, "FVE_E_RECOVERY_PARTITION"},
+	{0x80310083, "FVE_E_POLICY_CONFLICT_FDV_RK_OFF_AUK_ON"},
+	{0x80310084, "FVE_E_POLICY_CONFLICT_RDV_RK_OFF_AUK_ON"},
+	{0x80310085, "FVE_E_NON_BITLOCKER_OID"},
+	{0x80310086, "FVE_E_POLICY_PROHIBITS_SELFSIGNED"},
+	{0x80310087, "FVE_E_POLICY_CONFLICT_RO_AND_STARTUP_KEY_REQUIRED"},
+	{0x80310088, "FVE_E_CONV_RECOVERY_FAILED"},
+	{0x80310089, "FVE_E_VIRTUALIZED_SPACE_TOO_BIG"},
+	{0x80310090, "FVE_E_POLICY_CONFLICT_OSV_RP_OFF_ADB_ON"},
+	{0x80310091, "FVE_E_POLICY_CONFLICT_FDV_RP_OFF_ADB_ON"},
+	{0x80310092, "FVE_E_POLICY_CONFLICT_RDV_RP_OFF_ADB_ON"},
+	{0x80310093, "FVE_E_NON_BITLOCKER_KU"},
+	{0x80310094, "FVE_E_PRIVATEKEY_AUTH_FAILED"},
+	{0x80310095, "FVE_E_REMOVAL_OF_DRA_FAILED"},
+	{0x80310096, "FVE_E_OPERATION_NOT_SUPPORTED_ON_VISTA_VOLUME"},
+	{0x80310097, "FVE_E_CANT_LOCK_AUTOUNLOCK_ENABLED_VOLUME"},
+	{0x80310098, "FVE_E_FIPS_HASH_KDF_NOT_ALLOWED"},
+	{0x80310099, "FVE_E_ENH_PIN_INVALID"},
+	{0x8031009A, "FVE_E_INVALID_PIN_CHARS"},
+	{0x8031009B, "FVE_E_INVALID_DATUM_TYPE"},
+	{0x8031009C, "FVE_E_EFI_ONLY"},
+	{0x8031009D, "FVE_E_MULTIPLE_NKP_CERTS"},
+	{0x8031009E, "FVE_E_REMOVAL_OF_NKP_FAILED"},
+	{0x8031009F, "FVE_E_INVALID_NKP_CERT"},
+	{0x803100A0, "FVE_E_NO_EXISTING_PIN"},
+	{0x803100A1, "FVE_E_PROTECTOR_CHANGE_PIN_MISMATCH"},
+	{0x803100A2, "FVE_E_PIN_PROTECTOR_CHANGE_BY_STD_USER_DISALLOWED"},
+	{0x803100A3, "FVE_E_PROTECTOR_CHANGE_MAX_PIN_CHANGE_ATTEMPTS_REACHED"},
+	{0x803100A4, "FVE_E_POLICY_PASSPHRASE_REQUIRES_ASCII"},
+	{0x803100A5, "FVE_E_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE"},
+	{0x803100A6, "FVE_E_WIPE_NOT_ALLOWED_ON_TP_STORAGE"},
+	{0x803100A7, "FVE_E_KEY_LENGTH_NOT_SUPPORTED_BY_EDRIVE"},
+	{0x803100A8, "FVE_E_NO_EXISTING_PASSPHRASE"},
+	{0x803100A9, "FVE_E_PROTECTOR_CHANGE_PASSPHRASE_MISMATCH"},
+	{0x803100AA, "FVE_E_PASSPHRASE_TOO_LONG"},
+	{0x803100AB, "FVE_E_NO_PASSPHRASE_WITH_TPM"},
+	{0x803100AC, "FVE_E_NO_TPM_WITH_PASSPHRASE"},
+	{0x803100AD, "FVE_E_NOT_ALLOWED_ON_CSV_STACK"},
+	{0x803100AE, "FVE_E_NOT_ALLOWED_ON_CLUSTER"},
+	{0x803100AF, "FVE_E_EDRIVE_NO_FAILOVER_TO_SW"},
+	{0x803100B0, "FVE_E_EDRIVE_BAND_IN_USE"},
+	{0x803100B1, "FVE_E_EDRIVE_DISALLOWED_BY_GP"},
+	{0x803100B2, "FVE_E_EDRIVE_INCOMPATIBLE_VOLUME"},
+	{0x803100B3, "FVE_E_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING"},
+	{0x803100B4, "FVE_E_EDRIVE_DV_NOT_SUPPORTED"},
+	{0x803100B5, "FVE_E_NO_PREBOOT_KEYBOARD_DETECTED"},
+	{0x803100B6, "FVE_E_NO_PREBOOT_KEYBOARD_OR_WINRE_DETECTED"},
+	{0x803100B7, "FVE_E_POLICY_REQUIRES_STARTUP_PIN_ON_TOUCH_DEVICE"},
+	{0x803100B8, "FVE_E_POLICY_REQUIRES_RECOVERY_PASSWORD_ON_TOUCH_DEVICE"},
+	{0x803100B9, "FVE_E_WIPE_CANCEL_NOT_APPLICABLE"},
+	{0x803100BA, "FVE_E_SECUREBOOT_DISABLED"},
+	{0x803100BB, "FVE_E_SECUREBOOT_CONFIGURATION_INVALID"},
+	{0x803100BC, "FVE_E_EDRIVE_DRY_RUN_FAILED"},
+	{0x803100BD, "FVE_E_SHADOW_COPY_PRESENT"},
+	{0x803100BE, "FVE_E_POLICY_INVALID_ENHANCED_BCD_SETTINGS"},
+	{0x803100BF, "FVE_E_EDRIVE_INCOMPATIBLE_FIRMWARE"},
+	{0x803100C0, "FVE_E_PROTECTOR_CHANGE_MAX_PASSPHRASE_CHANGE_ATTEMPTS_REACHED"},
+	{0x803100C1, "FVE_E_PASSPHRASE_PROTECTOR_CHANGE_BY_STD_USER_DISALLOWED"},
+	{0x803100C2, "FVE_E_LIVEID_ACCOUNT_SUSPENDED"},
+	{0x803100C3, "FVE_E_LIVEID_ACCOUNT_BLOCKED"},
+	{0x803100C4, "FVE_E_NOT_PROVISIONED_ON_ALL_VOLUMES"},
+	{0x803100C5, "FVE_E_DE_FIXED_DATA_NOT_SUPPORTED"},
+	{0x803100C6, "FVE_E_DE_HARDWARE_NOT_COMPLIANT"},
+	{0x803100C7, "FVE_E_DE_WINRE_NOT_CONFIGURED"},
+	{0x803100C8, "FVE_E_DE_PROTECTION_SUSPENDED"},
+	{0x803100C9, "FVE_E_DE_OS_VOLUME_NOT_PROTECTED"},
+	{0x803100CA, "FVE_E_DE_DEVICE_LOCKEDOUT"},
+	{0x803100CB, "FVE_E_DE_PROTECTION_NOT_YET_ENABLED"},
+	{0x803100CC, "FVE_E_INVALID_PIN_CHARS_DETAILED"},
+	{0x803100CD, "FVE_E_DEVICE_LOCKOUT_COUNTER_UNAVAILABLE"},
+	{0x803100CE, "FVE_E_DEVICELOCKOUT_COUNTER_MISMATCH"},
+	{0x803100CF, "FVE_E_BUFFER_TOO_LARGE"},
+	{0x803100D0, "FVE_E_NO_SUCH_CAPABILITY_ON_TARGET"},
+	{0x803100D1, "FVE_E_DE_PREVENTED_FOR_OS"},
+	{0x803100D2, "FVE_E_DE_VOLUME_OPTED_OUT"},
+	{0x803100D3, "FVE_E_DE_VOLUME_NOT_SUPPORTED"},
+	{0x803100D4, "FVE_E_EOW_NOT_SUPPORTED_IN_VERSION"},
+	{0x803100D5, "FVE_E_ADBACKUP_NOT_ENABLED"},
+	{0x803100D6, "FVE_E_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT"},
+	{0x803100D7, "FVE_E_NOT_DE_VOLUME"},
+	{0x803100D8, "FVE_E_PROTECTION_CANNOT_BE_DISABLED"},
+	{0x803100D9, "FVE_E_OSV_KSR_NOT_ALLOWED"},
+	{0x803100DA, "FVE_E_AD_BACKUP_REQUIRED_POLICY_NOT_SET_OS_DRIVE"},
+	{0x803100DB, "FVE_E_AD_BACKUP_REQUIRED_POLICY_NOT_SET_FIXED_DRIVE"},
+	{0x803100DC, "FVE_E_AD_BACKUP_REQUIRED_POLICY_NOT_SET_REMOVABLE_DRIVE"},
+	{0x803100DD, "FVE_E_KEY_ROTATION_NOT_SUPPORTED"},
+	{0x803100DE, "FVE_E_EXECUTE_REQUEST_SENT_TOO_SOON"},
+	{0x803100DF, "FVE_E_KEY_ROTATION_NOT_ENABLED"},
+	{0x803100E0, "FVE_E_DEVICE_NOT_JOINED"},
+	{0x80320001, "FWP_E_CALLOUT_NOT_FOUND"},
+	{0x80320002, "FWP_E_CONDITION_NOT_FOUND"},
+	{0x80320003, "FWP_E_FILTER_NOT_FOUND"},
+	{0x80320004, "FWP_E_LAYER_NOT_FOUND"},
+	{0x80320005, "FWP_E_PROVIDER_NOT_FOUND"},
+	{0x80320006, "FWP_E_PROVIDER_CONTEXT_NOT_FOUND"},
+	{0x80320007, "FWP_E_SUBLAYER_NOT_FOUND"},
+	{0x80320008, "FWP_E_NOT_FOUND"},
+	{0x80320009, "FWP_E_ALREADY_EXISTS"},
+	{0x8032000A, "FWP_E_IN_USE"},
+	{0x8032000B, "FWP_E_DYNAMIC_SESSION_IN_PROGRESS"},
+	{0x8032000C, "FWP_E_WRONG_SESSION"},
+	{0x8032000D, "FWP_E_NO_TXN_IN_PROGRESS"},
+	{0x8032000E, "FWP_E_TXN_IN_PROGRESS"},
+	{0x8032000F, "FWP_E_TXN_ABORTED"},
+	{0x80320010, "FWP_E_SESSION_ABORTED"},
+	{0x80320011, "FWP_E_INCOMPATIBLE_TXN"},
+	{0x80320012, "FWP_E_TIMEOUT"},
+	{0x80320013, "FWP_E_NET_EVENTS_DISABLED"},
+	{0x80320014, "FWP_E_INCOMPATIBLE_LAYER"},
+	{0x80320015, "FWP_E_KM_CLIENTS_ONLY"},
+	{0x80320016, "FWP_E_LIFETIME_MISMATCH"},
+	{0x80320017, "FWP_E_BUILTIN_OBJECT"},
+	{0x80320018, "FWP_E_TOO_MANY_CALLOUTS"},
+	{0x80320019, "FWP_E_NOTIFICATION_DROPPED"},
+	{0x8032001A, "FWP_E_TRAFFIC_MISMATCH"},
+	{0x8032001B, "FWP_E_INCOMPATIBLE_SA_STATE"},
+	{0x8032001C, "FWP_E_NULL_POINTER"},
+	{0x8032001D, "FWP_E_INVALID_ENUMERATOR"},
+	{0x8032001E, "FWP_E_INVALID_FLAGS"},
+	{0x8032001F, "FWP_E_INVALID_NET_MASK"},
+	{0x80320020, "FWP_E_INVALID_RANGE"},
+	{0x80320021, "FWP_E_INVALID_INTERVAL"},
+	{0x80320022, "FWP_E_ZERO_LENGTH_ARRAY"},
+	{0x80320023, "FWP_E_NULL_DISPLAY_NAME"},
+	{0x80320024, "FWP_E_INVALID_ACTION_TYPE"},
+	{0x80320025, "FWP_E_INVALID_WEIGHT"},
+	{0x80320026, "FWP_E_MATCH_TYPE_MISMATCH"},
+	{0x80320027, "FWP_E_TYPE_MISMATCH"},
+	{0x80320028, "FWP_E_OUT_OF_BOUNDS"},
+	{0x80320029, "FWP_E_RESERVED"},
+	{0x8032002A, "FWP_E_DUPLICATE_CONDITION"},
+	{0x8032002B, "FWP_E_DUPLICATE_KEYMOD"},
+	{0x8032002C, "FWP_E_ACTION_INCOMPATIBLE_WITH_LAYER"},
+	{0x8032002D, "FWP_E_ACTION_INCOMPATIBLE_WITH_SUBLAYER"},
+	{0x8032002E, "FWP_E_CONTEXT_INCOMPATIBLE_WITH_LAYER"},
+	{0x8032002F, "FWP_E_CONTEXT_INCOMPATIBLE_WITH_CALLOUT"},
+	{0x80320030, "FWP_E_INCOMPATIBLE_AUTH_METHOD"},
+	{0x80320031, "FWP_E_INCOMPATIBLE_DH_GROUP"},
+	{0x80320032, "FWP_E_EM_NOT_SUPPORTED"},
+	{0x80320033, "FWP_E_NEVER_MATCH"},
+	{0x80320034, "FWP_E_PROVIDER_CONTEXT_MISMATCH"},
+	{0x80320035, "FWP_E_INVALID_PARAMETER"},
+	{0x80320036, "FWP_E_TOO_MANY_SUBLAYERS"},
+	{0x80320037, "FWP_E_CALLOUT_NOTIFICATION_FAILED"},
+	{0x80320038, "FWP_E_INVALID_AUTH_TRANSFORM"},
+	{0x80320039, "FWP_E_INVALID_CIPHER_TRANSFORM"},
+	{0x8032003A, "FWP_E_INCOMPATIBLE_CIPHER_TRANSFORM"},
+	{0x8032003B, "FWP_E_INVALID_TRANSFORM_COMBINATION"},
+	{0x8032003C, "FWP_E_DUPLICATE_AUTH_METHOD"},
+	{0x8032003D, "FWP_E_INVALID_TUNNEL_ENDPOINT"},
+	{0x8032003E, "FWP_E_L2_DRIVER_NOT_READY"},
+	{0x8032003F, "FWP_E_KEY_DICTATOR_ALREADY_REGISTERED"},
+	{0x80320040, "FWP_E_KEY_DICTATION_INVALID_KEYING_MATERIAL"},
+	{0x80320041, "FWP_E_CONNECTIONS_DISABLED"},
+	{0x80320042, "FWP_E_INVALID_DNS_NAME"},
+	{0x80320043, "FWP_E_STILL_ON"},
+	{0x80320044, "FWP_E_IKEEXT_NOT_RUNNING"},
+	{0x80320104, "FWP_E_DROP_NOICMP"},
+	{0x80370100, "HCS_E_TERMINATED_DURING_START"},
+	{0x80370101, "HCS_E_IMAGE_MISMATCH"},
+	{0x80370102, "HCS_E_HYPERV_NOT_INSTALLED"},
+	{0x80370105, "HCS_E_INVALID_STATE"},
+	{0x80370106, "HCS_E_UNEXPECTED_EXIT"},
+	{0x80370107, "HCS_E_TERMINATED"},
+	{0x80370108, "HCS_E_CONNECT_FAILED"},
+	{0x80370109, "HCS_E_CONNECTION_TIMEOUT"},
+	{0x8037010A, "HCS_E_CONNECTION_CLOSED"},
+	{0x8037010B, "HCS_E_UNKNOWN_MESSAGE"},
+	{0x8037010C, "HCS_E_UNSUPPORTED_PROTOCOL_VERSION"},
+	{0x8037010D, "HCS_E_INVALID_JSON"},
+	{0x8037010E, "HCS_E_SYSTEM_NOT_FOUND"},
+	{0x8037010F, "HCS_E_SYSTEM_ALREADY_EXISTS"},
+	{0x80370110, "HCS_E_SYSTEM_ALREADY_STOPPED"},
+	{0x80370111, "HCS_E_PROTOCOL_ERROR"},
+	{0x80370112, "HCS_E_INVALID_LAYER"},
+	{0x80370113, "HCS_E_WINDOWS_INSIDER_REQUIRED"},
+	{0x80370114, "HCS_E_SERVICE_NOT_AVAILABLE"},
+	{0x80370115, "HCS_E_OPERATION_NOT_STARTED"},
+	{0x80370116, "HCS_E_OPERATION_ALREADY_STARTED"},
+	{0x80370117, "HCS_E_OPERATION_PENDING"},
+	{0x80370118, "HCS_E_OPERATION_TIMEOUT"},
+	{0x80370119, "HCS_E_OPERATION_SYSTEM_CALLBACK_ALREADY_SET"},
+	{0x8037011A, "HCS_E_OPERATION_RESULT_ALLOCATION_FAILED"},
+	{0x8037011B, "HCS_E_ACCESS_DENIED"},
+	{0x8037011C, "HCS_E_GUEST_CRITICAL_ERROR"},
+	{0x80370300, "WHV_E_UNKNOWN_CAPABILITY"},
+	{0x80370301, "WHV_E_INSUFFICIENT_BUFFER"},
+	{0x80370302, "WHV_E_UNKNOWN_PROPERTY"},
+	{0x80370303, "WHV_E_UNSUPPORTED_HYPERVISOR_CONFIG"},
+	{0x80370304, "WHV_E_INVALID_PARTITION_CONFIG"},
+	{0x80370305, "WHV_E_GPA_RANGE_NOT_FOUND"},
+	{0x80370306, "WHV_E_VP_ALREADY_EXISTS"},
+	{0x80370307, "WHV_E_VP_DOES_NOT_EXIST"},
+	{0x80370308, "WHV_E_INVALID_VP_STATE"},
+	{0x80370309, "WHV_E_INVALID_VP_REGISTER_NAME"},
+	{0x803B0001, "HCN_E_NETWORK_NOT_FOUND"},
+	{0x803B0002, "HCN_E_ENDPOINT_NOT_FOUND"},
+	{0x803B0003, "HCN_E_LAYER_NOT_FOUND"},
+	{0x803B0004, "HCN_E_SWITCH_NOT_FOUND"},
+	{0x803B0005, "HCN_E_SUBNET_NOT_FOUND"},
+	{0x803B0006, "HCN_E_ADAPTER_NOT_FOUND"},
+	{0x803B0007, "HCN_E_PORT_NOT_FOUND"},
+	{0x803B0008, "HCN_E_POLICY_NOT_FOUND"},
+	{0x803B0009, "HCN_E_VFP_PORTSETTING_NOT_FOUND"},
+	{0x803B000A, "HCN_E_INVALID_NETWORK"},
+	{0x803B000B, "HCN_E_INVALID_NETWORK_TYPE"},
+	{0x803B000C, "HCN_E_INVALID_ENDPOINT"},
+	{0x803B000D, "HCN_E_INVALID_POLICY"},
+	{0x803B000E, "HCN_E_INVALID_POLICY_TYPE"},
+	{0x803B000F, "HCN_E_INVALID_REMOTE_ENDPOINT_OPERATION"},
+	{0x803B0010, "HCN_E_NETWORK_ALREADY_EXISTS"},
+	{0x803B0011, "HCN_E_LAYER_ALREADY_EXISTS"},
+	{0x803B0012, "HCN_E_POLICY_ALREADY_EXISTS"},
+	{0x803B0013, "HCN_E_PORT_ALREADY_EXISTS"},
+	{0x803B0014, "HCN_E_ENDPOINT_ALREADY_ATTACHED"},
+	{0x803B0015, "HCN_E_REQUEST_UNSUPPORTED"},
+	{0x803B0016, "HCN_E_MAPPING_NOT_SUPPORTED"},
+	{0x803B0017, "HCN_E_DEGRADED_OPERATION"},
+	{0x803B0018, "HCN_E_SHARED_SWITCH_MODIFICATION"},
+	{0x803B0019, "HCN_E_GUID_CONVERSION_FAILURE"},
+	{0x803B001A, "HCN_E_REGKEY_FAILURE"},
+	{0x803B001B, "HCN_E_INVALID_JSON"},
+	{0x803B001C, "HCN_E_INVALID_JSON_REFERENCE"},
+	{0x803B001D, "HCN_E_ENDPOINT_SHARING_DISABLED"},
+	{0x803B001E, "HCN_E_INVALID_IP"},
+	{0x803B001F, "HCN_E_SWITCH_EXTENSION_NOT_FOUND"},
+	{0x803B0020, "HCN_E_MANAGER_STOPPED"},
+	{0x803B0021, "GCN_E_MODULE_NOT_FOUND"},
+	{0x803B0022, "GCN_E_NO_REQUEST_HANDLERS"},
+	{0x803B0023, "GCN_E_REQUEST_UNSUPPORTED"},
+	{0x803B0024, "GCN_E_RUNTIMEKEYS_FAILED"},
+	{0x803B0025, "GCN_E_NETADAPTER_TIMEOUT"},
+	{0x803B0026, "GCN_E_NETADAPTER_NOT_FOUND"},
+	{0x803B0027, "GCN_E_NETCOMPARTMENT_NOT_FOUND"},
+	{0x803B0028, "GCN_E_NETINTERFACE_NOT_FOUND"},
+	{0x803B0029, "GCN_E_DEFAULTNAMESPACE_EXISTS"},
+	{0x803B002A, "HCN_E_ICS_DISABLED"},
+	{0x803B002B, "HCN_E_ENDPOINT_NAMESPACE_ALREADY_EXISTS"},
+	{0x803B002C, "HCN_E_ENTITY_HAS_REFERENCES"},
+	{0x803B002D, "HCN_E_INVALID_INTERNAL_PORT"},
+	{0x803B002E, "HCN_E_NAMESPACE_ATTACH_FAILED"},
+	{0x803B002F, "HCN_E_ADDR_INVALID_OR_RESERVED"},
+	{0x803D0000, "WS_E_INVALID_FORMAT"},
+	{0x803D0001, "WS_E_OBJECT_FAULTED"},
+	{0x803D0002, "WS_E_NUMERIC_OVERFLOW"},
+	{0x803D0003, "WS_E_INVALID_OPERATION"},
+	{0x803D0004, "WS_E_OPERATION_ABORTED"},
+	{0x803D0005, "WS_E_ENDPOINT_ACCESS_DENIED"},
+	{0x803D0006, "WS_E_OPERATION_TIMED_OUT"},
+	{0x803D0007, "WS_E_OPERATION_ABANDONED"},
+	{0x803D0008, "WS_E_QUOTA_EXCEEDED"},
+	{0x803D0009, "WS_E_NO_TRANSLATION_AVAILABLE"},
+	{0x803D000A, "WS_E_SECURITY_VERIFICATION_FAILURE"},
+	{0x803D000B, "WS_E_ADDRESS_IN_USE"},
+	{0x803D000C, "WS_E_ADDRESS_NOT_AVAILABLE"},
+	{0x803D000D, "WS_E_ENDPOINT_NOT_FOUND"},
+	{0x803D000E, "WS_E_ENDPOINT_NOT_AVAILABLE"},
+	{0x803D000F, "WS_E_ENDPOINT_FAILURE"},
+	{0x803D0010, "WS_E_ENDPOINT_UNREACHABLE"},
+	{0x803D0011, "WS_E_ENDPOINT_ACTION_NOT_SUPPORTED"},
+	{0x803D0012, "WS_E_ENDPOINT_TOO_BUSY"},
+	{0x803D0013, "WS_E_ENDPOINT_FAULT_RECEIVED"},
+	{0x803D0014, "WS_E_ENDPOINT_DISCONNECTED"},
+	{0x803D0015, "WS_E_PROXY_FAILURE"},
+	{0x803D0016, "WS_E_PROXY_ACCESS_DENIED"},
+	{0x803D0017, "WS_E_NOT_SUPPORTED"},
+	{0x803D0018, "WS_E_PROXY_REQUIRES_BASIC_AUTH"},
+	{0x803D0019, "WS_E_PROXY_REQUIRES_DIGEST_AUTH"},
+	{0x803D001A, "WS_E_PROXY_REQUIRES_NTLM_AUTH"},
+	{0x803D001B, "WS_E_PROXY_REQUIRES_NEGOTIATE_AUTH"},
+	{0x803D001C, "WS_E_SERVER_REQUIRES_BASIC_AUTH"},
+	{0x803D001D, "WS_E_SERVER_REQUIRES_DIGEST_AUTH"},
+	{0x803D001E, "WS_E_SERVER_REQUIRES_NTLM_AUTH"},
+	{0x803D001F, "WS_E_SERVER_REQUIRES_NEGOTIATE_AUTH"},
+	{0x803D0020, "WS_E_INVALID_ENDPOINT_URL"},
+	{0x803D0021, "WS_E_OTHER"},
+	{0x803D0022, "WS_E_SECURITY_TOKEN_EXPIRED"},
+	{0x803D0023, "WS_E_SECURITY_SYSTEM_FAILURE"},
+	{0x803E0100, "WPN_E_CHANNEL_CLOSED"},
+	{0x803E0101, "WPN_E_CHANNEL_REQUEST_NOT_COMPLETE"},
+	{0x803E0102, "WPN_E_INVALID_APP"},
+	{0x803E0103, "WPN_E_OUTSTANDING_CHANNEL_REQUEST"},
+	{0x803E0104, "WPN_E_DUPLICATE_CHANNEL"},
+	{0x803E0105, "WPN_E_PLATFORM_UNAVAILABLE"},
+	{0x803E0106, "WPN_E_NOTIFICATION_POSTED"},
+	{0x803E0107, "WPN_E_NOTIFICATION_HIDDEN"},
+	{0x803E0108, "WPN_E_NOTIFICATION_NOT_POSTED"},
+	{0x803E0109, "WPN_E_CLOUD_DISABLED"},
+	{0x803E0110, "WPN_E_CLOUD_INCAPABLE"},
+	{0x803E0111, "WPN_E_NOTIFICATION_DISABLED"},
+	{0x803E0112, "WPN_E_NOTIFICATION_INCAPABLE"},
+	{0x803E0113, "WPN_E_INTERNET_INCAPABLE"},
+	{0x803E0114, "WPN_E_NOTIFICATION_TYPE_DISABLED"},
+	{0x803E0115, "WPN_E_NOTIFICATION_SIZE"},
+	{0x803E0116, "WPN_E_TAG_SIZE"},
+	{0x803E0117, "WPN_E_ACCESS_DENIED"},
+	{0x803E0118, "WPN_E_DUPLICATE_REGISTRATION"},
+	{0x803E0119, "WPN_E_PUSH_NOTIFICATION_INCAPABLE"},
+	{0x803E011A, "WPN_E_CLOUD_AUTH_UNAVAILABLE"},
+	{0x803E011B, "WPN_E_CLOUD_SERVICE_UNAVAILABLE"},
+	{0x803E011C, "WPN_E_FAILED_LOCK_SCREEN_UPDATE_INTIALIZATION"},
+	{0x803E0120, "WPN_E_DEV_ID_SIZE"},
+	{0x803E012A, "WPN_E_TAG_ALPHANUMERIC"},
+	{0x803E012B, "WPN_E_INVALID_HTTP_STATUS_CODE"},
+	{0x803E0200, "WPN_E_OUT_OF_SESSION"},
+	{0x803E0201, "WPN_E_POWER_SAVE"},
+	{0x803E0202, "WPN_E_IMAGE_NOT_FOUND_IN_CACHE"},
+	{0x803E0203, "WPN_E_ALL_URL_NOT_COMPLETED"},
+	{0x803E0204, "WPN_E_INVALID_CLOUD_IMAGE"},
+	{0x803E0205, "WPN_E_NOTIFICATION_ID_MATCHED"},
+	{0x803E0206, "WPN_E_CALLBACK_ALREADY_REGISTERED"},
+	{0x803E0207, "WPN_E_TOAST_NOTIFICATION_DROPPED"},
+	{0x803E0208, "WPN_E_STORAGE_LOCKED"},
+	{0x803E0209, "WPN_E_GROUP_SIZE"},
+	{0x803E020A, "WPN_E_GROUP_ALPHANUMERIC"},
+	{0x803E020B, "WPN_E_CLOUD_DISABLED_FOR_APP"},
+	{0x80400000, "INPUT_E_OUT_OF_ORDER"},
+	{0x80400001, "INPUT_E_REENTRANCY"},
+	{0x80400002, "INPUT_E_MULTIMODAL"},
+	{0x80400003, "INPUT_E_PACKET"},
+	{0x80400004, "INPUT_E_FRAME"},
+	{0x80400005, "INPUT_E_HISTORY"},
+	{0x80400006, "INPUT_E_DEVICE_INFO"},
+	{0x80400007, "INPUT_E_TRANSFORM"},
+	{0x80400008, "INPUT_E_DEVICE_PROPERTY"},
+	{0x80548201, "E_MBN_CONTEXT_NOT_ACTIVATED"},
+	{0x80548202, "E_MBN_BAD_SIM"},
+	{0x80548203, "E_MBN_DATA_CLASS_NOT_AVAILABLE"},
+	{0x80548204, "E_MBN_INVALID_ACCESS_STRING"},
+	{0x80548205, "E_MBN_MAX_ACTIVATED_CONTEXTS"},
+	{0x80548206, "E_MBN_PACKET_SVC_DETACHED"},
+	{0x80548207, "E_MBN_PROVIDER_NOT_VISIBLE"},
+	{0x80548208, "E_MBN_RADIO_POWER_OFF"},
+	{0x80548209, "E_MBN_SERVICE_NOT_ACTIVATED"},
+	{0x8054820A, "E_MBN_SIM_NOT_INSERTED"},
+	{0x8054820B, "E_MBN_VOICE_CALL_IN_PROGRESS"},
+	{0x8054820C, "E_MBN_INVALID_CACHE"},
+	{0x8054820D, "E_MBN_NOT_REGISTERED"},
+	{0x8054820E, "E_MBN_PROVIDERS_NOT_FOUND"},
+	{0x8054820F, "E_MBN_PIN_NOT_SUPPORTED"},
+	{0x80548210, "E_MBN_PIN_REQUIRED"},
+	{0x80548211, "E_MBN_PIN_DISABLED"},
+	{0x80548212, "E_MBN_FAILURE"},
+	{0x80548218, "E_MBN_INVALID_PROFILE"},
+	{0x80548219, "E_MBN_DEFAULT_PROFILE_EXIST"},
+	{0x80548220, "E_MBN_SMS_ENCODING_NOT_SUPPORTED"},
+	{0x80548221, "E_MBN_SMS_FILTER_NOT_SUPPORTED"},
+	{0x80548222, "E_MBN_SMS_INVALID_MEMORY_INDEX"},
+	{0x80548223, "E_MBN_SMS_LANG_NOT_SUPPORTED"},
+	{0x80548224, "E_MBN_SMS_MEMORY_FAILURE"},
+	{0x80548225, "E_MBN_SMS_NETWORK_TIMEOUT"},
+	{0x80548226, "E_MBN_SMS_UNKNOWN_SMSC_ADDRESS"},
+	{0x80548227, "E_MBN_SMS_FORMAT_NOT_SUPPORTED"},
+	{0x80548228, "E_MBN_SMS_OPERATION_NOT_ALLOWED"},
+	{0x80548229, "E_MBN_SMS_MEMORY_FULL"},
+	{0x80550001, "EAS_E_POLICY_NOT_MANAGED_BY_OS"},
+	{0x80550002, "EAS_E_POLICY_COMPLIANT_WITH_ACTIONS"},
+	{0x80550003, "EAS_E_REQUESTED_POLICY_NOT_ENFORCEABLE"},
+	{0x80550004, "EAS_E_CURRENT_USER_HAS_BLANK_PASSWORD"},
+	{0x80550005, "EAS_E_REQUESTED_POLICY_PASSWORD_EXPIRATION_INCOMPATIBLE"},
+	{0x80550006, "EAS_E_USER_CANNOT_CHANGE_PASSWORD"},
+	{0x80550007, "EAS_E_ADMINS_HAVE_BLANK_PASSWORD"},
+	{0x80550008, "EAS_E_ADMINS_CANNOT_CHANGE_PASSWORD"},
+	{0x80550009, "EAS_E_LOCAL_CONTROLLED_USERS_CANNOT_CHANGE_PASSWORD"},
+	{0x8055000A, "EAS_E_PASSWORD_POLICY_NOT_ENFORCEABLE_FOR_CONNECTED_ADMINS"},
+	{0x8055000B, "EAS_E_CONNECTED_ADMINS_NEED_TO_CHANGE_PASSWORD"},
+	{0x8055000C, "EAS_E_PASSWORD_POLICY_NOT_ENFORCEABLE_FOR_CURRENT_CONNECTED_USER"},
+	{0x8055000D, "EAS_E_CURRENT_CONNECTED_USER_NEED_TO_CHANGE_PASSWORD"},
+	{0x80630001, "PEER_E_IPV6_NOT_INSTALLED"},
+	{0x80630002, "PEER_E_NOT_INITIALIZED"},
+	{0x80630003, "PEER_E_CANNOT_START_SERVICE"},
+	{0x80630004, "PEER_E_NOT_LICENSED"},
+	{0x80630010, "PEER_E_INVALID_GRAPH"},
+	{0x80630011, "PEER_E_DBNAME_CHANGED"},
+	{0x80630012, "PEER_E_DUPLICATE_GRAPH"},
+	{0x80630013, "PEER_E_GRAPH_NOT_READY"},
+	{0x80630014, "PEER_E_GRAPH_SHUTTING_DOWN"},
+	{0x80630015, "PEER_E_GRAPH_IN_USE"},
+	{0x80630016, "PEER_E_INVALID_DATABASE"},
+	{0x80630017, "PEER_E_TOO_MANY_ATTRIBUTES"},
+	{0x80630103, "PEER_E_CONNECTION_NOT_FOUND"},
+	{0x80630106, "PEER_E_CONNECT_SELF"},
+	{0x80630107, "PEER_E_ALREADY_LISTENING"},
+	{0x80630108, "PEER_E_NODE_NOT_FOUND"},
+	{0x80630109, "PEER_E_CONNECTION_FAILED"},
+	{0x8063010A, "PEER_E_CONNECTION_NOT_AUTHENTICATED"},
+	{0x8063010B, "PEER_E_CONNECTION_REFUSED"},
+	{0x80630201, "PEER_E_CLASSIFIER_TOO_LONG"},
+	{0x80630202, "PEER_E_TOO_MANY_IDENTITIES"},
+	{0x80630203, "PEER_E_NO_KEY_ACCESS"},
+	{0x80630204, "PEER_E_GROUPS_EXIST"},
+	{0x80630301, "PEER_E_RECORD_NOT_FOUND"},
+	{0x80630302, "PEER_E_DATABASE_ACCESSDENIED"},
+	{0x80630303, "PEER_E_DBINITIALIZATION_FAILED"},
+	{0x80630304, "PEER_E_MAX_RECORD_SIZE_EXCEEDED"},
+	{0x80630305, "PEER_E_DATABASE_ALREADY_PRESENT"},
+	{0x80630306, "PEER_E_DATABASE_NOT_PRESENT"},
+	{0x80630401, "PEER_E_IDENTITY_NOT_FOUND"},
+	{0x80630501, "PEER_E_EVENT_HANDLE_NOT_FOUND"},
+	{0x80630601, "PEER_E_INVALID_SEARCH"},
+	{0x80630602, "PEER_E_INVALID_ATTRIBUTES"},
+	{0x80630701, "PEER_E_INVITATION_NOT_TRUSTED"},
+	{0x80630703, "PEER_E_CHAIN_TOO_LONG"},
+	{0x80630705, "PEER_E_INVALID_TIME_PERIOD"},
+	{0x80630706, "PEER_E_CIRCULAR_CHAIN_DETECTED"},
+	{0x80630801, "PEER_E_CERT_STORE_CORRUPTED"},
+	{0x80631001, "PEER_E_NO_CLOUD"},
+	{0x80631005, "PEER_E_CLOUD_NAME_AMBIGUOUS"},
+	{0x80632010, "PEER_E_INVALID_RECORD"},
+	{0x80632020, "PEER_E_NOT_AUTHORIZED"},
+	{0x80632021, "PEER_E_PASSWORD_DOES_NOT_MEET_POLICY"},
+	{0x80632030, "PEER_E_DEFERRED_VALIDATION"},
+	{0x80632040, "PEER_E_INVALID_GROUP_PROPERTIES"},
+	{0x80632050, "PEER_E_INVALID_PEER_NAME"},
+	{0x80632060, "PEER_E_INVALID_CLASSIFIER"},
+	{0x80632070, "PEER_E_INVALID_FRIENDLY_NAME"},
+	{0x80632071, "PEER_E_INVALID_ROLE_PROPERTY"},
+	{0x80632072, "PEER_E_INVALID_CLASSIFIER_PROPERTY"},
+	{0x80632080, "PEER_E_INVALID_RECORD_EXPIRATION"},
+	{0x80632081, "PEER_E_INVALID_CREDENTIAL_INFO"},
+	{0x80632082, "PEER_E_INVALID_CREDENTIAL"},
+	{0x80632083, "PEER_E_INVALID_RECORD_SIZE"},
+	{0x80632090, "PEER_E_UNSUPPORTED_VERSION"},
+	{0x80632091, "PEER_E_GROUP_NOT_READY"},
+	{0x80632092, "PEER_E_GROUP_IN_USE"},
+	{0x80632093, "PEER_E_INVALID_GROUP"},
+	{0x80632094, "PEER_E_NO_MEMBERS_FOUND"},
+	{0x80632095, "PEER_E_NO_MEMBER_CONNECTIONS"},
+	{0x80632096, "PEER_E_UNABLE_TO_LISTEN"},
+	{0x806320A0, "PEER_E_IDENTITY_DELETED"},
+	{0x806320A1, "PEER_E_SERVICE_NOT_AVAILABLE"},
+	{0x80634001, "PEER_E_CANNOT_CONVERT_PEER_NAME"},
+	{0x80634002, "PEER_E_INVALID_PEER_HOST_NAME"},
+	{0x80634003, "PEER_E_NO_MORE"},
+	{0x80634005, "PEER_E_PNRP_DUPLICATE_PEER_NAME"},
+	{0x80636001, "PEER_E_CONTACT_NOT_FOUND"},
+	{0x80637000, "PEER_E_INVITE_CANCELLED"},
+	{0x80637001, "PEER_E_INVITE_RESPONSE_NOT_AVAILABLE"},
+	{0x80637003, "PEER_E_NOT_SIGNED_IN"},
+	{0x80637004, "PEER_E_PRIVACY_DECLINED"},
+	{0x80637005, "PEER_E_TIMEOUT"},
+	{0x80637007, "PEER_E_INVALID_ADDRESS"},
+	{0x80637008, "PEER_E_FW_EXCEPTION_DISABLED"},
+	{0x80637009, "PEER_E_FW_BLOCKED_BY_POLICY"},
+	{0x8063700A, "PEER_E_FW_BLOCKED_BY_SHIELDS_UP"},
+	{0x8063700B, "PEER_E_FW_DECLINED"},
+	{0x80650001, "E_BLUETOOTH_ATT_INVALID_HANDLE"},
+	{0x80650002, "E_BLUETOOTH_ATT_READ_NOT_PERMITTED"},
+	{0x80650003, "E_BLUETOOTH_ATT_WRITE_NOT_PERMITTED"},
+	{0x80650004, "E_BLUETOOTH_ATT_INVALID_PDU"},
+	{0x80650005, "E_BLUETOOTH_ATT_INSUFFICIENT_AUTHENTICATION"},
+	{0x80650006, "E_BLUETOOTH_ATT_REQUEST_NOT_SUPPORTED"},
+	{0x80650007, "E_BLUETOOTH_ATT_INVALID_OFFSET"},
+	{0x80650008, "E_BLUETOOTH_ATT_INSUFFICIENT_AUTHORIZATION"},
+	{0x80650009, "E_BLUETOOTH_ATT_PREPARE_QUEUE_FULL"},
+	{0x8065000A, "E_BLUETOOTH_ATT_ATTRIBUTE_NOT_FOUND"},
+	{0x8065000B, "E_BLUETOOTH_ATT_ATTRIBUTE_NOT_LONG"},
+	{0x8065000C, "E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE"},
+	{0x8065000D, "E_BLUETOOTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH"},
+	{0x8065000E, "E_BLUETOOTH_ATT_UNLIKELY"},
+	{0x8065000F, "E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION"},
+	{0x80650010, "E_BLUETOOTH_ATT_UNSUPPORTED_GROUP_TYPE"},
+	{0x80650011, "E_BLUETOOTH_ATT_INSUFFICIENT_RESOURCES"},
+	{0x80651000, "E_BLUETOOTH_ATT_UNKNOWN_ERROR"},
+	{0x80660001, "E_AUDIO_ENGINE_NODE_NOT_FOUND"},
+	{0x80660002, "E_HDAUDIO_EMPTY_CONNECTION_LIST"},
+	{0x80660003, "E_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED"},
+	{0x80660004, "E_HDAUDIO_NO_LOGICAL_DEVICES_CREATED"},
+	{0x80660005, "E_HDAUDIO_NULL_LINKED_LIST_ENTRY"},
+	{0x80670001, "STATEREPOSITORY_E_CONCURRENCY_LOCKING_FAILURE"},
+	{0x80670002, "STATEREPOSITORY_E_STATEMENT_INPROGRESS"},
+	{0x80670003, "STATEREPOSITORY_E_CONFIGURATION_INVALID"},
+	{0x80670004, "STATEREPOSITORY_E_UNKNOWN_SCHEMA_VERSION"},
+	{0x80670005, "STATEREPOSITORY_ERROR_DICTIONARY_CORRUPTED"},
+	{0x80670006, "STATEREPOSITORY_E_BLOCKED"},
+	{0x80670007, "STATEREPOSITORY_E_BUSY_RETRY"},
+	{0x80670008, "STATEREPOSITORY_E_BUSY_RECOVERY_RETRY"},
+	{0x80670009, "STATEREPOSITORY_E_LOCKED_RETRY"},
+	{0x8067000A, "STATEREPOSITORY_E_LOCKED_SHAREDCACHE_RETRY"},
+	{0x8067000B, "STATEREPOSITORY_E_TRANSACTION_REQUIRED"},
+	{0x8067000C, "STATEREPOSITORY_E_BUSY_TIMEOUT_EXCEEDED"},
+	{0x8067000D, "STATEREPOSITORY_E_BUSY_RECOVERY_TIMEOUT_EXCEEDED"},
+	{0x8067000E, "STATEREPOSITORY_E_LOCKED_TIMEOUT_EXCEEDED"},
+	{0x8067000F, "STATEREPOSITORY_E_LOCKED_SHAREDCACHE_TIMEOUT_EXCEEDED"},
+	{0x80670010, "STATEREPOSITORY_E_SERVICE_STOP_IN_PROGRESS"},
+	{0x80670011, "STATEREPOSTORY_E_NESTED_TRANSACTION_NOT_SUPPORTED"},
+	{0x80670012, "STATEREPOSITORY_ERROR_CACHE_CORRUPTED"},
+	{0x80820001, "ERROR_VOLSNAP_BOOTFILE_NOT_VALID"},
+	{0x80820002, "ERROR_VOLSNAP_ACTIVATION_TIMEOUT"},
+	{0x80830001, "ERROR_TIERING_NOT_SUPPORTED_ON_VOLUME"},
+	{0x80830002, "ERROR_TIERING_VOLUME_DISMOUNT_IN_PROGRESS"},
+	{0x80830003, "ERROR_TIERING_STORAGE_TIER_NOT_FOUND"},
+	{0x80830004, "ERROR_TIERING_INVALID_FILE_ID"},
+	{0x80830005, "ERROR_TIERING_WRONG_CLUSTER_NODE"},
+	{0x80830006, "ERROR_TIERING_ALREADY_PROCESSING"},
+	{0x80830007, "ERROR_TIERING_CANNOT_PIN_OBJECT"},
+	{0x80830008, "ERROR_TIERING_FILE_IS_NOT_PINNED"},
+	{0x80830009, "ERROR_NOT_A_TIERED_VOLUME"},
+	{0x8083000A, "ERROR_ATTRIBUTE_NOT_PRESENT"},
+	{0x80860001, "ONL_E_INVALID_AUTHENTICATION_TARGET"},
+	{0x80860002, "ONL_E_ACCESS_DENIED_BY_TOU"},
+	{0x80860003, "ONL_E_INVALID_APPLICATION"},
+	{0x80860004, "ONL_E_PASSWORD_UPDATE_REQUIRED"},
+	{0x80860005, "ONL_E_ACCOUNT_UPDATE_REQUIRED"},
+	{0x80860006, "ONL_E_FORCESIGNIN"},
+	{0x80860007, "ONL_E_ACCOUNT_LOCKED"},
+	{0x80860008, "ONL_E_PARENTAL_CONSENT_REQUIRED"},
+	{0x80860009, "ONL_E_EMAIL_VERIFICATION_REQUIRED"},
+	{0x8086000A, "ONL_E_ACCOUNT_SUSPENDED_COMPROIMISE"},
+	{0x8086000B, "ONL_E_ACCOUNT_SUSPENDED_ABUSE"},
+	{0x8086000C, "ONL_E_ACTION_REQUIRED"},
+	{0x8086000D, "ONL_CONNECTION_COUNT_LIMIT"},
+	{0x8086000E, "ONL_E_CONNECTED_ACCOUNT_CAN_NOT_SIGNOUT"},
+	{0x8086000F, "ONL_E_USER_AUTHENTICATION_REQUIRED"},
+	{0x80860010, "ONL_E_REQUEST_THROTTLED"},
+	{0x80B00001, "ERROR_DBG_CREATE_PROCESS_FAILURE_LOCKDOWN"},
+	{0x80B00002, "ERROR_DBG_ATTACH_PROCESS_FAILURE_LOCKDOWN"},
+	{0x80B00003, "ERROR_DBG_CONNECT_SERVER_FAILURE_LOCKDOWN"},
+	{0x80B00004, "ERROR_DBG_START_SERVER_FAILURE_LOCKDOWN"},
+	{0x80E70001, "ERROR_SPACES_FAULT_DOMAIN_TYPE_INVALID"},
+	{0x80E70002, "ERROR_SPACES_INTERNAL_ERROR"},
+	{0x80E70003, "ERROR_SPACES_RESILIENCY_TYPE_INVALID"},
+	{0x80E70004, "ERROR_SPACES_DRIVE_SECTOR_SIZE_INVALID"},
+	{0x80E70006, "ERROR_SPACES_DRIVE_REDUNDANCY_INVALID"},
+	{0x80E70007, "ERROR_SPACES_NUMBER_OF_DATA_COPIES_INVALID"},
+	{0x80E70008, "ERROR_SPACES_PARITY_LAYOUT_INVALID"},
+	{0x80E70009, "ERROR_SPACES_INTERLEAVE_LENGTH_INVALID"},
+	{0x80E7000A, "ERROR_SPACES_NUMBER_OF_COLUMNS_INVALID"},
+	{0x80E7000B, "ERROR_SPACES_NOT_ENOUGH_DRIVES"},
+	{0x80E7000C, "ERROR_SPACES_EXTENDED_ERROR"},
+	{0x80E7000D, "ERROR_SPACES_PROVISIONING_TYPE_INVALID"},
+	{0x80E7000E, "ERROR_SPACES_ALLOCATION_SIZE_INVALID"},
+	{0x80E7000F, "ERROR_SPACES_ENCLOSURE_AWARE_INVALID"},
+	{0x80E70010, "ERROR_SPACES_WRITE_CACHE_SIZE_INVALID"},
+	{0x80E70011, "ERROR_SPACES_NUMBER_OF_GROUPS_INVALID"},
+	{0x80E70012, "ERROR_SPACES_DRIVE_OPERATIONAL_STATE_INVALID"},
+	{0x80E70013, "ERROR_SPACES_ENTRY_INCOMPLETE"},
+	{0x80E70014, "ERROR_SPACES_ENTRY_INVALID"},
+	{0x83750001, "WEB_E_UNSUPPORTED_FORMAT"},
+	{0x83750002, "WEB_E_INVALID_XML"},
+	{0x83750003, "WEB_E_MISSING_REQUIRED_ELEMENT"},
+	{0x83750004, "WEB_E_MISSING_REQUIRED_ATTRIBUTE"},
+	{0x83750005, "WEB_E_UNEXPECTED_CONTENT"},
+	{0x83750006, "WEB_E_RESOURCE_TOO_LARGE"},
+	{0x83750007, "WEB_E_INVALID_JSON_STRING"},
+	{0x83750008, "WEB_E_INVALID_JSON_NUMBER"},
+	{0x83750009, "WEB_E_JSON_VALUE_NOT_FOUND"},
+	{0x83760001, "E_INVALID_PROTOCOL_OPERATION"},
+	{0x83760002, "E_INVALID_PROTOCOL_FORMAT"},
+	{0x83760003, "E_PROTOCOL_EXTENSIONS_NOT_SUPPORTED"},
+	{0x83760004, "E_SUBPROTOCOL_NOT_SUPPORTED"},
+	{0x83760005, "E_PROTOCOL_VERSION_NOT_SUPPORTED"},
+	{0x87AF0001, "SQLITE_E_ERROR"},
+	{0x87AF0002, "SQLITE_E_INTERNAL"},
+	{0x87AF0003, "SQLITE_E_PERM"},
+	{0x87AF0004, "SQLITE_E_ABORT"},
+	{0x87AF0005, "SQLITE_E_BUSY"},
+	{0x87AF0006, "SQLITE_E_LOCKED"},
+	{0x87AF0007, "SQLITE_E_NOMEM"},
+	{0x87AF0008, "SQLITE_E_READONLY"},
+	{0x87AF0009, "SQLITE_E_INTERRUPT"},
+	{0x87AF000A, "SQLITE_E_IOERR"},
+	{0x87AF000B, "SQLITE_E_CORRUPT"},
+	{0x87AF000C, "SQLITE_E_NOTFOUND"},
+	{0x87AF000D, "SQLITE_E_FULL"},
+	{0x87AF000E, "SQLITE_E_CANTOPEN"},
+	{0x87AF000F, "SQLITE_E_PROTOCOL"},
+	{0x87AF0010, "SQLITE_E_EMPTY"},
+	{0x87AF0011, "SQLITE_E_SCHEMA"},
+	{0x87AF0012, "SQLITE_E_TOOBIG"},
+	{0x87AF0013, "SQLITE_E_CONSTRAINT"},
+	{0x87AF0014, "SQLITE_E_MISMATCH"},
+	{0x87AF0015, "SQLITE_E_MISUSE"},
+	{0x87AF0016, "SQLITE_E_NOLFS"},
+	{0x87AF0017, "SQLITE_E_AUTH"},
+	{0x87AF0018, "SQLITE_E_FORMAT"},
+	{0x87AF0019, "SQLITE_E_RANGE"},
+	{0x87AF001A, "SQLITE_E_NOTADB"},
+	{0x87AF001B, "SQLITE_E_NOTICE"},
+	{0x87AF001C, "SQLITE_E_WARNING"},
+	{0x87AF0064, "SQLITE_E_ROW"},
+	{0x87AF0065, "SQLITE_E_DONE"},
+	{0x87AF0105, "SQLITE_E_BUSY_RECOVERY"},
+	{0x87AF0106, "SQLITE_E_LOCKED_SHAREDCACHE"},
+	{0x87AF0108, "SQLITE_E_READONLY_RECOVERY"},
+	{0x87AF010A, "SQLITE_E_IOERR_READ"},
+	{0x87AF010B, "SQLITE_E_CORRUPT_VTAB"},
+	{0x87AF010E, "SQLITE_E_CANTOPEN_NOTEMPDIR"},
+	{0x87AF0113, "SQLITE_E_CONSTRAINT_CHECK"},
+	{0x87AF011B, "SQLITE_E_NOTICE_RECOVER_WAL"},
+	{0x87AF011C, "SQLITE_E_WARNING_AUTOINDEX"},
+	{0x87AF0204, "SQLITE_E_ABORT_ROLLBACK"},
+	{0x87AF0205, "SQLITE_E_BUSY_SNAPSHOT"},
+	{0x87AF0208, "SQLITE_E_READONLY_CANTLOCK"},
+	{0x87AF020A, "SQLITE_E_IOERR_SHORT_READ"},
+	{0x87AF020E, "SQLITE_E_CANTOPEN_ISDIR"},
+	{0x87AF0213, "SQLITE_E_CONSTRAINT_COMMITHOOK"},
+	{0x87AF021B, "SQLITE_E_NOTICE_RECOVER_ROLLBACK"},
+	{0x87AF0308, "SQLITE_E_READONLY_ROLLBACK"},
+	{0x87AF030A, "SQLITE_E_IOERR_WRITE"},
+	{0x87AF030E, "SQLITE_E_CANTOPEN_FULLPATH"},
+	{0x87AF0313, "SQLITE_E_CONSTRAINT_FOREIGNKEY"},
+	{0x87AF0408, "SQLITE_E_READONLY_DBMOVED"},
+	{0x87AF040A, "SQLITE_E_IOERR_FSYNC"},
+	{0x87AF040E, "SQLITE_E_CANTOPEN_CONVPATH"},
+	{0x87AF0413, "SQLITE_E_CONSTRAINT_FUNCTION"},
+	{0x87AF050A, "SQLITE_E_IOERR_DIR_FSYNC"},
+	{0x87AF0513, "SQLITE_E_CONSTRAINT_NOTNULL"},
+	{0x87AF060A, "SQLITE_E_IOERR_TRUNCATE"},
+	{0x87AF0613, "SQLITE_E_CONSTRAINT_PRIMARYKEY"},
+	{0x87AF070A, "SQLITE_E_IOERR_FSTAT"},
+	{0x87AF0713, "SQLITE_E_CONSTRAINT_TRIGGER"},
+	{0x87AF080A, "SQLITE_E_IOERR_UNLOCK"},
+	{0x87AF0813, "SQLITE_E_CONSTRAINT_UNIQUE"},
+	{0x87AF090A, "SQLITE_E_IOERR_RDLOCK"},
+	{0x87AF0913, "SQLITE_E_CONSTRAINT_VTAB"},
+	{0x87AF0A0A, "SQLITE_E_IOERR_DELETE"},
+	{0x87AF0A13, "SQLITE_E_CONSTRAINT_ROWID"},
+	{0x87AF0B0A, "SQLITE_E_IOERR_BLOCKED"},
+	{0x87AF0C0A, "SQLITE_E_IOERR_NOMEM"},
+	{0x87AF0D0A, "SQLITE_E_IOERR_ACCESS"},
+	{0x87AF0E0A, "SQLITE_E_IOERR_CHECKRESERVEDLOCK"},
+	{0x87AF0F0A, "SQLITE_E_IOERR_LOCK"},
+	{0x87AF100A, "SQLITE_E_IOERR_CLOSE"},
+	{0x87AF110A, "SQLITE_E_IOERR_DIR_CLOSE"},
+	{0x87AF120A, "SQLITE_E_IOERR_SHMOPEN"},
+	{0x87AF130A, "SQLITE_E_IOERR_SHMSIZE"},
+	{0x87AF140A, "SQLITE_E_IOERR_SHMLOCK"},
+	{0x87AF150A, "SQLITE_E_IOERR_SHMMAP"},
+	{0x87AF160A, "SQLITE_E_IOERR_SEEK"},
+	{0x87AF170A, "SQLITE_E_IOERR_DELETE_NOENT"},
+	{0x87AF180A, "SQLITE_E_IOERR_MMAP"},
+	{0x87AF190A, "SQLITE_E_IOERR_GETTEMPPATH"},
+	{0x87AF1A02, "SQLITE_E_IOERR_VNODE"},
+	{0x87AF1A03, "SQLITE_E_IOERR_AUTH"},
+	{0x87AF1A0A, "SQLITE_E_IOERR_CONVPATH"},
+	{0x87C51001, "UTC_E_TOGGLE_TRACE_STARTED"},
+	{0x87C51002, "UTC_E_ALTERNATIVE_TRACE_CANNOT_PREEMPT"},
+	{0x87C51003, "UTC_E_AOT_NOT_RUNNING"},
+	{0x87C51004, "UTC_E_SCRIPT_TYPE_INVALID"},
+	{0x87C51005, "UTC_E_SCENARIODEF_NOT_FOUND"},
+	{0x87C51006, "UTC_E_TRACEPROFILE_NOT_FOUND"},
+	{0x87C51007, "UTC_E_FORWARDER_ALREADY_ENABLED"},
+	{0x87C51008, "UTC_E_FORWARDER_ALREADY_DISABLED"},
+	{0x87C51009, "UTC_E_EVENTLOG_ENTRY_MALFORMED"},
+	{0x87C5100A, "UTC_E_DIAGRULES_SCHEMAVERSION_MISMATCH"},
+	{0x87C5100B, "UTC_E_SCRIPT_TERMINATED"},
+	{0x87C5100C, "UTC_E_INVALID_CUSTOM_FILTER"},
+	{0x87C5100D, "UTC_E_TRACE_NOT_RUNNING"},
+	{0x87C5100E, "UTC_E_REESCALATED_TOO_QUICKLY"},
+	{0x87C5100F, "UTC_E_ESCALATION_ALREADY_RUNNING"},
+	{0x87C51010, "UTC_E_PERFTRACK_ALREADY_TRACING"},
+	{0x87C51011, "UTC_E_REACHED_MAX_ESCALATIONS"},
+	{0x87C51012, "UTC_E_FORWARDER_PRODUCER_MISMATCH"},
+	{0x87C51013, "UTC_E_INTENTIONAL_SCRIPT_FAILURE"},
+	{0x87C51014, "UTC_E_SQM_INIT_FAILED"},
+	{0x87C51015, "UTC_E_NO_WER_LOGGER_SUPPORTED"},
+	{0x87C51016, "UTC_E_TRACERS_DONT_EXIST"},
+	{0x87C51017, "UTC_E_WINRT_INIT_FAILED"},
+	{0x87C51018, "UTC_E_SCENARIODEF_SCHEMAVERSION_MISMATCH"},
+	{0x87C51019, "UTC_E_INVALID_FILTER"},
+	{0x87C5101A, "UTC_E_EXE_TERMINATED"},
+	{0x87C5101B, "UTC_E_ESCALATION_NOT_AUTHORIZED"},
+	{0x87C5101C, "UTC_E_SETUP_NOT_AUTHORIZED"},
+	{0x87C5101D, "UTC_E_CHILD_PROCESS_FAILED"},
+	{0x87C5101E, "UTC_E_COMMAND_LINE_NOT_AUTHORIZED"},
+	{0x87C5101F, "UTC_E_CANNOT_LOAD_SCENARIO_EDITOR_XML"},
+	{0x87C51020, "UTC_E_ESCALATION_TIMED_OUT"},
+	{0x87C51021, "UTC_E_SETUP_TIMED_OUT"},
+	{0x87C51022, "UTC_E_TRIGGER_MISMATCH"},
+	{0x87C51023, "UTC_E_TRIGGER_NOT_FOUND"},
+	{0x87C51024, "UTC_E_SIF_NOT_SUPPORTED"},
+	{0x87C51025, "UTC_E_DELAY_TERMINATED"},
+	{0x87C51026, "UTC_E_DEVICE_TICKET_ERROR"},
+	{0x87C51027, "UTC_E_TRACE_BUFFER_LIMIT_EXCEEDED"},
+	{0x87C51028, "UTC_E_API_RESULT_UNAVAILABLE"},
+	{0x87C51029, "UTC_E_RPC_TIMEOUT"},
+	{0x87C5102A, "UTC_E_RPC_WAIT_FAILED"},
+	{0x87C5102B, "UTC_E_API_BUSY"},
+	{0x87C5102C, "UTC_E_TRACE_MIN_DURATION_REQUIREMENT_NOT_MET"},
+	{0x87C5102D, "UTC_E_EXCLUSIVITY_NOT_AVAILABLE"},
+	{0x87C5102E, "UTC_E_GETFILE_FILE_PATH_NOT_APPROVED"},
+	{0x87C5102F, "UTC_E_ESCALATION_DIRECTORY_ALREADY_EXISTS"},
+	{0x87C51030, "UTC_E_TIME_TRIGGER_ON_START_INVALID"},
+	{0x87C51031, "UTC_E_TIME_TRIGGER_ONLY_VALID_ON_SINGLE_TRANSITION"},
+	{0x87C51032, "UTC_E_TIME_TRIGGER_INVALID_TIME_RANGE"},
+	{0x87C51033, "UTC_E_MULTIPLE_TIME_TRIGGER_ON_SINGLE_STATE"},
+	{0x87C51034, "UTC_E_BINARY_MISSING"},
+	{0x87C51035, "UTC_E_NETWORK_CAPTURE_NOT_ALLOWED"},
+	{0x87C51036, "UTC_E_FAILED_TO_RESOLVE_CONTAINER_ID"},
+	{0x87C51037, "UTC_E_UNABLE_TO_RESOLVE_SESSION"},
+	{0x87C51038, "UTC_E_THROTTLED"},
+	{0x87C51039, "UTC_E_UNAPPROVED_SCRIPT"},
+	{0x87C5103A, "UTC_E_SCRIPT_MISSING"},
+	{0x87C5103B, "UTC_E_SCENARIO_THROTTLED"},
+	{0x87C5103C, "UTC_E_API_NOT_SUPPORTED"},
+	{0x87C5103D, "UTC_E_GETFILE_EXTERNAL_PATH_NOT_APPROVED"},
+	{0x87C5103E, "UTC_E_TRY_GET_SCENARIO_TIMEOUT_EXCEEDED"},
+	{0x87C5103F, "UTC_E_CERT_REV_FAILED"},
+	{0x87C51040, "UTC_E_FAILED_TO_START_NDISCAP"},
+	{0x87C51041, "UTC_E_KERNELDUMP_LIMIT_REACHED"},
+	{0x87C51042, "UTC_E_MISSING_AGGREGATE_EVENT_TAG"},
+	{0x87C51043, "UTC_E_INVALID_AGGREGATION_STRUCT"},
+	{0x87C51044, "UTC_E_ACTION_NOT_SUPPORTED_IN_DESTINATION"},
+	{0x87C51045, "UTC_E_FILTER_MISSING_ATTRIBUTE"},
+	{0x87C51046, "UTC_E_FILTER_INVALID_TYPE"},
+	{0x87C51047, "UTC_E_FILTER_VARIABLE_NOT_FOUND"},
+	{0x87C51048, "UTC_E_FILTER_FUNCTION_RESTRICTED"},
+	{0x87C51049, "UTC_E_FILTER_VERSION_MISMATCH"},
+	{0x87C51050, "UTC_E_FILTER_INVALID_FUNCTION"},
+	{0x87C51051, "UTC_E_FILTER_INVALID_FUNCTION_PARAMS"},
+	{0x87C51052, "UTC_E_FILTER_INVALID_COMMAND"},
+	{0x87C51053, "UTC_E_FILTER_ILLEGAL_EVAL"},
+	{0x87C51054, "UTC_E_TTTRACER_RETURNED_ERROR"},
+	{0x87C51055, "UTC_E_AGENT_DIAGNOSTICS_TOO_LARGE"},
+	{0x87C51056, "UTC_E_FAILED_TO_RECEIVE_AGENT_DIAGNOSTICS"},
+	{0x87C51057, "UTC_E_SCENARIO_HAS_NO_ACTIONS"},
+	{0x87C51058, "UTC_E_TTTRACER_STORAGE_FULL"},
+	{0x87C51059, "UTC_E_INSUFFICIENT_SPACE_TO_START_TRACE"},
+	{0x87C5105A, "UTC_E_ESCALATION_CANCELLED_AT_SHUTDOWN"},
+	{0x87C5105B, "UTC_E_GETFILEINFOACTION_FILE_NOT_APPROVED"},
+	{0x87C5105C, "UTC_E_SETREGKEYACTION_TYPE_NOT_APPROVED"},
+	{0x88010001, "WEP_E_NOT_PROVISIONED_ON_ALL_VOLUMES"},
+	{0x88010002, "WEP_E_FIXED_DATA_NOT_SUPPORTED"},
+	{0x88010003, "WEP_E_HARDWARE_NOT_COMPLIANT"},
+	{0x88010004, "WEP_E_LOCK_NOT_CONFIGURED"},
+	{0x88010005, "WEP_E_PROTECTION_SUSPENDED"},
+	{0x88010006, "WEP_E_NO_LICENSE"},
+	{0x88010007, "WEP_E_OS_NOT_PROTECTED"},
+	{0x88010008, "WEP_E_UNEXPECTED_FAIL"},
+	{0x88010009, "WEP_E_BUFFER_TOO_LARGE"},
+	{0x8802B001, "E_SYNCENGINE_FILE_SIZE_OVER_LIMIT"},
+	{0x8802B002, "E_SYNCENGINE_FILE_SIZE_EXCEEDS_REMAINING_QUOTA"},
+	{0x8802B003, "E_SYNCENGINE_UNSUPPORTED_FILE_NAME"},
+	{0x8802B004, "E_SYNCENGINE_FOLDER_ITEM_COUNT_LIMIT_EXCEEDED"},
+	{0x8802B005, "E_SYNCENGINE_FILE_SYNC_PARTNER_ERROR"},
+	{0x8802B006, "E_SYNCENGINE_SYNC_PAUSED_BY_SERVICE"},
+	{0x8802C002, "E_SYNCENGINE_FILE_IDENTIFIER_UNKNOWN"},
+	{0x8802C003, "E_SYNCENGINE_SERVICE_AUTHENTICATION_FAILED"},
+	{0x8802C004, "E_SYNCENGINE_UNKNOWN_SERVICE_ERROR"},
+	{0x8802C005, "E_SYNCENGINE_SERVICE_RETURNED_UNEXPECTED_SIZE"},
+	{0x8802C006, "E_SYNCENGINE_REQUEST_BLOCKED_BY_SERVICE"},
+	{0x8802C007, "E_SYNCENGINE_REQUEST_BLOCKED_DUE_TO_CLIENT_ERROR"},
+	{0x8802D001, "E_SYNCENGINE_FOLDER_INACCESSIBLE"},
+	{0x8802D002, "E_SYNCENGINE_UNSUPPORTED_FOLDER_NAME"},
+	{0x8802D003, "E_SYNCENGINE_UNSUPPORTED_MARKET"},
+	{0x8802D004, "E_SYNCENGINE_PATH_LENGTH_LIMIT_EXCEEDED"},
+	{0x8802D005, "E_SYNCENGINE_REMOTE_PATH_LENGTH_LIMIT_EXCEEDED"},
+	{0x8802D006, "E_SYNCENGINE_CLIENT_UPDATE_NEEDED"},
+	{0x8802D007, "E_SYNCENGINE_PROXY_AUTHENTICATION_REQUIRED"},
+	{0x8802D008, "E_SYNCENGINE_STORAGE_SERVICE_PROVISIONING_FAILED"},
+	{0x8802D009, "E_SYNCENGINE_UNSUPPORTED_REPARSE_POINT"},
+	{0x8802D00A, "E_SYNCENGINE_STORAGE_SERVICE_BLOCKED"},
+	{0x8802D00B, "E_SYNCENGINE_FOLDER_IN_REDIRECTION"},
+	{0x88790001, "D3D10_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS"},
+	{0x88790002, "D3D10_ERROR_FILE_NOT_FOUND"},
+	{0x887A0001, "DXGI_ERROR_INVALID_CALL"},
+	{0x887A0002, "DXGI_ERROR_NOT_FOUND"},
+	{0x887A0003, "DXGI_ERROR_MORE_DATA"},
+	{0x887A0004, "DXGI_ERROR_UNSUPPORTED"},
+	{0x887A0005, "DXGI_ERROR_DEVICE_REMOVED"},
+	{0x887A0006, "DXGI_ERROR_DEVICE_HUNG"},
+	{0x887A0007, "DXGI_ERROR_DEVICE_RESET"},
+	{0x887A000A, "DXGI_ERROR_WAS_STILL_DRAWING"},
+	{0x887A000B, "DXGI_ERROR_FRAME_STATISTICS_DISJOINT"},
+	{0x887A000C, "DXGI_ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE"},
+	{0x887A0020, "DXGI_ERROR_DRIVER_INTERNAL_ERROR"},
+	{0x887A0021, "DXGI_ERROR_NONEXCLUSIVE"},
+	{0x887A0022, "DXGI_ERROR_NOT_CURRENTLY_AVAILABLE"},
+	{0x887A0023, "DXGI_ERROR_REMOTE_CLIENT_DISCONNECTED"},
+	{0x887A0024, "DXGI_ERROR_REMOTE_OUTOFMEMORY"},
+	{0x887A0025, "DXGI_ERROR_MODE_CHANGE_IN_PROGRESS"},
+	{0x887A0026, "DXGI_ERROR_ACCESS_LOST"},
+	{0x887A0027, "DXGI_ERROR_WAIT_TIMEOUT"},
+	{0x887A0028, "DXGI_ERROR_SESSION_DISCONNECTED"},
+	{0x887A0029, "DXGI_ERROR_RESTRICT_TO_OUTPUT_STALE"},
+	{0x887A002A, "DXGI_ERROR_CANNOT_PROTECT_CONTENT"},
+	{0x887A002B, "DXGI_ERROR_ACCESS_DENIED"},
+	{0x887A002C, "DXGI_ERROR_NAME_ALREADY_EXISTS"},
+	{0x887A002D, "DXGI_ERROR_SDK_COMPONENT_MISSING"},
+	{0x887A002E, "DXGI_ERROR_NOT_CURRENT"},
+	{0x887A0030, "DXGI_ERROR_HW_PROTECTION_OUTOFMEMORY"},
+	{0x887A0031, "DXGI_ERROR_DYNAMIC_CODE_POLICY_VIOLATION"},
+	{0x887A0032, "DXGI_ERROR_NON_COMPOSITED_UI"},
+	{0x887A0033, "DXGI_ERROR_CACHE_CORRUPT"},
+	{0x887A0034, "DXGI_ERROR_CACHE_FULL"},
+	{0x887A0035, "DXGI_ERROR_CACHE_HASH_COLLISION"},
+	{0x887A0036, "DXGI_ERROR_ALREADY_EXISTS"},
+	{0x887B0001, "DXGI_DDI_ERR_WASSTILLDRAWING"},
+	{0x887B0002, "DXGI_DDI_ERR_UNSUPPORTED"},
+	{0x887B0003, "DXGI_DDI_ERR_NONEXCLUSIVE"},
+	{0x887C0001, "D3D11_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS"},
+	{0x887C0002, "D3D11_ERROR_FILE_NOT_FOUND"},
+	{0x887C0003, "D3D11_ERROR_TOO_MANY_UNIQUE_VIEW_OBJECTS"},
+	{0x887C0004, "D3D11_ERROR_DEFERRED_CONTEXT_MAP_WITHOUT_INITIAL_DISCARD"},
+	{0x887E0001, "D3D12_ERROR_ADAPTER_NOT_FOUND"},
+	{0x887E0002, "D3D12_ERROR_DRIVER_VERSION_MISMATCH"},
+	{0x88900001, "WINML_ERR_INVALID_DEVICE"},
+	{0x88900002, "WINML_ERR_INVALID_BINDING"},
+	{0x88900003, "WINML_ERR_VALUE_NOTFOUND"},
+	{0x88900004, "WINML_ERR_SIZE_MISMATCH"},
+	{0x88980001, "MILERR_OBJECTBUSY"},
+	{0x88980002, "MILERR_INSUFFICIENTBUFFER"},
+	{0x88980003, "MILERR_WIN32ERROR"},
+	{0x88980004, "MILERR_SCANNER_FAILED"},
+	{0x88980005, "MILERR_SCREENACCESSDENIED"},
+	{0x88980006, "MILERR_DISPLAYSTATEINVALID"},
+	{0x88980007, "MILERR_NONINVERTIBLEMATRIX"},
+	{0x88980008, "MILERR_ZEROVECTOR"},
+	{0x88980009, "MILERR_TERMINATED"},
+	{0x8898000A, "MILERR_BADNUMBER"},
+	{0x88980080, "MILERR_INTERNALERROR"},
+	{0x88980084, "MILERR_DISPLAYFORMATNOTSUPPORTED"},
+	{0x88980085, "MILERR_INVALIDCALL"},
+	{0x88980086, "MILERR_ALREADYLOCKED"},
+	{0x88980087, "MILERR_NOTLOCKED"},
+	{0x88980088, "MILERR_DEVICECANNOTRENDERTEXT"},
+	{0x88980089, "MILERR_GLYPHBITMAPMISSED"},
+	{0x8898008A, "MILERR_MALFORMEDGLYPHCACHE"},
+	{0x8898008B, "MILERR_GENERIC_IGNORE"},
+	{0x8898008C, "MILERR_MALFORMED_GUIDELINE_DATA"},
+	{0x8898008D, "MILERR_NO_HARDWARE_DEVICE"},
+	{0x8898008E, "MILERR_NEED_RECREATE_AND_PRESENT"},
+	{0x8898008F, "MILERR_ALREADY_INITIALIZED"},
+	{0x88980090, "MILERR_MISMATCHED_SIZE"},
+	{0x88980091, "MILERR_NO_REDIRECTION_SURFACE_AVAILABLE"},
+	{0x88980092, "MILERR_REMOTING_NOT_SUPPORTED"},
+	{0x88980093, "MILERR_QUEUED_PRESENT_NOT_SUPPORTED"},
+	{0x88980094, "MILERR_NOT_QUEUING_PRESENTS"},
+	{0x88980095, "MILERR_NO_REDIRECTION_SURFACE_RETRY_LATER"},
+	{0x88980096, "MILERR_TOOMANYSHADERELEMNTS"},
+	{0x88980097, "MILERR_MROW_READLOCK_FAILED"},
+	{0x88980098, "MILERR_MROW_UPDATE_FAILED"},
+	{0x88980099, "MILERR_SHADER_COMPILE_FAILED"},
+	{0x8898009A, "MILERR_MAX_TEXTURE_SIZE_EXCEEDED"},
+	{0x8898009B, "MILERR_QPC_TIME_WENT_BACKWARD"},
+	{0x8898009D, "MILERR_DXGI_ENUMERATION_OUT_OF_SYNC"},
+	{0x8898009E, "MILERR_ADAPTER_NOT_FOUND"},
+	{0x8898009F, "MILERR_COLORSPACE_NOT_SUPPORTED"},
+	{0x889800A0, "MILERR_PREFILTER_NOT_SUPPORTED"},
+	{0x889800A1, "MILERR_DISPLAYID_ACCESS_DENIED"},
+	{0x88980400, "UCEERR_INVALIDPACKETHEADER"},
+	{0x88980401, "UCEERR_UNKNOWNPACKET"},
+	{0x88980402, "UCEERR_ILLEGALPACKET"},
+	{0x88980403, "UCEERR_MALFORMEDPACKET"},
+	{0x88980404, "UCEERR_ILLEGALHANDLE"},
+	{0x88980405, "UCEERR_HANDLELOOKUPFAILED"},
+	{0x88980406, "UCEERR_RENDERTHREADFAILURE"},
+	{0x88980407, "UCEERR_CTXSTACKFRSTTARGETNULL"},
+	{0x88980408, "UCEERR_CONNECTIONIDLOOKUPFAILED"},
+	{0x88980409, "UCEERR_BLOCKSFULL"},
+	{0x8898040A, "UCEERR_MEMORYFAILURE"},
+	{0x8898040B, "UCEERR_PACKETRECORDOUTOFRANGE"},
+	{0x8898040C, "UCEERR_ILLEGALRECORDTYPE"},
+	{0x8898040D, "UCEERR_OUTOFHANDLES"},
+	{0x8898040E, "UCEERR_UNCHANGABLE_UPDATE_ATTEMPTED"},
+	{0x8898040F, "UCEERR_NO_MULTIPLE_WORKER_THREADS"},
+	{0x88980410, "UCEERR_REMOTINGNOTSUPPORTED"},
+	{0x88980411, "UCEERR_MISSINGENDCOMMAND"},
+	{0x88980412, "UCEERR_MISSINGBEGINCOMMAND"},
+	{0x88980413, "UCEERR_CHANNELSYNCTIMEDOUT"},
+	{0x88980414, "UCEERR_CHANNELSYNCABANDONED"},
+	{0x88980415, "UCEERR_UNSUPPORTEDTRANSPORTVERSION"},
+	{0x88980416, "UCEERR_TRANSPORTUNAVAILABLE"},
+	{0x88980417, "UCEERR_FEEDBACK_UNSUPPORTED"},
+	{0x88980418, "UCEERR_COMMANDTRANSPORTDENIED"},
+	{0x88980419, "UCEERR_GRAPHICSSTREAMUNAVAILABLE"},
+	{0x88980420, "UCEERR_GRAPHICSSTREAMALREADYOPEN"},
+	{0x88980421, "UCEERR_TRANSPORTDISCONNECTED"},
+	{0x88980422, "UCEERR_TRANSPORTOVERLOADED"},
+	{0x88980423, "UCEERR_PARTITION_ZOMBIED"},
+	{0x88980500, "MILAVERR_NOCLOCK"},
+	{0x88980501, "MILAVERR_NOMEDIATYPE"},
+	{0x88980502, "MILAVERR_NOVIDEOMIXER"},
+	{0x88980503, "MILAVERR_NOVIDEOPRESENTER"},
+	{0x88980504, "MILAVERR_NOREADYFRAMES"},
+	{0x88980505, "MILAVERR_MODULENOTLOADED"},
+	{0x88980506, "MILAVERR_WMPFACTORYNOTREGISTERED"},
+	{0x88980507, "MILAVERR_INVALIDWMPVERSION"},
+	{0x88980508, "MILAVERR_INSUFFICIENTVIDEORESOURCES"},
+	{0x88980509, "MILAVERR_VIDEOACCELERATIONNOTAVAILABLE"},
+	{0x8898050A, "MILAVERR_REQUESTEDTEXTURETOOBIG"},
+	{0x8898050B, "MILAVERR_SEEKFAILED"},
+	{0x8898050C, "MILAVERR_UNEXPECTEDWMPFAILURE"},
+	{0x8898050D, "MILAVERR_MEDIAPLAYERCLOSED"},
+	{0x8898050E, "MILAVERR_UNKNOWNHARDWAREERROR"},
+	{0x8898060E, "MILEFFECTSERR_UNKNOWNPROPERTY"},
+	{0x8898060F, "MILEFFECTSERR_EFFECTNOTPARTOFGROUP"},
+	{0x88980610, "MILEFFECTSERR_NOINPUTSOURCEATTACHED"},
+	{0x88980611, "MILEFFECTSERR_CONNECTORNOTCONNECTED"},
+	{0x88980612, "MILEFFECTSERR_CONNECTORNOTASSOCIATEDWITHEFFECT"},
+	{0x88980613, "MILEFFECTSERR_RESERVED"},
+	{0x88980614, "MILEFFECTSERR_CYCLEDETECTED"},
+	{0x88980615, "MILEFFECTSERR_EFFECTINMORETHANONEGRAPH"},
+	{0x88980616, "MILEFFECTSERR_EFFECTALREADYINAGRAPH"},
+	{0x88980617, "MILEFFECTSERR_EFFECTHASNOCHILDREN"},
+	{0x88980618, "MILEFFECTSERR_ALREADYATTACHEDTOLISTENER"},
+	{0x88980619, "MILEFFECTSERR_NOTAFFINETRANSFORM"},
+	{0x8898061A, "MILEFFECTSERR_EMPTYBOUNDS"},
+	{0x8898061B, "MILEFFECTSERR_OUTPUTSIZETOOLARGE"},
+	{0x88980700, "DWMERR_STATE_TRANSITION_FAILED"},
+	{0x88980701, "DWMERR_THEME_FAILED"},
+	{0x88980702, "DWMERR_CATASTROPHIC_FAILURE"},
+	{0x88980800, "DCOMPOSITION_ERROR_WINDOW_ALREADY_COMPOSED"},
+	{0x88980801, "DCOMPOSITION_ERROR_SURFACE_BEING_RENDERED"},
+	{0x88980802, "DCOMPOSITION_ERROR_SURFACE_NOT_BEING_RENDERED"},
+	{0x88982F04, "WINCODEC_ERR_WRONGSTATE"},
+	{0x88982F05, "WINCODEC_ERR_VALUEOUTOFRANGE"},
+	{0x88982F07, "WINCODEC_ERR_UNKNOWNIMAGEFORMAT"},
+	{0x88982F0B, "WINCODEC_ERR_UNSUPPORTEDVERSION"},
+	{0x88982F0C, "WINCODEC_ERR_NOTINITIALIZED"},
+	{0x88982F0D, "WINCODEC_ERR_ALREADYLOCKED"},
+	{0x88982F40, "WINCODEC_ERR_PROPERTYNOTFOUND"},
+	{0x88982F41, "WINCODEC_ERR_PROPERTYNOTSUPPORTED"},
+	{0x88982F42, "WINCODEC_ERR_PROPERTYSIZE"},
+	{0x88982F43, "WINCODEC_ERR_CODECPRESENT"},
+	{0x88982F44, "WINCODEC_ERR_CODECNOTHUMBNAIL"},
+	{0x88982F45, "WINCODEC_ERR_PALETTEUNAVAILABLE"},
+	{0x88982F46, "WINCODEC_ERR_CODECTOOMANYSCANLINES"},
+	{0x88982F48, "WINCODEC_ERR_INTERNALERROR"},
+	{0x88982F49, "WINCODEC_ERR_SOURCERECTDOESNOTMATCHDIMENSIONS"},
+	{0x88982F50, "WINCODEC_ERR_COMPONENTNOTFOUND"},
+	{0x88982F51, "WINCODEC_ERR_IMAGESIZEOUTOFRANGE"},
+	{0x88982F52, "WINCODEC_ERR_TOOMUCHMETADATA"},
+	{0x88982F60, "WINCODEC_ERR_BADIMAGE"},
+	{0x88982F61, "WINCODEC_ERR_BADHEADER"},
+	{0x88982F62, "WINCODEC_ERR_FRAMEMISSING"},
+	{0x88982F63, "WINCODEC_ERR_BADMETADATAHEADER"},
+	{0x88982F70, "WINCODEC_ERR_BADSTREAMDATA"},
+	{0x88982F71, "WINCODEC_ERR_STREAMWRITE"},
+	{0x88982F72, "WINCODEC_ERR_STREAMREAD"},
+	{0x88982F73, "WINCODEC_ERR_STREAMNOTAVAILABLE"},
+	{0x88982F80, "WINCODEC_ERR_UNSUPPORTEDPIXELFORMAT"},
+	{0x88982F81, "WINCODEC_ERR_UNSUPPORTEDOPERATION"},
+	{0x88982F8A, "WINCODEC_ERR_INVALIDREGISTRATION"},
+	{0x88982F8B, "WINCODEC_ERR_COMPONENTINITIALIZEFAILURE"},
+	{0x88982F8C, "WINCODEC_ERR_INSUFFICIENTBUFFER"},
+	{0x88982F8D, "WINCODEC_ERR_DUPLICATEMETADATAPRESENT"},
+	{0x88982F8E, "WINCODEC_ERR_PROPERTYUNEXPECTEDTYPE"},
+	{0x88982F8F, "WINCODEC_ERR_UNEXPECTEDSIZE"},
+	{0x88982F90, "WINCODEC_ERR_INVALIDQUERYREQUEST"},
+	{0x88982F91, "WINCODEC_ERR_UNEXPECTEDMETADATATYPE"},
+	{0x88982F92, "WINCODEC_ERR_REQUESTONLYVALIDATMETADATAROOT"},
+	{0x88982F93, "WINCODEC_ERR_INVALIDQUERYCHARACTER"},
+	{0x88982F94, "WINCODEC_ERR_WIN32ERROR"},
+	{0x88982F95, "WINCODEC_ERR_INVALIDPROGRESSIVELEVEL"},
+	{0x88982F96, "WINCODEC_ERR_INVALIDJPEGSCANINDEX"},
+	{0x88985000, "DWRITE_E_FILEFORMAT"},
+	{0x88985001, "DWRITE_E_UNEXPECTED"},
+	{0x88985002, "DWRITE_E_NOFONT"},
+	{0x88985003, "DWRITE_E_FILENOTFOUND"},
+	{0x88985004, "DWRITE_E_FILEACCESS"},
+	{0x88985005, "DWRITE_E_FONTCOLLECTIONOBSOLETE"},
+	{0x88985006, "DWRITE_E_ALREADYREGISTERED"},
+	{0x88985007, "DWRITE_E_CACHEFORMAT"},
+	{0x88985008, "DWRITE_E_CACHEVERSION"},
+	{0x88985009, "DWRITE_E_UNSUPPORTEDOPERATION"},
+	{0x8898500A, "DWRITE_E_TEXTRENDERERINCOMPATIBLE"},
+	{0x8898500B, "DWRITE_E_FLOWDIRECTIONCONFLICTS"},
+	{0x8898500C, "DWRITE_E_NOCOLOR"},
+	{0x8898500D, "DWRITE_E_REMOTEFONT"},
+	{0x8898500E, "DWRITE_E_DOWNLOADCANCELLED"},
+	{0x8898500F, "DWRITE_E_DOWNLOADFAILED"},
+	{0x88985010, "DWRITE_E_TOOMANYDOWNLOADS"},
+	{0x88990001, "D2DERR_WRONG_STATE"},
+	{0x88990002, "D2DERR_NOT_INITIALIZED"},
+	{0x88990003, "D2DERR_UNSUPPORTED_OPERATION"},
+	{0x88990004, "D2DERR_SCANNER_FAILED"},
+	{0x88990005, "D2DERR_SCREEN_ACCESS_DENIED"},
+	{0x88990006, "D2DERR_DISPLAY_STATE_INVALID"},
+	{0x88990007, "D2DERR_ZERO_VECTOR"},
+	{0x88990008, "D2DERR_INTERNAL_ERROR"},
+	{0x88990009, "D2DERR_DISPLAY_FORMAT_NOT_SUPPORTED"},
+	{0x8899000A, "D2DERR_INVALID_CALL"},
+	{0x8899000B, "D2DERR_NO_HARDWARE_DEVICE"},
+	{0x8899000C, "D2DERR_RECREATE_TARGET"},
+	{0x8899000D, "D2DERR_TOO_MANY_SHADER_ELEMENTS"},
+	{0x8899000E, "D2DERR_SHADER_COMPILE_FAILED"},
+	{0x8899000F, "D2DERR_MAX_TEXTURE_SIZE_EXCEEDED"},
+	{0x88990010, "D2DERR_UNSUPPORTED_VERSION"},
+	{0x88990011, "D2DERR_BAD_NUMBER"},
+	{0x88990012, "D2DERR_WRONG_FACTORY"},
+	{0x88990013, "D2DERR_LAYER_ALREADY_IN_USE"},
+	{0x88990014, "D2DERR_POP_CALL_DID_NOT_MATCH_PUSH"},
+	{0x88990015, "D2DERR_WRONG_RESOURCE_DOMAIN"},
+	{0x88990016, "D2DERR_PUSH_POP_UNBALANCED"},
+	{0x88990017, "D2DERR_RENDER_TARGET_HAS_LAYER_OR_CLIPRECT"},
+	{0x88990018, "D2DERR_INCOMPATIBLE_BRUSH_TYPES"},
+	{0x88990019, "D2DERR_WIN32_ERROR"},
+	{0x8899001A, "D2DERR_TARGET_NOT_GDI_COMPATIBLE"},
+	{0x8899001B, "D2DERR_TEXT_EFFECT_IS_WRONG_TYPE"},
+	{0x8899001C, "D2DERR_TEXT_RENDERER_NOT_RELEASED"},
+	{0x8899001D, "D2DERR_EXCEEDS_MAX_BITMAP_SIZE"},
+	{0x8899001E, "D2DERR_INVALID_GRAPH_CONFIGURATION"},
+	{0x8899001F, "D2DERR_INVALID_INTERNAL_GRAPH_CONFIGURATION"},
+	{0x88990020, "D2DERR_CYCLIC_GRAPH"},
+	{0x88990021, "D2DERR_BITMAP_CANNOT_DRAW"},
+	{0x88990022, "D2DERR_OUTSTANDING_BITMAP_REFERENCES"},
+	{0x88990023, "D2DERR_ORIGINAL_TARGET_NOT_BOUND"},
+	{0x88990024, "D2DERR_INVALID_TARGET"},
+	{0x88990025, "D2DERR_BITMAP_BOUND_AS_TARGET"},
+	{0x88990026, "D2DERR_INSUFFICIENT_DEVICE_CAPABILITIES"},
+	{0x88990027, "D2DERR_INTERMEDIATE_TOO_LARGE"},
+	{0x88990028, "D2DERR_EFFECT_IS_NOT_REGISTERED"},
+	{0x88990029, "D2DERR_INVALID_PROPERTY"},
+	{0x8899002A, "D2DERR_NO_SUBPROPERTIES"},
+	{0x8899002B, "D2DERR_PRINT_JOB_CLOSED"},
+	{0x8899002C, "D2DERR_PRINT_FORMAT_NOT_SUPPORTED"},
+	{0x8899002D, "D2DERR_TOO_MANY_TRANSFORM_INPUTS"},
+	{0x8899002E, "D2DERR_INVALID_GLYPH_IMAGE"},
+	{0x89010001, "ERROR_IO_PREEMPTED"},
+	{0x89020001, "JSCRIPT_E_CANTEXECUTE"},
+	{0xC0090001, "ERROR_AUDITING_DISABLED"},
+	{0xC0090002, "ERROR_ALL_SIDS_FILTERED"},
+	{0xC0090003, "ERROR_BIZRULES_NOT_ENABLED"},
+	{0xC02605E8, "ERROR_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS"},
+	{0xC0261003, "ERROR_MONITOR_INVALID_DESCRIPTOR_CHECKSUM"},
+	{0xC0261004, "ERROR_MONITOR_INVALID_STANDARD_TIMING_BLOCK"},
+	{0xC0261005, "ERROR_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED"},
+	{0xC0261006, "ERROR_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK"},
+	{0xC0261007, "ERROR_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK"},
+	{0xC0261008, "ERROR_MONITOR_NO_MORE_DESCRIPTOR_DATA"},
+	{0xC0261009, "ERROR_MONITOR_INVALID_DETAILED_TIMING_BLOCK"},
+	{0xC026100A, "ERROR_MONITOR_INVALID_MANUFACTURE_DATE"},
+	{0xC0262000, "ERROR_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER"},
+	{0xC0262001, "ERROR_GRAPHICS_INSUFFICIENT_DMA_BUFFER"},
+	{0xC0262002, "ERROR_GRAPHICS_INVALID_DISPLAY_ADAPTER"},
+	{0xC0262003, "ERROR_GRAPHICS_ADAPTER_WAS_RESET"},
+	{0xC0262004, "ERROR_GRAPHICS_INVALID_DRIVER_MODEL"},
+	{0xC0262005, "ERROR_GRAPHICS_PRESENT_MODE_CHANGED"},
+	{0xC0262006, "ERROR_GRAPHICS_PRESENT_OCCLUDED"},
+	{0xC0262007, "ERROR_GRAPHICS_PRESENT_DENIED"},
+	{0xC0262008, "ERROR_GRAPHICS_CANNOTCOLORCONVERT"},
+	{0xC0262009, "ERROR_GRAPHICS_DRIVER_MISMATCH"},
+	{0xC026200B, "ERROR_GRAPHICS_PRESENT_REDIRECTION_DISABLED"},
+	{0xC026200C, "ERROR_GRAPHICS_PRESENT_UNOCCLUDED"},
+	{0xC026200D, "ERROR_GRAPHICS_WINDOWDC_NOT_AVAILABLE"},
+	{0xC026200E, "ERROR_GRAPHICS_WINDOWLESS_PRESENT_DISABLED"},
+	{0xC026200F, "ERROR_GRAPHICS_PRESENT_INVALID_WINDOW"},
+	{0xC0262010, "ERROR_GRAPHICS_PRESENT_BUFFER_NOT_BOUND"},
+	{0xC0262011, "ERROR_GRAPHICS_VAIL_STATE_CHANGED"},
+	{0xC0262012, "ERROR_GRAPHICS_INDIRECT_DISPLAY_ABANDON_SWAPCHAIN"},
+	{0xC0262013, "ERROR_GRAPHICS_INDIRECT_DISPLAY_DEVICE_STOPPED"},
+	{0xC0262100, "ERROR_GRAPHICS_NO_VIDEO_MEMORY"},
+	{0xC0262101, "ERROR_GRAPHICS_CANT_LOCK_MEMORY"},
+	{0xC0262102, "ERROR_GRAPHICS_ALLOCATION_BUSY"},
+	{0xC0262103, "ERROR_GRAPHICS_TOO_MANY_REFERENCES"},
+	{0xC0262104, "ERROR_GRAPHICS_TRY_AGAIN_LATER"},
+	{0xC0262105, "ERROR_GRAPHICS_TRY_AGAIN_NOW"},
+	{0xC0262106, "ERROR_GRAPHICS_ALLOCATION_INVALID"},
+	{0xC0262107, "ERROR_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE"},
+	{0xC0262108, "ERROR_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED"},
+	{0xC0262109, "ERROR_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION"},
+	{0xC0262110, "ERROR_GRAPHICS_INVALID_ALLOCATION_USAGE"},
+	{0xC0262111, "ERROR_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION"},
+	{0xC0262112, "ERROR_GRAPHICS_ALLOCATION_CLOSED"},
+	{0xC0262113, "ERROR_GRAPHICS_INVALID_ALLOCATION_INSTANCE"},
+	{0xC0262114, "ERROR_GRAPHICS_INVALID_ALLOCATION_HANDLE"},
+	{0xC0262115, "ERROR_GRAPHICS_WRONG_ALLOCATION_DEVICE"},
+	{0xC0262116, "ERROR_GRAPHICS_ALLOCATION_CONTENT_LOST"},
+	{0xC0262200, "ERROR_GRAPHICS_GPU_EXCEPTION_ON_DEVICE"},
+	{0xC0262300, "ERROR_GRAPHICS_INVALID_VIDPN_TOPOLOGY"},
+	{0xC0262301, "ERROR_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED"},
+	{0xC0262302, "ERROR_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED"},
+	{0xC0262303, "ERROR_GRAPHICS_INVALID_VIDPN"},
+	{0xC0262304, "ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE"},
+	{0xC0262305, "ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET"},
+	{0xC0262306, "ERROR_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED"},
+	{0xC0262308, "ERROR_GRAPHICS_INVALID_VIDPN_SOURCEMODESET"},
+	{0xC0262309, "ERROR_GRAPHICS_INVALID_VIDPN_TARGETMODESET"},
+	{0xC026230A, "ERROR_GRAPHICS_INVALID_FREQUENCY"},
+	{0xC026230B, "ERROR_GRAPHICS_INVALID_ACTIVE_REGION"},
+	{0xC026230C, "ERROR_GRAPHICS_INVALID_TOTAL_REGION"},
+	{0xC0262310, "ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE"},
+	{0xC0262311, "ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE"},
+	{0xC0262312, "ERROR_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET"},
+	{0xC0262313, "ERROR_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY"},
+	{0xC0262314, "ERROR_GRAPHICS_MODE_ALREADY_IN_MODESET"},
+	{0xC0262315, "ERROR_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET"},
+	{0xC0262316, "ERROR_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET"},
+	{0xC0262317, "ERROR_GRAPHICS_SOURCE_ALREADY_IN_SET"},
+	{0xC0262318, "ERROR_GRAPHICS_TARGET_ALREADY_IN_SET"},
+	{0xC0262319, "ERROR_GRAPHICS_INVALID_VIDPN_PRESENT_PATH"},
+	{0xC026231A, "ERROR_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY"},
+	{0xC026231B, "ERROR_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET"},
+	{0xC026231C, "ERROR_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE"},
+	{0xC026231D, "ERROR_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET"},
+	{0xC026231F, "ERROR_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET"},
+	{0xC0262320, "ERROR_GRAPHICS_STALE_MODESET"},
+	{0xC0262321, "ERROR_GRAPHICS_INVALID_MONITOR_SOURCEMODESET"},
+	{0xC0262322, "ERROR_GRAPHICS_INVALID_MONITOR_SOURCE_MODE"},
+	{0xC0262323, "ERROR_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN"},
+	{0xC0262324, "ERROR_GRAPHICS_MODE_ID_MUST_BE_UNIQUE"},
+	{0xC0262325, "ERROR_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION"},
+	{0xC0262326, "ERROR_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES"},
+	{0xC0262327, "ERROR_GRAPHICS_PATH_NOT_IN_TOPOLOGY"},
+	{0xC0262328, "ERROR_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE"},
+	{0xC0262329, "ERROR_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET"},
+	{0xC026232A, "ERROR_GRAPHICS_INVALID_MONITORDESCRIPTORSET"},
+	{0xC026232B, "ERROR_GRAPHICS_INVALID_MONITORDESCRIPTOR"},
+	{0xC026232C, "ERROR_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET"},
+	{0xC026232D, "ERROR_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET"},
+	{0xC026232E, "ERROR_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE"},
+	{0xC026232F, "ERROR_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE"},
+	{0xC0262330, "ERROR_GRAPHICS_RESOURCES_NOT_RELATED"},
+	{0xC0262331, "ERROR_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE"},
+	{0xC0262332, "ERROR_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE"},
+	{0xC0262333, "ERROR_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET"},
+	{0xC0262334, "ERROR_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER"},
+	{0xC0262335, "ERROR_GRAPHICS_NO_VIDPNMGR"},
+	{0xC0262336, "ERROR_GRAPHICS_NO_ACTIVE_VIDPN"},
+	{0xC0262337, "ERROR_GRAPHICS_STALE_VIDPN_TOPOLOGY"},
+	{0xC0262338, "ERROR_GRAPHICS_MONITOR_NOT_CONNECTED"},
+	{0xC0262339, "ERROR_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY"},
+	{0xC026233A, "ERROR_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE"},
+	{0xC026233B, "ERROR_GRAPHICS_INVALID_VISIBLEREGION_SIZE"},
+	{0xC026233C, "ERROR_GRAPHICS_INVALID_STRIDE"},
+	{0xC026233D, "ERROR_GRAPHICS_INVALID_PIXELFORMAT"},
+	{0xC026233E, "ERROR_GRAPHICS_INVALID_COLORBASIS"},
+	{0xC026233F, "ERROR_GRAPHICS_INVALID_PIXELVALUEACCESSMODE"},
+	{0xC0262340, "ERROR_GRAPHICS_TARGET_NOT_IN_TOPOLOGY"},
+	{0xC0262341, "ERROR_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT"},
+	{0xC0262342, "ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE"},
+	{0xC0262343, "ERROR_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN"},
+	{0xC0262344, "ERROR_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL"},
+	{0xC0262345, "ERROR_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION"},
+	{0xC0262346, "ERROR_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED"},
+	{0xC0262347, "ERROR_GRAPHICS_INVALID_GAMMA_RAMP"},
+	{0xC0262348, "ERROR_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED"},
+	{0xC0262349, "ERROR_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED"},
+	{0xC026234A, "ERROR_GRAPHICS_MODE_NOT_IN_MODESET"},
+	{0xC026234D, "ERROR_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON"},
+	{0xC026234E, "ERROR_GRAPHICS_INVALID_PATH_CONTENT_TYPE"},
+	{0xC026234F, "ERROR_GRAPHICS_INVALID_COPYPROTECTION_TYPE"},
+	{0xC0262350, "ERROR_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS"},
+	{0xC0262352, "ERROR_GRAPHICS_INVALID_SCANLINE_ORDERING"},
+	{0xC0262353, "ERROR_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED"},
+	{0xC0262354, "ERROR_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS"},
+	{0xC0262355, "ERROR_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT"},
+	{0xC0262356, "ERROR_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM"},
+	{0xC0262357, "ERROR_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN"},
+	{0xC0262358, "ERROR_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT"},
+	{0xC0262359, "ERROR_GRAPHICS_MAX_NUM_PATHS_REACHED"},
+	{0xC026235A, "ERROR_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION"},
+	{0xC026235B, "ERROR_GRAPHICS_INVALID_CLIENT_TYPE"},
+	{0xC026235C, "ERROR_GRAPHICS_CLIENTVIDPN_NOT_SET"},
+	{0xC0262400, "ERROR_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED"},
+	{0xC0262401, "ERROR_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED"},
+	{0xC0262430, "ERROR_GRAPHICS_NOT_A_LINKED_ADAPTER"},
+	{0xC0262431, "ERROR_GRAPHICS_LEADLINK_NOT_ENUMERATED"},
+	{0xC0262432, "ERROR_GRAPHICS_CHAINLINKS_NOT_ENUMERATED"},
+	{0xC0262433, "ERROR_GRAPHICS_ADAPTER_CHAIN_NOT_READY"},
+	{0xC0262434, "ERROR_GRAPHICS_CHAINLINKS_NOT_STARTED"},
+	{0xC0262435, "ERROR_GRAPHICS_CHAINLINKS_NOT_POWERED_ON"},
+	{0xC0262436, "ERROR_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE"},
+	{0xC0262438, "ERROR_GRAPHICS_NOT_POST_DEVICE_DRIVER"},
+	{0xC026243B, "ERROR_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED"},
+	{0xC0262500, "ERROR_GRAPHICS_OPM_NOT_SUPPORTED"},
+	{0xC0262501, "ERROR_GRAPHICS_COPP_NOT_SUPPORTED"},
+	{0xC0262502, "ERROR_GRAPHICS_UAB_NOT_SUPPORTED"},
+	{0xC0262503, "ERROR_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS"},
+	{0xC0262505, "ERROR_GRAPHICS_OPM_NO_VIDEO_OUTPUTS_EXIST"},
+	{0xC026250B, "ERROR_GRAPHICS_OPM_INTERNAL_ERROR"},
+	{0xC026250C, "ERROR_GRAPHICS_OPM_INVALID_HANDLE"},
+	{0xC026250E, "ERROR_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH"},
+	{0xC026250F, "ERROR_GRAPHICS_OPM_SPANNING_MODE_ENABLED"},
+	{0xC0262510, "ERROR_GRAPHICS_OPM_THEATER_MODE_ENABLED"},
+	{0xC0262511, "ERROR_GRAPHICS_PVP_HFS_FAILED"},
+	{0xC0262512, "ERROR_GRAPHICS_OPM_INVALID_SRM"},
+	{0xC0262513, "ERROR_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP"},
+	{0xC0262514, "ERROR_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP"},
+	{0xC0262515, "ERROR_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA"},
+	{0xC0262516, "ERROR_GRAPHICS_OPM_HDCP_SRM_NEVER_SET"},
+	{0xC0262517, "ERROR_GRAPHICS_OPM_RESOLUTION_TOO_HIGH"},
+	{0xC0262518, "ERROR_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE"},
+	{0xC026251A, "ERROR_GRAPHICS_OPM_VIDEO_OUTPUT_NO_LONGER_EXISTS"},
+	{0xC026251B, "ERROR_GRAPHICS_OPM_SESSION_TYPE_CHANGE_IN_PROGRESS"},
+	{0xC026251C, "ERROR_GRAPHICS_OPM_VIDEO_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS"},
+	{0xC026251D, "ERROR_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST"},
+	{0xC026251E, "ERROR_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR"},
+	{0xC026251F, "ERROR_GRAPHICS_OPM_VIDEO_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS"},
+	{0xC0262520, "ERROR_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED"},
+	{0xC0262521, "ERROR_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST"},
+	{0xC0262580, "ERROR_GRAPHICS_I2C_NOT_SUPPORTED"},
+	{0xC0262581, "ERROR_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST"},
+	{0xC0262582, "ERROR_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA"},
+	{0xC0262583, "ERROR_GRAPHICS_I2C_ERROR_RECEIVING_DATA"},
+	{0xC0262584, "ERROR_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED"},
+	{0xC0262585, "ERROR_GRAPHICS_DDCCI_INVALID_DATA"},
+	{0xC0262586, "ERROR_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE"},
+	{0xC0262587, "ERROR_GRAPHICS_MCA_INVALID_CAPABILITIES_STRING"},
+	{0xC0262588, "ERROR_GRAPHICS_MCA_INTERNAL_ERROR"},
+	{0xC0262589, "ERROR_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND"},
+	{0xC026258A, "ERROR_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH"},
+	{0xC026258B, "ERROR_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM"},
+	{0xC026258C, "ERROR_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE"},
+	{0xC026258D, "ERROR_GRAPHICS_MONITOR_NO_LONGER_EXISTS"},
+	{0xC02625D8, "ERROR_GRAPHICS_DDCCI_CURRENT_CURRENT_VALUE_GREATER_THAN_MAXIMUM_VALUE"},
+	{0xC02625D9, "ERROR_GRAPHICS_MCA_INVALID_VCP_VERSION"},
+	{0xC02625DA, "ERROR_GRAPHICS_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION"},
+	{0xC02625DB, "ERROR_GRAPHICS_MCA_MCCS_VERSION_MISMATCH"},
+	{0xC02625DC, "ERROR_GRAPHICS_MCA_UNSUPPORTED_MCCS_VERSION"},
+	{0xC02625DE, "ERROR_GRAPHICS_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED"},
+	{0xC02625DF, "ERROR_GRAPHICS_MCA_UNSUPPORTED_COLOR_TEMPERATURE"},
+	{0xC02625E0, "ERROR_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED"},
+	{0xC02625E1, "ERROR_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME"},
+	{0xC02625E2, "ERROR_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP"},
+	{0xC02625E3, "ERROR_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED"},
+	{0xC02625E4, "ERROR_GRAPHICS_INVALID_POINTER"},
+	{0xC02625E5, "ERROR_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE"},
+	{0xC02625E6, "ERROR_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL"},
+	{0xC02625E7, "ERROR_GRAPHICS_INTERNAL_ERROR"},
+	{0xC05C0000, "ERROR_SVHDX_ERROR_STORED"},
+	{0xC05CFF00, "ERROR_SVHDX_ERROR_NOT_AVAILABLE"},
+	{0xC05CFF01, "ERROR_SVHDX_UNIT_ATTENTION_AVAILABLE"},
+	{0xC05CFF02, "ERROR_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED"},
+	{0xC05CFF03, "ERROR_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED"},
+	{0xC05CFF04, "ERROR_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED"},
+	{0xC05CFF05, "ERROR_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED"},
+	{0xC05CFF06, "ERROR_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED"},
+	{0xC05CFF07, "ERROR_SVHDX_RESERVATION_CONFLICT"},
+	{0xC05CFF08, "ERROR_SVHDX_WRONG_FILE_TYPE"},
+	{0xC05CFF09, "ERROR_SVHDX_VERSION_MISMATCH"},
+	{0xC05CFF0A, "ERROR_VHD_SHARED"},
+	{0xC05CFF0B, "ERROR_SVHDX_NO_INITIATOR"},
+	{0xC05CFF0C, "ERROR_VHDSET_BACKING_STORAGE_NOT_FOUND"},
+	{0xC05D0000, "ERROR_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP"},
+	{0xC05D0001, "ERROR_SMB_BAD_CLUSTER_DIALECT"},
+	{0xC0E80000, "ERROR_SECCORE_INVALID_COMMAND"},
+	{0xC0EA0001, "ERROR_NO_APPLICABLE_APP_LICENSES_FOUND"},
+	{0xC0EA0002, "ERROR_CLIP_LICENSE_NOT_FOUND"},
+	{0xC0EA0003, "ERROR_CLIP_DEVICE_LICENSE_MISSING"},
+	{0xC0EA0004, "ERROR_CLIP_LICENSE_INVALID_SIGNATURE"},
+	{0xC0EA0005, "ERROR_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID"},
+	{0xC0EA0006, "ERROR_CLIP_LICENSE_EXPIRED"},
+	{0xC0EA0007, "ERROR_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE"},
+	{0xC0EA0008, "ERROR_CLIP_LICENSE_NOT_SIGNED"},
+	{0xC0EA0009, "ERROR_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE"},
+	{0xC0EA000A, "ERROR_CLIP_LICENSE_DEVICE_ID_MISMATCH"},
+}
+
+// ntstatusCodes contains NTSTATUS codes from ntstatus.h, winerror.h,
+// winhttp.h, wininet.h, sorted by code
+var ntstatusCodes = []errCode{
+	{0x00000000, "STATUS_SUCCESS"},
+	{0x00000000, "STATUS_WAIT_0"},
+	{0x00000001, "STATUS_WAIT_1"},
+	{0x00000002, "STATUS_WAIT_2"},
+	{0x00000003, "STATUS_WAIT_3"},
+	{0x0000003F, "STATUS_WAIT_63"},
+	{0x00000080, "STATUS_ABANDONED"},
+	{0x00000080, "STATUS_ABANDONED_WAIT_0"},
+	{0x000000BF, "STATUS_ABANDONED_WAIT_63"},
+	{0x000000C0, "STATUS_USER_APC"},
+	{0x000000FF, "STATUS_ALREADY_COMPLETE"},
+	{0x00000100, "STATUS_KERNEL_APC"},
+	{0x00000101, "STATUS_ALERTED"},
+	{0x00000102, "STATUS_TIMEOUT"},
+	{0x00000103, "STATUS_PENDING"},
+	{0x00000104, "STATUS_REPARSE"},
+	{0x00000105, "STATUS_MORE_ENTRIES"},
+	{0x00000106, "STATUS_NOT_ALL_ASSIGNED"},
+	{0x00000107, "STATUS_SOME_NOT_MAPPED"},
+	{0x00000108, "STATUS_OPLOCK_BREAK_IN_PROGRESS"},
+	{0x00000109, "STATUS_VOLUME_MOUNTED"},
+	{0x0000010A, "STATUS_RXACT_COMMITTED"},
+	{0x0000010B, "STATUS_NOTIFY_CLEANUP"},
+	{0x0000010C, "STATUS_NOTIFY_ENUM_DIR"},
+	{0x0000010D, "STATUS_NO_QUOTAS_FOR_ACCOUNT"},
+	{0x0000010E, "STATUS_PRIMARY_TRANSPORT_CONNECT_FAILED"},
+	{0x00000110, "STATUS_PAGE_FAULT_TRANSITION"},
+	{0x00000111, "STATUS_PAGE_FAULT_DEMAND_ZERO"},
+	{0x00000112, "STATUS_PAGE_FAULT_COPY_ON_WRITE"},
+	{0x00000113, "STATUS_PAGE_FAULT_GUARD_PAGE"},
+	{0x00000114, "STATUS_PAGE_FAULT_PAGING_FILE"},
+	{0x00000115, "STATUS_CACHE_PAGE_LOCKED"},
+	{0x00000116, "STATUS_CRASH_DUMP"},
+	{0x00000117, "STATUS_BUFFER_ALL_ZEROS"},
+	{0x00000118, "STATUS_REPARSE_OBJECT"},
+	{0x00000119, "STATUS_RESOURCE_REQUIREMENTS_CHANGED"},
+	{0x00000120, "STATUS_TRANSLATION_COMPLETE"},
+	{0x00000121, "STATUS_DS_MEMBERSHIP_EVALUATED_LOCALLY"},
+	{0x00000122, "STATUS_NOTHING_TO_TERMINATE"},
+	{0x00000123, "STATUS_PROCESS_NOT_IN_JOB"},
+	{0x00000124, "STATUS_PROCESS_IN_JOB"},
+	{0x00000125, "STATUS_VOLSNAP_HIBERNATE_READY"},
+	{0x00000126, "STATUS_FSFILTER_OP_COMPLETED_SUCCESSFULLY"},
+	{0x00000127, "STATUS_INTERRUPT_VECTOR_ALREADY_CONNECTED"},
+	{0x00000128, "STATUS_INTERRUPT_STILL_CONNECTED"},
+	{0x00000129, "STATUS_PROCESS_CLONED"},
+	{0x0000012A, "STATUS_FILE_LOCKED_WITH_ONLY_READERS"},
+	{0x0000012B, "STATUS_FILE_LOCKED_WITH_WRITERS"},
+	{0x0000012C, "STATUS_VALID_IMAGE_HASH"},
+	{0x0000012D, "STATUS_VALID_CATALOG_HASH"},
+	{0x0000012E, "STATUS_VALID_STRONG_CODE_HASH"},
+	{0x0000012F, "STATUS_GHOSTED"},
+	{0x00000130, "STATUS_DATA_OVERWRITTEN"},
+	{0x00000202, "STATUS_RESOURCEMANAGER_READ_ONLY"},
+	{0x00000210, "STATUS_RING_PREVIOUSLY_EMPTY"},
+	{0x00000211, "STATUS_RING_PREVIOUSLY_FULL"},
+	{0x00000212, "STATUS_RING_PREVIOUSLY_ABOVE_QUOTA"},
+	{0x00000213, "STATUS_RING_NEWLY_EMPTY"},
+	{0x00000214, "STATUS_RING_SIGNAL_OPPOSITE_ENDPOINT"},
+	{0x00000215, "STATUS_OPLOCK_SWITCHED_TO_NEW_HANDLE"},
+	{0x00000216, "STATUS_OPLOCK_HANDLE_CLOSED"},
+	{0x00000367, "STATUS_WAIT_FOR_OPLOCK"},
+	{0x00000368, "STATUS_REPARSE_GLOBAL"},
+	{0x001C0001, "STATUS_FLT_IO_COMPLETE"},
+	{0x00293000, "STATUS_RTPM_CONTEXT_CONTINUE"},
+	{0x00293001, "STATUS_RTPM_CONTEXT_COMPLETE"},
+	{0x00350059, "STATUS_HV_PENDING_PAGE_REQUESTS"},
+	{0x00E70000, "STATUS_SPACES_REPAIRED"},
+	{0x00E70001, "STATUS_SPACES_PAUSE"},
+	{0x00E70002, "STATUS_SPACES_COMPLETE"},
+	{0x00E70003, "STATUS_SPACES_REDIRECT"},
+	{0x40000000, "STATUS_OBJECT_NAME_EXISTS"},
+	{0x40000001, "STATUS_THREAD_WAS_SUSPENDED"},
+	{0x40000002, "STATUS_WORKING_SET_LIMIT_RANGE"},
+	{0x40000004, "STATUS_RXACT_STATE_CREATED"},
+	{0x40000005, "STATUS_SEGMENT_NOTIFICATION"},
+	{0x40000006, "STATUS_LOCAL_USER_SESSION_KEY"},
+	{0x40000007, "STATUS_BAD_CURRENT_DIRECTORY"},
+	{0x40000008, "STATUS_SERIAL_MORE_WRITES"},
+	{0x40000009, "STATUS_REGISTRY_RECOVERED"},
+	{0x4000000A, "STATUS_FT_READ_RECOVERY_FROM_BACKUP"},
+	{0x4000000B, "STATUS_FT_WRITE_RECOVERY"},
+	{0x4000000C, "STATUS_SERIAL_COUNTER_TIMEOUT"},
+	{0x4000000D, "STATUS_NULL_LM_PASSWORD"},
+	{0x4000000E, "STATUS_IMAGE_MACHINE_TYPE_MISMATCH"},
+	{0x4000000F, "STATUS_RECEIVE_PARTIAL"},
+	{0x40000010, "STATUS_RECEIVE_EXPEDITED"},
+	{0x40000011, "STATUS_RECEIVE_PARTIAL_EXPEDITED"},
+	{0x40000012, "STATUS_EVENT_DONE"},
+	{0x40000013, "STATUS_EVENT_PENDING"},
+	{0x40000014, "STATUS_CHECKING_FILE_SYSTEM"},
+	{0x40000015, "STATUS_FATAL_APP_EXIT"},
+	{0x40000016, "STATUS_PREDEFINED_HANDLE"},
+	{0x40000017, "STATUS_WAS_UNLOCKED"},
+	{0x40000018, "STATUS_SERVICE_NOTIFICATION"},
+	{0x40000019, "STATUS_WAS_LOCKED"},
+	{0x4000001A, "STATUS_LOG_HARD_ERROR"},
+	{0x4000001B, "STATUS_ALREADY_WIN32"},
+	{0x4000001C, "STATUS_WX86_UNSIMULATE"},
+	{0x4000001D, "STATUS_WX86_CONTINUE"},
+	{0x4000001E, "STATUS_WX86_SINGLE_STEP"},
+	{0x4000001F, "STATUS_WX86_BREAKPOINT"},
+	{0x40000020, "STATUS_WX86_EXCEPTION_CONTINUE"},
+	{0x40000021, "STATUS_WX86_EXCEPTION_LASTCHANCE"},
+	{0x40000022, "STATUS_WX86_EXCEPTION_CHAIN"},
+	{0x40000023, "STATUS_IMAGE_MACHINE_TYPE_MISMATCH_EXE"},
+	{0x40000024, "STATUS_NO_YIELD_PERFORMED"},
+	{0x40000025, "STATUS_TIMER_RESUME_IGNORED"},
+	{0x40000026, "STATUS_ARBITRATION_UNHANDLED"},
+	{0x40000027, "STATUS_CARDBUS_NOT_SUPPORTED"},
+	{0x40000028, "STATUS_WX86_CREATEWX86TIB"},
+	{0x40000029, "STATUS_MP_PROCESSOR_MISMATCH"},
+	{0x4000002A, "STATUS_HIBERNATED"},
+	{0x4000002B, "STATUS_RESUME_HIBERNATION"},
+	{0x4000002C, "STATUS_FIRMWARE_UPDATED"},
+	{0x4000002D, "STATUS_DRIVERS_LEAKING_LOCKED_PAGES"},
+	{0x4000002E, "STATUS_MESSAGE_RETRIEVED"},
+	{0x4000002F, "STATUS_SYSTEM_POWERSTATE_TRANSITION"},
+	{0x40000030, "STATUS_ALPC_CHECK_COMPLETION_LIST"},
+	{0x40000031, "STATUS_SYSTEM_POWERSTATE_COMPLEX_TRANSITION"},
+	{0x40000032, "STATUS_ACCESS_AUDIT_BY_POLICY"},
+	{0x40000033, "STATUS_ABANDON_HIBERFILE"},
+	{0x40000034, "STATUS_BIZRULES_NOT_ENABLED"},
+	{0x40000035, "STATUS_FT_READ_FROM_COPY"},
+	{0x40000037, "STATUS_PATCH_DEFERRED"},
+	{0x40000294, "STATUS_WAKE_SYSTEM"},
+	{0x40000370, "STATUS_DS_SHUTTING_DOWN"},
+	{0x40000807, "STATUS_DISK_REPAIR_REDIRECTED"},
+	{0x4000A144, "STATUS_SERVICES_FAILED_AUTOSTART"},
+	{0x400A0004, "STATUS_CTX_CDM_CONNECT"},
+	{0x400A0005, "STATUS_CTX_CDM_DISCONNECT"},
+	{0x4015000D, "STATUS_SXS_RELEASE_ACTIVATION_CONTEXT"},
+	{0x40190001, "STATUS_HEURISTIC_DAMAGE_POSSIBLE"},
+	{0x40190034, "STATUS_RECOVERY_NOT_NEEDED"},
+	{0x40190035, "STATUS_RM_ALREADY_STARTED"},
+	{0x401A000C, "STATUS_LOG_NO_RESTART"},
+	{0x401B00EC, "STATUS_VIDEO_DRIVER_DEBUG_REPORT_REQUEST"},
+	{0x401E000A, "STATUS_GRAPHICS_PARTIAL_DATA_POPULATED"},
+	{0x401E0201, "STATUS_GRAPHICS_SKIP_ALLOCATION_PREPARATION"},
+	{0x401E0307, "STATUS_GRAPHICS_MODE_NOT_PINNED"},
+	{0x401E031E, "STATUS_GRAPHICS_NO_PREFERRED_MODE"},
+	{0x401E034B, "STATUS_GRAPHICS_DATASET_IS_EMPTY"},
+	{0x401E034C, "STATUS_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET"},
+	{0x401E0351, "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED"},
+	{0x401E042F, "STATUS_GRAPHICS_UNKNOWN_CHILD_STATUS"},
+	{0x401E0437, "STATUS_GRAPHICS_LEADLINK_START_DEFERRED"},
+	{0x401E0439, "STATUS_GRAPHICS_POLLING_TOO_FREQUENTLY"},
+	{0x401E043A, "STATUS_GRAPHICS_START_DEFERRED"},
+	{0x401E043C, "STATUS_GRAPHICS_DEPENDABLE_CHILD_STATUS"},
+	{0x40230001, "STATUS_NDIS_INDICATION_REQUIRED"},
+	{0x40292023, "STATUS_PCP_UNSUPPORTED_PSS_SALT"},
+	{0x80000001, "STATUS_GUARD_PAGE_VIOLATION"},
+	{0x80000002, "STATUS_DATATYPE_MISALIGNMENT"},
+	{0x80000003, "STATUS_BREAKPOINT"},
+	{0x80000004, "STATUS_SINGLE_STEP"},
+	{0x80000005, "STATUS_BUFFER_OVERFLOW"},
+	{0x80000006, "STATUS_NO_MORE_FILES"},
+	{0x80000007, "STATUS_WAKE_SYSTEM_DEBUGGER"},
+	{0x8000000A, "STATUS_HANDLES_CLOSED"},
+	{0x8000000B, "STATUS_NO_INHERITANCE"},
+	{0x8000000C, "STATUS_GUID_SUBSTITUTION_MADE"},
+	{0x8000000D, "STATUS_PARTIAL_COPY"},
+	{0x8000000E, "STATUS_DEVICE_PAPER_EMPTY"},
+	{0x8000000F, "STATUS_DEVICE_POWERED_OFF"},
+	{0x80000010, "STATUS_DEVICE_OFF_LINE"},
+	{0x80000011, "STATUS_DEVICE_BUSY"},
+	{0x80000012, "STATUS_NO_MORE_EAS"},
+	{0x80000013, "STATUS_INVALID_EA_NAME"},
+	{0x80000014, "STATUS_EA_LIST_INCONSISTENT"},
+	{0x80000015, "STATUS_INVALID_EA_FLAG"},
+	{0x80000016, "STATUS_VERIFY_REQUIRED"},
+	{0x80000017, "STATUS_EXTRANEOUS_INFORMATION"},
+	{0x80000018, "STATUS_RXACT_COMMIT_NECESSARY"},
+	{0x8000001A, "STATUS_NO_MORE_ENTRIES"},
+	{0x8000001B, "STATUS_FILEMARK_DETECTED"},
+	{0x8000001C, "STATUS_MEDIA_CHANGED"},
+	{0x8000001D, "STATUS_BUS_RESET"},
+	{0x8000001E, "STATUS_END_OF_MEDIA"},
+	{0x8000001F, "STATUS_BEGINNING_OF_MEDIA"},
+	{0x80000020, "STATUS_MEDIA_CHECK"},
+	{0x80000021, "STATUS_SETMARK_DETECTED"},
+	{0x80000022, "STATUS_NO_DATA_DETECTED"},
+	{0x80000023, "STATUS_REDIRECTOR_HAS_OPEN_HANDLES"},
+	{0x80000024, "STATUS_SERVER_HAS_OPEN_HANDLES"},
+	{0x80000025, "STATUS_ALREADY_DISCONNECTED"},
+	{0x80000026, "STATUS_LONGJUMP"},
+	{0x80000027, "STATUS_CLEANER_CARTRIDGE_INSTALLED"},
+	{0x80000028, "STATUS_PLUGPLAY_QUERY_VETOED"},
+	{0x80000029, "STATUS_UNWIND_CONSOLIDATE"},
+	{0x8000002A, "STATUS_REGISTRY_HIVE_RECOVERED"},
+	{0x8000002B, "STATUS_DLL_MIGHT_BE_INSECURE"},
+	{0x8000002C, "STATUS_DLL_MIGHT_BE_INCOMPATIBLE"},
+	{0x8000002D, "STATUS_STOPPED_ON_SYMLINK"},
+	{0x8000002E, "STATUS_CANNOT_GRANT_REQUESTED_OPLOCK"},
+	{0x8000002F, "STATUS_NO_ACE_CONDITION"},
+	{0x80000030, "STATUS_DEVICE_SUPPORT_IN_PROGRESS"},
+	{0x80000031, "STATUS_DEVICE_POWER_CYCLE_REQUIRED"},
+	{0x80000032, "STATUS_NO_WORK_DONE"},
+	{0x80000288, "STATUS_DEVICE_REQUIRES_CLEANING"},
+	{0x80000289, "STATUS_DEVICE_DOOR_OPEN"},
+	{0x80000803, "STATUS_DATA_LOST_REPAIR"},
+	{0x8000A127, "STATUS_GPIO_INTERRUPT_ALREADY_UNMASKED"},
+	{0x8000CF00, "STATUS_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH"},
+	{0x8000CF04, "STATUS_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE"},
+	{0x8000CF05, "STATUS_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS"},
+	{0x80130001, "STATUS_CLUSTER_NODE_ALREADY_UP"},
+	{0x80130002, "STATUS_CLUSTER_NODE_ALREADY_DOWN"},
+	{0x80130003, "STATUS_CLUSTER_NETWORK_ALREADY_ONLINE"},
+	{0x80130004, "STATUS_CLUSTER_NETWORK_ALREADY_OFFLINE"},
+	{0x80130005, "STATUS_CLUSTER_NODE_ALREADY_MEMBER"},
+	{0x80190009, "STATUS_COULD_NOT_RESIZE_LOG"},
+	{0x80190029, "STATUS_NO_TXF_METADATA"},
+	{0x80190031, "STATUS_CANT_RECOVER_WITH_HANDLE_OPEN"},
+	{0x80190041, "STATUS_TXF_METADATA_ALREADY_PRESENT"},
+	{0x80190042, "STATUS_TRANSACTION_SCOPE_CALLBACKS_NOT_SET"},
+	{0x801B00EB, "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD_RECOVERED"},
+	{0x801C0001, "STATUS_FLT_BUFFER_TOO_SMALL"},
+	{0x80210001, "STATUS_FVE_PARTIAL_METADATA"},
+	{0x80210002, "STATUS_FVE_TRANSIENT_STATE"},
+	{0x80370001, "STATUS_VID_REMOTE_NODE_PARENT_GPA_PAGES_USED"},
+	{0x80380001, "STATUS_VOLMGR_INCOMPLETE_REGENERATION"},
+	{0x80380002, "STATUS_VOLMGR_INCOMPLETE_DISK_MIGRATION"},
+	{0x80390001, "STATUS_BCD_NOT_ALL_ENTRIES_IMPORTED"},
+	{0x80390003, "STATUS_BCD_NOT_ALL_ENTRIES_SYNCHRONIZED"},
+	{0x803A0001, "STATUS_QUERY_STORAGE_ERROR"},
+	{0x803F0001, "STATUS_GDI_HANDLE_LEAK"},
+	{0x80430006, "STATUS_SECUREBOOT_NOT_ENABLED"},
+	{0xC0000001, "STATUS_UNSUCCESSFUL"},
+	{0xC0000002, "STATUS_NOT_IMPLEMENTED"},
+	{0xC0000003, "STATUS_INVALID_INFO_CLASS"},
+	{0xC0000004, "STATUS_INFO_LENGTH_MISMATCH"},
+	{0xC0000005, "STATUS_ACCESS_VIOLATION"},
+	{0xC0000006, "STATUS_IN_PAGE_ERROR"},
+	{0xC0000007, "STATUS_PAGEFILE_QUOTA"},
+	{0xC0000008, "STATUS_INVALID_HANDLE"},
+	{0xC0000009, "STATUS_BAD_INITIAL_STACK"},
+	{0xC000000A, "STATUS_BAD_INITIAL_PC"},
+	{0xC000000B, "STATUS_INVALID_CID"},
+	{0xC000000C, "STATUS_TIMER_NOT_CANCELED"},
+	{0xC000000D, "STATUS_INVALID_PARAMETER"},
+	{0xC000000E, "STATUS_NO_SUCH_DEVICE"},
+	{0xC000000F, "STATUS_NO_SUCH_FILE"},
+	{0xC0000010, "STATUS_INVALID_DEVICE_REQUEST"},
+	{0xC0000011, "STATUS_END_OF_FILE"},
+	{0xC0000012, "STATUS_WRONG_VOLUME"},
+	{0xC0000013, "STATUS_NO_MEDIA_IN_DEVICE"},
+	{0xC0000014, "STATUS_UNRECOGNIZED_MEDIA"},
+	{0xC0000015, "STATUS_NONEXISTENT_SECTOR"},
+	{0xC0000016, "STATUS_MORE_PROCESSING_REQUIRED"},
+	{0xC0000017, "STATUS_NO_MEMORY"},
+	{0xC0000018, "STATUS_CONFLICTING_ADDRESSES"},
+	{0xC0000019, "STATUS_NOT_MAPPED_VIEW"},
+	{0xC000001A, "STATUS_UNABLE_TO_FREE_VM"},
+	{0xC000001B, "STATUS_UNABLE_TO_DELETE_SECTION"},
+	{0xC000001C, "STATUS_INVALID_SYSTEM_SERVICE"},
+	{0xC000001D, "STATUS_ILLEGAL_INSTRUCTION"},
+	{0xC000001E, "STATUS_INVALID_LOCK_SEQUENCE"},
+	{0xC000001F, "STATUS_INVALID_VIEW_SIZE"},
+	{0xC0000020, "STATUS_INVALID_FILE_FOR_SECTION"},
+	{0xC0000021, "STATUS_ALREADY_COMMITTED"},
+	{0xC0000022, "STATUS_ACCESS_DENIED"},
+	{0xC0000023, "STATUS_BUFFER_TOO_SMALL"},
+	{0xC0000024, "STATUS_OBJECT_TYPE_MISMATCH"},
+	{0xC0000025, "STATUS_NONCONTINUABLE_EXCEPTION"},
+	{0xC0000026, "STATUS_INVALID_DISPOSITION"},
+	{0xC0000027, "STATUS_UNWIND"},
+	{0xC0000028, "STATUS_BAD_STACK"},
+	{0xC0000029, "STATUS_INVALID_UNWIND_TARGET"},
+	{0xC000002A, "STATUS_NOT_LOCKED"},
+	{0xC000002B, "STATUS_PARITY_ERROR"},
+	{0xC000002C, "STATUS_UNABLE_TO_DECOMMIT_VM"},
+	{0xC000002D, "STATUS_NOT_COMMITTED"},
+	{0xC000002E, "STATUS_INVALID_PORT_ATTRIBUTES"},
+	{0xC000002F, "STATUS_PORT_MESSAGE_TOO_LONG"},
+	{0xC0000030, "STATUS_INVALID_PARAMETER_MIX"},
+	{0xC0000031, "STATUS_INVALID_QUOTA_LOWER"},
+	{0xC0000032, "STATUS_DISK_CORRUPT_ERROR"},
+	{0xC0000033, "STATUS_OBJECT_NAME_INVALID"},
+	{0xC0000034, "STATUS_OBJECT_NAME_NOT_FOUND"},
+	{0xC0000035, "STATUS_OBJECT_NAME_COLLISION"},
+	{0xC0000036, "STATUS_PORT_DO_NOT_DISTURB"},
+	{0xC0000037, "STATUS_PORT_DISCONNECTED"},
+	{0xC0000038, "STATUS_DEVICE_ALREADY_ATTACHED"},
+	{0xC0000039, "STATUS_OBJECT_PATH_INVALID"},
+	{0xC000003A, "STATUS_OBJECT_PATH_NOT_FOUND"},
+	{0xC000003B, "STATUS_OBJECT_PATH_SYNTAX_BAD"},
+	{0xC000003C, "STATUS_DATA_OVERRUN"},
+	{0xC000003D, "STATUS_DATA_LATE_ERROR"},
+	{0xC000003E, "STATUS_DATA_ERROR"},
+	{0xC000003F, "STATUS_CRC_ERROR"},
+	{0xC0000040, "STATUS_SECTION_TOO_BIG"},
+	{0xC0000041, "STATUS_PORT_CONNECTION_REFUSED"},
+	{0xC0000042, "STATUS_INVALID_PORT_HANDLE"},
+	{0xC0000043, "STATUS_SHARING_VIOLATION"},
+	{0xC0000044, "STATUS_QUOTA_EXCEEDED"},
+	{0xC0000045, "STATUS_INVALID_PAGE_PROTECTION"},
+	{0xC0000046, "STATUS_MUTANT_NOT_OWNED"},
+	{0xC0000047, "STATUS_SEMAPHORE_LIMIT_EXCEEDED"},
+	{0xC0000048, "STATUS_PORT_ALREADY_SET"},
+	{0xC0000049, "STATUS_SECTION_NOT_IMAGE"},
+	{0xC000004A, "STATUS_SUSPEND_COUNT_EXCEEDED"},
+	{0xC000004B, "STATUS_THREAD_IS_TERMINATING"},
+	{0xC000004C, "STATUS_BAD_WORKING_SET_LIMIT"},
+	{0xC000004D, "STATUS_INCOMPATIBLE_FILE_MAP"},
+	{0xC000004E, "STATUS_SECTION_PROTECTION"},
+	{0xC000004F, "STATUS_EAS_NOT_SUPPORTED"},
+	{0xC0000050, "STATUS_EA_TOO_LARGE"},
+	{0xC0000051, "STATUS_NONEXISTENT_EA_ENTRY"},
+	{0xC0000052, "STATUS_NO_EAS_ON_FILE"},
+	{0xC0000053, "STATUS_EA_CORRUPT_ERROR"},
+	{0xC0000054, "STATUS_FILE_LOCK_CONFLICT"},
+	{0xC0000055, "STATUS_LOCK_NOT_GRANTED"},
+	{0xC0000056, "STATUS_DELETE_PENDING"},
+	{0xC0000057, "STATUS_CTL_FILE_NOT_SUPPORTED"},
+	{0xC0000058, "STATUS_UNKNOWN_REVISION"},
+	{0xC0000059, "STATUS_REVISION_MISMATCH"},
+	{0xC000005A, "STATUS_INVALID_OWNER"},
+	{0xC000005B, "STATUS_INVALID_PRIMARY_GROUP"},
+	{0xC000005C, "STATUS_NO_IMPERSONATION_TOKEN"},
+	{0xC000005D, "STATUS_CANT_DISABLE_MANDATORY"},
+	{0xC000005E, "STATUS_NO_LOGON_SERVERS"},
+	{0xC000005F, "STATUS_NO_SUCH_LOGON_SESSION"},
+	{0xC0000060, "STATUS_NO_SUCH_PRIVILEGE"},
+	{0xC0000061, "STATUS_PRIVILEGE_NOT_HELD"},
+	{0xC0000062, "STATUS_INVALID_ACCOUNT_NAME"},
+	{0xC0000063, "STATUS_USER_EXISTS"},
+	{0xC0000064, "STATUS_NO_SUCH_USER"},
+	{0xC0000065, "STATUS_GROUP_EXISTS"},
+	{0xC0000066, "STATUS_NO_SUCH_GROUP"},
+	{0xC0000067, "STATUS_MEMBER_IN_GROUP"},
+	{0xC0000068, "STATUS_MEMBER_NOT_IN_GROUP"},
+	{0xC0000069, "STATUS_LAST_ADMIN"},
+	{0xC000006A, "STATUS_WRONG_PASSWORD"},
+	{0xC000006B, "STATUS_ILL_FORMED_PASSWORD"},
+	{0xC000006C, "STATUS_PASSWORD_RESTRICTION"},
+	{0xC000006D, "STATUS_LOGON_FAILURE"},
+	{0xC000006E, "STATUS_ACCOUNT_RESTRICTION"},
+	{0xC000006F, "STATUS_INVALID_LOGON_HOURS"},
+	{0xC0000070, "STATUS_INVALID_WORKSTATION"},
+	{0xC0000071, "STATUS_PASSWORD_EXPIRED"},
+	{0xC0000072, "STATUS_ACCOUNT_DISABLED"},
+	{0xC0000073, "STATUS_NONE_MAPPED"},
+	{0xC0000074, "STATUS_TOO_MANY_LUIDS_REQUESTED"},
+	{0xC0000075, "STATUS_LUIDS_EXHAUSTED"},
+	{0xC0000076, "STATUS_INVALID_SUB_AUTHORITY"},
+	{0xC0000077, "STATUS_INVALID_ACL"},
+	{0xC0000078, "STATUS_INVALID_SID"},
+	{0xC0000079, "STATUS_INVALID_SECURITY_DESCR"},
+	{0xC000007A, "STATUS_PROCEDURE_NOT_FOUND"},
+	{0xC000007B, "STATUS_INVALID_IMAGE_FORMAT"},
+	{0xC000007C, "STATUS_NO_TOKEN"},
+	{0xC000007D, "STATUS_BAD_INHERITANCE_ACL"},
+	{0xC000007E, "STATUS_RANGE_NOT_LOCKED"},
+	{0xC000007F, "STATUS_DISK_FULL"},
+	{0xC0000080, "STATUS_SERVER_DISABLED"},
+	{0xC0000081, "STATUS_SERVER_NOT_DISABLED"},
+	{0xC0000082, "STATUS_TOO_MANY_GUIDS_REQUESTED"},
+	{0xC0000083, "STATUS_GUIDS_EXHAUSTED"},
+	{0xC0000084, "STATUS_INVALID_ID_AUTHORITY"},
+	{0xC0000085, "STATUS_AGENTS_EXHAUSTED"},
+	{0xC0000086, "STATUS_INVALID_VOLUME_LABEL"},
+	{0xC0000087, "STATUS_SECTION_NOT_EXTENDED"},
+	{0xC0000088, "STATUS_NOT_MAPPED_DATA"},
+	{0xC0000089, "STATUS_RESOURCE_DATA_NOT_FOUND"},
+	{0xC000008A, "STATUS_RESOURCE_TYPE_NOT_FOUND"},
+	{0xC000008B, "STATUS_RESOURCE_NAME_NOT_FOUND"},
+	{0xC000008C, "STATUS_ARRAY_BOUNDS_EXCEEDED"},
+	{0xC000008D, "STATUS_FLOAT_DENORMAL_OPERAND"},
+	{0xC000008E, "STATUS_FLOAT_DIVIDE_BY_ZERO"},
+	{0xC000008F, "STATUS_FLOAT_INEXACT_RESULT"},
+	{0xC0000090, "STATUS_FLOAT_INVALID_OPERATION"},
+	{0xC0000091, "STATUS_FLOAT_OVERFLOW"},
+	{0xC0000092, "STATUS_FLOAT_STACK_CHECK"},
+	{0xC0000093, "STATUS_FLOAT_UNDERFLOW"},
+	{0xC0000094, "STATUS_INTEGER_DIVIDE_BY_ZERO"},
+	{0xC0000095, "STATUS_INTEGER_OVERFLOW"},
+	{0xC0000096, "STATUS_PRIVILEGED_INSTRUCTION"},
+	{0xC0000097, "STATUS_TOO_MANY_PAGING_FILES"},
+	{0xC0000098, "STATUS_FILE_INVALID"},
+	{0xC0000099, "STATUS_ALLOTTED_SPACE_EXCEEDED"},
+	{0xC000009A, "STATUS_INSUFFICIENT_RESOURCES"},
+	{0xC000009B, "STATUS_DFS_EXIT_PATH_FOUND"},
+	{0xC000009C, "STATUS_DEVICE_DATA_ERROR"},
+	{0xC000009D, "STATUS_DEVICE_NOT_CONNECTED"},
+	{0xC000009E, "STATUS_DEVICE_POWER_FAILURE"},
+	{0xC00000A0, "STATUS_MEMORY_NOT_ALLOCATED"},
+	{0xC00000A1, "STATUS_WORKING_SET_QUOTA"},
+	{0xC00000A2, "STATUS_MEDIA_WRITE_PROTECTED"},
+	{0xC00000A3, "STATUS_DEVICE_NOT_READY"},
+	{0xC00000A4, "STATUS_INVALID_GROUP_ATTRIBUTES"},
+	{0xC00000A5, "STATUS_BAD_IMPERSONATION_LEVEL"},
+	{0xC00000A6, "STATUS_CANT_OPEN_ANONYMOUS"},
+	{0xC00000A7, "STATUS_BAD_VALIDATION_CLASS"},
+	{0xC00000A8, "STATUS_BAD_TOKEN_TYPE"},
+	{0xC00000A9, "STATUS_BAD_MASTER_BOOT_RECORD"},
+	{0xC00000AA, "STATUS_INSTRUCTION_MISALIGNMENT"},
+	{0xC00000AB, "STATUS_INSTANCE_NOT_AVAILABLE"},
+	{0xC00000AC, "STATUS_PIPE_NOT_AVAILABLE"},
+	{0xC00000AD, "STATUS_INVALID_PIPE_STATE"},
+	{0xC00000AE, "STATUS_PIPE_BUSY"},
+	{0xC00000AF, "STATUS_ILLEGAL_FUNCTION"},
+	{0xC00000B0, "STATUS_PIPE_DISCONNECTED"},
+	{0xC00000B1, "STATUS_PIPE_CLOSING"},
+	{0xC00000B2, "STATUS_PIPE_CONNECTED"},
+	{0xC00000B3, "STATUS_PIPE_LISTENING"},
+	{0xC00000B4, "STATUS_INVALID_READ_MODE"},
+	{0xC00000B5, "STATUS_IO_TIMEOUT"},
+	{0xC00000B6, "STATUS_FILE_FORCED_CLOSED"},
+	{0xC00000B7, "STATUS_PROFILING_NOT_STARTED"},
+	{0xC00000B8, "STATUS_PROFILING_NOT_STOPPED"},
+	{0xC00000B9, "STATUS_COULD_NOT_INTERPRET"},
+	{0xC00000BA, "STATUS_FILE_IS_A_DIRECTORY"},
+	{0xC00000BB, "STATUS_NOT_SUPPORTED"},
+	{0xC00000BC, "STATUS_REMOTE_NOT_LISTENING"},
+	{0xC00000BD, "STATUS_DUPLICATE_NAME"},
+	{0xC00000BE, "STATUS_BAD_NETWORK_PATH"},
+	{0xC00000BF, "STATUS_NETWORK_BUSY"},
+	{0xC00000C0, "STATUS_DEVICE_DOES_NOT_EXIST"},
+	{0xC00000C1, "STATUS_TOO_MANY_COMMANDS"},
+	{0xC00000C2, "STATUS_ADAPTER_HARDWARE_ERROR"},
+	{0xC00000C3, "STATUS_INVALID_NETWORK_RESPONSE"},
+	{0xC00000C4, "STATUS_UNEXPECTED_NETWORK_ERROR"},
+	{0xC00000C5, "STATUS_BAD_REMOTE_ADAPTER"},
+	{0xC00000C6, "STATUS_PRINT_QUEUE_FULL"},
+	{0xC00000C7, "STATUS_NO_SPOOL_SPACE"},
+	{0xC00000C8, "STATUS_PRINT_CANCELLED"},
+	{0xC00000C9, "STATUS_NETWORK_NAME_DELETED"},
+	{0xC00000CA, "STATUS_NETWORK_ACCESS_DENIED"},
+	{0xC00000CB, "STATUS_BAD_DEVICE_TYPE"},
+	{0xC00000CC, "STATUS_BAD_NETWORK_NAME"},
+	{0xC00000CD, "STATUS_TOO_MANY_NAMES"},
+	{0xC00000CE, "STATUS_TOO_MANY_SESSIONS"},
+	{0xC00000CF, "STATUS_SHARING_PAUSED"},
+	{0xC00000D0, "STATUS_REQUEST_NOT_ACCEPTED"},
+	{0xC00000D1, "STATUS_REDIRECTOR_PAUSED"},
+	{0xC00000D2, "STATUS_NET_WRITE_FAULT"},
+	{0xC00000D3, "STATUS_PROFILING_AT_LIMIT"},
+	{0xC00000D4, "STATUS_NOT_SAME_DEVICE"},
+	{0xC00000D5, "STATUS_FILE_RENAMED"},
+	{0xC00000D6, "STATUS_VIRTUAL_CIRCUIT_CLOSED"},
+	{0xC00000D7, "STATUS_NO_SECURITY_ON_OBJECT"},
+	{0xC00000D8, "STATUS_CANT_WAIT"},
+	{0xC00000D9, "STATUS_PIPE_EMPTY"},
+	{0xC00000DA, "STATUS_CANT_ACCESS_DOMAIN_INFO"},
+	{0xC00000DB, "STATUS_CANT_TERMINATE_SELF"},
+	{0xC00000DC, "STATUS_INVALID_SERVER_STATE"},
+	{0xC00000DD, "STATUS_INVALID_DOMAIN_STATE"},
+	{0xC00000DE, "STATUS_INVALID_DOMAIN_ROLE"},
+	{0xC00000DF, "STATUS_NO_SUCH_DOMAIN"},
+	{0xC00000E0, "STATUS_DOMAIN_EXISTS"},
+	{0xC00000E1, "STATUS_DOMAIN_LIMIT_EXCEEDED"},
+	{0xC00000E2, "STATUS_OPLOCK_NOT_GRANTED"},
+	{0xC00000E3, "STATUS_INVALID_OPLOCK_PROTOCOL"},
+	{0xC00000E4, "STATUS_INTERNAL_DB_CORRUPTION"},
+	{0xC00000E5, "STATUS_INTERNAL_ERROR"},
+	{0xC00000E6, "STATUS_GENERIC_NOT_MAPPED"},
+	{0xC00000E7, "STATUS_BAD_DESCRIPTOR_FORMAT"},
+	{0xC00000E8, "STATUS_INVALID_USER_BUFFER"},
+	{0xC00000E9, "STATUS_UNEXPECTED_IO_ERROR"},
+	{0xC00000EA, "STATUS_UNEXPECTED_MM_CREATE_ERR"},
+	{0xC00000EB, "STATUS_UNEXPECTED_MM_MAP_ERROR"},
+	{0xC00000EC, "STATUS_UNEXPECTED_MM_EXTEND_ERR"},
+	{0xC00000ED, "STATUS_NOT_LOGON_PROCESS"},
+	{0xC00000EE, "STATUS_LOGON_SESSION_EXISTS"},
+	{0xC00000EF, "STATUS_INVALID_PARAMETER_1"},
+	{0xC00000F0, "STATUS_INVALID_PARAMETER_2"},
+	{0xC00000F1, "STATUS_INVALID_PARAMETER_3"},
+	{0xC00000F2, "STATUS_INVALID_PARAMETER_4"},
+	{0xC00000F3, "STATUS_INVALID_PARAMETER_5"},
+	{0xC00000F4, "STATUS_INVALID_PARAMETER_6"},
+	{0xC00000F5, "STATUS_INVALID_PARAMETER_7"},
+	{0xC00000F6, "STATUS_INVALID_PARAMETER_8"},
+	{0xC00000F7, "STATUS_INVALID_PARAMETER_9"},
+	{0xC00000F8, "STATUS_INVALID_PARAMETER_10"},
+	{0xC00000F9, "STATUS_INVALID_PARAMETER_11"},
+	{0xC00000FA, "STATUS_INVALID_PARAMETER_12"},
+	{0xC00000FB, "STATUS_REDIRECTOR_NOT_STARTED"},
+	{0xC00000FC, "STATUS_REDIRECTOR_STARTED"},
+	{0xC00000FD, "STATUS_STACK_OVERFLOW"},
+	{0xC00000FE, "STATUS_NO_SUCH_PACKAGE"},
+	{0xC00000FF, "STATUS_BAD_FUNCTION_TABLE"},
+	{0xC0000100, "STATUS_VARIABLE_NOT_FOUND"},
+	{0xC0000101, "STATUS_DIRECTORY_NOT_EMPTY"},
+	{0xC0000102, "STATUS_FILE_CORRUPT_ERROR"},
+	{0xC0000103, "STATUS_NOT_A_DIRECTORY"},
+	{0xC0000104, "STATUS_BAD_LOGON_SESSION_STATE"},
+	{0xC0000105, "STATUS_LOGON_SESSION_COLLISION"},
+	{0xC0000106, "STATUS_NAME_TOO_LONG"},
+	{0xC0000107, "STATUS_FILES_OPEN"},
+	{0xC0000108, "STATUS_CONNECTION_IN_USE"},
+	{0xC0000109, "STATUS_MESSAGE_NOT_FOUND"},
+	{0xC000010A, "STATUS_PROCESS_IS_TERMINATING"},
+	{0xC000010B, "STATUS_INVALID_LOGON_TYPE"},
+	{0xC000010C, "STATUS_NO_GUID_TRANSLATION"},
+	{0xC000010D, "STATUS_CANNOT_IMPERSONATE"},
+	{0xC000010E, "STATUS_IMAGE_ALREADY_LOADED"},
+	{0xC000010F, "STATUS_ABIOS_NOT_PRESENT"},
+	{0xC0000110, "STATUS_ABIOS_LID_NOT_EXIST"},
+	{0xC0000111, "STATUS_ABIOS_LID_ALREADY_OWNED"},
+	{0xC0000112, "STATUS_ABIOS_NOT_LID_OWNER"},
+	{0xC0000113, "STATUS_ABIOS_INVALID_COMMAND"},
+	{0xC0000114, "STATUS_ABIOS_INVALID_LID"},
+	{0xC0000115, "STATUS_ABIOS_SELECTOR_NOT_AVAILABLE"},
+	{0xC0000116, "STATUS_ABIOS_INVALID_SELECTOR"},
+	{0xC0000117, "STATUS_NO_LDT"},
+	{0xC0000118, "STATUS_INVALID_LDT_SIZE"},
+	{0xC0000119, "STATUS_INVALID_LDT_OFFSET"},
+	{0xC000011A, "STATUS_INVALID_LDT_DESCRIPTOR"},
+	{0xC000011B, "STATUS_INVALID_IMAGE_NE_FORMAT"},
+	{0xC000011C, "STATUS_RXACT_INVALID_STATE"},
+	{0xC000011D, "STATUS_RXACT_COMMIT_FAILURE"},
+	{0xC000011E, "STATUS_MAPPED_FILE_SIZE_ZERO"},
+	{0xC000011F, "STATUS_TOO_MANY_OPENED_FILES"},
+	{0xC0000120, "STATUS_CANCELLED"},
+	{0xC0000121, "STATUS_CANNOT_DELETE"},
+	{0xC0000122, "STATUS_INVALID_COMPUTER_NAME"},
+	{0xC0000123, "STATUS_FILE_DELETED"},
+	{0xC0000124, "STATUS_SPECIAL_ACCOUNT"},
+	{0xC0000125, "STATUS_SPECIAL_GROUP"},
+	{0xC0000126, "STATUS_SPECIAL_USER"},
+	{0xC0000127, "STATUS_MEMBERS_PRIMARY_GROUP"},
+	{0xC0000128, "STATUS_FILE_CLOSED"},
+	{0xC0000129, "STATUS_TOO_MANY_THREADS"},
+	{0xC000012A, "STATUS_THREAD_NOT_IN_PROCESS"},
+	{0xC000012B, "STATUS_TOKEN_ALREADY_IN_USE"},
+	{0xC000012C, "STATUS_PAGEFILE_QUOTA_EXCEEDED"},
+	{0xC000012D, "STATUS_COMMITMENT_LIMIT"},
+	{0xC000012E, "STATUS_INVALID_IMAGE_LE_FORMAT"},
+	{0xC000012F, "STATUS_INVALID_IMAGE_NOT_MZ"},
+	{0xC0000130, "STATUS_INVALID_IMAGE_PROTECT"},
+	{0xC0000131, "STATUS_INVALID_IMAGE_WIN_16"},
+	{0xC0000132, "STATUS_LOGON_SERVER_CONFLICT"},
+	{0xC0000133, "STATUS_TIME_DIFFERENCE_AT_DC"},
+	{0xC0000134, "STATUS_SYNCHRONIZATION_REQUIRED"},
+	{0xC0000135, "STATUS_DLL_NOT_FOUND"},
+	{0xC0000136, "STATUS_OPEN_FAILED"},
+	{0xC0000137, "STATUS_IO_PRIVILEGE_FAILED"},
+	{0xC0000138, "STATUS_ORDINAL_NOT_FOUND"},
+	{0xC0000139, "STATUS_ENTRYPOINT_NOT_FOUND"},
+	{0xC000013A, "STATUS_CONTROL_C_EXIT"},
+	{0xC000013B, "STATUS_LOCAL_DISCONNECT"},
+	{0xC000013C, "STATUS_REMOTE_DISCONNECT"},
+	{0xC000013D, "STATUS_REMOTE_RESOURCES"},
+	{0xC000013E, "STATUS_LINK_FAILED"},
+	{0xC000013F, "STATUS_LINK_TIMEOUT"},
+	{0xC0000140, "STATUS_INVALID_CONNECTION"},
+	{0xC0000141, "STATUS_INVALID_ADDRESS"},
+	{0xC0000142, "STATUS_DLL_INIT_FAILED"},
+	{0xC0000143, "STATUS_MISSING_SYSTEMFILE"},
+	{0xC0000144, "STATUS_UNHANDLED_EXCEPTION"},
+	{0xC0000145, "STATUS_APP_INIT_FAILURE"},
+	{0xC0000146, "STATUS_PAGEFILE_CREATE_FAILED"},
+	{0xC0000147, "STATUS_NO_PAGEFILE"},
+	{0xC0000148, "STATUS_INVALID_LEVEL"},
+	{0xC0000149, "STATUS_WRONG_PASSWORD_CORE"},
+	{0xC000014A, "STATUS_ILLEGAL_FLOAT_CONTEXT"},
+	{0xC000014B, "STATUS_PIPE_BROKEN"},
+	{0xC000014C, "STATUS_REGISTRY_CORRUPT"},
+	{0xC000014D, "STATUS_REGISTRY_IO_FAILED"},
+	{0xC000014E, "STATUS_NO_EVENT_PAIR"},
+	{0xC000014F, "STATUS_UNRECOGNIZED_VOLUME"},
+	{0xC0000150, "STATUS_SERIAL_NO_DEVICE_INITED"},
+	{0xC0000151, "STATUS_NO_SUCH_ALIAS"},
+	{0xC0000152, "STATUS_MEMBER_NOT_IN_ALIAS"},
+	{0xC0000153, "STATUS_MEMBER_IN_ALIAS"},
+	{0xC0000154, "STATUS_ALIAS_EXISTS"},
+	{0xC0000155, "STATUS_LOGON_NOT_GRANTED"},
+	{0xC0000156, "STATUS_TOO_MANY_SECRETS"},
+	{0xC0000157, "STATUS_SECRET_TOO_LONG"},
+	{0xC0000158, "STATUS_INTERNAL_DB_ERROR"},
+	{0xC0000159, "STATUS_FULLSCREEN_MODE"},
+	{0xC000015A, "STATUS_TOO_MANY_CONTEXT_IDS"},
+	{0xC000015B, "STATUS_LOGON_TYPE_NOT_GRANTED"},
+	{0xC000015C, "STATUS_NOT_REGISTRY_FILE"},
+	{0xC000015D, "STATUS_NT_CROSS_ENCRYPTION_REQUIRED"},
+	{0xC000015E, "STATUS_DOMAIN_CTRLR_CONFIG_ERROR"},
+	{0xC000015F, "STATUS_FT_MISSING_MEMBER"},
+	{0xC0000160, "STATUS_ILL_FORMED_SERVICE_ENTRY"},
+	{0xC0000161, "STATUS_ILLEGAL_CHARACTER"},
+	{0xC0000162, "STATUS_UNMAPPABLE_CHARACTER"},
+	{0xC0000163, "STATUS_UNDEFINED_CHARACTER"},
+	{0xC0000164, "STATUS_FLOPPY_VOLUME"},
+	{0xC0000165, "STATUS_FLOPPY_ID_MARK_NOT_FOUND"},
+	{0xC0000166, "STATUS_FLOPPY_WRONG_CYLINDER"},
+	{0xC0000167, "STATUS_FLOPPY_UNKNOWN_ERROR"},
+	{0xC0000168, "STATUS_FLOPPY_BAD_REGISTERS"},
+	{0xC0000169, "STATUS_DISK_RECALIBRATE_FAILED"},
+	{0xC000016A, "STATUS_DISK_OPERATION_FAILED"},
+	{0xC000016B, "STATUS_DISK_RESET_FAILED"},
+	{0xC000016C, "STATUS_SHARED_IRQ_BUSY"},
+	{0xC000016D, "STATUS_FT_ORPHANING"},
+	{0xC000016E, "STATUS_BIOS_FAILED_TO_CONNECT_INTERRUPT"},
+	{0xC0000172, "STATUS_PARTITION_FAILURE"},
+	{0xC0000173, "STATUS_INVALID_BLOCK_LENGTH"},
+	{0xC0000174, "STATUS_DEVICE_NOT_PARTITIONED"},
+	{0xC0000175, "STATUS_UNABLE_TO_LOCK_MEDIA"},
+	{0xC0000176, "STATUS_UNABLE_TO_UNLOAD_MEDIA"},
+	{0xC0000177, "STATUS_EOM_OVERFLOW"},
+	{0xC0000178, "STATUS_NO_MEDIA"},
+	{0xC000017A, "STATUS_NO_SUCH_MEMBER"},
+	{0xC000017B, "STATUS_INVALID_MEMBER"},
+	{0xC000017C, "STATUS_KEY_DELETED"},
+	{0xC000017D, "STATUS_NO_LOG_SPACE"},
+	{0xC000017E, "STATUS_TOO_MANY_SIDS"},
+	{0xC000017F, "STATUS_LM_CROSS_ENCRYPTION_REQUIRED"},
+	{0xC0000180, "STATUS_KEY_HAS_CHILDREN"},
+	{0xC0000181, "STATUS_CHILD_MUST_BE_VOLATILE"},
+	{0xC0000182, "STATUS_DEVICE_CONFIGURATION_ERROR"},
+	{0xC0000183, "STATUS_DRIVER_INTERNAL_ERROR"},
+	{0xC0000184, "STATUS_INVALID_DEVICE_STATE"},
+	{0xC0000185, "STATUS_IO_DEVICE_ERROR"},
+	{0xC0000186, "STATUS_DEVICE_PROTOCOL_ERROR"},
+	{0xC0000187, "STATUS_BACKUP_CONTROLLER"},
+	{0xC0000188, "STATUS_LOG_FILE_FULL"},
+	{0xC0000189, "STATUS_TOO_LATE"},
+	{0xC000018A, "STATUS_NO_TRUST_LSA_SECRET"},
+	{0xC000018B, "STATUS_NO_TRUST_SAM_ACCOUNT"},
+	{0xC000018C, "STATUS_TRUSTED_DOMAIN_FAILURE"},
+	{0xC000018D, "STATUS_TRUSTED_RELATIONSHIP_FAILURE"},
+	{0xC000018E, "STATUS_EVENTLOG_FILE_CORRUPT"},
+	{0xC000018F, "STATUS_EVENTLOG_CANT_START"},
+	{0xC0000190, "STATUS_TRUST_FAILURE"},
+	{0xC0000191, "STATUS_MUTANT_LIMIT_EXCEEDED"},
+	{0xC0000192, "STATUS_NETLOGON_NOT_STARTED"},
+	{0xC0000193, "STATUS_ACCOUNT_EXPIRED"},
+	{0xC0000194, "STATUS_POSSIBLE_DEADLOCK"},
+	{0xC0000195, "STATUS_NETWORK_CREDENTIAL_CONFLICT"},
+	{0xC0000196, "STATUS_REMOTE_SESSION_LIMIT"},
+	{0xC0000197, "STATUS_EVENTLOG_FILE_CHANGED"},
+	{0xC0000198, "STATUS_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT"},
+	{0xC0000199, "STATUS_NOLOGON_WORKSTATION_TRUST_ACCOUNT"},
+	{0xC000019A, "STATUS_NOLOGON_SERVER_TRUST_ACCOUNT"},
+	{0xC000019B, "STATUS_DOMAIN_TRUST_INCONSISTENT"},
+	{0xC000019C, "STATUS_FS_DRIVER_REQUIRED"},
+	{0xC000019D, "STATUS_IMAGE_ALREADY_LOADED_AS_DLL"},
+	{0xC000019E, "STATUS_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING"},
+	{0xC000019F, "STATUS_SHORT_NAMES_NOT_ENABLED_ON_VOLUME"},
+	{0xC00001A0, "STATUS_SECURITY_STREAM_IS_INCONSISTENT"},
+	{0xC00001A1, "STATUS_INVALID_LOCK_RANGE"},
+	{0xC00001A2, "STATUS_INVALID_ACE_CONDITION"},
+	{0xC00001A3, "STATUS_IMAGE_SUBSYSTEM_NOT_PRESENT"},
+	{0xC00001A4, "STATUS_NOTIFICATION_GUID_ALREADY_DEFINED"},
+	{0xC00001A5, "STATUS_INVALID_EXCEPTION_HANDLER"},
+	{0xC00001A6, "STATUS_DUPLICATE_PRIVILEGES"},
+	{0xC00001A7, "STATUS_NOT_ALLOWED_ON_SYSTEM_FILE"},
+	{0xC00001A8, "STATUS_REPAIR_NEEDED"},
+	{0xC00001A9, "STATUS_QUOTA_NOT_ENABLED"},
+	{0xC00001AA, "STATUS_NO_APPLICATION_PACKAGE"},
+	{0xC00001AB, "STATUS_FILE_METADATA_OPTIMIZATION_IN_PROGRESS"},
+	{0xC00001AC, "STATUS_NOT_SAME_OBJECT"},
+	{0xC00001AD, "STATUS_FATAL_MEMORY_EXHAUSTION"},
+	{0xC00001AE, "STATUS_ERROR_PROCESS_NOT_IN_JOB"},
+	{0xC00001AF, "STATUS_CPU_SET_INVALID"},
+	{0xC00001B0, "STATUS_IO_DEVICE_INVALID_DATA"},
+	{0xC00001B1, "STATUS_IO_UNALIGNED_WRITE"},
+	{0xC0000201, "STATUS_NETWORK_OPEN_RESTRICTION"},
+	{0xC0000202, "STATUS_NO_USER_SESSION_KEY"},
+	{0xC0000203, "STATUS_USER_SESSION_DELETED"},
+	{0xC0000204, "STATUS_RESOURCE_LANG_NOT_FOUND"},
+	{0xC0000205, "STATUS_INSUFF_SERVER_RESOURCES"},
+	{0xC0000206, "STATUS_INVALID_BUFFER_SIZE"},
+	{0xC0000207, "STATUS_INVALID_ADDRESS_COMPONENT"},
+	{0xC0000208, "STATUS_INVALID_ADDRESS_WILDCARD"},
+	{0xC0000209, "STATUS_TOO_MANY_ADDRESSES"},
+	{0xC000020A, "STATUS_ADDRESS_ALREADY_EXISTS"},
+	{0xC000020B, "STATUS_ADDRESS_CLOSED"},
+	{0xC000020C, "STATUS_CONNECTION_DISCONNECTED"},
+	{0xC000020D, "STATUS_CONNECTION_RESET"},
+	{0xC000020E, "STATUS_TOO_MANY_NODES"},
+	{0xC000020F, "STATUS_TRANSACTION_ABORTED"},
+	{0xC0000210, "STATUS_TRANSACTION_TIMED_OUT"},
+	{0xC0000211, "STATUS_TRANSACTION_NO_RELEASE"},
+	{0xC0000212, "STATUS_TRANSACTION_NO_MATCH"},
+	{0xC0000213, "STATUS_TRANSACTION_RESPONDED"},
+	{0xC0000214, "STATUS_TRANSACTION_INVALID_ID"},
+	{0xC0000215, "STATUS_TRANSACTION_INVALID_TYPE"},
+	{0xC0000216, "STATUS_NOT_SERVER_SESSION"},
+	{0xC0000217, "STATUS_NOT_CLIENT_SESSION"},
+	{0xC0000218, "STATUS_CANNOT_LOAD_REGISTRY_FILE"},
+	{0xC0000219, "STATUS_DEBUG_ATTACH_FAILED"},
+	{0xC000021A, "STATUS_SYSTEM_PROCESS_TERMINATED"},
+	{0xC000021B, "STATUS_DATA_NOT_ACCEPTED"},
+	{0xC000021C, "STATUS_NO_BROWSER_SERVERS_FOUND"},
+	{0xC000021D, "STATUS_VDM_HARD_ERROR"},
+	{0xC000021E, "STATUS_DRIVER_CANCEL_TIMEOUT"},
+	{0xC000021F, "STATUS_REPLY_MESSAGE_MISMATCH"},
+	{0xC0000220, "STATUS_MAPPED_ALIGNMENT"},
+	{0xC0000221, "STATUS_IMAGE_CHECKSUM_MISMATCH"},
+	{0xC0000222, "STATUS_LOST_WRITEBEHIND_DATA"},
+	{0xC0000223, "STATUS_CLIENT_SERVER_PARAMETERS_INVALID"},
+	{0xC0000224, "STATUS_PASSWORD_MUST_CHANGE"},
+	{0xC0000225, "STATUS_NOT_FOUND"},
+	{0xC0000226, "STATUS_NOT_TINY_STREAM"},
+	{0xC0000227, "STATUS_RECOVERY_FAILURE"},
+	{0xC0000228, "STATUS_STACK_OVERFLOW_READ"},
+	{0xC0000229, "STATUS_FAIL_CHECK"},
+	{0xC000022A, "STATUS_DUPLICATE_OBJECTID"},
+	{0xC000022B, "STATUS_OBJECTID_EXISTS"},
+	{0xC000022C, "STATUS_CONVERT_TO_LARGE"},
+	{0xC000022D, "STATUS_RETRY"},
+	{0xC000022E, "STATUS_FOUND_OUT_OF_SCOPE"},
+	{0xC000022F, "STATUS_ALLOCATE_BUCKET"},
+	{0xC0000230, "STATUS_PROPSET_NOT_FOUND"},
+	{0xC0000231, "STATUS_MARSHALL_OVERFLOW"},
+	{0xC0000232, "STATUS_INVALID_VARIANT"},
+	{0xC0000233, "STATUS_DOMAIN_CONTROLLER_NOT_FOUND"},
+	{0xC0000234, "STATUS_ACCOUNT_LOCKED_OUT"},
+	{0xC0000235, "STATUS_HANDLE_NOT_CLOSABLE"},
+	{0xC0000236, "STATUS_CONNECTION_REFUSED"},
+	{0xC0000237, "STATUS_GRACEFUL_DISCONNECT"},
+	{0xC0000238, "STATUS_ADDRESS_ALREADY_ASSOCIATED"},
+	{0xC0000239, "STATUS_ADDRESS_NOT_ASSOCIATED"},
+	{0xC000023A, "STATUS_CONNECTION_INVALID"},
+	{0xC000023B, "STATUS_CONNECTION_ACTIVE"},
+	{0xC000023C, "STATUS_NETWORK_UNREACHABLE"},
+	{0xC000023D, "STATUS_HOST_UNREACHABLE"},
+	{0xC000023E, "STATUS_PROTOCOL_UNREACHABLE"},
+	{0xC000023F, "STATUS_PORT_UNREACHABLE"},
+	{0xC0000240, "STATUS_REQUEST_ABORTED"},
+	{0xC0000241, "STATUS_CONNECTION_ABORTED"},
+	{0xC0000242, "STATUS_BAD_COMPRESSION_BUFFER"},
+	{0xC0000243, "STATUS_USER_MAPPED_FILE"},
+	{0xC0000244, "STATUS_AUDIT_FAILED"},
+	{0xC0000245, "STATUS_TIMER_RESOLUTION_NOT_SET"},
+	{0xC0000246, "STATUS_CONNECTION_COUNT_LIMIT"},
+	{0xC0000247, "STATUS_LOGIN_TIME_RESTRICTION"},
+	{0xC0000248, "STATUS_LOGIN_WKSTA_RESTRICTION"},
+	{0xC0000249, "STATUS_IMAGE_MP_UP_MISMATCH"},
+	{0xC0000250, "STATUS_INSUFFICIENT_LOGON_INFO"},
+	{0xC0000251, "STATUS_BAD_DLL_ENTRYPOINT"},
+	{0xC0000252, "STATUS_BAD_SERVICE_ENTRYPOINT"},
+	{0xC0000253, "STATUS_LPC_REPLY_LOST"},
+	{0xC0000254, "STATUS_IP_ADDRESS_CONFLICT1"},
+	{0xC0000255, "STATUS_IP_ADDRESS_CONFLICT2"},
+	{0xC0000256, "STATUS_REGISTRY_QUOTA_LIMIT"},
+	{0xC0000257, "STATUS_PATH_NOT_COVERED"},
+	{0xC0000258, "STATUS_NO_CALLBACK_ACTIVE"},
+	{0xC0000259, "STATUS_LICENSE_QUOTA_EXCEEDED"},
+	{0xC000025A, "STATUS_PWD_TOO_SHORT"},
+	{0xC000025B, "STATUS_PWD_TOO_RECENT"},
+	{0xC000025C, "STATUS_PWD_HISTORY_CONFLICT"},
+	{0xC000025E, "STATUS_PLUGPLAY_NO_DEVICE"},
+	{0xC000025F, "STATUS_UNSUPPORTED_COMPRESSION"},
+	{0xC0000260, "STATUS_INVALID_HW_PROFILE"},
+	{0xC0000261, "STATUS_INVALID_PLUGPLAY_DEVICE_PATH"},
+	{0xC0000262, "STATUS_DRIVER_ORDINAL_NOT_FOUND"},
+	{0xC0000263, "STATUS_DRIVER_ENTRYPOINT_NOT_FOUND"},
+	{0xC0000264, "STATUS_RESOURCE_NOT_OWNED"},
+	{0xC0000265, "STATUS_TOO_MANY_LINKS"},
+	{0xC0000266, "STATUS_QUOTA_LIST_INCONSISTENT"},
+	{0xC0000267, "STATUS_FILE_IS_OFFLINE"},
+	{0xC0000268, "STATUS_EVALUATION_EXPIRATION"},
+	{0xC0000269, "STATUS_ILLEGAL_DLL_RELOCATION"},
+	{0xC000026A, "STATUS_LICENSE_VIOLATION"},
+	{0xC000026B, "STATUS_DLL_INIT_FAILED_LOGOFF"},
+	{0xC000026C, "STATUS_DRIVER_UNABLE_TO_LOAD"},
+	{0xC000026D, "STATUS_DFS_UNAVAILABLE"},
+	{0xC000026E, "STATUS_VOLUME_DISMOUNTED"},
+	{0xC000026F, "STATUS_WX86_INTERNAL_ERROR"},
+	{0xC0000270, "STATUS_WX86_FLOAT_STACK_CHECK"},
+	{0xC0000271, "STATUS_VALIDATE_CONTINUE"},
+	{0xC0000272, "STATUS_NO_MATCH"},
+	{0xC0000273, "STATUS_NO_MORE_MATCHES"},
+	{0xC0000275, "STATUS_NOT_A_REPARSE_POINT"},
+	{0xC0000276, "STATUS_IO_REPARSE_TAG_INVALID"},
+	{0xC0000277, "STATUS_IO_REPARSE_TAG_MISMATCH"},
+	{0xC0000278, "STATUS_IO_REPARSE_DATA_INVALID"},
+	{0xC0000279, "STATUS_IO_REPARSE_TAG_NOT_HANDLED"},
+	{0xC000027A, "STATUS_PWD_TOO_LONG"},
+	{0xC000027B, "STATUS_STOWED_EXCEPTION"},
+	{0xC000027C, "STATUS_CONTEXT_STOWED_EXCEPTION"},
+	{0xC0000280, "STATUS_REPARSE_POINT_NOT_RESOLVED"},
+	{0xC0000281, "STATUS_DIRECTORY_IS_A_REPARSE_POINT"},
+	{0xC0000282, "STATUS_RANGE_LIST_CONFLICT"},
+	{0xC0000283, "STATUS_SOURCE_ELEMENT_EMPTY"},
+	{0xC0000284, "STATUS_DESTINATION_ELEMENT_FULL"},
+	{0xC0000285, "STATUS_ILLEGAL_ELEMENT_ADDRESS"},
+	{0xC0000286, "STATUS_MAGAZINE_NOT_PRESENT"},
+	{0xC0000287, "STATUS_REINITIALIZATION_NEEDED"},
+	{0xC000028A, "STATUS_ENCRYPTION_FAILED"},
+	{0xC000028B, "STATUS_DECRYPTION_FAILED"},
+	{0xC000028C, "STATUS_RANGE_NOT_FOUND"},
+	{0xC000028D, "STATUS_NO_RECOVERY_POLICY"},
+	{0xC000028E, "STATUS_NO_EFS"},
+	{0xC000028F, "STATUS_WRONG_EFS"},
+	{0xC0000290, "STATUS_NO_USER_KEYS"},
+	{0xC0000291, "STATUS_FILE_NOT_ENCRYPTED"},
+	{0xC0000292, "STATUS_NOT_EXPORT_FORMAT"},
+	{0xC0000293, "STATUS_FILE_ENCRYPTED"},
+	{0xC0000295, "STATUS_WMI_GUID_NOT_FOUND"},
+	{0xC0000296, "STATUS_WMI_INSTANCE_NOT_FOUND"},
+	{0xC0000297, "STATUS_WMI_ITEMID_NOT_FOUND"},
+	{0xC0000298, "STATUS_WMI_TRY_AGAIN"},
+	{0xC0000299, "STATUS_SHARED_POLICY"},
+	{0xC000029A, "STATUS_POLICY_OBJECT_NOT_FOUND"},
+	{0xC000029B, "STATUS_POLICY_ONLY_IN_DS"},
+	{0xC000029C, "STATUS_VOLUME_NOT_UPGRADED"},
+	{0xC000029D, "STATUS_REMOTE_STORAGE_NOT_ACTIVE"},
+	{0xC000029E, "STATUS_REMOTE_STORAGE_MEDIA_ERROR"},
+	{0xC000029F, "STATUS_NO_TRACKING_SERVICE"},
+	{0xC00002A0, "STATUS_SERVER_SID_MISMATCH"},
+	{0xC00002A1, "STATUS_DS_NO_ATTRIBUTE_OR_VALUE"},
+	{0xC00002A2, "STATUS_DS_INVALID_ATTRIBUTE_SYNTAX"},
+	{0xC00002A3, "STATUS_DS_ATTRIBUTE_TYPE_UNDEFINED"},
+	{0xC00002A4, "STATUS_DS_ATTRIBUTE_OR_VALUE_EXISTS"},
+	{0xC00002A5, "STATUS_DS_BUSY"},
+	{0xC00002A6, "STATUS_DS_UNAVAILABLE"},
+	{0xC00002A7, "STATUS_DS_NO_RIDS_ALLOCATED"},
+	{0xC00002A8, "STATUS_DS_NO_MORE_RIDS"},
+	{0xC00002A9, "STATUS_DS_INCORRECT_ROLE_OWNER"},
+	{0xC00002AA, "STATUS_DS_RIDMGR_INIT_ERROR"},
+	{0xC00002AB, "STATUS_DS_OBJ_CLASS_VIOLATION"},
+	{0xC00002AC, "STATUS_DS_CANT_ON_NON_LEAF"},
+	{0xC00002AD, "STATUS_DS_CANT_ON_RDN"},
+	{0xC00002AE, "STATUS_DS_CANT_MOD_OBJ_CLASS"},
+	{0xC00002AF, "STATUS_DS_CROSS_DOM_MOVE_FAILED"},
+	{0xC00002B0, "STATUS_DS_GC_NOT_AVAILABLE"},
+	{0xC00002B1, "STATUS_DIRECTORY_SERVICE_REQUIRED"},
+	{0xC00002B2, "STATUS_REPARSE_ATTRIBUTE_CONFLICT"},
+	{0xC00002B3, "STATUS_CANT_ENABLE_DENY_ONLY"},
+	{0xC00002B4, "STATUS_FLOAT_MULTIPLE_FAULTS"},
+	{0xC00002B5, "STATUS_FLOAT_MULTIPLE_TRAPS"},
+	{0xC00002B6, "STATUS_DEVICE_REMOVED"},
+	{0xC00002B7, "STATUS_JOURNAL_DELETE_IN_PROGRESS"},
+	{0xC00002B8, "STATUS_JOURNAL_NOT_ACTIVE"},
+	{0xC00002B9, "STATUS_NOINTERFACE"},
+	{0xC00002BA, "STATUS_DS_RIDMGR_DISABLED"},
+	{0xC00002C1, "STATUS_DS_ADMIN_LIMIT_EXCEEDED"},
+	{0xC00002C2, "STATUS_DRIVER_FAILED_SLEEP"},
+	{0xC00002C3, "STATUS_MUTUAL_AUTHENTICATION_FAILED"},
+	{0xC00002C4, "STATUS_CORRUPT_SYSTEM_FILE"},
+	{0xC00002C5, "STATUS_DATATYPE_MISALIGNMENT_ERROR"},
+	{0xC00002C6, "STATUS_WMI_READ_ONLY"},
+	{0xC00002C7, "STATUS_WMI_SET_FAILURE"},
+	{0xC00002C8, "STATUS_COMMITMENT_MINIMUM"},
+	{0xC00002C9, "STATUS_REG_NAT_CONSUMPTION"},
+	{0xC00002CA, "STATUS_TRANSPORT_FULL"},
+	{0xC00002CB, "STATUS_DS_SAM_INIT_FAILURE"},
+	{0xC00002CC, "STATUS_ONLY_IF_CONNECTED"},
+	{0xC00002CD, "STATUS_DS_SENSITIVE_GROUP_VIOLATION"},
+	{0xC00002CE, "STATUS_PNP_RESTART_ENUMERATION"},
+	{0xC00002CF, "STATUS_JOURNAL_ENTRY_DELETED"},
+	{0xC00002D0, "STATUS_DS_CANT_MOD_PRIMARYGROUPID"},
+	{0xC00002D1, "STATUS_SYSTEM_IMAGE_BAD_SIGNATURE"},
+	{0xC00002D2, "STATUS_PNP_REBOOT_REQUIRED"},
+	{0xC00002D3, "STATUS_POWER_STATE_INVALID"},
+	{0xC00002D4, "STATUS_DS_INVALID_GROUP_TYPE"},
+	{0xC00002D5, "STATUS_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN"},
+	{0xC00002D6, "STATUS_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN"},
+	{0xC00002D7, "STATUS_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER"},
+	{0xC00002D8, "STATUS_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER"},
+	{0xC00002D9, "STATUS_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER"},
+	{0xC00002DA, "STATUS_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER"},
+	{0xC00002DB, "STATUS_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER"},
+	{0xC00002DC, "STATUS_DS_HAVE_PRIMARY_MEMBERS"},
+	{0xC00002DD, "STATUS_WMI_NOT_SUPPORTED"},
+	{0xC00002DE, "STATUS_INSUFFICIENT_POWER"},
+	{0xC00002DF, "STATUS_SAM_NEED_BOOTKEY_PASSWORD"},
+	{0xC00002E0, "STATUS_SAM_NEED_BOOTKEY_FLOPPY"},
+	{0xC00002E1, "STATUS_DS_CANT_START"},
+	{0xC00002E2, "STATUS_DS_INIT_FAILURE"},
+	{0xC00002E3, "STATUS_SAM_INIT_FAILURE"},
+	{0xC00002E4, "STATUS_DS_GC_REQUIRED"},
+	{0xC00002E5, "STATUS_DS_LOCAL_MEMBER_OF_LOCAL_ONLY"},
+	{0xC00002E6, "STATUS_DS_NO_FPO_IN_UNIVERSAL_GROUPS"},
+	{0xC00002E7, "STATUS_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED"},
+	{0xC00002E8, "STATUS_MULTIPLE_FAULT_VIOLATION"},
+	{0xC00002E9, "STATUS_CURRENT_DOMAIN_NOT_ALLOWED"},
+	{0xC00002EA, "STATUS_CANNOT_MAKE"},
+	{0xC00002EB, "STATUS_SYSTEM_SHUTDOWN"},
+	{0xC00002EC, "STATUS_DS_INIT_FAILURE_CONSOLE"},
+	{0xC00002ED, "STATUS_DS_SAM_INIT_FAILURE_CONSOLE"},
+	{0xC00002EE, "STATUS_UNFINISHED_CONTEXT_DELETED"},
+	{0xC00002EF, "STATUS_NO_TGT_REPLY"},
+	{0xC00002F0, "STATUS_OBJECTID_NOT_FOUND"},
+	{0xC00002F1, "STATUS_NO_IP_ADDRESSES"},
+	{0xC00002F2, "STATUS_WRONG_CREDENTIAL_HANDLE"},
+	{0xC00002F3, "STATUS_CRYPTO_SYSTEM_INVALID"},
+	{0xC00002F4, "STATUS_MAX_REFERRALS_EXCEEDED"},
+	{0xC00002F5, "STATUS_MUST_BE_KDC"},
+	{0xC00002F6, "STATUS_STRONG_CRYPTO_NOT_SUPPORTED"},
+	{0xC00002F7, "STATUS_TOO_MANY_PRINCIPALS"},
+	{0xC00002F8, "STATUS_NO_PA_DATA"},
+	{0xC00002F9, "STATUS_PKINIT_NAME_MISMATCH"},
+	{0xC00002FA, "STATUS_SMARTCARD_LOGON_REQUIRED"},
+	{0xC00002FB, "STATUS_KDC_INVALID_REQUEST"},
+	{0xC00002FC, "STATUS_KDC_UNABLE_TO_REFER"},
+	{0xC00002FD, "STATUS_KDC_UNKNOWN_ETYPE"},
+	{0xC00002FE, "STATUS_SHUTDOWN_IN_PROGRESS"},
+	{0xC00002FF, "STATUS_SERVER_SHUTDOWN_IN_PROGRESS"},
+	{0xC0000300, "STATUS_NOT_SUPPORTED_ON_SBS"},
+	{0xC0000301, "STATUS_WMI_GUID_DISCONNECTED"},
+	{0xC0000302, "STATUS_WMI_ALREADY_DISABLED"},
+	{0xC0000303, "STATUS_WMI_ALREADY_ENABLED"},
+	{0xC0000304, "STATUS_MFT_TOO_FRAGMENTED"},
+	{0xC0000305, "STATUS_COPY_PROTECTION_FAILURE"},
+	{0xC0000306, "STATUS_CSS_AUTHENTICATION_FAILURE"},
+	{0xC0000307, "STATUS_CSS_KEY_NOT_PRESENT"},
+	{0xC0000308, "STATUS_CSS_KEY_NOT_ESTABLISHED"},
+	{0xC0000309, "STATUS_CSS_SCRAMBLED_SECTOR"},
+	{0xC000030A, "STATUS_CSS_REGION_MISMATCH"},
+	{0xC000030B, "STATUS_CSS_RESETS_EXHAUSTED"},
+	{0xC000030C, "STATUS_PASSWORD_CHANGE_REQUIRED"},
+	{0xC000030D, "STATUS_LOST_MODE_LOGON_RESTRICTION"},
+	{0xC0000320, "STATUS_PKINIT_FAILURE"},
+	{0xC0000321, "STATUS_SMARTCARD_SUBSYSTEM_FAILURE"},
+	{0xC0000322, "STATUS_NO_KERB_KEY"},
+	{0xC0000350, "STATUS_HOST_DOWN"},
+	{0xC0000351, "STATUS_UNSUPPORTED_PREAUTH"},
+	{0xC0000352, "STATUS_EFS_ALG_BLOB_TOO_BIG"},
+	{0xC0000353, "STATUS_PORT_NOT_SET"},
+	{0xC0000354, "STATUS_DEBUGGER_INACTIVE"},
+	{0xC0000355, "STATUS_DS_VERSION_CHECK_FAILURE"},
+	{0xC0000356, "STATUS_AUDITING_DISABLED"},
+	{0xC0000357, "STATUS_PRENT4_MACHINE_ACCOUNT"},
+	{0xC0000358, "STATUS_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER"},
+	{0xC0000359, "STATUS_INVALID_IMAGE_WIN_32"},
+	{0xC000035A, "STATUS_INVALID_IMAGE_WIN_64"},
+	{0xC000035B, "STATUS_BAD_BINDINGS"},
+	{0xC000035C, "STATUS_NETWORK_SESSION_EXPIRED"},
+	{0xC000035D, "STATUS_APPHELP_BLOCK"},
+	{0xC000035E, "STATUS_ALL_SIDS_FILTERED"},
+	{0xC000035F, "STATUS_NOT_SAFE_MODE_DRIVER"},
+	{0xC0000361, "STATUS_ACCESS_DISABLED_BY_POLICY_DEFAULT"},
+	{0xC0000362, "STATUS_ACCESS_DISABLED_BY_POLICY_PATH"},
+	{0xC0000363, "STATUS_ACCESS_DISABLED_BY_POLICY_PUBLISHER"},
+	{0xC0000364, "STATUS_ACCESS_DISABLED_BY_POLICY_OTHER"},
+	{0xC0000365, "STATUS_FAILED_DRIVER_ENTRY"},
+	{0xC0000366, "STATUS_DEVICE_ENUMERATION_ERROR"},
+	{0xC0000368, "STATUS_MOUNT_POINT_NOT_RESOLVED"},
+	{0xC0000369, "STATUS_INVALID_DEVICE_OBJECT_PARAMETER"},
+	{0xC000036A, "STATUS_MCA_OCCURED"},
+	{0xC000036B, "STATUS_DRIVER_BLOCKED_CRITICAL"},
+	{0xC000036C, "STATUS_DRIVER_BLOCKED"},
+	{0xC000036D, "STATUS_DRIVER_DATABASE_ERROR"},
+	{0xC000036E, "STATUS_SYSTEM_HIVE_TOO_LARGE"},
+	{0xC000036F, "STATUS_INVALID_IMPORT_OF_NON_DLL"},
+	{0xC0000371, "STATUS_NO_SECRETS"},
+	{0xC0000372, "STATUS_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY"},
+	{0xC0000373, "STATUS_FAILED_STACK_SWITCH"},
+	{0xC0000374, "STATUS_HEAP_CORRUPTION"},
+	{0xC0000380, "STATUS_SMARTCARD_WRONG_PIN"},
+	{0xC0000381, "STATUS_SMARTCARD_CARD_BLOCKED"},
+	{0xC0000382, "STATUS_SMARTCARD_CARD_NOT_AUTHENTICATED"},
+	{0xC0000383, "STATUS_SMARTCARD_NO_CARD"},
+	{0xC0000384, "STATUS_SMARTCARD_NO_KEY_CONTAINER"},
+	{0xC0000385, "STATUS_SMARTCARD_NO_CERTIFICATE"},
+	{0xC0000386, "STATUS_SMARTCARD_NO_KEYSET"},
+	{0xC0000387, "STATUS_SMARTCARD_IO_ERROR"},
+	{0xC0000388, "STATUS_DOWNGRADE_DETECTED"},
+	{0xC0000389, "STATUS_SMARTCARD_CERT_REVOKED"},
+	{0xC000038A, "STATUS_ISSUING_CA_UNTRUSTED"},
+	{0xC000038B, "STATUS_REVOCATION_OFFLINE_C"},
+	{0xC000038C, "STATUS_PKINIT_CLIENT_FAILURE"},
+	{0xC000038D, "STATUS_SMARTCARD_CERT_EXPIRED"},
+	{0xC000038E, "STATUS_DRIVER_FAILED_PRIOR_UNLOAD"},
+	{0xC000038F, "STATUS_SMARTCARD_SILENT_CONTEXT"},
+	{0xC0000401, "STATUS_PER_USER_TRUST_QUOTA_EXCEEDED"},
+	{0xC0000402, "STATUS_ALL_USER_TRUST_QUOTA_EXCEEDED"},
+	{0xC0000403, "STATUS_USER_DELETE_TRUST_QUOTA_EXCEEDED"},
+	{0xC0000404, "STATUS_DS_NAME_NOT_UNIQUE"},
+	{0xC0000405, "STATUS_DS_DUPLICATE_ID_FOUND"},
+	{0xC0000406, "STATUS_DS_GROUP_CONVERSION_ERROR"},
+	{0xC0000407, "STATUS_VOLSNAP_PREPARE_HIBERNATE"},
+	{0xC0000408, "STATUS_USER2USER_REQUIRED"},
+	{0xC0000409, "STATUS_STACK_BUFFER_OVERRUN"},
+	{0xC000040A, "STATUS_NO_S4U_PROT_SUPPORT"},
+	{0xC000040B, "STATUS_CROSSREALM_DELEGATION_FAILURE"},
+	{0xC000040C, "STATUS_REVOCATION_OFFLINE_KDC"},
+	{0xC000040D, "STATUS_ISSUING_CA_UNTRUSTED_KDC"},
+	{0xC000040E, "STATUS_KDC_CERT_EXPIRED"},
+	{0xC000040F, "STATUS_KDC_CERT_REVOKED"},
+	{0xC0000410, "STATUS_PARAMETER_QUOTA_EXCEEDED"},
+	{0xC0000411, "STATUS_HIBERNATION_FAILURE"},
+	{0xC0000412, "STATUS_DELAY_LOAD_FAILED"},
+	{0xC0000413, "STATUS_AUTHENTICATION_FIREWALL_FAILED"},
+	{0xC0000414, "STATUS_VDM_DISALLOWED"},
+	{0xC0000415, "STATUS_HUNG_DISPLAY_DRIVER_THREAD"},
+	{0xC0000416, "STATUS_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE"},
+	{0xC0000417, "STATUS_INVALID_CRUNTIME_PARAMETER"},
+	{0xC0000418, "STATUS_NTLM_BLOCKED"},
+	{0xC0000419, "STATUS_DS_SRC_SID_EXISTS_IN_FOREST"},
+	{0xC000041A, "STATUS_DS_DOMAIN_NAME_EXISTS_IN_FOREST"},
+	{0xC000041B, "STATUS_DS_FLAT_NAME_EXISTS_IN_FOREST"},
+	{0xC000041C, "STATUS_INVALID_USER_PRINCIPAL_NAME"},
+	{0xC000041D, "STATUS_FATAL_USER_CALLBACK_EXCEPTION"},
+	{0xC0000420, "STATUS_ASSERTION_FAILURE"},
+	{0xC0000421, "STATUS_VERIFIER_STOP"},
+	{0xC0000423, "STATUS_CALLBACK_POP_STACK"},
+	{0xC0000424, "STATUS_INCOMPATIBLE_DRIVER_BLOCKED"},
+	{0xC0000425, "STATUS_HIVE_UNLOADED"},
+	{0xC0000426, "STATUS_COMPRESSION_DISABLED"},
+	{0xC0000427, "STATUS_FILE_SYSTEM_LIMITATION"},
+	{0xC0000428, "STATUS_INVALID_IMAGE_HASH"},
+	{0xC0000429, "STATUS_NOT_CAPABLE"},
+	{0xC000042A, "STATUS_REQUEST_OUT_OF_SEQUENCE"},
+	{0xC000042B, "STATUS_IMPLEMENTATION_LIMIT"},
+	{0xC000042C, "STATUS_ELEVATION_REQUIRED"},
+	{0xC000042D, "STATUS_NO_SECURITY_CONTEXT"},
+	{0xC000042F, "STATUS_PKU2U_CERT_FAILURE"},
+	{0xC0000432, "STATUS_BEYOND_VDL"},
+	{0xC0000433, "STATUS_ENCOUNTERED_WRITE_IN_PROGRESS"},
+	{0xC0000434, "STATUS_PTE_CHANGED"},
+	{0xC0000435, "STATUS_PURGE_FAILED"},
+	{0xC0000440, "STATUS_CRED_REQUIRES_CONFIRMATION"},
+	{0xC0000441, "STATUS_CS_ENCRYPTION_INVALID_SERVER_RESPONSE"},
+	{0xC0000442, "STATUS_CS_ENCRYPTION_UNSUPPORTED_SERVER"},
+	{0xC0000443, "STATUS_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE"},
+	{0xC0000444, "STATUS_CS_ENCRYPTION_NEW_ENCRYPTED_FILE"},
+	{0xC0000445, "STATUS_CS_ENCRYPTION_FILE_NOT_CSE"},
+	{0xC0000446, "STATUS_INVALID_LABEL"},
+	{0xC0000450, "STATUS_DRIVER_PROCESS_TERMINATED"},
+	{0xC0000451, "STATUS_AMBIGUOUS_SYSTEM_DEVICE"},
+	{0xC0000452, "STATUS_SYSTEM_DEVICE_NOT_FOUND"},
+	{0xC0000453, "STATUS_RESTART_BOOT_APPLICATION"},
+	{0xC0000454, "STATUS_INSUFFICIENT_NVRAM_RESOURCES"},
+	{0xC0000455, "STATUS_INVALID_SESSION"},
+	{0xC0000456, "STATUS_THREAD_ALREADY_IN_SESSION"},
+	{0xC0000457, "STATUS_THREAD_NOT_IN_SESSION"},
+	{0xC0000458, "STATUS_INVALID_WEIGHT"},
+	{0xC0000459, "STATUS_REQUEST_PAUSED"},
+	{0xC0000460, "STATUS_NO_RANGES_PROCESSED"},
+	{0xC0000461, "STATUS_DISK_RESOURCES_EXHAUSTED"},
+	{0xC0000462, "STATUS_NEEDS_REMEDIATION"},
+	{0xC0000463, "STATUS_DEVICE_FEATURE_NOT_SUPPORTED"},
+	{0xC0000464, "STATUS_DEVICE_UNREACHABLE"},
+	{0xC0000465, "STATUS_INVALID_TOKEN"},
+	{0xC0000466, "STATUS_SERVER_UNAVAILABLE"},
+	{0xC0000467, "STATUS_FILE_NOT_AVAILABLE"},
+	{0xC0000468, "STATUS_DEVICE_INSUFFICIENT_RESOURCES"},
+	{0xC0000469, "STATUS_PACKAGE_UPDATING"},
+	{0xC000046A, "STATUS_NOT_READ_FROM_COPY"},
+	{0xC000046B, "STATUS_FT_WRITE_FAILURE"},
+	{0xC000046C, "STATUS_FT_DI_SCAN_REQUIRED"},
+	{0xC000046D, "STATUS_OBJECT_NOT_EXTERNALLY_BACKED"},
+	{0xC000046E, "STATUS_EXTERNAL_BACKING_PROVIDER_UNKNOWN"},
+	{0xC000046F, "STATUS_COMPRESSION_NOT_BENEFICIAL"},
+	{0xC0000470, "STATUS_DATA_CHECKSUM_ERROR"},
+	{0xC0000471, "STATUS_INTERMIXED_KERNEL_EA_OPERATION"},
+	{0xC0000472, "STATUS_TRIM_READ_ZERO_NOT_SUPPORTED"},
+	{0xC0000473, "STATUS_TOO_MANY_SEGMENT_DESCRIPTORS"},
+	{0xC0000474, "STATUS_INVALID_OFFSET_ALIGNMENT"},
+	{0xC0000475, "STATUS_INVALID_FIELD_IN_PARAMETER_LIST"},
+	{0xC0000476, "STATUS_OPERATION_IN_PROGRESS"},
+	{0xC0000477, "STATUS_INVALID_INITIATOR_TARGET_PATH"},
+	{0xC0000478, "STATUS_SCRUB_DATA_DISABLED"},
+	{0xC0000479, "STATUS_NOT_REDUNDANT_STORAGE"},
+	{0xC000047A, "STATUS_RESIDENT_FILE_NOT_SUPPORTED"},
+	{0xC000047B, "STATUS_COMPRESSED_FILE_NOT_SUPPORTED"},
+	{0xC000047C, "STATUS_DIRECTORY_NOT_SUPPORTED"},
+	{0xC000047D, "STATUS_IO_OPERATION_TIMEOUT"},
+	{0xC000047E, "STATUS_SYSTEM_NEEDS_REMEDIATION"},
+	{0xC000047F, "STATUS_APPX_INTEGRITY_FAILURE_CLR_NGEN"},
+	{0xC0000480, "STATUS_SHARE_UNAVAILABLE"},
+	{0xC0000481, "STATUS_APISET_NOT_HOSTED"},
+	{0xC0000482, "STATUS_APISET_NOT_PRESENT"},
+	{0xC0000483, "STATUS_DEVICE_HARDWARE_ERROR"},
+	{0xC0000484, "STATUS_FIRMWARE_SLOT_INVALID"},
+	{0xC0000485, "STATUS_FIRMWARE_IMAGE_INVALID"},
+	{0xC0000486, "STATUS_STORAGE_TOPOLOGY_ID_MISMATCH"},
+	{0xC0000487, "STATUS_WIM_NOT_BOOTABLE"},
+	{0xC0000488, "STATUS_BLOCKED_BY_PARENTAL_CONTROLS"},
+	{0xC0000489, "STATUS_NEEDS_REGISTRATION"},
+	{0xC000048A, "STATUS_QUOTA_ACTIVITY"},
+	{0xC000048B, "STATUS_CALLBACK_INVOKE_INLINE"},
+	{0xC000048C, "STATUS_BLOCK_TOO_MANY_REFERENCES"},
+	{0xC000048D, "STATUS_MARKED_TO_DISALLOW_WRITES"},
+	{0xC000048E, "STATUS_NETWORK_ACCESS_DENIED_EDP"},
+	{0xC000048F, "STATUS_ENCLAVE_FAILURE"},
+	{0xC0000490, "STATUS_PNP_NO_COMPAT_DRIVERS"},
+	{0xC0000491, "STATUS_PNP_DRIVER_PACKAGE_NOT_FOUND"},
+	{0xC0000492, "STATUS_PNP_DRIVER_CONFIGURATION_NOT_FOUND"},
+	{0xC0000493, "STATUS_PNP_DRIVER_CONFIGURATION_INCOMPLETE"},
+	{0xC0000494, "STATUS_PNP_FUNCTION_DRIVER_REQUIRED"},
+	{0xC0000495, "STATUS_PNP_DEVICE_CONFIGURATION_PENDING"},
+	{0xC0000496, "STATUS_DEVICE_HINT_NAME_BUFFER_TOO_SMALL"},
+	{0xC0000497, "STATUS_PACKAGE_NOT_AVAILABLE"},
+	{0xC0000499, "STATUS_DEVICE_IN_MAINTENANCE"},
+	{0xC000049A, "STATUS_NOT_SUPPORTED_ON_DAX"},
+	{0xC000049B, "STATUS_FREE_SPACE_TOO_FRAGMENTED"},
+	{0xC000049C, "STATUS_DAX_MAPPING_EXISTS"},
+	{0xC000049D, "STATUS_CHILD_PROCESS_BLOCKED"},
+	{0xC000049E, "STATUS_STORAGE_LOST_DATA_PERSISTENCE"},
+	{0xC000049F, "STATUS_VRF_CFG_ENABLED"},
+	{0xC00004A0, "STATUS_PARTITION_TERMINATING"},
+	{0xC00004A1, "STATUS_EXTERNAL_SYSKEY_NOT_SUPPORTED"},
+	{0xC00004A2, "STATUS_ENCLAVE_VIOLATION"},
+	{0xC00004A3, "STATUS_FILE_PROTECTED_UNDER_DPL"},
+	{0xC00004A4, "STATUS_VOLUME_NOT_CLUSTER_ALIGNED"},
+	{0xC00004A5, "STATUS_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND"},
+	{0xC00004A6, "STATUS_APPX_FILE_NOT_ENCRYPTED"},
+	{0xC00004A7, "STATUS_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED"},
+	{0xC00004A8, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET"},
+	{0xC00004A9, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE"},
+	{0xC00004AA, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER"},
+	{0xC00004AB, "STATUS_FT_READ_FAILURE"},
+	{0xC00004AC, "STATUS_PATCH_CONFLICT"},
+	{0xC00004AD, "STATUS_STORAGE_RESERVE_ID_INVALID"},
+	{0xC00004AE, "STATUS_STORAGE_RESERVE_DOES_NOT_EXIST"},
+	{0xC00004AF, "STATUS_STORAGE_RESERVE_ALREADY_EXISTS"},
+	{0xC00004B0, "STATUS_STORAGE_RESERVE_NOT_EMPTY"},
+	{0xC00004B1, "STATUS_NOT_A_DAX_VOLUME"},
+	{0xC00004B2, "STATUS_NOT_DAX_MAPPABLE"},
+	{0xC00004B3, "STATUS_CASE_DIFFERING_NAMES_IN_DIR"},
+	{0xC00004B4, "STATUS_FILE_NOT_SUPPORTED"},
+	{0xC00004B5, "STATUS_NOT_SUPPORTED_WITH_BTT"},
+	{0xC00004B6, "STATUS_ENCRYPTION_DISABLED"},
+	{0xC00004B7, "STATUS_ENCRYPTING_METADATA_DISALLOWED"},
+	{0xC00004B8, "STATUS_CANT_CLEAR_ENCRYPTION_FLAG"},
+	{0xC0000500, "STATUS_INVALID_TASK_NAME"},
+	{0xC0000501, "STATUS_INVALID_TASK_INDEX"},
+	{0xC0000502, "STATUS_THREAD_ALREADY_IN_TASK"},
+	{0xC0000503, "STATUS_CALLBACK_BYPASS"},
+	{0xC0000504, "STATUS_UNDEFINED_SCOPE"},
+	{0xC0000505, "STATUS_INVALID_CAP"},
+	{0xC0000506, "STATUS_NOT_GUI_PROCESS"},
+	{0xC0000507, "STATUS_DEVICE_HUNG"},
+	{0xC0000508, "STATUS_CONTAINER_ASSIGNED"},
+	{0xC0000509, "STATUS_JOB_NO_CONTAINER"},
+	{0xC000050A, "STATUS_DEVICE_UNRESPONSIVE"},
+	{0xC000050B, "STATUS_REPARSE_POINT_ENCOUNTERED"},
+	{0xC000050C, "STATUS_ATTRIBUTE_NOT_PRESENT"},
+	{0xC000050D, "STATUS_NOT_A_TIERED_VOLUME"},
+	{0xC000050E, "STATUS_ALREADY_HAS_STREAM_ID"},
+	{0xC000050F, "STATUS_JOB_NOT_EMPTY"},
+	{0xC0000510, "STATUS_ALREADY_INITIALIZED"},
+	{0xC0000511, "STATUS_ENCLAVE_NOT_TERMINATED"},
+	{0xC0000512, "STATUS_ENCLAVE_IS_TERMINATING"},
+	{0xC0000513, "STATUS_SMB1_NOT_AVAILABLE"},
+	{0xC0000514, "STATUS_SMR_GARBAGE_COLLECTION_REQUIRED"},
+	{0xC0000515, "STATUS_INTERRUPTED"},
+	{0xC0000516, "STATUS_THREAD_NOT_RUNNING"},
+	{0xC0000602, "STATUS_FAIL_FAST_EXCEPTION"},
+	{0xC0000603, "STATUS_IMAGE_CERT_REVOKED"},
+	{0xC0000604, "STATUS_DYNAMIC_CODE_BLOCKED"},
+	{0xC0000605, "STATUS_IMAGE_CERT_EXPIRED"},
+	{0xC0000606, "STATUS_STRICT_CFG_VIOLATION"},
+	{0xC000060A, "STATUS_SET_CONTEXT_DENIED"},
+	{0xC000060B, "STATUS_CROSS_PARTITION_VIOLATION"},
+	{0xC0000700, "STATUS_PORT_CLOSED"},
+	{0xC0000701, "STATUS_MESSAGE_LOST"},
+	{0xC0000702, "STATUS_INVALID_MESSAGE"},
+	{0xC0000703, "STATUS_REQUEST_CANCELED"},
+	{0xC0000704, "STATUS_RECURSIVE_DISPATCH"},
+	{0xC0000705, "STATUS_LPC_RECEIVE_BUFFER_EXPECTED"},
+	{0xC0000706, "STATUS_LPC_INVALID_CONNECTION_USAGE"},
+	{0xC0000707, "STATUS_LPC_REQUESTS_NOT_ALLOWED"},
+	{0xC0000708, "STATUS_RESOURCE_IN_USE"},
+	{0xC0000709, "STATUS_HARDWARE_MEMORY_ERROR"},
+	{0xC000070A, "STATUS_THREADPOOL_HANDLE_EXCEPTION"},
+	{0xC000070B, "STATUS_THREADPOOL_SET_EVENT_ON_COMPLETION_FAILED"},
+	{0xC000070C, "STATUS_THREADPOOL_RELEASE_SEMAPHORE_ON_COMPLETION_FAILED"},
+	{0xC000070D, "STATUS_THREADPOOL_RELEASE_MUTEX_ON_COMPLETION_FAILED"},
+	{0xC000070E, "STATUS_THREADPOOL_FREE_LIBRARY_ON_COMPLETION_FAILED"},
+	{0xC000070F, "STATUS_THREADPOOL_RELEASED_DURING_OPERATION"},
+	{0xC0000710, "STATUS_CALLBACK_RETURNED_WHILE_IMPERSONATING"},
+	{0xC0000711, "STATUS_APC_RETURNED_WHILE_IMPERSONATING"},
+	{0xC0000712, "STATUS_PROCESS_IS_PROTECTED"},
+	{0xC0000713, "STATUS_MCA_EXCEPTION"},
+	{0xC0000714, "STATUS_CERTIFICATE_MAPPING_NOT_UNIQUE"},
+	{0xC0000715, "STATUS_SYMLINK_CLASS_DISABLED"},
+	{0xC0000716, "STATUS_INVALID_IDN_NORMALIZATION"},
+	{0xC0000717, "STATUS_NO_UNICODE_TRANSLATION"},
+	{0xC0000718, "STATUS_ALREADY_REGISTERED"},
+	{0xC0000719, "STATUS_CONTEXT_MISMATCH"},
+	{0xC000071A, "STATUS_PORT_ALREADY_HAS_COMPLETION_LIST"},
+	{0xC000071B, "STATUS_CALLBACK_RETURNED_THREAD_PRIORITY"},
+	{0xC000071C, "STATUS_INVALID_THREAD"},
+	{0xC000071D, "STATUS_CALLBACK_RETURNED_TRANSACTION"},
+	{0xC000071E, "STATUS_CALLBACK_RETURNED_LDR_LOCK"},
+	{0xC000071F, "STATUS_CALLBACK_RETURNED_LANG"},
+	{0xC0000720, "STATUS_CALLBACK_RETURNED_PRI_BACK"},
+	{0xC0000721, "STATUS_CALLBACK_RETURNED_THREAD_AFFINITY"},
+	{0xC0000722, "STATUS_LPC_HANDLE_COUNT_EXCEEDED"},
+	{0xC0000723, "STATUS_EXECUTABLE_MEMORY_WRITE"},
+	{0xC0000724, "STATUS_KERNEL_EXECUTABLE_MEMORY_WRITE"},
+	{0xC0000725, "STATUS_ATTACHED_EXECUTABLE_MEMORY_WRITE"},
+	{0xC0000726, "STATUS_TRIGGERED_EXECUTABLE_MEMORY_WRITE"},
+	{0xC0000800, "STATUS_DISK_REPAIR_DISABLED"},
+	{0xC0000801, "STATUS_DS_DOMAIN_RENAME_IN_PROGRESS"},
+	{0xC0000802, "STATUS_DISK_QUOTA_EXCEEDED"},
+	{0xC0000804, "STATUS_CONTENT_BLOCKED"},
+	{0xC0000805, "STATUS_BAD_CLUSTERS"},
+	{0xC0000806, "STATUS_VOLUME_DIRTY"},
+	{0xC0000808, "STATUS_DISK_REPAIR_UNSUCCESSFUL"},
+	{0xC0000809, "STATUS_CORRUPT_LOG_OVERFULL"},
+	{0xC000080A, "STATUS_CORRUPT_LOG_CORRUPTED"},
+	{0xC000080B, "STATUS_CORRUPT_LOG_UNAVAILABLE"},
+	{0xC000080C, "STATUS_CORRUPT_LOG_DELETED_FULL"},
+	{0xC000080D, "STATUS_CORRUPT_LOG_CLEARED"},
+	{0xC000080E, "STATUS_ORPHAN_NAME_EXHAUSTED"},
+	{0xC000080F, "STATUS_PROACTIVE_SCAN_IN_PROGRESS"},
+	{0xC0000810, "STATUS_ENCRYPTED_IO_NOT_POSSIBLE"},
+	{0xC0000811, "STATUS_CORRUPT_LOG_UPLEVEL_RECORDS"},
+	{0xC0000901, "STATUS_FILE_CHECKED_OUT"},
+	{0xC0000902, "STATUS_CHECKOUT_REQUIRED"},
+	{0xC0000903, "STATUS_BAD_FILE_TYPE"},
+	{0xC0000904, "STATUS_FILE_TOO_LARGE"},
+	{0xC0000905, "STATUS_FORMS_AUTH_REQUIRED"},
+	{0xC0000906, "STATUS_VIRUS_INFECTED"},
+	{0xC0000907, "STATUS_VIRUS_DELETED"},
+	{0xC0000908, "STATUS_BAD_MCFG_TABLE"},
+	{0xC0000909, "STATUS_CANNOT_BREAK_OPLOCK"},
+	{0xC000090A, "STATUS_BAD_KEY"},
+	{0xC000090B, "STATUS_BAD_DATA"},
+	{0xC000090C, "STATUS_NO_KEY"},
+	{0xC0000910, "STATUS_FILE_HANDLE_REVOKED"},
+	{0xC0009898, "STATUS_WOW_ASSERTION"},
+	{0xC000A000, "STATUS_INVALID_SIGNATURE"},
+	{0xC000A001, "STATUS_HMAC_NOT_SUPPORTED"},
+	{0xC000A002, "STATUS_AUTH_TAG_MISMATCH"},
+	{0xC000A003, "STATUS_INVALID_STATE_TRANSITION"},
+	{0xC000A004, "STATUS_INVALID_KERNEL_INFO_VERSION"},
+	{0xC000A005, "STATUS_INVALID_PEP_INFO_VERSION"},
+	{0xC000A006, "STATUS_HANDLE_REVOKED"},
+	{0xC000A007, "STATUS_EOF_ON_GHOSTED_RANGE"},
+	{0xC000A010, "STATUS_IPSEC_QUEUE_OVERFLOW"},
+	{0xC000A011, "STATUS_ND_QUEUE_OVERFLOW"},
+	{0xC000A012, "STATUS_HOPLIMIT_EXCEEDED"},
+	{0xC000A013, "STATUS_PROTOCOL_NOT_SUPPORTED"},
+	{0xC000A014, "STATUS_FASTPATH_REJECTED"},
+	{0xC000A080, "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED"},
+	{0xC000A081, "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR"},
+	{0xC000A082, "STATUS_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR"},
+	{0xC000A083, "STATUS_XML_PARSE_ERROR"},
+	{0xC000A084, "STATUS_XMLDSIG_ERROR"},
+	{0xC000A085, "STATUS_WRONG_COMPARTMENT"},
+	{0xC000A086, "STATUS_AUTHIP_FAILURE"},
+	{0xC000A087, "STATUS_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS"},
+	{0xC000A088, "STATUS_DS_OID_NOT_FOUND"},
+	{0xC000A089, "STATUS_INCORRECT_ACCOUNT_TYPE"},
+	{0xC000A100, "STATUS_HASH_NOT_SUPPORTED"},
+	{0xC000A101, "STATUS_HASH_NOT_PRESENT"},
+	{0xC000A121, "STATUS_SECONDARY_IC_PROVIDER_NOT_REGISTERED"},
+	{0xC000A122, "STATUS_GPIO_CLIENT_INFORMATION_INVALID"},
+	{0xC000A123, "STATUS_GPIO_VERSION_NOT_SUPPORTED"},
+	{0xC000A124, "STATUS_GPIO_INVALID_REGISTRATION_PACKET"},
+	{0xC000A125, "STATUS_GPIO_OPERATION_DENIED"},
+	{0xC000A126, "STATUS_GPIO_INCOMPATIBLE_CONNECT_MODE"},
+	{0xC000A141, "STATUS_CANNOT_SWITCH_RUNLEVEL"},
+	{0xC000A142, "STATUS_INVALID_RUNLEVEL_SETTING"},
+	{0xC000A143, "STATUS_RUNLEVEL_SWITCH_TIMEOUT"},
+	{0xC000A145, "STATUS_RUNLEVEL_SWITCH_AGENT_TIMEOUT"},
+	{0xC000A146, "STATUS_RUNLEVEL_SWITCH_IN_PROGRESS"},
+	{0xC000A200, "STATUS_NOT_APPCONTAINER"},
+	{0xC000A201, "STATUS_NOT_SUPPORTED_IN_APPCONTAINER"},
+	{0xC000A202, "STATUS_INVALID_PACKAGE_SID_LENGTH"},
+	{0xC000A203, "STATUS_LPAC_ACCESS_DENIED"},
+	{0xC000A204, "STATUS_ADMINLESS_ACCESS_DENIED"},
+	{0xC000A281, "STATUS_APP_DATA_NOT_FOUND"},
+	{0xC000A282, "STATUS_APP_DATA_EXPIRED"},
+	{0xC000A283, "STATUS_APP_DATA_CORRUPT"},
+	{0xC000A284, "STATUS_APP_DATA_LIMIT_EXCEEDED"},
+	{0xC000A285, "STATUS_APP_DATA_REBOOT_REQUIRED"},
+	{0xC000A2A1, "STATUS_OFFLOAD_READ_FLT_NOT_SUPPORTED"},
+	{0xC000A2A2, "STATUS_OFFLOAD_WRITE_FLT_NOT_SUPPORTED"},
+	{0xC000A2A3, "STATUS_OFFLOAD_READ_FILE_NOT_SUPPORTED"},
+	{0xC000A2A4, "STATUS_OFFLOAD_WRITE_FILE_NOT_SUPPORTED"},
+	{0xC000A2A5, "STATUS_WOF_WIM_HEADER_CORRUPT"},
+	{0xC000A2A6, "STATUS_WOF_WIM_RESOURCE_TABLE_CORRUPT"},
+	{0xC000A2A7, "STATUS_WOF_FILE_RESOURCE_TABLE_CORRUPT"},
+	{0xC000CE01, "STATUS_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE"},
+	{0xC000CE02, "STATUS_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT"},
+	{0xC000CE03, "STATUS_FILE_SYSTEM_VIRTUALIZATION_BUSY"},
+	{0xC000CE04, "STATUS_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN"},
+	{0xC000CE05, "STATUS_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION"},
+	{0xC000CF00, "STATUS_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT"},
+	{0xC000CF01, "STATUS_CLOUD_FILE_PROVIDER_NOT_RUNNING"},
+	{0xC000CF02, "STATUS_CLOUD_FILE_METADATA_CORRUPT"},
+	{0xC000CF03, "STATUS_CLOUD_FILE_METADATA_TOO_LARGE"},
+	{0xC000CF06, "STATUS_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED"},
+	{0xC000CF07, "STATUS_NOT_A_CLOUD_FILE"},
+	{0xC000CF08, "STATUS_CLOUD_FILE_NOT_IN_SYNC"},
+	{0xC000CF09, "STATUS_CLOUD_FILE_ALREADY_CONNECTED"},
+	{0xC000CF0A, "STATUS_CLOUD_FILE_NOT_SUPPORTED"},
+	{0xC000CF0B, "STATUS_CLOUD_FILE_INVALID_REQUEST"},
+	{0xC000CF0C, "STATUS_CLOUD_FILE_READ_ONLY_VOLUME"},
+	{0xC000CF0D, "STATUS_CLOUD_FILE_CONNECTED_PROVIDER_ONLY"},
+	{0xC000CF0E, "STATUS_CLOUD_FILE_VALIDATION_FAILED"},
+	{0xC000CF0F, "STATUS_CLOUD_FILE_AUTHENTICATION_FAILED"},
+	{0xC000CF10, "STATUS_CLOUD_FILE_INSUFFICIENT_RESOURCES"},
+	{0xC000CF11, "STATUS_CLOUD_FILE_NETWORK_UNAVAILABLE"},
+	{0xC000CF12, "STATUS_CLOUD_FILE_UNSUCCESSFUL"},
+	{0xC000CF13, "STATUS_CLOUD_FILE_NOT_UNDER_SYNC_ROOT"},
+	{0xC000CF14, "STATUS_CLOUD_FILE_IN_USE"},
+	{0xC000CF15, "STATUS_CLOUD_FILE_PINNED"},
+	{0xC000CF16, "STATUS_CLOUD_FILE_REQUEST_ABORTED"},
+	{0xC000CF17, "STATUS_CLOUD_FILE_PROPERTY_CORRUPT"},
+	{0xC000CF18, "STATUS_CLOUD_FILE_ACCESS_DENIED"},
+	{0xC000CF19, "STATUS_CLOUD_FILE_INCOMPATIBLE_HARDLINKS"},
+	{0xC000CF1A, "STATUS_CLOUD_FILE_PROPERTY_LOCK_CONFLICT"},
+	{0xC000CF1B, "STATUS_CLOUD_FILE_REQUEST_CANCELED"},
+	{0xC000CF1D, "STATUS_CLOUD_FILE_PROVIDER_TERMINATED"},
+	{0xC000CF1E, "STATUS_NOT_A_CLOUD_SYNC_ROOT"},
+	{0xC000CF1F, "STATUS_CLOUD_FILE_REQUEST_TIMEOUT"},
+	{0xC0040035, "STATUS_PNP_BAD_MPS_TABLE"},
+	{0xC0040036, "STATUS_PNP_TRANSLATION_FAILED"},
+	{0xC0040037, "STATUS_PNP_IRQ_TRANSLATION_FAILED"},
+	{0xC0040038, "STATUS_PNP_INVALID_ID"},
+	{0xC0040039, "STATUS_IO_REISSUE_AS_CACHED"},
+	{0xC00A0001, "STATUS_CTX_WINSTATION_NAME_INVALID"},
+	{0xC00A0002, "STATUS_CTX_INVALID_PD"},
+	{0xC00A0003, "STATUS_CTX_PD_NOT_FOUND"},
+	{0xC00A0006, "STATUS_CTX_CLOSE_PENDING"},
+	{0xC00A0007, "STATUS_CTX_NO_OUTBUF"},
+	{0xC00A0008, "STATUS_CTX_MODEM_INF_NOT_FOUND"},
+	{0xC00A0009, "STATUS_CTX_INVALID_MODEMNAME"},
+	{0xC00A000A, "STATUS_CTX_RESPONSE_ERROR"},
+	{0xC00A000B, "STATUS_CTX_MODEM_RESPONSE_TIMEOUT"},
+	{0xC00A000C, "STATUS_CTX_MODEM_RESPONSE_NO_CARRIER"},
+	{0xC00A000D, "STATUS_CTX_MODEM_RESPONSE_NO_DIALTONE"},
+	{0xC00A000E, "STATUS_CTX_MODEM_RESPONSE_BUSY"},
+	{0xC00A000F, "STATUS_CTX_MODEM_RESPONSE_VOICE"},
+	{0xC00A0010, "STATUS_CTX_TD_ERROR"},
+	{0xC00A0012, "STATUS_CTX_LICENSE_CLIENT_INVALID"},
+	{0xC00A0013, "STATUS_CTX_LICENSE_NOT_AVAILABLE"},
+	{0xC00A0014, "STATUS_CTX_LICENSE_EXPIRED"},
+	{0xC00A0015, "STATUS_CTX_WINSTATION_NOT_FOUND"},
+	{0xC00A0016, "STATUS_CTX_WINSTATION_NAME_COLLISION"},
+	{0xC00A0017, "STATUS_CTX_WINSTATION_BUSY"},
+	{0xC00A0018, "STATUS_CTX_BAD_VIDEO_MODE"},
+	{0xC00A0022, "STATUS_CTX_GRAPHICS_INVALID"},
+	{0xC00A0024, "STATUS_CTX_NOT_CONSOLE"},
+	{0xC00A0026, "STATUS_CTX_CLIENT_QUERY_TIMEOUT"},
+	{0xC00A0027, "STATUS_CTX_CONSOLE_DISCONNECT"},
+	{0xC00A0028, "STATUS_CTX_CONSOLE_CONNECT"},
+	{0xC00A002A, "STATUS_CTX_SHADOW_DENIED"},
+	{0xC00A002B, "STATUS_CTX_WINSTATION_ACCESS_DENIED"},
+	{0xC00A002E, "STATUS_CTX_INVALID_WD"},
+	{0xC00A002F, "STATUS_CTX_WD_NOT_FOUND"},
+	{0xC00A0030, "STATUS_CTX_SHADOW_INVALID"},
+	{0xC00A0031, "STATUS_CTX_SHADOW_DISABLED"},
+	{0xC00A0032, "STATUS_RDP_PROTOCOL_ERROR"},
+	{0xC00A0033, "STATUS_CTX_CLIENT_LICENSE_NOT_SET"},
+	{0xC00A0034, "STATUS_CTX_CLIENT_LICENSE_IN_USE"},
+	{0xC00A0035, "STATUS_CTX_SHADOW_ENDED_BY_MODE_CHANGE"},
+	{0xC00A0036, "STATUS_CTX_SHADOW_NOT_RUNNING"},
+	{0xC00A0037, "STATUS_CTX_LOGON_DISABLED"},
+	{0xC00A0038, "STATUS_CTX_SECURITY_LAYER_ERROR"},
+	{0xC00A0039, "STATUS_TS_INCOMPATIBLE_SESSIONS"},
+	{0xC00A003A, "STATUS_TS_VIDEO_SUBSYSTEM_ERROR"},
+	{0xC00B0001, "STATUS_MUI_FILE_NOT_FOUND"},
+	{0xC00B0002, "STATUS_MUI_INVALID_FILE"},
+	{0xC00B0003, "STATUS_MUI_INVALID_RC_CONFIG"},
+	{0xC00B0004, "STATUS_MUI_INVALID_LOCALE_NAME"},
+	{0xC00B0005, "STATUS_MUI_INVALID_ULTIMATEFALLBACK_NAME"},
+	{0xC00B0006, "STATUS_MUI_FILE_NOT_LOADED"},
+	{0xC00B0007, "STATUS_RESOURCE_ENUM_USER_STOP"},
+	{0xC0130001, "STATUS_CLUSTER_INVALID_NODE"},
+	{0xC0130002, "STATUS_CLUSTER_NODE_EXISTS"},
+	{0xC0130003, "STATUS_CLUSTER_JOIN_IN_PROGRESS"},
+	{0xC0130004, "STATUS_CLUSTER_NODE_NOT_FOUND"},
+	{0xC0130005, "STATUS_CLUSTER_LOCAL_NODE_NOT_FOUND"},
+	{0xC0130006, "STATUS_CLUSTER_NETWORK_EXISTS"},
+	{0xC0130007, "STATUS_CLUSTER_NETWORK_NOT_FOUND"},
+	{0xC0130008, "STATUS_CLUSTER_NETINTERFACE_EXISTS"},
+	{0xC0130009, "STATUS_CLUSTER_NETINTERFACE_NOT_FOUND"},
+	{0xC013000A, "STATUS_CLUSTER_INVALID_REQUEST"},
+	{0xC013000B, "STATUS_CLUSTER_INVALID_NETWORK_PROVIDER"},
+	{0xC013000C, "STATUS_CLUSTER_NODE_DOWN"},
+	{0xC013000D, "STATUS_CLUSTER_NODE_UNREACHABLE"},
+	{0xC013000E, "STATUS_CLUSTER_NODE_NOT_MEMBER"},
+	{0xC013000F, "STATUS_CLUSTER_JOIN_NOT_IN_PROGRESS"},
+	{0xC0130010, "STATUS_CLUSTER_INVALID_NETWORK"},
+	{0xC0130011, "STATUS_CLUSTER_NO_NET_ADAPTERS"},
+	{0xC0130012, "STATUS_CLUSTER_NODE_UP"},
+	{0xC0130013, "STATUS_CLUSTER_NODE_PAUSED"},
+	{0xC0130014, "STATUS_CLUSTER_NODE_NOT_PAUSED"},
+	{0xC0130015, "STATUS_CLUSTER_NO_SECURITY_CONTEXT"},
+	{0xC0130016, "STATUS_CLUSTER_NETWORK_NOT_INTERNAL"},
+	{0xC0130017, "STATUS_CLUSTER_POISONED"},
+	{0xC0130018, "STATUS_CLUSTER_NON_CSV_PATH"},
+	{0xC0130019, "STATUS_CLUSTER_CSV_VOLUME_NOT_LOCAL"},
+	{0xC0130020, "STATUS_CLUSTER_CSV_READ_OPLOCK_BREAK_IN_PROGRESS"},
+	{0xC0130021, "STATUS_CLUSTER_CSV_AUTO_PAUSE_ERROR"},
+	{0xC0130022, "STATUS_CLUSTER_CSV_REDIRECTED"},
+	{0xC0130023, "STATUS_CLUSTER_CSV_NOT_REDIRECTED"},
+	{0xC0130024, "STATUS_CLUSTER_CSV_VOLUME_DRAINING"},
+	{0xC0130025, "STATUS_CLUSTER_CSV_SNAPSHOT_CREATION_IN_PROGRESS"},
+	{0xC0130026, "STATUS_CLUSTER_CSV_VOLUME_DRAINING_SUCCEEDED_DOWNLEVEL"},
+	{0xC0130027, "STATUS_CLUSTER_CSV_NO_SNAPSHOTS"},
+	{0xC0130028, "STATUS_CSV_IO_PAUSE_TIMEOUT"},
+	{0xC0130029, "STATUS_CLUSTER_CSV_INVALID_HANDLE"},
+	{0xC0130030, "STATUS_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR"},
+	{0xC0130031, "STATUS_CLUSTER_CAM_TICKET_REPLAY_DETECTED"},
+	{0xC0140001, "STATUS_ACPI_INVALID_OPCODE"},
+	{0xC0140002, "STATUS_ACPI_STACK_OVERFLOW"},
+	{0xC0140003, "STATUS_ACPI_ASSERT_FAILED"},
+	{0xC0140004, "STATUS_ACPI_INVALID_INDEX"},
+	{0xC0140005, "STATUS_ACPI_INVALID_ARGUMENT"},
+	{0xC0140006, "STATUS_ACPI_FATAL"},
+	{0xC0140007, "STATUS_ACPI_INVALID_SUPERNAME"},
+	{0xC0140008, "STATUS_ACPI_INVALID_ARGTYPE"},
+	{0xC0140009, "STATUS_ACPI_INVALID_OBJTYPE"},
+	{0xC014000A, "STATUS_ACPI_INVALID_TARGETTYPE"},
+	{0xC014000B, "STATUS_ACPI_INCORRECT_ARGUMENT_COUNT"},
+	{0xC014000C, "STATUS_ACPI_ADDRESS_NOT_MAPPED"},
+	{0xC014000D, "STATUS_ACPI_INVALID_EVENTTYPE"},
+	{0xC014000E, "STATUS_ACPI_HANDLER_COLLISION"},
+	{0xC014000F, "STATUS_ACPI_INVALID_DATA"},
+	{0xC0140010, "STATUS_ACPI_INVALID_REGION"},
+	{0xC0140011, "STATUS_ACPI_INVALID_ACCESS_SIZE"},
+	{0xC0140012, "STATUS_ACPI_ACQUIRE_GLOBAL_LOCK"},
+	{0xC0140013, "STATUS_ACPI_ALREADY_INITIALIZED"},
+	{0xC0140014, "STATUS_ACPI_NOT_INITIALIZED"},
+	{0xC0140015, "STATUS_ACPI_INVALID_MUTEX_LEVEL"},
+	{0xC0140016, "STATUS_ACPI_MUTEX_NOT_OWNED"},
+	{0xC0140017, "STATUS_ACPI_MUTEX_NOT_OWNER"},
+	{0xC0140018, "STATUS_ACPI_RS_ACCESS"},
+	{0xC0140019, "STATUS_ACPI_INVALID_TABLE"},
+	{0xC0140020, "STATUS_ACPI_REG_HANDLER_FAILED"},
+	{0xC0140021, "STATUS_ACPI_POWER_REQUEST_FAILED"},
+	{0xC0150001, "STATUS_SXS_SECTION_NOT_FOUND"},
+	{0xC0150002, "STATUS_SXS_CANT_GEN_ACTCTX"},
+	{0xC0150003, "STATUS_SXS_INVALID_ACTCTXDATA_FORMAT"},
+	{0xC0150004, "STATUS_SXS_ASSEMBLY_NOT_FOUND"},
+	{0xC0150005, "STATUS_SXS_MANIFEST_FORMAT_ERROR"},
+	{0xC0150006, "STATUS_SXS_MANIFEST_PARSE_ERROR"},
+	{0xC0150007, "STATUS_SXS_ACTIVATION_CONTEXT_DISABLED"},
+	{0xC0150008, "STATUS_SXS_KEY_NOT_FOUND"},
+	{0xC0150009, "STATUS_SXS_VERSION_CONFLICT"},
+	{0xC015000A, "STATUS_SXS_WRONG_SECTION_TYPE"},
+	{0xC015000B, "STATUS_SXS_THREAD_QUERIES_DISABLED"},
+	{0xC015000C, "STATUS_SXS_ASSEMBLY_MISSING"},
+	{0xC015000E, "STATUS_SXS_PROCESS_DEFAULT_ALREADY_SET"},
+	{0xC015000F, "STATUS_SXS_EARLY_DEACTIVATION"},
+	{0xC0150010, "STATUS_SXS_INVALID_DEACTIVATION"},
+	{0xC0150011, "STATUS_SXS_MULTIPLE_DEACTIVATION"},
+	{0xC0150012, "STATUS_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY"},
+	{0xC0150013, "STATUS_SXS_PROCESS_TERMINATION_REQUESTED"},
+	{0xC0150014, "STATUS_SXS_CORRUPT_ACTIVATION_STACK"},
+	{0xC0150015, "STATUS_SXS_CORRUPTION"},
+	{0xC0150016, "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE"},
+	{0xC0150017, "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME"},
+	{0xC0150018, "STATUS_SXS_IDENTITY_DUPLICATE_ATTRIBUTE"},
+	{0xC0150019, "STATUS_SXS_IDENTITY_PARSE_ERROR"},
+	{0xC015001A, "STATUS_SXS_COMPONENT_STORE_CORRUPT"},
+	{0xC015001B, "STATUS_SXS_FILE_HASH_MISMATCH"},
+	{0xC015001C, "STATUS_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT"},
+	{0xC015001D, "STATUS_SXS_IDENTITIES_DIFFERENT"},
+	{0xC015001E, "STATUS_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT"},
+	{0xC015001F, "STATUS_SXS_FILE_NOT_PART_OF_ASSEMBLY"},
+	{0xC0150020, "STATUS_ADVANCED_INSTALLER_FAILED"},
+	{0xC0150021, "STATUS_XML_ENCODING_MISMATCH"},
+	{0xC0150022, "STATUS_SXS_MANIFEST_TOO_BIG"},
+	{0xC0150023, "STATUS_SXS_SETTING_NOT_REGISTERED"},
+	{0xC0150024, "STATUS_SXS_TRANSACTION_CLOSURE_INCOMPLETE"},
+	{0xC0150025, "STATUS_SMI_PRIMITIVE_INSTALLER_FAILED"},
+	{0xC0150026, "STATUS_GENERIC_COMMAND_FAILED"},
+	{0xC0150027, "STATUS_SXS_FILE_HASH_MISSING"},
+	{0xC0190001, "STATUS_TRANSACTIONAL_CONFLICT"},
+	{0xC0190002, "STATUS_INVALID_TRANSACTION"},
+	{0xC0190003, "STATUS_TRANSACTION_NOT_ACTIVE"},
+	{0xC0190004, "STATUS_TM_INITIALIZATION_FAILED"},
+	{0xC0190005, "STATUS_RM_NOT_ACTIVE"},
+	{0xC0190006, "STATUS_RM_METADATA_CORRUPT"},
+	{0xC0190007, "STATUS_TRANSACTION_NOT_JOINED"},
+	{0xC0190008, "STATUS_DIRECTORY_NOT_RM"},
+	{0xC019000A, "STATUS_TRANSACTIONS_UNSUPPORTED_REMOTE"},
+	{0xC019000B, "STATUS_LOG_RESIZE_INVALID_SIZE"},
+	{0xC019000C, "STATUS_REMOTE_FILE_VERSION_MISMATCH"},
+	{0xC019000F, "STATUS_CRM_PROTOCOL_ALREADY_EXISTS"},
+	{0xC0190010, "STATUS_TRANSACTION_PROPAGATION_FAILED"},
+	{0xC0190011, "STATUS_CRM_PROTOCOL_NOT_FOUND"},
+	{0xC0190012, "STATUS_TRANSACTION_SUPERIOR_EXISTS"},
+	{0xC0190013, "STATUS_TRANSACTION_REQUEST_NOT_VALID"},
+	{0xC0190014, "STATUS_TRANSACTION_NOT_REQUESTED"},
+	{0xC0190015, "STATUS_TRANSACTION_ALREADY_ABORTED"},
+	{0xC0190016, "STATUS_TRANSACTION_ALREADY_COMMITTED"},
+	{0xC0190017, "STATUS_TRANSACTION_INVALID_MARSHALL_BUFFER"},
+	{0xC0190018, "STATUS_CURRENT_TRANSACTION_NOT_VALID"},
+	{0xC0190019, "STATUS_LOG_GROWTH_FAILED"},
+	{0xC0190021, "STATUS_OBJECT_NO_LONGER_EXISTS"},
+	{0xC0190022, "STATUS_STREAM_MINIVERSION_NOT_FOUND"},
+	{0xC0190023, "STATUS_STREAM_MINIVERSION_NOT_VALID"},
+	{0xC0190024, "STATUS_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION"},
+	{0xC0190025, "STATUS_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT"},
+	{0xC0190026, "STATUS_CANT_CREATE_MORE_STREAM_MINIVERSIONS"},
+	{0xC0190028, "STATUS_HANDLE_NO_LONGER_VALID"},
+	{0xC0190030, "STATUS_LOG_CORRUPTION_DETECTED"},
+	{0xC0190032, "STATUS_RM_DISCONNECTED"},
+	{0xC0190033, "STATUS_ENLISTMENT_NOT_SUPERIOR"},
+	{0xC0190036, "STATUS_FILE_IDENTITY_NOT_PERSISTENT"},
+	{0xC0190037, "STATUS_CANT_BREAK_TRANSACTIONAL_DEPENDENCY"},
+	{0xC0190038, "STATUS_CANT_CROSS_RM_BOUNDARY"},
+	{0xC0190039, "STATUS_TXF_DIR_NOT_EMPTY"},
+	{0xC019003A, "STATUS_INDOUBT_TRANSACTIONS_EXIST"},
+	{0xC019003B, "STATUS_TM_VOLATILE"},
+	{0xC019003C, "STATUS_ROLLBACK_TIMER_EXPIRED"},
+	{0xC019003D, "STATUS_TXF_ATTRIBUTE_CORRUPT"},
+	{0xC019003E, "STATUS_EFS_NOT_ALLOWED_IN_TRANSACTION"},
+	{0xC019003F, "STATUS_TRANSACTIONAL_OPEN_NOT_ALLOWED"},
+	{0xC0190040, "STATUS_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE"},
+	{0xC0190043, "STATUS_TRANSACTION_REQUIRED_PROMOTION"},
+	{0xC0190044, "STATUS_CANNOT_EXECUTE_FILE_IN_TRANSACTION"},
+	{0xC0190045, "STATUS_TRANSACTIONS_NOT_FROZEN"},
+	{0xC0190046, "STATUS_TRANSACTION_FREEZE_IN_PROGRESS"},
+	{0xC0190047, "STATUS_NOT_SNAPSHOT_VOLUME"},
+	{0xC0190048, "STATUS_NO_SAVEPOINT_WITH_OPEN_FILES"},
+	{0xC0190049, "STATUS_SPARSE_NOT_ALLOWED_IN_TRANSACTION"},
+	{0xC019004A, "STATUS_TM_IDENTITY_MISMATCH"},
+	{0xC019004B, "STATUS_FLOATED_SECTION"},
+	{0xC019004C, "STATUS_CANNOT_ACCEPT_TRANSACTED_WORK"},
+	{0xC019004D, "STATUS_CANNOT_ABORT_TRANSACTIONS"},
+	{0xC019004E, "STATUS_TRANSACTION_NOT_FOUND"},
+	{0xC019004F, "STATUS_RESOURCEMANAGER_NOT_FOUND"},
+	{0xC0190050, "STATUS_ENLISTMENT_NOT_FOUND"},
+	{0xC0190051, "STATUS_TRANSACTIONMANAGER_NOT_FOUND"},
+	{0xC0190052, "STATUS_TRANSACTIONMANAGER_NOT_ONLINE"},
+	{0xC0190053, "STATUS_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION"},
+	{0xC0190054, "STATUS_TRANSACTION_NOT_ROOT"},
+	{0xC0190055, "STATUS_TRANSACTION_OBJECT_EXPIRED"},
+	{0xC0190056, "STATUS_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION"},
+	{0xC0190057, "STATUS_TRANSACTION_RESPONSE_NOT_ENLISTED"},
+	{0xC0190058, "STATUS_TRANSACTION_RECORD_TOO_LONG"},
+	{0xC0190059, "STATUS_NO_LINK_TRACKING_IN_TRANSACTION"},
+	{0xC019005A, "STATUS_OPERATION_NOT_SUPPORTED_IN_TRANSACTION"},
+	{0xC019005B, "STATUS_TRANSACTION_INTEGRITY_VIOLATED"},
+	{0xC019005C, "STATUS_TRANSACTIONMANAGER_IDENTITY_MISMATCH"},
+	{0xC019005D, "STATUS_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT"},
+	{0xC019005E, "STATUS_TRANSACTION_MUST_WRITETHROUGH"},
+	{0xC019005F, "STATUS_TRANSACTION_NO_SUPERIOR"},
+	{0xC0190060, "STATUS_EXPIRED_HANDLE"},
+	{0xC0190061, "STATUS_TRANSACTION_NOT_ENLISTED"},
+	{0xC01A0001, "STATUS_LOG_SECTOR_INVALID"},
+	{0xC01A0002, "STATUS_LOG_SECTOR_PARITY_INVALID"},
+	{0xC01A0003, "STATUS_LOG_SECTOR_REMAPPED"},
+	{0xC01A0004, "STATUS_LOG_BLOCK_INCOMPLETE"},
+	{0xC01A0005, "STATUS_LOG_INVALID_RANGE"},
+	{0xC01A0006, "STATUS_LOG_BLOCKS_EXHAUSTED"},
+	{0xC01A0007, "STATUS_LOG_READ_CONTEXT_INVALID"},
+	{0xC01A0008, "STATUS_LOG_RESTART_INVALID"},
+	{0xC01A0009, "STATUS_LOG_BLOCK_VERSION"},
+	{0xC01A000A, "STATUS_LOG_BLOCK_INVALID"},
+	{0xC01A000B, "STATUS_LOG_READ_MODE_INVALID"},
+	{0xC01A000D, "STATUS_LOG_METADATA_CORRUPT"},
+	{0xC01A000E, "STATUS_LOG_METADATA_INVALID"},
+	{0xC01A000F, "STATUS_LOG_METADATA_INCONSISTENT"},
+	{0xC01A0010, "STATUS_LOG_RESERVATION_INVALID"},
+	{0xC01A0011, "STATUS_LOG_CANT_DELETE"},
+	{0xC01A0012, "STATUS_LOG_CONTAINER_LIMIT_EXCEEDED"},
+	{0xC01A0013, "STATUS_LOG_START_OF_LOG"},
+	{0xC01A0014, "STATUS_LOG_POLICY_ALREADY_INSTALLED"},
+	{0xC01A0015, "STATUS_LOG_POLICY_NOT_INSTALLED"},
+	{0xC01A0016, "STATUS_LOG_POLICY_INVALID"},
+	{0xC01A0017, "STATUS_LOG_POLICY_CONFLICT"},
+	{0xC01A0018, "STATUS_LOG_PINNED_ARCHIVE_TAIL"},
+	{0xC01A0019, "STATUS_LOG_RECORD_NONEXISTENT"},
+	{0xC01A001A, "STATUS_LOG_RECORDS_RESERVED_INVALID"},
+	{0xC01A001B, "STATUS_LOG_SPACE_RESERVED_INVALID"},
+	{0xC01A001C, "STATUS_LOG_TAIL_INVALID"},
+	{0xC01A001D, "STATUS_LOG_FULL"},
+	{0xC01A001E, "STATUS_LOG_MULTIPLEXED"},
+	{0xC01A001F, "STATUS_LOG_DEDICATED"},
+	{0xC01A0020, "STATUS_LOG_ARCHIVE_NOT_IN_PROGRESS"},
+	{0xC01A0021, "STATUS_LOG_ARCHIVE_IN_PROGRESS"},
+	{0xC01A0022, "STATUS_LOG_EPHEMERAL"},
+	{0xC01A0023, "STATUS_LOG_NOT_ENOUGH_CONTAINERS"},
+	{0xC01A0024, "STATUS_LOG_CLIENT_ALREADY_REGISTERED"},
+	{0xC01A0025, "STATUS_LOG_CLIENT_NOT_REGISTERED"},
+	{0xC01A0026, "STATUS_LOG_FULL_HANDLER_IN_PROGRESS"},
+	{0xC01A0027, "STATUS_LOG_CONTAINER_READ_FAILED"},
+	{0xC01A0028, "STATUS_LOG_CONTAINER_WRITE_FAILED"},
+	{0xC01A0029, "STATUS_LOG_CONTAINER_OPEN_FAILED"},
+	{0xC01A002A, "STATUS_LOG_CONTAINER_STATE_INVALID"},
+	{0xC01A002B, "STATUS_LOG_STATE_INVALID"},
+	{0xC01A002C, "STATUS_LOG_PINNED"},
+	{0xC01A002D, "STATUS_LOG_METADATA_FLUSH_FAILED"},
+	{0xC01A002E, "STATUS_LOG_INCONSISTENT_SECURITY"},
+	{0xC01A002F, "STATUS_LOG_APPENDED_FLUSH_FAILED"},
+	{0xC01A0030, "STATUS_LOG_PINNED_RESERVATION"},
+	{0xC01B00EA, "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD"},
+	{0xC01C0001, "STATUS_FLT_NO_HANDLER_DEFINED"},
+	{0xC01C0002, "STATUS_FLT_CONTEXT_ALREADY_DEFINED"},
+	{0xC01C0003, "STATUS_FLT_INVALID_ASYNCHRONOUS_REQUEST"},
+	{0xC01C0004, "STATUS_FLT_DISALLOW_FAST_IO"},
+	{0xC01C0005, "STATUS_FLT_INVALID_NAME_REQUEST"},
+	{0xC01C0006, "STATUS_FLT_NOT_SAFE_TO_POST_OPERATION"},
+	{0xC01C0007, "STATUS_FLT_NOT_INITIALIZED"},
+	{0xC01C0008, "STATUS_FLT_FILTER_NOT_READY"},
+	{0xC01C0009, "STATUS_FLT_POST_OPERATION_CLEANUP"},
+	{0xC01C000A, "STATUS_FLT_INTERNAL_ERROR"},
+	{0xC01C000B, "STATUS_FLT_DELETING_OBJECT"},
+	{0xC01C000C, "STATUS_FLT_MUST_BE_NONPAGED_POOL"},
+	{0xC01C000D, "STATUS_FLT_DUPLICATE_ENTRY"},
+	{0xC01C000E, "STATUS_FLT_CBDQ_DISABLED"},
+	{0xC01C000F, "STATUS_FLT_DO_NOT_ATTACH"},
+	{0xC01C0010, "STATUS_FLT_DO_NOT_DETACH"},
+	{0xC01C0011, "STATUS_FLT_INSTANCE_ALTITUDE_COLLISION"},
+	{0xC01C0012, "STATUS_FLT_INSTANCE_NAME_COLLISION"},
+	{0xC01C0013, "STATUS_FLT_FILTER_NOT_FOUND"},
+	{0xC01C0014, "STATUS_FLT_VOLUME_NOT_FOUND"},
+	{0xC01C0015, "STATUS_FLT_INSTANCE_NOT_FOUND"},
+	{0xC01C0016, "STATUS_FLT_CONTEXT_ALLOCATION_NOT_FOUND"},
+	{0xC01C0017, "STATUS_FLT_INVALID_CONTEXT_REGISTRATION"},
+	{0xC01C0018, "STATUS_FLT_NAME_CACHE_MISS"},
+	{0xC01C0019, "STATUS_FLT_NO_DEVICE_OBJECT"},
+	{0xC01C001A, "STATUS_FLT_VOLUME_ALREADY_MOUNTED"},
+	{0xC01C001B, "STATUS_FLT_ALREADY_ENLISTED"},
+	{0xC01C001C, "STATUS_FLT_CONTEXT_ALREADY_LINKED"},
+	{0xC01C0020, "STATUS_FLT_NO_WAITER_FOR_REPLY"},
+	{0xC01C0023, "STATUS_FLT_REGISTRATION_BUSY"},
+	{0xC01D0001, "STATUS_MONITOR_NO_DESCRIPTOR"},
+	{0xC01D0002, "STATUS_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT"},
+	{0xC01D0003, "STATUS_MONITOR_INVALID_DESCRIPTOR_CHECKSUM"},
+	{0xC01D0004, "STATUS_MONITOR_INVALID_STANDARD_TIMING_BLOCK"},
+	{0xC01D0005, "STATUS_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED"},
+	{0xC01D0006, "STATUS_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK"},
+	{0xC01D0007, "STATUS_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK"},
+	{0xC01D0008, "STATUS_MONITOR_NO_MORE_DESCRIPTOR_DATA"},
+	{0xC01D0009, "STATUS_MONITOR_INVALID_DETAILED_TIMING_BLOCK"},
+	{0xC01D000A, "STATUS_MONITOR_INVALID_MANUFACTURE_DATE"},
+	{0xC01E0000, "STATUS_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER"},
+	{0xC01E0001, "STATUS_GRAPHICS_INSUFFICIENT_DMA_BUFFER"},
+	{0xC01E0002, "STATUS_GRAPHICS_INVALID_DISPLAY_ADAPTER"},
+	{0xC01E0003, "STATUS_GRAPHICS_ADAPTER_WAS_RESET"},
+	{0xC01E0004, "STATUS_GRAPHICS_INVALID_DRIVER_MODEL"},
+	{0xC01E0005, "STATUS_GRAPHICS_PRESENT_MODE_CHANGED"},
+	{0xC01E0006, "STATUS_GRAPHICS_PRESENT_OCCLUDED"},
+	{0xC01E0007, "STATUS_GRAPHICS_PRESENT_DENIED"},
+	{0xC01E0008, "STATUS_GRAPHICS_CANNOTCOLORCONVERT"},
+	{0xC01E0009, "STATUS_GRAPHICS_DRIVER_MISMATCH"},
+	{0xC01E000B, "STATUS_GRAPHICS_PRESENT_REDIRECTION_DISABLED"},
+	{0xC01E000C, "STATUS_GRAPHICS_PRESENT_UNOCCLUDED"},
+	{0xC01E000D, "STATUS_GRAPHICS_WINDOWDC_NOT_AVAILABLE"},
+	{0xC01E000E, "STATUS_GRAPHICS_WINDOWLESS_PRESENT_DISABLED"},
+	{0xC01E000F, "STATUS_GRAPHICS_PRESENT_INVALID_WINDOW"},
+	{0xC01E0010, "STATUS_GRAPHICS_PRESENT_BUFFER_NOT_BOUND"},
+	{0xC01E0011, "STATUS_GRAPHICS_VAIL_STATE_CHANGED"},
+	{0xC01E0012, "STATUS_GRAPHICS_INDIRECT_DISPLAY_ABANDON_SWAPCHAIN"},
+	{0xC01E0013, "STATUS_GRAPHICS_INDIRECT_DISPLAY_DEVICE_STOPPED"},
+	{0xC01E0100, "STATUS_GRAPHICS_NO_VIDEO_MEMORY"},
+	{0xC01E0101, "STATUS_GRAPHICS_CANT_LOCK_MEMORY"},
+	{0xC01E0102, "STATUS_GRAPHICS_ALLOCATION_BUSY"},
+	{0xC01E0103, "STATUS_GRAPHICS_TOO_MANY_REFERENCES"},
+	{0xC01E0104, "STATUS_GRAPHICS_TRY_AGAIN_LATER"},
+	{0xC01E0105, "STATUS_GRAPHICS_TRY_AGAIN_NOW"},
+	{0xC01E0106, "STATUS_GRAPHICS_ALLOCATION_INVALID"},
+	{0xC01E0107, "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE"},
+	{0xC01E0108, "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED"},
+	{0xC01E0109, "STATUS_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION"},
+	{0xC01E0110, "STATUS_GRAPHICS_INVALID_ALLOCATION_USAGE"},
+	{0xC01E0111, "STATUS_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION"},
+	{0xC01E0112, "STATUS_GRAPHICS_ALLOCATION_CLOSED"},
+	{0xC01E0113, "STATUS_GRAPHICS_INVALID_ALLOCATION_INSTANCE"},
+	{0xC01E0114, "STATUS_GRAPHICS_INVALID_ALLOCATION_HANDLE"},
+	{0xC01E0115, "STATUS_GRAPHICS_WRONG_ALLOCATION_DEVICE"},
+	{0xC01E0116, "STATUS_GRAPHICS_ALLOCATION_CONTENT_LOST"},
+	{0xC01E0200, "STATUS_GRAPHICS_GPU_EXCEPTION_ON_DEVICE"},
+	{0xC01E0300, "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY"},
+	{0xC01E0301, "STATUS_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED"},
+	{0xC01E0302, "STATUS_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED"},
+	{0xC01E0303, "STATUS_GRAPHICS_INVALID_VIDPN"},
+	{0xC01E0304, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE"},
+	{0xC01E0305, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET"},
+	{0xC01E0306, "STATUS_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED"},
+	{0xC01E0308, "STATUS_GRAPHICS_INVALID_VIDPN_SOURCEMODESET"},
+	{0xC01E0309, "STATUS_GRAPHICS_INVALID_VIDPN_TARGETMODESET"},
+	{0xC01E030A, "STATUS_GRAPHICS_INVALID_FREQUENCY"},
+	{0xC01E030B, "STATUS_GRAPHICS_INVALID_ACTIVE_REGION"},
+	{0xC01E030C, "STATUS_GRAPHICS_INVALID_TOTAL_REGION"},
+	{0xC01E0310, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE"},
+	{0xC01E0311, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE"},
+	{0xC01E0312, "STATUS_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET"},
+	{0xC01E0313, "STATUS_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY"},
+	{0xC01E0314, "STATUS_GRAPHICS_MODE_ALREADY_IN_MODESET"},
+	{0xC01E0315, "STATUS_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET"},
+	{0xC01E0316, "STATUS_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET"},
+	{0xC01E0317, "STATUS_GRAPHICS_SOURCE_ALREADY_IN_SET"},
+	{0xC01E0318, "STATUS_GRAPHICS_TARGET_ALREADY_IN_SET"},
+	{0xC01E0319, "STATUS_GRAPHICS_INVALID_VIDPN_PRESENT_PATH"},
+	{0xC01E031A, "STATUS_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY"},
+	{0xC01E031B, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET"},
+	{0xC01E031C, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE"},
+	{0xC01E031D, "STATUS_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET"},
+	{0xC01E031F, "STATUS_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET"},
+	{0xC01E0320, "STATUS_GRAPHICS_STALE_MODESET"},
+	{0xC01E0321, "STATUS_GRAPHICS_INVALID_MONITOR_SOURCEMODESET"},
+	{0xC01E0322, "STATUS_GRAPHICS_INVALID_MONITOR_SOURCE_MODE"},
+	{0xC01E0323, "STATUS_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN"},
+	{0xC01E0324, "STATUS_GRAPHICS_MODE_ID_MUST_BE_UNIQUE"},
+	{0xC01E0325, "STATUS_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION"},
+	{0xC01E0326, "STATUS_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES"},
+	{0xC01E0327, "STATUS_GRAPHICS_PATH_NOT_IN_TOPOLOGY"},
+	{0xC01E0328, "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE"},
+	{0xC01E0329, "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET"},
+	{0xC01E032A, "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTORSET"},
+	{0xC01E032B, "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTOR"},
+	{0xC01E032C, "STATUS_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET"},
+	{0xC01E032D, "STATUS_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET"},
+	{0xC01E032E, "STATUS_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE"},
+	{0xC01E032F, "STATUS_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE"},
+	{0xC01E0330, "STATUS_GRAPHICS_RESOURCES_NOT_RELATED"},
+	{0xC01E0331, "STATUS_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE"},
+	{0xC01E0332, "STATUS_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE"},
+	{0xC01E0333, "STATUS_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET"},
+	{0xC01E0334, "STATUS_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER"},
+	{0xC01E0335, "STATUS_GRAPHICS_NO_VIDPNMGR"},
+	{0xC01E0336, "STATUS_GRAPHICS_NO_ACTIVE_VIDPN"},
+	{0xC01E0337, "STATUS_GRAPHICS_STALE_VIDPN_TOPOLOGY"},
+	{0xC01E0338, "STATUS_GRAPHICS_MONITOR_NOT_CONNECTED"},
+	{0xC01E0339, "STATUS_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY"},
+	{0xC01E033A, "STATUS_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE"},
+	{0xC01E033B, "STATUS_GRAPHICS_INVALID_VISIBLEREGION_SIZE"},
+	{0xC01E033C, "STATUS_GRAPHICS_INVALID_STRIDE"},
+	{0xC01E033D, "STATUS_GRAPHICS_INVALID_PIXELFORMAT"},
+	{0xC01E033E, "STATUS_GRAPHICS_INVALID_COLORBASIS"},
+	{0xC01E033F, "STATUS_GRAPHICS_INVALID_PIXELVALUEACCESSMODE"},
+	{0xC01E0340, "STATUS_GRAPHICS_TARGET_NOT_IN_TOPOLOGY"},
+	{0xC01E0341, "STATUS_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT"},
+	{0xC01E0342, "STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE"},
+	{0xC01E0343, "STATUS_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN"},
+	{0xC01E0344, "STATUS_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL"},
+	{0xC01E0345, "STATUS_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION"},
+	{0xC01E0346, "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED"},
+	{0xC01E0347, "STATUS_GRAPHICS_INVALID_GAMMA_RAMP"},
+	{0xC01E0348, "STATUS_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED"},
+	{0xC01E0349, "STATUS_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED"},
+	{0xC01E034A, "STATUS_GRAPHICS_MODE_NOT_IN_MODESET"},
+	{0xC01E034D, "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON"},
+	{0xC01E034E, "STATUS_GRAPHICS_INVALID_PATH_CONTENT_TYPE"},
+	{0xC01E034F, "STATUS_GRAPHICS_INVALID_COPYPROTECTION_TYPE"},
+	{0xC01E0350, "STATUS_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS"},
+	{0xC01E0352, "STATUS_GRAPHICS_INVALID_SCANLINE_ORDERING"},
+	{0xC01E0353, "STATUS_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED"},
+	{0xC01E0354, "STATUS_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS"},
+	{0xC01E0355, "STATUS_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT"},
+	{0xC01E0356, "STATUS_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM"},
+	{0xC01E0357, "STATUS_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN"},
+	{0xC01E0358, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT"},
+	{0xC01E0359, "STATUS_GRAPHICS_MAX_NUM_PATHS_REACHED"},
+	{0xC01E035A, "STATUS_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION"},
+	{0xC01E035B, "STATUS_GRAPHICS_INVALID_CLIENT_TYPE"},
+	{0xC01E035C, "STATUS_GRAPHICS_CLIENTVIDPN_NOT_SET"},
+	{0xC01E0400, "STATUS_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED"},
+	{0xC01E0401, "STATUS_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED"},
+	{0xC01E0430, "STATUS_GRAPHICS_NOT_A_LINKED_ADAPTER"},
+	{0xC01E0431, "STATUS_GRAPHICS_LEADLINK_NOT_ENUMERATED"},
+	{0xC01E0432, "STATUS_GRAPHICS_CHAINLINKS_NOT_ENUMERATED"},
+	{0xC01E0433, "STATUS_GRAPHICS_ADAPTER_CHAIN_NOT_READY"},
+	{0xC01E0434, "STATUS_GRAPHICS_CHAINLINKS_NOT_STARTED"},
+	{0xC01E0435, "STATUS_GRAPHICS_CHAINLINKS_NOT_POWERED_ON"},
+	{0xC01E0436, "STATUS_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE"},
+	{0xC01E0438, "STATUS_GRAPHICS_NOT_POST_DEVICE_DRIVER"},
+	{0xC01E043B, "STATUS_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED"},
+	{0xC01E0500, "STATUS_GRAPHICS_OPM_NOT_SUPPORTED"},
+	{0xC01E0501, "STATUS_GRAPHICS_COPP_NOT_SUPPORTED"},
+	{0xC01E0502, "STATUS_GRAPHICS_UAB_NOT_SUPPORTED"},
+	{0xC01E0503, "STATUS_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS"},
+	{0xC01E0505, "STATUS_GRAPHICS_OPM_NO_PROTECTED_OUTPUTS_EXIST"},
+	{0xC01E050B, "STATUS_GRAPHICS_OPM_INTERNAL_ERROR"},
+	{0xC01E050C, "STATUS_GRAPHICS_OPM_INVALID_HANDLE"},
+	{0xC01E050E, "STATUS_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH"},
+	{0xC01E050F, "STATUS_GRAPHICS_OPM_SPANNING_MODE_ENABLED"},
+	{0xC01E0510, "STATUS_GRAPHICS_OPM_THEATER_MODE_ENABLED"},
+	{0xC01E0511, "STATUS_GRAPHICS_PVP_HFS_FAILED"},
+	{0xC01E0512, "STATUS_GRAPHICS_OPM_INVALID_SRM"},
+	{0xC01E0513, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP"},
+	{0xC01E0514, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP"},
+	{0xC01E0515, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA"},
+	{0xC01E0516, "STATUS_GRAPHICS_OPM_HDCP_SRM_NEVER_SET"},
+	{0xC01E0517, "STATUS_GRAPHICS_OPM_RESOLUTION_TOO_HIGH"},
+	{0xC01E0518, "STATUS_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE"},
+	{0xC01E051A, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_NO_LONGER_EXISTS"},
+	{0xC01E051C, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS"},
+	{0xC01E051D, "STATUS_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST"},
+	{0xC01E051E, "STATUS_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR"},
+	{0xC01E051F, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS"},
+	{0xC01E0520, "STATUS_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED"},
+	{0xC01E0521, "STATUS_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST"},
+	{0xC01E0580, "STATUS_GRAPHICS_I2C_NOT_SUPPORTED"},
+	{0xC01E0581, "STATUS_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST"},
+	{0xC01E0582, "STATUS_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA"},
+	{0xC01E0583, "STATUS_GRAPHICS_I2C_ERROR_RECEIVING_DATA"},
+	{0xC01E0584, "STATUS_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED"},
+	{0xC01E0585, "STATUS_GRAPHICS_DDCCI_INVALID_DATA"},
+	{0xC01E0586, "STATUS_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE"},
+	{0xC01E0587, "STATUS_GRAPHICS_DDCCI_INVALID_CAPABILITIES_STRING"},
+	{0xC01E0588, "STATUS_GRAPHICS_MCA_INTERNAL_ERROR"},
+	{0xC01E0589, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND"},
+	{0xC01E058A, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH"},
+	{0xC01E058B, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM"},
+	{0xC01E058C, "STATUS_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE"},
+	{0xC01E058D, "STATUS_GRAPHICS_MONITOR_NO_LONGER_EXISTS"},
+	{0xC01E05E0, "STATUS_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED"},
+	{0xC01E05E1, "STATUS_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME"},
+	{0xC01E05E2, "STATUS_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP"},
+	{0xC01E05E3, "STATUS_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED"},
+	{0xC01E05E4, "STATUS_GRAPHICS_INVALID_POINTER"},
+	{0xC01E05E5, "STATUS_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE"},
+	{0xC01E05E6, "STATUS_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL"},
+	{0xC01E05E7, "STATUS_GRAPHICS_INTERNAL_ERROR"},
+	{0xC01E05E8, "STATUS_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS"},
+	{0xC0210000, "STATUS_FVE_LOCKED_VOLUME"},
+	{0xC0210001, "STATUS_FVE_NOT_ENCRYPTED"},
+	{0xC0210002, "STATUS_FVE_BAD_INFORMATION"},
+	{0xC0210003, "STATUS_FVE_TOO_SMALL"},
+	{0xC0210004, "STATUS_FVE_FAILED_WRONG_FS"},
+	{0xC0210005, "STATUS_FVE_BAD_PARTITION_SIZE"},
+	{0xC0210006, "STATUS_FVE_FS_NOT_EXTENDED"},
+	{0xC0210007, "STATUS_FVE_FS_MOUNTED"},
+	{0xC0210008, "STATUS_FVE_NO_LICENSE"},
+	{0xC0210009, "STATUS_FVE_ACTION_NOT_ALLOWED"},
+	{0xC021000A, "STATUS_FVE_BAD_DATA"},
+	{0xC021000B, "STATUS_FVE_VOLUME_NOT_BOUND"},
+	{0xC021000C, "STATUS_FVE_NOT_DATA_VOLUME"},
+	{0xC021000D, "STATUS_FVE_CONV_READ_ERROR"},
+	{0xC021000E, "STATUS_FVE_CONV_WRITE_ERROR"},
+	{0xC021000F, "STATUS_FVE_OVERLAPPED_UPDATE"},
+	{0xC0210010, "STATUS_FVE_FAILED_SECTOR_SIZE"},
+	{0xC0210011, "STATUS_FVE_FAILED_AUTHENTICATION"},
+	{0xC0210012, "STATUS_FVE_NOT_OS_VOLUME"},
+	{0xC0210013, "STATUS_FVE_KEYFILE_NOT_FOUND"},
+	{0xC0210014, "STATUS_FVE_KEYFILE_INVALID"},
+	{0xC0210015, "STATUS_FVE_KEYFILE_NO_VMK"},
+	{0xC0210016, "STATUS_FVE_TPM_DISABLED"},
+	{0xC0210017, "STATUS_FVE_TPM_SRK_AUTH_NOT_ZERO"},
+	{0xC0210018, "STATUS_FVE_TPM_INVALID_PCR"},
+	{0xC0210019, "STATUS_FVE_TPM_NO_VMK"},
+	{0xC021001A, "STATUS_FVE_PIN_INVALID"},
+	{0xC021001B, "STATUS_FVE_AUTH_INVALID_APPLICATION"},
+	{0xC021001C, "STATUS_FVE_AUTH_INVALID_CONFIG"},
+	{0xC021001D, "STATUS_FVE_DEBUGGER_ENABLED"},
+	{0xC021001E, "STATUS_FVE_DRY_RUN_FAILED"},
+	{0xC021001F, "STATUS_FVE_BAD_METADATA_POINTER"},
+	{0xC0210020, "STATUS_FVE_OLD_METADATA_COPY"},
+	{0xC0210021, "STATUS_FVE_REBOOT_REQUIRED"},
+	{0xC0210022, "STATUS_FVE_RAW_ACCESS"},
+	{0xC0210023, "STATUS_FVE_RAW_BLOCKED"},
+	{0xC0210024, "STATUS_FVE_NO_AUTOUNLOCK_MASTER_KEY"},
+	{0xC0210025, "STATUS_FVE_MOR_FAILED"},
+	{0xC0210026, "STATUS_FVE_NO_FEATURE_LICENSE"},
+	{0xC0210027, "STATUS_FVE_POLICY_USER_DISABLE_RDV_NOT_ALLOWED"},
+	{0xC0210028, "STATUS_FVE_CONV_RECOVERY_FAILED"},
+	{0xC0210029, "STATUS_FVE_VIRTUALIZED_SPACE_TOO_BIG"},
+	{0xC021002A, "STATUS_FVE_INVALID_DATUM_TYPE"},
+	{0xC0210030, "STATUS_FVE_VOLUME_TOO_SMALL"},
+	{0xC0210031, "STATUS_FVE_ENH_PIN_INVALID"},
+	{0xC0210032, "STATUS_FVE_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE"},
+	{0xC0210033, "STATUS_FVE_WIPE_NOT_ALLOWED_ON_TP_STORAGE"},
+	{0xC0210034, "STATUS_FVE_NOT_ALLOWED_ON_CSV_STACK"},
+	{0xC0210035, "STATUS_FVE_NOT_ALLOWED_ON_CLUSTER"},
+	{0xC0210036, "STATUS_FVE_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING"},
+	{0xC0210037, "STATUS_FVE_WIPE_CANCEL_NOT_APPLICABLE"},
+	{0xC0210038, "STATUS_FVE_EDRIVE_DRY_RUN_FAILED"},
+	{0xC0210039, "STATUS_FVE_SECUREBOOT_DISABLED"},
+	{0xC021003A, "STATUS_FVE_SECUREBOOT_CONFIG_CHANGE"},
+	{0xC021003B, "STATUS_FVE_DEVICE_LOCKEDOUT"},
+	{0xC021003C, "STATUS_FVE_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT"},
+	{0xC021003D, "STATUS_FVE_NOT_DE_VOLUME"},
+	{0xC021003E, "STATUS_FVE_PROTECTION_DISABLED"},
+	{0xC021003F, "STATUS_FVE_PROTECTION_CANNOT_BE_DISABLED"},
+	{0xC0210040, "STATUS_FVE_OSV_KSR_NOT_ALLOWED"},
+	{0xC0220001, "STATUS_FWP_CALLOUT_NOT_FOUND"},
+	{0xC0220002, "STATUS_FWP_CONDITION_NOT_FOUND"},
+	{0xC0220003, "STATUS_FWP_FILTER_NOT_FOUND"},
+	{0xC0220004, "STATUS_FWP_LAYER_NOT_FOUND"},
+	{0xC0220005, "STATUS_FWP_PROVIDER_NOT_FOUND"},
+	{0xC0220006, "STATUS_FWP_PROVIDER_CONTEXT_NOT_FOUND"},
+	{0xC0220007, "STATUS_FWP_SUBLAYER_NOT_FOUND"},
+	{0xC0220008, "STATUS_FWP_NOT_FOUND"},
+	{0xC0220009, "STATUS_FWP_ALREADY_EXISTS"},
+	{0xC022000A, "STATUS_FWP_IN_USE"},
+	{0xC022000B, "STATUS_FWP_DYNAMIC_SESSION_IN_PROGRESS"},
+	{0xC022000C, "STATUS_FWP_WRONG_SESSION"},
+	{0xC022000D, "STATUS_FWP_NO_TXN_IN_PROGRESS"},
+	{0xC022000E, "STATUS_FWP_TXN_IN_PROGRESS"},
+	{0xC022000F, "STATUS_FWP_TXN_ABORTED"},
+	{0xC0220010, "STATUS_FWP_SESSION_ABORTED"},
+	{0xC0220011, "STATUS_FWP_INCOMPATIBLE_TXN"},
+	{0xC0220012, "STATUS_FWP_TIMEOUT"},
+	{0xC0220013, "STATUS_FWP_NET_EVENTS_DISABLED"},
+	{0xC0220014, "STATUS_FWP_INCOMPATIBLE_LAYER"},
+	{0xC0220015, "STATUS_FWP_KM_CLIENTS_ONLY"},
+	{0xC0220016, "STATUS_FWP_LIFETIME_MISMATCH"},
+	{0xC0220017, "STATUS_FWP_BUILTIN_OBJECT"},
+	{0xC0220018, "STATUS_FWP_TOO_MANY_CALLOUTS"},
+	{0xC0220019, "STATUS_FWP_NOTIFICATION_DROPPED"},
+	{0xC022001A, "STATUS_FWP_TRAFFIC_MISMATCH"},
+	{0xC022001B, "STATUS_FWP_INCOMPATIBLE_SA_STATE"},
+	{0xC022001C, "STATUS_FWP_NULL_POINTER"},
+	{0xC022001D, "STATUS_FWP_INVALID_ENUMERATOR"},
+	{0xC022001E, "STATUS_FWP_INVALID_FLAGS"},
+	{0xC022001F, "STATUS_FWP_INVALID_NET_MASK"},
+	{0xC0220020, "STATUS_FWP_INVALID_RANGE"},
+	{0xC0220021, "STATUS_FWP_INVALID_INTERVAL"},
+	{0xC0220022, "STATUS_FWP_ZERO_LENGTH_ARRAY"},
+	{0xC0220023, "STATUS_FWP_NULL_DISPLAY_NAME"},
+	{0xC0220024, "STATUS_FWP_INVALID_ACTION_TYPE"},
+	{0xC0220025, "STATUS_FWP_INVALID_WEIGHT"},
+	{0xC0220026, "STATUS_FWP_MATCH_TYPE_MISMATCH"},
+	{0xC0220027, "STATUS_FWP_TYPE_MISMATCH"},
+	{0xC0220028, "STATUS_FWP_OUT_OF_BOUNDS"},
+	{0xC0220029, "STATUS_FWP_RESERVED"},
+	{0xC022002A, "STATUS_FWP_DUPLICATE_CONDITION"},
+	{0xC022002B, "STATUS_FWP_DUPLICATE_KEYMOD"},
+	{0xC022002C, "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_LAYER"},
+	{0xC022002D, "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_SUBLAYER"},
+	{0xC022002E, "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_LAYER"},
+	{0xC022002F, "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_CALLOUT"},
+	{0xC0220030, "STATUS_FWP_INCOMPATIBLE_AUTH_METHOD"},
+	{0xC0220031, "STATUS_FWP_INCOMPATIBLE_DH_GROUP"},
+	{0xC0220032, "STATUS_FWP_EM_NOT_SUPPORTED"},
+	{0xC0220033, "STATUS_FWP_NEVER_MATCH"},
+	{0xC0220034, "STATUS_FWP_PROVIDER_CONTEXT_MISMATCH"},
+	{0xC0220035, "STATUS_FWP_INVALID_PARAMETER"},
+	{0xC0220036, "STATUS_FWP_TOO_MANY_SUBLAYERS"},
+	{0xC0220037, "STATUS_FWP_CALLOUT_NOTIFICATION_FAILED"},
+	{0xC0220038, "STATUS_FWP_INVALID_AUTH_TRANSFORM"},
+	{0xC0220039, "STATUS_FWP_INVALID_CIPHER_TRANSFORM"},
+	{0xC022003A, "STATUS_FWP_INCOMPATIBLE_CIPHER_TRANSFORM"},
+	{0xC022003B, "STATUS_FWP_INVALID_TRANSFORM_COMBINATION"},
+	{0xC022003C, "STATUS_FWP_DUPLICATE_AUTH_METHOD"},
+	{0xC022003D, "STATUS_FWP_INVALID_TUNNEL_ENDPOINT"},
+	{0xC022003E, "STATUS_FWP_L2_DRIVER_NOT_READY"},
+	{0xC022003F, "STATUS_FWP_KEY_DICTATOR_ALREADY_REGISTERED"},
+	{0xC0220040, "STATUS_FWP_KEY_DICTATION_INVALID_KEYING_MATERIAL"},
+	{0xC0220041, "STATUS_FWP_CONNECTIONS_DISABLED"},
+	{0xC0220042, "STATUS_FWP_INVALID_DNS_NAME"},
+	{0xC0220043, "STATUS_FWP_STILL_ON"},
+	{0xC0220044, "STATUS_FWP_IKEEXT_NOT_RUNNING"},
+	{0xC0220100, "STATUS_FWP_TCPIP_NOT_READY"},
+	{0xC0220101, "STATUS_FWP_INJECT_HANDLE_CLOSING"},
+	{0xC0220102, "STATUS_FWP_INJECT_HANDLE_STALE"},
+	{0xC0220103, "STATUS_FWP_CANNOT_PEND"},
+	{0xC0220104, "STATUS_FWP_DROP_NOICMP"},
+	{0xC0230002, "STATUS_NDIS_CLOSING"},
+	{0xC0230004, "STATUS_NDIS_BAD_VERSION"},
+	{0xC0230005, "STATUS_NDIS_BAD_CHARACTERISTICS"},
+	{0xC0230006, "STATUS_NDIS_ADAPTER_NOT_FOUND"},
+	{0xC0230007, "STATUS_NDIS_OPEN_FAILED"},
+	{0xC0230008, "STATUS_NDIS_DEVICE_FAILED"},
+	{0xC0230009, "STATUS_NDIS_MULTICAST_FULL"},
+	{0xC023000A, "STATUS_NDIS_MULTICAST_EXISTS"},
+	{0xC023000B, "STATUS_NDIS_MULTICAST_NOT_FOUND"},
+	{0xC023000C, "STATUS_NDIS_REQUEST_ABORTED"},
+	{0xC023000D, "STATUS_NDIS_RESET_IN_PROGRESS"},
+	{0xC023000F, "STATUS_NDIS_INVALID_PACKET"},
+	{0xC0230010, "STATUS_NDIS_INVALID_DEVICE_REQUEST"},
+	{0xC0230011, "STATUS_NDIS_ADAPTER_NOT_READY"},
+	{0xC0230014, "STATUS_NDIS_INVALID_LENGTH"},
+	{0xC0230015, "STATUS_NDIS_INVALID_DATA"},
+	{0xC0230016, "STATUS_NDIS_BUFFER_TOO_SHORT"},
+	{0xC0230017, "STATUS_NDIS_INVALID_OID"},
+	{0xC0230018, "STATUS_NDIS_ADAPTER_REMOVED"},
+	{0xC0230019, "STATUS_NDIS_UNSUPPORTED_MEDIA"},
+	{0xC023001A, "STATUS_NDIS_GROUP_ADDRESS_IN_USE"},
+	{0xC023001B, "STATUS_NDIS_FILE_NOT_FOUND"},
+	{0xC023001C, "STATUS_NDIS_ERROR_READING_FILE"},
+	{0xC023001D, "STATUS_NDIS_ALREADY_MAPPED"},
+	{0xC023001E, "STATUS_NDIS_RESOURCE_CONFLICT"},
+	{0xC023001F, "STATUS_NDIS_MEDIA_DISCONNECTED"},
+	{0xC0230022, "STATUS_NDIS_INVALID_ADDRESS"},
+	{0xC023002A, "STATUS_NDIS_PAUSED"},
+	{0xC023002B, "STATUS_NDIS_INTERFACE_NOT_FOUND"},
+	{0xC023002C, "STATUS_NDIS_UNSUPPORTED_REVISION"},
+	{0xC023002D, "STATUS_NDIS_INVALID_PORT"},
+	{0xC023002E, "STATUS_NDIS_INVALID_PORT_STATE"},
+	{0xC023002F, "STATUS_NDIS_LOW_POWER_STATE"},
+	{0xC0230030, "STATUS_NDIS_REINIT_REQUIRED"},
+	{0xC0230031, "STATUS_NDIS_NO_QUEUES"},
+	{0xC02300BB, "STATUS_NDIS_NOT_SUPPORTED"},
+	{0xC023100F, "STATUS_NDIS_OFFLOAD_POLICY"},
+	{0xC0231012, "STATUS_NDIS_OFFLOAD_CONNECTION_REJECTED"},
+	{0xC0231013, "STATUS_NDIS_OFFLOAD_PATH_REJECTED"},
+	{0xC0232000, "STATUS_NDIS_DOT11_AUTO_CONFIG_ENABLED"},
+	{0xC0232001, "STATUS_NDIS_DOT11_MEDIA_IN_USE"},
+	{0xC0232002, "STATUS_NDIS_DOT11_POWER_STATE_INVALID"},
+	{0xC0232003, "STATUS_NDIS_PM_WOL_PATTERN_LIST_FULL"},
+	{0xC0232004, "STATUS_NDIS_PM_PROTOCOL_OFFLOAD_LIST_FULL"},
+	{0xC0232005, "STATUS_NDIS_DOT11_AP_CHANNEL_CURRENTLY_NOT_AVAILABLE"},
+	{0xC0232006, "STATUS_NDIS_DOT11_AP_BAND_CURRENTLY_NOT_AVAILABLE"},
+	{0xC0232007, "STATUS_NDIS_DOT11_AP_CHANNEL_NOT_ALLOWED"},
+	{0xC0232008, "STATUS_NDIS_DOT11_AP_BAND_NOT_ALLOWED"},
+	{0xC0290000, "STATUS_TPM_ERROR_MASK"},
+	{0xC0290001, "STATUS_TPM_AUTHFAIL"},
+	{0xC0290002, "STATUS_TPM_BADINDEX"},
+	{0xC0290003, "STATUS_TPM_BAD_PARAMETER"},
+	{0xC0290004, "STATUS_TPM_AUDITFAILURE"},
+	{0xC0290005, "STATUS_TPM_CLEAR_DISABLED"},
+	{0xC0290006, "STATUS_TPM_DEACTIVATED"},
+	{0xC0290007, "STATUS_TPM_DISABLED"},
+	{0xC0290008, "STATUS_TPM_DISABLED_CMD"},
+	{0xC0290009, "STATUS_TPM_FAIL"},
+	{0xC029000A, "STATUS_TPM_BAD_ORDINAL"},
+	{0xC029000B, "STATUS_TPM_INSTALL_DISABLED"},
+	{0xC029000C, "STATUS_TPM_INVALID_KEYHANDLE"},
+	{0xC029000D, "STATUS_TPM_KEYNOTFOUND"},
+	{0xC029000E, "STATUS_TPM_INAPPROPRIATE_ENC"},
+	{0xC029000F, "STATUS_TPM_MIGRATEFAIL"},
+	{0xC0290010, "STATUS_TPM_INVALID_PCR_INFO"},
+	{0xC0290011, "STATUS_TPM_NOSPACE"},
+	{0xC0290012, "STATUS_TPM_NOSRK"},
+	{0xC0290013, "STATUS_TPM_NOTSEALED_BLOB"},
+	{0xC0290014, "STATUS_TPM_OWNER_SET"},
+	{0xC0290015, "STATUS_TPM_RESOURCES"},
+	{0xC0290016, "STATUS_TPM_SHORTRANDOM"},
+	{0xC0290017, "STATUS_TPM_SIZE"},
+	{0xC0290018, "STATUS_TPM_WRONGPCRVAL"},
+	{0xC0290019, "STATUS_TPM_BAD_PARAM_SIZE"},
+	{0xC029001A, "STATUS_TPM_SHA_THREAD"},
+	{0xC029001B, "STATUS_TPM_SHA_ERROR"},
+	{0xC029001C, "STATUS_TPM_FAILEDSELFTEST"},
+	{0xC029001D, "STATUS_TPM_AUTH2FAIL"},
+	{0xC029001E, "STATUS_TPM_BADTAG"},
+	{0xC029001F, "STATUS_TPM_IOERROR"},
+	{0xC0290020, "STATUS_TPM_ENCRYPT_ERROR"},
+	{0xC0290021, "STATUS_TPM_DECRYPT_ERROR"},
+	{0xC0290022, "STATUS_TPM_INVALID_AUTHHANDLE"},
+	{0xC0290023, "STATUS_TPM_NO_ENDORSEMENT"},
+	{0xC0290024, "STATUS_TPM_INVALID_KEYUSAGE"},
+	{0xC0290025, "STATUS_TPM_WRONG_ENTITYTYPE"},
+	{0xC0290026, "STATUS_TPM_INVALID_POSTINIT"},
+	{0xC0290027, "STATUS_TPM_INAPPROPRIATE_SIG"},
+	{0xC0290028, "STATUS_TPM_BAD_KEY_PROPERTY"},
+	{0xC0290029, "STATUS_TPM_BAD_MIGRATION"},
+	{0xC029002A, "STATUS_TPM_BAD_SCHEME"},
+	{0xC029002B, "STATUS_TPM_BAD_DATASIZE"},
+	{0xC029002C, "STATUS_TPM_BAD_MODE"},
+	{0xC029002D, "STATUS_TPM_BAD_PRESENCE"},
+	{0xC029002E, "STATUS_TPM_BAD_VERSION"},
+	{0xC029002F, "STATUS_TPM_NO_WRAP_TRANSPORT"},
+	{0xC0290030, "STATUS_TPM_AUDITFAIL_UNSUCCESSFUL"},
+	{0xC0290031, "STATUS_TPM_AUDITFAIL_SUCCESSFUL"},
+	{0xC0290032, "STATUS_TPM_NOTRESETABLE"},
+	{0xC0290033, "STATUS_TPM_NOTLOCAL"},
+	{0xC0290034, "STATUS_TPM_BAD_TYPE"},
+	{0xC0290035, "STATUS_TPM_INVALID_RESOURCE"},
+	{0xC0290036, "STATUS_TPM_NOTFIPS"},
+	{0xC0290037, "STATUS_TPM_INVALID_FAMILY"},
+	{0xC0290038, "STATUS_TPM_NO_NV_PERMISSION"},
+	{0xC0290039, "STATUS_TPM_REQUIRES_SIGN"},
+	{0xC029003A, "STATUS_TPM_KEY_NOTSUPPORTED"},
+	{0xC029003B, "STATUS_TPM_AUTH_CONFLICT"},
+	{0xC029003C, "STATUS_TPM_AREA_LOCKED"},
+	{0xC029003D, "STATUS_TPM_BAD_LOCALITY"},
+	{0xC029003E, "STATUS_TPM_READ_ONLY"},
+	{0xC029003F, "STATUS_TPM_PER_NOWRITE"},
+	{0xC0290040, "STATUS_TPM_FAMILYCOUNT"},
+	{0xC0290041, "STATUS_TPM_WRITE_LOCKED"},
+	{0xC0290042, "STATUS_TPM_BAD_ATTRIBUTES"},
+	{0xC0290043, "STATUS_TPM_INVALID_STRUCTURE"},
+	{0xC0290044, "STATUS_TPM_KEY_OWNER_CONTROL"},
+	{0xC0290045, "STATUS_TPM_BAD_COUNTER"},
+	{0xC0290046, "STATUS_TPM_NOT_FULLWRITE"},
+	{0xC0290047, "STATUS_TPM_CONTEXT_GAP"},
+	{0xC0290048, "STATUS_TPM_MAXNVWRITES"},
+	{0xC0290049, "STATUS_TPM_NOOPERATOR"},
+	{0xC029004A, "STATUS_TPM_RESOURCEMISSING"},
+	{0xC029004B, "STATUS_TPM_DELEGATE_LOCK"},
+	{0xC029004C, "STATUS_TPM_DELEGATE_FAMILY"},
+	{0xC029004D, "STATUS_TPM_DELEGATE_ADMIN"},
+	{0xC029004E, "STATUS_TPM_TRANSPORT_NOTEXCLUSIVE"},
+	{0xC029004F, "STATUS_TPM_OWNER_CONTROL"},
+	{0xC0290050, "STATUS_TPM_DAA_RESOURCES"},
+	{0xC0290051, "STATUS_TPM_DAA_INPUT_DATA0"},
+	{0xC0290052, "STATUS_TPM_DAA_INPUT_DATA1"},
+	{0xC0290053, "STATUS_TPM_DAA_ISSUER_SETTINGS"},
+	{0xC0290054, "STATUS_TPM_DAA_TPM_SETTINGS"},
+	{0xC0290055, "STATUS_TPM_DAA_STAGE"},
+	{0xC0290056, "STATUS_TPM_DAA_ISSUER_VALIDITY"},
+	{0xC0290057, "STATUS_TPM_DAA_WRONG_W"},
+	{0xC0290058, "STATUS_TPM_BAD_HANDLE"},
+	{0xC0290059, "STATUS_TPM_BAD_DELEGATE"},
+	{0xC029005A, "STATUS_TPM_BADCONTEXT"},
+	{0xC029005B, "STATUS_TPM_TOOMANYCONTEXTS"},
+	{0xC029005C, "STATUS_TPM_MA_TICKET_SIGNATURE"},
+	{0xC029005D, "STATUS_TPM_MA_DESTINATION"},
+	{0xC029005E, "STATUS_TPM_MA_SOURCE"},
+	{0xC029005F, "STATUS_TPM_MA_AUTHORITY"},
+	{0xC0290061, "STATUS_TPM_PERMANENTEK"},
+	{0xC0290062, "STATUS_TPM_BAD_SIGNATURE"},
+	{0xC0290063, "STATUS_TPM_NOCONTEXTSPACE"},
+	{0xC0290081, "STATUS_TPM_20_E_ASYMMETRIC"},
+	{0xC0290082, "STATUS_TPM_20_E_ATTRIBUTES"},
+	{0xC0290083, "STATUS_TPM_20_E_HASH"},
+	{0xC0290084, "STATUS_TPM_20_E_VALUE"},
+	{0xC0290085, "STATUS_TPM_20_E_HIERARCHY"},
+	{0xC0290087, "STATUS_TPM_20_E_KEY_SIZE"},
+	{0xC0290088, "STATUS_TPM_20_E_MGF"},
+	{0xC0290089, "STATUS_TPM_20_E_MODE"},
+	{0xC029008A, "STATUS_TPM_20_E_TYPE"},
+	{0xC029008B, "STATUS_TPM_20_E_HANDLE"},
+	{0xC029008C, "STATUS_TPM_20_E_KDF"},
+	{0xC029008D, "STATUS_TPM_20_E_RANGE"},
+	{0xC029008E, "STATUS_TPM_20_E_AUTH_FAIL"},
+	{0xC029008F, "STATUS_TPM_20_E_NONCE"},
+	{0xC0290090, "STATUS_TPM_20_E_PP"},
+	{0xC0290092, "STATUS_TPM_20_E_SCHEME"},
+	{0xC0290095, "STATUS_TPM_20_E_SIZE"},
+	{0xC0290096, "STATUS_TPM_20_E_SYMMETRIC"},
+	{0xC0290097, "STATUS_TPM_20_E_TAG"},
+	{0xC0290098, "STATUS_TPM_20_E_SELECTOR"},
+	{0xC029009A, "STATUS_TPM_20_E_INSUFFICIENT"},
+	{0xC029009B, "STATUS_TPM_20_E_SIGNATURE"},
+	{0xC029009C, "STATUS_TPM_20_E_KEY"},
+	{0xC029009D, "STATUS_TPM_20_E_POLICY_FAIL"},
+	{0xC029009F, "STATUS_TPM_20_E_INTEGRITY"},
+	{0xC02900A0, "STATUS_TPM_20_E_TICKET"},
+	{0xC02900A1, "STATUS_TPM_20_E_RESERVED_BITS"},
+	{0xC02900A2, "STATUS_TPM_20_E_BAD_AUTH"},
+	{0xC02900A3, "STATUS_TPM_20_E_EXPIRED"},
+	{0xC02900A4, "STATUS_TPM_20_E_POLICY_CC"},
+	{0xC02900A5, "STATUS_TPM_20_E_BINDING"},
+	{0xC02900A6, "STATUS_TPM_20_E_CURVE"},
+	{0xC02900A7, "STATUS_TPM_20_E_ECC_POINT"},
+	{0xC0290100, "STATUS_TPM_20_E_INITIALIZE"},
+	{0xC0290101, "STATUS_TPM_20_E_FAILURE"},
+	{0xC0290103, "STATUS_TPM_20_E_SEQUENCE"},
+	{0xC029010B, "STATUS_TPM_20_E_PRIVATE"},
+	{0xC0290119, "STATUS_TPM_20_E_HMAC"},
+	{0xC0290120, "STATUS_TPM_20_E_DISABLED"},
+	{0xC0290121, "STATUS_TPM_20_E_EXCLUSIVE"},
+	{0xC0290123, "STATUS_TPM_20_E_ECC_CURVE"},
+	{0xC0290124, "STATUS_TPM_20_E_AUTH_TYPE"},
+	{0xC0290125, "STATUS_TPM_20_E_AUTH_MISSING"},
+	{0xC0290126, "STATUS_TPM_20_E_POLICY"},
+	{0xC0290127, "STATUS_TPM_20_E_PCR"},
+	{0xC0290128, "STATUS_TPM_20_E_PCR_CHANGED"},
+	{0xC029012D, "STATUS_TPM_20_E_UPGRADE"},
+	{0xC029012E, "STATUS_TPM_20_E_TOO_MANY_CONTEXTS"},
+	{0xC029012F, "STATUS_TPM_20_E_AUTH_UNAVAILABLE"},
+	{0xC0290130, "STATUS_TPM_20_E_REBOOT"},
+	{0xC0290131, "STATUS_TPM_20_E_UNBALANCED"},
+	{0xC0290142, "STATUS_TPM_20_E_COMMAND_SIZE"},
+	{0xC0290143, "STATUS_TPM_20_E_COMMAND_CODE"},
+	{0xC0290144, "STATUS_TPM_20_E_AUTHSIZE"},
+	{0xC0290145, "STATUS_TPM_20_E_AUTH_CONTEXT"},
+	{0xC0290146, "STATUS_TPM_20_E_NV_RANGE"},
+	{0xC0290147, "STATUS_TPM_20_E_NV_SIZE"},
+	{0xC0290148, "STATUS_TPM_20_E_NV_LOCKED"},
+	{0xC0290149, "STATUS_TPM_20_E_NV_AUTHORIZATION"},
+	{0xC029014A, "STATUS_TPM_20_E_NV_UNINITIALIZED"},
+	{0xC029014B, "STATUS_TPM_20_E_NV_SPACE"},
+	{0xC029014C, "STATUS_TPM_20_E_NV_DEFINED"},
+	{0xC0290150, "STATUS_TPM_20_E_BAD_CONTEXT"},
+	{0xC0290151, "STATUS_TPM_20_E_CPHASH"},
+	{0xC0290152, "STATUS_TPM_20_E_PARENT"},
+	{0xC0290153, "STATUS_TPM_20_E_NEEDS_TEST"},
+	{0xC0290154, "STATUS_TPM_20_E_NO_RESULT"},
+	{0xC0290155, "STATUS_TPM_20_E_SENSITIVE"},
+	{0xC0290400, "STATUS_TPM_COMMAND_BLOCKED"},
+	{0xC0290401, "STATUS_TPM_INVALID_HANDLE"},
+	{0xC0290402, "STATUS_TPM_DUPLICATE_VHANDLE"},
+	{0xC0290403, "STATUS_TPM_EMBEDDED_COMMAND_BLOCKED"},
+	{0xC0290404, "STATUS_TPM_EMBEDDED_COMMAND_UNSUPPORTED"},
+	{0xC0290800, "STATUS_TPM_RETRY"},
+	{0xC0290801, "STATUS_TPM_NEEDS_SELFTEST"},
+	{0xC0290802, "STATUS_TPM_DOING_SELFTEST"},
+	{0xC0290803, "STATUS_TPM_DEFEND_LOCK_RUNNING"},
+	{0xC0291001, "STATUS_TPM_COMMAND_CANCELED"},
+	{0xC0291002, "STATUS_TPM_TOO_MANY_CONTEXTS"},
+	{0xC0291003, "STATUS_TPM_NOT_FOUND"},
+	{0xC0291004, "STATUS_TPM_ACCESS_DENIED"},
+	{0xC0291005, "STATUS_TPM_INSUFFICIENT_BUFFER"},
+	{0xC0291006, "STATUS_TPM_PPI_FUNCTION_UNSUPPORTED"},
+	{0xC0292000, "STATUS_PCP_ERROR_MASK"},
+	{0xC0292001, "STATUS_PCP_DEVICE_NOT_READY"},
+	{0xC0292002, "STATUS_PCP_INVALID_HANDLE"},
+	{0xC0292003, "STATUS_PCP_INVALID_PARAMETER"},
+	{0xC0292004, "STATUS_PCP_FLAG_NOT_SUPPORTED"},
+	{0xC0292005, "STATUS_PCP_NOT_SUPPORTED"},
+	{0xC0292006, "STATUS_PCP_BUFFER_TOO_SMALL"},
+	{0xC0292007, "STATUS_PCP_INTERNAL_ERROR"},
+	{0xC0292008, "STATUS_PCP_AUTHENTICATION_FAILED"},
+	{0xC0292009, "STATUS_PCP_AUTHENTICATION_IGNORED"},
+	{0xC029200A, "STATUS_PCP_POLICY_NOT_FOUND"},
+	{0xC029200B, "STATUS_PCP_PROFILE_NOT_FOUND"},
+	{0xC029200C, "STATUS_PCP_VALIDATION_FAILED"},
+	{0xC029200D, "STATUS_PCP_DEVICE_NOT_FOUND"},
+	{0xC029200E, "STATUS_PCP_WRONG_PARENT"},
+	{0xC029200F, "STATUS_PCP_KEY_NOT_LOADED"},
+	{0xC0292010, "STATUS_PCP_NO_KEY_CERTIFICATION"},
+	{0xC0292011, "STATUS_PCP_KEY_NOT_FINALIZED"},
+	{0xC0292012, "STATUS_PCP_ATTESTATION_CHALLENGE_NOT_SET"},
+	{0xC0292013, "STATUS_PCP_NOT_PCR_BOUND"},
+	{0xC0292014, "STATUS_PCP_KEY_ALREADY_FINALIZED"},
+	{0xC0292015, "STATUS_PCP_KEY_USAGE_POLICY_NOT_SUPPORTED"},
+	{0xC0292016, "STATUS_PCP_KEY_USAGE_POLICY_INVALID"},
+	{0xC0292017, "STATUS_PCP_SOFT_KEY_ERROR"},
+	{0xC0292018, "STATUS_PCP_KEY_NOT_AUTHENTICATED"},
+	{0xC0292019, "STATUS_PCP_KEY_NOT_AIK"},
+	{0xC029201A, "STATUS_PCP_KEY_NOT_SIGNING_KEY"},
+	{0xC029201B, "STATUS_PCP_LOCKED_OUT"},
+	{0xC029201C, "STATUS_PCP_CLAIM_TYPE_NOT_SUPPORTED"},
+	{0xC029201D, "STATUS_PCP_TPM_VERSION_NOT_SUPPORTED"},
+	{0xC029201E, "STATUS_PCP_BUFFER_LENGTH_MISMATCH"},
+	{0xC029201F, "STATUS_PCP_IFX_RSA_KEY_CREATION_BLOCKED"},
+	{0xC0292020, "STATUS_PCP_TICKET_MISSING"},
+	{0xC0292021, "STATUS_PCP_RAW_POLICY_NOT_SUPPORTED"},
+	{0xC0292022, "STATUS_PCP_KEY_HANDLE_INVALIDATED"},
+	{0xC0293002, "STATUS_RTPM_NO_RESULT"},
+	{0xC0293003, "STATUS_RTPM_PCR_READ_INCOMPLETE"},
+	{0xC0293004, "STATUS_RTPM_INVALID_CONTEXT"},
+	{0xC0293005, "STATUS_RTPM_UNSUPPORTED_CMD"},
+	{0xC0294000, "STATUS_TPM_ZERO_EXHAUST_ENABLED"},
+	{0xC0350002, "STATUS_HV_INVALID_HYPERCALL_CODE"},
+	{0xC0350003, "STATUS_HV_INVALID_HYPERCALL_INPUT"},
+	{0xC0350004, "STATUS_HV_INVALID_ALIGNMENT"},
+	{0xC0350005, "STATUS_HV_INVALID_PARAMETER"},
+	{0xC0350006, "STATUS_HV_ACCESS_DENIED"},
+	{0xC0350007, "STATUS_HV_INVALID_PARTITION_STATE"},
+	{0xC0350008, "STATUS_HV_OPERATION_DENIED"},
+	{0xC0350009, "STATUS_HV_UNKNOWN_PROPERTY"},
+	{0xC035000A, "STATUS_HV_PROPERTY_VALUE_OUT_OF_RANGE"},
+	{0xC035000B, "STATUS_HV_INSUFFICIENT_MEMORY"},
+	{0xC035000C, "STATUS_HV_PARTITION_TOO_DEEP"},
+	{0xC035000D, "STATUS_HV_INVALID_PARTITION_ID"},
+	{0xC035000E, "STATUS_HV_INVALID_VP_INDEX"},
+	{0xC0350011, "STATUS_HV_INVALID_PORT_ID"},
+	{0xC0350012, "STATUS_HV_INVALID_CONNECTION_ID"},
+	{0xC0350013, "STATUS_HV_INSUFFICIENT_BUFFERS"},
+	{0xC0350014, "STATUS_HV_NOT_ACKNOWLEDGED"},
+	{0xC0350015, "STATUS_HV_INVALID_VP_STATE"},
+	{0xC0350016, "STATUS_HV_ACKNOWLEDGED"},
+	{0xC0350017, "STATUS_HV_INVALID_SAVE_RESTORE_STATE"},
+	{0xC0350018, "STATUS_HV_INVALID_SYNIC_STATE"},
+	{0xC0350019, "STATUS_HV_OBJECT_IN_USE"},
+	{0xC035001A, "STATUS_HV_INVALID_PROXIMITY_DOMAIN_INFO"},
+	{0xC035001B, "STATUS_HV_NO_DATA"},
+	{0xC035001C, "STATUS_HV_INACTIVE"},
+	{0xC035001D, "STATUS_HV_NO_RESOURCES"},
+	{0xC035001E, "STATUS_HV_FEATURE_UNAVAILABLE"},
+	{0xC0350033, "STATUS_HV_INSUFFICIENT_BUFFER"},
+	{0xC0350038, "STATUS_HV_INSUFFICIENT_DEVICE_DOMAINS"},
+	{0xC035003C, "STATUS_HV_CPUID_FEATURE_VALIDATION_ERROR"},
+	{0xC035003D, "STATUS_HV_CPUID_XSAVE_FEATURE_VALIDATION_ERROR"},
+	{0xC035003E, "STATUS_HV_PROCESSOR_STARTUP_TIMEOUT"},
+	{0xC035003F, "STATUS_HV_SMX_ENABLED"},
+	{0xC0350041, "STATUS_HV_INVALID_LP_INDEX"},
+	{0xC0350050, "STATUS_HV_INVALID_REGISTER_VALUE"},
+	{0xC0350051, "STATUS_HV_INVALID_VTL_STATE"},
+	{0xC0350055, "STATUS_HV_NX_NOT_DETECTED"},
+	{0xC0350057, "STATUS_HV_INVALID_DEVICE_ID"},
+	{0xC0350058, "STATUS_HV_INVALID_DEVICE_STATE"},
+	{0xC0350060, "STATUS_HV_PAGE_REQUEST_INVALID"},
+	{0xC035006F, "STATUS_HV_INVALID_CPU_GROUP_ID"},
+	{0xC0350070, "STATUS_HV_INVALID_CPU_GROUP_STATE"},
+	{0xC0350071, "STATUS_HV_OPERATION_FAILED"},
+	{0xC0350072, "STATUS_HV_NOT_ALLOWED_WITH_NESTED_VIRT_ACTIVE"},
+	{0xC0350073, "STATUS_HV_INSUFFICIENT_ROOT_MEMORY"},
+	{0xC0351000, "STATUS_HV_NOT_PRESENT"},
+	{0xC0360001, "STATUS_IPSEC_BAD_SPI"},
+	{0xC0360002, "STATUS_IPSEC_SA_LIFETIME_EXPIRED"},
+	{0xC0360003, "STATUS_IPSEC_WRONG_SA"},
+	{0xC0360004, "STATUS_IPSEC_REPLAY_CHECK_FAILED"},
+	{0xC0360005, "STATUS_IPSEC_INVALID_PACKET"},
+	{0xC0360006, "STATUS_IPSEC_INTEGRITY_CHECK_FAILED"},
+	{0xC0360007, "STATUS_IPSEC_CLEAR_TEXT_DROP"},
+	{0xC0360008, "STATUS_IPSEC_AUTH_FIREWALL_DROP"},
+	{0xC0360009, "STATUS_IPSEC_THROTTLE_DROP"},
+	{0xC0368000, "STATUS_IPSEC_DOSP_BLOCK"},
+	{0xC0368001, "STATUS_IPSEC_DOSP_RECEIVED_MULTICAST"},
+	{0xC0368002, "STATUS_IPSEC_DOSP_INVALID_PACKET"},
+	{0xC0368003, "STATUS_IPSEC_DOSP_STATE_LOOKUP_FAILED"},
+	{0xC0368004, "STATUS_IPSEC_DOSP_MAX_ENTRIES"},
+	{0xC0368005, "STATUS_IPSEC_DOSP_KEYMOD_NOT_ALLOWED"},
+	{0xC0368006, "STATUS_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES"},
+	{0xC0370001, "STATUS_VID_DUPLICATE_HANDLER"},
+	{0xC0370002, "STATUS_VID_TOO_MANY_HANDLERS"},
+	{0xC0370003, "STATUS_VID_QUEUE_FULL"},
+	{0xC0370004, "STATUS_VID_HANDLER_NOT_PRESENT"},
+	{0xC0370005, "STATUS_VID_INVALID_OBJECT_NAME"},
+	{0xC0370006, "STATUS_VID_PARTITION_NAME_TOO_LONG"},
+	{0xC0370007, "STATUS_VID_MESSAGE_QUEUE_NAME_TOO_LONG"},
+	{0xC0370008, "STATUS_VID_PARTITION_ALREADY_EXISTS"},
+	{0xC0370009, "STATUS_VID_PARTITION_DOES_NOT_EXIST"},
+	{0xC037000A, "STATUS_VID_PARTITION_NAME_NOT_FOUND"},
+	{0xC037000B, "STATUS_VID_MESSAGE_QUEUE_ALREADY_EXISTS"},
+	{0xC037000C, "STATUS_VID_EXCEEDED_MBP_ENTRY_MAP_LIMIT"},
+	{0xC037000D, "STATUS_VID_MB_STILL_REFERENCED"},
+	{0xC037000E, "STATUS_VID_CHILD_GPA_PAGE_SET_CORRUPTED"},
+	{0xC037000F, "STATUS_VID_INVALID_NUMA_SETTINGS"},
+	{0xC0370010, "STATUS_VID_INVALID_NUMA_NODE_INDEX"},
+	{0xC0370011, "STATUS_VID_NOTIFICATION_QUEUE_ALREADY_ASSOCIATED"},
+	{0xC0370012, "STATUS_VID_INVALID_MEMORY_BLOCK_HANDLE"},
+	{0xC0370013, "STATUS_VID_PAGE_RANGE_OVERFLOW"},
+	{0xC0370014, "STATUS_VID_INVALID_MESSAGE_QUEUE_HANDLE"},
+	{0xC0370015, "STATUS_VID_INVALID_GPA_RANGE_HANDLE"},
+	{0xC0370016, "STATUS_VID_NO_MEMORY_BLOCK_NOTIFICATION_QUEUE"},
+	{0xC0370017, "STATUS_VID_MEMORY_BLOCK_LOCK_COUNT_EXCEEDED"},
+	{0xC0370018, "STATUS_VID_INVALID_PPM_HANDLE"},
+	{0xC0370019, "STATUS_VID_MBPS_ARE_LOCKED"},
+	{0xC037001A, "STATUS_VID_MESSAGE_QUEUE_CLOSED"},
+	{0xC037001B, "STATUS_VID_VIRTUAL_PROCESSOR_LIMIT_EXCEEDED"},
+	{0xC037001C, "STATUS_VID_STOP_PENDING"},
+	{0xC037001D, "STATUS_VID_INVALID_PROCESSOR_STATE"},
+	{0xC037001E, "STATUS_VID_EXCEEDED_KM_CONTEXT_COUNT_LIMIT"},
+	{0xC037001F, "STATUS_VID_KM_INTERFACE_ALREADY_INITIALIZED"},
+	{0xC0370020, "STATUS_VID_MB_PROPERTY_ALREADY_SET_RESET"},
+	{0xC0370021, "STATUS_VID_MMIO_RANGE_DESTROYED"},
+	{0xC0370022, "STATUS_VID_INVALID_CHILD_GPA_PAGE_SET"},
+	{0xC0370023, "STATUS_VID_RESERVE_PAGE_SET_IS_BEING_USED"},
+	{0xC0370024, "STATUS_VID_RESERVE_PAGE_SET_TOO_SMALL"},
+	{0xC0370025, "STATUS_VID_MBP_ALREADY_LOCKED_USING_RESERVED_PAGE"},
+	{0xC0370026, "STATUS_VID_MBP_COUNT_EXCEEDED_LIMIT"},
+	{0xC0370027, "STATUS_VID_SAVED_STATE_CORRUPT"},
+	{0xC0370028, "STATUS_VID_SAVED_STATE_UNRECOGNIZED_ITEM"},
+	{0xC0370029, "STATUS_VID_SAVED_STATE_INCOMPATIBLE"},
+	{0xC037002A, "STATUS_VID_VTL_ACCESS_DENIED"},
+	{0xC0380001, "STATUS_VOLMGR_DATABASE_FULL"},
+	{0xC0380002, "STATUS_VOLMGR_DISK_CONFIGURATION_CORRUPTED"},
+	{0xC0380003, "STATUS_VOLMGR_DISK_CONFIGURATION_NOT_IN_SYNC"},
+	{0xC0380004, "STATUS_VOLMGR_PACK_CONFIG_UPDATE_FAILED"},
+	{0xC0380005, "STATUS_VOLMGR_DISK_CONTAINS_NON_SIMPLE_VOLUME"},
+	{0xC0380006, "STATUS_VOLMGR_DISK_DUPLICATE"},
+	{0xC0380007, "STATUS_VOLMGR_DISK_DYNAMIC"},
+	{0xC0380008, "STATUS_VOLMGR_DISK_ID_INVALID"},
+	{0xC0380009, "STATUS_VOLMGR_DISK_INVALID"},
+	{0xC038000A, "STATUS_VOLMGR_DISK_LAST_VOTER"},
+	{0xC038000B, "STATUS_VOLMGR_DISK_LAYOUT_INVALID"},
+	{0xC038000C, "STATUS_VOLMGR_DISK_LAYOUT_NON_BASIC_BETWEEN_BASIC_PARTITIONS"},
+	{0xC038000D, "STATUS_VOLMGR_DISK_LAYOUT_NOT_CYLINDER_ALIGNED"},
+	{0xC038000E, "STATUS_VOLMGR_DISK_LAYOUT_PARTITIONS_TOO_SMALL"},
+	{0xC038000F, "STATUS_VOLMGR_DISK_LAYOUT_PRIMARY_BETWEEN_LOGICAL_PARTITIONS"},
+	{0xC0380010, "STATUS_VOLMGR_DISK_LAYOUT_TOO_MANY_PARTITIONS"},
+	{0xC0380011, "STATUS_VOLMGR_DISK_MISSING"},
+	{0xC0380012, "STATUS_VOLMGR_DISK_NOT_EMPTY"},
+	{0xC0380013, "STATUS_VOLMGR_DISK_NOT_ENOUGH_SPACE"},
+	{0xC0380014, "STATUS_VOLMGR_DISK_REVECTORING_FAILED"},
+	{0xC0380015, "STATUS_VOLMGR_DISK_SECTOR_SIZE_INVALID"},
+	{0xC0380016, "STATUS_VOLMGR_DISK_SET_NOT_CONTAINED"},
+	{0xC0380017, "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_MEMBERS"},
+	{0xC0380018, "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_PLEXES"},
+	{0xC0380019, "STATUS_VOLMGR_DYNAMIC_DISK_NOT_SUPPORTED"},
+	{0xC038001A, "STATUS_VOLMGR_EXTENT_ALREADY_USED"},
+	{0xC038001B, "STATUS_VOLMGR_EXTENT_NOT_CONTIGUOUS"},
+	{0xC038001C, "STATUS_VOLMGR_EXTENT_NOT_IN_PUBLIC_REGION"},
+	{0xC038001D, "STATUS_VOLMGR_EXTENT_NOT_SECTOR_ALIGNED"},
+	{0xC038001E, "STATUS_VOLMGR_EXTENT_OVERLAPS_EBR_PARTITION"},
+	{0xC038001F, "STATUS_VOLMGR_EXTENT_VOLUME_LENGTHS_DO_NOT_MATCH"},
+	{0xC0380020, "STATUS_VOLMGR_FAULT_TOLERANT_NOT_SUPPORTED"},
+	{0xC0380021, "STATUS_VOLMGR_INTERLEAVE_LENGTH_INVALID"},
+	{0xC0380022, "STATUS_VOLMGR_MAXIMUM_REGISTERED_USERS"},
+	{0xC0380023, "STATUS_VOLMGR_MEMBER_IN_SYNC"},
+	{0xC0380024, "STATUS_VOLMGR_MEMBER_INDEX_DUPLICATE"},
+	{0xC0380025, "STATUS_VOLMGR_MEMBER_INDEX_INVALID"},
+	{0xC0380026, "STATUS_VOLMGR_MEMBER_MISSING"},
+	{0xC0380027, "STATUS_VOLMGR_MEMBER_NOT_DETACHED"},
+	{0xC0380028, "STATUS_VOLMGR_MEMBER_REGENERATING"},
+	{0xC0380029, "STATUS_VOLMGR_ALL_DISKS_FAILED"},
+	{0xC038002A, "STATUS_VOLMGR_NO_REGISTERED_USERS"},
+	{0xC038002B, "STATUS_VOLMGR_NO_SUCH_USER"},
+	{0xC038002C, "STATUS_VOLMGR_NOTIFICATION_RESET"},
+	{0xC038002D, "STATUS_VOLMGR_NUMBER_OF_MEMBERS_INVALID"},
+	{0xC038002E, "STATUS_VOLMGR_NUMBER_OF_PLEXES_INVALID"},
+	{0xC038002F, "STATUS_VOLMGR_PACK_DUPLICATE"},
+	{0xC0380030, "STATUS_VOLMGR_PACK_ID_INVALID"},
+	{0xC0380031, "STATUS_VOLMGR_PACK_INVALID"},
+	{0xC0380032, "STATUS_VOLMGR_PACK_NAME_INVALID"},
+	{0xC0380033, "STATUS_VOLMGR_PACK_OFFLINE"},
+	{0xC0380034, "STATUS_VOLMGR_PACK_HAS_QUORUM"},
+	{0xC0380035, "STATUS_VOLMGR_PACK_WITHOUT_QUORUM"},
+	{0xC0380036, "STATUS_VOLMGR_PARTITION_STYLE_INVALID"},
+	{0xC0380037, "STATUS_VOLMGR_PARTITION_UPDATE_FAILED"},
+	{0xC0380038, "STATUS_VOLMGR_PLEX_IN_SYNC"},
+	{0xC0380039, "STATUS_VOLMGR_PLEX_INDEX_DUPLICATE"},
+	{0xC038003A, "STATUS_VOLMGR_PLEX_INDEX_INVALID"},
+	{0xC038003B, "STATUS_VOLMGR_PLEX_LAST_ACTIVE"},
+	{0xC038003C, "STATUS_VOLMGR_PLEX_MISSING"},
+	{0xC038003D, "STATUS_VOLMGR_PLEX_REGENERATING"},
+	{0xC038003E, "STATUS_VOLMGR_PLEX_TYPE_INVALID"},
+	{0xC038003F, "STATUS_VOLMGR_PLEX_NOT_RAID5"},
+	{0xC0380040, "STATUS_VOLMGR_PLEX_NOT_SIMPLE"},
+	{0xC0380041, "STATUS_VOLMGR_STRUCTURE_SIZE_INVALID"},
+	{0xC0380042, "STATUS_VOLMGR_TOO_MANY_NOTIFICATION_REQUESTS"},
+	{0xC0380043, "STATUS_VOLMGR_TRANSACTION_IN_PROGRESS"},
+	{0xC0380044, "STATUS_VOLMGR_UNEXPECTED_DISK_LAYOUT_CHANGE"},
+	{0xC0380045, "STATUS_VOLMGR_VOLUME_CONTAINS_MISSING_DISK"},
+	{0xC0380046, "STATUS_VOLMGR_VOLUME_ID_INVALID"},
+	{0xC0380047, "STATUS_VOLMGR_VOLUME_LENGTH_INVALID"},
+	{0xC0380048, "STATUS_VOLMGR_VOLUME_LENGTH_NOT_SECTOR_SIZE_MULTIPLE"},
+	{0xC0380049, "STATUS_VOLMGR_VOLUME_NOT_MIRRORED"},
+	{0xC038004A, "STATUS_VOLMGR_VOLUME_NOT_RETAINED"},
+	{0xC038004B, "STATUS_VOLMGR_VOLUME_OFFLINE"},
+	{0xC038004C, "STATUS_VOLMGR_VOLUME_RETAINED"},
+	{0xC038004D, "STATUS_VOLMGR_NUMBER_OF_EXTENTS_INVALID"},
+	{0xC038004E, "STATUS_VOLMGR_DIFFERENT_SECTOR_SIZE"},
+	{0xC038004F, "STATUS_VOLMGR_BAD_BOOT_DISK"},
+	{0xC0380050, "STATUS_VOLMGR_PACK_CONFIG_OFFLINE"},
+	{0xC0380051, "STATUS_VOLMGR_PACK_CONFIG_ONLINE"},
+	{0xC0380052, "STATUS_VOLMGR_NOT_PRIMARY_PACK"},
+	{0xC0380053, "STATUS_VOLMGR_PACK_LOG_UPDATE_FAILED"},
+	{0xC0380054, "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_PLEX_INVALID"},
+	{0xC0380055, "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_MEMBER_INVALID"},
+	{0xC0380056, "STATUS_VOLMGR_VOLUME_MIRRORED"},
+	{0xC0380057, "STATUS_VOLMGR_PLEX_NOT_SIMPLE_SPANNED"},
+	{0xC0380058, "STATUS_VOLMGR_NO_VALID_LOG_COPIES"},
+	{0xC0380059, "STATUS_VOLMGR_PRIMARY_PACK_PRESENT"},
+	{0xC038005A, "STATUS_VOLMGR_NUMBER_OF_DISKS_INVALID"},
+	{0xC038005B, "STATUS_VOLMGR_MIRROR_NOT_SUPPORTED"},
+	{0xC038005C, "STATUS_VOLMGR_RAID5_NOT_SUPPORTED"},
+	{0xC0390002, "STATUS_BCD_TOO_MANY_ELEMENTS"},
+	{0xC03A0001, "STATUS_VHD_DRIVE_FOOTER_MISSING"},
+	{0xC03A0002, "STATUS_VHD_DRIVE_FOOTER_CHECKSUM_MISMATCH"},
+	{0xC03A0003, "STATUS_VHD_DRIVE_FOOTER_CORRUPT"},
+	{0xC03A0004, "STATUS_VHD_FORMAT_UNKNOWN"},
+	{0xC03A0005, "STATUS_VHD_FORMAT_UNSUPPORTED_VERSION"},
+	{0xC03A0006, "STATUS_VHD_SPARSE_HEADER_CHECKSUM_MISMATCH"},
+	{0xC03A0007, "STATUS_VHD_SPARSE_HEADER_UNSUPPORTED_VERSION"},
+	{0xC03A0008, "STATUS_VHD_SPARSE_HEADER_CORRUPT"},
+	{0xC03A0009, "STATUS_VHD_BLOCK_ALLOCATION_FAILURE"},
+	{0xC03A000A, "STATUS_VHD_BLOCK_ALLOCATION_TABLE_CORRUPT"},
+	{0xC03A000B, "STATUS_VHD_INVALID_BLOCK_SIZE"},
+	{0xC03A000C, "STATUS_VHD_BITMAP_MISMATCH"},
+	{0xC03A000D, "STATUS_VHD_PARENT_VHD_NOT_FOUND"},
+	{0xC03A000E, "STATUS_VHD_CHILD_PARENT_ID_MISMATCH"},
+	{0xC03A000F, "STATUS_VHD_CHILD_PARENT_TIMESTAMP_MISMATCH"},
+	{0xC03A0010, "STATUS_VHD_METADATA_READ_FAILURE"},
+	{0xC03A0011, "STATUS_VHD_METADATA_WRITE_FAILURE"},
+	{0xC03A0012, "STATUS_VHD_INVALID_SIZE"},
+	{0xC03A0013, "STATUS_VHD_INVALID_FILE_SIZE"},
+	{0xC03A0014, "STATUS_VIRTDISK_PROVIDER_NOT_FOUND"},
+	{0xC03A0015, "STATUS_VIRTDISK_NOT_VIRTUAL_DISK"},
+	{0xC03A0016, "STATUS_VHD_PARENT_VHD_ACCESS_DENIED"},
+	{0xC03A0017, "STATUS_VHD_CHILD_PARENT_SIZE_MISMATCH"},
+	{0xC03A0018, "STATUS_VHD_DIFFERENCING_CHAIN_CYCLE_DETECTED"},
+	{0xC03A0019, "STATUS_VHD_DIFFERENCING_CHAIN_ERROR_IN_PARENT"},
+	{0xC03A001A, "STATUS_VIRTUAL_DISK_LIMITATION"},
+	{0xC03A001B, "STATUS_VHD_INVALID_TYPE"},
+	{0xC03A001C, "STATUS_VHD_INVALID_STATE"},
+	{0xC03A001D, "STATUS_VIRTDISK_UNSUPPORTED_DISK_SECTOR_SIZE"},
+	{0xC03A001E, "STATUS_VIRTDISK_DISK_ALREADY_OWNED"},
+	{0xC03A001F, "STATUS_VIRTDISK_DISK_ONLINE_AND_WRITABLE"},
+	{0xC03A0020, "STATUS_CTLOG_TRACKING_NOT_INITIALIZED"},
+	{0xC03A0021, "STATUS_CTLOG_LOGFILE_SIZE_EXCEEDED_MAXSIZE"},
+	{0xC03A0022, "STATUS_CTLOG_VHD_CHANGED_OFFLINE"},
+	{0xC03A0023, "STATUS_CTLOG_INVALID_TRACKING_STATE"},
+	{0xC03A0024, "STATUS_CTLOG_INCONSISTENT_TRACKING_FILE"},
+	{0xC03A0028, "STATUS_VHD_METADATA_FULL"},
+	{0xC03A0029, "STATUS_VHD_INVALID_CHANGE_TRACKING_ID"},
+	{0xC03A002A, "STATUS_VHD_CHANGE_TRACKING_DISABLED"},
+	{0xC03A0030, "STATUS_VHD_MISSING_CHANGE_TRACKING_INFORMATION"},
+	{0xC03A0031, "STATUS_VHD_RESIZE_WOULD_TRUNCATE_DATA"},
+	{0xC03A0032, "STATUS_VHD_COULD_NOT_COMPUTE_MINIMUM_VIRTUAL_SIZE"},
+	{0xC03A0033, "STATUS_VHD_ALREADY_AT_OR_BELOW_MINIMUM_VIRTUAL_SIZE"},
+	{0xC0400001, "STATUS_RKF_KEY_NOT_FOUND"},
+	{0xC0400002, "STATUS_RKF_DUPLICATE_KEY"},
+	{0xC0400003, "STATUS_RKF_BLOB_FULL"},
+	{0xC0400004, "STATUS_RKF_STORE_FULL"},
+	{0xC0400005, "STATUS_RKF_FILE_BLOCKED"},
+	{0xC0400006, "STATUS_RKF_ACTIVE_KEY"},
+	{0xC0410001, "STATUS_RDBSS_RESTART_OPERATION"},
+	{0xC0410002, "STATUS_RDBSS_CONTINUE_OPERATION"},
+	{0xC0410003, "STATUS_RDBSS_POST_OPERATION"},
+	{0xC0410004, "STATUS_RDBSS_RETRY_LOOKUP"},
+	{0xC0420001, "STATUS_BTH_ATT_INVALID_HANDLE"},
+	{0xC0420002, "STATUS_BTH_ATT_READ_NOT_PERMITTED"},
+	{0xC0420003, "STATUS_BTH_ATT_WRITE_NOT_PERMITTED"},
+	{0xC0420004, "STATUS_BTH_ATT_INVALID_PDU"},
+	{0xC0420005, "STATUS_BTH_ATT_INSUFFICIENT_AUTHENTICATION"},
+	{0xC0420006, "STATUS_BTH_ATT_REQUEST_NOT_SUPPORTED"},
+	{0xC0420007, "STATUS_BTH_ATT_INVALID_OFFSET"},
+	{0xC0420008, "STATUS_BTH_ATT_INSUFFICIENT_AUTHORIZATION"},
+	{0xC0420009, "STATUS_BTH_ATT_PREPARE_QUEUE_FULL"},
+	{0xC042000A, "STATUS_BTH_ATT_ATTRIBUTE_NOT_FOUND"},
+	{0xC042000B, "STATUS_BTH_ATT_ATTRIBUTE_NOT_LONG"},
+	{0xC042000C, "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE"},
+	{0xC042000D, "STATUS_BTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH"},
+	{0xC042000E, "STATUS_BTH_ATT_UNLIKELY"},
+	{0xC042000F, "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION"},
+	{0xC0420010, "STATUS_BTH_ATT_UNSUPPORTED_GROUP_TYPE"},
+	{0xC0420011, "STATUS_BTH_ATT_INSUFFICIENT_RESOURCES"},
+	{0xC0421000, "STATUS_BTH_ATT_UNKNOWN_ERROR"},
+	{0xC0430001, "STATUS_SECUREBOOT_ROLLBACK_DETECTED"},
+	{0xC0430002, "STATUS_SECUREBOOT_POLICY_VIOLATION"},
+	{0xC0430003, "STATUS_SECUREBOOT_INVALID_POLICY"},
+	{0xC0430004, "STATUS_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND"},
+	{0xC0430005, "STATUS_SECUREBOOT_POLICY_NOT_SIGNED"},
+	{0xC0430007, "STATUS_SECUREBOOT_FILE_REPLACED"},
+	{0xC0430008, "STATUS_SECUREBOOT_POLICY_NOT_AUTHORIZED"},
+	{0xC0430009, "STATUS_SECUREBOOT_POLICY_UNKNOWN"},
+	{0xC043000A, "STATUS_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION"},
+	{0xC043000B, "STATUS_SECUREBOOT_PLATFORM_ID_MISMATCH"},
+	{0xC043000C, "STATUS_SECUREBOOT_POLICY_ROLLBACK_DETECTED"},
+	{0xC043000D, "STATUS_SECUREBOOT_POLICY_UPGRADE_MISMATCH"},
+	{0xC043000E, "STATUS_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING"},
+	{0xC043000F, "STATUS_SECUREBOOT_NOT_BASE_POLICY"},
+	{0xC0430010, "STATUS_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY"},
+	{0xC0440001, "STATUS_AUDIO_ENGINE_NODE_NOT_FOUND"},
+	{0xC0440002, "STATUS_HDAUDIO_EMPTY_CONNECTION_LIST"},
+	{0xC0440003, "STATUS_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED"},
+	{0xC0440004, "STATUS_HDAUDIO_NO_LOGICAL_DEVICES_CREATED"},
+	{0xC0440005, "STATUS_HDAUDIO_NULL_LINKED_LIST_ENTRY"},
+	{0xC0450000, "STATUS_VSM_NOT_INITIALIZED"},
+	{0xC0450001, "STATUS_VSM_DMA_PROTECTION_NOT_IN_USE"},
+	{0xC0500003, "STATUS_VOLSNAP_BOOTFILE_NOT_VALID"},
+	{0xC0500004, "STATUS_VOLSNAP_ACTIVATION_TIMEOUT"},
+	{0xC0510001, "STATUS_IO_PREEMPTED"},
+	{0xC05C0000, "STATUS_SVHDX_ERROR_STORED"},
+	{0xC05CFF00, "STATUS_SVHDX_ERROR_NOT_AVAILABLE"},
+	{0xC05CFF01, "STATUS_SVHDX_UNIT_ATTENTION_AVAILABLE"},
+	{0xC05CFF02, "STATUS_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED"},
+	{0xC05CFF03, "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED"},
+	{0xC05CFF04, "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED"},
+	{0xC05CFF05, "STATUS_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED"},
+	{0xC05CFF06, "STATUS_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED"},
+	{0xC05CFF07, "STATUS_SVHDX_RESERVATION_CONFLICT"},
+	{0xC05CFF08, "STATUS_SVHDX_WRONG_FILE_TYPE"},
+	{0xC05CFF09, "STATUS_SVHDX_VERSION_MISMATCH"},
+	{0xC05CFF0A, "STATUS_VHD_SHARED"},
+	{0xC05CFF0B, "STATUS_SVHDX_NO_INITIATOR"},
+	{0xC05CFF0C, "STATUS_VHDSET_BACKING_STORAGE_NOT_FOUND"},
+	{0xC05D0000, "STATUS_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP"},
+	{0xC05D0001, "STATUS_SMB_BAD_CLUSTER_DIALECT"},
+	{0xC05D0002, "STATUS_SMB_GUEST_LOGON_BLOCKED"},
+	{0xC0E70001, "STATUS_SPACES_FAULT_DOMAIN_TYPE_INVALID"},
+	{0xC0E70003, "STATUS_SPACES_RESILIENCY_TYPE_INVALID"},
+	{0xC0E70004, "STATUS_SPACES_DRIVE_SECTOR_SIZE_INVALID"},
+	{0xC0E70006, "STATUS_SPACES_DRIVE_REDUNDANCY_INVALID"},
+	{0xC0E70007, "STATUS_SPACES_NUMBER_OF_DATA_COPIES_INVALID"},
+	{0xC0E70009, "STATUS_SPACES_INTERLEAVE_LENGTH_INVALID"},
+	{0xC0E7000A, "STATUS_SPACES_NUMBER_OF_COLUMNS_INVALID"},
+	{0xC0E7000B, "STATUS_SPACES_NOT_ENOUGH_DRIVES"},
+	{0xC0E7000C, "STATUS_SPACES_EXTENDED_ERROR"},
+	{0xC0E7000D, "STATUS_SPACES_PROVISIONING_TYPE_INVALID"},
+	{0xC0E7000E, "STATUS_SPACES_ALLOCATION_SIZE_INVALID"},
+	{0xC0E7000F, "STATUS_SPACES_ENCLOSURE_AWARE_INVALID"},
+	{0xC0E70010, "STATUS_SPACES_WRITE_CACHE_SIZE_INVALID"},
+	{0xC0E70011, "STATUS_SPACES_NUMBER_OF_GROUPS_INVALID"},
+	{0xC0E70012, "STATUS_SPACES_DRIVE_OPERATIONAL_STATE_INVALID"},
+	{0xC0E70013, "STATUS_SPACES_UPDATE_COLUMN_STATE"},
+	{0xC0E70014, "STATUS_SPACES_MAP_REQUIRED"},
+	{0xC0E70015, "STATUS_SPACES_UNSUPPORTED_VERSION"},
+	{0xC0E70016, "STATUS_SPACES_CORRUPT_METADATA"},
+	{0xC0E70017, "STATUS_SPACES_DRT_FULL"},
+	{0xC0E70018, "STATUS_SPACES_INCONSISTENCY"},
+	{0xC0E70019, "STATUS_SPACES_LOG_NOT_READY"},
+	{0xC0E7001A, "STATUS_SPACES_NO_REDUNDANCY"},
+	{0xC0E7001B, "STATUS_SPACES_DRIVE_NOT_READY"},
+	{0xC0E7001C, "STATUS_SPACES_DRIVE_SPLIT"},
+	{0xC0E7001D, "STATUS_SPACES_DRIVE_LOST_DATA"},
+	{0xC0E7001E, "STATUS_SPACES_ENTRY_INCOMPLETE"},
+	{0xC0E7001F, "STATUS_SPACES_ENTRY_INVALID"},
+	{0xC0E70020, "STATUS_SPACES_MARK_DIRTY"},
+	{0xC0E80000, "STATUS_SECCORE_INVALID_COMMAND"},
+	{0xC0E90001, "STATUS_SYSTEM_INTEGRITY_ROLLBACK_DETECTED"},
+	{0xC0E90002, "STATUS_SYSTEM_INTEGRITY_POLICY_VIOLATION"},
+	{0xC0E90003, "STATUS_SYSTEM_INTEGRITY_INVALID_POLICY"},
+	{0xC0E90004, "STATUS_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED"},
+	{0xC0E90005, "STATUS_SYSTEM_INTEGRITY_TOO_MANY_POLICIES"},
+	{0xC0E90006, "STATUS_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED"},
+	{0xC0EA0001, "STATUS_NO_APPLICABLE_APP_LICENSES_FOUND"},
+	{0xC0EA0002, "STATUS_CLIP_LICENSE_NOT_FOUND"},
+	{0xC0EA0003, "STATUS_CLIP_DEVICE_LICENSE_MISSING"},
+	{0xC0EA0004, "STATUS_CLIP_LICENSE_INVALID_SIGNATURE"},
+	{0xC0EA0005, "STATUS_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID"},
+	{0xC0EA0006, "STATUS_CLIP_LICENSE_EXPIRED"},
+	{0xC0EA0007, "STATUS_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE"},
+	{0xC0EA0008, "STATUS_CLIP_LICENSE_NOT_SIGNED"},
+	{0xC0EA0009, "STATUS_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE"},
+	{0xC0EA000A, "STATUS_CLIP_LICENSE_DEVICE_ID_MISMATCH"},
+	{0xC0EB0001, "STATUS_PLATFORM_MANIFEST_NOT_AUTHORIZED"},
+	{0xC0EB0002, "STATUS_PLATFORM_MANIFEST_INVALID"},
+	{0xC0EB0003, "STATUS_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED"},
+	{0xC0EB0004, "STATUS_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED"},
+	{0xC0EB0005, "STATUS_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND"},
+	{0xC0EB0006, "STATUS_PLATFORM_MANIFEST_NOT_ACTIVE"},
+	{0xC0EB0007, "STATUS_PLATFORM_MANIFEST_NOT_SIGNED"},
+	{0xC0EC0000, "STATUS_APPEXEC_CONDITION_NOT_SATISFIED"},
+	{0xC0EC0001, "STATUS_APPEXEC_HANDLE_INVALIDATED"},
+	{0xC0EC0002, "STATUS_APPEXEC_INVALID_HOST_GENERATION"},
+	{0xC0EC0003, "STATUS_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION"},
+	{0xC0EC0004, "STATUS_APPEXEC_INVALID_HOST_STATE"},
+	{0xC0EC0005, "STATUS_APPEXEC_NO_DONOR"},
+	{0xC0EC0006, "STATUS_APPEXEC_HOST_ID_MISMATCH"},
+	{0xC0EC0007, "STATUS_APPEXEC_UNKNOWN_USER"},
+}
+
+// win32Codes contains Win32 error codes from ntstatus.h, winerror.h,
+// winhttp.h, wininet.h, sorted by code
+var win32Codes = []errCode{
+	{0, "ERROR_SUCCESS"},
+	{0, "NO_ERROR"},
+	{0, "DS_S_SUCCESS"},
+	{0, "DNS_ERROR_RCODE_NO_ERROR"},
+	{0, "NOERROR"},
+	{0, "NTE_OP_OK"},
+	{1, "ERROR_INVALID_FUNCTION"},
+	{2, "ERROR_FILE_NOT_FOUND"},
+	{3, "ERROR_PATH_NOT_FOUND"},
+	{4, "ERROR_TOO_MANY_OPEN_FILES"},
+	{5, "ERROR_ACCESS_DENIED"},
+	{6, "ERROR_INVALID_HANDLE"},
+	{7, "ERROR_ARENA_TRASHED"},
+	{8, "ERROR_NOT_ENOUGH_MEMORY"},
+	{9, "ERROR_INVALID_BLOCK"},
+	{10, "ERROR_BAD_ENVIRONMENT"},
+	{11, "ERROR_BAD_FORMAT"},
+	{12, "ERROR_INVALID_ACCESS"},
+	{13, "ERROR_INVALID_DATA"},
+	{13, "DNS_ERROR_INVALID_DATA"},
+	{14, "ERROR_OUTOFMEMORY"},
+	{14, "DNS_ERROR_NO_MEMORY"},
+	{15, "ERROR_INVALID_DRIVE"},
+	{16, "ERROR_CURRENT_DIRECTORY"},
+	{17, "ERROR_NOT_SAME_DEVICE"},
+	{18, "ERROR_NO_MORE_FILES"},
+	{19, "ERROR_WRITE_PROTECT"},
+	{20, "ERROR_BAD_UNIT"},
+	{21, "ERROR_NOT_READY"},
+	{22, "ERROR_BAD_COMMAND"},
+	{23, "ERROR_CRC"},
+	{24, "ERROR_BAD_LENGTH"},
+	{25, "ERROR_SEEK"},
+	{26, "ERROR_NOT_DOS_DISK"},
+	{27, "ERROR_SECTOR_NOT_FOUND"},
+	{28, "ERROR_OUT_OF_PAPER"},
+	{29, "ERROR_WRITE_FAULT"},
+	{30, "ERROR_READ_FAULT"},
+	{31, "ERROR_GEN_FAILURE"},
+	{32, "ERROR_SHARING_VIOLATION"},
+	{33, "ERROR_LOCK_VIOLATION"},
+	{34, "ERROR_WRONG_DISK"},
+	{36, "ERROR_SHARING_BUFFER_EXCEEDED"},
+	{38, "ERROR_HANDLE_EOF"},
+	{39, "ERROR_HANDLE_DISK_FULL"},
+	{50, "ERROR_NOT_SUPPORTED"},
+	{51, "ERROR_REM_NOT_LIST"},
+	{52, "ERROR_DUP_NAME"},
+	{53, "ERROR_BAD_NETPATH"},
+	{54, "ERROR_NETWORK_BUSY"},
+	{55, "ERROR_DEV_NOT_EXIST"},
+	{56, "ERROR_TOO_MANY_CMDS"},
+	{57, "ERROR_ADAP_HDW_ERR"},
+	{58, "ERROR_BAD_NET_RESP"},
+	{59, "ERROR_UNEXP_NET_ERR"},
+	{60, "ERROR_BAD_REM_ADAP"},
+	{61, "ERROR_PRINTQ_FULL"},
+	{62, "ERROR_NO_SPOOL_SPACE"},
+	{63, "ERROR_PRINT_CANCELLED"},
+	{64, "ERROR_NETNAME_DELETED"},
+	{65, "ERROR_NETWORK_ACCESS_DENIED"},
+	{66, "ERROR_BAD_DEV_TYPE"},
+	{67, "ERROR_BAD_NET_NAME"},
+	{68, "ERROR_TOO_MANY_NAMES"},
+	{69, "ERROR_TOO_MANY_SESS"},
+	{70, "ERROR_SHARING_PAUSED"},
+	{71, "ERROR_REQ_NOT_ACCEP"},
+	{72, "ERROR_REDIR_PAUSED"},
+	{80, "ERROR_FILE_EXISTS"},
+	{82, "ERROR_CANNOT_MAKE"},
+	{83, "ERROR_FAIL_I24"},
+	{84, "ERROR_OUT_OF_STRUCTURES"},
+	{85, "ERROR_ALREADY_ASSIGNED"},
+	{86, "ERROR_INVALID_PASSWORD"},
+	{87, "ERROR_INVALID_PARAMETER"},
+	{88, "ERROR_NET_WRITE_FAULT"},
+	{89, "ERROR_NO_PROC_SLOTS"},
+	{100, "ERROR_TOO_MANY_SEMAPHORES"},
+	{101, "ERROR_EXCL_SEM_ALREADY_OWNED"},
+	{102, "ERROR_SEM_IS_SET"},
+	{103, "ERROR_TOO_MANY_SEM_REQUESTS"},
+	{104, "ERROR_INVALID_AT_INTERRUPT_TIME"},
+	{105, "ERROR_SEM_OWNER_DIED"},
+	{106, "ERROR_SEM_USER_LIMIT"},
+	{107, "ERROR_DISK_CHANGE"},
+	{108, "ERROR_DRIVE_LOCKED"},
+	{109, "ERROR_BROKEN_PIPE"},
+	{110, "ERROR_OPEN_FAILED"},
+	{111, "ERROR_BUFFER_OVERFLOW"},
+	{112, "ERROR_DISK_FULL"},
+	{113, "ERROR_NO_MORE_SEARCH_HANDLES"},
+	{114, "ERROR_INVALID_TARGET_HANDLE"},
+	{117, "ERROR_INVALID_CATEGORY"},
+	{118, "ERROR_INVALID_VERIFY_SWITCH"},
+	{119, "ERROR_BAD_DRIVER_LEVEL"},
+	{120, "ERROR_CALL_NOT_IMPLEMENTED"},
+	{121, "ERROR_SEM_TIMEOUT"},
+	{122, "ERROR_INSUFFICIENT_BUFFER"},
+	{123, "ERROR_INVALID_NAME"},
+	{123, "DNS_ERROR_INVALID_NAME"},
+	{124, "ERROR_INVALID_LEVEL"},
+	{125, "ERROR_NO_VOLUME_LABEL"},
+	{126, "ERROR_MOD_NOT_FOUND"},
+	{127, "ERROR_PROC_NOT_FOUND"},
+	{128, "ERROR_WAIT_NO_CHILDREN"},
+	{129, "ERROR_CHILD_NOT_COMPLETE"},
+	{130, "ERROR_DIRECT_ACCESS_HANDLE"},
+	{131, "ERROR_NEGATIVE_SEEK"},
+	{132, "ERROR_SEEK_ON_DEVICE"},
+	{133, "ERROR_IS_JOIN_TARGET"},
+	{134, "ERROR_IS_JOINED"},
+	{135, "ERROR_IS_SUBSTED"},
+	{136, "ERROR_NOT_JOINED"},
+	{137, "ERROR_NOT_SUBSTED"},
+	{138, "ERROR_JOIN_TO_JOIN"},
+	{139, "ERROR_SUBST_TO_SUBST"},
+	{140, "ERROR_JOIN_TO_SUBST"},
+	{141, "ERROR_SUBST_TO_JOIN"},
+	{142, "ERROR_BUSY_DRIVE"},
+	{143, "ERROR_SAME_DRIVE"},
+	{144, "ERROR_DIR_NOT_ROOT"},
+	{145, "ERROR_DIR_NOT_EMPTY"},
+	{146, "ERROR_IS_SUBST_PATH"},
+	{147, "ERROR_IS_JOIN_PATH"},
+	{148, "ERROR_PATH_BUSY"},
+	{149, "ERROR_IS_SUBST_TARGET"},
+	{150, "ERROR_SYSTEM_TRACE"},
+	{151, "ERROR_INVALID_EVENT_COUNT"},
+	{152, "ERROR_TOO_MANY_MUXWAITERS"},
+	{153, "ERROR_INVALID_LIST_FORMAT"},
+	{154, "ERROR_LABEL_TOO_LONG"},
+	{155, "ERROR_TOO_MANY_TCBS"},
+	{156, "ERROR_SIGNAL_REFUSED"},
+	{157, "ERROR_DISCARDED"},
+	{158, "ERROR_NOT_LOCKED"},
+	{159, "ERROR_BAD_THREADID_ADDR"},
+	{160, "ERROR_BAD_ARGUMENTS"},
+	{161, "ERROR_BAD_PATHNAME"},
+	{162, "ERROR_SIGNAL_PENDING"},
+	{164, "ERROR_MAX_THRDS_REACHED"},
+	{167, "ERROR_LOCK_FAILED"},
+	{170, "ERROR_BUSY"},
+	{171, "ERROR_DEVICE_SUPPORT_IN_PROGRESS"},
+	{173, "ERROR_CANCEL_VIOLATION"},
+	{174, "ERROR_ATOMIC_LOCKS_NOT_SUPPORTED"},
+	{180, "ERROR_INVALID_SEGMENT_NUMBER"},
+	{182, "ERROR_INVALID_ORDINAL"},
+	{183, "ERROR_ALREADY_EXISTS"},
+	{186, "ERROR_INVALID_FLAG_NUMBER"},
+	{187, "ERROR_SEM_NOT_FOUND"},
+	{188, "ERROR_INVALID_STARTING_CODESEG"},
+	{189, "ERROR_INVALID_STACKSEG"},
+	{190, "ERROR_INVALID_MODULETYPE"},
+	{191, "ERROR_INVALID_EXE_SIGNATURE"},
+	{192, "ERROR_EXE_MARKED_INVALID"},
+	{193, "ERROR_BAD_EXE_FORMAT"},
+	{195, "ERROR_INVALID_MINALLOCSIZE"},
+	{196, "ERROR_DYNLINK_FROM_INVALID_RING"},
+	{197, "ERROR_IOPL_NOT_ENABLED"},
+	{198, "ERROR_INVALID_SEGDPL"},
+	{200, "ERROR_RING2SEG_MUST_BE_MOVABLE"},
+	{201, "ERROR_RELOC_CHAIN_XEEDS_SEGLIM"},
+	{202, "ERROR_INFLOOP_IN_RELOC_CHAIN"},
+	{203, "ERROR_ENVVAR_NOT_FOUND"},
+	{205, "ERROR_NO_SIGNAL_SENT"},
+	{206, "ERROR_FILENAME_EXCED_RANGE"},
+	{207, "ERROR_RING2_STACK_IN_USE"},
+	{208, "ERROR_META_EXPANSION_TOO_LONG"},
+	{209, "ERROR_INVALID_SIGNAL_NUMBER"},
+	{210, "ERROR_THREAD_1_INACTIVE"},
+	{212, "ERROR_LOCKED"},
+	{214, "ERROR_TOO_MANY_MODULES"},
+	{215, "ERROR_NESTING_NOT_ALLOWED"},
+	{216, "ERROR_EXE_MACHINE_TYPE_MISMATCH"},
+	{217, "ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY"},
+	{218, "ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY"},
+	{220, "ERROR_FILE_CHECKED_OUT"},
+	{221, "ERROR_CHECKOUT_REQUIRED"},
+	{222, "ERROR_BAD_FILE_TYPE"},
+	{223, "ERROR_FILE_TOO_LARGE"},
+	{224, "ERROR_FORMS_AUTH_REQUIRED"},
+	{225, "ERROR_VIRUS_INFECTED"},
+	{226, "ERROR_VIRUS_DELETED"},
+	{229, "ERROR_PIPE_LOCAL"},
+	{230, "ERROR_BAD_PIPE"},
+	{231, "ERROR_PIPE_BUSY"},
+	{232, "ERROR_NO_DATA"},
+	{233, "ERROR_PIPE_NOT_CONNECTED"},
+	{234, "ERROR_MORE_DATA"},
+	{235, "ERROR_NO_WORK_DONE"},
+	{240, "ERROR_VC_DISCONNECTED"},
+	{254, "ERROR_INVALID_EA_NAME"},
+	{255, "ERROR_EA_LIST_INCONSISTENT"},
+	{258, "WAIT_TIMEOUT"},
+	{259, "ERROR_NO_MORE_ITEMS"},
+	{266, "ERROR_CANNOT_COPY"},
+	{267, "ERROR_DIRECTORY"},
+	{275, "ERROR_EAS_DIDNT_FIT"},
+	{276, "ERROR_EA_FILE_CORRUPT"},
+	{277, "ERROR_EA_TABLE_FULL"},
+	{278, "ERROR_INVALID_EA_HANDLE"},
+	{282, "ERROR_EAS_NOT_SUPPORTED"},
+	{288, "ERROR_NOT_OWNER"},
+	{298, "ERROR_TOO_MANY_POSTS"},
+	{299, "ERROR_PARTIAL_COPY"},
+	{300, "ERROR_OPLOCK_NOT_GRANTED"},
+	{301, "ERROR_INVALID_OPLOCK_PROTOCOL"},
+	{302, "ERROR_DISK_TOO_FRAGMENTED"},
+	{303, "ERROR_DELETE_PENDING"},
+	{304, "ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING"},
+	{305, "ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME"},
+	{306, "ERROR_SECURITY_STREAM_IS_INCONSISTENT"},
+	{307, "ERROR_INVALID_LOCK_RANGE"},
+	{308, "ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT"},
+	{309, "ERROR_NOTIFICATION_GUID_ALREADY_DEFINED"},
+	{310, "ERROR_INVALID_EXCEPTION_HANDLER"},
+	{311, "ERROR_DUPLICATE_PRIVILEGES"},
+	{312, "ERROR_NO_RANGES_PROCESSED"},
+	{313, "ERROR_NOT_ALLOWED_ON_SYSTEM_FILE"},
+	{314, "ERROR_DISK_RESOURCES_EXHAUSTED"},
+	{315, "ERROR_INVALID_TOKEN"},
+	{316, "ERROR_DEVICE_FEATURE_NOT_SUPPORTED"},
+	{317, "ERROR_MR_MID_NOT_FOUND"},
+	{318, "ERROR_SCOPE_NOT_FOUND"},
+	{319, "ERROR_UNDEFINED_SCOPE"},
+	{320, "ERROR_INVALID_CAP"},
+	{321, "ERROR_DEVICE_UNREACHABLE"},
+	{322, "ERROR_DEVICE_NO_RESOURCES"},
+	{323, "ERROR_DATA_CHECKSUM_ERROR"},
+	{324, "ERROR_INTERMIXED_KERNEL_EA_OPERATION"},
+	{326, "ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED"},
+	{327, "ERROR_OFFSET_ALIGNMENT_VIOLATION"},
+	{328, "ERROR_INVALID_FIELD_IN_PARAMETER_LIST"},
+	{329, "ERROR_OPERATION_IN_PROGRESS"},
+	{330, "ERROR_BAD_DEVICE_PATH"},
+	{331, "ERROR_TOO_MANY_DESCRIPTORS"},
+	{332, "ERROR_SCRUB_DATA_DISABLED"},
+	{333, "ERROR_NOT_REDUNDANT_STORAGE"},
+	{334, "ERROR_RESIDENT_FILE_NOT_SUPPORTED"},
+	{335, "ERROR_COMPRESSED_FILE_NOT_SUPPORTED"},
+	{336, "ERROR_DIRECTORY_NOT_SUPPORTED"},
+	{337, "ERROR_NOT_READ_FROM_COPY"},
+	{338, "ERROR_FT_WRITE_FAILURE"},
+	{339, "ERROR_FT_DI_SCAN_REQUIRED"},
+	{340, "ERROR_INVALID_KERNEL_INFO_VERSION"},
+	{341, "ERROR_INVALID_PEP_INFO_VERSION"},
+	{342, "ERROR_OBJECT_NOT_EXTERNALLY_BACKED"},
+	{343, "ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN"},
+	{344, "ERROR_COMPRESSION_NOT_BENEFICIAL"},
+	{345, "ERROR_STORAGE_TOPOLOGY_ID_MISMATCH"},
+	{346, "ERROR_BLOCKED_BY_PARENTAL_CONTROLS"},
+	{347, "ERROR_BLOCK_TOO_MANY_REFERENCES"},
+	{348, "ERROR_MARKED_TO_DISALLOW_WRITES"},
+	{349, "ERROR_ENCLAVE_FAILURE"},
+	{350, "ERROR_FAIL_NOACTION_REBOOT"},
+	{351, "ERROR_FAIL_SHUTDOWN"},
+	{352, "ERROR_FAIL_RESTART"},
+	{353, "ERROR_MAX_SESSIONS_REACHED"},
+	{354, "ERROR_NETWORK_ACCESS_DENIED_EDP"},
+	{355, "ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL"},
+	{356, "ERROR_EDP_POLICY_DENIES_OPERATION"},
+	{357, "ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED"},
+	{358, "ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT"},
+	{359, "ERROR_DEVICE_IN_MAINTENANCE"},
+	{360, "ERROR_NOT_SUPPORTED_ON_DAX"},
+	{361, "ERROR_DAX_MAPPING_EXISTS"},
+	{362, "ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING"},
+	{363, "ERROR_CLOUD_FILE_METADATA_CORRUPT"},
+	{364, "ERROR_CLOUD_FILE_METADATA_TOO_LARGE"},
+	{365, "ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE"},
+	{366, "ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH"},
+	{367, "ERROR_CHILD_PROCESS_BLOCKED"},
+	{368, "ERROR_STORAGE_LOST_DATA_PERSISTENCE"},
+	{369, "ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE"},
+	{370, "ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT"},
+	{371, "ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY"},
+	{372, "ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN"},
+	{373, "ERROR_GDI_HANDLE_LEAK"},
+	{374, "ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS"},
+	{375, "ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED"},
+	{376, "ERROR_NOT_A_CLOUD_FILE"},
+	{377, "ERROR_CLOUD_FILE_NOT_IN_SYNC"},
+	{378, "ERROR_CLOUD_FILE_ALREADY_CONNECTED"},
+	{379, "ERROR_CLOUD_FILE_NOT_SUPPORTED"},
+	{380, "ERROR_CLOUD_FILE_INVALID_REQUEST"},
+	{381, "ERROR_CLOUD_FILE_READ_ONLY_VOLUME"},
+	{382, "ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY"},
+	{383, "ERROR_CLOUD_FILE_VALIDATION_FAILED"},
+	{384, "ERROR_SMB1_NOT_AVAILABLE"},
+	{385, "ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION"},
+	{386, "ERROR_CLOUD_FILE_AUTHENTICATION_FAILED"},
+	{387, "ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES"},
+	{388, "ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE"},
+	{389, "ERROR_CLOUD_FILE_UNSUCCESSFUL"},
+	{390, "ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT"},
+	{391, "ERROR_CLOUD_FILE_IN_USE"},
+	{392, "ERROR_CLOUD_FILE_PINNED"},
+	{393, "ERROR_CLOUD_FILE_REQUEST_ABORTED"},
+	{394, "ERROR_CLOUD_FILE_PROPERTY_CORRUPT"},
+	{395, "ERROR_CLOUD_FILE_ACCESS_DENIED"},
+	{396, "ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS"},
+	{397, "ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT"},
+	{398, "ERROR_CLOUD_FILE_REQUEST_CANCELED"},
+	{399, "ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED"},
+	{400, "ERROR_THREAD_MODE_ALREADY_BACKGROUND"},
+	{401, "ERROR_THREAD_MODE_NOT_BACKGROUND"},
+	{402, "ERROR_PROCESS_MODE_ALREADY_BACKGROUND"},
+	{403, "ERROR_PROCESS_MODE_NOT_BACKGROUND"},
+	{404, "ERROR_CLOUD_FILE_PROVIDER_TERMINATED"},
+	{405, "ERROR_NOT_A_CLOUD_SYNC_ROOT"},
+	{406, "ERROR_FILE_PROTECTED_UNDER_DPL"},
+	{407, "ERROR_VOLUME_NOT_CLUSTER_ALIGNED"},
+	{408, "ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND"},
+	{409, "ERROR_APPX_FILE_NOT_ENCRYPTED"},
+	{410, "ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED"},
+	{411, "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET"},
+	{412, "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE"},
+	{413, "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER"},
+	{414, "ERROR_LINUX_SUBSYSTEM_NOT_PRESENT"},
+	{415, "ERROR_FT_READ_FAILURE"},
+	{416, "ERROR_STORAGE_RESERVE_ID_INVALID"},
+	{417, "ERROR_STORAGE_RESERVE_DOES_NOT_EXIST"},
+	{418, "ERROR_STORAGE_RESERVE_ALREADY_EXISTS"},
+	{419, "ERROR_STORAGE_RESERVE_NOT_EMPTY"},
+	{420, "ERROR_NOT_A_DAX_VOLUME"},
+	{421, "ERROR_NOT_DAX_MAPPABLE"},
+	{422, "ERROR_TIME_SENSITIVE_THREAD"},
+	{423, "ERROR_DPL_NOT_SUPPORTED_FOR_USER"},
+	{424, "ERROR_CASE_DIFFERING_NAMES_IN_DIR"},
+	{425, "ERROR_FILE_NOT_SUPPORTED"},
+	{426, "ERROR_CLOUD_FILE_REQUEST_TIMEOUT"},
+	{427, "ERROR_NO_TASK_QUEUE"},
+	{428, "ERROR_SRC_SRV_DLL_LOAD_FAILED"},
+	{429, "ERROR_NOT_SUPPORTED_WITH_BTT"},
+	{430, "ERROR_ENCRYPTION_DISABLED"},
+	{431, "ERROR_ENCRYPTING_METADATA_DISALLOWED"},
+	{432, "ERROR_CANT_CLEAR_ENCRYPTION_FLAG"},
+	{433, "ERROR_NO_SUCH_DEVICE"},
+	{450, "ERROR_CAPAUTHZ_NOT_DEVUNLOCKED"},
+	{451, "ERROR_CAPAUTHZ_CHANGE_TYPE"},
+	{452, "ERROR_CAPAUTHZ_NOT_PROVISIONED"},
+	{453, "ERROR_CAPAUTHZ_NOT_AUTHORIZED"},
+	{454, "ERROR_CAPAUTHZ_NO_POLICY"},
+	{455, "ERROR_CAPAUTHZ_DB_CORRUPTED"},
+	{456, "ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG"},
+	{457, "ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY"},
+	{458, "ERROR_CAPAUTHZ_SCCD_PARSE_ERROR"},
+	{459, "ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED"},
+	{460, "ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH"},
+	{480, "ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT"},
+	{481, "ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT"},
+	{482, "ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT"},
+	{483, "ERROR_DEVICE_HARDWARE_ERROR"},
+	{487, "ERROR_INVALID_ADDRESS"},
+	{500, "ERROR_USER_PROFILE_LOAD"},
+	{534, "ERROR_ARITHMETIC_OVERFLOW"},
+	{535, "ERROR_PIPE_CONNECTED"},
+	{536, "ERROR_PIPE_LISTENING"},
+	{537, "ERROR_VERIFIER_STOP"},
+	{538, "ERROR_ABIOS_ERROR"},
+	{539, "ERROR_WX86_WARNING"},
+	{540, "ERROR_WX86_ERROR"},
+	{541, "ERROR_TIMER_NOT_CANCELED"},
+	{542, "ERROR_UNWIND"},
+	{543, "ERROR_BAD_STACK"},
+	{544, "ERROR_INVALID_UNWIND_TARGET"},
+	{545, "ERROR_INVALID_PORT_ATTRIBUTES"},
+	{546, "ERROR_PORT_MESSAGE_TOO_LONG"},
+	{547, "ERROR_INVALID_QUOTA_LOWER"},
+	{548, "ERROR_DEVICE_ALREADY_ATTACHED"},
+	{549, "ERROR_INSTRUCTION_MISALIGNMENT"},
+	{550, "ERROR_PROFILING_NOT_STARTED"},
+	{551, "ERROR_PROFILING_NOT_STOPPED"},
+	{552, "ERROR_COULD_NOT_INTERPRET"},
+	{553, "ERROR_PROFILING_AT_LIMIT"},
+	{554, "ERROR_CANT_WAIT"},
+	{555, "ERROR_CANT_TERMINATE_SELF"},
+	{556, "ERROR_UNEXPECTED_MM_CREATE_ERR"},
+	{557, "ERROR_UNEXPECTED_MM_MAP_ERROR"},
+	{558, "ERROR_UNEXPECTED_MM_EXTEND_ERR"},
+	{559, "ERROR_BAD_FUNCTION_TABLE"},
+	{560, "ERROR_NO_GUID_TRANSLATION"},
+	{561, "ERROR_INVALID_LDT_SIZE"},
+	{563, "ERROR_INVALID_LDT_OFFSET"},
+	{564, "ERROR_INVALID_LDT_DESCRIPTOR"},
+	{565, "ERROR_TOO_MANY_THREADS"},
+	{566, "ERROR_THREAD_NOT_IN_PROCESS"},
+	{567, "ERROR_PAGEFILE_QUOTA_EXCEEDED"},
+	{568, "ERROR_LOGON_SERVER_CONFLICT"},
+	{569, "ERROR_SYNCHRONIZATION_REQUIRED"},
+	{570, "ERROR_NET_OPEN_FAILED"},
+	{571, "ERROR_IO_PRIVILEGE_FAILED"},
+	{572, "ERROR_CONTROL_C_EXIT"},
+	{573, "ERROR_MISSING_SYSTEMFILE"},
+	{574, "ERROR_UNHANDLED_EXCEPTION"},
+	{575, "ERROR_APP_INIT_FAILURE"},
+	{576, "ERROR_PAGEFILE_CREATE_FAILED"},
+	{577, "ERROR_INVALID_IMAGE_HASH"},
+	{578, "ERROR_NO_PAGEFILE"},
+	{579, "ERROR_ILLEGAL_FLOAT_CONTEXT"},
+	{580, "ERROR_NO_EVENT_PAIR"},
+	{581, "ERROR_DOMAIN_CTRLR_CONFIG_ERROR"},
+	{582, "ERROR_ILLEGAL_CHARACTER"},
+	{583, "ERROR_UNDEFINED_CHARACTER"},
+	{584, "ERROR_FLOPPY_VOLUME"},
+	{585, "ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT"},
+	{586, "ERROR_BACKUP_CONTROLLER"},
+	{587, "ERROR_MUTANT_LIMIT_EXCEEDED"},
+	{588, "ERROR_FS_DRIVER_REQUIRED"},
+	{589, "ERROR_CANNOT_LOAD_REGISTRY_FILE"},
+	{590, "ERROR_DEBUG_ATTACH_FAILED"},
+	{591, "ERROR_SYSTEM_PROCESS_TERMINATED"},
+	{592, "ERROR_DATA_NOT_ACCEPTED"},
+	{593, "ERROR_VDM_HARD_ERROR"},
+	{594, "ERROR_DRIVER_CANCEL_TIMEOUT"},
+	{595, "ERROR_REPLY_MESSAGE_MISMATCH"},
+	{596, "ERROR_LOST_WRITEBEHIND_DATA"},
+	{597, "ERROR_CLIENT_SERVER_PARAMETERS_INVALID"},
+	{598, "ERROR_NOT_TINY_STREAM"},
+	{599, "ERROR_STACK_OVERFLOW_READ"},
+	{600, "ERROR_CONVERT_TO_LARGE"},
+	{601, "ERROR_FOUND_OUT_OF_SCOPE"},
+	{602, "ERROR_ALLOCATE_BUCKET"},
+	{603, "ERROR_MARSHALL_OVERFLOW"},
+	{604, "ERROR_INVALID_VARIANT"},
+	{605, "ERROR_BAD_COMPRESSION_BUFFER"},
+	{606, "ERROR_AUDIT_FAILED"},
+	{607, "ERROR_TIMER_RESOLUTION_NOT_SET"},
+	{608, "ERROR_INSUFFICIENT_LOGON_INFO"},
+	{609, "ERROR_BAD_DLL_ENTRYPOINT"},
+	{610, "ERROR_BAD_SERVICE_ENTRYPOINT"},
+	{611, "ERROR_IP_ADDRESS_CONFLICT1"},
+	{612, "ERROR_IP_ADDRESS_CONFLICT2"},
+	{613, "ERROR_REGISTRY_QUOTA_LIMIT"},
+	{614, "ERROR_NO_CALLBACK_ACTIVE"},
+	{615, "ERROR_PWD_TOO_SHORT"},
+	{616, "ERROR_PWD_TOO_RECENT"},
+	{617, "ERROR_PWD_HISTORY_CONFLICT"},
+	{618, "ERROR_UNSUPPORTED_COMPRESSION"},
+	{619, "ERROR_INVALID_HW_PROFILE"},
+	{620, "ERROR_INVALID_PLUGPLAY_DEVICE_PATH"},
+	{621, "ERROR_QUOTA_LIST_INCONSISTENT"},
+	{622, "ERROR_EVALUATION_EXPIRATION"},
+	{623, "ERROR_ILLEGAL_DLL_RELOCATION"},
+	{624, "ERROR_DLL_INIT_FAILED_LOGOFF"},
+	{625, "ERROR_VALIDATE_CONTINUE"},
+	{626, "ERROR_NO_MORE_MATCHES"},
+	{627, "ERROR_RANGE_LIST_CONFLICT"},
+	{628, "ERROR_SERVER_SID_MISMATCH"},
+	{629, "ERROR_CANT_ENABLE_DENY_ONLY"},
+	{630, "ERROR_FLOAT_MULTIPLE_FAULTS"},
+	{631, "ERROR_FLOAT_MULTIPLE_TRAPS"},
+	{632, "ERROR_NOINTERFACE"},
+	{633, "ERROR_DRIVER_FAILED_SLEEP"},
+	{634, "ERROR_CORRUPT_SYSTEM_FILE"},
+	{635, "ERROR_COMMITMENT_MINIMUM"},
+	{636, "ERROR_PNP_RESTART_ENUMERATION"},
+	{637, "ERROR_SYSTEM_IMAGE_BAD_SIGNATURE"},
+	{638, "ERROR_PNP_REBOOT_REQUIRED"},
+	{639, "ERROR_INSUFFICIENT_POWER"},
+	{640, "ERROR_MULTIPLE_FAULT_VIOLATION"},
+	{641, "ERROR_SYSTEM_SHUTDOWN"},
+	{642, "ERROR_PORT_NOT_SET"},
+	{643, "ERROR_DS_VERSION_CHECK_FAILURE"},
+	{644, "ERROR_RANGE_NOT_FOUND"},
+	{646, "ERROR_NOT_SAFE_MODE_DRIVER"},
+	{647, "ERROR_FAILED_DRIVER_ENTRY"},
+	{648, "ERROR_DEVICE_ENUMERATION_ERROR"},
+	{649, "ERROR_MOUNT_POINT_NOT_RESOLVED"},
+	{650, "ERROR_INVALID_DEVICE_OBJECT_PARAMETER"},
+	{651, "ERROR_MCA_OCCURED"},
+	{652, "ERROR_DRIVER_DATABASE_ERROR"},
+	{653, "ERROR_SYSTEM_HIVE_TOO_LARGE"},
+	{654, "ERROR_DRIVER_FAILED_PRIOR_UNLOAD"},
+	{655, "ERROR_VOLSNAP_PREPARE_HIBERNATE"},
+	{656, "ERROR_HIBERNATION_FAILURE"},
+	{657, "ERROR_PWD_TOO_LONG"},
+	{665, "ERROR_FILE_SYSTEM_LIMITATION"},
+	{668, "ERROR_ASSERTION_FAILURE"},
+	{669, "ERROR_ACPI_ERROR"},
+	{670, "ERROR_WOW_ASSERTION"},
+	{671, "ERROR_PNP_BAD_MPS_TABLE"},
+	{672, "ERROR_PNP_TRANSLATION_FAILED"},
+	{673, "ERROR_PNP_IRQ_TRANSLATION_FAILED"},
+	{674, "ERROR_PNP_INVALID_ID"},
+	{675, "ERROR_WAKE_SYSTEM_DEBUGGER"},
+	{676, "ERROR_HANDLES_CLOSED"},
+	{677, "ERROR_EXTRANEOUS_INFORMATION"},
+	{678, "ERROR_RXACT_COMMIT_NECESSARY"},
+	{679, "ERROR_MEDIA_CHECK"},
+	{680, "ERROR_GUID_SUBSTITUTION_MADE"},
+	{681, "ERROR_STOPPED_ON_SYMLINK"},
+	{682, "ERROR_LONGJUMP"},
+	{683, "ERROR_PLUGPLAY_QUERY_VETOED"},
+	{684, "ERROR_UNWIND_CONSOLIDATE"},
+	{685, "ERROR_REGISTRY_HIVE_RECOVERED"},
+	{686, "ERROR_DLL_MIGHT_BE_INSECURE"},
+	{687, "ERROR_DLL_MIGHT_BE_INCOMPATIBLE"},
+	{688, "ERROR_DBG_EXCEPTION_NOT_HANDLED"},
+	{689, "ERROR_DBG_REPLY_LATER"},
+	{690, "ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE"},
+	{691, "ERROR_DBG_TERMINATE_THREAD"},
+	{692, "ERROR_DBG_TERMINATE_PROCESS"},
+	{693, "ERROR_DBG_CONTROL_C"},
+	{694, "ERROR_DBG_PRINTEXCEPTION_C"},
+	{695, "ERROR_DBG_RIPEXCEPTION"},
+	{696, "ERROR_DBG_CONTROL_BREAK"},
+	{697, "ERROR_DBG_COMMAND_EXCEPTION"},
+	{698, "ERROR_OBJECT_NAME_EXISTS"},
+	{699, "ERROR_THREAD_WAS_SUSPENDED"},
+	{701, "ERROR_RXACT_STATE_CREATED"},
+	{702, "ERROR_SEGMENT_NOTIFICATION"},
+	{703, "ERROR_BAD_CURRENT_DIRECTORY"},
+	{704, "ERROR_FT_READ_RECOVERY_FROM_BACKUP"},
+	{705, "ERROR_FT_WRITE_RECOVERY"},
+	{706, "ERROR_IMAGE_MACHINE_TYPE_MISMATCH"},
+	{707, "ERROR_RECEIVE_PARTIAL"},
+	{708, "ERROR_RECEIVE_EXPEDITED"},
+	{709, "ERROR_RECEIVE_PARTIAL_EXPEDITED"},
+	{710, "ERROR_EVENT_DONE"},
+	{711, "ERROR_EVENT_PENDING"},
+	{712, "ERROR_CHECKING_FILE_SYSTEM"},
+	{713, "ERROR_FATAL_APP_EXIT"},
+	{714, "ERROR_PREDEFINED_HANDLE"},
+	{715, "ERROR_WAS_UNLOCKED"},
+	{716, "ERROR_SERVICE_NOTIFICATION"},
+	{717, "ERROR_WAS_LOCKED"},
+	{718, "ERROR_LOG_HARD_ERROR"},
+	{719, "ERROR_ALREADY_WIN32"},
+	{720, "ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE"},
+	{721, "ERROR_NO_YIELD_PERFORMED"},
+	{722, "ERROR_TIMER_RESUME_IGNORED"},
+	{723, "ERROR_ARBITRATION_UNHANDLED"},
+	{724, "ERROR_CARDBUS_NOT_SUPPORTED"},
+	{725, "ERROR_MP_PROCESSOR_MISMATCH"},
+	{726, "ERROR_HIBERNATED"},
+	{727, "ERROR_RESUME_HIBERNATION"},
+	{728, "ERROR_FIRMWARE_UPDATED"},
+	{729, "ERROR_DRIVERS_LEAKING_LOCKED_PAGES"},
+	{730, "ERROR_WAKE_SYSTEM"},
+	{731, "ERROR_WAIT_1"},
+	{732, "ERROR_WAIT_2"},
+	{733, "ERROR_WAIT_3"},
+	{734, "ERROR_WAIT_63"},
+	{735, "ERROR_ABANDONED_WAIT_0"},
+	{736, "ERROR_ABANDONED_WAIT_63"},
+	{737, "ERROR_USER_APC"},
+	{738, "ERROR_KERNEL_APC"},
+	{739, "ERROR_ALERTED"},
+	{740, "ERROR_ELEVATION_REQUIRED"},
+	{741, "ERROR_REPARSE"},
+	{742, "ERROR_OPLOCK_BREAK_IN_PROGRESS"},
+	{743, "ERROR_VOLUME_MOUNTED"},
+	{744, "ERROR_RXACT_COMMITTED"},
+	{745, "ERROR_NOTIFY_CLEANUP"},
+	{746, "ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED"},
+	{747, "ERROR_PAGE_FAULT_TRANSITION"},
+	{748, "ERROR_PAGE_FAULT_DEMAND_ZERO"},
+	{749, "ERROR_PAGE_FAULT_COPY_ON_WRITE"},
+	{750, "ERROR_PAGE_FAULT_GUARD_PAGE"},
+	{751, "ERROR_PAGE_FAULT_PAGING_FILE"},
+	{752, "ERROR_CACHE_PAGE_LOCKED"},
+	{753, "ERROR_CRASH_DUMP"},
+	{754, "ERROR_BUFFER_ALL_ZEROS"},
+	{755, "ERROR_REPARSE_OBJECT"},
+	{756, "ERROR_RESOURCE_REQUIREMENTS_CHANGED"},
+	{757, "ERROR_TRANSLATION_COMPLETE"},
+	{758, "ERROR_NOTHING_TO_TERMINATE"},
+	{759, "ERROR_PROCESS_NOT_IN_JOB"},
+	{760, "ERROR_PROCESS_IN_JOB"},
+	{761, "ERROR_VOLSNAP_HIBERNATE_READY"},
+	{762, "ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY"},
+	{763, "ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED"},
+	{764, "ERROR_INTERRUPT_STILL_CONNECTED"},
+	{765, "ERROR_WAIT_FOR_OPLOCK"},
+	{766, "ERROR_DBG_EXCEPTION_HANDLED"},
+	{767, "ERROR_DBG_CONTINUE"},
+	{768, "ERROR_CALLBACK_POP_STACK"},
+	{769, "ERROR_COMPRESSION_DISABLED"},
+	{770, "ERROR_CANTFETCHBACKWARDS"},
+	{771, "ERROR_CANTSCROLLBACKWARDS"},
+	{772, "ERROR_ROWSNOTRELEASED"},
+	{773, "ERROR_BAD_ACCESSOR_FLAGS"},
+	{774, "ERROR_ERRORS_ENCOUNTERED"},
+	{775, "ERROR_NOT_CAPABLE"},
+	{776, "ERROR_REQUEST_OUT_OF_SEQUENCE"},
+	{777, "ERROR_VERSION_PARSE_ERROR"},
+	{778, "ERROR_BADSTARTPOSITION"},
+	{779, "ERROR_MEMORY_HARDWARE"},
+	{780, "ERROR_DISK_REPAIR_DISABLED"},
+	{781, "ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE"},
+	{782, "ERROR_SYSTEM_POWERSTATE_TRANSITION"},
+	{783, "ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION"},
+	{784, "ERROR_MCA_EXCEPTION"},
+	{785, "ERROR_ACCESS_AUDIT_BY_POLICY"},
+	{786, "ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY"},
+	{787, "ERROR_ABANDON_HIBERFILE"},
+	{788, "ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED"},
+	{789, "ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR"},
+	{790, "ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR"},
+	{791, "ERROR_BAD_MCFG_TABLE"},
+	{792, "ERROR_DISK_REPAIR_REDIRECTED"},
+	{793, "ERROR_DISK_REPAIR_UNSUCCESSFUL"},
+	{794, "ERROR_CORRUPT_LOG_OVERFULL"},
+	{795, "ERROR_CORRUPT_LOG_CORRUPTED"},
+	{796, "ERROR_CORRUPT_LOG_UNAVAILABLE"},
+	{797, "ERROR_CORRUPT_LOG_DELETED_FULL"},
+	{798, "ERROR_CORRUPT_LOG_CLEARED"},
+	{799, "ERROR_ORPHAN_NAME_EXHAUSTED"},
+	{800, "ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE"},
+	{801, "ERROR_CANNOT_GRANT_REQUESTED_OPLOCK"},
+	{802, "ERROR_CANNOT_BREAK_OPLOCK"},
+	{803, "ERROR_OPLOCK_HANDLE_CLOSED"},
+	{804, "ERROR_NO_ACE_CONDITION"},
+	{805, "ERROR_INVALID_ACE_CONDITION"},
+	{806, "ERROR_FILE_HANDLE_REVOKED"},
+	{808, "ERROR_ENCRYPTED_IO_NOT_POSSIBLE"},
+	{809, "ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS"},
+	{810, "ERROR_QUOTA_ACTIVITY"},
+	{811, "ERROR_HANDLE_REVOKED"},
+	{812, "ERROR_CALLBACK_INVOKE_INLINE"},
+	{813, "ERROR_CPU_SET_INVALID"},
+	{814, "ERROR_ENCLAVE_NOT_TERMINATED"},
+	{815, "ERROR_ENCLAVE_VIOLATION"},
+	{994, "ERROR_EA_ACCESS_DENIED"},
+	{995, "ERROR_OPERATION_ABORTED"},
+	{996, "ERROR_IO_INCOMPLETE"},
+	{997, "ERROR_IO_PENDING"},
+	{998, "ERROR_NOACCESS"},
+	{999, "ERROR_SWAPERROR"},
+	{1001, "ERROR_STACK_OVERFLOW"},
+	{1002, "ERROR_INVALID_MESSAGE"},
+	{1003, "ERROR_CAN_NOT_COMPLETE"},
+	{1004, "ERROR_INVALID_FLAGS"},
+	{1005, "ERROR_UNRECOGNIZED_VOLUME"},
+	{1006, "ERROR_FILE_INVALID"},
+	{1007, "ERROR_FULLSCREEN_MODE"},
+	{1008, "ERROR_NO_TOKEN"},
+	{1009, "ERROR_BADDB"},
+	{1010, "ERROR_BADKEY"},
+	{1011, "ERROR_CANTOPEN"},
+	{1012, "ERROR_CANTREAD"},
+	{1013, "ERROR_CANTWRITE"},
+	{1014, "ERROR_REGISTRY_RECOVERED"},
+	{1015, "ERROR_REGISTRY_CORRUPT"},
+	{1016, "ERROR_REGISTRY_IO_FAILED"},
+	{1017, "ERROR_NOT_REGISTRY_FILE"},
+	{1018, "ERROR_KEY_DELETED"},
+	{1019, "ERROR_NO_LOG_SPACE"},
+	{1020, "ERROR_KEY_HAS_CHILDREN"},
+	{1021, "ERROR_CHILD_MUST_BE_VOLATILE"},
+	{1022, "ERROR_NOTIFY_ENUM_DIR"},
+	{1051, "ERROR_DEPENDENT_SERVICES_RUNNING"},
+	{1052, "ERROR_INVALID_SERVICE_CONTROL"},
+	{1053, "ERROR_SERVICE_REQUEST_TIMEOUT"},
+	{1054, "ERROR_SERVICE_NO_THREAD"},
+	{1055, "ERROR_SERVICE_DATABASE_LOCKED"},
+	{1056, "ERROR_SERVICE_ALREADY_RUNNING"},
+	{1057, "ERROR_INVALID_SERVICE_ACCOUNT"},
+	{1058, "ERROR_SERVICE_DISABLED"},
+	{1059, "ERROR_CIRCULAR_DEPENDENCY"},
+	{1060, "ERROR_SERVICE_DOES_NOT_EXIST"},
+	{1061, "ERROR_SERVICE_CANNOT_ACCEPT_CTRL"},
+	{1062, "ERROR_SERVICE_NOT_ACTIVE"},
+	{1063, "ERROR_FAILED_SERVICE_CONTROLLER_CONNECT"},
+	{1064, "ERROR_EXCEPTION_IN_SERVICE"},
+	{1065, "ERROR_DATABASE_DOES_NOT_EXIST"},
+	{1066, "ERROR_SERVICE_SPECIFIC_ERROR"},
+	{1067, "ERROR_PROCESS_ABORTED"},
+	{1068, "ERROR_SERVICE_DEPENDENCY_FAIL"},
+	{1069, "ERROR_SERVICE_LOGON_FAILED"},
+	{1070, "ERROR_SERVICE_START_HANG"},
+	{1071, "ERROR_INVALID_SERVICE_LOCK"},
+	{1072, "ERROR_SERVICE_MARKED_FOR_DELETE"},
+	{1073, "ERROR_SERVICE_EXISTS"},
+	{1074, "ERROR_ALREADY_RUNNING_LKG"},
+	{1075, "ERROR_SERVICE_DEPENDENCY_DELETED"},
+	{1076, "ERROR_BOOT_ALREADY_ACCEPTED"},
+	{1077, "ERROR_SERVICE_NEVER_STARTED"},
+	{1078, "ERROR_DUPLICATE_SERVICE_NAME"},
+	{1079, "ERROR_DIFFERENT_SERVICE_ACCOUNT"},
+	{1080, "ERROR_CANNOT_DETECT_DRIVER_FAILURE"},
+	{1081, "ERROR_CANNOT_DETECT_PROCESS_ABORT"},
+	{1082, "ERROR_NO_RECOVERY_PROGRAM"},
+	{1083, "ERROR_SERVICE_NOT_IN_EXE"},
+	{1084, "ERROR_NOT_SAFEBOOT_SERVICE"},
+	{1100, "ERROR_END_OF_MEDIA"},
+	{1101, "ERROR_FILEMARK_DETECTED"},
+	{1102, "ERROR_BEGINNING_OF_MEDIA"},
+	{1103, "ERROR_SETMARK_DETECTED"},
+	{1104, "ERROR_NO_DATA_DETECTED"},
+	{1105, "ERROR_PARTITION_FAILURE"},
+	{1106, "ERROR_INVALID_BLOCK_LENGTH"},
+	{1107, "ERROR_DEVICE_NOT_PARTITIONED"},
+	{1108, "ERROR_UNABLE_TO_LOCK_MEDIA"},
+	{1109, "ERROR_UNABLE_TO_UNLOAD_MEDIA"},
+	{1110, "ERROR_MEDIA_CHANGED"},
+	{1111, "ERROR_BUS_RESET"},
+	{1112, "ERROR_NO_MEDIA_IN_DRIVE"},
+	{1113, "ERROR_NO_UNICODE_TRANSLATION"},
+	{1114, "ERROR_DLL_INIT_FAILED"},
+	{1115, "ERROR_SHUTDOWN_IN_PROGRESS"},
+	{1116, "ERROR_NO_SHUTDOWN_IN_PROGRESS"},
+	{1117, "ERROR_IO_DEVICE"},
+	{1118, "ERROR_SERIAL_NO_DEVICE"},
+	{1119, "ERROR_IRQ_BUSY"},
+	{1120, "ERROR_MORE_WRITES"},
+	{1121, "ERROR_COUNTER_TIMEOUT"},
+	{1122, "ERROR_FLOPPY_ID_MARK_NOT_FOUND"},
+	{1123, "ERROR_FLOPPY_WRONG_CYLINDER"},
+	{1124, "ERROR_FLOPPY_UNKNOWN_ERROR"},
+	{1125, "ERROR_FLOPPY_BAD_REGISTERS"},
+	{1126, "ERROR_DISK_RECALIBRATE_FAILED"},
+	{1127, "ERROR_DISK_OPERATION_FAILED"},
+	{1128, "ERROR_DISK_RESET_FAILED"},
+	{1129, "ERROR_EOM_OVERFLOW"},
+	{1130, "ERROR_NOT_ENOUGH_SERVER_MEMORY"},
+	{1131, "ERROR_POSSIBLE_DEADLOCK"},
+	{1132, "ERROR_MAPPED_ALIGNMENT"},
+	{1140, "ERROR_SET_POWER_STATE_VETOED"},
+	{1141, "ERROR_SET_POWER_STATE_FAILED"},
+	{1142, "ERROR_TOO_MANY_LINKS"},
+	{1150, "ERROR_OLD_WIN_VERSION"},
+	{1151, "ERROR_APP_WRONG_OS"},
+	{1152, "ERROR_SINGLE_INSTANCE_APP"},
+	{1153, "ERROR_RMODE_APP"},
+	{1154, "ERROR_INVALID_DLL"},
+	{1155, "ERROR_NO_ASSOCIATION"},
+	{1156, "ERROR_DDE_FAIL"},
+	{1157, "ERROR_DLL_NOT_FOUND"},
+	{1158, "ERROR_NO_MORE_USER_HANDLES"},
+	{1159, "ERROR_MESSAGE_SYNC_ONLY"},
+	{1160, "ERROR_SOURCE_ELEMENT_EMPTY"},
+	{1161, "ERROR_DESTINATION_ELEMENT_FULL"},
+	{1162, "ERROR_ILLEGAL_ELEMENT_ADDRESS"},
+	{1163, "ERROR_MAGAZINE_NOT_PRESENT"},
+	{1164, "ERROR_DEVICE_REINITIALIZATION_NEEDED"},
+	{1165, "ERROR_DEVICE_REQUIRES_CLEANING"},
+	{1166, "ERROR_DEVICE_DOOR_OPEN"},
+	{1167, "ERROR_DEVICE_NOT_CONNECTED"},
+	{1168, "ERROR_NOT_FOUND"},
+	{1169, "ERROR_NO_MATCH"},
+	{1170, "ERROR_SET_NOT_FOUND"},
+	{1171, "ERROR_POINT_NOT_FOUND"},
+	{1172, "ERROR_NO_TRACKING_SERVICE"},
+	{1173, "ERROR_NO_VOLUME_ID"},
+	{1175, "ERROR_UNABLE_TO_REMOVE_REPLACED"},
+	{1176, "ERROR_UNABLE_TO_MOVE_REPLACEMENT"},
+	{1177, "ERROR_UNABLE_TO_MOVE_REPLACEMENT_2"},
+	{1178, "ERROR_JOURNAL_DELETE_IN_PROGRESS"},
+	{1179, "ERROR_JOURNAL_NOT_ACTIVE"},
+	{1180, "ERROR_POTENTIAL_FILE_FOUND"},
+	{1181, "ERROR_JOURNAL_ENTRY_DELETED"},
+	{1183, "ERROR_VRF_CFG_ENABLED"},
+	{1184, "ERROR_PARTITION_TERMINATING"},
+	{1190, "ERROR_SHUTDOWN_IS_SCHEDULED"},
+	{1191, "ERROR_SHUTDOWN_USERS_LOGGED_ON"},
+	{1200, "ERROR_BAD_DEVICE"},
+	{1201, "ERROR_CONNECTION_UNAVAIL"},
+	{1202, "ERROR_DEVICE_ALREADY_REMEMBERED"},
+	{1203, "ERROR_NO_NET_OR_BAD_PATH"},
+	{1204, "ERROR_BAD_PROVIDER"},
+	{1205, "ERROR_CANNOT_OPEN_PROFILE"},
+	{1206, "ERROR_BAD_PROFILE"},
+	{1207, "ERROR_NOT_CONTAINER"},
+	{1208, "ERROR_EXTENDED_ERROR"},
+	{1209, "ERROR_INVALID_GROUPNAME"},
+	{1210, "ERROR_INVALID_COMPUTERNAME"},
+	{1211, "ERROR_INVALID_EVENTNAME"},
+	{1212, "ERROR_INVALID_DOMAINNAME"},
+	{1213, "ERROR_INVALID_SERVICENAME"},
+	{1214, "ERROR_INVALID_NETNAME"},
+	{1215, "ERROR_INVALID_SHARENAME"},
+	{1216, "ERROR_INVALID_PASSWORDNAME"},
+	{1217, "ERROR_INVALID_MESSAGENAME"},
+	{1218, "ERROR_INVALID_MESSAGEDEST"},
+	{1219, "ERROR_SESSION_CREDENTIAL_CONFLICT"},
+	{1220, "ERROR_REMOTE_SESSION_LIMIT_EXCEEDED"},
+	{1221, "ERROR_DUP_DOMAINNAME"},
+	{1222, "ERROR_NO_NETWORK"},
+	{1223, "ERROR_CANCELLED"},
+	{1224, "ERROR_USER_MAPPED_FILE"},
+	{1225, "ERROR_CONNECTION_REFUSED"},
+	{1226, "ERROR_GRACEFUL_DISCONNECT"},
+	{1227, "ERROR_ADDRESS_ALREADY_ASSOCIATED"},
+	{1228, "ERROR_ADDRESS_NOT_ASSOCIATED"},
+	{1229, "ERROR_CONNECTION_INVALID"},
+	{1230, "ERROR_CONNECTION_ACTIVE"},
+	{1231, "ERROR_NETWORK_UNREACHABLE"},
+	{1232, "ERROR_HOST_UNREACHABLE"},
+	{1233, "ERROR_PROTOCOL_UNREACHABLE"},
+	{1234, "ERROR_PORT_UNREACHABLE"},
+	{1235, "ERROR_REQUEST_ABORTED"},
+	{1236, "ERROR_CONNECTION_ABORTED"},
+	{1237, "ERROR_RETRY"},
+	{1238, "ERROR_CONNECTION_COUNT_LIMIT"},
+	{1239, "ERROR_LOGIN_TIME_RESTRICTION"},
+	{1240, "ERROR_LOGIN_WKSTA_RESTRICTION"},
+	{1241, "ERROR_INCORRECT_ADDRESS"},
+	{1242, "ERROR_ALREADY_REGISTERED"},
+	{1243, "ERROR_SERVICE_NOT_FOUND"},
+	{1244, "ERROR_NOT_AUTHENTICATED"},
+	{1245, "ERROR_NOT_LOGGED_ON"},
+	{1246, "ERROR_CONTINUE"},
+	{1247, "ERROR_ALREADY_INITIALIZED"},
+	{1248, "ERROR_NO_MORE_DEVICES"},
+	{1249, "ERROR_NO_SUCH_SITE"},
+	{1250, "ERROR_DOMAIN_CONTROLLER_EXISTS"},
+	{1251, "ERROR_ONLY_IF_CONNECTED"},
+	{1252, "ERROR_OVERRIDE_NOCHANGES"},
+	{1253, "ERROR_BAD_USER_PROFILE"},
+	{1254, "ERROR_NOT_SUPPORTED_ON_SBS"},
+	{1255, "ERROR_SERVER_SHUTDOWN_IN_PROGRESS"},
+	{1256, "ERROR_HOST_DOWN"},
+	{1257, "ERROR_NON_ACCOUNT_SID"},
+	{1258, "ERROR_NON_DOMAIN_SID"},
+	{1259, "ERROR_APPHELP_BLOCK"},
+	{1260, "ERROR_ACCESS_DISABLED_BY_POLICY"},
+	{1261, "ERROR_REG_NAT_CONSUMPTION"},
+	{1262, "ERROR_CSCSHARE_OFFLINE"},
+	{1263, "ERROR_PKINIT_FAILURE"},
+	{1264, "ERROR_SMARTCARD_SUBSYSTEM_FAILURE"},
+	{1265, "ERROR_DOWNGRADE_DETECTED"},
+	{1271, "ERROR_MACHINE_LOCKED"},
+	{1272, "ERROR_SMB_GUEST_LOGON_BLOCKED"},
+	{1273, "ERROR_CALLBACK_SUPPLIED_INVALID_DATA"},
+	{1274, "ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED"},
+	{1275, "ERROR_DRIVER_BLOCKED"},
+	{1276, "ERROR_INVALID_IMPORT_OF_NON_DLL"},
+	{1277, "ERROR_ACCESS_DISABLED_WEBBLADE"},
+	{1278, "ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER"},
+	{1279, "ERROR_RECOVERY_FAILURE"},
+	{1280, "ERROR_ALREADY_FIBER"},
+	{1281, "ERROR_ALREADY_THREAD"},
+	{1282, "ERROR_STACK_BUFFER_OVERRUN"},
+	{1283, "ERROR_PARAMETER_QUOTA_EXCEEDED"},
+	{1284, "ERROR_DEBUGGER_INACTIVE"},
+	{1285, "ERROR_DELAY_LOAD_FAILED"},
+	{1286, "ERROR_VDM_DISALLOWED"},
+	{1287, "ERROR_UNIDENTIFIED_ERROR"},
+	{1288, "ERROR_INVALID_CRUNTIME_PARAMETER"},
+	{1289, "ERROR_BEYOND_VDL"},
+	{1290, "ERROR_INCOMPATIBLE_SERVICE_SID_TYPE"},
+	{1291, "ERROR_DRIVER_PROCESS_TERMINATED"},
+	{1292, "ERROR_IMPLEMENTATION_LIMIT"},
+	{1293, "ERROR_PROCESS_IS_PROTECTED"},
+	{1294, "ERROR_SERVICE_NOTIFY_CLIENT_LAGGING"},
+	{1295, "ERROR_DISK_QUOTA_EXCEEDED"},
+	{1296, "ERROR_CONTENT_BLOCKED"},
+	{1297, "ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE"},
+	{1298, "ERROR_APP_HANG"},
+	{1299, "ERROR_INVALID_LABEL"},
+	{1300, "ERROR_NOT_ALL_ASSIGNED"},
+	{1301, "ERROR_SOME_NOT_MAPPED"},
+	{1302, "ERROR_NO_QUOTAS_FOR_ACCOUNT"},
+	{1303, "ERROR_LOCAL_USER_SESSION_KEY"},
+	{1304, "ERROR_NULL_LM_PASSWORD"},
+	{1305, "ERROR_UNKNOWN_REVISION"},
+	{1306, "ERROR_REVISION_MISMATCH"},
+	{1307, "ERROR_INVALID_OWNER"},
+	{1308, "ERROR_INVALID_PRIMARY_GROUP"},
+	{1309, "ERROR_NO_IMPERSONATION_TOKEN"},
+	{1310, "ERROR_CANT_DISABLE_MANDATORY"},
+	{1311, "ERROR_NO_LOGON_SERVERS"},
+	{1312, "ERROR_NO_SUCH_LOGON_SESSION"},
+	{1313, "ERROR_NO_SUCH_PRIVILEGE"},
+	{1314, "ERROR_PRIVILEGE_NOT_HELD"},
+	{1315, "ERROR_INVALID_ACCOUNT_NAME"},
+	{1316, "ERROR_USER_EXISTS"},
+	{1317, "ERROR_NO_SUCH_USER"},
+	{1318, "ERROR_GROUP_EXISTS"},
+	{1319, "ERROR_NO_SUCH_GROUP"},
+	{1320, "ERROR_MEMBER_IN_GROUP"},
+	{1321, "ERROR_MEMBER_NOT_IN_GROUP"},
+	{1322, "ERROR_LAST_ADMIN"},
+	{1323, "ERROR_WRONG_PASSWORD"},
+	{1324, "ERROR_ILL_FORMED_PASSWORD"},
+	{1325, "ERROR_PASSWORD_RESTRICTION"},
+	{1326, "ERROR_LOGON_FAILURE"},
+	{1327, "ERROR_ACCOUNT_RESTRICTION"},
+	{1328, "ERROR_INVALID_LOGON_HOURS"},
+	{1329, "ERROR_INVALID_WORKSTATION"},
+	{1330, "ERROR_PASSWORD_EXPIRED"},
+	{1331, "ERROR_ACCOUNT_DISABLED"},
+	{1332, "ERROR_NONE_MAPPED"},
+	{1333, "ERROR_TOO_MANY_LUIDS_REQUESTED"},
+	{1334, "ERROR_LUIDS_EXHAUSTED"},
+	{1335, "ERROR_INVALID_SUB_AUTHORITY"},
+	{1336, "ERROR_INVALID_ACL"},
+	{1337, "ERROR_INVALID_SID"},
+	{1338, "ERROR_INVALID_SECURITY_DESCR"},
+	{1340, "ERROR_BAD_INHERITANCE_ACL"},
+	{1341, "ERROR_SERVER_DISABLED"},
+	{1342, "ERROR_SERVER_NOT_DISABLED"},
+	{1343, "ERROR_INVALID_ID_AUTHORITY"},
+	{1344, "ERROR_ALLOTTED_SPACE_EXCEEDED"},
+	{1345, "ERROR_INVALID_GROUP_ATTRIBUTES"},
+	{1346, "ERROR_BAD_IMPERSONATION_LEVEL"},
+	{1347, "ERROR_CANT_OPEN_ANONYMOUS"},
+	{1348, "ERROR_BAD_VALIDATION_CLASS"},
+	{1349, "ERROR_BAD_TOKEN_TYPE"},
+	{1350, "ERROR_NO_SECURITY_ON_OBJECT"},
+	{1351, "ERROR_CANT_ACCESS_DOMAIN_INFO"},
+	{1352, "ERROR_INVALID_SERVER_STATE"},
+	{1353, "ERROR_INVALID_DOMAIN_STATE"},
+	{1354, "ERROR_INVALID_DOMAIN_ROLE"},
+	{1355, "ERROR_NO_SUCH_DOMAIN"},
+	{1356, "ERROR_DOMAIN_EXISTS"},
+	{1357, "ERROR_DOMAIN_LIMIT_EXCEEDED"},
+	{1358, "ERROR_INTERNAL_DB_CORRUPTION"},
+	{1359, "ERROR_INTERNAL_ERROR"},
+	{1360, "ERROR_GENERIC_NOT_MAPPED"},
+	{1361, "ERROR_BAD_DESCRIPTOR_FORMAT"},
+	{1362, "ERROR_NOT_LOGON_PROCESS"},
+	{1363, "ERROR_LOGON_SESSION_EXISTS"},
+	{1364, "ERROR_NO_SUCH_PACKAGE"},
+	{1365, "ERROR_BAD_LOGON_SESSION_STATE"},
+	{1366, "ERROR_LOGON_SESSION_COLLISION"},
+	{1367, "ERROR_INVALID_LOGON_TYPE"},
+	{1368, "ERROR_CANNOT_IMPERSONATE"},
+	{1369, "ERROR_RXACT_INVALID_STATE"},
+	{1370, "ERROR_RXACT_COMMIT_FAILURE"},
+	{1371, "ERROR_SPECIAL_ACCOUNT"},
+	{1372, "ERROR_SPECIAL_GROUP"},
+	{1373, "ERROR_SPECIAL_USER"},
+	{1374, "ERROR_MEMBERS_PRIMARY_GROUP"},
+	{1375, "ERROR_TOKEN_ALREADY_IN_USE"},
+	{1376, "ERROR_NO_SUCH_ALIAS"},
+	{1377, "ERROR_MEMBER_NOT_IN_ALIAS"},
+	{1378, "ERROR_MEMBER_IN_ALIAS"},
+	{1379, "ERROR_ALIAS_EXISTS"},
+	{1380, "ERROR_LOGON_NOT_GRANTED"},
+	{1381, "ERROR_TOO_MANY_SECRETS"},
+	{1382, "ERROR_SECRET_TOO_LONG"},
+	{1383, "ERROR_INTERNAL_DB_ERROR"},
+	{1384, "ERROR_TOO_MANY_CONTEXT_IDS"},
+	{1385, "ERROR_LOGON_TYPE_NOT_GRANTED"},
+	{1386, "ERROR_NT_CROSS_ENCRYPTION_REQUIRED"},
+	{1387, "ERROR_NO_SUCH_MEMBER"},
+	{1388, "ERROR_INVALID_MEMBER"},
+	{1389, "ERROR_TOO_MANY_SIDS"},
+	{1390, "ERROR_LM_CROSS_ENCRYPTION_REQUIRED"},
+	{1391, "ERROR_NO_INHERITANCE"},
+	{1392, "ERROR_FILE_CORRUPT"},
+	{1393, "ERROR_DISK_CORRUPT"},
+	{1394, "ERROR_NO_USER_SESSION_KEY"},
+	{1395, "ERROR_LICENSE_QUOTA_EXCEEDED"},
+	{1396, "ERROR_WRONG_TARGET_NAME"},
+	{1397, "ERROR_MUTUAL_AUTH_FAILED"},
+	{1398, "ERROR_TIME_SKEW"},
+	{1399, "ERROR_CURRENT_DOMAIN_NOT_ALLOWED"},
+	{1400, "ERROR_INVALID_WINDOW_HANDLE"},
+	{1401, "ERROR_INVALID_MENU_HANDLE"},
+	{1402, "ERROR_INVALID_CURSOR_HANDLE"},
+	{1403, "ERROR_INVALID_ACCEL_HANDLE"},
+	{1404, "ERROR_INVALID_HOOK_HANDLE"},
+	{1405, "ERROR_INVALID_DWP_HANDLE"},
+	{1406, "ERROR_TLW_WITH_WSCHILD"},
+	{1407, "ERROR_CANNOT_FIND_WND_CLASS"},
+	{1408, "ERROR_WINDOW_OF_OTHER_THREAD"},
+	{1409, "ERROR_HOTKEY_ALREADY_REGISTERED"},
+	{1410, "ERROR_CLASS_ALREADY_EXISTS"},
+	{1411, "ERROR_CLASS_DOES_NOT_EXIST"},
+	{1412, "ERROR_CLASS_HAS_WINDOWS"},
+	{1413, "ERROR_INVALID_INDEX"},
+	{1414, "ERROR_INVALID_ICON_HANDLE"},
+	{1415, "ERROR_PRIVATE_DIALOG_INDEX"},
+	{1416, "ERROR_LISTBOX_ID_NOT_FOUND"},
+	{1417, "ERROR_NO_WILDCARD_CHARACTERS"},
+	{1418, "ERROR_CLIPBOARD_NOT_OPEN"},
+	{1419, "ERROR_HOTKEY_NOT_REGISTERED"},
+	{1420, "ERROR_WINDOW_NOT_DIALOG"},
+	{1421, "ERROR_CONTROL_ID_NOT_FOUND"},
+	{1422, "ERROR_INVALID_COMBOBOX_MESSAGE"},
+	{1423, "ERROR_WINDOW_NOT_COMBOBOX"},
+	{1424, "ERROR_INVALID_EDIT_HEIGHT"},
+	{1425, "ERROR_DC_NOT_FOUND"},
+	{1426, "ERROR_INVALID_HOOK_FILTER"},
+	{1427, "ERROR_INVALID_FILTER_PROC"},
+	{1428, "ERROR_HOOK_NEEDS_HMOD"},
+	{1429, "ERROR_GLOBAL_ONLY_HOOK"},
+	{1430, "ERROR_JOURNAL_HOOK_SET"},
+	{1431, "ERROR_HOOK_NOT_INSTALLED"},
+	{1432, "ERROR_INVALID_LB_MESSAGE"},
+	{1433, "ERROR_SETCOUNT_ON_BAD_LB"},
+	{1434, "ERROR_LB_WITHOUT_TABSTOPS"},
+	{1435, "ERROR_DESTROY_OBJECT_OF_OTHER_THREAD"},
+	{1436, "ERROR_CHILD_WINDOW_MENU"},
+	{1437, "ERROR_NO_SYSTEM_MENU"},
+	{1438, "ERROR_INVALID_MSGBOX_STYLE"},
+	{1439, "ERROR_INVALID_SPI_VALUE"},
+	{1440, "ERROR_SCREEN_ALREADY_LOCKED"},
+	{1441, "ERROR_HWNDS_HAVE_DIFF_PARENT"},
+	{1442, "ERROR_NOT_CHILD_WINDOW"},
+	{1443, "ERROR_INVALID_GW_COMMAND"},
+	{1444, "ERROR_INVALID_THREAD_ID"},
+	{1445, "ERROR_NON_MDICHILD_WINDOW"},
+	{1446, "ERROR_POPUP_ALREADY_ACTIVE"},
+	{1447, "ERROR_NO_SCROLLBARS"},
+	{1448, "ERROR_INVALID_SCROLLBAR_RANGE"},
+	{1449, "ERROR_INVALID_SHOWWIN_COMMAND"},
+	{1450, "ERROR_NO_SYSTEM_RESOURCES"},
+	{1451, "ERROR_NONPAGED_SYSTEM_RESOURCES"},
+	{1452, "ERROR_PAGED_SYSTEM_RESOURCES"},
+	{1453, "ERROR_WORKING_SET_QUOTA"},
+	{1454, "ERROR_PAGEFILE_QUOTA"},
+	{1455, "ERROR_COMMITMENT_LIMIT"},
+	{1456, "ERROR_MENU_ITEM_NOT_FOUND"},
+	{1457, "ERROR_INVALID_KEYBOARD_HANDLE"},
+	{1458, "ERROR_HOOK_TYPE_NOT_ALLOWED"},
+	{1459, "ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION"},
+	{1460, "ERROR_TIMEOUT"},
+	{1461, "ERROR_INVALID_MONITOR_HANDLE"},
+	{1462, "ERROR_INCORRECT_SIZE"},
+	{1463, "ERROR_SYMLINK_CLASS_DISABLED"},
+	{1464, "ERROR_SYMLINK_NOT_SUPPORTED"},
+	{1465, "ERROR_XML_PARSE_ERROR"},
+	{1466, "ERROR_XMLDSIG_ERROR"},
+	{1467, "ERROR_RESTART_APPLICATION"},
+	{1468, "ERROR_WRONG_COMPARTMENT"},
+	{1469, "ERROR_AUTHIP_FAILURE"},
+	{1470, "ERROR_NO_NVRAM_RESOURCES"},
+	{1471, "ERROR_NOT_GUI_PROCESS"},
+	{1500, "ERROR_EVENTLOG_FILE_CORRUPT"},
+	{1501, "ERROR_EVENTLOG_CANT_START"},
+	{1502, "ERROR_LOG_FILE_FULL"},
+	{1503, "ERROR_EVENTLOG_FILE_CHANGED"},
+	{1504, "ERROR_CONTAINER_ASSIGNED"},
+	{1505, "ERROR_JOB_NO_CONTAINER"},
+	{1550, "ERROR_INVALID_TASK_NAME"},
+	{1551, "ERROR_INVALID_TASK_INDEX"},
+	{1552, "ERROR_THREAD_ALREADY_IN_TASK"},
+	{1601, "ERROR_INSTALL_SERVICE_FAILURE"},
+	{1602, "ERROR_INSTALL_USEREXIT"},
+	{1603, "ERROR_INSTALL_FAILURE"},
+	{1604, "ERROR_INSTALL_SUSPEND"},
+	{1605, "ERROR_UNKNOWN_PRODUCT"},
+	{1606, "ERROR_UNKNOWN_FEATURE"},
+	{1607, "ERROR_UNKNOWN_COMPONENT"},
+	{1608, "ERROR_UNKNOWN_PROPERTY"},
+	{1609, "ERROR_INVALID_HANDLE_STATE"},
+	{1610, "ERROR_BAD_CONFIGURATION"},
+	{1611, "ERROR_INDEX_ABSENT"},
+	{1612, "ERROR_INSTALL_SOURCE_ABSENT"},
+	{1613, "ERROR_INSTALL_PACKAGE_VERSION"},
+	{1614, "ERROR_PRODUCT_UNINSTALLED"},
+	{1615, "ERROR_BAD_QUERY_SYNTAX"},
+	{1616, "ERROR_INVALID_FIELD"},
+	{1617, "ERROR_DEVICE_REMOVED"},
+	{1618, "ERROR_INSTALL_ALREADY_RUNNING"},
+	{1619, "ERROR_INSTALL_PACKAGE_OPEN_FAILED"},
+	{1620, "ERROR_INSTALL_PACKAGE_INVALID"},
+	{1621, "ERROR_INSTALL_UI_FAILURE"},
+	{1622, "ERROR_INSTALL_LOG_FAILURE"},
+	{1623, "ERROR_INSTALL_LANGUAGE_UNSUPPORTED"},
+	{1624, "ERROR_INSTALL_TRANSFORM_FAILURE"},
+	{1625, "ERROR_INSTALL_PACKAGE_REJECTED"},
+	{1626, "ERROR_FUNCTION_NOT_CALLED"},
+	{1627, "ERROR_FUNCTION_FAILED"},
+	{1628, "ERROR_INVALID_TABLE"},
+	{1629, "ERROR_DATATYPE_MISMATCH"},
+	{1630, "ERROR_UNSUPPORTED_TYPE"},
+	{1631, "ERROR_CREATE_FAILED"},
+	{1632, "ERROR_INSTALL_TEMP_UNWRITABLE"},
+	{1633, "ERROR_INSTALL_PLATFORM_UNSUPPORTED"},
+	{1634, "ERROR_INSTALL_NOTUSED"},
+	{1635, "ERROR_PATCH_PACKAGE_OPEN_FAILED"},
+	{1636, "ERROR_PATCH_PACKAGE_INVALID"},
+	{1637, "ERROR_PATCH_PACKAGE_UNSUPPORTED"},
+	{1638, "ERROR_PRODUCT_VERSION"},
+	{1639, "ERROR_INVALID_COMMAND_LINE"},
+	{1640, "ERROR_INSTALL_REMOTE_DISALLOWED"},
+	{1641, "ERROR_SUCCESS_REBOOT_INITIATED"},
+	{1642, "ERROR_PATCH_TARGET_NOT_FOUND"},
+	{1643, "ERROR_PATCH_PACKAGE_REJECTED"},
+	{1644, "ERROR_INSTALL_TRANSFORM_REJECTED"},
+	{1645, "ERROR_INSTALL_REMOTE_PROHIBITED"},
+	{1646, "ERROR_PATCH_REMOVAL_UNSUPPORTED"},
+	{1647, "ERROR_UNKNOWN_PATCH"},
+	{1648, "ERROR_PATCH_NO_SEQUENCE"},
+	{1649, "ERROR_PATCH_REMOVAL_DISALLOWED"},
+	{1650, "ERROR_INVALID_PATCH_XML"},
+	{1651, "ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT"},
+	{1652, "ERROR_INSTALL_SERVICE_SAFEBOOT"},
+	{1653, "ERROR_FAIL_FAST_EXCEPTION"},
+	{1654, "ERROR_INSTALL_REJECTED"},
+	{1655, "ERROR_DYNAMIC_CODE_BLOCKED"},
+	{1656, "ERROR_NOT_SAME_OBJECT"},
+	{1657, "ERROR_STRICT_CFG_VIOLATION"},
+	{1660, "ERROR_SET_CONTEXT_DENIED"},
+	{1661, "ERROR_CROSS_PARTITION_VIOLATION"},
+	{1700, "RPC_S_INVALID_STRING_BINDING"},
+	{1701, "RPC_S_WRONG_KIND_OF_BINDING"},
+	{1702, "RPC_S_INVALID_BINDING"},
+	{1703, "RPC_S_PROTSEQ_NOT_SUPPORTED"},
+	{1704, "RPC_S_INVALID_RPC_PROTSEQ"},
+	{1705, "RPC_S_INVALID_STRING_UUID"},
+	{1706, "RPC_S_INVALID_ENDPOINT_FORMAT"},
+	{1707, "RPC_S_INVALID_NET_ADDR"},
+	{1708, "RPC_S_NO_ENDPOINT_FOUND"},
+	{1709, "RPC_S_INVALID_TIMEOUT"},
+	{1710, "RPC_S_OBJECT_NOT_FOUND"},
+	{1711, "RPC_S_ALREADY_REGISTERED"},
+	{1712, "RPC_S_TYPE_ALREADY_REGISTERED"},
+	{1713, "RPC_S_ALREADY_LISTENING"},
+	{1714, "RPC_S_NO_PROTSEQS_REGISTERED"},
+	{1715, "RPC_S_NOT_LISTENING"},
+	{1716, "RPC_S_UNKNOWN_MGR_TYPE"},
+	{1717, "RPC_S_UNKNOWN_IF"},
+	{1718, "RPC_S_NO_BINDINGS"},
+	{1719, "RPC_S_NO_PROTSEQS"},
+	{1720, "RPC_S_CANT_CREATE_ENDPOINT"},
+	{1721, "RPC_S_OUT_OF_RESOURCES"},
+	{1722, "RPC_S_SERVER_UNAVAILABLE"},
+	{1723, "RPC_S_SERVER_TOO_BUSY"},
+	{1724, "RPC_S_INVALID_NETWORK_OPTIONS"},
+	{1725, "RPC_S_NO_CALL_ACTIVE"},
+	{1726, "RPC_S_CALL_FAILED"},
+	{1727, "RPC_S_CALL_FAILED_DNE"},
+	{1728, "RPC_S_PROTOCOL_ERROR"},
+	{1729, "RPC_S_PROXY_ACCESS_DENIED"},
+	{1730, "RPC_S_UNSUPPORTED_TRANS_SYN"},
+	{1732, "RPC_S_UNSUPPORTED_TYPE"},
+	{1733, "RPC_S_INVALID_TAG"},
+	{1734, "RPC_S_INVALID_BOUND"},
+	{1735, "RPC_S_NO_ENTRY_NAME"},
+	{1736, "RPC_S_INVALID_NAME_SYNTAX"},
+	{1737, "RPC_S_UNSUPPORTED_NAME_SYNTAX"},
+	{1739, "RPC_S_UUID_NO_ADDRESS"},
+	{1740, "RPC_S_DUPLICATE_ENDPOINT"},
+	{1741, "RPC_S_UNKNOWN_AUTHN_TYPE"},
+	{1742, "RPC_S_MAX_CALLS_TOO_SMALL"},
+	{1743, "RPC_S_STRING_TOO_LONG"},
+	{1744, "RPC_S_PROTSEQ_NOT_FOUND"},
+	{1745, "RPC_S_PROCNUM_OUT_OF_RANGE"},
+	{1746, "RPC_S_BINDING_HAS_NO_AUTH"},
+	{1747, "RPC_S_UNKNOWN_AUTHN_SERVICE"},
+	{1748, "RPC_S_UNKNOWN_AUTHN_LEVEL"},
+	{1749, "RPC_S_INVALID_AUTH_IDENTITY"},
+	{1750, "RPC_S_UNKNOWN_AUTHZ_SERVICE"},
+	{1751, "EPT_S_INVALID_ENTRY"},
+	{1752, "EPT_S_CANT_PERFORM_OP"},
+	{1753, "EPT_S_NOT_REGISTERED"},
+	{1754, "RPC_S_NOTHING_TO_EXPORT"},
+	{1755, "RPC_S_INCOMPLETE_NAME"},
+	{1756, "RPC_S_INVALID_VERS_OPTION"},
+	{1757, "RPC_S_NO_MORE_MEMBERS"},
+	{1758, "RPC_S_NOT_ALL_OBJS_UNEXPORTED"},
+	{1759, "RPC_S_INTERFACE_NOT_FOUND"},
+	{1760, "RPC_S_ENTRY_ALREADY_EXISTS"},
+	{1761, "RPC_S_ENTRY_NOT_FOUND"},
+	{1762, "RPC_S_NAME_SERVICE_UNAVAILABLE"},
+	{1763, "RPC_S_INVALID_NAF_ID"},
+	{1764, "RPC_S_CANNOT_SUPPORT"},
+	{1765, "RPC_S_NO_CONTEXT_AVAILABLE"},
+	{1766, "RPC_S_INTERNAL_ERROR"},
+	{1767, "RPC_S_ZERO_DIVIDE"},
+	{1768, "RPC_S_ADDRESS_ERROR"},
+	{1769, "RPC_S_FP_DIV_ZERO"},
+	{1770, "RPC_S_FP_UNDERFLOW"},
+	{1771, "RPC_S_FP_OVERFLOW"},
+	{1772, "RPC_X_NO_MORE_ENTRIES"},
+	{1773, "RPC_X_SS_CHAR_TRANS_OPEN_FAIL"},
+	{1774, "RPC_X_SS_CHAR_TRANS_SHORT_FILE"},
+	{1775, "RPC_X_SS_IN_NULL_CONTEXT"},
+	{1777, "RPC_X_SS_CONTEXT_DAMAGED"},
+	{1778, "RPC_X_SS_HANDLES_MISMATCH"},
+	{1779, "RPC_X_SS_CANNOT_GET_CALL_HANDLE"},
+	{1780, "RPC_X_NULL_REF_POINTER"},
+	{1781, "RPC_X_ENUM_VALUE_OUT_OF_RANGE"},
+	{1782, "RPC_X_BYTE_COUNT_TOO_SMALL"},
+	{1783, "RPC_X_BAD_STUB_DATA"},
+	{1784, "ERROR_INVALID_USER_BUFFER"},
+	{1785, "ERROR_UNRECOGNIZED_MEDIA"},
+	{1786, "ERROR_NO_TRUST_LSA_SECRET"},
+	{1787, "ERROR_NO_TRUST_SAM_ACCOUNT"},
+	{1788, "ERROR_TRUSTED_DOMAIN_FAILURE"},
+	{1789, "ERROR_TRUSTED_RELATIONSHIP_FAILURE"},
+	{1790, "ERROR_TRUST_FAILURE"},
+	{1791, "RPC_S_CALL_IN_PROGRESS"},
+	{1792, "ERROR_NETLOGON_NOT_STARTED"},
+	{1793, "ERROR_ACCOUNT_EXPIRED"},
+	{1794, "ERROR_REDIRECTOR_HAS_OPEN_HANDLES"},
+	{1795, "ERROR_PRINTER_DRIVER_ALREADY_INSTALLED"},
+	{1796, "ERROR_UNKNOWN_PORT"},
+	{1797, "ERROR_UNKNOWN_PRINTER_DRIVER"},
+	{1798, "ERROR_UNKNOWN_PRINTPROCESSOR"},
+	{1799, "ERROR_INVALID_SEPARATOR_FILE"},
+	{1800, "ERROR_INVALID_PRIORITY"},
+	{1801, "ERROR_INVALID_PRINTER_NAME"},
+	{1802, "ERROR_PRINTER_ALREADY_EXISTS"},
+	{1803, "ERROR_INVALID_PRINTER_COMMAND"},
+	{1804, "ERROR_INVALID_DATATYPE"},
+	{1805, "ERROR_INVALID_ENVIRONMENT"},
+	{1806, "RPC_S_NO_MORE_BINDINGS"},
+	{1807, "ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT"},
+	{1808, "ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT"},
+	{1809, "ERROR_NOLOGON_SERVER_TRUST_ACCOUNT"},
+	{1810, "ERROR_DOMAIN_TRUST_INCONSISTENT"},
+	{1811, "ERROR_SERVER_HAS_OPEN_HANDLES"},
+	{1812, "ERROR_RESOURCE_DATA_NOT_FOUND"},
+	{1813, "ERROR_RESOURCE_TYPE_NOT_FOUND"},
+	{1814, "ERROR_RESOURCE_NAME_NOT_FOUND"},
+	{1815, "ERROR_RESOURCE_LANG_NOT_FOUND"},
+	{1816, "ERROR_NOT_ENOUGH_QUOTA"},
+	{1817, "RPC_S_NO_INTERFACES"},
+	{1818, "RPC_S_CALL_CANCELLED"},
+	{1819, "RPC_S_BINDING_INCOMPLETE"},
+	{1820, "RPC_S_COMM_FAILURE"},
+	{1821, "RPC_S_UNSUPPORTED_AUTHN_LEVEL"},
+	{1822, "RPC_S_NO_PRINC_NAME"},
+	{1823, "RPC_S_NOT_RPC_ERROR"},
+	{1824, "RPC_S_UUID_LOCAL_ONLY"},
+	{1825, "RPC_S_SEC_PKG_ERROR"},
+	{1826, "RPC_S_NOT_CANCELLED"},
+	{1827, "RPC_X_INVALID_ES_ACTION"},
+	{1828, "RPC_X_WRONG_ES_VERSION"},
+	{1829, "RPC_X_WRONG_STUB_VERSION"},
+	{1830, "RPC_X_INVALID_PIPE_OBJECT"},
+	{1831, "RPC_X_WRONG_PIPE_ORDER"},
+	{1832, "RPC_X_WRONG_PIPE_VERSION"},
+	{1833, "RPC_S_COOKIE_AUTH_FAILED"},
+	{1834, "RPC_S_DO_NOT_DISTURB"},
+	{1835, "RPC_S_SYSTEM_HANDLE_COUNT_EXCEEDED"},
+	{1836, "RPC_S_SYSTEM_HANDLE_TYPE_MISMATCH"},
+	{1898, "RPC_S_GROUP_MEMBER_NOT_FOUND"},
+	{1899, "EPT_S_CANT_CREATE"},
+	{1900, "RPC_S_INVALID_OBJECT"},
+	{1901, "ERROR_INVALID_TIME"},
+	{1902, "ERROR_INVALID_FORM_NAME"},
+	{1903, "ERROR_INVALID_FORM_SIZE"},
+	{1904, "ERROR_ALREADY_WAITING"},
+	{1905, "ERROR_PRINTER_DELETED"},
+	{1906, "ERROR_INVALID_PRINTER_STATE"},
+	{1907, "ERROR_PASSWORD_MUST_CHANGE"},
+	{1908, "ERROR_DOMAIN_CONTROLLER_NOT_FOUND"},
+	{1909, "ERROR_ACCOUNT_LOCKED_OUT"},
+	{1910, "OR_INVALID_OXID"},
+	{1911, "OR_INVALID_OID"},
+	{1912, "OR_INVALID_SET"},
+	{1913, "RPC_S_SEND_INCOMPLETE"},
+	{1914, "RPC_S_INVALID_ASYNC_HANDLE"},
+	{1915, "RPC_S_INVALID_ASYNC_CALL"},
+	{1916, "RPC_X_PIPE_CLOSED"},
+	{1917, "RPC_X_PIPE_DISCIPLINE_ERROR"},
+	{1918, "RPC_X_PIPE_EMPTY"},
+	{1919, "ERROR_NO_SITENAME"},
+	{1920, "ERROR_CANT_ACCESS_FILE"},
+	{1921, "ERROR_CANT_RESOLVE_FILENAME"},
+	{1922, "RPC_S_ENTRY_TYPE_MISMATCH"},
+	{1923, "RPC_S_NOT_ALL_OBJS_EXPORTED"},
+	{1924, "RPC_S_INTERFACE_NOT_EXPORTED"},
+	{1925, "RPC_S_PROFILE_NOT_ADDED"},
+	{1926, "RPC_S_PRF_ELT_NOT_ADDED"},
+	{1927, "RPC_S_PRF_ELT_NOT_REMOVED"},
+	{1928, "RPC_S_GRP_ELT_NOT_ADDED"},
+	{1929, "RPC_S_GRP_ELT_NOT_REMOVED"},
+	{1930, "ERROR_KM_DRIVER_BLOCKED"},
+	{1931, "ERROR_CONTEXT_EXPIRED"},
+	{1932, "ERROR_PER_USER_TRUST_QUOTA_EXCEEDED"},
+	{1933, "ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED"},
+	{1934, "ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED"},
+	{1935, "ERROR_AUTHENTICATION_FIREWALL_FAILED"},
+	{1936, "ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED"},
+	{1937, "ERROR_NTLM_BLOCKED"},
+	{1938, "ERROR_PASSWORD_CHANGE_REQUIRED"},
+	{1939, "ERROR_LOST_MODE_LOGON_RESTRICTION"},
+	{2000, "ERROR_INVALID_PIXEL_FORMAT"},
+	{2001, "ERROR_BAD_DRIVER"},
+	{2002, "ERROR_INVALID_WINDOW_STYLE"},
+	{2003, "ERROR_METAFILE_NOT_SUPPORTED"},
+	{2004, "ERROR_TRANSFORM_NOT_SUPPORTED"},
+	{2005, "ERROR_CLIPPING_NOT_SUPPORTED"},
+	{2010, "ERROR_INVALID_CMM"},
+	{2011, "ERROR_INVALID_PROFILE"},
+	{2012, "ERROR_TAG_NOT_FOUND"},
+	{2013, "ERROR_TAG_NOT_PRESENT"},
+	{2014, "ERROR_DUPLICATE_TAG"},
+	{2015, "ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE"},
+	{2016, "ERROR_PROFILE_NOT_FOUND"},
+	{2017, "ERROR_INVALID_COLORSPACE"},
+	{2018, "ERROR_ICM_NOT_ENABLED"},
+	{2019, "ERROR_DELETING_ICM_XFORM"},
+	{2020, "ERROR_INVALID_TRANSFORM"},
+	{2021, "ERROR_COLORSPACE_MISMATCH"},
+	{2022, "ERROR_INVALID_COLORINDEX"},
+	{2023, "ERROR_PROFILE_DOES_NOT_MATCH_DEVICE"},
+	{2108, "ERROR_CONNECTED_OTHER_PASSWORD"},
+	{2109, "ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT"},
+	{2202, "ERROR_BAD_USERNAME"},
+	{2250, "ERROR_NOT_CONNECTED"},
+	{2401, "ERROR_OPEN_FILES"},
+	{2402, "ERROR_ACTIVE_CONNECTIONS"},
+	{2404, "ERROR_DEVICE_IN_USE"},
+	{3000, "ERROR_UNKNOWN_PRINT_MONITOR"},
+	{3001, "ERROR_PRINTER_DRIVER_IN_USE"},
+	{3002, "ERROR_SPOOL_FILE_NOT_FOUND"},
+	{3003, "ERROR_SPL_NO_STARTDOC"},
+	{3004, "ERROR_SPL_NO_ADDJOB"},
+	{3005, "ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED"},
+	{3006, "ERROR_PRINT_MONITOR_ALREADY_INSTALLED"},
+	{3007, "ERROR_INVALID_PRINT_MONITOR"},
+	{3008, "ERROR_PRINT_MONITOR_IN_USE"},
+	{3009, "ERROR_PRINTER_HAS_JOBS_QUEUED"},
+	{3010, "ERROR_SUCCESS_REBOOT_REQUIRED"},
+	{3011, "ERROR_SUCCESS_RESTART_REQUIRED"},
+	{3012, "ERROR_PRINTER_NOT_FOUND"},
+	{3013, "ERROR_PRINTER_DRIVER_WARNED"},
+	{3014, "ERROR_PRINTER_DRIVER_BLOCKED"},
+	{3015, "ERROR_PRINTER_DRIVER_PACKAGE_IN_USE"},
+	{3016, "ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND"},
+	{3017, "ERROR_FAIL_REBOOT_REQUIRED"},
+	{3018, "ERROR_FAIL_REBOOT_INITIATED"},
+	{3019, "ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED"},
+	{3020, "ERROR_PRINT_JOB_RESTART_REQUIRED"},
+	{3021, "ERROR_INVALID_PRINTER_DRIVER_MANIFEST"},
+	{3022, "ERROR_PRINTER_NOT_SHAREABLE"},
+	{3050, "ERROR_REQUEST_PAUSED"},
+	{3060, "ERROR_APPEXEC_CONDITION_NOT_SATISFIED"},
+	{3061, "ERROR_APPEXEC_HANDLE_INVALIDATED"},
+	{3062, "ERROR_APPEXEC_INVALID_HOST_GENERATION"},
+	{3063, "ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION"},
+	{3064, "ERROR_APPEXEC_INVALID_HOST_STATE"},
+	{3065, "ERROR_APPEXEC_NO_DONOR"},
+	{3066, "ERROR_APPEXEC_HOST_ID_MISMATCH"},
+	{3067, "ERROR_APPEXEC_UNKNOWN_USER"},
+	{3950, "ERROR_IO_REISSUE_AS_CACHED"},
+	{4000, "ERROR_WINS_INTERNAL"},
+	{4001, "ERROR_CAN_NOT_DEL_LOCAL_WINS"},
+	{4002, "ERROR_STATIC_INIT"},
+	{4003, "ERROR_INC_BACKUP"},
+	{4004, "ERROR_FULL_BACKUP"},
+	{4005, "ERROR_REC_NON_EXISTENT"},
+	{4006, "ERROR_RPL_NOT_ALLOWED"},
+	{4050, "PEERDIST_ERROR_CONTENTINFO_VERSION_UNSUPPORTED"},
+	{4051, "PEERDIST_ERROR_CANNOT_PARSE_CONTENTINFO"},
+	{4052, "PEERDIST_ERROR_MISSING_DATA"},
+	{4053, "PEERDIST_ERROR_NO_MORE"},
+	{4054, "PEERDIST_ERROR_NOT_INITIALIZED"},
+	{4055, "PEERDIST_ERROR_ALREADY_INITIALIZED"},
+	{4056, "PEERDIST_ERROR_SHUTDOWN_IN_PROGRESS"},
+	{4057, "PEERDIST_ERROR_INVALIDATED"},
+	{4058, "PEERDIST_ERROR_ALREADY_EXISTS"},
+	{4059, "PEERDIST_ERROR_OPERATION_NOTFOUND"},
+	{4060, "PEERDIST_ERROR_ALREADY_COMPLETED"},
+	{4061, "PEERDIST_ERROR_OUT_OF_BOUNDS"},
+	{4062, "PEERDIST_ERROR_VERSION_UNSUPPORTED"},
+	{4063, "PEERDIST_ERROR_INVALID_CONFIGURATION"},
+	{4064, "PEERDIST_ERROR_NOT_LICENSED"},
+	{4065, "PEERDIST_ERROR_SERVICE_UNAVAILABLE"},
+	{4066, "PEERDIST_ERROR_TRUST_FAILURE"},
+	{4100, "ERROR_DHCP_ADDRESS_CONFLICT"},
+	{4200, "ERROR_WMI_GUID_NOT_FOUND"},
+	{4201, "ERROR_WMI_INSTANCE_NOT_FOUND"},
+	{4202, "ERROR_WMI_ITEMID_NOT_FOUND"},
+	{4203, "ERROR_WMI_TRY_AGAIN"},
+	{4204, "ERROR_WMI_DP_NOT_FOUND"},
+	{4205, "ERROR_WMI_UNRESOLVED_INSTANCE_REF"},
+	{4206, "ERROR_WMI_ALREADY_ENABLED"},
+	{4207, "ERROR_WMI_GUID_DISCONNECTED"},
+	{4208, "ERROR_WMI_SERVER_UNAVAILABLE"},
+	{4209, "ERROR_WMI_DP_FAILED"},
+	{4210, "ERROR_WMI_INVALID_MOF"},
+	{4211, "ERROR_WMI_INVALID_REGINFO"},
+	{4212, "ERROR_WMI_ALREADY_DISABLED"},
+	{4213, "ERROR_WMI_READ_ONLY"},
+	{4214, "ERROR_WMI_SET_FAILURE"},
+	{4250, "ERROR_NOT_APPCONTAINER"},
+	{4251, "ERROR_APPCONTAINER_REQUIRED"},
+	{4252, "ERROR_NOT_SUPPORTED_IN_APPCONTAINER"},
+	{4253, "ERROR_INVALID_PACKAGE_SID_LENGTH"},
+	{4300, "ERROR_INVALID_MEDIA"},
+	{4301, "ERROR_INVALID_LIBRARY"},
+	{4302, "ERROR_INVALID_MEDIA_POOL"},
+	{4303, "ERROR_DRIVE_MEDIA_MISMATCH"},
+	{4304, "ERROR_MEDIA_OFFLINE"},
+	{4305, "ERROR_LIBRARY_OFFLINE"},
+	{4306, "ERROR_EMPTY"},
+	{4307, "ERROR_NOT_EMPTY"},
+	{4308, "ERROR_MEDIA_UNAVAILABLE"},
+	{4309, "ERROR_RESOURCE_DISABLED"},
+	{4310, "ERROR_INVALID_CLEANER"},
+	{4311, "ERROR_UNABLE_TO_CLEAN"},
+	{4312, "ERROR_OBJECT_NOT_FOUND"},
+	{4313, "ERROR_DATABASE_FAILURE"},
+	{4314, "ERROR_DATABASE_FULL"},
+	{4315, "ERROR_MEDIA_INCOMPATIBLE"},
+	{4316, "ERROR_RESOURCE_NOT_PRESENT"},
+	{4317, "ERROR_INVALID_OPERATION"},
+	{4318, "ERROR_MEDIA_NOT_AVAILABLE"},
+	{4319, "ERROR_DEVICE_NOT_AVAILABLE"},
+	{4320, "ERROR_REQUEST_REFUSED"},
+	{4321, "ERROR_INVALID_DRIVE_OBJECT"},
+	{4322, "ERROR_LIBRARY_FULL"},
+	{4323, "ERROR_MEDIUM_NOT_ACCESSIBLE"},
+	{4324, "ERROR_UNABLE_TO_LOAD_MEDIUM"},
+	{4325, "ERROR_UNABLE_TO_INVENTORY_DRIVE"},
+	{4326, "ERROR_UNABLE_TO_INVENTORY_SLOT"},
+	{4327, "ERROR_UNABLE_TO_INVENTORY_TRANSPORT"},
+	{4328, "ERROR_TRANSPORT_FULL"},
+	{4329, "ERROR_CONTROLLING_IEPORT"},
+	{4330, "ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA"},
+	{4331, "ERROR_CLEANER_SLOT_SET"},
+	{4332, "ERROR_CLEANER_SLOT_NOT_SET"},
+	{4333, "ERROR_CLEANER_CARTRIDGE_SPENT"},
+	{4334, "ERROR_UNEXPECTED_OMID"},
+	{4335, "ERROR_CANT_DELETE_LAST_ITEM"},
+	{4336, "ERROR_MESSAGE_EXCEEDS_MAX_SIZE"},
+	{4337, "ERROR_VOLUME_CONTAINS_SYS_FILES"},
+	{4338, "ERROR_INDIGENOUS_TYPE"},
+	{4339, "ERROR_NO_SUPPORTING_DRIVES"},
+	{4340, "ERROR_CLEANER_CARTRIDGE_INSTALLED"},
+	{4341, "ERROR_IEPORT_FULL"},
+	{4350, "ERROR_FILE_OFFLINE"},
+	{4351, "ERROR_REMOTE_STORAGE_NOT_ACTIVE"},
+	{4352, "ERROR_REMOTE_STORAGE_MEDIA_ERROR"},
+	{4390, "ERROR_NOT_A_REPARSE_POINT"},
+	{4391, "ERROR_REPARSE_ATTRIBUTE_CONFLICT"},
+	{4392, "ERROR_INVALID_REPARSE_DATA"},
+	{4393, "ERROR_REPARSE_TAG_INVALID"},
+	{4394, "ERROR_REPARSE_TAG_MISMATCH"},
+	{4395, "ERROR_REPARSE_POINT_ENCOUNTERED"},
+	{4400, "ERROR_APP_DATA_NOT_FOUND"},
+	{4401, "ERROR_APP_DATA_EXPIRED"},
+	{4402, "ERROR_APP_DATA_CORRUPT"},
+	{4403, "ERROR_APP_DATA_LIMIT_EXCEEDED"},
+	{4404, "ERROR_APP_DATA_REBOOT_REQUIRED"},
+	{4420, "ERROR_SECUREBOOT_ROLLBACK_DETECTED"},
+	{4421, "ERROR_SECUREBOOT_POLICY_VIOLATION"},
+	{4422, "ERROR_SECUREBOOT_INVALID_POLICY"},
+	{4423, "ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND"},
+	{4424, "ERROR_SECUREBOOT_POLICY_NOT_SIGNED"},
+	{4425, "ERROR_SECUREBOOT_NOT_ENABLED"},
+	{4426, "ERROR_SECUREBOOT_FILE_REPLACED"},
+	{4427, "ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED"},
+	{4428, "ERROR_SECUREBOOT_POLICY_UNKNOWN"},
+	{4429, "ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION"},
+	{4430, "ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH"},
+	{4431, "ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED"},
+	{4432, "ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH"},
+	{4433, "ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING"},
+	{4434, "ERROR_SECUREBOOT_NOT_BASE_POLICY"},
+	{4435, "ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY"},
+	{4440, "ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED"},
+	{4441, "ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED"},
+	{4442, "ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED"},
+	{4443, "ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED"},
+	{4444, "ERROR_ALREADY_HAS_STREAM_ID"},
+	{4445, "ERROR_SMR_GARBAGE_COLLECTION_REQUIRED"},
+	{4446, "ERROR_WOF_WIM_HEADER_CORRUPT"},
+	{4447, "ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT"},
+	{4448, "ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT"},
+	{4500, "ERROR_VOLUME_NOT_SIS_ENABLED"},
+	{4550, "ERROR_SYSTEM_INTEGRITY_ROLLBACK_DETECTED"},
+	{4551, "ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION"},
+	{4552, "ERROR_SYSTEM_INTEGRITY_INVALID_POLICY"},
+	{4553, "ERROR_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED"},
+	{4554, "ERROR_SYSTEM_INTEGRITY_TOO_MANY_POLICIES"},
+	{4555, "ERROR_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED"},
+	{4560, "ERROR_VSM_NOT_INITIALIZED"},
+	{4561, "ERROR_VSM_DMA_PROTECTION_NOT_IN_USE"},
+	{4570, "ERROR_PLATFORM_MANIFEST_NOT_AUTHORIZED"},
+	{4571, "ERROR_PLATFORM_MANIFEST_INVALID"},
+	{4572, "ERROR_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED"},
+	{4573, "ERROR_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED"},
+	{4574, "ERROR_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND"},
+	{4575, "ERROR_PLATFORM_MANIFEST_NOT_ACTIVE"},
+	{4576, "ERROR_PLATFORM_MANIFEST_NOT_SIGNED"},
+	{5001, "ERROR_DEPENDENT_RESOURCE_EXISTS"},
+	{5002, "ERROR_DEPENDENCY_NOT_FOUND"},
+	{5003, "ERROR_DEPENDENCY_ALREADY_EXISTS"},
+	{5004, "ERROR_RESOURCE_NOT_ONLINE"},
+	{5005, "ERROR_HOST_NODE_NOT_AVAILABLE"},
+	{5006, "ERROR_RESOURCE_NOT_AVAILABLE"},
+	{5007, "ERROR_RESOURCE_NOT_FOUND"},
+	{5008, "ERROR_SHUTDOWN_CLUSTER"},
+	{5009, "ERROR_CANT_EVICT_ACTIVE_NODE"},
+	{5010, "ERROR_OBJECT_ALREADY_EXISTS"},
+	{5011, "ERROR_OBJECT_IN_LIST"},
+	{5012, "ERROR_GROUP_NOT_AVAILABLE"},
+	{5013, "ERROR_GROUP_NOT_FOUND"},
+	{5014, "ERROR_GROUP_NOT_ONLINE"},
+	{5015, "ERROR_HOST_NODE_NOT_RESOURCE_OWNER"},
+	{5016, "ERROR_HOST_NODE_NOT_GROUP_OWNER"},
+	{5017, "ERROR_RESMON_CREATE_FAILED"},
+	{5018, "ERROR_RESMON_ONLINE_FAILED"},
+	{5019, "ERROR_RESOURCE_ONLINE"},
+	{5020, "ERROR_QUORUM_RESOURCE"},
+	{5021, "ERROR_NOT_QUORUM_CAPABLE"},
+	{5022, "ERROR_CLUSTER_SHUTTING_DOWN"},
+	{5023, "ERROR_INVALID_STATE"},
+	{5024, "ERROR_RESOURCE_PROPERTIES_STORED"},
+	{5025, "ERROR_NOT_QUORUM_CLASS"},
+	{5026, "ERROR_CORE_RESOURCE"},
+	{5027, "ERROR_QUORUM_RESOURCE_ONLINE_FAILED"},
+	{5028, "ERROR_QUORUMLOG_OPEN_FAILED"},
+	{5029, "ERROR_CLUSTERLOG_CORRUPT"},
+	{5030, "ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE"},
+	{5031, "ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE"},
+	{5032, "ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND"},
+	{5033, "ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE"},
+	{5034, "ERROR_QUORUM_OWNER_ALIVE"},
+	{5035, "ERROR_NETWORK_NOT_AVAILABLE"},
+	{5036, "ERROR_NODE_NOT_AVAILABLE"},
+	{5037, "ERROR_ALL_NODES_NOT_AVAILABLE"},
+	{5038, "ERROR_RESOURCE_FAILED"},
+	{5039, "ERROR_CLUSTER_INVALID_NODE"},
+	{5040, "ERROR_CLUSTER_NODE_EXISTS"},
+	{5041, "ERROR_CLUSTER_JOIN_IN_PROGRESS"},
+	{5042, "ERROR_CLUSTER_NODE_NOT_FOUND"},
+	{5043, "ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND"},
+	{5044, "ERROR_CLUSTER_NETWORK_EXISTS"},
+	{5045, "ERROR_CLUSTER_NETWORK_NOT_FOUND"},
+	{5046, "ERROR_CLUSTER_NETINTERFACE_EXISTS"},
+	{5047, "ERROR_CLUSTER_NETINTERFACE_NOT_FOUND"},
+	{5048, "ERROR_CLUSTER_INVALID_REQUEST"},
+	{5049, "ERROR_CLUSTER_INVALID_NETWORK_PROVIDER"},
+	{5050, "ERROR_CLUSTER_NODE_DOWN"},
+	{5051, "ERROR_CLUSTER_NODE_UNREACHABLE"},
+	{5052, "ERROR_CLUSTER_NODE_NOT_MEMBER"},
+	{5053, "ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS"},
+	{5054, "ERROR_CLUSTER_INVALID_NETWORK"},
+	{5056, "ERROR_CLUSTER_NODE_UP"},
+	{5057, "ERROR_CLUSTER_IPADDR_IN_USE"},
+	{5058, "ERROR_CLUSTER_NODE_NOT_PAUSED"},
+	{5059, "ERROR_CLUSTER_NO_SECURITY_CONTEXT"},
+	{5060, "ERROR_CLUSTER_NETWORK_NOT_INTERNAL"},
+	{5061, "ERROR_CLUSTER_NODE_ALREADY_UP"},
+	{5062, "ERROR_CLUSTER_NODE_ALREADY_DOWN"},
+	{5063, "ERROR_CLUSTER_NETWORK_ALREADY_ONLINE"},
+	{5064, "ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE"},
+	{5065, "ERROR_CLUSTER_NODE_ALREADY_MEMBER"},
+	{5066, "ERROR_CLUSTER_LAST_INTERNAL_NETWORK"},
+	{5067, "ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS"},
+	{5068, "ERROR_INVALID_OPERATION_ON_QUORUM"},
+	{5069, "ERROR_DEPENDENCY_NOT_ALLOWED"},
+	{5070, "ERROR_CLUSTER_NODE_PAUSED"},
+	{5071, "ERROR_NODE_CANT_HOST_RESOURCE"},
+	{5072, "ERROR_CLUSTER_NODE_NOT_READY"},
+	{5073, "ERROR_CLUSTER_NODE_SHUTTING_DOWN"},
+	{5074, "ERROR_CLUSTER_JOIN_ABORTED"},
+	{5075, "ERROR_CLUSTER_INCOMPATIBLE_VERSIONS"},
+	{5076, "ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED"},
+	{5077, "ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED"},
+	{5078, "ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND"},
+	{5079, "ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED"},
+	{5080, "ERROR_CLUSTER_RESNAME_NOT_FOUND"},
+	{5081, "ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED"},
+	{5082, "ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST"},
+	{5083, "ERROR_CLUSTER_DATABASE_SEQMISMATCH"},
+	{5084, "ERROR_RESMON_INVALID_STATE"},
+	{5085, "ERROR_CLUSTER_GUM_NOT_LOCKER"},
+	{5086, "ERROR_QUORUM_DISK_NOT_FOUND"},
+	{5087, "ERROR_DATABASE_BACKUP_CORRUPT"},
+	{5088, "ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT"},
+	{5089, "ERROR_RESOURCE_PROPERTY_UNCHANGEABLE"},
+	{5090, "ERROR_NO_ADMIN_ACCESS_POINT"},
+	{5890, "ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE"},
+	{5891, "ERROR_CLUSTER_QUORUMLOG_NOT_FOUND"},
+	{5892, "ERROR_CLUSTER_MEMBERSHIP_HALT"},
+	{5893, "ERROR_CLUSTER_INSTANCE_ID_MISMATCH"},
+	{5894, "ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP"},
+	{5895, "ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH"},
+	{5896, "ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP"},
+	{5897, "ERROR_CLUSTER_PARAMETER_MISMATCH"},
+	{5898, "ERROR_NODE_CANNOT_BE_CLUSTERED"},
+	{5899, "ERROR_CLUSTER_WRONG_OS_VERSION"},
+	{5900, "ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME"},
+	{5901, "ERROR_CLUSCFG_ALREADY_COMMITTED"},
+	{5902, "ERROR_CLUSCFG_ROLLBACK_FAILED"},
+	{5903, "ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT"},
+	{5904, "ERROR_CLUSTER_OLD_VERSION"},
+	{5905, "ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME"},
+	{5906, "ERROR_CLUSTER_NO_NET_ADAPTERS"},
+	{5907, "ERROR_CLUSTER_POISONED"},
+	{5908, "ERROR_CLUSTER_GROUP_MOVING"},
+	{5909, "ERROR_CLUSTER_RESOURCE_TYPE_BUSY"},
+	{5910, "ERROR_RESOURCE_CALL_TIMED_OUT"},
+	{5911, "ERROR_INVALID_CLUSTER_IPV6_ADDRESS"},
+	{5912, "ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION"},
+	{5913, "ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS"},
+	{5914, "ERROR_CLUSTER_PARTIAL_SEND"},
+	{5915, "ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION"},
+	{5916, "ERROR_CLUSTER_INVALID_STRING_TERMINATION"},
+	{5917, "ERROR_CLUSTER_INVALID_STRING_FORMAT"},
+	{5918, "ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS"},
+	{5919, "ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS"},
+	{5920, "ERROR_CLUSTER_NULL_DATA"},
+	{5921, "ERROR_CLUSTER_PARTIAL_READ"},
+	{5922, "ERROR_CLUSTER_PARTIAL_WRITE"},
+	{5923, "ERROR_CLUSTER_CANT_DESERIALIZE_DATA"},
+	{5924, "ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT"},
+	{5925, "ERROR_CLUSTER_NO_QUORUM"},
+	{5926, "ERROR_CLUSTER_INVALID_IPV6_NETWORK"},
+	{5927, "ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK"},
+	{5928, "ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP"},
+	{5929, "ERROR_DEPENDENCY_TREE_TOO_COMPLEX"},
+	{5930, "ERROR_EXCEPTION_IN_RESOURCE_CALL"},
+	{5931, "ERROR_CLUSTER_RHS_FAILED_INITIALIZATION"},
+	{5932, "ERROR_CLUSTER_NOT_INSTALLED"},
+	{5933, "ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE"},
+	{5934, "ERROR_CLUSTER_MAX_NODES_IN_CLUSTER"},
+	{5935, "ERROR_CLUSTER_TOO_MANY_NODES"},
+	{5936, "ERROR_CLUSTER_OBJECT_ALREADY_USED"},
+	{5937, "ERROR_NONCORE_GROUPS_FOUND"},
+	{5938, "ERROR_FILE_SHARE_RESOURCE_CONFLICT"},
+	{5939, "ERROR_CLUSTER_EVICT_INVALID_REQUEST"},
+	{5940, "ERROR_CLUSTER_SINGLETON_RESOURCE"},
+	{5941, "ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE"},
+	{5942, "ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED"},
+	{5943, "ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR"},
+	{5944, "ERROR_CLUSTER_GROUP_BUSY"},
+	{5945, "ERROR_CLUSTER_NOT_SHARED_VOLUME"},
+	{5946, "ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR"},
+	{5947, "ERROR_CLUSTER_SHARED_VOLUMES_IN_USE"},
+	{5948, "ERROR_CLUSTER_USE_SHARED_VOLUMES_API"},
+	{5949, "ERROR_CLUSTER_BACKUP_IN_PROGRESS"},
+	{5950, "ERROR_NON_CSV_PATH"},
+	{5951, "ERROR_CSV_VOLUME_NOT_LOCAL"},
+	{5952, "ERROR_CLUSTER_WATCHDOG_TERMINATING"},
+	{5953, "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES"},
+	{5954, "ERROR_CLUSTER_INVALID_NODE_WEIGHT"},
+	{5955, "ERROR_CLUSTER_RESOURCE_VETOED_CALL"},
+	{5956, "ERROR_RESMON_SYSTEM_RESOURCES_LACKING"},
+	{5957, "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION"},
+	{5958, "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE"},
+	{5959, "ERROR_CLUSTER_GROUP_QUEUED"},
+	{5960, "ERROR_CLUSTER_RESOURCE_LOCKED_STATUS"},
+	{5961, "ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED"},
+	{5962, "ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS"},
+	{5963, "ERROR_CLUSTER_DISK_NOT_CONNECTED"},
+	{5964, "ERROR_DISK_NOT_CSV_CAPABLE"},
+	{5965, "ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE"},
+	{5966, "ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED"},
+	{5967, "ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED"},
+	{5968, "ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES"},
+	{5969, "ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES"},
+	{5970, "ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE"},
+	{5971, "ERROR_CLUSTER_AFFINITY_CONFLICT"},
+	{5972, "ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE"},
+	{5973, "ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS"},
+	{5974, "ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED"},
+	{5975, "ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED"},
+	{5976, "ERROR_CLUSTER_UPGRADE_IN_PROGRESS"},
+	{5977, "ERROR_CLUSTER_UPGRADE_INCOMPLETE"},
+	{5978, "ERROR_CLUSTER_NODE_IN_GRACE_PERIOD"},
+	{5979, "ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT"},
+	{5980, "ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER"},
+	{5981, "ERROR_CLUSTER_RESOURCE_NOT_MONITORED"},
+	{5982, "ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED"},
+	{5983, "ERROR_CLUSTER_RESOURCE_IS_REPLICATED"},
+	{5984, "ERROR_CLUSTER_NODE_ISOLATED"},
+	{5985, "ERROR_CLUSTER_NODE_QUARANTINED"},
+	{5986, "ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED"},
+	{5987, "ERROR_CLUSTER_SPACE_DEGRADED"},
+	{5988, "ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED"},
+	{5989, "ERROR_CLUSTER_CSV_INVALID_HANDLE"},
+	{5990, "ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR"},
+	{5991, "ERROR_GROUPSET_NOT_AVAILABLE"},
+	{5992, "ERROR_GROUPSET_NOT_FOUND"},
+	{5993, "ERROR_GROUPSET_CANT_PROVIDE"},
+	{5994, "ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND"},
+	{5995, "ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY"},
+	{5996, "ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION"},
+	{5997, "ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS"},
+	{5998, "ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME"},
+	{5999, "ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE"},
+	{6000, "ERROR_ENCRYPTION_FAILED"},
+	{6001, "ERROR_DECRYPTION_FAILED"},
+	{6002, "ERROR_FILE_ENCRYPTED"},
+	{6003, "ERROR_NO_RECOVERY_POLICY"},
+	{6004, "ERROR_NO_EFS"},
+	{6005, "ERROR_WRONG_EFS"},
+	{6006, "ERROR_NO_USER_KEYS"},
+	{6007, "ERROR_FILE_NOT_ENCRYPTED"},
+	{6008, "ERROR_NOT_EXPORT_FORMAT"},
+	{6009, "ERROR_FILE_READ_ONLY"},
+	{6010, "ERROR_DIR_EFS_DISALLOWED"},
+	{6011, "ERROR_EFS_SERVER_NOT_TRUSTED"},
+	{6012, "ERROR_BAD_RECOVERY_POLICY"},
+	{6013, "ERROR_EFS_ALG_BLOB_TOO_BIG"},
+	{6014, "ERROR_VOLUME_NOT_SUPPORT_EFS"},
+	{6015, "ERROR_EFS_DISABLED"},
+	{6016, "ERROR_EFS_VERSION_NOT_SUPPORT"},
+	{6017, "ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE"},
+	{6018, "ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER"},
+	{6019, "ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE"},
+	{6020, "ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE"},
+	{6021, "ERROR_CS_ENCRYPTION_FILE_NOT_CSE"},
+	{6022, "ERROR_ENCRYPTION_POLICY_DENIES_OPERATION"},
+	{6023, "ERROR_WIP_ENCRYPTION_FAILED"},
+	{6118, "ERROR_NO_BROWSER_SERVERS_FOUND"},
+	{6200, "SCHED_E_SERVICE_NOT_LOCALSYSTEM"},
+	{6600, "ERROR_LOG_SECTOR_INVALID"},
+	{6601, "ERROR_LOG_SECTOR_PARITY_INVALID"},
+	{6602, "ERROR_LOG_SECTOR_REMAPPED"},
+	{6603, "ERROR_LOG_BLOCK_INCOMPLETE"},
+	{6604, "ERROR_LOG_INVALID_RANGE"},
+	{6605, "ERROR_LOG_BLOCKS_EXHAUSTED"},
+	{6606, "ERROR_LOG_READ_CONTEXT_INVALID"},
+	{6607, "ERROR_LOG_RESTART_INVALID"},
+	{6608, "ERROR_LOG_BLOCK_VERSION"},
+	{6609, "ERROR_LOG_BLOCK_INVALID"},
+	{6610, "ERROR_LOG_READ_MODE_INVALID"},
+	{6611, "ERROR_LOG_NO_RESTART"},
+	{6612, "ERROR_LOG_METADATA_CORRUPT"},
+	{6613, "ERROR_LOG_METADATA_INVALID"},
+	{6614, "ERROR_LOG_METADATA_INCONSISTENT"},
+	{6615, "ERROR_LOG_RESERVATION_INVALID"},
+	{6616, "ERROR_LOG_CANT_DELETE"},
+	{6617, "ERROR_LOG_CONTAINER_LIMIT_EXCEEDED"},
+	{6618, "ERROR_LOG_START_OF_LOG"},
+	{6619, "ERROR_LOG_POLICY_ALREADY_INSTALLED"},
+	{6620, "ERROR_LOG_POLICY_NOT_INSTALLED"},
+	{6621, "ERROR_LOG_POLICY_INVALID"},
+	{6622, "ERROR_LOG_POLICY_CONFLICT"},
+	{6623, "ERROR_LOG_PINNED_ARCHIVE_TAIL"},
+	{6624, "ERROR_LOG_RECORD_NONEXISTENT"},
+	{6625, "ERROR_LOG_RECORDS_RESERVED_INVALID"},
+	{6626, "ERROR_LOG_SPACE_RESERVED_INVALID"},
+	{6627, "ERROR_LOG_TAIL_INVALID"},
+	{6628, "ERROR_LOG_FULL"},
+	{6629, "ERROR_COULD_NOT_RESIZE_LOG"},
+	{6630, "ERROR_LOG_MULTIPLEXED"},
+	{6631, "ERROR_LOG_DEDICATED"},
+	{6632, "ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS"},
+	{6633, "ERROR_LOG_ARCHIVE_IN_PROGRESS"},
+	{6634, "ERROR_LOG_EPHEMERAL"},
+	{6635, "ERROR_LOG_NOT_ENOUGH_CONTAINERS"},
+	{6636, "ERROR_LOG_CLIENT_ALREADY_REGISTERED"},
+	{6637, "ERROR_LOG_CLIENT_NOT_REGISTERED"},
+	{6638, "ERROR_LOG_FULL_HANDLER_IN_PROGRESS"},
+	{6639, "ERROR_LOG_CONTAINER_READ_FAILED"},
+	{6640, "ERROR_LOG_CONTAINER_WRITE_FAILED"},
+	{6641, "ERROR_LOG_CONTAINER_OPEN_FAILED"},
+	{6642, "ERROR_LOG_CONTAINER_STATE_INVALID"},
+	{6643, "ERROR_LOG_STATE_INVALID"},
+	{6644, "ERROR_LOG_PINNED"},
+	{6645, "ERROR_LOG_METADATA_FLUSH_FAILED"},
+	{6646, "ERROR_LOG_INCONSISTENT_SECURITY"},
+	{6647, "ERROR_LOG_APPENDED_FLUSH_FAILED"},
+	{6648, "ERROR_LOG_PINNED_RESERVATION"},
+	{6700, "ERROR_INVALID_TRANSACTION"},
+	{6701, "ERROR_TRANSACTION_NOT_ACTIVE"},
+	{6702, "ERROR_TRANSACTION_REQUEST_NOT_VALID"},
+	{6703, "ERROR_TRANSACTION_NOT_REQUESTED"},
+	{6704, "ERROR_TRANSACTION_ALREADY_ABORTED"},
+	{6705, "ERROR_TRANSACTION_ALREADY_COMMITTED"},
+	{6706, "ERROR_TM_INITIALIZATION_FAILED"},
+	{6707, "ERROR_RESOURCEMANAGER_READ_ONLY"},
+	{6708, "ERROR_TRANSACTION_NOT_JOINED"},
+	{6709, "ERROR_TRANSACTION_SUPERIOR_EXISTS"},
+	{6710, "ERROR_CRM_PROTOCOL_ALREADY_EXISTS"},
+	{6711, "ERROR_TRANSACTION_PROPAGATION_FAILED"},
+	{6712, "ERROR_CRM_PROTOCOL_NOT_FOUND"},
+	{6713, "ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER"},
+	{6714, "ERROR_CURRENT_TRANSACTION_NOT_VALID"},
+	{6715, "ERROR_TRANSACTION_NOT_FOUND"},
+	{6716, "ERROR_RESOURCEMANAGER_NOT_FOUND"},
+	{6717, "ERROR_ENLISTMENT_NOT_FOUND"},
+	{6718, "ERROR_TRANSACTIONMANAGER_NOT_FOUND"},
+	{6719, "ERROR_TRANSACTIONMANAGER_NOT_ONLINE"},
+	{6720, "ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION"},
+	{6721, "ERROR_TRANSACTION_NOT_ROOT"},
+	{6722, "ERROR_TRANSACTION_OBJECT_EXPIRED"},
+	{6723, "ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED"},
+	{6724, "ERROR_TRANSACTION_RECORD_TOO_LONG"},
+	{6725, "ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED"},
+	{6726, "ERROR_TRANSACTION_INTEGRITY_VIOLATED"},
+	{6727, "ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH"},
+	{6728, "ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT"},
+	{6729, "ERROR_TRANSACTION_MUST_WRITETHROUGH"},
+	{6730, "ERROR_TRANSACTION_NO_SUPERIOR"},
+	{6731, "ERROR_HEURISTIC_DAMAGE_POSSIBLE"},
+	{6800, "ERROR_TRANSACTIONAL_CONFLICT"},
+	{6801, "ERROR_RM_NOT_ACTIVE"},
+	{6802, "ERROR_RM_METADATA_CORRUPT"},
+	{6803, "ERROR_DIRECTORY_NOT_RM"},
+	{6805, "ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE"},
+	{6806, "ERROR_LOG_RESIZE_INVALID_SIZE"},
+	{6807, "ERROR_OBJECT_NO_LONGER_EXISTS"},
+	{6808, "ERROR_STREAM_MINIVERSION_NOT_FOUND"},
+	{6809, "ERROR_STREAM_MINIVERSION_NOT_VALID"},
+	{6810, "ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION"},
+	{6811, "ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT"},
+	{6812, "ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS"},
+	{6814, "ERROR_REMOTE_FILE_VERSION_MISMATCH"},
+	{6815, "ERROR_HANDLE_NO_LONGER_VALID"},
+	{6816, "ERROR_NO_TXF_METADATA"},
+	{6817, "ERROR_LOG_CORRUPTION_DETECTED"},
+	{6818, "ERROR_CANT_RECOVER_WITH_HANDLE_OPEN"},
+	{6819, "ERROR_RM_DISCONNECTED"},
+	{6820, "ERROR_ENLISTMENT_NOT_SUPERIOR"},
+	{6821, "ERROR_RECOVERY_NOT_NEEDED"},
+	{6822, "ERROR_RM_ALREADY_STARTED"},
+	{6823, "ERROR_FILE_IDENTITY_NOT_PERSISTENT"},
+	{6824, "ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY"},
+	{6825, "ERROR_CANT_CROSS_RM_BOUNDARY"},
+	{6826, "ERROR_TXF_DIR_NOT_EMPTY"},
+	{6827, "ERROR_INDOUBT_TRANSACTIONS_EXIST"},
+	{6828, "ERROR_TM_VOLATILE"},
+	{6829, "ERROR_ROLLBACK_TIMER_EXPIRED"},
+	{6830, "ERROR_TXF_ATTRIBUTE_CORRUPT"},
+	{6831, "ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION"},
+	{6832, "ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED"},
+	{6833, "ERROR_LOG_GROWTH_FAILED"},
+	{6834, "ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE"},
+	{6835, "ERROR_TXF_METADATA_ALREADY_PRESENT"},
+	{6836, "ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET"},
+	{6837, "ERROR_TRANSACTION_REQUIRED_PROMOTION"},
+	{6838, "ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION"},
+	{6839, "ERROR_TRANSACTIONS_NOT_FROZEN"},
+	{6840, "ERROR_TRANSACTION_FREEZE_IN_PROGRESS"},
+	{6841, "ERROR_NOT_SNAPSHOT_VOLUME"},
+	{6842, "ERROR_NO_SAVEPOINT_WITH_OPEN_FILES"},
+	{6843, "ERROR_DATA_LOST_REPAIR"},
+	{6844, "ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION"},
+	{6845, "ERROR_TM_IDENTITY_MISMATCH"},
+	{6846, "ERROR_FLOATED_SECTION"},
+	{6847, "ERROR_CANNOT_ACCEPT_TRANSACTED_WORK"},
+	{6848, "ERROR_CANNOT_ABORT_TRANSACTIONS"},
+	{6849, "ERROR_BAD_CLUSTERS"},
+	{6850, "ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION"},
+	{6851, "ERROR_VOLUME_DIRTY"},
+	{6852, "ERROR_NO_LINK_TRACKING_IN_TRANSACTION"},
+	{6853, "ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION"},
+	{6854, "ERROR_EXPIRED_HANDLE"},
+	{6855, "ERROR_TRANSACTION_NOT_ENLISTED"},
+	{7001, "ERROR_CTX_WINSTATION_NAME_INVALID"},
+	{7002, "ERROR_CTX_INVALID_PD"},
+	{7003, "ERROR_CTX_PD_NOT_FOUND"},
+	{7004, "ERROR_CTX_WD_NOT_FOUND"},
+	{7005, "ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY"},
+	{7006, "ERROR_CTX_SERVICE_NAME_COLLISION"},
+	{7007, "ERROR_CTX_CLOSE_PENDING"},
+	{7008, "ERROR_CTX_NO_OUTBUF"},
+	{7009, "ERROR_CTX_MODEM_INF_NOT_FOUND"},
+	{7010, "ERROR_CTX_INVALID_MODEMNAME"},
+	{7011, "ERROR_CTX_MODEM_RESPONSE_ERROR"},
+	{7012, "ERROR_CTX_MODEM_RESPONSE_TIMEOUT"},
+	{7013, "ERROR_CTX_MODEM_RESPONSE_NO_CARRIER"},
+	{7014, "ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE"},
+	{7015, "ERROR_CTX_MODEM_RESPONSE_BUSY"},
+	{7016, "ERROR_CTX_MODEM_RESPONSE_VOICE"},
+	{7017, "ERROR_CTX_TD_ERROR"},
+	{7022, "ERROR_CTX_WINSTATION_NOT_FOUND"},
+	{7023, "ERROR_CTX_WINSTATION_ALREADY_EXISTS"},
+	{7024, "ERROR_CTX_WINSTATION_BUSY"},
+	{7025, "ERROR_CTX_BAD_VIDEO_MODE"},
+	{7035, "ERROR_CTX_GRAPHICS_INVALID"},
+	{7037, "ERROR_CTX_LOGON_DISABLED"},
+	{7038, "ERROR_CTX_NOT_CONSOLE"},
+	{7040, "ERROR_CTX_CLIENT_QUERY_TIMEOUT"},
+	{7041, "ERROR_CTX_CONSOLE_DISCONNECT"},
+	{7042, "ERROR_CTX_CONSOLE_CONNECT"},
+	{7044, "ERROR_CTX_SHADOW_DENIED"},
+	{7045, "ERROR_CTX_WINSTATION_ACCESS_DENIED"},
+	{7049, "ERROR_CTX_INVALID_WD"},
+	{7050, "ERROR_CTX_SHADOW_INVALID"},
+	{7051, "ERROR_CTX_SHADOW_DISABLED"},
+	{7052, "ERROR_CTX_CLIENT_LICENSE_IN_USE"},
+	{7053, "ERROR_CTX_CLIENT_LICENSE_NOT_SET"},
+	{7054, "ERROR_CTX_LICENSE_NOT_AVAILABLE"},
+	{7055, "ERROR_CTX_LICENSE_CLIENT_INVALID"},
+	{7056, "ERROR_CTX_LICENSE_EXPIRED"},
+	{7057, "ERROR_CTX_SHADOW_NOT_RUNNING"},
+	{7058, "ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE"},
+	{7059, "ERROR_ACTIVATION_COUNT_EXCEEDED"},
+	{7060, "ERROR_CTX_WINSTATIONS_DISABLED"},
+	{7061, "ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED"},
+	{7062, "ERROR_CTX_SESSION_IN_USE"},
+	{7063, "ERROR_CTX_NO_FORCE_LOGOFF"},
+	{7064, "ERROR_CTX_ACCOUNT_RESTRICTION"},
+	{7065, "ERROR_RDP_PROTOCOL_ERROR"},
+	{7066, "ERROR_CTX_CDM_CONNECT"},
+	{7067, "ERROR_CTX_CDM_DISCONNECT"},
+	{7068, "ERROR_CTX_SECURITY_LAYER_ERROR"},
+	{7069, "ERROR_TS_INCOMPATIBLE_SESSIONS"},
+	{7070, "ERROR_TS_VIDEO_SUBSYSTEM_ERROR"},
+	{8001, "FRS_ERR_INVALID_API_SEQUENCE"},
+	{8002, "FRS_ERR_STARTING_SERVICE"},
+	{8003, "FRS_ERR_STOPPING_SERVICE"},
+	{8004, "FRS_ERR_INTERNAL_API"},
+	{8005, "FRS_ERR_INTERNAL"},
+	{8006, "FRS_ERR_SERVICE_COMM"},
+	{8007, "FRS_ERR_INSUFFICIENT_PRIV"},
+	{8008, "FRS_ERR_AUTHENTICATION"},
+	{8009, "FRS_ERR_PARENT_INSUFFICIENT_PRIV"},
+	{8010, "FRS_ERR_PARENT_AUTHENTICATION"},
+	{8011, "FRS_ERR_CHILD_TO_PARENT_COMM"},
+	{8012, "FRS_ERR_PARENT_TO_CHILD_COMM"},
+	{8013, "FRS_ERR_SYSVOL_POPULATE"},
+	{8014, "FRS_ERR_SYSVOL_POPULATE_TIMEOUT"},
+	{8015, "FRS_ERR_SYSVOL_IS_BUSY"},
+	{8016, "FRS_ERR_SYSVOL_DEMOTE"},
+	{8017, "FRS_ERR_INVALID_SERVICE_PARAMETER"},
+	{8200, "ERROR_DS_NOT_INSTALLED"},
+	{8201, "ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY"},
+	{8202, "ERROR_DS_NO_ATTRIBUTE_OR_VALUE"},
+	{8203, "ERROR_DS_INVALID_ATTRIBUTE_SYNTAX"},
+	{8204, "ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED"},
+	{8205, "ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS"},
+	{8206, "ERROR_DS_BUSY"},
+	{8207, "ERROR_DS_UNAVAILABLE"},
+	{8208, "ERROR_DS_NO_RIDS_ALLOCATED"},
+	{8209, "ERROR_DS_NO_MORE_RIDS"},
+	{8210, "ERROR_DS_INCORRECT_ROLE_OWNER"},
+	{8211, "ERROR_DS_RIDMGR_INIT_ERROR"},
+	{8212, "ERROR_DS_OBJ_CLASS_VIOLATION"},
+	{8213, "ERROR_DS_CANT_ON_NON_LEAF"},
+	{8214, "ERROR_DS_CANT_ON_RDN"},
+	{8215, "ERROR_DS_CANT_MOD_OBJ_CLASS"},
+	{8216, "ERROR_DS_CROSS_DOM_MOVE_ERROR"},
+	{8217, "ERROR_DS_GC_NOT_AVAILABLE"},
+	{8218, "ERROR_SHARED_POLICY"},
+	{8219, "ERROR_POLICY_OBJECT_NOT_FOUND"},
+	{8220, "ERROR_POLICY_ONLY_IN_DS"},
+	{8221, "ERROR_PROMOTION_ACTIVE"},
+	{8222, "ERROR_NO_PROMOTION_ACTIVE"},
+	{8224, "ERROR_DS_OPERATIONS_ERROR"},
+	{8225, "ERROR_DS_PROTOCOL_ERROR"},
+	{8226, "ERROR_DS_TIMELIMIT_EXCEEDED"},
+	{8227, "ERROR_DS_SIZELIMIT_EXCEEDED"},
+	{8228, "ERROR_DS_ADMIN_LIMIT_EXCEEDED"},
+	{8229, "ERROR_DS_COMPARE_FALSE"},
+	{8230, "ERROR_DS_COMPARE_TRUE"},
+	{8231, "ERROR_DS_AUTH_METHOD_NOT_SUPPORTED"},
+	{8232, "ERROR_DS_STRONG_AUTH_REQUIRED"},
+	{8233, "ERROR_DS_INAPPROPRIATE_AUTH"},
+	{8234, "ERROR_DS_AUTH_UNKNOWN"},
+	{8235, "ERROR_DS_REFERRAL"},
+	{8236, "ERROR_DS_UNAVAILABLE_CRIT_EXTENSION"},
+	{8237, "ERROR_DS_CONFIDENTIALITY_REQUIRED"},
+	{8238, "ERROR_DS_INAPPROPRIATE_MATCHING"},
+	{8239, "ERROR_DS_CONSTRAINT_VIOLATION"},
+	{8240, "ERROR_DS_NO_SUCH_OBJECT"},
+	{8241, "ERROR_DS_ALIAS_PROBLEM"},
+	{8242, "ERROR_DS_INVALID_DN_SYNTAX"},
+	{8243, "ERROR_DS_IS_LEAF"},
+	{8244, "ERROR_DS_ALIAS_DEREF_PROBLEM"},
+	{8245, "ERROR_DS_UNWILLING_TO_PERFORM"},
+	{8246, "ERROR_DS_LOOP_DETECT"},
+	{8247, "ERROR_DS_NAMING_VIOLATION"},
+	{8248, "ERROR_DS_OBJECT_RESULTS_TOO_LARGE"},
+	{8249, "ERROR_DS_AFFECTS_MULTIPLE_DSAS"},
+	{8250, "ERROR_DS_SERVER_DOWN"},
+	{8251, "ERROR_DS_LOCAL_ERROR"},
+	{8252, "ERROR_DS_ENCODING_ERROR"},
+	{8253, "ERROR_DS_DECODING_ERROR"},
+	{8254, "ERROR_DS_FILTER_UNKNOWN"},
+	{8255, "ERROR_DS_PARAM_ERROR"},
+	{8256, "ERROR_DS_NOT_SUPPORTED"},
+	{8257, "ERROR_DS_NO_RESULTS_RETURNED"},
+	{8258, "ERROR_DS_CONTROL_NOT_FOUND"},
+	{8259, "ERROR_DS_CLIENT_LOOP"},
+	{8260, "ERROR_DS_REFERRAL_LIMIT_EXCEEDED"},
+	{8261, "ERROR_DS_SORT_CONTROL_MISSING"},
+	{8262, "ERROR_DS_OFFSET_RANGE_ERROR"},
+	{8263, "ERROR_DS_RIDMGR_DISABLED"},
+	{8301, "ERROR_DS_ROOT_MUST_BE_NC"},
+	{8302, "ERROR_DS_ADD_REPLICA_INHIBITED"},
+	{8303, "ERROR_DS_ATT_NOT_DEF_IN_SCHEMA"},
+	{8304, "ERROR_DS_MAX_OBJ_SIZE_EXCEEDED"},
+	{8305, "ERROR_DS_OBJ_STRING_NAME_EXISTS"},
+	{8306, "ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA"},
+	{8307, "ERROR_DS_RDN_DOESNT_MATCH_SCHEMA"},
+	{8308, "ERROR_DS_NO_REQUESTED_ATTS_FOUND"},
+	{8309, "ERROR_DS_USER_BUFFER_TO_SMALL"},
+	{8310, "ERROR_DS_ATT_IS_NOT_ON_OBJ"},
+	{8311, "ERROR_DS_ILLEGAL_MOD_OPERATION"},
+	{8312, "ERROR_DS_OBJ_TOO_LARGE"},
+	{8313, "ERROR_DS_BAD_INSTANCE_TYPE"},
+	{8314, "ERROR_DS_MASTERDSA_REQUIRED"},
+	{8315, "ERROR_DS_OBJECT_CLASS_REQUIRED"},
+	{8316, "ERROR_DS_MISSING_REQUIRED_ATT"},
+	{8317, "ERROR_DS_ATT_NOT_DEF_FOR_CLASS"},
+	{8318, "ERROR_DS_ATT_ALREADY_EXISTS"},
+	{8320, "ERROR_DS_CANT_ADD_ATT_VALUES"},
+	{8321, "ERROR_DS_SINGLE_VALUE_CONSTRAINT"},
+	{8322, "ERROR_DS_RANGE_CONSTRAINT"},
+	{8323, "ERROR_DS_ATT_VAL_ALREADY_EXISTS"},
+	{8324, "ERROR_DS_CANT_REM_MISSING_ATT"},
+	{8325, "ERROR_DS_CANT_REM_MISSING_ATT_VAL"},
+	{8326, "ERROR_DS_ROOT_CANT_BE_SUBREF"},
+	{8327, "ERROR_DS_NO_CHAINING"},
+	{8328, "ERROR_DS_NO_CHAINED_EVAL"},
+	{8329, "ERROR_DS_NO_PARENT_OBJECT"},
+	{8330, "ERROR_DS_PARENT_IS_AN_ALIAS"},
+	{8331, "ERROR_DS_CANT_MIX_MASTER_AND_REPS"},
+	{8332, "ERROR_DS_CHILDREN_EXIST"},
+	{8333, "ERROR_DS_OBJ_NOT_FOUND"},
+	{8334, "ERROR_DS_ALIASED_OBJ_MISSING"},
+	{8335, "ERROR_DS_BAD_NAME_SYNTAX"},
+	{8336, "ERROR_DS_ALIAS_POINTS_TO_ALIAS"},
+	{8337, "ERROR_DS_CANT_DEREF_ALIAS"},
+	{8338, "ERROR_DS_OUT_OF_SCOPE"},
+	{8339, "ERROR_DS_OBJECT_BEING_REMOVED"},
+	{8340, "ERROR_DS_CANT_DELETE_DSA_OBJ"},
+	{8341, "ERROR_DS_GENERIC_ERROR"},
+	{8342, "ERROR_DS_DSA_MUST_BE_INT_MASTER"},
+	{8343, "ERROR_DS_CLASS_NOT_DSA"},
+	{8344, "ERROR_DS_INSUFF_ACCESS_RIGHTS"},
+	{8345, "ERROR_DS_ILLEGAL_SUPERIOR"},
+	{8346, "ERROR_DS_ATTRIBUTE_OWNED_BY_SAM"},
+	{8347, "ERROR_DS_NAME_TOO_MANY_PARTS"},
+	{8348, "ERROR_DS_NAME_TOO_LONG"},
+	{8349, "ERROR_DS_NAME_VALUE_TOO_LONG"},
+	{8350, "ERROR_DS_NAME_UNPARSEABLE"},
+	{8351, "ERROR_DS_NAME_TYPE_UNKNOWN"},
+	{8352, "ERROR_DS_NOT_AN_OBJECT"},
+	{8353, "ERROR_DS_SEC_DESC_TOO_SHORT"},
+	{8354, "ERROR_DS_SEC_DESC_INVALID"},
+	{8355, "ERROR_DS_NO_DELETED_NAME"},
+	{8356, "ERROR_DS_SUBREF_MUST_HAVE_PARENT"},
+	{8357, "ERROR_DS_NCNAME_MUST_BE_NC"},
+	{8358, "ERROR_DS_CANT_ADD_SYSTEM_ONLY"},
+	{8359, "ERROR_DS_CLASS_MUST_BE_CONCRETE"},
+	{8360, "ERROR_DS_INVALID_DMD"},
+	{8361, "ERROR_DS_OBJ_GUID_EXISTS"},
+	{8362, "ERROR_DS_NOT_ON_BACKLINK"},
+	{8363, "ERROR_DS_NO_CROSSREF_FOR_NC"},
+	{8364, "ERROR_DS_SHUTTING_DOWN"},
+	{8365, "ERROR_DS_UNKNOWN_OPERATION"},
+	{8366, "ERROR_DS_INVALID_ROLE_OWNER"},
+	{8367, "ERROR_DS_COULDNT_CONTACT_FSMO"},
+	{8368, "ERROR_DS_CROSS_NC_DN_RENAME"},
+	{8369, "ERROR_DS_CANT_MOD_SYSTEM_ONLY"},
+	{8370, "ERROR_DS_REPLICATOR_ONLY"},
+	{8371, "ERROR_DS_OBJ_CLASS_NOT_DEFINED"},
+	{8372, "ERROR_DS_OBJ_CLASS_NOT_SUBCLASS"},
+	{8373, "ERROR_DS_NAME_REFERENCE_INVALID"},
+	{8374, "ERROR_DS_CROSS_REF_EXISTS"},
+	{8375, "ERROR_DS_CANT_DEL_MASTER_CROSSREF"},
+	{8376, "ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD"},
+	{8377, "ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX"},
+	{8378, "ERROR_DS_DUP_RDN"},
+	{8379, "ERROR_DS_DUP_OID"},
+	{8380, "ERROR_DS_DUP_MAPI_ID"},
+	{8381, "ERROR_DS_DUP_SCHEMA_ID_GUID"},
+	{8382, "ERROR_DS_DUP_LDAP_DISPLAY_NAME"},
+	{8383, "ERROR_DS_SEMANTIC_ATT_TEST"},
+	{8384, "ERROR_DS_SYNTAX_MISMATCH"},
+	{8385, "ERROR_DS_EXISTS_IN_MUST_HAVE"},
+	{8386, "ERROR_DS_EXISTS_IN_MAY_HAVE"},
+	{8387, "ERROR_DS_NONEXISTENT_MAY_HAVE"},
+	{8388, "ERROR_DS_NONEXISTENT_MUST_HAVE"},
+	{8389, "ERROR_DS_AUX_CLS_TEST_FAIL"},
+	{8390, "ERROR_DS_NONEXISTENT_POSS_SUP"},
+	{8391, "ERROR_DS_SUB_CLS_TEST_FAIL"},
+	{8392, "ERROR_DS_BAD_RDN_ATT_ID_SYNTAX"},
+	{8393, "ERROR_DS_EXISTS_IN_AUX_CLS"},
+	{8394, "ERROR_DS_EXISTS_IN_SUB_CLS"},
+	{8395, "ERROR_DS_EXISTS_IN_POSS_SUP"},
+	{8396, "ERROR_DS_RECALCSCHEMA_FAILED"},
+	{8397, "ERROR_DS_TREE_DELETE_NOT_FINISHED"},
+	{8398, "ERROR_DS_CANT_DELETE"},
+	{8399, "ERROR_DS_ATT_SCHEMA_REQ_ID"},
+	{8400, "ERROR_DS_BAD_ATT_SCHEMA_SYNTAX"},
+	{8401, "ERROR_DS_CANT_CACHE_ATT"},
+	{8402, "ERROR_DS_CANT_CACHE_CLASS"},
+	{8403, "ERROR_DS_CANT_REMOVE_ATT_CACHE"},
+	{8404, "ERROR_DS_CANT_REMOVE_CLASS_CACHE"},
+	{8405, "ERROR_DS_CANT_RETRIEVE_DN"},
+	{8406, "ERROR_DS_MISSING_SUPREF"},
+	{8407, "ERROR_DS_CANT_RETRIEVE_INSTANCE"},
+	{8408, "ERROR_DS_CODE_INCONSISTENCY"},
+	{8409, "ERROR_DS_DATABASE_ERROR"},
+	{8410, "ERROR_DS_GOVERNSID_MISSING"},
+	{8411, "ERROR_DS_MISSING_EXPECTED_ATT"},
+	{8412, "ERROR_DS_NCNAME_MISSING_CR_REF"},
+	{8413, "ERROR_DS_SECURITY_CHECKING_ERROR"},
+	{8414, "ERROR_DS_SCHEMA_NOT_LOADED"},
+	{8415, "ERROR_DS_SCHEMA_ALLOC_FAILED"},
+	{8416, "ERROR_DS_ATT_SCHEMA_REQ_SYNTAX"},
+	{8417, "ERROR_DS_GCVERIFY_ERROR"},
+	{8418, "ERROR_DS_DRA_SCHEMA_MISMATCH"},
+	{8419, "ERROR_DS_CANT_FIND_DSA_OBJ"},
+	{8420, "ERROR_DS_CANT_FIND_EXPECTED_NC"},
+	{8421, "ERROR_DS_CANT_FIND_NC_IN_CACHE"},
+	{8422, "ERROR_DS_CANT_RETRIEVE_CHILD"},
+	{8423, "ERROR_DS_SECURITY_ILLEGAL_MODIFY"},
+	{8424, "ERROR_DS_CANT_REPLACE_HIDDEN_REC"},
+	{8425, "ERROR_DS_BAD_HIERARCHY_FILE"},
+	{8426, "ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED"},
+	{8427, "ERROR_DS_CONFIG_PARAM_MISSING"},
+	{8428, "ERROR_DS_COUNTING_AB_INDICES_FAILED"},
+	{8429, "ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED"},
+	{8430, "ERROR_DS_INTERNAL_FAILURE"},
+	{8431, "ERROR_DS_UNKNOWN_ERROR"},
+	{8432, "ERROR_DS_ROOT_REQUIRES_CLASS_TOP"},
+	{8433, "ERROR_DS_REFUSING_FSMO_ROLES"},
+	{8434, "ERROR_DS_MISSING_FSMO_SETTINGS"},
+	{8435, "ERROR_DS_UNABLE_TO_SURRENDER_ROLES"},
+	{8436, "ERROR_DS_DRA_GENERIC"},
+	{8437, "ERROR_DS_DRA_INVALID_PARAMETER"},
+	{8438, "ERROR_DS_DRA_BUSY"},
+	{8439, "ERROR_DS_DRA_BAD_DN"},
+	{8440, "ERROR_DS_DRA_BAD_NC"},
+	{8441, "ERROR_DS_DRA_DN_EXISTS"},
+	{8442, "ERROR_DS_DRA_INTERNAL_ERROR"},
+	{8443, "ERROR_DS_DRA_INCONSISTENT_DIT"},
+	{8444, "ERROR_DS_DRA_CONNECTION_FAILED"},
+	{8445, "ERROR_DS_DRA_BAD_INSTANCE_TYPE"},
+	{8446, "ERROR_DS_DRA_OUT_OF_MEM"},
+	{8447, "ERROR_DS_DRA_MAIL_PROBLEM"},
+	{8448, "ERROR_DS_DRA_REF_ALREADY_EXISTS"},
+	{8449, "ERROR_DS_DRA_REF_NOT_FOUND"},
+	{8450, "ERROR_DS_DRA_OBJ_IS_REP_SOURCE"},
+	{8451, "ERROR_DS_DRA_DB_ERROR"},
+	{8452, "ERROR_DS_DRA_NO_REPLICA"},
+	{8453, "ERROR_DS_DRA_ACCESS_DENIED"},
+	{8454, "ERROR_DS_DRA_NOT_SUPPORTED"},
+	{8455, "ERROR_DS_DRA_RPC_CANCELLED"},
+	{8456, "ERROR_DS_DRA_SOURCE_DISABLED"},
+	{8457, "ERROR_DS_DRA_SINK_DISABLED"},
+	{8458, "ERROR_DS_DRA_NAME_COLLISION"},
+	{8459, "ERROR_DS_DRA_SOURCE_REINSTALLED"},
+	{8460, "ERROR_DS_DRA_MISSING_PARENT"},
+	{8461, "ERROR_DS_DRA_PREEMPTED"},
+	{8462, "ERROR_DS_DRA_ABANDON_SYNC"},
+	{8463, "ERROR_DS_DRA_SHUTDOWN"},
+	{8464, "ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET"},
+	{8465, "ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA"},
+	{8466, "ERROR_DS_DRA_EXTN_CONNECTION_FAILED"},
+	{8467, "ERROR_DS_INSTALL_SCHEMA_MISMATCH"},
+	{8468, "ERROR_DS_DUP_LINK_ID"},
+	{8469, "ERROR_DS_NAME_ERROR_RESOLVING"},
+	{8470, "ERROR_DS_NAME_ERROR_NOT_FOUND"},
+	{8471, "ERROR_DS_NAME_ERROR_NOT_UNIQUE"},
+	{8472, "ERROR_DS_NAME_ERROR_NO_MAPPING"},
+	{8473, "ERROR_DS_NAME_ERROR_DOMAIN_ONLY"},
+	{8474, "ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING"},
+	{8475, "ERROR_DS_CONSTRUCTED_ATT_MOD"},
+	{8476, "ERROR_DS_WRONG_OM_OBJ_CLASS"},
+	{8477, "ERROR_DS_DRA_REPL_PENDING"},
+	{8478, "ERROR_DS_DS_REQUIRED"},
+	{8479, "ERROR_DS_INVALID_LDAP_DISPLAY_NAME"},
+	{8480, "ERROR_DS_NON_BASE_SEARCH"},
+	{8481, "ERROR_DS_CANT_RETRIEVE_ATTS"},
+	{8482, "ERROR_DS_BACKLINK_WITHOUT_LINK"},
+	{8483, "ERROR_DS_EPOCH_MISMATCH"},
+	{8484, "ERROR_DS_SRC_NAME_MISMATCH"},
+	{8485, "ERROR_DS_SRC_AND_DST_NC_IDENTICAL"},
+	{8486, "ERROR_DS_DST_NC_MISMATCH"},
+	{8487, "ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC"},
+	{8488, "ERROR_DS_SRC_GUID_MISMATCH"},
+	{8489, "ERROR_DS_CANT_MOVE_DELETED_OBJECT"},
+	{8490, "ERROR_DS_PDC_OPERATION_IN_PROGRESS"},
+	{8491, "ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD"},
+	{8492, "ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION"},
+	{8493, "ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS"},
+	{8494, "ERROR_DS_NC_MUST_HAVE_NC_PARENT"},
+	{8495, "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE"},
+	{8496, "ERROR_DS_DST_DOMAIN_NOT_NATIVE"},
+	{8497, "ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER"},
+	{8498, "ERROR_DS_CANT_MOVE_ACCOUNT_GROUP"},
+	{8499, "ERROR_DS_CANT_MOVE_RESOURCE_GROUP"},
+	{8500, "ERROR_DS_INVALID_SEARCH_FLAG"},
+	{8501, "ERROR_DS_NO_TREE_DELETE_ABOVE_NC"},
+	{8502, "ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE"},
+	{8503, "ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE"},
+	{8504, "ERROR_DS_SAM_INIT_FAILURE"},
+	{8505, "ERROR_DS_SENSITIVE_GROUP_VIOLATION"},
+	{8506, "ERROR_DS_CANT_MOD_PRIMARYGROUPID"},
+	{8507, "ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD"},
+	{8508, "ERROR_DS_NONSAFE_SCHEMA_CHANGE"},
+	{8509, "ERROR_DS_SCHEMA_UPDATE_DISALLOWED"},
+	{8510, "ERROR_DS_CANT_CREATE_UNDER_SCHEMA"},
+	{8511, "ERROR_DS_INSTALL_NO_SRC_SCH_VERSION"},
+	{8512, "ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE"},
+	{8513, "ERROR_DS_INVALID_GROUP_TYPE"},
+	{8514, "ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN"},
+	{8515, "ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN"},
+	{8516, "ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER"},
+	{8517, "ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER"},
+	{8518, "ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER"},
+	{8519, "ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER"},
+	{8520, "ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER"},
+	{8521, "ERROR_DS_HAVE_PRIMARY_MEMBERS"},
+	{8522, "ERROR_DS_STRING_SD_CONVERSION_FAILED"},
+	{8523, "ERROR_DS_NAMING_MASTER_GC"},
+	{8524, "ERROR_DS_DNS_LOOKUP_FAILURE"},
+	{8525, "ERROR_DS_COULDNT_UPDATE_SPNS"},
+	{8526, "ERROR_DS_CANT_RETRIEVE_SD"},
+	{8527, "ERROR_DS_KEY_NOT_UNIQUE"},
+	{8528, "ERROR_DS_WRONG_LINKED_ATT_SYNTAX"},
+	{8529, "ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD"},
+	{8530, "ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY"},
+	{8531, "ERROR_DS_CANT_START"},
+	{8532, "ERROR_DS_INIT_FAILURE"},
+	{8533, "ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION"},
+	{8534, "ERROR_DS_SOURCE_DOMAIN_IN_FOREST"},
+	{8535, "ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST"},
+	{8536, "ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED"},
+	{8537, "ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN"},
+	{8538, "ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER"},
+	{8539, "ERROR_DS_SRC_SID_EXISTS_IN_FOREST"},
+	{8540, "ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH"},
+	{8541, "ERROR_SAM_INIT_FAILURE"},
+	{8542, "ERROR_DS_DRA_SCHEMA_INFO_SHIP"},
+	{8543, "ERROR_DS_DRA_SCHEMA_CONFLICT"},
+	{8544, "ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT"},
+	{8545, "ERROR_DS_DRA_OBJ_NC_MISMATCH"},
+	{8546, "ERROR_DS_NC_STILL_HAS_DSAS"},
+	{8547, "ERROR_DS_GC_REQUIRED"},
+	{8548, "ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY"},
+	{8549, "ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS"},
+	{8550, "ERROR_DS_CANT_ADD_TO_GC"},
+	{8551, "ERROR_DS_NO_CHECKPOINT_WITH_PDC"},
+	{8552, "ERROR_DS_SOURCE_AUDITING_NOT_ENABLED"},
+	{8553, "ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC"},
+	{8554, "ERROR_DS_INVALID_NAME_FOR_SPN"},
+	{8555, "ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS"},
+	{8556, "ERROR_DS_UNICODEPWD_NOT_IN_QUOTES"},
+	{8557, "ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED"},
+	{8558, "ERROR_DS_MUST_BE_RUN_ON_DST_DC"},
+	{8559, "ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER"},
+	{8560, "ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ"},
+	{8561, "ERROR_DS_INIT_FAILURE_CONSOLE"},
+	{8562, "ERROR_DS_SAM_INIT_FAILURE_CONSOLE"},
+	{8563, "ERROR_DS_FOREST_VERSION_TOO_HIGH"},
+	{8564, "ERROR_DS_DOMAIN_VERSION_TOO_HIGH"},
+	{8565, "ERROR_DS_FOREST_VERSION_TOO_LOW"},
+	{8566, "ERROR_DS_DOMAIN_VERSION_TOO_LOW"},
+	{8567, "ERROR_DS_INCOMPATIBLE_VERSION"},
+	{8568, "ERROR_DS_LOW_DSA_VERSION"},
+	{8569, "ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN"},
+	{8570, "ERROR_DS_NOT_SUPPORTED_SORT_ORDER"},
+	{8571, "ERROR_DS_NAME_NOT_UNIQUE"},
+	{8572, "ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4"},
+	{8573, "ERROR_DS_OUT_OF_VERSION_STORE"},
+	{8574, "ERROR_DS_INCOMPATIBLE_CONTROLS_USED"},
+	{8575, "ERROR_DS_NO_REF_DOMAIN"},
+	{8576, "ERROR_DS_RESERVED_LINK_ID"},
+	{8577, "ERROR_DS_LINK_ID_NOT_AVAILABLE"},
+	{8578, "ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER"},
+	{8579, "ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE"},
+	{8580, "ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC"},
+	{8581, "ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG"},
+	{8582, "ERROR_DS_MODIFYDN_WRONG_GRANDPARENT"},
+	{8583, "ERROR_DS_NAME_ERROR_TRUST_REFERRAL"},
+	{8584, "ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER"},
+	{8585, "ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD"},
+	{8586, "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2"},
+	{8587, "ERROR_DS_THREAD_LIMIT_EXCEEDED"},
+	{8588, "ERROR_DS_NOT_CLOSEST"},
+	{8589, "ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF"},
+	{8590, "ERROR_DS_SINGLE_USER_MODE_FAILED"},
+	{8591, "ERROR_DS_NTDSCRIPT_SYNTAX_ERROR"},
+	{8592, "ERROR_DS_NTDSCRIPT_PROCESS_ERROR"},
+	{8593, "ERROR_DS_DIFFERENT_REPL_EPOCHS"},
+	{8594, "ERROR_DS_DRS_EXTENSIONS_CHANGED"},
+	{8595, "ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR"},
+	{8596, "ERROR_DS_NO_MSDS_INTID"},
+	{8597, "ERROR_DS_DUP_MSDS_INTID"},
+	{8598, "ERROR_DS_EXISTS_IN_RDNATTID"},
+	{8599, "ERROR_DS_AUTHORIZATION_FAILED"},
+	{8600, "ERROR_DS_INVALID_SCRIPT"},
+	{8601, "ERROR_DS_REMOTE_CROSSREF_OP_FAILED"},
+	{8602, "ERROR_DS_CROSS_REF_BUSY"},
+	{8603, "ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN"},
+	{8604, "ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC"},
+	{8605, "ERROR_DS_DUPLICATE_ID_FOUND"},
+	{8606, "ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT"},
+	{8607, "ERROR_DS_GROUP_CONVERSION_ERROR"},
+	{8608, "ERROR_DS_CANT_MOVE_APP_BASIC_GROUP"},
+	{8609, "ERROR_DS_CANT_MOVE_APP_QUERY_GROUP"},
+	{8610, "ERROR_DS_ROLE_NOT_VERIFIED"},
+	{8611, "ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL"},
+	{8612, "ERROR_DS_DOMAIN_RENAME_IN_PROGRESS"},
+	{8613, "ERROR_DS_EXISTING_AD_CHILD_NC"},
+	{8614, "ERROR_DS_REPL_LIFETIME_EXCEEDED"},
+	{8615, "ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER"},
+	{8616, "ERROR_DS_LDAP_SEND_QUEUE_FULL"},
+	{8617, "ERROR_DS_DRA_OUT_SCHEDULE_WINDOW"},
+	{8618, "ERROR_DS_POLICY_NOT_KNOWN"},
+	{8619, "ERROR_NO_SITE_SETTINGS_OBJECT"},
+	{8620, "ERROR_NO_SECRETS"},
+	{8621, "ERROR_NO_WRITABLE_DC_FOUND"},
+	{8622, "ERROR_DS_NO_SERVER_OBJECT"},
+	{8623, "ERROR_DS_NO_NTDSA_OBJECT"},
+	{8624, "ERROR_DS_NON_ASQ_SEARCH"},
+	{8625, "ERROR_DS_AUDIT_FAILURE"},
+	{8626, "ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE"},
+	{8627, "ERROR_DS_INVALID_SEARCH_FLAG_TUPLE"},
+	{8628, "ERROR_DS_HIERARCHY_TABLE_TOO_DEEP"},
+	{8629, "ERROR_DS_DRA_CORRUPT_UTD_VECTOR"},
+	{8630, "ERROR_DS_DRA_SECRETS_DENIED"},
+	{8631, "ERROR_DS_RESERVED_MAPI_ID"},
+	{8632, "ERROR_DS_MAPI_ID_NOT_AVAILABLE"},
+	{8633, "ERROR_DS_DRA_MISSING_KRBTGT_SECRET"},
+	{8634, "ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST"},
+	{8635, "ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST"},
+	{8636, "ERROR_INVALID_USER_PRINCIPAL_NAME"},
+	{8637, "ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS"},
+	{8638, "ERROR_DS_OID_NOT_FOUND"},
+	{8639, "ERROR_DS_DRA_RECYCLED_TARGET"},
+	{8640, "ERROR_DS_DISALLOWED_NC_REDIRECT"},
+	{8641, "ERROR_DS_HIGH_ADLDS_FFL"},
+	{8642, "ERROR_DS_HIGH_DSA_VERSION"},
+	{8643, "ERROR_DS_LOW_ADLDS_FFL"},
+	{8644, "ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION"},
+	{8645, "ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED"},
+	{8646, "ERROR_INCORRECT_ACCOUNT_TYPE"},
+	{8647, "ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST"},
+	{8648, "ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST"},
+	{8649, "ERROR_DS_MISSING_FOREST_TRUST"},
+	{8650, "ERROR_DS_VALUE_KEY_NOT_UNIQUE"},
+	{9000, "DNS_ERROR_MASK"},
+	{9001, "DNS_ERROR_RCODE_FORMAT_ERROR"},
+	{9002, "DNS_ERROR_RCODE_SERVER_FAILURE"},
+	{9003, "DNS_ERROR_RCODE_NAME_ERROR"},
+	{9004, "DNS_ERROR_RCODE_NOT_IMPLEMENTED"},
+	{9005, "DNS_ERROR_RCODE_REFUSED"},
+	{9006, "DNS_ERROR_RCODE_YXDOMAIN"},
+	{9007, "DNS_ERROR_RCODE_YXRRSET"},
+	{9008, "DNS_ERROR_RCODE_NXRRSET"},
+	{9009, "DNS_ERROR_RCODE_NOTAUTH"},
+	{9010, "DNS_ERROR_RCODE_NOTZONE"},
+	{9016, "DNS_ERROR_RCODE_BADSIG"},
+	{9017, "DNS_ERROR_RCODE_BADKEY"},
+	{9018, "DNS_ERROR_RCODE_BADTIME"},
+	{9101, "DNS_ERROR_KEYMASTER_REQUIRED"},
+	{9102, "DNS_ERROR_NOT_ALLOWED_ON_SIGNED_ZONE"},
+	{9103, "DNS_ERROR_NSEC3_INCOMPATIBLE_WITH_RSA_SHA1"},
+	{9104, "DNS_ERROR_NOT_ENOUGH_SIGNING_KEY_DESCRIPTORS"},
+	{9105, "DNS_ERROR_UNSUPPORTED_ALGORITHM"},
+	{9106, "DNS_ERROR_INVALID_KEY_SIZE"},
+	{9107, "DNS_ERROR_SIGNING_KEY_NOT_ACCESSIBLE"},
+	{9108, "DNS_ERROR_KSP_DOES_NOT_SUPPORT_PROTECTION"},
+	{9109, "DNS_ERROR_UNEXPECTED_DATA_PROTECTION_ERROR"},
+	{9110, "DNS_ERROR_UNEXPECTED_CNG_ERROR"},
+	{9111, "DNS_ERROR_UNKNOWN_SIGNING_PARAMETER_VERSION"},
+	{9112, "DNS_ERROR_KSP_NOT_ACCESSIBLE"},
+	{9113, "DNS_ERROR_TOO_MANY_SKDS"},
+	{9114, "DNS_ERROR_INVALID_ROLLOVER_PERIOD"},
+	{9115, "DNS_ERROR_INVALID_INITIAL_ROLLOVER_OFFSET"},
+	{9116, "DNS_ERROR_ROLLOVER_IN_PROGRESS"},
+	{9117, "DNS_ERROR_STANDBY_KEY_NOT_PRESENT"},
+	{9118, "DNS_ERROR_NOT_ALLOWED_ON_ZSK"},
+	{9119, "DNS_ERROR_NOT_ALLOWED_ON_ACTIVE_SKD"},
+	{9120, "DNS_ERROR_ROLLOVER_ALREADY_QUEUED"},
+	{9121, "DNS_ERROR_NOT_ALLOWED_ON_UNSIGNED_ZONE"},
+	{9122, "DNS_ERROR_BAD_KEYMASTER"},
+	{9123, "DNS_ERROR_INVALID_SIGNATURE_VALIDITY_PERIOD"},
+	{9124, "DNS_ERROR_INVALID_NSEC3_ITERATION_COUNT"},
+	{9125, "DNS_ERROR_DNSSEC_IS_DISABLED"},
+	{9126, "DNS_ERROR_INVALID_XML"},
+	{9127, "DNS_ERROR_NO_VALID_TRUST_ANCHORS"},
+	{9128, "DNS_ERROR_ROLLOVER_NOT_POKEABLE"},
+	{9129, "DNS_ERROR_NSEC3_NAME_COLLISION"},
+	{9130, "DNS_ERROR_NSEC_INCOMPATIBLE_WITH_NSEC3_RSA_SHA1"},
+	{9501, "DNS_INFO_NO_RECORDS"},
+	{9502, "DNS_ERROR_BAD_PACKET"},
+	{9503, "DNS_ERROR_NO_PACKET"},
+	{9504, "DNS_ERROR_RCODE"},
+	{9505, "DNS_ERROR_UNSECURE_PACKET"},
+	{9505, "DNS_STATUS_PACKET_UNSECURE"},
+	{9506, "DNS_REQUEST_PENDING"},
+	{9551, "DNS_ERROR_INVALID_TYPE"},
+	{9552, "DNS_ERROR_INVALID_IP_ADDRESS"},
+	{9553, "DNS_ERROR_INVALID_PROPERTY"},
+	{9554, "DNS_ERROR_TRY_AGAIN_LATER"},
+	{9555, "DNS_ERROR_NOT_UNIQUE"},
+	{9556, "DNS_ERROR_NON_RFC_NAME"},
+	{9557, "DNS_STATUS_FQDN"},
+	{9558, "DNS_STATUS_DOTTED_NAME"},
+	{9559, "DNS_STATUS_SINGLE_PART_NAME"},
+	{9560, "DNS_ERROR_INVALID_NAME_CHAR"},
+	{9561, "DNS_ERROR_NUMERIC_NAME"},
+	{9562, "DNS_ERROR_NOT_ALLOWED_ON_ROOT_SERVER"},
+	{9563, "DNS_ERROR_NOT_ALLOWED_UNDER_DELEGATION"},
+	{9564, "DNS_ERROR_CANNOT_FIND_ROOT_HINTS"},
+	{9565, "DNS_ERROR_INCONSISTENT_ROOT_HINTS"},
+	{9566, "DNS_ERROR_DWORD_VALUE_TOO_SMALL"},
+	{9567, "DNS_ERROR_DWORD_VALUE_TOO_LARGE"},
+	{9568, "DNS_ERROR_BACKGROUND_LOADING"},
+	{9569, "DNS_ERROR_NOT_ALLOWED_ON_RODC"},
+	{9570, "DNS_ERROR_NOT_ALLOWED_UNDER_DNAME"},
+	{9571, "DNS_ERROR_DELEGATION_REQUIRED"},
+	{9572, "DNS_ERROR_INVALID_POLICY_TABLE"},
+	{9573, "DNS_ERROR_ADDRESS_REQUIRED"},
+	{9601, "DNS_ERROR_ZONE_DOES_NOT_EXIST"},
+	{9602, "DNS_ERROR_NO_ZONE_INFO"},
+	{9603, "DNS_ERROR_INVALID_ZONE_OPERATION"},
+	{9604, "DNS_ERROR_ZONE_CONFIGURATION_ERROR"},
+	{9605, "DNS_ERROR_ZONE_HAS_NO_SOA_RECORD"},
+	{9606, "DNS_ERROR_ZONE_HAS_NO_NS_RECORDS"},
+	{9607, "DNS_ERROR_ZONE_LOCKED"},
+	{9608, "DNS_ERROR_ZONE_CREATION_FAILED"},
+	{9609, "DNS_ERROR_ZONE_ALREADY_EXISTS"},
+	{9610, "DNS_ERROR_AUTOZONE_ALREADY_EXISTS"},
+	{9611, "DNS_ERROR_INVALID_ZONE_TYPE"},
+	{9612, "DNS_ERROR_SECONDARY_REQUIRES_MASTER_IP"},
+	{9613, "DNS_ERROR_ZONE_NOT_SECONDARY"},
+	{9614, "DNS_ERROR_NEED_SECONDARY_ADDRESSES"},
+	{9615, "DNS_ERROR_WINS_INIT_FAILED"},
+	{9616, "DNS_ERROR_NEED_WINS_SERVERS"},
+	{9617, "DNS_ERROR_NBSTAT_INIT_FAILED"},
+	{9618, "DNS_ERROR_SOA_DELETE_INVALID"},
+	{9619, "DNS_ERROR_FORWARDER_ALREADY_EXISTS"},
+	{9620, "DNS_ERROR_ZONE_REQUIRES_MASTER_IP"},
+	{9621, "DNS_ERROR_ZONE_IS_SHUTDOWN"},
+	{9622, "DNS_ERROR_ZONE_LOCKED_FOR_SIGNING"},
+	{9651, "DNS_ERROR_PRIMARY_REQUIRES_DATAFILE"},
+	{9652, "DNS_ERROR_INVALID_DATAFILE_NAME"},
+	{9653, "DNS_ERROR_DATAFILE_OPEN_FAILURE"},
+	{9654, "DNS_ERROR_FILE_WRITEBACK_FAILED"},
+	{9655, "DNS_ERROR_DATAFILE_PARSING"},
+	{9701, "DNS_ERROR_RECORD_DOES_NOT_EXIST"},
+	{9702, "DNS_ERROR_RECORD_FORMAT"},
+	{9703, "DNS_ERROR_NODE_CREATION_FAILED"},
+	{9704, "DNS_ERROR_UNKNOWN_RECORD_TYPE"},
+	{9705, "DNS_ERROR_RECORD_TIMED_OUT"},
+	{9706, "DNS_ERROR_NAME_NOT_IN_ZONE"},
+	{9707, "DNS_ERROR_CNAME_LOOP"},
+	{9708, "DNS_ERROR_NODE_IS_CNAME"},
+	{9709, "DNS_ERROR_CNAME_COLLISION"},
+	{9710, "DNS_ERROR_RECORD_ONLY_AT_ZONE_ROOT"},
+	{9711, "DNS_ERROR_RECORD_ALREADY_EXISTS"},
+	{9712, "DNS_ERROR_SECONDARY_DATA"},
+	{9713, "DNS_ERROR_NO_CREATE_CACHE_DATA"},
+	{9714, "DNS_ERROR_NAME_DOES_NOT_EXIST"},
+	{9715, "DNS_WARNING_PTR_CREATE_FAILED"},
+	{9716, "DNS_WARNING_DOMAIN_UNDELETED"},
+	{9717, "DNS_ERROR_DS_UNAVAILABLE"},
+	{9718, "DNS_ERROR_DS_ZONE_ALREADY_EXISTS"},
+	{9719, "DNS_ERROR_NO_BOOTFILE_IF_DS_ZONE"},
+	{9720, "DNS_ERROR_NODE_IS_DNAME"},
+	{9721, "DNS_ERROR_DNAME_COLLISION"},
+	{9722, "DNS_ERROR_ALIAS_LOOP"},
+	{9751, "DNS_INFO_AXFR_COMPLETE"},
+	{9752, "DNS_ERROR_AXFR"},
+	{9753, "DNS_INFO_ADDED_LOCAL_WINS"},
+	{9801, "DNS_STATUS_CONTINUE_NEEDED"},
+	{9851, "DNS_ERROR_NO_TCPIP"},
+	{9852, "DNS_ERROR_NO_DNS_SERVERS"},
+	{9901, "DNS_ERROR_DP_DOES_NOT_EXIST"},
+	{9902, "DNS_ERROR_DP_ALREADY_EXISTS"},
+	{9903, "DNS_ERROR_DP_NOT_ENLISTED"},
+	{9904, "DNS_ERROR_DP_ALREADY_ENLISTED"},
+	{9905, "DNS_ERROR_DP_NOT_AVAILABLE"},
+	{9906, "DNS_ERROR_DP_FSMO_ERROR"},
+	{9911, "DNS_ERROR_RRL_NOT_ENABLED"},
+	{9912, "DNS_ERROR_RRL_INVALID_WINDOW_SIZE"},
+	{9913, "DNS_ERROR_RRL_INVALID_IPV4_PREFIX"},
+	{9914, "DNS_ERROR_RRL_INVALID_IPV6_PREFIX"},
+	{9915, "DNS_ERROR_RRL_INVALID_TC_RATE"},
+	{9916, "DNS_ERROR_RRL_INVALID_LEAK_RATE"},
+	{9917, "DNS_ERROR_RRL_LEAK_RATE_LESSTHAN_TC_RATE"},
+	{9921, "DNS_ERROR_VIRTUALIZATION_INSTANCE_ALREADY_EXISTS"},
+	{9922, "DNS_ERROR_VIRTUALIZATION_INSTANCE_DOES_NOT_EXIST"},
+	{9923, "DNS_ERROR_VIRTUALIZATION_TREE_LOCKED"},
+	{9924, "DNS_ERROR_INVAILD_VIRTUALIZATION_INSTANCE_NAME"},
+	{9925, "DNS_ERROR_DEFAULT_VIRTUALIZATION_INSTANCE"},
+	{9951, "DNS_ERROR_ZONESCOPE_ALREADY_EXISTS"},
+	{9952, "DNS_ERROR_ZONESCOPE_DOES_NOT_EXIST"},
+	{9953, "DNS_ERROR_DEFAULT_ZONESCOPE"},
+	{9954, "DNS_ERROR_INVALID_ZONESCOPE_NAME"},
+	{9955, "DNS_ERROR_NOT_ALLOWED_WITH_ZONESCOPES"},
+	{9956, "DNS_ERROR_LOAD_ZONESCOPE_FAILED"},
+	{9957, "DNS_ERROR_ZONESCOPE_FILE_WRITEBACK_FAILED"},
+	{9958, "DNS_ERROR_INVALID_SCOPE_NAME"},
+	{9959, "DNS_ERROR_SCOPE_DOES_NOT_EXIST"},
+	{9960, "DNS_ERROR_DEFAULT_SCOPE"},
+	{9961, "DNS_ERROR_INVALID_SCOPE_OPERATION"},
+	{9962, "DNS_ERROR_SCOPE_LOCKED"},
+	{9963, "DNS_ERROR_SCOPE_ALREADY_EXISTS"},
+	{9971, "DNS_ERROR_POLICY_ALREADY_EXISTS"},
+	{9972, "DNS_ERROR_POLICY_DOES_NOT_EXIST"},
+	{9973, "DNS_ERROR_POLICY_INVALID_CRITERIA"},
+	{9974, "DNS_ERROR_POLICY_INVALID_SETTINGS"},
+	{9975, "DNS_ERROR_CLIENT_SUBNET_IS_ACCESSED"},
+	{9976, "DNS_ERROR_CLIENT_SUBNET_DOES_NOT_EXIST"},
+	{9977, "DNS_ERROR_CLIENT_SUBNET_ALREADY_EXISTS"},
+	{9978, "DNS_ERROR_SUBNET_DOES_NOT_EXIST"},
+	{9979, "DNS_ERROR_SUBNET_ALREADY_EXISTS"},
+	{9980, "DNS_ERROR_POLICY_LOCKED"},
+	{9981, "DNS_ERROR_POLICY_INVALID_WEIGHT"},
+	{9982, "DNS_ERROR_POLICY_INVALID_NAME"},
+	{9983, "DNS_ERROR_POLICY_MISSING_CRITERIA"},
+	{9984, "DNS_ERROR_INVALID_CLIENT_SUBNET_NAME"},
+	{9985, "DNS_ERROR_POLICY_PROCESSING_ORDER_INVALID"},
+	{9986, "DNS_ERROR_POLICY_SCOPE_MISSING"},
+	{9987, "DNS_ERROR_POLICY_SCOPE_NOT_ALLOWED"},
+	{9988, "DNS_ERROR_SERVERSCOPE_IS_REFERENCED"},
+	{9989, "DNS_ERROR_ZONESCOPE_IS_REFERENCED"},
+	{9990, "DNS_ERROR_POLICY_INVALID_CRITERIA_CLIENT_SUBNET"},
+	{9991, "DNS_ERROR_POLICY_INVALID_CRITERIA_TRANSPORT_PROTOCOL"},
+	{9992, "DNS_ERROR_POLICY_INVALID_CRITERIA_NETWORK_PROTOCOL"},
+	{9993, "DNS_ERROR_POLICY_INVALID_CRITERIA_INTERFACE"},
+	{9994, "DNS_ERROR_POLICY_INVALID_CRITERIA_FQDN"},
+	{9995, "DNS_ERROR_POLICY_INVALID_CRITERIA_QUERY_TYPE"},
+	{9996, "DNS_ERROR_POLICY_INVALID_CRITERIA_TIME_OF_DAY"},
+	{10000, "WSABASEERR"},
+	{10004, "WSAEINTR"},
+	{10009, "WSAEBADF"},
+	{10013, "WSAEACCES"},
+	{10014, "WSAEFAULT"},
+	{10022, "WSAEINVAL"},
+	{10024, "WSAEMFILE"},
+	{10035, "WSAEWOULDBLOCK"},
+	{10036, "WSAEINPROGRESS"},
+	{10037, "WSAEALREADY"},
+	{10038, "WSAENOTSOCK"},
+	{10039, "WSAEDESTADDRREQ"},
+	{10040, "WSAEMSGSIZE"},
+	{10041, "WSAEPROTOTYPE"},
+	{10042, "WSAENOPROTOOPT"},
+	{10043, "WSAEPROTONOSUPPORT"},
+	{10044, "WSAESOCKTNOSUPPORT"},
+	{10045, "WSAEOPNOTSUPP"},
+	{10046, "WSAEPFNOSUPPORT"},
+	{10047, "WSAEAFNOSUPPORT"},
+	{10048, "WSAEADDRINUSE"},
+	{10049, "WSAEADDRNOTAVAIL"},
+	{10050, "WSAENETDOWN"},
+	{10051, "WSAENETUNREACH"},
+	{10052, "WSAENETRESET"},
+	{10053, "WSAECONNABORTED"},
+	{10054, "WSAECONNRESET"},
+	{10055, "WSAENOBUFS"},
+	{10056, "WSAEISCONN"},
+	{10057, "WSAENOTCONN"},
+	{10058, "WSAESHUTDOWN"},
+	{10059, "WSAETOOMANYREFS"},
+	{10060, "WSAETIMEDOUT"},
+	{10061, "WSAECONNREFUSED"},
+	{10062, "WSAELOOP"},
+	{10063, "WSAENAMETOOLONG"},
+	{10064, "WSAEHOSTDOWN"},
+	{10065, "WSAEHOSTUNREACH"},
+	{10066, "WSAENOTEMPTY"},
+	{10067, "WSAEPROCLIM"},
+	{10068, "WSAEUSERS"},
+	{10069, "WSAEDQUOT"},
+	{10070, "WSAESTALE"},
+	{10071, "WSAEREMOTE"},
+	{10091, "WSASYSNOTREADY"},
+	{10092, "WSAVERNOTSUPPORTED"},
+	{10093, "WSANOTINITIALISED"},
+	{10101, "WSAEDISCON"},
+	{10102, "WSAENOMORE"},
+	{10103, "WSAECANCELLED"},
+	{10104, "WSAEINVALIDPROCTABLE"},
+	{10105, "WSAEINVALIDPROVIDER"},
+	{10106, "WSAEPROVIDERFAILEDINIT"},
+	{10107, "WSASYSCALLFAILURE"},
+	{10108, "WSASERVICE_NOT_FOUND"},
+	{10109, "WSATYPE_NOT_FOUND"},
+	{10110, "WSA_E_NO_MORE"},
+	{10111, "WSA_E_CANCELLED"},
+	{10112, "WSAEREFUSED"},
+	{11001, "WSAHOST_NOT_FOUND"},
+	{11002, "WSATRY_AGAIN"},
+	{11003, "WSANO_RECOVERY"},
+	{11004, "WSANO_DATA"},
+	{11005, "WSA_QOS_RECEIVERS"},
+	{11006, "WSA_QOS_SENDERS"},
+	{11007, "WSA_QOS_NO_SENDERS"},
+	{11008, "WSA_QOS_NO_RECEIVERS"},
+	{11009, "WSA_QOS_REQUEST_CONFIRMED"},
+	{11010, "WSA_QOS_ADMISSION_FAILURE"},
+	{11011, "WSA_QOS_POLICY_FAILURE"},
+	{11012, "WSA_QOS_BAD_STYLE"},
+	{11013, "WSA_QOS_BAD_OBJECT"},
+	{11014, "WSA_QOS_TRAFFIC_CTRL_ERROR"},
+	{11015, "WSA_QOS_GENERIC_ERROR"},
+	{11016, "WSA_QOS_ESERVICETYPE"},
+	{11017, "WSA_QOS_EFLOWSPEC"},
+	{11018, "WSA_QOS_EPROVSPECBUF"},
+	{11019, "WSA_QOS_EFILTERSTYLE"},
+	{11020, "WSA_QOS_EFILTERTYPE"},
+	{11021, "WSA_QOS_EFILTERCOUNT"},
+	{11022, "WSA_QOS_EOBJLENGTH"},
+	{11023, "WSA_QOS_EFLOWCOUNT"},
+	{11024, "WSA_QOS_EUNKOWNPSOBJ"},
+	{11025, "WSA_QOS_EPOLICYOBJ"},
+	{11026, "WSA_QOS_EFLOWDESC"},
+	{11027, "WSA_QOS_EPSFLOWSPEC"},
+	{11028, "WSA_QOS_EPSFILTERSPEC"},
+	{11029, "WSA_QOS_ESDMODEOBJ"},
+	{11030, "WSA_QOS_ESHAPERATEOBJ"},
+	{11031, "WSA_QOS_RESERVED_PETYPE"},
+	{11032, "WSA_SECURE_HOST_NOT_FOUND"},
+	{11033, "WSA_IPSEC_NAME_POLICY_ERROR"},
+	{12001, "ERROR_WINHTTP_OUT_OF_HANDLES"},
+	{12001, "ERROR_INTERNET_OUT_OF_HANDLES"},
+	{12002, "ERROR_WINHTTP_TIMEOUT"},
+	{12002, "ERROR_INTERNET_TIMEOUT"},
+	{12003, "ERROR_INTERNET_EXTENDED_ERROR"},
+	{12004, "ERROR_WINHTTP_INTERNAL_ERROR"},
+	{12004, "ERROR_INTERNET_INTERNAL_ERROR"},
+	{12005, "ERROR_WINHTTP_INVALID_URL"},
+	{12005, "ERROR_INTERNET_INVALID_URL"},
+	{12006, "ERROR_WINHTTP_UNRECOGNIZED_SCHEME"},
+	{12006, "ERROR_INTERNET_UNRECOGNIZED_SCHEME"},
+	{12007, "ERROR_WINHTTP_NAME_NOT_RESOLVED"},
+	{12007, "ERROR_INTERNET_NAME_NOT_RESOLVED"},
+	{12008, "ERROR_INTERNET_PROTOCOL_NOT_FOUND"},
+	{12009, "ERROR_WINHTTP_INVALID_OPTION"},
+	{12009, "ERROR_INTERNET_INVALID_OPTION"},
+	{12010, "ERROR_INTERNET_BAD_OPTION_LENGTH"},
+	{12011, "ERROR_WINHTTP_OPTION_NOT_SETTABLE"},
+	{12011, "ERROR_INTERNET_OPTION_NOT_SETTABLE"},
+	{12012, "ERROR_WINHTTP_SHUTDOWN"},
+	{12012, "ERROR_INTERNET_SHUTDOWN"},
+	{12013, "ERROR_INTERNET_INCORRECT_USER_NAME"},
+	{12014, "ERROR_INTERNET_INCORRECT_PASSWORD"},
+	{12015, "ERROR_WINHTTP_LOGIN_FAILURE"},
+	{12015, "ERROR_INTERNET_LOGIN_FAILURE"},
+	{12016, "ERROR_INTERNET_INVALID_OPERATION"},
+	{12017, "ERROR_WINHTTP_OPERATION_CANCELLED"},
+	{12017, "ERROR_INTERNET_OPERATION_CANCELLED"},
+	{12018, "ERROR_WINHTTP_INCORRECT_HANDLE_TYPE"},
+	{12018, "ERROR_INTERNET_INCORRECT_HANDLE_TYPE"},
+	{12019, "ERROR_WINHTTP_INCORRECT_HANDLE_STATE"},
+	{12019, "ERROR_INTERNET_INCORRECT_HANDLE_STATE"},
+	{12020, "ERROR_INTERNET_NOT_PROXY_REQUEST"},
+	{12021, "ERROR_INTERNET_REGISTRY_VALUE_NOT_FOUND"},
+	{12022, "ERROR_INTERNET_BAD_REGISTRY_PARAMETER"},
+	{12023, "ERROR_INTERNET_NO_DIRECT_ACCESS"},
+	{12024, "ERROR_INTERNET_NO_CONTEXT"},
+	{12025, "ERROR_INTERNET_NO_CALLBACK"},
+	{12026, "ERROR_INTERNET_REQUEST_PENDING"},
+	{12027, "ERROR_INTERNET_INCORRECT_FORMAT"},
+	{12028, "ERROR_INTERNET_ITEM_NOT_FOUND"},
+	{12029, "ERROR_WINHTTP_CANNOT_CONNECT"},
+	{12029, "ERROR_INTERNET_CANNOT_CONNECT"},
+	{12030, "ERROR_WINHTTP_CONNECTION_ERROR"},
+	{12030, "ERROR_INTERNET_CONNECTION_ABORTED"},
+	{12031, "ERROR_INTERNET_CONNECTION_RESET"},
+	{12032, "ERROR_WINHTTP_RESEND_REQUEST"},
+	{12032, "ERROR_INTERNET_FORCE_RETRY"},
+	{12033, "ERROR_INTERNET_INVALID_PROXY_REQUEST"},
+	{12034, "ERROR_INTERNET_NEED_UI"},
+	{12036, "ERROR_INTERNET_HANDLE_EXISTS"},
+	{12037, "ERROR_WINHTTP_SECURE_CERT_DATE_INVALID"},
+	{12037, "ERROR_INTERNET_SEC_CERT_DATE_INVALID"},
+	{12038, "ERROR_WINHTTP_SECURE_CERT_CN_INVALID"},
+	{12038, "ERROR_INTERNET_SEC_CERT_CN_INVALID"},
+	{12039, "ERROR_INTERNET_HTTP_TO_HTTPS_ON_REDIR"},
+	{12040, "ERROR_INTERNET_HTTPS_TO_HTTP_ON_REDIR"},
+	{12041, "ERROR_INTERNET_MIXED_SECURITY"},
+	{12042, "ERROR_INTERNET_CHG_POST_IS_NON_SECURE"},
+	{12043, "ERROR_INTERNET_POST_IS_NON_SECURE"},
+	{12044, "ERROR_WINHTTP_CLIENT_AUTH_CERT_NEEDED"},
+	{12044, "ERROR_INTERNET_CLIENT_AUTH_CERT_NEEDED"},
+	{12045, "ERROR_WINHTTP_SECURE_INVALID_CA"},
+	{12045, "ERROR_INTERNET_INVALID_CA"},
+	{12046, "ERROR_INTERNET_CLIENT_AUTH_NOT_SETUP"},
+	{12047, "ERROR_INTERNET_ASYNC_THREAD_FAILED"},
+	{12048, "ERROR_INTERNET_REDIRECT_SCHEME_CHANGE"},
+	{12049, "ERROR_INTERNET_DIALOG_PENDING"},
+	{12050, "ERROR_INTERNET_RETRY_DIALOG"},
+	{12052, "ERROR_INTERNET_HTTPS_HTTP_SUBMIT_REDIR"},
+	{12053, "ERROR_INTERNET_INSERT_CDROM"},
+	{12054, "ERROR_INTERNET_FORTEZZA_LOGIN_NEEDED"},
+	{12055, "ERROR_INTERNET_SEC_CERT_ERRORS"},
+	{12056, "ERROR_INTERNET_SEC_CERT_NO_REV"},
+	{12057, "ERROR_WINHTTP_SECURE_CERT_REV_FAILED"},
+	{12057, "ERROR_INTERNET_SEC_CERT_REV_FAILED"},
+	{12100, "ERROR_WINHTTP_CANNOT_CALL_BEFORE_OPEN"},
+	{12101, "ERROR_WINHTTP_CANNOT_CALL_BEFORE_SEND"},
+	{12102, "ERROR_WINHTTP_CANNOT_CALL_AFTER_SEND"},
+	{12103, "ERROR_WINHTTP_CANNOT_CALL_AFTER_OPEN"},
+	{12110, "ERROR_FTP_TRANSFER_IN_PROGRESS"},
+	{12111, "ERROR_FTP_DROPPED"},
+	{12112, "ERROR_FTP_NO_PASSIVE_MODE"},
+	{12130, "ERROR_GOPHER_PROTOCOL_ERROR"},
+	{12131, "ERROR_GOPHER_NOT_FILE"},
+	{12132, "ERROR_GOPHER_DATA_ERROR"},
+	{12133, "ERROR_GOPHER_END_OF_DATA"},
+	{12134, "ERROR_GOPHER_INVALID_LOCATOR"},
+	{12135, "ERROR_GOPHER_INCORRECT_LOCATOR_TYPE"},
+	{12136, "ERROR_GOPHER_NOT_GOPHER_PLUS"},
+	{12137, "ERROR_GOPHER_ATTRIBUTE_NOT_FOUND"},
+	{12138, "ERROR_GOPHER_UNKNOWN_LOCATOR"},
+	{12150, "ERROR_WINHTTP_HEADER_NOT_FOUND"},
+	{12150, "ERROR_HTTP_HEADER_NOT_FOUND"},
+	{12151, "ERROR_HTTP_DOWNLEVEL_SERVER"},
+	{12152, "ERROR_WINHTTP_INVALID_SERVER_RESPONSE"},
+	{12152, "ERROR_HTTP_INVALID_SERVER_RESPONSE"},
+	{12153, "ERROR_WINHTTP_INVALID_HEADER"},
+	{12153, "ERROR_HTTP_INVALID_HEADER"},
+	{12154, "ERROR_WINHTTP_INVALID_QUERY_REQUEST"},
+	{12154, "ERROR_HTTP_INVALID_QUERY_REQUEST"},
+	{12155, "ERROR_WINHTTP_HEADER_ALREADY_EXISTS"},
+	{12155, "ERROR_HTTP_HEADER_ALREADY_EXISTS"},
+	{12156, "ERROR_WINHTTP_REDIRECT_FAILED"},
+	{12156, "ERROR_HTTP_REDIRECT_FAILED"},
+	{12157, "ERROR_WINHTTP_SECURE_CHANNEL_ERROR"},
+	{12157, "ERROR_INTERNET_SECURITY_CHANNEL_ERROR"},
+	{12158, "ERROR_INTERNET_UNABLE_TO_CACHE_FILE"},
+	{12159, "ERROR_INTERNET_TCPIP_NOT_INSTALLED"},
+	{12160, "ERROR_HTTP_NOT_REDIRECTED"},
+	{12161, "ERROR_HTTP_COOKIE_NEEDS_CONFIRMATION"},
+	{12162, "ERROR_HTTP_COOKIE_DECLINED"},
+	{12163, "ERROR_INTERNET_DISCONNECTED"},
+	{12164, "ERROR_INTERNET_SERVER_UNREACHABLE"},
+	{12165, "ERROR_INTERNET_PROXY_SERVER_UNREACHABLE"},
+	{12166, "ERROR_WINHTTP_BAD_AUTO_PROXY_SCRIPT"},
+	{12166, "ERROR_INTERNET_BAD_AUTO_PROXY_SCRIPT"},
+	{12167, "ERROR_WINHTTP_UNABLE_TO_DOWNLOAD_SCRIPT"},
+	{12167, "ERROR_INTERNET_UNABLE_TO_DOWNLOAD_SCRIPT"},
+	{12168, "ERROR_HTTP_REDIRECT_NEEDS_CONFIRMATION"},
+	{12169, "ERROR_WINHTTP_SECURE_INVALID_CERT"},
+	{12169, "ERROR_INTERNET_SEC_INVALID_CERT"},
+	{12170, "ERROR_WINHTTP_SECURE_CERT_REVOKED"},
+	{12170, "ERROR_INTERNET_SEC_CERT_REVOKED"},
+	{12171, "ERROR_INTERNET_FAILED_DUETOSECURITYCHECK"},
+	{12172, "ERROR_WINHTTP_NOT_INITIALIZED"},
+	{12172, "ERROR_INTERNET_NOT_INITIALIZED"},
+	{12173, "ERROR_INTERNET_NEED_MSN_SSPI_PKG"},
+	{12174, "ERROR_INTERNET_LOGIN_FAILURE_DISPLAY_ENTITY_BODY"},
+	{12175, "ERROR_WINHTTP_SECURE_FAILURE"},
+	{12176, "ERROR_WINHTTP_UNHANDLED_SCRIPT_TYPE"},
+	{12177, "ERROR_WINHTTP_SCRIPT_EXECUTION_ERROR"},
+	{12178, "ERROR_WINHTTP_AUTO_PROXY_SERVICE_ERROR"},
+	{12179, "ERROR_WINHTTP_SECURE_CERT_WRONG_USAGE"},
+	{12180, "ERROR_WINHTTP_AUTODETECTION_FAILED"},
+	{12181, "ERROR_WINHTTP_HEADER_COUNT_EXCEEDED"},
+	{12182, "ERROR_WINHTTP_HEADER_SIZE_OVERFLOW"},
+	{12183, "ERROR_WINHTTP_CHUNKED_ENCODING_HEADER_SIZE_OVERFLOW"},
+	{12184, "ERROR_WINHTTP_RESPONSE_DRAIN_OVERFLOW"},
+	{12185, "ERROR_WINHTTP_CLIENT_CERT_NO_PRIVATE_KEY"},
+	{12186, "ERROR_WINHTTP_CLIENT_CERT_NO_ACCESS_PRIVATE_KEY"},
+	{12187, "ERROR_WINHTTP_CLIENT_AUTH_CERT_NEEDED_PROXY"},
+	{12188, "ERROR_WINHTTP_SECURE_FAILURE_PROXY"},
+	{12189, "ERROR_WINHTTP_RESERVED189"},
+	{12190, "ERROR_WINHTTP_HTTP_PROTOCOL_MISMATCH"},
+	{13000, "ERROR_IPSEC_QM_POLICY_EXISTS"},
+	{13001, "ERROR_IPSEC_QM_POLICY_NOT_FOUND"},
+	{13002, "ERROR_IPSEC_QM_POLICY_IN_USE"},
+	{13003, "ERROR_IPSEC_MM_POLICY_EXISTS"},
+	{13004, "ERROR_IPSEC_MM_POLICY_NOT_FOUND"},
+	{13005, "ERROR_IPSEC_MM_POLICY_IN_USE"},
+	{13006, "ERROR_IPSEC_MM_FILTER_EXISTS"},
+	{13007, "ERROR_IPSEC_MM_FILTER_NOT_FOUND"},
+	{13008, "ERROR_IPSEC_TRANSPORT_FILTER_EXISTS"},
+	{13009, "ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND"},
+	{13010, "ERROR_IPSEC_MM_AUTH_EXISTS"},
+	{13011, "ERROR_IPSEC_MM_AUTH_NOT_FOUND"},
+	{13012, "ERROR_IPSEC_MM_AUTH_IN_USE"},
+	{13013, "ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND"},
+	{13014, "ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND"},
+	{13015, "ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND"},
+	{13016, "ERROR_IPSEC_TUNNEL_FILTER_EXISTS"},
+	{13017, "ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND"},
+	{13018, "ERROR_IPSEC_MM_FILTER_PENDING_DELETION"},
+	{13019, "ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION"},
+	{13020, "ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION"},
+	{13021, "ERROR_IPSEC_MM_POLICY_PENDING_DELETION"},
+	{13022, "ERROR_IPSEC_MM_AUTH_PENDING_DELETION"},
+	{13023, "ERROR_IPSEC_QM_POLICY_PENDING_DELETION"},
+	{13024, "WARNING_IPSEC_MM_POLICY_PRUNED"},
+	{13025, "WARNING_IPSEC_QM_POLICY_PRUNED"},
+	{13800, "ERROR_IPSEC_IKE_NEG_STATUS_BEGIN"},
+	{13801, "ERROR_IPSEC_IKE_AUTH_FAIL"},
+	{13802, "ERROR_IPSEC_IKE_ATTRIB_FAIL"},
+	{13803, "ERROR_IPSEC_IKE_NEGOTIATION_PENDING"},
+	{13804, "ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR"},
+	{13805, "ERROR_IPSEC_IKE_TIMED_OUT"},
+	{13806, "ERROR_IPSEC_IKE_NO_CERT"},
+	{13807, "ERROR_IPSEC_IKE_SA_DELETED"},
+	{13808, "ERROR_IPSEC_IKE_SA_REAPED"},
+	{13809, "ERROR_IPSEC_IKE_MM_ACQUIRE_DROP"},
+	{13810, "ERROR_IPSEC_IKE_QM_ACQUIRE_DROP"},
+	{13811, "ERROR_IPSEC_IKE_QUEUE_DROP_MM"},
+	{13812, "ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM"},
+	{13813, "ERROR_IPSEC_IKE_DROP_NO_RESPONSE"},
+	{13814, "ERROR_IPSEC_IKE_MM_DELAY_DROP"},
+	{13815, "ERROR_IPSEC_IKE_QM_DELAY_DROP"},
+	{13816, "ERROR_IPSEC_IKE_ERROR"},
+	{13817, "ERROR_IPSEC_IKE_CRL_FAILED"},
+	{13818, "ERROR_IPSEC_IKE_INVALID_KEY_USAGE"},
+	{13819, "ERROR_IPSEC_IKE_INVALID_CERT_TYPE"},
+	{13820, "ERROR_IPSEC_IKE_NO_PRIVATE_KEY"},
+	{13821, "ERROR_IPSEC_IKE_SIMULTANEOUS_REKEY"},
+	{13822, "ERROR_IPSEC_IKE_DH_FAIL"},
+	{13823, "ERROR_IPSEC_IKE_CRITICAL_PAYLOAD_NOT_RECOGNIZED"},
+	{13824, "ERROR_IPSEC_IKE_INVALID_HEADER"},
+	{13825, "ERROR_IPSEC_IKE_NO_POLICY"},
+	{13826, "ERROR_IPSEC_IKE_INVALID_SIGNATURE"},
+	{13827, "ERROR_IPSEC_IKE_KERBEROS_ERROR"},
+	{13828, "ERROR_IPSEC_IKE_NO_PUBLIC_KEY"},
+	{13829, "ERROR_IPSEC_IKE_PROCESS_ERR"},
+	{13830, "ERROR_IPSEC_IKE_PROCESS_ERR_SA"},
+	{13831, "ERROR_IPSEC_IKE_PROCESS_ERR_PROP"},
+	{13832, "ERROR_IPSEC_IKE_PROCESS_ERR_TRANS"},
+	{13833, "ERROR_IPSEC_IKE_PROCESS_ERR_KE"},
+	{13834, "ERROR_IPSEC_IKE_PROCESS_ERR_ID"},
+	{13835, "ERROR_IPSEC_IKE_PROCESS_ERR_CERT"},
+	{13836, "ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ"},
+	{13837, "ERROR_IPSEC_IKE_PROCESS_ERR_HASH"},
+	{13838, "ERROR_IPSEC_IKE_PROCESS_ERR_SIG"},
+	{13839, "ERROR_IPSEC_IKE_PROCESS_ERR_NONCE"},
+	{13840, "ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY"},
+	{13841, "ERROR_IPSEC_IKE_PROCESS_ERR_DELETE"},
+	{13842, "ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR"},
+	{13843, "ERROR_IPSEC_IKE_INVALID_PAYLOAD"},
+	{13844, "ERROR_IPSEC_IKE_LOAD_SOFT_SA"},
+	{13845, "ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN"},
+	{13846, "ERROR_IPSEC_IKE_INVALID_COOKIE"},
+	{13847, "ERROR_IPSEC_IKE_NO_PEER_CERT"},
+	{13848, "ERROR_IPSEC_IKE_PEER_CRL_FAILED"},
+	{13849, "ERROR_IPSEC_IKE_POLICY_CHANGE"},
+	{13850, "ERROR_IPSEC_IKE_NO_MM_POLICY"},
+	{13851, "ERROR_IPSEC_IKE_NOTCBPRIV"},
+	{13852, "ERROR_IPSEC_IKE_SECLOADFAIL"},
+	{13853, "ERROR_IPSEC_IKE_FAILSSPINIT"},
+	{13854, "ERROR_IPSEC_IKE_FAILQUERYSSP"},
+	{13855, "ERROR_IPSEC_IKE_SRVACQFAIL"},
+	{13856, "ERROR_IPSEC_IKE_SRVQUERYCRED"},
+	{13857, "ERROR_IPSEC_IKE_GETSPIFAIL"},
+	{13858, "ERROR_IPSEC_IKE_INVALID_FILTER"},
+	{13859, "ERROR_IPSEC_IKE_OUT_OF_MEMORY"},
+	{13860, "ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED"},
+	{13861, "ERROR_IPSEC_IKE_INVALID_POLICY"},
+	{13862, "ERROR_IPSEC_IKE_UNKNOWN_DOI"},
+	{13863, "ERROR_IPSEC_IKE_INVALID_SITUATION"},
+	{13864, "ERROR_IPSEC_IKE_DH_FAILURE"},
+	{13865, "ERROR_IPSEC_IKE_INVALID_GROUP"},
+	{13866, "ERROR_IPSEC_IKE_ENCRYPT"},
+	{13867, "ERROR_IPSEC_IKE_DECRYPT"},
+	{13868, "ERROR_IPSEC_IKE_POLICY_MATCH"},
+	{13869, "ERROR_IPSEC_IKE_UNSUPPORTED_ID"},
+	{13870, "ERROR_IPSEC_IKE_INVALID_HASH"},
+	{13871, "ERROR_IPSEC_IKE_INVALID_HASH_ALG"},
+	{13872, "ERROR_IPSEC_IKE_INVALID_HASH_SIZE"},
+	{13873, "ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG"},
+	{13874, "ERROR_IPSEC_IKE_INVALID_AUTH_ALG"},
+	{13875, "ERROR_IPSEC_IKE_INVALID_SIG"},
+	{13876, "ERROR_IPSEC_IKE_LOAD_FAILED"},
+	{13877, "ERROR_IPSEC_IKE_RPC_DELETE"},
+	{13878, "ERROR_IPSEC_IKE_BENIGN_REINIT"},
+	{13879, "ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY"},
+	{13880, "ERROR_IPSEC_IKE_INVALID_MAJOR_VERSION"},
+	{13881, "ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN"},
+	{13882, "ERROR_IPSEC_IKE_MM_LIMIT"},
+	{13883, "ERROR_IPSEC_IKE_NEGOTIATION_DISABLED"},
+	{13884, "ERROR_IPSEC_IKE_QM_LIMIT"},
+	{13885, "ERROR_IPSEC_IKE_MM_EXPIRED"},
+	{13886, "ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID"},
+	{13887, "ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH"},
+	{13888, "ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID"},
+	{13889, "ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD"},
+	{13890, "ERROR_IPSEC_IKE_DOS_COOKIE_SENT"},
+	{13891, "ERROR_IPSEC_IKE_SHUTTING_DOWN"},
+	{13892, "ERROR_IPSEC_IKE_CGA_AUTH_FAILED"},
+	{13893, "ERROR_IPSEC_IKE_PROCESS_ERR_NATOA"},
+	{13894, "ERROR_IPSEC_IKE_INVALID_MM_FOR_QM"},
+	{13895, "ERROR_IPSEC_IKE_QM_EXPIRED"},
+	{13896, "ERROR_IPSEC_IKE_TOO_MANY_FILTERS"},
+	{13897, "ERROR_IPSEC_IKE_NEG_STATUS_END"},
+	{13898, "ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL"},
+	{13899, "ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE"},
+	{13900, "ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING"},
+	{13901, "ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING"},
+	{13902, "ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS"},
+	{13903, "ERROR_IPSEC_IKE_RATELIMIT_DROP"},
+	{13904, "ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE"},
+	{13905, "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE"},
+	{13906, "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE"},
+	{13907, "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY"},
+	{13908, "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE"},
+	{13909, "ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END"},
+	{13910, "ERROR_IPSEC_BAD_SPI"},
+	{13911, "ERROR_IPSEC_SA_LIFETIME_EXPIRED"},
+	{13912, "ERROR_IPSEC_WRONG_SA"},
+	{13913, "ERROR_IPSEC_REPLAY_CHECK_FAILED"},
+	{13914, "ERROR_IPSEC_INVALID_PACKET"},
+	{13915, "ERROR_IPSEC_INTEGRITY_CHECK_FAILED"},
+	{13916, "ERROR_IPSEC_CLEAR_TEXT_DROP"},
+	{13917, "ERROR_IPSEC_AUTH_FIREWALL_DROP"},
+	{13918, "ERROR_IPSEC_THROTTLE_DROP"},
+	{13925, "ERROR_IPSEC_DOSP_BLOCK"},
+	{13926, "ERROR_IPSEC_DOSP_RECEIVED_MULTICAST"},
+	{13927, "ERROR_IPSEC_DOSP_INVALID_PACKET"},
+	{13928, "ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED"},
+	{13929, "ERROR_IPSEC_DOSP_MAX_ENTRIES"},
+	{13930, "ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED"},
+	{13931, "ERROR_IPSEC_DOSP_NOT_INSTALLED"},
+	{13932, "ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES"},
+	{14000, "ERROR_SXS_SECTION_NOT_FOUND"},
+	{14001, "ERROR_SXS_CANT_GEN_ACTCTX"},
+	{14002, "ERROR_SXS_INVALID_ACTCTXDATA_FORMAT"},
+	{14003, "ERROR_SXS_ASSEMBLY_NOT_FOUND"},
+	{14004, "ERROR_SXS_MANIFEST_FORMAT_ERROR"},
+	{14005, "ERROR_SXS_MANIFEST_PARSE_ERROR"},
+	{14006, "ERROR_SXS_ACTIVATION_CONTEXT_DISABLED"},
+	{14007, "ERROR_SXS_KEY_NOT_FOUND"},
+	{14008, "ERROR_SXS_VERSION_CONFLICT"},
+	{14009, "ERROR_SXS_WRONG_SECTION_TYPE"},
+	{14010, "ERROR_SXS_THREAD_QUERIES_DISABLED"},
+	{14011, "ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET"},
+	{14012, "ERROR_SXS_UNKNOWN_ENCODING_GROUP"},
+	{14013, "ERROR_SXS_UNKNOWN_ENCODING"},
+	{14014, "ERROR_SXS_INVALID_XML_NAMESPACE_URI"},
+	{14015, "ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED"},
+	{14016, "ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED"},
+	{14017, "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE"},
+	{14018, "ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE"},
+	{14019, "ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE"},
+	{14020, "ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT"},
+	{14021, "ERROR_SXS_DUPLICATE_DLL_NAME"},
+	{14022, "ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME"},
+	{14023, "ERROR_SXS_DUPLICATE_CLSID"},
+	{14024, "ERROR_SXS_DUPLICATE_IID"},
+	{14025, "ERROR_SXS_DUPLICATE_TLBID"},
+	{14026, "ERROR_SXS_DUPLICATE_PROGID"},
+	{14027, "ERROR_SXS_DUPLICATE_ASSEMBLY_NAME"},
+	{14028, "ERROR_SXS_FILE_HASH_MISMATCH"},
+	{14029, "ERROR_SXS_POLICY_PARSE_ERROR"},
+	{14030, "ERROR_SXS_XML_E_MISSINGQUOTE"},
+	{14031, "ERROR_SXS_XML_E_COMMENTSYNTAX"},
+	{14032, "ERROR_SXS_XML_E_BADSTARTNAMECHAR"},
+	{14033, "ERROR_SXS_XML_E_BADNAMECHAR"},
+	{14034, "ERROR_SXS_XML_E_BADCHARINSTRING"},
+	{14035, "ERROR_SXS_XML_E_XMLDECLSYNTAX"},
+	{14036, "ERROR_SXS_XML_E_BADCHARDATA"},
+	{14037, "ERROR_SXS_XML_E_MISSINGWHITESPACE"},
+	{14038, "ERROR_SXS_XML_E_EXPECTINGTAGEND"},
+	{14039, "ERROR_SXS_XML_E_MISSINGSEMICOLON"},
+	{14040, "ERROR_SXS_XML_E_UNBALANCEDPAREN"},
+	{14041, "ERROR_SXS_XML_E_INTERNALERROR"},
+	{14042, "ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE"},
+	{14043, "ERROR_SXS_XML_E_INCOMPLETE_ENCODING"},
+	{14044, "ERROR_SXS_XML_E_MISSING_PAREN"},
+	{14045, "ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE"},
+	{14046, "ERROR_SXS_XML_E_MULTIPLE_COLONS"},
+	{14047, "ERROR_SXS_XML_E_INVALID_DECIMAL"},
+	{14048, "ERROR_SXS_XML_E_INVALID_HEXIDECIMAL"},
+	{14049, "ERROR_SXS_XML_E_INVALID_UNICODE"},
+	{14050, "ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK"},
+	{14051, "ERROR_SXS_XML_E_UNEXPECTEDENDTAG"},
+	{14052, "ERROR_SXS_XML_E_UNCLOSEDTAG"},
+	{14053, "ERROR_SXS_XML_E_DUPLICATEATTRIBUTE"},
+	{14054, "ERROR_SXS_XML_E_MULTIPLEROOTS"},
+	{14055, "ERROR_SXS_XML_E_INVALIDATROOTLEVEL"},
+	{14056, "ERROR_SXS_XML_E_BADXMLDECL"},
+	{14057, "ERROR_SXS_XML_E_MISSINGROOT"},
+	{14058, "ERROR_SXS_XML_E_UNEXPECTEDEOF"},
+	{14059, "ERROR_SXS_XML_E_BADPEREFINSUBSET"},
+	{14060, "ERROR_SXS_XML_E_UNCLOSEDSTARTTAG"},
+	{14061, "ERROR_SXS_XML_E_UNCLOSEDENDTAG"},
+	{14062, "ERROR_SXS_XML_E_UNCLOSEDSTRING"},
+	{14063, "ERROR_SXS_XML_E_UNCLOSEDCOMMENT"},
+	{14064, "ERROR_SXS_XML_E_UNCLOSEDDECL"},
+	{14065, "ERROR_SXS_XML_E_UNCLOSEDCDATA"},
+	{14066, "ERROR_SXS_XML_E_RESERVEDNAMESPACE"},
+	{14067, "ERROR_SXS_XML_E_INVALIDENCODING"},
+	{14068, "ERROR_SXS_XML_E_INVALIDSWITCH"},
+	{14069, "ERROR_SXS_XML_E_BADXMLCASE"},
+	{14070, "ERROR_SXS_XML_E_INVALID_STANDALONE"},
+	{14071, "ERROR_SXS_XML_E_UNEXPECTED_STANDALONE"},
+	{14072, "ERROR_SXS_XML_E_INVALID_VERSION"},
+	{14073, "ERROR_SXS_XML_E_MISSINGEQUALS"},
+	{14074, "ERROR_SXS_PROTECTION_RECOVERY_FAILED"},
+	{14075, "ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT"},
+	{14076, "ERROR_SXS_PROTECTION_CATALOG_NOT_VALID"},
+	{14077, "ERROR_SXS_UNTRANSLATABLE_HRESULT"},
+	{14078, "ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING"},
+	{14079, "ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE"},
+	{14080, "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME"},
+	{14081, "ERROR_SXS_ASSEMBLY_MISSING"},
+	{14082, "ERROR_SXS_CORRUPT_ACTIVATION_STACK"},
+	{14083, "ERROR_SXS_CORRUPTION"},
+	{14084, "ERROR_SXS_EARLY_DEACTIVATION"},
+	{14085, "ERROR_SXS_INVALID_DEACTIVATION"},
+	{14086, "ERROR_SXS_MULTIPLE_DEACTIVATION"},
+	{14087, "ERROR_SXS_PROCESS_TERMINATION_REQUESTED"},
+	{14088, "ERROR_SXS_RELEASE_ACTIVATION_CONTEXT"},
+	{14089, "ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY"},
+	{14090, "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE"},
+	{14091, "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME"},
+	{14092, "ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE"},
+	{14093, "ERROR_SXS_IDENTITY_PARSE_ERROR"},
+	{14094, "ERROR_MALFORMED_SUBSTITUTION_STRING"},
+	{14095, "ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN"},
+	{14096, "ERROR_UNMAPPED_SUBSTITUTION_STRING"},
+	{14097, "ERROR_SXS_ASSEMBLY_NOT_LOCKED"},
+	{14098, "ERROR_SXS_COMPONENT_STORE_CORRUPT"},
+	{14099, "ERROR_ADVANCED_INSTALLER_FAILED"},
+	{14100, "ERROR_XML_ENCODING_MISMATCH"},
+	{14101, "ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT"},
+	{14102, "ERROR_SXS_IDENTITIES_DIFFERENT"},
+	{14103, "ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT"},
+	{14104, "ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY"},
+	{14105, "ERROR_SXS_MANIFEST_TOO_BIG"},
+	{14106, "ERROR_SXS_SETTING_NOT_REGISTERED"},
+	{14107, "ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE"},
+	{14108, "ERROR_SMI_PRIMITIVE_INSTALLER_FAILED"},
+	{14109, "ERROR_GENERIC_COMMAND_FAILED"},
+	{14110, "ERROR_SXS_FILE_HASH_MISSING"},
+	{14111, "ERROR_SXS_DUPLICATE_ACTIVATABLE_CLASS"},
+	{15000, "ERROR_EVT_INVALID_CHANNEL_PATH"},
+	{15001, "ERROR_EVT_INVALID_QUERY"},
+	{15002, "ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND"},
+	{15003, "ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND"},
+	{15004, "ERROR_EVT_INVALID_PUBLISHER_NAME"},
+	{15005, "ERROR_EVT_INVALID_EVENT_DATA"},
+	{15007, "ERROR_EVT_CHANNEL_NOT_FOUND"},
+	{15008, "ERROR_EVT_MALFORMED_XML_TEXT"},
+	{15009, "ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL"},
+	{15010, "ERROR_EVT_CONFIGURATION_ERROR"},
+	{15011, "ERROR_EVT_QUERY_RESULT_STALE"},
+	{15012, "ERROR_EVT_QUERY_RESULT_INVALID_POSITION"},
+	{15013, "ERROR_EVT_NON_VALIDATING_MSXML"},
+	{15014, "ERROR_EVT_FILTER_ALREADYSCOPED"},
+	{15015, "ERROR_EVT_FILTER_NOTELTSET"},
+	{15016, "ERROR_EVT_FILTER_INVARG"},
+	{15017, "ERROR_EVT_FILTER_INVTEST"},
+	{15018, "ERROR_EVT_FILTER_INVTYPE"},
+	{15019, "ERROR_EVT_FILTER_PARSEERR"},
+	{15020, "ERROR_EVT_FILTER_UNSUPPORTEDOP"},
+	{15021, "ERROR_EVT_FILTER_UNEXPECTEDTOKEN"},
+	{15022, "ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL"},
+	{15023, "ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE"},
+	{15024, "ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE"},
+	{15025, "ERROR_EVT_CHANNEL_CANNOT_ACTIVATE"},
+	{15026, "ERROR_EVT_FILTER_TOO_COMPLEX"},
+	{15027, "ERROR_EVT_MESSAGE_NOT_FOUND"},
+	{15028, "ERROR_EVT_MESSAGE_ID_NOT_FOUND"},
+	{15029, "ERROR_EVT_UNRESOLVED_VALUE_INSERT"},
+	{15030, "ERROR_EVT_UNRESOLVED_PARAMETER_INSERT"},
+	{15031, "ERROR_EVT_MAX_INSERTS_REACHED"},
+	{15032, "ERROR_EVT_EVENT_DEFINITION_NOT_FOUND"},
+	{15033, "ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND"},
+	{15034, "ERROR_EVT_VERSION_TOO_OLD"},
+	{15035, "ERROR_EVT_VERSION_TOO_NEW"},
+	{15036, "ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY"},
+	{15037, "ERROR_EVT_PUBLISHER_DISABLED"},
+	{15038, "ERROR_EVT_FILTER_OUT_OF_RANGE"},
+	{15080, "ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE"},
+	{15081, "ERROR_EC_LOG_DISABLED"},
+	{15082, "ERROR_EC_CIRCULAR_FORWARDING"},
+	{15083, "ERROR_EC_CREDSTORE_FULL"},
+	{15084, "ERROR_EC_CRED_NOT_FOUND"},
+	{15085, "ERROR_EC_NO_ACTIVE_CHANNEL"},
+	{15100, "ERROR_MUI_FILE_NOT_FOUND"},
+	{15101, "ERROR_MUI_INVALID_FILE"},
+	{15102, "ERROR_MUI_INVALID_RC_CONFIG"},
+	{15103, "ERROR_MUI_INVALID_LOCALE_NAME"},
+	{15104, "ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME"},
+	{15105, "ERROR_MUI_FILE_NOT_LOADED"},
+	{15106, "ERROR_RESOURCE_ENUM_USER_STOP"},
+	{15107, "ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED"},
+	{15108, "ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME"},
+	{15110, "ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE"},
+	{15111, "ERROR_MRM_INVALID_PRICONFIG"},
+	{15112, "ERROR_MRM_INVALID_FILE_TYPE"},
+	{15113, "ERROR_MRM_UNKNOWN_QUALIFIER"},
+	{15114, "ERROR_MRM_INVALID_QUALIFIER_VALUE"},
+	{15115, "ERROR_MRM_NO_CANDIDATE"},
+	{15116, "ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE"},
+	{15117, "ERROR_MRM_RESOURCE_TYPE_MISMATCH"},
+	{15118, "ERROR_MRM_DUPLICATE_MAP_NAME"},
+	{15119, "ERROR_MRM_DUPLICATE_ENTRY"},
+	{15120, "ERROR_MRM_INVALID_RESOURCE_IDENTIFIER"},
+	{15121, "ERROR_MRM_FILEPATH_TOO_LONG"},
+	{15122, "ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE"},
+	{15126, "ERROR_MRM_INVALID_PRI_FILE"},
+	{15127, "ERROR_MRM_NAMED_RESOURCE_NOT_FOUND"},
+	{15135, "ERROR_MRM_MAP_NOT_FOUND"},
+	{15136, "ERROR_MRM_UNSUPPORTED_PROFILE_TYPE"},
+	{15137, "ERROR_MRM_INVALID_QUALIFIER_OPERATOR"},
+	{15138, "ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE"},
+	{15139, "ERROR_MRM_AUTOMERGE_ENABLED"},
+	{15140, "ERROR_MRM_TOO_MANY_RESOURCES"},
+	{15141, "ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_MERGE"},
+	{15142, "ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_LOAD_UNLOAD_PRI_FILE"},
+	{15143, "ERROR_MRM_NO_CURRENT_VIEW_ON_THREAD"},
+	{15144, "ERROR_DIFFERENT_PROFILE_RESOURCE_MANAGER_EXIST"},
+	{15145, "ERROR_OPERATION_NOT_ALLOWED_FROM_SYSTEM_COMPONENT"},
+	{15146, "ERROR_MRM_DIRECT_REF_TO_NON_DEFAULT_RESOURCE"},
+	{15147, "ERROR_MRM_GENERATION_COUNT_MISMATCH"},
+	{15148, "ERROR_PRI_MERGE_VERSION_MISMATCH"},
+	{15149, "ERROR_PRI_MERGE_MISSING_SCHEMA"},
+	{15150, "ERROR_PRI_MERGE_LOAD_FILE_FAILED"},
+	{15151, "ERROR_PRI_MERGE_ADD_FILE_FAILED"},
+	{15152, "ERROR_PRI_MERGE_WRITE_FILE_FAILED"},
+	{15153, "ERROR_PRI_MERGE_MULTIPLE_PACKAGE_FAMILIES_NOT_ALLOWED"},
+	{15154, "ERROR_PRI_MERGE_MULTIPLE_MAIN_PACKAGES_NOT_ALLOWED"},
+	{15155, "ERROR_PRI_MERGE_BUNDLE_PACKAGES_NOT_ALLOWED"},
+	{15156, "ERROR_PRI_MERGE_MAIN_PACKAGE_REQUIRED"},
+	{15157, "ERROR_PRI_MERGE_RESOURCE_PACKAGE_REQUIRED"},
+	{15158, "ERROR_PRI_MERGE_INVALID_FILE_NAME"},
+	{15159, "ERROR_MRM_PACKAGE_NOT_FOUND"},
+	{15160, "ERROR_MRM_MISSING_DEFAULT_LANGUAGE"},
+	{15200, "ERROR_MCA_INVALID_CAPABILITIES_STRING"},
+	{15201, "ERROR_MCA_INVALID_VCP_VERSION"},
+	{15202, "ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION"},
+	{15203, "ERROR_MCA_MCCS_VERSION_MISMATCH"},
+	{15204, "ERROR_MCA_UNSUPPORTED_MCCS_VERSION"},
+	{15205, "ERROR_MCA_INTERNAL_ERROR"},
+	{15206, "ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED"},
+	{15207, "ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE"},
+	{15250, "ERROR_AMBIGUOUS_SYSTEM_DEVICE"},
+	{15299, "ERROR_SYSTEM_DEVICE_NOT_FOUND"},
+	{15300, "ERROR_HASH_NOT_SUPPORTED"},
+	{15301, "ERROR_HASH_NOT_PRESENT"},
+	{15321, "ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED"},
+	{15322, "ERROR_GPIO_CLIENT_INFORMATION_INVALID"},
+	{15323, "ERROR_GPIO_VERSION_NOT_SUPPORTED"},
+	{15324, "ERROR_GPIO_INVALID_REGISTRATION_PACKET"},
+	{15325, "ERROR_GPIO_OPERATION_DENIED"},
+	{15326, "ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE"},
+	{15327, "ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED"},
+	{15400, "ERROR_CANNOT_SWITCH_RUNLEVEL"},
+	{15401, "ERROR_INVALID_RUNLEVEL_SETTING"},
+	{15402, "ERROR_RUNLEVEL_SWITCH_TIMEOUT"},
+	{15403, "ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT"},
+	{15404, "ERROR_RUNLEVEL_SWITCH_IN_PROGRESS"},
+	{15405, "ERROR_SERVICES_FAILED_AUTOSTART"},
+	{15501, "ERROR_COM_TASK_STOP_PENDING"},
+	{15600, "ERROR_INSTALL_OPEN_PACKAGE_FAILED"},
+	{15601, "ERROR_INSTALL_PACKAGE_NOT_FOUND"},
+	{15602, "ERROR_INSTALL_INVALID_PACKAGE"},
+	{15603, "ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED"},
+	{15604, "ERROR_INSTALL_OUT_OF_DISK_SPACE"},
+	{15605, "ERROR_INSTALL_NETWORK_FAILURE"},
+	{15606, "ERROR_INSTALL_REGISTRATION_FAILURE"},
+	{15607, "ERROR_INSTALL_DEREGISTRATION_FAILURE"},
+	{15608, "ERROR_INSTALL_CANCEL"},
+	{15609, "ERROR_INSTALL_FAILED"},
+	{15610, "ERROR_REMOVE_FAILED"},
+	{15611, "ERROR_PACKAGE_ALREADY_EXISTS"},
+	{15612, "ERROR_NEEDS_REMEDIATION"},
+	{15613, "ERROR_INSTALL_PREREQUISITE_FAILED"},
+	{15614, "ERROR_PACKAGE_REPOSITORY_CORRUPTED"},
+	{15615, "ERROR_INSTALL_POLICY_FAILURE"},
+	{15616, "ERROR_PACKAGE_UPDATING"},
+	{15617, "ERROR_DEPLOYMENT_BLOCKED_BY_POLICY"},
+	{15618, "ERROR_PACKAGES_IN_USE"},
+	{15619, "ERROR_RECOVERY_FILE_CORRUPT"},
+	{15620, "ERROR_INVALID_STAGED_SIGNATURE"},
+	{15621, "ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED"},
+	{15622, "ERROR_INSTALL_PACKAGE_DOWNGRADE"},
+	{15623, "ERROR_SYSTEM_NEEDS_REMEDIATION"},
+	{15624, "ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN"},
+	{15625, "ERROR_RESILIENCY_FILE_CORRUPT"},
+	{15626, "ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING"},
+	{15627, "ERROR_PACKAGE_MOVE_FAILED"},
+	{15628, "ERROR_INSTALL_VOLUME_NOT_EMPTY"},
+	{15629, "ERROR_INSTALL_VOLUME_OFFLINE"},
+	{15630, "ERROR_INSTALL_VOLUME_CORRUPT"},
+	{15631, "ERROR_NEEDS_REGISTRATION"},
+	{15632, "ERROR_INSTALL_WRONG_PROCESSOR_ARCHITECTURE"},
+	{15633, "ERROR_DEV_SIDELOAD_LIMIT_EXCEEDED"},
+	{15634, "ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE"},
+	{15635, "ERROR_PACKAGE_NOT_SUPPORTED_ON_FILESYSTEM"},
+	{15636, "ERROR_PACKAGE_MOVE_BLOCKED_BY_STREAMING"},
+	{15637, "ERROR_INSTALL_OPTIONAL_PACKAGE_APPLICATIONID_NOT_UNIQUE"},
+	{15638, "ERROR_PACKAGE_STAGING_ONHOLD"},
+	{15639, "ERROR_INSTALL_INVALID_RELATED_SET_UPDATE"},
+	{15640, "ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_FULLTRUST_CAPABILITY"},
+	{15641, "ERROR_DEPLOYMENT_BLOCKED_BY_USER_LOG_OFF"},
+	{15642, "ERROR_PROVISION_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_PROVISIONED"},
+	{15643, "ERROR_PACKAGES_REPUTATION_CHECK_FAILED"},
+	{15644, "ERROR_PACKAGES_REPUTATION_CHECK_TIMEDOUT"},
+	{15645, "ERROR_DEPLOYMENT_OPTION_NOT_SUPPORTED"},
+	{15646, "ERROR_APPINSTALLER_ACTIVATION_BLOCKED"},
+	{15647, "ERROR_REGISTRATION_FROM_REMOTE_DRIVE_NOT_SUPPORTED"},
+	{15648, "ERROR_APPX_RAW_DATA_WRITE_FAILED"},
+	{15649, "ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_PACKAGE"},
+	{15650, "ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_MACHINE"},
+	{15651, "ERROR_DEPLOYMENT_BLOCKED_BY_PROFILE_POLICY"},
+	{15652, "ERROR_DEPLOYMENT_FAILED_CONFLICTING_MUTABLE_PACKAGE_DIRECTORY"},
+	{15653, "ERROR_SINGLETON_RESOURCE_INSTALLED_IN_ACTIVE_USER"},
+	{15654, "ERROR_DIFFERENT_VERSION_OF_PACKAGED_SERVICE_INSTALLED"},
+	{15655, "ERROR_SERVICE_EXISTS_AS_NON_PACKAGED_SERVICE"},
+	{15656, "ERROR_PACKAGED_SERVICE_REQUIRES_ADMIN_PRIVILEGES"},
+	{15700, "APPMODEL_ERROR_NO_PACKAGE"},
+	{15701, "APPMODEL_ERROR_PACKAGE_RUNTIME_CORRUPT"},
+	{15702, "APPMODEL_ERROR_PACKAGE_IDENTITY_CORRUPT"},
+	{15703, "APPMODEL_ERROR_NO_APPLICATION"},
+	{15704, "APPMODEL_ERROR_DYNAMIC_PROPERTY_READ_FAILED"},
+	{15705, "APPMODEL_ERROR_DYNAMIC_PROPERTY_INVALID"},
+	{15706, "APPMODEL_ERROR_PACKAGE_NOT_AVAILABLE"},
+	{15707, "APPMODEL_ERROR_NO_MUTABLE_DIRECTORY"},
+	{15800, "ERROR_STATE_LOAD_STORE_FAILED"},
+	{15801, "ERROR_STATE_GET_VERSION_FAILED"},
+	{15802, "ERROR_STATE_SET_VERSION_FAILED"},
+	{15803, "ERROR_STATE_STRUCTURED_RESET_FAILED"},
+	{15804, "ERROR_STATE_OPEN_CONTAINER_FAILED"},
+	{15805, "ERROR_STATE_CREATE_CONTAINER_FAILED"},
+	{15806, "ERROR_STATE_DELETE_CONTAINER_FAILED"},
+	{15807, "ERROR_STATE_READ_SETTING_FAILED"},
+	{15808, "ERROR_STATE_WRITE_SETTING_FAILED"},
+	{15809, "ERROR_STATE_DELETE_SETTING_FAILED"},
+	{15810, "ERROR_STATE_QUERY_SETTING_FAILED"},
+	{15811, "ERROR_STATE_READ_COMPOSITE_SETTING_FAILED"},
+	{15812, "ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED"},
+	{15813, "ERROR_STATE_ENUMERATE_CONTAINER_FAILED"},
+	{15814, "ERROR_STATE_ENUMERATE_SETTINGS_FAILED"},
+	{15815, "ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED"},
+	{15816, "ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED"},
+	{15817, "ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED"},
+	{15818, "ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED"},
+	{15841, "ERROR_API_UNAVAILABLE"},
+	{15861, "STORE_ERROR_UNLICENSED"},
+	{15862, "STORE_ERROR_UNLICENSED_USER"},
+	{15863, "STORE_ERROR_PENDING_COM_TRANSACTION"},
+	{15864, "STORE_ERROR_LICENSE_REVOKED"},
+}
+
+// NTSTATUS codes, sorted by code, which can be compared with
+// errors.Is()
+const (
+	StatusSuccess                                               NTStatus = 0x00000000
+	StatusWait0                                                 NTStatus = 0x00000000
+	StatusWait1                                                 NTStatus = 0x00000001
+	StatusWait2                                                 NTStatus = 0x00000002
+	StatusWait3                                                 NTStatus = 0x00000003
+	StatusWait63                                                NTStatus = 0x0000003F
+	StatusAbandoned                                             NTStatus = 0x00000080
+	StatusAbandonedWait0                                        NTStatus = 0x00000080
+	StatusAbandonedWait63                                       NTStatus = 0x000000BF
+	StatusUserApc                                               NTStatus = 0x000000C0
+	StatusAlreadyComplete                                       NTStatus = 0x000000FF
+	StatusKernelApc                                             NTStatus = 0x00000100
+	StatusAlerted                                               NTStatus = 0x00000101
+	StatusTimeout                                               NTStatus = 0x00000102
+	StatusPending                                               NTStatus = 0x00000103
+	StatusReparse                                               NTStatus = 0x00000104
+	StatusMoreEntries                                           NTStatus = 0x00000105
+	StatusNotAllAssigned                                        NTStatus = 0x00000106
+	StatusSomeNotMapped                                         NTStatus = 0x00000107
+	StatusOplockBreakInProgress                                 NTStatus = 0x00000108
+	StatusVolumeMounted                                         NTStatus = 0x00000109
+	StatusRxactCommitted                                        NTStatus = 0x0000010A
+	StatusNotifyCleanup                                         NTStatus = 0x0000010B
+	StatusNotifyEnumDir                                         NTStatus = 0x0000010C
+	StatusNoQuotasForAccount                                    NTStatus = 0x0000010D
+	StatusPrimaryTransportConnectFailed                         NTStatus = 0x0000010E
+	StatusPageFaultTransition                                   NTStatus = 0x00000110
+	StatusPageFaultDemandZero                                   NTStatus = 0x00000111
+	StatusPageFaultCopyOnWrite                                  NTStatus = 0x00000112
+	StatusPageFaultGuardPage                                    NTStatus = 0x00000113
+	StatusPageFaultPagingFile                                   NTStatus = 0x00000114
+	StatusCachePageLocked                                       NTStatus = 0x00000115
+	StatusCrashDump                                             NTStatus = 0x00000116
+	StatusBufferAllZeros                                        NTStatus = 0x00000117
+	StatusReparseObject                                         NTStatus = 0x00000118
+	StatusResourceRequirementsChanged                           NTStatus = 0x00000119
+	StatusTranslationComplete                                   NTStatus = 0x00000120
+	StatusDsMembershipEvaluatedLocally                          NTStatus = 0x00000121
+	StatusNothingToTerminate                                    NTStatus = 0x00000122
+	StatusProcessNotInJob                                       NTStatus = 0x00000123
+	StatusProcessInJob                                          NTStatus = 0x00000124
+	StatusVolsnapHibernateReady                                 NTStatus = 0x00000125
+	StatusFsfilterOpCompletedSuccessfully                       NTStatus = 0x00000126
+	StatusInterruptVectorAlreadyConnected                       NTStatus = 0x00000127
+	StatusInterruptStillConnected                               NTStatus = 0x00000128
+	StatusProcessCloned                                         NTStatus = 0x00000129
+	StatusFileLockedWithOnlyReaders                             NTStatus = 0x0000012A
+	StatusFileLockedWithWriters                                 NTStatus = 0x0000012B
+	StatusValidImageHash                                        NTStatus = 0x0000012C
+	StatusValidCatalogHash                                      NTStatus = 0x0000012D
+	StatusValidStrongCodeHash                                   NTStatus = 0x0000012E
+	StatusGhosted                                               NTStatus = 0x0000012F
+	StatusDataOverwritten                                       NTStatus = 0x00000130
+	StatusResourcemanagerReadOnly                               NTStatus = 0x00000202
+	StatusRingPreviouslyEmpty                                   NTStatus = 0x00000210
+	StatusRingPreviouslyFull                                    NTStatus = 0x00000211
+	StatusRingPreviouslyAboveQuota                              NTStatus = 0x00000212
+	StatusRingNewlyEmpty                                        NTStatus = 0x00000213
+	StatusRingSignalOppositeEndpoint                            NTStatus = 0x00000214
+	StatusOplockSwitchedToNewHandle                             NTStatus = 0x00000215
+	StatusOplockHandleClosed                                    NTStatus = 0x00000216
+	StatusWaitForOplock                                         NTStatus = 0x00000367
+	StatusReparseGlobal                                         NTStatus = 0x00000368
+	StatusFltIoComplete                                         NTStatus = 0x001C0001
+	StatusRtpmContextContinue                                   NTStatus = 0x00293000
+	StatusRtpmContextComplete                                   NTStatus = 0x00293001
+	StatusHvPendingPageRequests                                 NTStatus = 0x00350059
+	StatusSpacesRepaired                                        NTStatus = 0x00E70000
+	StatusSpacesPause                                           NTStatus = 0x00E70001
+	StatusSpacesComplete                                        NTStatus = 0x00E70002
+	StatusSpacesRedirect                                        NTStatus = 0x00E70003
+	StatusObjectNameExists                                      NTStatus = 0x40000000
+	StatusThreadWasSuspended                                    NTStatus = 0x40000001
+	StatusWorkingSetLimitRange                                  NTStatus = 0x40000002
+	StatusRxactStateCreated                                     NTStatus = 0x40000004
+	StatusSegmentNotification                                   NTStatus = 0x40000005
+	StatusLocalUserSessionKey                                   NTStatus = 0x40000006
+	StatusBadCurrentDirectory                                   NTStatus = 0x40000007
+	StatusSerialMoreWrites                                      NTStatus = 0x40000008
+	StatusRegistryRecovered                                     NTStatus = 0x40000009
+	StatusFtReadRecoveryFromBackup                              NTStatus = 0x4000000A
+	StatusFtWriteRecovery                                       NTStatus = 0x4000000B
+	StatusSerialCounterTimeout                                  NTStatus = 0x4000000C
+	StatusNullLmPassword                                        NTStatus = 0x4000000D
+	StatusImageMachineTypeMismatch                              NTStatus = 0x4000000E
+	StatusReceivePartial                                        NTStatus = 0x4000000F
+	StatusReceiveExpedited                                      NTStatus = 0x40000010
+	StatusReceivePartialExpedited                               NTStatus = 0x40000011
+	StatusEventDone                                             NTStatus = 0x40000012
+	StatusEventPending                                          NTStatus = 0x40000013
+	StatusCheckingFileSystem                                    NTStatus = 0x40000014
+	StatusFatalAppExit                                          NTStatus = 0x40000015
+	StatusPredefinedHandle                                      NTStatus = 0x40000016
+	StatusWasUnlocked                                           NTStatus = 0x40000017
+	StatusServiceNotification                                   NTStatus = 0x40000018
+	StatusWasLocked                                             NTStatus = 0x40000019
+	StatusLogHardError                                          NTStatus = 0x4000001A
+	StatusAlreadyWin32                                          NTStatus = 0x4000001B
+	StatusWx86Unsimulate                                        NTStatus = 0x4000001C
+	StatusWx86Continue                                          NTStatus = 0x4000001D
+	StatusWx86SingleStep                                        NTStatus = 0x4000001E
+	StatusWx86Breakpoint                                        NTStatus = 0x4000001F
+	StatusWx86ExceptionContinue                                 NTStatus = 0x40000020
+	StatusWx86ExceptionLastchance                               NTStatus = 0x40000021
+	StatusWx86ExceptionChain                                    NTStatus = 0x40000022
+	StatusImageMachineTypeMismatchExe                           NTStatus = 0x40000023
+	StatusNoYieldPerformed                                      NTStatus = 0x40000024
+	StatusTimerResumeIgnored                                    NTStatus = 0x40000025
+	StatusArbitrationUnhandled                                  NTStatus = 0x40000026
+	StatusCardbusNotSupported                                   NTStatus = 0x40000027
+	StatusWx86Createwx86tib                                     NTStatus = 0x40000028
+	StatusMpProcessorMismatch                                   NTStatus = 0x40000029
+	StatusHibernated                                            NTStatus = 0x4000002A
+	StatusResumeHibernation                                     NTStatus = 0x4000002B
+	StatusFirmwareUpdated                                       NTStatus = 0x4000002C
+	StatusDriversLeakingLockedPages                             NTStatus = 0x4000002D
+	StatusMessageRetrieved                                      NTStatus = 0x4000002E
+	StatusSystemPowerstateTransition                            NTStatus = 0x4000002F
+	StatusAlpcCheckCompletionList                               NTStatus = 0x40000030
+	StatusSystemPowerstateComplexTransition                     NTStatus = 0x40000031
+	StatusAccessAuditByPolicy                                   NTStatus = 0x40000032
+	StatusAbandonHiberfile                                      NTStatus = 0x40000033
+	StatusBizrulesNotEnabled                                    NTStatus = 0x40000034
+	StatusFtReadFromCopy                                        NTStatus = 0x40000035
+	StatusPatchDeferred                                         NTStatus = 0x40000037
+	StatusWakeSystem                                            NTStatus = 0x40000294
+	StatusDsShuttingDown                                        NTStatus = 0x40000370
+	StatusDiskRepairRedirected                                  NTStatus = 0x40000807
+	StatusServicesFailedAutostart                               NTStatus = 0x4000A144
+	StatusCtxCdmConnect                                         NTStatus = 0x400A0004
+	StatusCtxCdmDisconnect                                      NTStatus = 0x400A0005
+	StatusSxsReleaseActivationContext                           NTStatus = 0x4015000D
+	StatusHeuristicDamagePossible                               NTStatus = 0x40190001
+	StatusRecoveryNotNeeded                                     NTStatus = 0x40190034
+	StatusRmAlreadyStarted                                      NTStatus = 0x40190035
+	StatusLogNoRestart                                          NTStatus = 0x401A000C
+	StatusVideoDriverDebugReportRequest                         NTStatus = 0x401B00EC
+	StatusGraphicsPartialDataPopulated                          NTStatus = 0x401E000A
+	StatusGraphicsSkipAllocationPreparation                     NTStatus = 0x401E0201
+	StatusGraphicsModeNotPinned                                 NTStatus = 0x401E0307
+	StatusGraphicsNoPreferredMode                               NTStatus = 0x401E031E
+	StatusGraphicsDatasetIsEmpty                                NTStatus = 0x401E034B
+	StatusGraphicsNoMoreElementsInDataset                       NTStatus = 0x401E034C
+	StatusGraphicsPathContentGeometryTransformationNotPinned    NTStatus = 0x401E0351
+	StatusGraphicsUnknownChildStatus                            NTStatus = 0x401E042F
+	StatusGraphicsLeadlinkStartDeferred                         NTStatus = 0x401E0437
+	StatusGraphicsPollingTooFrequently                          NTStatus = 0x401E0439
+	StatusGraphicsStartDeferred                                 NTStatus = 0x401E043A
+	StatusGraphicsDependableChildStatus                         NTStatus = 0x401E043C
+	StatusNdisIndicationRequired                                NTStatus = 0x40230001
+	StatusPcpUnsupportedPssSalt                                 NTStatus = 0x40292023
+	StatusGuardPageViolation                                    NTStatus = 0x80000001
+	StatusDatatypeMisalignment                                  NTStatus = 0x80000002
+	StatusBreakpoint                                            NTStatus = 0x80000003
+	StatusSingleStep                                            NTStatus = 0x80000004
+	StatusBufferOverflow                                        NTStatus = 0x80000005
+	StatusNoMoreFiles                                           NTStatus = 0x80000006
+	StatusWakeSystemDebugger                                    NTStatus = 0x80000007
+	StatusHandlesClosed                                         NTStatus = 0x8000000A
+	StatusNoInheritance                                         NTStatus = 0x8000000B
+	StatusGuidSubstitutionMade                                  NTStatus = 0x8000000C
+	StatusPartialCopy                                           NTStatus = 0x8000000D
+	StatusDevicePaperEmpty                                      NTStatus = 0x8000000E
+	StatusDevicePoweredOff                                      NTStatus = 0x8000000F
+	StatusDeviceOffLine                                         NTStatus = 0x80000010
+	StatusDeviceBusy                                            NTStatus = 0x80000011
+	StatusNoMoreEas                                             NTStatus = 0x80000012
+	StatusInvalidEaName                                         NTStatus = 0x80000013
+	StatusEaListInconsistent                                    NTStatus = 0x80000014
+	StatusInvalidEaFlag                                         NTStatus = 0x80000015
+	StatusVerifyRequired                                        NTStatus = 0x80000016
+	StatusExtraneousInformation                                 NTStatus = 0x80000017
+	StatusRxactCommitNecessary                                  NTStatus = 0x80000018
+	StatusNoMoreEntries                                         NTStatus = 0x8000001A
+	StatusFilemarkDetected                                      NTStatus = 0x8000001B
+	StatusMediaChanged                                          NTStatus = 0x8000001C
+	StatusBusReset                                              NTStatus = 0x8000001D
+	StatusEndOfMedia                                            NTStatus = 0x8000001E
+	StatusBeginningOfMedia                                      NTStatus = 0x8000001F
+	StatusMediaCheck                                            NTStatus = 0x80000020
+	StatusSetmarkDetected                                       NTStatus = 0x80000021
+	StatusNoDataDetected                                        NTStatus = 0x80000022
+	StatusRedirectorHasOpenHandles                              NTStatus = 0x80000023
+	StatusServerHasOpenHandles                                  NTStatus = 0x80000024
+	StatusAlreadyDisconnected                                   NTStatus = 0x80000025
+	StatusLongjump                                              NTStatus = 0x80000026
+	StatusCleanerCartridgeInstalled                             NTStatus = 0x80000027
+	StatusPlugplayQueryVetoed                                   NTStatus = 0x80000028
+	StatusUnwindConsolidate                                     NTStatus = 0x80000029
+	StatusRegistryHiveRecovered                                 NTStatus = 0x8000002A
+	StatusDllMightBeInsecure                                    NTStatus = 0x8000002B
+	StatusDllMightBeIncompatible                                NTStatus = 0x8000002C
+	StatusStoppedOnSymlink                                      NTStatus = 0x8000002D
+	StatusCannotGrantRequestedOplock                            NTStatus = 0x8000002E
+	StatusNoAceCondition                                        NTStatus = 0x8000002F
+	StatusDeviceSupportInProgress                               NTStatus = 0x80000030
+	StatusDevicePowerCycleRequired                              NTStatus = 0x80000031
+	StatusNoWorkDone                                            NTStatus = 0x80000032
+	StatusDeviceRequiresCleaning                                NTStatus = 0x80000288
+	StatusDeviceDoorOpen                                        NTStatus = 0x80000289
+	StatusDataLostRepair                                        NTStatus = 0x80000803
+	StatusGpioInterruptAlreadyUnmasked                          NTStatus = 0x8000A127
+	StatusCloudFilePropertyBlobChecksumMismatch                 NTStatus = 0x8000CF00
+	StatusCloudFilePropertyBlobTooLarge                         NTStatus = 0x8000CF04
+	StatusCloudFileTooManyPropertyBlobs                         NTStatus = 0x8000CF05
+	StatusClusterNodeAlreadyUp                                  NTStatus = 0x80130001
+	StatusClusterNodeAlreadyDown                                NTStatus = 0x80130002
+	StatusClusterNetworkAlreadyOnline                           NTStatus = 0x80130003
+	StatusClusterNetworkAlreadyOffline                          NTStatus = 0x80130004
+	StatusClusterNodeAlreadyMember                              NTStatus = 0x80130005
+	StatusCouldNotResizeLog                                     NTStatus = 0x80190009
+	StatusNoTxfMetadata                                         NTStatus = 0x80190029
+	StatusCantRecoverWithHandleOpen                             NTStatus = 0x80190031
+	StatusTxfMetadataAlreadyPresent                             NTStatus = 0x80190041
+	StatusTransactionScopeCallbacksNotSet                       NTStatus = 0x80190042
+	StatusVideoHungDisplayDriverThreadRecovered                 NTStatus = 0x801B00EB
+	StatusFltBufferTooSmall                                     NTStatus = 0x801C0001
+	StatusFvePartialMetadata                                    NTStatus = 0x80210001
+	StatusFveTransientState                                     NTStatus = 0x80210002
+	StatusVidRemoteNodeParentGpaPagesUsed                       NTStatus = 0x80370001
+	StatusVolmgrIncompleteRegeneration                          NTStatus = 0x80380001
+	StatusVolmgrIncompleteDiskMigration                         NTStatus = 0x80380002
+	StatusBcdNotAllEntriesImported                              NTStatus = 0x80390001
+	StatusBcdNotAllEntriesSynchronized                          NTStatus = 0x80390003
+	StatusQueryStorageError                                     NTStatus = 0x803A0001
+	StatusGdiHandleLeak                                         NTStatus = 0x803F0001
+	StatusSecurebootNotEnabled                                  NTStatus = 0x80430006
+	StatusUnsuccessful                                          NTStatus = 0xC0000001
+	StatusNotImplemented                                        NTStatus = 0xC0000002
+	StatusInvalidInfoClass                                      NTStatus = 0xC0000003
+	StatusInfoLengthMismatch                                    NTStatus = 0xC0000004
+	StatusAccessViolation                                       NTStatus = 0xC0000005
+	StatusInPageError                                           NTStatus = 0xC0000006
+	StatusPagefileQuota                                         NTStatus = 0xC0000007
+	StatusInvalidHandle                                         NTStatus = 0xC0000008
+	StatusBadInitialStack                                       NTStatus = 0xC0000009
+	StatusBadInitialPc                                          NTStatus = 0xC000000A
+	StatusInvalidCid                                            NTStatus = 0xC000000B
+	StatusTimerNotCanceled                                      NTStatus = 0xC000000C
+	StatusInvalidParameter                                      NTStatus = 0xC000000D
+	StatusNoSuchDevice                                          NTStatus = 0xC000000E
+	StatusNoSuchFile                                            NTStatus = 0xC000000F
+	StatusInvalidDeviceRequest                                  NTStatus = 0xC0000010
+	StatusEndOfFile                                             NTStatus = 0xC0000011
+	StatusWrongVolume                                           NTStatus = 0xC0000012
+	StatusNoMediaInDevice                                       NTStatus = 0xC0000013
+	StatusUnrecognizedMedia                                     NTStatus = 0xC0000014
+	StatusNonexistentSector                                     NTStatus = 0xC0000015
+	StatusMoreProcessingRequired                                NTStatus = 0xC0000016
+	StatusNoMemory                                              NTStatus = 0xC0000017
+	StatusConflictingAddresses                                  NTStatus = 0xC0000018
+	StatusNotMappedView                                         NTStatus = 0xC0000019
+	StatusUnableToFreeVm                                        NTStatus = 0xC000001A
+	StatusUnableToDeleteSection                                 NTStatus = 0xC000001B
+	StatusInvalidSystemService                                  NTStatus = 0xC000001C
+	StatusIllegalInstruction                                    NTStatus = 0xC000001D
+	StatusInvalidLockSequence                                   NTStatus = 0xC000001E
+	StatusInvalidViewSize                                       NTStatus = 0xC000001F
+	StatusInvalidFileForSection                                 NTStatus = 0xC0000020
+	StatusAlreadyCommitted                                      NTStatus = 0xC0000021
+	StatusAccessDenied                                          NTStatus = 0xC0000022
+	StatusBufferTooSmall                                        NTStatus = 0xC0000023
+	StatusObjectTypeMismatch                                    NTStatus = 0xC0000024
+	StatusNoncontinuableException                               NTStatus = 0xC0000025
+	StatusInvalidDisposition                                    NTStatus = 0xC0000026
+	StatusUnwind                                                NTStatus = 0xC0000027
+	StatusBadStack                                              NTStatus = 0xC0000028
+	StatusInvalidUnwindTarget                                   NTStatus = 0xC0000029
+	StatusNotLocked                                             NTStatus = 0xC000002A
+	StatusParityError                                           NTStatus = 0xC000002B
+	StatusUnableToDecommitVm                                    NTStatus = 0xC000002C
+	StatusNotCommitted                                          NTStatus = 0xC000002D
+	StatusInvalidPortAttributes                                 NTStatus = 0xC000002E
+	StatusPortMessageTooLong                                    NTStatus = 0xC000002F
+	StatusInvalidParameterMix                                   NTStatus = 0xC0000030
+	StatusInvalidQuotaLower                                     NTStatus = 0xC0000031
+	StatusDiskCorruptError                                      NTStatus = 0xC0000032
+	StatusObjectNameInvalid                                     NTStatus = 0xC0000033
+	StatusObjectNameNotFound                                    NTStatus = 0xC0000034
+	StatusObjectNameCollision                                   NTStatus = 0xC0000035
+	StatusPortDoNotDisturb                                      NTStatus = 0xC0000036
+	StatusPortDisconnected                                      NTStatus = 0xC0000037
+	StatusDeviceAlreadyAttached                                 NTStatus = 0xC0000038
+	StatusObjectPathInvalid                                     NTStatus = 0xC0000039
+	StatusObjectPathNotFound                                    NTStatus = 0xC000003A
+	StatusObjectPathSyntaxBad                                   NTStatus = 0xC000003B
+	StatusDataOverrun                                           NTStatus = 0xC000003C
+	StatusDataLateError                                         NTStatus = 0xC000003D
+	StatusDataError                                             NTStatus = 0xC000003E
+	StatusCrcError                                              NTStatus = 0xC000003F
+	StatusSectionTooBig                                         NTStatus = 0xC0000040
+	StatusPortConnectionRefused                                 NTStatus = 0xC0000041
+	StatusInvalidPortHandle                                     NTStatus = 0xC0000042
+	StatusSharingViolation                                      NTStatus = 0xC0000043
+	StatusQuotaExceeded                                         NTStatus = 0xC0000044
+	StatusInvalidPageProtection                                 NTStatus = 0xC0000045
+	StatusMutantNotOwned                                        NTStatus = 0xC0000046
+	StatusSemaphoreLimitExceeded                                NTStatus = 0xC0000047
+	StatusPortAlreadySet                                        NTStatus = 0xC0000048
+	StatusSectionNotImage                                       NTStatus = 0xC0000049
+	StatusSuspendCountExceeded                                  NTStatus = 0xC000004A
+	StatusThreadIsTerminating                                   NTStatus = 0xC000004B
+	StatusBadWorkingSetLimit                                    NTStatus = 0xC000004C
+	StatusIncompatibleFileMap                                   NTStatus = 0xC000004D
+	StatusSectionProtection                                     NTStatus = 0xC000004E
+	StatusEasNotSupported                                       NTStatus = 0xC000004F
+	StatusEaTooLarge                                            NTStatus = 0xC0000050
+	StatusNonexistentEaEntry                                    NTStatus = 0xC0000051
+	StatusNoEasOnFile                                           NTStatus = 0xC0000052
+	StatusEaCorruptError                                        NTStatus = 0xC0000053
+	StatusFileLockConflict                                      NTStatus = 0xC0000054
+	StatusLockNotGranted                                        NTStatus = 0xC0000055
+	StatusDeletePending                                         NTStatus = 0xC0000056
+	StatusCtlFileNotSupported                                   NTStatus = 0xC0000057
+	StatusUnknownRevision                                       NTStatus = 0xC0000058
+	StatusRevisionMismatch                                      NTStatus = 0xC0000059
+	StatusInvalidOwner                                          NTStatus = 0xC000005A
+	StatusInvalidPrimaryGroup                                   NTStatus = 0xC000005B
+	StatusNoImpersonationToken                                  NTStatus = 0xC000005C
+	StatusCantDisableMandatory                                  NTStatus = 0xC000005D
+	StatusNoLogonServers                                        NTStatus = 0xC000005E
+	StatusNoSuchLogonSession                                    NTStatus = 0xC000005F
+	StatusNoSuchPrivilege                                       NTStatus = 0xC0000060
+	StatusPrivilegeNotHeld                                      NTStatus = 0xC0000061
+	StatusInvalidAccountName                                    NTStatus = 0xC0000062
+	StatusUserExists                                            NTStatus = 0xC0000063
+	StatusNoSuchUser                                            NTStatus = 0xC0000064
+	StatusGroupExists                                           NTStatus = 0xC0000065
+	StatusNoSuchGroup                                           NTStatus = 0xC0000066
+	StatusMemberInGroup                                         NTStatus = 0xC0000067
+	StatusMemberNotInGroup                                      NTStatus = 0xC0000068
+	StatusLastAdmin                                             NTStatus = 0xC0000069
+	StatusWrongPassword                                         NTStatus = 0xC000006A
+	StatusIllFormedPassword                                     NTStatus = 0xC000006B
+	StatusPasswordRestriction                                   NTStatus = 0xC000006C
+	StatusLogonFailure                                          NTStatus = 0xC000006D
+	StatusAccountRestriction                                    NTStatus = 0xC000006E
+	StatusInvalidLogonHours                                     NTStatus = 0xC000006F
+	StatusInvalidWorkstation                                    NTStatus = 0xC0000070
+	StatusPasswordExpired                                       NTStatus = 0xC0000071
+	StatusAccountDisabled                                       NTStatus = 0xC0000072
+	StatusNoneMapped                                            NTStatus = 0xC0000073
+	StatusTooManyLuidsRequested                                 NTStatus = 0xC0000074
+	StatusLuidsExhausted                                        NTStatus = 0xC0000075
+	StatusInvalidSubAuthority                                   NTStatus = 0xC0000076
+	StatusInvalidAcl                                            NTStatus = 0xC0000077
+	StatusInvalidSid                                            NTStatus = 0xC0000078
+	StatusInvalidSecurityDescr                                  NTStatus = 0xC0000079
+	StatusProcedureNotFound                                     NTStatus = 0xC000007A
+	StatusInvalidImageFormat                                    NTStatus = 0xC000007B
+	StatusNoToken                                               NTStatus = 0xC000007C
+	StatusBadInheritanceAcl                                     NTStatus = 0xC000007D
+	StatusRangeNotLocked                                        NTStatus = 0xC000007E
+	StatusDiskFull                                              NTStatus = 0xC000007F
+	StatusServerDisabled                                        NTStatus = 0xC0000080
+	StatusServerNotDisabled                                     NTStatus = 0xC0000081
+	StatusTooManyGuidsRequested                                 NTStatus = 0xC0000082
+	StatusGuidsExhausted                                        NTStatus = 0xC0000083
+	StatusInvalidIdAuthority                                    NTStatus = 0xC0000084
+	StatusAgentsExhausted                                       NTStatus = 0xC0000085
+	StatusInvalidVolumeLabel                                    NTStatus = 0xC0000086
+	StatusSectionNotExtended                                    NTStatus = 0xC0000087
+	StatusNotMappedData                                         NTStatus = 0xC0000088
+	StatusResourceDataNotFound                                  NTStatus = 0xC0000089
+	StatusResourceTypeNotFound                                  NTStatus = 0xC000008A
+	StatusResourceNameNotFound                                  NTStatus = 0xC000008B
+	StatusArrayBoundsExceeded                                   NTStatus = 0xC000008C
+	StatusFloatDenormalOperand                                  NTStatus = 0xC000008D
+	StatusFloatDivideByZero                                     NTStatus = 0xC000008E
+	StatusFloatInexactResult                                    NTStatus = 0xC000008F
+	StatusFloatInvalidOperation                                 NTStatus = 0xC0000090
+	StatusFloatOverflow                                         NTStatus = 0xC0000091
+	StatusFloatStackCheck                                       NTStatus = 0xC0000092
+	StatusFloatUnderflow                                        NTStatus = 0xC0000093
+	StatusIntegerDivideByZero                                   NTStatus = 0xC0000094
+	StatusIntegerOverflow                                       NTStatus = 0xC0000095
+	StatusPrivilegedInstruction                                 NTStatus = 0xC0000096
+	StatusTooManyPagingFiles                                    NTStatus = 0xC0000097
+	StatusFileInvalid                                           NTStatus = 0xC0000098
+	StatusAllottedSpaceExceeded                                 NTStatus = 0xC0000099
+	StatusInsufficientResources                                 NTStatus = 0xC000009A
+	StatusDfsExitPathFound                                      NTStatus = 0xC000009B
+	StatusDeviceDataError                                       NTStatus = 0xC000009C
+	StatusDeviceNotConnected                                    NTStatus = 0xC000009D
+	StatusDevicePowerFailure                                    NTStatus = 0xC000009E
+	StatusMemoryNotAllocated                                    NTStatus = 0xC00000A0
+	StatusWorkingSetQuota                                       NTStatus = 0xC00000A1
+	StatusMediaWriteProtected                                   NTStatus = 0xC00000A2
+	StatusDeviceNotReady                                        NTStatus = 0xC00000A3
+	StatusInvalidGroupAttributes                                NTStatus = 0xC00000A4
+	StatusBadImpersonationLevel                                 NTStatus = 0xC00000A5
+	StatusCantOpenAnonymous                                     NTStatus = 0xC00000A6
+	StatusBadValidationClass                                    NTStatus = 0xC00000A7
+	StatusBadTokenType                                          NTStatus = 0xC00000A8
+	StatusBadMasterBootRecord                                   NTStatus = 0xC00000A9
+	StatusInstructionMisalignment                               NTStatus = 0xC00000AA
+	StatusInstanceNotAvailable                                  NTStatus = 0xC00000AB
+	StatusPipeNotAvailable                                      NTStatus = 0xC00000AC
+	StatusInvalidPipeState                                      NTStatus = 0xC00000AD
+	StatusPipeBusy                                              NTStatus = 0xC00000AE
+	StatusIllegalFunction                                       NTStatus = 0xC00000AF
+	StatusPipeDisconnected                                      NTStatus = 0xC00000B0
+	StatusPipeClosing                                           NTStatus = 0xC00000B1
+	StatusPipeConnected                                         NTStatus = 0xC00000B2
+	StatusPipeListening                                         NTStatus = 0xC00000B3
+	StatusInvalidReadMode                                       NTStatus = 0xC00000B4
+	StatusIoTimeout                                             NTStatus = 0xC00000B5
+	StatusFileForcedClosed                                      NTStatus = 0xC00000B6
+	StatusProfilingNotStarted                                   NTStatus = 0xC00000B7
+	StatusProfilingNotStopped                                   NTStatus = 0xC00000B8
+	StatusCouldNotInterpret                                     NTStatus = 0xC00000B9
+	StatusFileIsADirectory                                      NTStatus = 0xC00000BA
+	StatusNotSupported                                          NTStatus = 0xC00000BB
+	StatusRemoteNotListening                                    NTStatus = 0xC00000BC
+	StatusDuplicateName                                         NTStatus = 0xC00000BD
+	StatusBadNetworkPath                                        NTStatus = 0xC00000BE
+	StatusNetworkBusy                                           NTStatus = 0xC00000BF
+	StatusDeviceDoesNotExist                                    NTStatus = 0xC00000C0
+	StatusTooManyCommands                                       NTStatus = 0xC00000C1
+	StatusAdapterHardwareError                                  NTStatus = 0xC00000C2
+	StatusInvalidNetworkResponse                                NTStatus = 0xC00000C3
+	StatusUnexpectedNetworkError                                NTStatus = 0xC00000C4
+	StatusBadRemoteAdapter                                      NTStatus = 0xC00000C5
+	StatusPrintQueueFull                                        NTStatus = 0xC00000C6
+	StatusNoSpoolSpace                                          NTStatus = 0xC00000C7
+	StatusPrintCancelled                                        NTStatus = 0xC00000C8
+	StatusNetworkNameDeleted                                    NTStatus = 0xC00000C9
+	StatusNetworkAccessDenied                                   NTStatus = 0xC00000CA
+	StatusBadDeviceType                                         NTStatus = 0xC00000CB
+	StatusBadNetworkName                                        NTStatus = 0xC00000CC
+	StatusTooManyNames                                          NTStatus = 0xC00000CD
+	StatusTooManySessions                                       NTStatus = 0xC00000CE
+	StatusSharingPaused                                         NTStatus = 0xC00000CF
+	StatusRequestNotAccepted                                    NTStatus = 0xC00000D0
+	StatusRedirectorPaused                                      NTStatus = 0xC00000D1
+	StatusNetWriteFault                                         NTStatus = 0xC00000D2
+	StatusProfilingAtLimit                                      NTStatus = 0xC00000D3
+	StatusNotSameDevice                                         NTStatus = 0xC00000D4
+	StatusFileRenamed                                           NTStatus = 0xC00000D5
+	StatusVirtualCircuitClosed                                  NTStatus = 0xC00000D6
+	StatusNoSecurityOnObject                                    NTStatus = 0xC00000D7
+	StatusCantWait                                              NTStatus = 0xC00000D8
+	StatusPipeEmpty                                             NTStatus = 0xC00000D9
+	StatusCantAccessDomainInfo                                  NTStatus = 0xC00000DA
+	StatusCantTerminateSelf                                     NTStatus = 0xC00000DB
+	StatusInvalidServerState                                    NTStatus = 0xC00000DC
+	StatusInvalidDomainState                                    NTStatus = 0xC00000DD
+	StatusInvalidDomainRole                                     NTStatus = 0xC00000DE
+	StatusNoSuchDomain                                          NTStatus = 0xC00000DF
+	StatusDomainExists                                          NTStatus = 0xC00000E0
+	StatusDomainLimitExceeded                                   NTStatus = 0xC00000E1
+	StatusOplockNotGranted                                      NTStatus = 0xC00000E2
+	StatusInvalidOplockProtocol                                 NTStatus = 0xC00000E3
+	StatusInternalDbCorruption                                  NTStatus = 0xC00000E4
+	StatusInternalError                                         NTStatus = 0xC00000E5
+	StatusGenericNotMapped                                      NTStatus = 0xC00000E6
+	StatusBadDescriptorFormat                                   NTStatus = 0xC00000E7
+	StatusInvalidUserBuffer                                     NTStatus = 0xC00000E8
+	StatusUnexpectedIoError                                     NTStatus = 0xC00000E9
+	StatusUnexpectedMmCreateErr                                 NTStatus = 0xC00000EA
+	StatusUnexpectedMmMapError                                  NTStatus = 0xC00000EB
+	StatusUnexpectedMmExtendErr                                 NTStatus = 0xC00000EC
+	StatusNotLogonProcess                                       NTStatus = 0xC00000ED
+	StatusLogonSessionExists                                    NTStatus = 0xC00000EE
+	StatusInvalidParameter1                                     NTStatus = 0xC00000EF
+	StatusInvalidParameter2                                     NTStatus = 0xC00000F0
+	StatusInvalidParameter3                                     NTStatus = 0xC00000F1
+	StatusInvalidParameter4                                     NTStatus = 0xC00000F2
+	StatusInvalidParameter5                                     NTStatus = 0xC00000F3
+	StatusInvalidParameter6                                     NTStatus = 0xC00000F4
+	StatusInvalidParameter7                                     NTStatus = 0xC00000F5
+	StatusInvalidParameter8                                     NTStatus = 0xC00000F6
+	StatusInvalidParameter9                                     NTStatus = 0xC00000F7
+	StatusInvalidParameter10                                    NTStatus = 0xC00000F8
+	StatusInvalidParameter11                                    NTStatus = 0xC00000F9
+	StatusInvalidParameter12                                    NTStatus = 0xC00000FA
+	StatusRedirectorNotStarted                                  NTStatus = 0xC00000FB
+	StatusRedirectorStarted                                     NTStatus = 0xC00000FC
+	StatusStackOverflow                                         NTStatus = 0xC00000FD
+	StatusNoSuchPackage                                         NTStatus = 0xC00000FE
+	StatusBadFunctionTable                                      NTStatus = 0xC00000FF
+	StatusVariableNotFound                                      NTStatus = 0xC0000100
+	StatusDirectoryNotEmpty                                     NTStatus = 0xC0000101
+	StatusFileCorruptError                                      NTStatus = 0xC0000102
+	StatusNotADirectory                                         NTStatus = 0xC0000103
+	StatusBadLogonSessionState                                  NTStatus = 0xC0000104
+	StatusLogonSessionCollision                                 NTStatus = 0xC0000105
+	StatusNameTooLong                                           NTStatus = 0xC0000106
+	StatusFilesOpen                                             NTStatus = 0xC0000107
+	StatusConnectionInUse                                       NTStatus = 0xC0000108
+	StatusMessageNotFound                                       NTStatus = 0xC0000109
+	StatusProcessIsTerminating                                  NTStatus = 0xC000010A
+	StatusInvalidLogonType                                      NTStatus = 0xC000010B
+	StatusNoGuidTranslation                                     NTStatus = 0xC000010C
+	StatusCannotImpersonate                                     NTStatus = 0xC000010D
+	StatusImageAlreadyLoaded                                    NTStatus = 0xC000010E
+	StatusAbiosNotPresent                                       NTStatus = 0xC000010F
+	StatusAbiosLidNotExist                                      NTStatus = 0xC0000110
+	StatusAbiosLidAlreadyOwned                                  NTStatus = 0xC0000111
+	StatusAbiosNotLidOwner                                      NTStatus = 0xC0000112
+	StatusAbiosInvalidCommand                                   NTStatus = 0xC0000113
+	StatusAbiosInvalidLid                                       NTStatus = 0xC0000114
+	StatusAbiosSelectorNotAvailable                             NTStatus = 0xC0000115
+	StatusAbiosInvalidSelector                                  NTStatus = 0xC0000116
+	StatusNoLdt                                                 NTStatus = 0xC0000117
+	StatusInvalidLdtSize                                        NTStatus = 0xC0000118
+	StatusInvalidLdtOffset                                      NTStatus = 0xC0000119
+	StatusInvalidLdtDescriptor                                  NTStatus = 0xC000011A
+	StatusInvalidImageNeFormat                                  NTStatus = 0xC000011B
+	StatusRxactInvalidState                                     NTStatus = 0xC000011C
+	StatusRxactCommitFailure                                    NTStatus = 0xC000011D
+	StatusMappedFileSizeZero                                    NTStatus = 0xC000011E
+	StatusTooManyOpenedFiles                                    NTStatus = 0xC000011F
+	StatusCancelled                                             NTStatus = 0xC0000120
+	StatusCannotDelete                                          NTStatus = 0xC0000121
+	StatusInvalidComputerName                                   NTStatus = 0xC0000122
+	StatusFileDeleted                                           NTStatus = 0xC0000123
+	StatusSpecialAccount                                        NTStatus = 0xC0000124
+	StatusSpecialGroup                                          NTStatus = 0xC0000125
+	StatusSpecialUser                                           NTStatus = 0xC0000126
+	StatusMembersPrimaryGroup                                   NTStatus = 0xC0000127
+	StatusFileClosed                                            NTStatus = 0xC0000128
+	StatusTooManyThreads                                        NTStatus = 0xC0000129
+	StatusThreadNotInProcess                                    NTStatus = 0xC000012A
+	StatusTokenAlreadyInUse                                     NTStatus = 0xC000012B
+	StatusPagefileQuotaExceeded                                 NTStatus = 0xC000012C
+	StatusCommitmentLimit                                       NTStatus = 0xC000012D
+	StatusInvalidImageLeFormat                                  NTStatus = 0xC000012E
+	StatusInvalidImageNotMz                                     NTStatus = 0xC000012F
+	StatusInvalidImageProtect                                   NTStatus = 0xC0000130
+	StatusInvalidImageWin16                                     NTStatus = 0xC0000131
+	StatusLogonServerConflict                                   NTStatus = 0xC0000132
+	StatusTimeDifferenceAtDc                                    NTStatus = 0xC0000133
+	StatusSynchronizationRequired                               NTStatus = 0xC0000134
+	StatusDllNotFound                                           NTStatus = 0xC0000135
+	StatusOpenFailed                                            NTStatus = 0xC0000136
+	StatusIoPrivilegeFailed                                     NTStatus = 0xC0000137
+	StatusOrdinalNotFound                                       NTStatus = 0xC0000138
+	StatusEntrypointNotFound                                    NTStatus = 0xC0000139
+	StatusControlCExit                                          NTStatus = 0xC000013A
+	StatusLocalDisconnect                                       NTStatus = 0xC000013B
+	StatusRemoteDisconnect                                      NTStatus = 0xC000013C
+	StatusRemoteResources                                       NTStatus = 0xC000013D
+	StatusLinkFailed                                            NTStatus = 0xC000013E
+	StatusLinkTimeout                                           NTStatus = 0xC000013F
+	StatusInvalidConnection                                     NTStatus = 0xC0000140
+	StatusInvalidAddress                                        NTStatus = 0xC0000141
+	StatusDllInitFailed                                         NTStatus = 0xC0000142
+	StatusMissingSystemfile                                     NTStatus = 0xC0000143
+	StatusUnhandledException                                    NTStatus = 0xC0000144
+	StatusAppInitFailure                                        NTStatus = 0xC0000145
+	StatusPagefileCreateFailed                                  NTStatus = 0xC0000146
+	StatusNoPagefile                                            NTStatus = 0xC0000147
+	StatusInvalidLevel                                          NTStatus = 0xC0000148
+	StatusWrongPasswordCore                                     NTStatus = 0xC0000149
+	StatusIllegalFloatContext                                   NTStatus = 0xC000014A
+	StatusPipeBroken                                            NTStatus = 0xC000014B
+	StatusRegistryCorrupt                                       NTStatus = 0xC000014C
+	StatusRegistryIoFailed                                      NTStatus = 0xC000014D
+	StatusNoEventPair                                           NTStatus = 0xC000014E
+	StatusUnrecognizedVolume                                    NTStatus = 0xC000014F
+	StatusSerialNoDeviceInited                                  NTStatus = 0xC0000150
+	StatusNoSuchAlias                                           NTStatus = 0xC0000151
+	StatusMemberNotInAlias                                      NTStatus = 0xC0000152
+	StatusMemberInAlias                                         NTStatus = 0xC0000153
+	StatusAliasExists                                           NTStatus = 0xC0000154
+	StatusLogonNotGranted                                       NTStatus = 0xC0000155
+	StatusTooManySecrets                                        NTStatus = 0xC0000156
+	StatusSecretTooLong                                         NTStatus = 0xC0000157
+	StatusInternalDbError                                       NTStatus = 0xC0000158
+	StatusFullscreenMode                                        NTStatus = 0xC0000159
+	StatusTooManyContextIds                                     NTStatus = 0xC000015A
+	StatusLogonTypeNotGranted                                   NTStatus = 0xC000015B
+	StatusNotRegistryFile                                       NTStatus = 0xC000015C
+	StatusNtCrossEncryptionRequired                             NTStatus = 0xC000015D
+	StatusDomainCtrlrConfigError                                NTStatus = 0xC000015E
+	StatusFtMissingMember                                       NTStatus = 0xC000015F
+	StatusIllFormedServiceEntry                                 NTStatus = 0xC0000160
+	StatusIllegalCharacter                                      NTStatus = 0xC0000161
+	StatusUnmappableCharacter                                   NTStatus = 0xC0000162
+	StatusUndefinedCharacter                                    NTStatus = 0xC0000163
+	StatusFloppyVolume                                          NTStatus = 0xC0000164
+	StatusFloppyIdMarkNotFound                                  NTStatus = 0xC0000165
+	StatusFloppyWrongCylinder                                   NTStatus = 0xC0000166
+	StatusFloppyUnknownError                                    NTStatus = 0xC0000167
+	StatusFloppyBadRegisters                                    NTStatus = 0xC0000168
+	StatusDiskRecalibrateFailed                                 NTStatus = 0xC0000169
+	StatusDiskOperationFailed                                   NTStatus = 0xC000016A
+	StatusDiskResetFailed                                       NTStatus = 0xC000016B
+	StatusSharedIrqBusy                                         NTStatus = 0xC000016C
+	StatusFtOrphaning                                           NTStatus = 0xC000016D
+	StatusBiosFailedToConnectInterrupt                          NTStatus = 0xC000016E
+	StatusPartitionFailure                                      NTStatus = 0xC0000172
+	StatusInvalidBlockLength                                    NTStatus = 0xC0000173
+	StatusDeviceNotPartitioned                                  NTStatus = 0xC0000174
+	StatusUnableToLockMedia                                     NTStatus = 0xC0000175
+	StatusUnableToUnloadMedia                                   NTStatus = 0xC0000176
+	StatusEomOverflow                                           NTStatus = 0xC0000177
+	StatusNoMedia                                               NTStatus = 0xC0000178
+	StatusNoSuchMember                                          NTStatus = 0xC000017A
+	StatusInvalidMember                                         NTStatus = 0xC000017B
+	StatusKeyDeleted                                            NTStatus = 0xC000017C
+	StatusNoLogSpace                                            NTStatus = 0xC000017D
+	StatusTooManySids                                           NTStatus = 0xC000017E
+	StatusLmCrossEncryptionRequired                             NTStatus = 0xC000017F
+	StatusKeyHasChildren                                        NTStatus = 0xC0000180
+	StatusChildMustBeVolatile                                   NTStatus = 0xC0000181
+	StatusDeviceConfigurationError                              NTStatus = 0xC0000182
+	StatusDriverInternalError                                   NTStatus = 0xC0000183
+	StatusInvalidDeviceState                                    NTStatus = 0xC0000184
+	StatusIoDeviceError                                         NTStatus = 0xC0000185
+	StatusDeviceProtocolError                                   NTStatus = 0xC0000186
+	StatusBackupController                                      NTStatus = 0xC0000187
+	StatusLogFileFull                                           NTStatus = 0xC0000188
+	StatusTooLate                                               NTStatus = 0xC0000189
+	StatusNoTrustLsaSecret                                      NTStatus = 0xC000018A
+	StatusNoTrustSamAccount                                     NTStatus = 0xC000018B
+	StatusTrustedDomainFailure                                  NTStatus = 0xC000018C
+	StatusTrustedRelationshipFailure                            NTStatus = 0xC000018D
+	StatusEventlogFileCorrupt                                   NTStatus = 0xC000018E
+	StatusEventlogCantStart                                     NTStatus = 0xC000018F
+	StatusTrustFailure                                          NTStatus = 0xC0000190
+	StatusMutantLimitExceeded                                   NTStatus = 0xC0000191
+	StatusNetlogonNotStarted                                    NTStatus = 0xC0000192
+	StatusAccountExpired                                        NTStatus = 0xC0000193
+	StatusPossibleDeadlock                                      NTStatus = 0xC0000194
+	StatusNetworkCredentialConflict                             NTStatus = 0xC0000195
+	StatusRemoteSessionLimit                                    NTStatus = 0xC0000196
+	StatusEventlogFileChanged                                   NTStatus = 0xC0000197
+	StatusNologonInterdomainTrustAccount                        NTStatus = 0xC0000198
+	StatusNologonWorkstationTrustAccount                        NTStatus = 0xC0000199
+	StatusNologonServerTrustAccount                             NTStatus = 0xC000019A
+	StatusDomainTrustInconsistent                               NTStatus = 0xC000019B
+	StatusFsDriverRequired                                      NTStatus = 0xC000019C
+	StatusImageAlreadyLoadedAsDll                               NTStatus = 0xC000019D
+	StatusIncompatibleWithGlobalShortNameRegistrySetting        NTStatus = 0xC000019E
+	StatusShortNamesNotEnabledOnVolume                          NTStatus = 0xC000019F
+	StatusSecurityStreamIsInconsistent                          NTStatus = 0xC00001A0
+	StatusInvalidLockRange                                      NTStatus = 0xC00001A1
+	StatusInvalidAceCondition                                   NTStatus = 0xC00001A2
+	StatusImageSubsystemNotPresent                              NTStatus = 0xC00001A3
+	StatusNotificationGuidAlreadyDefined                        NTStatus = 0xC00001A4
+	StatusInvalidExceptionHandler                               NTStatus = 0xC00001A5
+	StatusDuplicatePrivileges                                   NTStatus = 0xC00001A6
+	StatusNotAllowedOnSystemFile                                NTStatus = 0xC00001A7
+	StatusRepairNeeded                                          NTStatus = 0xC00001A8
+	StatusQuotaNotEnabled                                       NTStatus = 0xC00001A9
+	StatusNoApplicationPackage                                  NTStatus = 0xC00001AA
+	StatusFileMetadataOptimizationInProgress                    NTStatus = 0xC00001AB
+	StatusNotSameObject                                         NTStatus = 0xC00001AC
+	StatusFatalMemoryExhaustion                                 NTStatus = 0xC00001AD
+	StatusErrorProcessNotInJob                                  NTStatus = 0xC00001AE
+	StatusCpuSetInvalid                                         NTStatus = 0xC00001AF
+	StatusIoDeviceInvalidData                                   NTStatus = 0xC00001B0
+	StatusIoUnalignedWrite                                      NTStatus = 0xC00001B1
+	StatusNetworkOpenRestriction                                NTStatus = 0xC0000201
+	StatusNoUserSessionKey                                      NTStatus = 0xC0000202
+	StatusUserSessionDeleted                                    NTStatus = 0xC0000203
+	StatusResourceLangNotFound                                  NTStatus = 0xC0000204
+	StatusInsuffServerResources                                 NTStatus = 0xC0000205
+	StatusInvalidBufferSize                                     NTStatus = 0xC0000206
+	StatusInvalidAddressComponent                               NTStatus = 0xC0000207
+	StatusInvalidAddressWildcard                                NTStatus = 0xC0000208
+	StatusTooManyAddresses                                      NTStatus = 0xC0000209
+	StatusAddressAlreadyExists                                  NTStatus = 0xC000020A
+	StatusAddressClosed                                         NTStatus = 0xC000020B
+	StatusConnectionDisconnected                                NTStatus = 0xC000020C
+	StatusConnectionReset                                       NTStatus = 0xC000020D
+	StatusTooManyNodes                                          NTStatus = 0xC000020E
+	StatusTransactionAborted                                    NTStatus = 0xC000020F
+	StatusTransactionTimedOut                                   NTStatus = 0xC0000210
+	StatusTransactionNoRelease                                  NTStatus = 0xC0000211
+	StatusTransactionNoMatch                                    NTStatus = 0xC0000212
+	StatusTransactionResponded                                  NTStatus = 0xC0000213
+	StatusTransactionInvalidId                                  NTStatus = 0xC0000214
+	StatusTransactionInvalidType                                NTStatus = 0xC0000215
+	StatusNotServerSession                                      NTStatus = 0xC0000216
+	StatusNotClientSession                                      NTStatus = 0xC0000217
+	StatusCannotLoadRegistryFile                                NTStatus = 0xC0000218
+	StatusDebugAttachFailed                                     NTStatus = 0xC0000219
+	StatusSystemProcessTerminated                               NTStatus = 0xC000021A
+	StatusDataNotAccepted                                       NTStatus = 0xC000021B
+	StatusNoBrowserServersFound                                 NTStatus = 0xC000021C
+	StatusVdmHardError                                          NTStatus = 0xC000021D
+	StatusDriverCancelTimeout                                   NTStatus = 0xC000021E
+	StatusReplyMessageMismatch                                  NTStatus = 0xC000021F
+	StatusMappedAlignment                                       NTStatus = 0xC0000220
+	StatusImageChecksumMismatch                                 NTStatus = 0xC0000221
+	StatusLostWritebehindData                                   NTStatus = 0xC0000222
+	StatusClientServerParametersInvalid                         NTStatus = 0xC0000223
+	StatusPasswordMustChange                                    NTStatus = 0xC0000224
+	StatusNotFound                                              NTStatus = 0xC0000225
+	StatusNotTinyStream                                         NTStatus = 0xC0000226
+	StatusRecoveryFailure                                       NTStatus = 0xC0000227
+	StatusStackOverflowRead                                     NTStatus = 0xC0000228
+	StatusFailCheck                                             NTStatus = 0xC0000229
+	StatusDuplicateObjectid                                     NTStatus = 0xC000022A
+	StatusObjectidExists                                        NTStatus = 0xC000022B
+	StatusConvertToLarge                                        NTStatus = 0xC000022C
+	StatusRetry                                                 NTStatus = 0xC000022D
+	StatusFoundOutOfScope                                       NTStatus = 0xC000022E
+	StatusAllocateBucket                                        NTStatus = 0xC000022F
+	StatusPropsetNotFound                                       NTStatus = 0xC0000230
+	StatusMarshallOverflow                                      NTStatus = 0xC0000231
+	StatusInvalidVariant                                        NTStatus = 0xC0000232
+	StatusDomainControllerNotFound                              NTStatus = 0xC0000233
+	StatusAccountLockedOut                                      NTStatus = 0xC0000234
+	StatusHandleNotClosable                                     NTStatus = 0xC0000235
+	StatusConnectionRefused                                     NTStatus = 0xC0000236
+	StatusGracefulDisconnect                                    NTStatus = 0xC0000237
+	StatusAddressAlreadyAssociated                              NTStatus = 0xC0000238
+	StatusAddressNotAssociated                                  NTStatus = 0xC0000239
+	StatusConnectionInvalid                                     NTStatus = 0xC000023A
+	StatusConnectionActive                                      NTStatus = 0xC000023B
+	StatusNetworkUnreachable                                    NTStatus = 0xC000023C
+	StatusHostUnreachable                                       NTStatus = 0xC000023D
+	StatusProtocolUnreachable                                   NTStatus = 0xC000023E
+	StatusPortUnreachable                                       NTStatus = 0xC000023F
+	StatusRequestAborted                                        NTStatus = 0xC0000240
+	StatusConnectionAborted                                     NTStatus = 0xC0000241
+	StatusBadCompressionBuffer                                  NTStatus = 0xC0000242
+	StatusUserMappedFile                                        NTStatus = 0xC0000243
+	StatusAuditFailed                                           NTStatus = 0xC0000244
+	StatusTimerResolutionNotSet                                 NTStatus = 0xC0000245
+	StatusConnectionCountLimit                                  NTStatus = 0xC0000246
+	StatusLoginTimeRestriction                                  NTStatus = 0xC0000247
+	StatusLoginWkstaRestriction                                 NTStatus = 0xC0000248
+	StatusImageMpUpMismatch                                     NTStatus = 0xC0000249
+	StatusInsufficientLogonInfo                                 NTStatus = 0xC0000250
+	StatusBadDllEntrypoint                                      NTStatus = 0xC0000251
+	StatusBadServiceEntrypoint                                  NTStatus = 0xC0000252
+	StatusLpcReplyLost                                          NTStatus = 0xC0000253
+	StatusIpAddressConflict1                                    NTStatus = 0xC0000254
+	StatusIpAddressConflict2                                    NTStatus = 0xC0000255
+	StatusRegistryQuotaLimit                                    NTStatus = 0xC0000256
+	StatusPathNotCovered                                        NTStatus = 0xC0000257
+	StatusNoCallbackActive                                      NTStatus = 0xC0000258
+	StatusLicenseQuotaExceeded                                  NTStatus = 0xC0000259
+	StatusPwdTooShort                                           NTStatus = 0xC000025A
+	StatusPwdTooRecent                                          NTStatus = 0xC000025B
+	StatusPwdHistoryConflict                                    NTStatus = 0xC000025C
+	StatusPlugplayNoDevice                                      NTStatus = 0xC000025E
+	StatusUnsupportedCompression                                NTStatus = 0xC000025F
+	StatusInvalidHwProfile                                      NTStatus = 0xC0000260
+	StatusInvalidPlugplayDevicePath                             NTStatus = 0xC0000261
+	StatusDriverOrdinalNotFound                                 NTStatus = 0xC0000262
+	StatusDriverEntrypointNotFound                              NTStatus = 0xC0000263
+	StatusResourceNotOwned                                      NTStatus = 0xC0000264
+	StatusTooManyLinks                                          NTStatus = 0xC0000265
+	StatusQuotaListInconsistent                                 NTStatus = 0xC0000266
+	StatusFileIsOffline                                         NTStatus = 0xC0000267
+	StatusEvaluationExpiration                                  NTStatus = 0xC0000268
+	StatusIllegalDllRelocation                                  NTStatus = 0xC0000269
+	StatusLicenseViolation                                      NTStatus = 0xC000026A
+	StatusDllInitFailedLogoff                                   NTStatus = 0xC000026B
+	StatusDriverUnableToLoad                                    NTStatus = 0xC000026C
+	StatusDfsUnavailable                                        NTStatus = 0xC000026D
+	StatusVolumeDismounted                                      NTStatus = 0xC000026E
+	StatusWx86InternalError                                     NTStatus = 0xC000026F
+	StatusWx86FloatStackCheck                                   NTStatus = 0xC0000270
+	StatusValidateContinue                                      NTStatus = 0xC0000271
+	StatusNoMatch                                               NTStatus = 0xC0000272
+	StatusNoMoreMatches                                         NTStatus = 0xC0000273
+	StatusNotAReparsePoint                                      NTStatus = 0xC0000275
+	StatusIoReparseTagInvalid                                   NTStatus = 0xC0000276
+	StatusIoReparseTagMismatch                                  NTStatus = 0xC0000277
+	StatusIoReparseDataInvalid                                  NTStatus = 0xC0000278
+	StatusIoReparseTagNotHandled                                NTStatus = 0xC0000279
+	StatusPwdTooLong                                            NTStatus = 0xC000027A
+	StatusStowedException                                       NTStatus = 0xC000027B
+	StatusContextStowedException                                NTStatus = 0xC000027C
+	StatusReparsePointNotResolved                               NTStatus = 0xC0000280
+	StatusDirectoryIsAReparsePoint                              NTStatus = 0xC0000281
+	StatusRangeListConflict                                     NTStatus = 0xC0000282
+	StatusSourceElementEmpty                                    NTStatus = 0xC0000283
+	StatusDestinationElementFull                                NTStatus = 0xC0000284
+	StatusIllegalElementAddress                                 NTStatus = 0xC0000285
+	StatusMagazineNotPresent                                    NTStatus = 0xC0000286
+	StatusReinitializationNeeded                                NTStatus = 0xC0000287
+	StatusEncryptionFailed                                      NTStatus = 0xC000028A
+	StatusDecryptionFailed                                      NTStatus = 0xC000028B
+	StatusRangeNotFound                                         NTStatus = 0xC000028C
+	StatusNoRecoveryPolicy                                      NTStatus = 0xC000028D
+	StatusNoEfs                                                 NTStatus = 0xC000028E
+	StatusWrongEfs                                              NTStatus = 0xC000028F
+	StatusNoUserKeys                                            NTStatus = 0xC0000290
+	StatusFileNotEncrypted                                      NTStatus = 0xC0000291
+	StatusNotExportFormat                                       NTStatus = 0xC0000292
+	StatusFileEncrypted                                         NTStatus = 0xC0000293
+	StatusWmiGuidNotFound                                       NTStatus = 0xC0000295
+	StatusWmiInstanceNotFound                                   NTStatus = 0xC0000296
+	StatusWmiItemidNotFound                                     NTStatus = 0xC0000297
+	StatusWmiTryAgain                                           NTStatus = 0xC0000298
+	StatusSharedPolicy                                          NTStatus = 0xC0000299
+	StatusPolicyObjectNotFound                                  NTStatus = 0xC000029A
+	StatusPolicyOnlyInDs                                        NTStatus = 0xC000029B
+	StatusVolumeNotUpgraded                                     NTStatus = 0xC000029C
+	StatusRemoteStorageNotActive                                NTStatus = 0xC000029D
+	StatusRemoteStorageMediaError                               NTStatus = 0xC000029E
+	StatusNoTrackingService                                     NTStatus = 0xC000029F
+	StatusServerSidMismatch                                     NTStatus = 0xC00002A0
+	StatusDsNoAttributeOrValue                                  NTStatus = 0xC00002A1
+	StatusDsInvalidAttributeSyntax                              NTStatus = 0xC00002A2
+	StatusDsAttributeTypeUndefined                              NTStatus = 0xC00002A3
+	StatusDsAttributeOrValueExists                              NTStatus = 0xC00002A4
+	StatusDsBusy                                                NTStatus = 0xC00002A5
+	StatusDsUnavailable                                         NTStatus = 0xC00002A6
+	StatusDsNoRidsAllocated                                     NTStatus = 0xC00002A7
+	StatusDsNoMoreRids                                          NTStatus = 0xC00002A8
+	StatusDsIncorrectRoleOwner                                  NTStatus = 0xC00002A9
+	StatusDsRidmgrInitError                                     NTStatus = 0xC00002AA
+	StatusDsObjClassViolation                                   NTStatus = 0xC00002AB
+	StatusDsCantOnNonLeaf                                       NTStatus = 0xC00002AC
+	StatusDsCantOnRdn                                           NTStatus = 0xC00002AD
+	StatusDsCantModObjClass                                     NTStatus = 0xC00002AE
+	StatusDsCrossDomMoveFailed                                  NTStatus = 0xC00002AF
+	StatusDsGcNotAvailable                                      NTStatus = 0xC00002B0
+	StatusDirectoryServiceRequired                              NTStatus = 0xC00002B1
+	StatusReparseAttributeConflict                              NTStatus = 0xC00002B2
+	StatusCantEnableDenyOnly                                    NTStatus = 0xC00002B3
+	StatusFloatMultipleFaults                                   NTStatus = 0xC00002B4
+	StatusFloatMultipleTraps                                    NTStatus = 0xC00002B5
+	StatusDeviceRemoved                                         NTStatus = 0xC00002B6
+	StatusJournalDeleteInProgress                               NTStatus = 0xC00002B7
+	StatusJournalNotActive                                      NTStatus = 0xC00002B8
+	StatusNointerface                                           NTStatus = 0xC00002B9
+	StatusDsRidmgrDisabled                                      NTStatus = 0xC00002BA
+	StatusDsAdminLimitExceeded                                  NTStatus = 0xC00002C1
+	StatusDriverFailedSleep                                     NTStatus = 0xC00002C2
+	StatusMutualAuthenticationFailed                            NTStatus = 0xC00002C3
+	StatusCorruptSystemFile                                     NTStatus = 0xC00002C4
+	StatusDatatypeMisalignmentError                             NTStatus = 0xC00002C5
+	StatusWmiReadOnly                                           NTStatus = 0xC00002C6
+	StatusWmiSetFailure                                         NTStatus = 0xC00002C7
+	StatusCommitmentMinimum                                     NTStatus = 0xC00002C8
+	StatusRegNatConsumption                                     NTStatus = 0xC00002C9
+	StatusTransportFull                                         NTStatus = 0xC00002CA
+	StatusDsSamInitFailure                                      NTStatus = 0xC00002CB
+	StatusOnlyIfConnected                                       NTStatus = 0xC00002CC
+	StatusDsSensitiveGroupViolation                             NTStatus = 0xC00002CD
+	StatusPnpRestartEnumeration                                 NTStatus = 0xC00002CE
+	StatusJournalEntryDeleted                                   NTStatus = 0xC00002CF
+	StatusDsCantModPrimarygroupid                               NTStatus = 0xC00002D0
+	StatusSystemImageBadSignature                               NTStatus = 0xC00002D1
+	StatusPnpRebootRequired                                     NTStatus = 0xC00002D2
+	StatusPowerStateInvalid                                     NTStatus = 0xC00002D3
+	StatusDsInvalidGroupType                                    NTStatus = 0xC00002D4
+	StatusDsNoNestGlobalgroupInMixeddomain                      NTStatus = 0xC00002D5
+	StatusDsNoNestLocalgroupInMixeddomain                       NTStatus = 0xC00002D6
+	StatusDsGlobalCantHaveLocalMember                           NTStatus = 0xC00002D7
+	StatusDsGlobalCantHaveUniversalMember                       NTStatus = 0xC00002D8
+	StatusDsUniversalCantHaveLocalMember                        NTStatus = 0xC00002D9
+	StatusDsGlobalCantHaveCrossdomainMember                     NTStatus = 0xC00002DA
+	StatusDsLocalCantHaveCrossdomainLocalMember                 NTStatus = 0xC00002DB
+	StatusDsHavePrimaryMembers                                  NTStatus = 0xC00002DC
+	StatusWmiNotSupported                                       NTStatus = 0xC00002DD
+	StatusInsufficientPower                                     NTStatus = 0xC00002DE
+	StatusSamNeedBootkeyPassword                                NTStatus = 0xC00002DF
+	StatusSamNeedBootkeyFloppy                                  NTStatus = 0xC00002E0
+	StatusDsCantStart                                           NTStatus = 0xC00002E1
+	StatusDsInitFailure                                         NTStatus = 0xC00002E2
+	StatusSamInitFailure                                        NTStatus = 0xC00002E3
+	StatusDsGcRequired                                          NTStatus = 0xC00002E4
+	StatusDsLocalMemberOfLocalOnly                              NTStatus = 0xC00002E5
+	StatusDsNoFpoInUniversalGroups                              NTStatus = 0xC00002E6
+	StatusDsMachineAccountQuotaExceeded                         NTStatus = 0xC00002E7
+	StatusMultipleFaultViolation                                NTStatus = 0xC00002E8
+	StatusCurrentDomainNotAllowed                               NTStatus = 0xC00002E9
+	StatusCannotMake                                            NTStatus = 0xC00002EA
+	StatusSystemShutdown                                        NTStatus = 0xC00002EB
+	StatusDsInitFailureConsole                                  NTStatus = 0xC00002EC
+	StatusDsSamInitFailureConsole                               NTStatus = 0xC00002ED
+	StatusUnfinishedContextDeleted                              NTStatus = 0xC00002EE
+	StatusNoTgtReply                                            NTStatus = 0xC00002EF
+	StatusObjectidNotFound                                      NTStatus = 0xC00002F0
+	StatusNoIpAddresses                                         NTStatus = 0xC00002F1
+	StatusWrongCredentialHandle                                 NTStatus = 0xC00002F2
+	StatusCryptoSystemInvalid                                   NTStatus = 0xC00002F3
+	StatusMaxReferralsExceeded                                  NTStatus = 0xC00002F4
+	StatusMustBeKdc                                             NTStatus = 0xC00002F5
+	StatusStrongCryptoNotSupported                              NTStatus = 0xC00002F6
+	StatusTooManyPrincipals                                     NTStatus = 0xC00002F7
+	StatusNoPaData                                              NTStatus = 0xC00002F8
+	StatusPkinitNameMismatch                                    NTStatus = 0xC00002F9
+	StatusSmartcardLogonRequired                                NTStatus = 0xC00002FA
+	StatusKdcInvalidRequest                                     NTStatus = 0xC00002FB
+	StatusKdcUnableToRefer                                      NTStatus = 0xC00002FC
+	StatusKdcUnknownEtype                                       NTStatus = 0xC00002FD
+	StatusShutdownInProgress                                    NTStatus = 0xC00002FE
+	StatusServerShutdownInProgress                              NTStatus = 0xC00002FF
+	StatusNotSupportedOnSbs                                     NTStatus = 0xC0000300
+	StatusWmiGuidDisconnected                                   NTStatus = 0xC0000301
+	StatusWmiAlreadyDisabled                                    NTStatus = 0xC0000302
+	StatusWmiAlreadyEnabled                                     NTStatus = 0xC0000303
+	StatusMftTooFragmented                                      NTStatus = 0xC0000304
+	StatusCopyProtectionFailure                                 NTStatus = 0xC0000305
+	StatusCssAuthenticationFailure                              NTStatus = 0xC0000306
+	StatusCssKeyNotPresent                                      NTStatus = 0xC0000307
+	StatusCssKeyNotEstablished                                  NTStatus = 0xC0000308
+	StatusCssScrambledSector                                    NTStatus = 0xC0000309
+	StatusCssRegionMismatch                                     NTStatus = 0xC000030A
+	StatusCssResetsExhausted                                    NTStatus = 0xC000030B
+	StatusPasswordChangeRequired                                NTStatus = 0xC000030C
+	StatusLostModeLogonRestriction                              NTStatus = 0xC000030D
+	StatusPkinitFailure                                         NTStatus = 0xC0000320
+	StatusSmartcardSubsystemFailure                             NTStatus = 0xC0000321
+	StatusNoKerbKey                                             NTStatus = 0xC0000322
+	StatusHostDown                                              NTStatus = 0xC0000350
+	StatusUnsupportedPreauth                                    NTStatus = 0xC0000351
+	StatusEfsAlgBlobTooBig                                      NTStatus = 0xC0000352
+	StatusPortNotSet                                            NTStatus = 0xC0000353
+	StatusDebuggerInactive                                      NTStatus = 0xC0000354
+	StatusDsVersionCheckFailure                                 NTStatus = 0xC0000355
+	StatusAuditingDisabled                                      NTStatus = 0xC0000356
+	StatusPrent4MachineAccount                                  NTStatus = 0xC0000357
+	StatusDsAgCantHaveUniversalMember                           NTStatus = 0xC0000358
+	StatusInvalidImageWin32                                     NTStatus = 0xC0000359
+	StatusInvalidImageWin64                                     NTStatus = 0xC000035A
+	StatusBadBindings                                           NTStatus = 0xC000035B
+	StatusNetworkSessionExpired                                 NTStatus = 0xC000035C
+	StatusApphelpBlock                                          NTStatus = 0xC000035D
+	StatusAllSidsFiltered                                       NTStatus = 0xC000035E
+	StatusNotSafeModeDriver                                     NTStatus = 0xC000035F
+	StatusAccessDisabledByPolicyDefault                         NTStatus = 0xC0000361
+	StatusAccessDisabledByPolicyPath                            NTStatus = 0xC0000362
+	StatusAccessDisabledByPolicyPublisher                       NTStatus = 0xC0000363
+	StatusAccessDisabledByPolicyOther                           NTStatus = 0xC0000364
+	StatusFailedDriverEntry                                     NTStatus = 0xC0000365
+	StatusDeviceEnumerationError                                NTStatus = 0xC0000366
+	StatusMountPointNotResolved                                 NTStatus = 0xC0000368
+	StatusInvalidDeviceObjectParameter                          NTStatus = 0xC0000369
+	StatusMcaOccured                                            NTStatus = 0xC000036A
+	StatusDriverBlockedCritical                                 NTStatus = 0xC000036B
+	StatusDriverBlocked                                         NTStatus = 0xC000036C
+	StatusDriverDatabaseError                                   NTStatus = 0xC000036D
+	StatusSystemHiveTooLarge                                    NTStatus = 0xC000036E
+	StatusInvalidImportOfNonDll                                 NTStatus = 0xC000036F
+	StatusNoSecrets                                             NTStatus = 0xC0000371
+	StatusAccessDisabledNoSaferUiByPolicy                       NTStatus = 0xC0000372
+	StatusFailedStackSwitch                                     NTStatus = 0xC0000373
+	StatusHeapCorruption                                        NTStatus = 0xC0000374
+	StatusSmartcardWrongPin                                     NTStatus = 0xC0000380
+	StatusSmartcardCardBlocked                                  NTStatus = 0xC0000381
+	StatusSmartcardCardNotAuthenticated                         NTStatus = 0xC0000382
+	StatusSmartcardNoCard                                       NTStatus = 0xC0000383
+	StatusSmartcardNoKeyContainer                               NTStatus = 0xC0000384
+	StatusSmartcardNoCertificate                                NTStatus = 0xC0000385
+	StatusSmartcardNoKeyset                                     NTStatus = 0xC0000386
+	StatusSmartcardIoError                                      NTStatus = 0xC0000387
+	StatusDowngradeDetected                                     NTStatus = 0xC0000388
+	StatusSmartcardCertRevoked                                  NTStatus = 0xC0000389
+	StatusIssuingCaUntrusted                                    NTStatus = 0xC000038A
+	StatusRevocationOfflineC                                    NTStatus = 0xC000038B
+	StatusPkinitClientFailure                                   NTStatus = 0xC000038C
+	StatusSmartcardCertExpired                                  NTStatus = 0xC000038D
+	StatusDriverFailedPriorUnload                               NTStatus = 0xC000038E
+	StatusSmartcardSilentContext                                NTStatus = 0xC000038F
+	StatusPerUserTrustQuotaExceeded                             NTStatus = 0xC0000401
+	StatusAllUserTrustQuotaExceeded                             NTStatus = 0xC0000402
+	StatusUserDeleteTrustQuotaExceeded                          NTStatus = 0xC0000403
+	StatusDsNameNotUnique                                       NTStatus = 0xC0000404
+	StatusDsDuplicateIdFound                                    NTStatus = 0xC0000405
+	StatusDsGroupConversionError                                NTStatus = 0xC0000406
+	StatusVolsnapPrepareHibernate                               NTStatus = 0xC0000407
+	StatusUser2userRequired                                     NTStatus = 0xC0000408
+	StatusStackBufferOverrun                                    NTStatus = 0xC0000409
+	StatusNoS4uProtSupport                                      NTStatus = 0xC000040A
+	StatusCrossrealmDelegationFailure                           NTStatus = 0xC000040B
+	StatusRevocationOfflineKdc                                  NTStatus = 0xC000040C
+	StatusIssuingCaUntrustedKdc                                 NTStatus = 0xC000040D
+	StatusKdcCertExpired                                        NTStatus = 0xC000040E
+	StatusKdcCertRevoked                                        NTStatus = 0xC000040F
+	StatusParameterQuotaExceeded                                NTStatus = 0xC0000410
+	StatusHibernationFailure                                    NTStatus = 0xC0000411
+	StatusDelayLoadFailed                                       NTStatus = 0xC0000412
+	StatusAuthenticationFirewallFailed                          NTStatus = 0xC0000413
+	StatusVdmDisallowed                                         NTStatus = 0xC0000414
+	StatusHungDisplayDriverThread                               NTStatus = 0xC0000415
+	StatusInsufficientResourceForSpecifiedSharedSectionSize     NTStatus = 0xC0000416
+	StatusInvalidCruntimeParameter                              NTStatus = 0xC0000417
+	StatusNtlmBlocked                                           NTStatus = 0xC0000418
+	StatusDsSrcSidExistsInForest                                NTStatus = 0xC0000419
+	StatusDsDomainNameExistsInForest                            NTStatus = 0xC000041A
+	StatusDsFlatNameExistsInForest                              NTStatus = 0xC000041B
+	StatusInvalidUserPrincipalName                              NTStatus = 0xC000041C
+	StatusFatalUserCallbackException                            NTStatus = 0xC000041D
+	StatusAssertionFailure                                      NTStatus = 0xC0000420
+	StatusVerifierStop                                          NTStatus = 0xC0000421
+	StatusCallbackPopStack                                      NTStatus = 0xC0000423
+	StatusIncompatibleDriverBlocked                             NTStatus = 0xC0000424
+	StatusHiveUnloaded                                          NTStatus = 0xC0000425
+	StatusCompressionDisabled                                   NTStatus = 0xC0000426
+	StatusFileSystemLimitation                                  NTStatus = 0xC0000427
+	StatusInvalidImageHash                                      NTStatus = 0xC0000428
+	StatusNotCapable                                            NTStatus = 0xC0000429
+	StatusRequestOutOfSequence                                  NTStatus = 0xC000042A
+	StatusImplementationLimit                                   NTStatus = 0xC000042B
+	StatusElevationRequired                                     NTStatus = 0xC000042C
+	StatusNoSecurityContext                                     NTStatus = 0xC000042D
+	StatusPku2uCertFailure                                      NTStatus = 0xC000042F
+	StatusBeyondVdl                                             NTStatus = 0xC0000432
+	StatusEncounteredWriteInProgress                            NTStatus = 0xC0000433
+	StatusPteChanged                                            NTStatus = 0xC0000434
+	StatusPurgeFailed                                           NTStatus = 0xC0000435
+	StatusCredRequiresConfirmation                              NTStatus = 0xC0000440
+	StatusCsEncryptionInvalidServerResponse                     NTStatus = 0xC0000441
+	StatusCsEncryptionUnsupportedServer                         NTStatus = 0xC0000442
+	StatusCsEncryptionExistingEncryptedFile                     NTStatus = 0xC0000443
+	StatusCsEncryptionNewEncryptedFile                          NTStatus = 0xC0000444
+	StatusCsEncryptionFileNotCse                                NTStatus = 0xC0000445
+	StatusInvalidLabel                                          NTStatus = 0xC0000446
+	StatusDriverProcessTerminated                               NTStatus = 0xC0000450
+	StatusAmbiguousSystemDevice                                 NTStatus = 0xC0000451
+	StatusSystemDeviceNotFound                                  NTStatus = 0xC0000452
+	StatusRestartBootApplication                                NTStatus = 0xC0000453
+	StatusInsufficientNvramResources                            NTStatus = 0xC0000454
+	StatusInvalidSession                                        NTStatus = 0xC0000455
+	StatusThreadAlreadyInSession                                NTStatus = 0xC0000456
+	StatusThreadNotInSession                                    NTStatus = 0xC0000457
+	StatusInvalidWeight                                         NTStatus = 0xC0000458
+	StatusRequestPaused                                         NTStatus = 0xC0000459
+	StatusNoRangesProcessed                                     NTStatus = 0xC0000460
+	StatusDiskResourcesExhausted                                NTStatus = 0xC0000461
+	StatusNeedsRemediation                                      NTStatus = 0xC0000462
+	StatusDeviceFeatureNotSupported                             NTStatus = 0xC0000463
+	StatusDeviceUnreachable                                     NTStatus = 0xC0000464
+	StatusInvalidToken                                          NTStatus = 0xC0000465
+	StatusServerUnavailable                                     NTStatus = 0xC0000466
+	StatusFileNotAvailable                                      NTStatus = 0xC0000467
+	StatusDeviceInsufficientResources                           NTStatus = 0xC0000468
+	StatusPackageUpdating                                       NTStatus = 0xC0000469
+	StatusNotReadFromCopy                                       NTStatus = 0xC000046A
+	StatusFtWriteFailure                                        NTStatus = 0xC000046B
+	StatusFtDiScanRequired                                      NTStatus = 0xC000046C
+	StatusObjectNotExternallyBacked                             NTStatus = 0xC000046D
+	StatusExternalBackingProviderUnknown                        NTStatus = 0xC000046E
+	StatusCompressionNotBeneficial                              NTStatus = 0xC000046F
+	StatusDataChecksumError                                     NTStatus = 0xC0000470
+	StatusIntermixedKernelEaOperation                           NTStatus = 0xC0000471
+	StatusTrimReadZeroNotSupported                              NTStatus = 0xC0000472
+	StatusTooManySegmentDescriptors                             NTStatus = 0xC0000473
+	StatusInvalidOffsetAlignment                                NTStatus = 0xC0000474
+	StatusInvalidFieldInParameterList                           NTStatus = 0xC0000475
+	StatusOperationInProgress                                   NTStatus = 0xC0000476
+	StatusInvalidInitiatorTargetPath                            NTStatus = 0xC0000477
+	StatusScrubDataDisabled                                     NTStatus = 0xC0000478
+	StatusNotRedundantStorage                                   NTStatus = 0xC0000479
+	StatusResidentFileNotSupported                              NTStatus = 0xC000047A
+	StatusCompressedFileNotSupported                            NTStatus = 0xC000047B
+	StatusDirectoryNotSupported                                 NTStatus = 0xC000047C
+	StatusIoOperationTimeout                                    NTStatus = 0xC000047D
+	StatusSystemNeedsRemediation                                NTStatus = 0xC000047E
+	StatusAppxIntegrityFailureClrNgen                           NTStatus = 0xC000047F
+	StatusShareUnavailable                                      NTStatus = 0xC0000480
+	StatusApisetNotHosted                                       NTStatus = 0xC0000481
+	StatusApisetNotPresent                                      NTStatus = 0xC0000482
+	StatusDeviceHardwareError                                   NTStatus = 0xC0000483
+	StatusFirmwareSlotInvalid                                   NTStatus = 0xC0000484
+	StatusFirmwareImageInvalid                                  NTStatus = 0xC0000485
+	StatusStorageTopologyIdMismatch                             NTStatus = 0xC0000486
+	StatusWimNotBootable                                        NTStatus = 0xC0000487
+	StatusBlockedByParentalControls                             NTStatus = 0xC0000488
+	StatusNeedsRegistration                                     NTStatus = 0xC0000489
+	StatusQuotaActivity                                         NTStatus = 0xC000048A
+	StatusCallbackInvokeInline                                  NTStatus = 0xC000048B
+	StatusBlockTooManyReferences                                NTStatus = 0xC000048C
+	StatusMarkedToDisallowWrites                                NTStatus = 0xC000048D
+	StatusNetworkAccessDeniedEdp                                NTStatus = 0xC000048E
+	StatusEnclaveFailure                                        NTStatus = 0xC000048F
+	StatusPnpNoCompatDrivers                                    NTStatus = 0xC0000490
+	StatusPnpDriverPackageNotFound                              NTStatus = 0xC0000491
+	StatusPnpDriverConfigurationNotFound                        NTStatus = 0xC0000492
+	StatusPnpDriverConfigurationIncomplete                      NTStatus = 0xC0000493
+	StatusPnpFunctionDriverRequired                             NTStatus = 0xC0000494
+	StatusPnpDeviceConfigurationPending                         NTStatus = 0xC0000495
+	StatusDeviceHintNameBufferTooSmall                          NTStatus = 0xC0000496
+	StatusPackageNotAvailable                                   NTStatus = 0xC0000497
+	StatusDeviceInMaintenance                                   NTStatus = 0xC0000499
+	StatusNotSupportedOnDax                                     NTStatus = 0xC000049A
+	StatusFreeSpaceTooFragmented                                NTStatus = 0xC000049B
+	StatusDaxMappingExists                                      NTStatus = 0xC000049C
+	StatusChildProcessBlocked                                   NTStatus = 0xC000049D
+	StatusStorageLostDataPersistence                            NTStatus = 0xC000049E
+	StatusVrfCfgEnabled                                         NTStatus = 0xC000049F
+	StatusPartitionTerminating                                  NTStatus = 0xC00004A0
+	StatusExternalSyskeyNotSupported                            NTStatus = 0xC00004A1
+	StatusEnclaveViolation                                      NTStatus = 0xC00004A2
+	StatusFileProtectedUnderDpl                                 NTStatus = 0xC00004A3
+	StatusVolumeNotClusterAligned                               NTStatus = 0xC00004A4
+	StatusNoPhysicallyAlignedFreeSpaceFound                     NTStatus = 0xC00004A5
+	StatusAppxFileNotEncrypted                                  NTStatus = 0xC00004A6
+	StatusRwrawEncryptedFileNotEncrypted                        NTStatus = 0xC00004A7
+	StatusRwrawEncryptedInvalidEdatainfoFileoffset              NTStatus = 0xC00004A8
+	StatusRwrawEncryptedInvalidEdatainfoFilerange               NTStatus = 0xC00004A9
+	StatusRwrawEncryptedInvalidEdatainfoParameter               NTStatus = 0xC00004AA
+	StatusFtReadFailure                                         NTStatus = 0xC00004AB
+	StatusPatchConflict                                         NTStatus = 0xC00004AC
+	StatusStorageReserveIdInvalid                               NTStatus = 0xC00004AD
+	StatusStorageReserveDoesNotExist                            NTStatus = 0xC00004AE
+	StatusStorageReserveAlreadyExists                           NTStatus = 0xC00004AF
+	StatusStorageReserveNotEmpty                                NTStatus = 0xC00004B0
+	StatusNotADaxVolume                                         NTStatus = 0xC00004B1
+	StatusNotDaxMappable                                        NTStatus = 0xC00004B2
+	StatusCaseDifferingNamesInDir                               NTStatus = 0xC00004B3
+	StatusFileNotSupported                                      NTStatus = 0xC00004B4
+	StatusNotSupportedWithBtt                                   NTStatus = 0xC00004B5
+	StatusEncryptionDisabled                                    NTStatus = 0xC00004B6
+	StatusEncryptingMetadataDisallowed                          NTStatus = 0xC00004B7
+	StatusCantClearEncryptionFlag                               NTStatus = 0xC00004B8
+	StatusInvalidTaskName                                       NTStatus = 0xC0000500
+	StatusInvalidTaskIndex                                      NTStatus = 0xC0000501
+	StatusThreadAlreadyInTask                                   NTStatus = 0xC0000502
+	StatusCallbackBypass                                        NTStatus = 0xC0000503
+	StatusUndefinedScope                                        NTStatus = 0xC0000504
+	StatusInvalidCap                                            NTStatus = 0xC0000505
+	StatusNotGuiProcess                                         NTStatus = 0xC0000506
+	StatusDeviceHung                                            NTStatus = 0xC0000507
+	StatusContainerAssigned                                     NTStatus = 0xC0000508
+	StatusJobNoContainer                                        NTStatus = 0xC0000509
+	StatusDeviceUnresponsive                                    NTStatus = 0xC000050A
+	StatusReparsePointEncountered                               NTStatus = 0xC000050B
+	StatusAttributeNotPresent                                   NTStatus = 0xC000050C
+	StatusNotATieredVolume                                      NTStatus = 0xC000050D
+	StatusAlreadyHasStreamId                                    NTStatus = 0xC000050E
+	StatusJobNotEmpty                                           NTStatus = 0xC000050F
+	StatusAlreadyInitialized                                    NTStatus = 0xC0000510
+	StatusEnclaveNotTerminated                                  NTStatus = 0xC0000511
+	StatusEnclaveIsTerminating                                  NTStatus = 0xC0000512
+	StatusSmb1NotAvailable                                      NTStatus = 0xC0000513
+	StatusSmrGarbageCollectionRequired                          NTStatus = 0xC0000514
+	StatusInterrupted                                           NTStatus = 0xC0000515
+	StatusThreadNotRunning                                      NTStatus = 0xC0000516
+	StatusFailFastException                                     NTStatus = 0xC0000602
+	StatusImageCertRevoked                                      NTStatus = 0xC0000603
+	StatusDynamicCodeBlocked                                    NTStatus = 0xC0000604
+	StatusImageCertExpired                                      NTStatus = 0xC0000605
+	StatusStrictCfgViolation                                    NTStatus = 0xC0000606
+	StatusSetContextDenied                                      NTStatus = 0xC000060A
+	StatusCrossPartitionViolation                               NTStatus = 0xC000060B
+	StatusPortClosed                                            NTStatus = 0xC0000700
+	StatusMessageLost                                           NTStatus = 0xC0000701
+	StatusInvalidMessage                                        NTStatus = 0xC0000702
+	StatusRequestCanceled                                       NTStatus = 0xC0000703
+	StatusRecursiveDispatch                                     NTStatus = 0xC0000704
+	StatusLpcReceiveBufferExpected                              NTStatus = 0xC0000705
+	StatusLpcInvalidConnectionUsage                             NTStatus = 0xC0000706
+	StatusLpcRequestsNotAllowed                                 NTStatus = 0xC0000707
+	StatusResourceInUse                                         NTStatus = 0xC0000708
+	StatusHardwareMemoryError                                   NTStatus = 0xC0000709
+	StatusThreadpoolHandleException                             NTStatus = 0xC000070A
+	StatusThreadpoolSetEventOnCompletionFailed                  NTStatus = 0xC000070B
+	StatusThreadpoolReleaseSemaphoreOnCompletionFailed          NTStatus = 0xC000070C
+	StatusThreadpoolReleaseMutexOnCompletionFailed              NTStatus = 0xC000070D
+	StatusThreadpoolFreeLibraryOnCompletionFailed               NTStatus = 0xC000070E
+	StatusThreadpoolReleasedDuringOperation                     NTStatus = 0xC000070F
+	StatusCallbackReturnedWhileImpersonating                    NTStatus = 0xC0000710
+	StatusApcReturnedWhileImpersonating                         NTStatus = 0xC0000711
+	StatusProcessIsProtected                                    NTStatus = 0xC0000712
+	StatusMcaException                                          NTStatus = 0xC0000713
+	StatusCertificateMappingNotUnique                           NTStatus = 0xC0000714
+	StatusSymlinkClassDisabled                                  NTStatus = 0xC0000715
+	StatusInvalidIdnNormalization                               NTStatus = 0xC0000716
+	StatusNoUnicodeTranslation                                  NTStatus = 0xC0000717
+	StatusAlreadyRegistered                                     NTStatus = 0xC0000718
+	StatusContextMismatch                                       NTStatus = 0xC0000719
+	StatusPortAlreadyHasCompletionList                          NTStatus = 0xC000071A
+	StatusCallbackReturnedThreadPriority                        NTStatus = 0xC000071B
+	StatusInvalidThread                                         NTStatus = 0xC000071C
+	StatusCallbackReturnedTransaction                           NTStatus = 0xC000071D
+	StatusCallbackReturnedLdrLock                               NTStatus = 0xC000071E
+	StatusCallbackReturnedLang                                  NTStatus = 0xC000071F
+	StatusCallbackReturnedPriBack                               NTStatus = 0xC0000720
+	StatusCallbackReturnedThreadAffinity                        NTStatus = 0xC0000721
+	StatusLpcHandleCountExceeded                                NTStatus = 0xC0000722
+	StatusExecutableMemoryWrite                                 NTStatus = 0xC0000723
+	StatusKernelExecutableMemoryWrite                           NTStatus = 0xC0000724
+	StatusAttachedExecutableMemoryWrite                         NTStatus = 0xC0000725
+	StatusTriggeredExecutableMemoryWrite                        NTStatus = 0xC0000726
+	StatusDiskRepairDisabled                                    NTStatus = 0xC0000800
+	StatusDsDomainRenameInProgress                              NTStatus = 0xC0000801
+	StatusDiskQuotaExceeded                                     NTStatus = 0xC0000802
+	StatusContentBlocked                                        NTStatus = 0xC0000804
+	StatusBadClusters                                           NTStatus = 0xC0000805
+	StatusVolumeDirty                                           NTStatus = 0xC0000806
+	StatusDiskRepairUnsuccessful                                NTStatus = 0xC0000808
+	StatusCorruptLogOverfull                                    NTStatus = 0xC0000809
+	StatusCorruptLogCorrupted                                   NTStatus = 0xC000080A
+	StatusCorruptLogUnavailable                                 NTStatus = 0xC000080B
+	StatusCorruptLogDeletedFull                                 NTStatus = 0xC000080C
+	StatusCorruptLogCleared                                     NTStatus = 0xC000080D
+	StatusOrphanNameExhausted                                   NTStatus = 0xC000080E
+	StatusProactiveScanInProgress                               NTStatus = 0xC000080F
+	StatusEncryptedIoNotPossible                                NTStatus = 0xC0000810
+	StatusCorruptLogUplevelRecords                              NTStatus = 0xC0000811
+	StatusFileCheckedOut                                        NTStatus = 0xC0000901
+	StatusCheckoutRequired                                      NTStatus = 0xC0000902
+	StatusBadFileType                                           NTStatus = 0xC0000903
+	StatusFileTooLarge                                          NTStatus = 0xC0000904
+	StatusFormsAuthRequired                                     NTStatus = 0xC0000905
+	StatusVirusInfected                                         NTStatus = 0xC0000906
+	StatusVirusDeleted                                          NTStatus = 0xC0000907
+	StatusBadMcfgTable                                          NTStatus = 0xC0000908
+	StatusCannotBreakOplock                                     NTStatus = 0xC0000909
+	StatusBadKey                                                NTStatus = 0xC000090A
+	StatusBadData                                               NTStatus = 0xC000090B
+	StatusNoKey                                                 NTStatus = 0xC000090C
+	StatusFileHandleRevoked                                     NTStatus = 0xC0000910
+	StatusWowAssertion                                          NTStatus = 0xC0009898
+	StatusInvalidSignature                                      NTStatus = 0xC000A000
+	StatusHmacNotSupported                                      NTStatus = 0xC000A001
+	StatusAuthTagMismatch                                       NTStatus = 0xC000A002
+	StatusInvalidStateTransition                                NTStatus = 0xC000A003
+	StatusInvalidKernelInfoVersion                              NTStatus = 0xC000A004
+	StatusInvalidPepInfoVersion                                 NTStatus = 0xC000A005
+	StatusHandleRevoked                                         NTStatus = 0xC000A006
+	StatusEofOnGhostedRange                                     NTStatus = 0xC000A007
+	StatusIpsecQueueOverflow                                    NTStatus = 0xC000A010
+	StatusNdQueueOverflow                                       NTStatus = 0xC000A011
+	StatusHoplimitExceeded                                      NTStatus = 0xC000A012
+	StatusProtocolNotSupported                                  NTStatus = 0xC000A013
+	StatusFastpathRejected                                      NTStatus = 0xC000A014
+	StatusLostWritebehindDataNetworkDisconnected                NTStatus = 0xC000A080
+	StatusLostWritebehindDataNetworkServerError                 NTStatus = 0xC000A081
+	StatusLostWritebehindDataLocalDiskError                     NTStatus = 0xC000A082
+	StatusXmlParseError                                         NTStatus = 0xC000A083
+	StatusXmldsigError                                          NTStatus = 0xC000A084
+	StatusWrongCompartment                                      NTStatus = 0xC000A085
+	StatusAuthipFailure                                         NTStatus = 0xC000A086
+	StatusDsOidMappedGroupCantHaveMembers                       NTStatus = 0xC000A087
+	StatusDsOidNotFound                                         NTStatus = 0xC000A088
+	StatusIncorrectAccountType                                  NTStatus = 0xC000A089
+	StatusHashNotSupported                                      NTStatus = 0xC000A100
+	StatusHashNotPresent                                        NTStatus = 0xC000A101
+	StatusSecondaryIcProviderNotRegistered                      NTStatus = 0xC000A121
+	StatusGpioClientInformationInvalid                          NTStatus = 0xC000A122
+	StatusGpioVersionNotSupported                               NTStatus = 0xC000A123
+	StatusGpioInvalidRegistrationPacket                         NTStatus = 0xC000A124
+	StatusGpioOperationDenied                                   NTStatus = 0xC000A125
+	StatusGpioIncompatibleConnectMode                           NTStatus = 0xC000A126
+	StatusCannotSwitchRunlevel                                  NTStatus = 0xC000A141
+	StatusInvalidRunlevelSetting                                NTStatus = 0xC000A142
+	StatusRunlevelSwitchTimeout                                 NTStatus = 0xC000A143
+	StatusRunlevelSwitchAgentTimeout                            NTStatus = 0xC000A145
+	StatusRunlevelSwitchInProgress                              NTStatus = 0xC000A146
+	StatusNotAppcontainer                                       NTStatus = 0xC000A200
+	StatusNotSupportedInAppcontainer                            NTStatus = 0xC000A201
+	StatusInvalidPackageSidLength                               NTStatus = 0xC000A202
+	StatusLpacAccessDenied                                      NTStatus = 0xC000A203
+	StatusAdminlessAccessDenied                                 NTStatus = 0xC000A204
+	StatusAppDataNotFound                                       NTStatus = 0xC000A281
+	StatusAppDataExpired                                        NTStatus = 0xC000A282
+	StatusAppDataCorrupt                                        NTStatus = 0xC000A283
+	StatusAppDataLimitExceeded                                  NTStatus = 0xC000A284
+	StatusAppDataRebootRequired                                 NTStatus = 0xC000A285
+	StatusOffloadReadFltNotSupported                            NTStatus = 0xC000A2A1
+	StatusOffloadWriteFltNotSupported                           NTStatus = 0xC000A2A2
+	StatusOffloadReadFileNotSupported                           NTStatus = 0xC000A2A3
+	StatusOffloadWriteFileNotSupported                          NTStatus = 0xC000A2A4
+	StatusWofWimHeaderCorrupt                                   NTStatus = 0xC000A2A5
+	StatusWofWimResourceTableCorrupt                            NTStatus = 0xC000A2A6
+	StatusWofFileResourceTableCorrupt                           NTStatus = 0xC000A2A7
+	StatusFileSystemVirtualizationUnavailable                   NTStatus = 0xC000CE01
+	StatusFileSystemVirtualizationMetadataCorrupt               NTStatus = 0xC000CE02
+	StatusFileSystemVirtualizationBusy                          NTStatus = 0xC000CE03
+	StatusFileSystemVirtualizationProviderUnknown               NTStatus = 0xC000CE04
+	StatusFileSystemVirtualizationInvalidOperation              NTStatus = 0xC000CE05
+	StatusCloudFileSyncRootMetadataCorrupt                      NTStatus = 0xC000CF00
+	StatusCloudFileProviderNotRunning                           NTStatus = 0xC000CF01
+	StatusCloudFileMetadataCorrupt                              NTStatus = 0xC000CF02
+	StatusCloudFileMetadataTooLarge                             NTStatus = 0xC000CF03
+	StatusCloudFilePropertyVersionNotSupported                  NTStatus = 0xC000CF06
+	StatusNotACloudFile                                         NTStatus = 0xC000CF07
+	StatusCloudFileNotInSync                                    NTStatus = 0xC000CF08
+	StatusCloudFileAlreadyConnected                             NTStatus = 0xC000CF09
+	StatusCloudFileNotSupported                                 NTStatus = 0xC000CF0A
+	StatusCloudFileInvalidRequest                               NTStatus = 0xC000CF0B
+	StatusCloudFileReadOnlyVolume                               NTStatus = 0xC000CF0C
+	StatusCloudFileConnectedProviderOnly                        NTStatus = 0xC000CF0D
+	StatusCloudFileValidationFailed                             NTStatus = 0xC000CF0E
+	StatusCloudFileAuthenticationFailed                         NTStatus = 0xC000CF0F
+	StatusCloudFileInsufficientResources                        NTStatus = 0xC000CF10
+	StatusCloudFileNetworkUnavailable                           NTStatus = 0xC000CF11
+	StatusCloudFileUnsuccessful                                 NTStatus = 0xC000CF12
+	StatusCloudFileNotUnderSyncRoot                             NTStatus = 0xC000CF13
+	StatusCloudFileInUse                                        NTStatus = 0xC000CF14
+	StatusCloudFilePinned                                       NTStatus = 0xC000CF15
+	StatusCloudFileRequestAborted                               NTStatus = 0xC000CF16
+	StatusCloudFilePropertyCorrupt                              NTStatus = 0xC000CF17
+	StatusCloudFileAccessDenied                                 NTStatus = 0xC000CF18
+	StatusCloudFileIncompatibleHardlinks                        NTStatus = 0xC000CF19
+	StatusCloudFilePropertyLockConflict                         NTStatus = 0xC000CF1A
+	StatusCloudFileRequestCanceled                              NTStatus = 0xC000CF1B
+	StatusCloudFileProviderTerminated                           NTStatus = 0xC000CF1D
+	StatusNotACloudSyncRoot                                     NTStatus = 0xC000CF1E
+	StatusCloudFileRequestTimeout                               NTStatus = 0xC000CF1F
+	StatusPnpBadMpsTable                                        NTStatus = 0xC0040035
+	StatusPnpTranslationFailed                                  NTStatus = 0xC0040036
+	StatusPnpIrqTranslationFailed                               NTStatus = 0xC0040037
+	StatusPnpInvalidId                                          NTStatus = 0xC0040038
+	StatusIoReissueAsCached                                     NTStatus = 0xC0040039
+	StatusCtxWinstationNameInvalid                              NTStatus = 0xC00A0001
+	StatusCtxInvalidPd                                          NTStatus = 0xC00A0002
+	StatusCtxPdNotFound                                         NTStatus = 0xC00A0003
+	StatusCtxClosePending                                       NTStatus = 0xC00A0006
+	StatusCtxNoOutbuf                                           NTStatus = 0xC00A0007
+	StatusCtxModemInfNotFound                                   NTStatus = 0xC00A0008
+	StatusCtxInvalidModemname                                   NTStatus = 0xC00A0009
+	StatusCtxResponseError                                      NTStatus = 0xC00A000A
+	StatusCtxModemResponseTimeout                               NTStatus = 0xC00A000B
+	StatusCtxModemResponseNoCarrier                             NTStatus = 0xC00A000C
+	StatusCtxModemResponseNoDialtone                            NTStatus = 0xC00A000D
+	StatusCtxModemResponseBusy                                  NTStatus = 0xC00A000E
+	StatusCtxModemResponseVoice                                 NTStatus = 0xC00A000F
+	StatusCtxTdError                                            NTStatus = 0xC00A0010
+	StatusCtxLicenseClientInvalid                               NTStatus = 0xC00A0012
+	StatusCtxLicenseNotAvailable                                NTStatus = 0xC00A0013
+	StatusCtxLicenseExpired                                     NTStatus = 0xC00A0014
+	StatusCtxWinstationNotFound                                 NTStatus = 0xC00A0015
+	StatusCtxWinstationNameCollision                            NTStatus = 0xC00A0016
+	StatusCtxWinstationBusy                                     NTStatus = 0xC00A0017
+	StatusCtxBadVideoMode                                       NTStatus = 0xC00A0018
+	StatusCtxGraphicsInvalid                                    NTStatus = 0xC00A0022
+	StatusCtxNotConsole                                         NTStatus = 0xC00A0024
+	StatusCtxClientQueryTimeout                                 NTStatus = 0xC00A0026
+	StatusCtxConsoleDisconnect                                  NTStatus = 0xC00A0027
+	StatusCtxConsoleConnect                                     NTStatus = 0xC00A0028
+	StatusCtxShadowDenied                                       NTStatus = 0xC00A002A
+	StatusCtxWinstationAccessDenied                             NTStatus = 0xC00A002B
+	StatusCtxInvalidWd                                          NTStatus = 0xC00A002E
+	StatusCtxWdNotFound                                         NTStatus = 0xC00A002F
+	StatusCtxShadowInvalid                                      NTStatus = 0xC00A0030
+	StatusCtxShadowDisabled                                     NTStatus = 0xC00A0031
+	StatusRdpProtocolError                                      NTStatus = 0xC00A0032
+	StatusCtxClientLicenseNotSet                                NTStatus = 0xC00A0033
+	StatusCtxClientLicenseInUse                                 NTStatus = 0xC00A0034
+	StatusCtxShadowEndedByModeChange                            NTStatus = 0xC00A0035
+	StatusCtxShadowNotRunning                                   NTStatus = 0xC00A0036
+	StatusCtxLogonDisabled                                      NTStatus = 0xC00A0037
+	StatusCtxSecurityLayerError                                 NTStatus = 0xC00A0038
+	StatusTsIncompatibleSessions                                NTStatus = 0xC00A0039
+	StatusTsVideoSubsystemError                                 NTStatus = 0xC00A003A
+	StatusMuiFileNotFound                                       NTStatus = 0xC00B0001
+	StatusMuiInvalidFile                                        NTStatus = 0xC00B0002
+	StatusMuiInvalidRcConfig                                    NTStatus = 0xC00B0003
+	StatusMuiInvalidLocaleName                                  NTStatus = 0xC00B0004
+	StatusMuiInvalidUltimatefallbackName                        NTStatus = 0xC00B0005
+	StatusMuiFileNotLoaded                                      NTStatus = 0xC00B0006
+	StatusResourceEnumUserStop                                  NTStatus = 0xC00B0007
+	StatusClusterInvalidNode                                    NTStatus = 0xC0130001
+	StatusClusterNodeExists                                     NTStatus = 0xC0130002
+	StatusClusterJoinInProgress                                 NTStatus = 0xC0130003
+	StatusClusterNodeNotFound                                   NTStatus = 0xC0130004
+	StatusClusterLocalNodeNotFound                              NTStatus = 0xC0130005
+	StatusClusterNetworkExists                                  NTStatus = 0xC0130006
+	StatusClusterNetworkNotFound                                NTStatus = 0xC0130007
+	StatusClusterNetinterfaceExists                             NTStatus = 0xC0130008
+	StatusClusterNetinterfaceNotFound                           NTStatus = 0xC0130009
+	StatusClusterInvalidRequest                                 NTStatus = 0xC013000A
+	StatusClusterInvalidNetworkProvider                         NTStatus = 0xC013000B
+	StatusClusterNodeDown                                       NTStatus = 0xC013000C
+	StatusClusterNodeUnreachable                                NTStatus = 0xC013000D
+	StatusClusterNodeNotMember                                  NTStatus = 0xC013000E
+	StatusClusterJoinNotInProgress                              NTStatus = 0xC013000F
+	StatusClusterInvalidNetwork                                 NTStatus = 0xC0130010
+	StatusClusterNoNetAdapters                                  NTStatus = 0xC0130011
+	StatusClusterNodeUp                                         NTStatus = 0xC0130012
+	StatusClusterNodePaused                                     NTStatus = 0xC0130013
+	StatusClusterNodeNotPaused                                  NTStatus = 0xC0130014
+	StatusClusterNoSecurityContext                              NTStatus = 0xC0130015
+	StatusClusterNetworkNotInternal                             NTStatus = 0xC0130016
+	StatusClusterPoisoned                                       NTStatus = 0xC0130017
+	StatusClusterNonCsvPath                                     NTStatus = 0xC0130018
+	StatusClusterCsvVolumeNotLocal                              NTStatus = 0xC0130019
+	StatusClusterCsvReadOplockBreakInProgress                   NTStatus = 0xC0130020
+	StatusClusterCsvAutoPauseError                              NTStatus = 0xC0130021
+	StatusClusterCsvRedirected                                  NTStatus = 0xC0130022
+	StatusClusterCsvNotRedirected                               NTStatus = 0xC0130023
+	StatusClusterCsvVolumeDraining                              NTStatus = 0xC0130024
+	StatusClusterCsvSnapshotCreationInProgress                  NTStatus = 0xC0130025
+	StatusClusterCsvVolumeDrainingSucceededDownlevel            NTStatus = 0xC0130026
+	StatusClusterCsvNoSnapshots                                 NTStatus = 0xC0130027
+	StatusCsvIoPauseTimeout                                     NTStatus = 0xC0130028
+	StatusClusterCsvInvalidHandle                               NTStatus = 0xC0130029
+	StatusClusterCsvSupportedOnlyOnCoordinator                  NTStatus = 0xC0130030
+	StatusClusterCamTicketReplayDetected                        NTStatus = 0xC0130031
+	StatusAcpiInvalidOpcode                                     NTStatus = 0xC0140001
+	StatusAcpiStackOverflow                                     NTStatus = 0xC0140002
+	StatusAcpiAssertFailed                                      NTStatus = 0xC0140003
+	StatusAcpiInvalidIndex                                      NTStatus = 0xC0140004
+	StatusAcpiInvalidArgument                                   NTStatus = 0xC0140005
+	StatusAcpiFatal                                             NTStatus = 0xC0140006
+	StatusAcpiInvalidSupername                                  NTStatus = 0xC0140007
+	StatusAcpiInvalidArgtype                                    NTStatus = 0xC0140008
+	StatusAcpiInvalidObjtype                                    NTStatus = 0xC0140009
+	StatusAcpiInvalidTargettype                                 NTStatus = 0xC014000A
+	StatusAcpiIncorrectArgumentCount                            NTStatus = 0xC014000B
+	StatusAcpiAddressNotMapped                                  NTStatus = 0xC014000C
+	StatusAcpiInvalidEventtype                                  NTStatus = 0xC014000D
+	StatusAcpiHandlerCollision                                  NTStatus = 0xC014000E
+	StatusAcpiInvalidData                                       NTStatus = 0xC014000F
+	StatusAcpiInvalidRegion                                     NTStatus = 0xC0140010
+	StatusAcpiInvalidAccessSize                                 NTStatus = 0xC0140011
+	StatusAcpiAcquireGlobalLock                                 NTStatus = 0xC0140012
+	StatusAcpiAlreadyInitialized                                NTStatus = 0xC0140013
+	StatusAcpiNotInitialized                                    NTStatus = 0xC0140014
+	StatusAcpiInvalidMutexLevel                                 NTStatus = 0xC0140015
+	StatusAcpiMutexNotOwned                                     NTStatus = 0xC0140016
+	StatusAcpiMutexNotOwner                                     NTStatus = 0xC0140017
+	StatusAcpiRsAccess                                          NTStatus = 0xC0140018
+	StatusAcpiInvalidTable                                      NTStatus = 0xC0140019
+	StatusAcpiRegHandlerFailed                                  NTStatus = 0xC0140020
+	StatusAcpiPowerRequestFailed                                NTStatus = 0xC0140021
+	StatusSxsSectionNotFound                                    NTStatus = 0xC0150001
+	StatusSxsCantGenActctx                                      NTStatus = 0xC0150002
+	StatusSxsInvalidActctxdataFormat                            NTStatus = 0xC0150003
+	StatusSxsAssemblyNotFound                                   NTStatus = 0xC0150004
+	StatusSxsManifestFormatError                                NTStatus = 0xC0150005
+	StatusSxsManifestParseError                                 NTStatus = 0xC0150006
+	StatusSxsActivationContextDisabled                          NTStatus = 0xC0150007
+	StatusSxsKeyNotFound                                        NTStatus = 0xC0150008
+	StatusSxsVersionConflict                                    NTStatus = 0xC0150009
+	StatusSxsWrongSectionType                                   NTStatus = 0xC015000A
+	StatusSxsThreadQueriesDisabled                              NTStatus = 0xC015000B
+	StatusSxsAssemblyMissing                                    NTStatus = 0xC015000C
+	StatusSxsProcessDefaultAlreadySet                           NTStatus = 0xC015000E
+	StatusSxsEarlyDeactivation                                  NTStatus = 0xC015000F
+	StatusSxsInvalidDeactivation                                NTStatus = 0xC0150010
+	StatusSxsMultipleDeactivation                               NTStatus = 0xC0150011
+	StatusSxsSystemDefaultActivationContextEmpty                NTStatus = 0xC0150012
+	StatusSxsProcessTerminationRequested                        NTStatus = 0xC0150013
+	StatusSxsCorruptActivationStack                             NTStatus = 0xC0150014
+	StatusSxsCorruption                                         NTStatus = 0xC0150015
+	StatusSxsInvalidIdentityAttributeValue                      NTStatus = 0xC0150016
+	StatusSxsInvalidIdentityAttributeName                       NTStatus = 0xC0150017
+	StatusSxsIdentityDuplicateAttribute                         NTStatus = 0xC0150018
+	StatusSxsIdentityParseError                                 NTStatus = 0xC0150019
+	StatusSxsComponentStoreCorrupt                              NTStatus = 0xC015001A
+	StatusSxsFileHashMismatch                                   NTStatus = 0xC015001B
+	StatusSxsManifestIdentitySameButContentsDifferent           NTStatus = 0xC015001C
+	StatusSxsIdentitiesDifferent                                NTStatus = 0xC015001D
+	StatusSxsAssemblyIsNotADeployment                           NTStatus = 0xC015001E
+	StatusSxsFileNotPartOfAssembly                              NTStatus = 0xC015001F
+	StatusAdvancedInstallerFailed                               NTStatus = 0xC0150020
+	StatusXmlEncodingMismatch                                   NTStatus = 0xC0150021
+	StatusSxsManifestTooBig                                     NTStatus = 0xC0150022
+	StatusSxsSettingNotRegistered                               NTStatus = 0xC0150023
+	StatusSxsTransactionClosureIncomplete                       NTStatus = 0xC0150024
+	StatusSmiPrimitiveInstallerFailed                           NTStatus = 0xC0150025
+	StatusGenericCommandFailed                                  NTStatus = 0xC0150026
+	StatusSxsFileHashMissing                                    NTStatus = 0xC0150027
+	StatusTransactionalConflict                                 NTStatus = 0xC0190001
+	StatusInvalidTransaction                                    NTStatus = 0xC0190002
+	StatusTransactionNotActive                                  NTStatus = 0xC0190003
+	StatusTmInitializationFailed                                NTStatus = 0xC0190004
+	StatusRmNotActive                                           NTStatus = 0xC0190005
+	StatusRmMetadataCorrupt                                     NTStatus = 0xC0190006
+	StatusTransactionNotJoined                                  NTStatus = 0xC0190007
+	StatusDirectoryNotRm                                        NTStatus = 0xC0190008
+	StatusTransactionsUnsupportedRemote                         NTStatus = 0xC019000A
+	StatusLogResizeInvalidSize                                  NTStatus = 0xC019000B
+	StatusRemoteFileVersionMismatch                             NTStatus = 0xC019000C
+	StatusCrmProtocolAlreadyExists                              NTStatus = 0xC019000F
+	StatusTransactionPropagationFailed                          NTStatus = 0xC0190010
+	StatusCrmProtocolNotFound                                   NTStatus = 0xC0190011
+	StatusTransactionSuperiorExists                             NTStatus = 0xC0190012
+	StatusTransactionRequestNotValid                            NTStatus = 0xC0190013
+	StatusTransactionNotRequested                               NTStatus = 0xC0190014
+	StatusTransactionAlreadyAborted                             NTStatus = 0xC0190015
+	StatusTransactionAlreadyCommitted                           NTStatus = 0xC0190016
+	StatusTransactionInvalidMarshallBuffer                      NTStatus = 0xC0190017
+	StatusCurrentTransactionNotValid                            NTStatus = 0xC0190018
+	StatusLogGrowthFailed                                       NTStatus = 0xC0190019
+	StatusObjectNoLongerExists                                  NTStatus = 0xC0190021
+	StatusStreamMiniversionNotFound                             NTStatus = 0xC0190022
+	StatusStreamMiniversionNotValid                             NTStatus = 0xC0190023
+	StatusMiniversionInaccessibleFromSpecifiedTransaction       NTStatus = 0xC0190024
+	StatusCantOpenMiniversionWithModifyIntent                   NTStatus = 0xC0190025
+	StatusCantCreateMoreStreamMiniversions                      NTStatus = 0xC0190026
+	StatusHandleNoLongerValid                                   NTStatus = 0xC0190028
+	StatusLogCorruptionDetected                                 NTStatus = 0xC0190030
+	StatusRmDisconnected                                        NTStatus = 0xC0190032
+	StatusEnlistmentNotSuperior                                 NTStatus = 0xC0190033
+	StatusFileIdentityNotPersistent                             NTStatus = 0xC0190036
+	StatusCantBreakTransactionalDependency                      NTStatus = 0xC0190037
+	StatusCantCrossRmBoundary                                   NTStatus = 0xC0190038
+	StatusTxfDirNotEmpty                                        NTStatus = 0xC0190039
+	StatusIndoubtTransactionsExist                              NTStatus = 0xC019003A
+	StatusTmVolatile                                            NTStatus = 0xC019003B
+	StatusRollbackTimerExpired                                  NTStatus = 0xC019003C
+	StatusTxfAttributeCorrupt                                   NTStatus = 0xC019003D
+	StatusEfsNotAllowedInTransaction                            NTStatus = 0xC019003E
+	StatusTransactionalOpenNotAllowed                           NTStatus = 0xC019003F
+	StatusTransactedMappingUnsupportedRemote                    NTStatus = 0xC0190040
+	StatusTransactionRequiredPromotion                          NTStatus = 0xC0190043
+	StatusCannotExecuteFileInTransaction                        NTStatus = 0xC0190044
+	StatusTransactionsNotFrozen                                 NTStatus = 0xC0190045
+	StatusTransactionFreezeInProgress                           NTStatus = 0xC0190046
+	StatusNotSnapshotVolume                                     NTStatus = 0xC0190047
+	StatusNoSavepointWithOpenFiles                              NTStatus = 0xC0190048
+	StatusSparseNotAllowedInTransaction                         NTStatus = 0xC0190049
+	StatusTmIdentityMismatch                                    NTStatus = 0xC019004A
+	StatusFloatedSection                                        NTStatus = 0xC019004B
+	StatusCannotAcceptTransactedWork                            NTStatus = 0xC019004C
+	StatusCannotAbortTransactions                               NTStatus = 0xC019004D
+	StatusTransactionNotFound                                   NTStatus = 0xC019004E
+	StatusResourcemanagerNotFound                               NTStatus = 0xC019004F
+	StatusEnlistmentNotFound                                    NTStatus = 0xC0190050
+	StatusTransactionmanagerNotFound                            NTStatus = 0xC0190051
+	StatusTransactionmanagerNotOnline                           NTStatus = 0xC0190052
+	StatusTransactionmanagerRecoveryNameCollision               NTStatus = 0xC0190053
+	StatusTransactionNotRoot                                    NTStatus = 0xC0190054
+	StatusTransactionObjectExpired                              NTStatus = 0xC0190055
+	StatusCompressionNotAllowedInTransaction                    NTStatus = 0xC0190056
+	StatusTransactionResponseNotEnlisted                        NTStatus = 0xC0190057
+	StatusTransactionRecordTooLong                              NTStatus = 0xC0190058
+	StatusNoLinkTrackingInTransaction                           NTStatus = 0xC0190059
+	StatusOperationNotSupportedInTransaction                    NTStatus = 0xC019005A
+	StatusTransactionIntegrityViolated                          NTStatus = 0xC019005B
+	StatusTransactionmanagerIdentityMismatch                    NTStatus = 0xC019005C
+	StatusRmCannotBeFrozenForSnapshot                           NTStatus = 0xC019005D
+	StatusTransactionMustWritethrough                           NTStatus = 0xC019005E
+	StatusTransactionNoSuperior                                 NTStatus = 0xC019005F
+	StatusExpiredHandle                                         NTStatus = 0xC0190060
+	StatusTransactionNotEnlisted                                NTStatus = 0xC0190061
+	StatusLogSectorInvalid                                      NTStatus = 0xC01A0001
+	StatusLogSectorParityInvalid                                NTStatus = 0xC01A0002
+	StatusLogSectorRemapped                                     NTStatus = 0xC01A0003
+	StatusLogBlockIncomplete                                    NTStatus = 0xC01A0004
+	StatusLogInvalidRange                                       NTStatus = 0xC01A0005
+	StatusLogBlocksExhausted                                    NTStatus = 0xC01A0006
+	StatusLogReadContextInvalid                                 NTStatus = 0xC01A0007
+	StatusLogRestartInvalid                                     NTStatus = 0xC01A0008
+	StatusLogBlockVersion                                       NTStatus = 0xC01A0009
+	StatusLogBlockInvalid                                       NTStatus = 0xC01A000A
+	StatusLogReadModeInvalid                                    NTStatus = 0xC01A000B
+	StatusLogMetadataCorrupt                                    NTStatus = 0xC01A000D
+	StatusLogMetadataInvalid                                    NTStatus = 0xC01A000E
+	StatusLogMetadataInconsistent                               NTStatus = 0xC01A000F
+	StatusLogReservationInvalid                                 NTStatus = 0xC01A0010
+	StatusLogCantDelete                                         NTStatus = 0xC01A0011
+	StatusLogContainerLimitExceeded                             NTStatus = 0xC01A0012
+	StatusLogStartOfLog                                         NTStatus = 0xC01A0013
+	StatusLogPolicyAlreadyInstalled                             NTStatus = 0xC01A0014
+	StatusLogPolicyNotInstalled                                 NTStatus = 0xC01A0015
+	StatusLogPolicyInvalid                                      NTStatus = 0xC01A0016
+	StatusLogPolicyConflict                                     NTStatus = 0xC01A0017
+	StatusLogPinnedArchiveTail                                  NTStatus = 0xC01A0018
+	StatusLogRecordNonexistent                                  NTStatus = 0xC01A0019
+	StatusLogRecordsReservedInvalid                             NTStatus = 0xC01A001A
+	StatusLogSpaceReservedInvalid                               NTStatus = 0xC01A001B
+	StatusLogTailInvalid                                        NTStatus = 0xC01A001C
+	StatusLogFull                                               NTStatus = 0xC01A001D
+	StatusLogMultiplexed                                        NTStatus = 0xC01A001E
+	StatusLogDedicated                                          NTStatus = 0xC01A001F
+	StatusLogArchiveNotInProgress                               NTStatus = 0xC01A0020
+	StatusLogArchiveInProgress                                  NTStatus = 0xC01A0021
+	StatusLogEphemeral                                          NTStatus = 0xC01A0022
+	StatusLogNotEnoughContainers                                NTStatus = 0xC01A0023
+	StatusLogClientAlreadyRegistered                            NTStatus = 0xC01A0024
+	StatusLogClientNotRegistered                                NTStatus = 0xC01A0025
+	StatusLogFullHandlerInProgress                              NTStatus = 0xC01A0026
+	StatusLogContainerReadFailed                                NTStatus = 0xC01A0027
+	StatusLogContainerWriteFailed                               NTStatus = 0xC01A0028
+	StatusLogContainerOpenFailed                                NTStatus = 0xC01A0029
+	StatusLogContainerStateInvalid                              NTStatus = 0xC01A002A
+	StatusLogStateInvalid                                       NTStatus = 0xC01A002B
+	StatusLogPinned                                             NTStatus = 0xC01A002C
+	StatusLogMetadataFlushFailed                                NTStatus = 0xC01A002D
+	StatusLogInconsistentSecurity                               NTStatus = 0xC01A002E
+	StatusLogAppendedFlushFailed                                NTStatus = 0xC01A002F
+	StatusLogPinnedReservation                                  NTStatus = 0xC01A0030
+	StatusVideoHungDisplayDriverThread                          NTStatus = 0xC01B00EA
+	StatusFltNoHandlerDefined                                   NTStatus = 0xC01C0001
+	StatusFltContextAlreadyDefined                              NTStatus = 0xC01C0002
+	StatusFltInvalidAsynchronousRequest                         NTStatus = 0xC01C0003
+	StatusFltDisallowFastIo                                     NTStatus = 0xC01C0004
+	StatusFltInvalidNameRequest                                 NTStatus = 0xC01C0005
+	StatusFltNotSafeToPostOperation                             NTStatus = 0xC01C0006
+	StatusFltNotInitialized                                     NTStatus = 0xC01C0007
+	StatusFltFilterNotReady                                     NTStatus = 0xC01C0008
+	StatusFltPostOperationCleanup                               NTStatus = 0xC01C0009
+	StatusFltInternalError                                      NTStatus = 0xC01C000A
+	StatusFltDeletingObject                                     NTStatus = 0xC01C000B
+	StatusFltMustBeNonpagedPool                                 NTStatus = 0xC01C000C
+	StatusFltDuplicateEntry                                     NTStatus = 0xC01C000D
+	StatusFltCbdqDisabled                                       NTStatus = 0xC01C000E
+	StatusFltDoNotAttach                                        NTStatus = 0xC01C000F
+	StatusFltDoNotDetach                                        NTStatus = 0xC01C0010
+	StatusFltInstanceAltitudeCollision                          NTStatus = 0xC01C0011
+	StatusFltInstanceNameCollision                              NTStatus = 0xC01C0012
+	StatusFltFilterNotFound                                     NTStatus = 0xC01C0013
+	StatusFltVolumeNotFound                                     NTStatus = 0xC01C0014
+	StatusFltInstanceNotFound                                   NTStatus = 0xC01C0015
+	StatusFltContextAllocationNotFound                          NTStatus = 0xC01C0016
+	StatusFltInvalidContextRegistration                         NTStatus = 0xC01C0017
+	StatusFltNameCacheMiss                                      NTStatus = 0xC01C0018
+	StatusFltNoDeviceObject                                     NTStatus = 0xC01C0019
+	StatusFltVolumeAlreadyMounted                               NTStatus = 0xC01C001A
+	StatusFltAlreadyEnlisted                                    NTStatus = 0xC01C001B
+	StatusFltContextAlreadyLinked                               NTStatus = 0xC01C001C
+	StatusFltNoWaiterForReply                                   NTStatus = 0xC01C0020
+	StatusFltRegistrationBusy                                   NTStatus = 0xC01C0023
+	StatusMonitorNoDescriptor                                   NTStatus = 0xC01D0001
+	StatusMonitorUnknownDescriptorFormat                        NTStatus = 0xC01D0002
+	StatusMonitorInvalidDescriptorChecksum                      NTStatus = 0xC01D0003
+	StatusMonitorInvalidStandardTimingBlock                     NTStatus = 0xC01D0004
+	StatusMonitorWmiDatablockRegistrationFailed                 NTStatus = 0xC01D0005
+	StatusMonitorInvalidSerialNumberMondscBlock                 NTStatus = 0xC01D0006
+	StatusMonitorInvalidUserFriendlyMondscBlock                 NTStatus = 0xC01D0007
+	StatusMonitorNoMoreDescriptorData                           NTStatus = 0xC01D0008
+	StatusMonitorInvalidDetailedTimingBlock                     NTStatus = 0xC01D0009
+	StatusMonitorInvalidManufactureDate                         NTStatus = 0xC01D000A
+	StatusGraphicsNotExclusiveModeOwner                         NTStatus = 0xC01E0000
+	StatusGraphicsInsufficientDmaBuffer                         NTStatus = 0xC01E0001
+	StatusGraphicsInvalidDisplayAdapter                         NTStatus = 0xC01E0002
+	StatusGraphicsAdapterWasReset                               NTStatus = 0xC01E0003
+	StatusGraphicsInvalidDriverModel                            NTStatus = 0xC01E0004
+	StatusGraphicsPresentModeChanged                            NTStatus = 0xC01E0005
+	StatusGraphicsPresentOccluded                               NTStatus = 0xC01E0006
+	StatusGraphicsPresentDenied                                 NTStatus = 0xC01E0007
+	StatusGraphicsCannotcolorconvert                            NTStatus = 0xC01E0008
+	StatusGraphicsDriverMismatch                                NTStatus = 0xC01E0009
+	StatusGraphicsPresentRedirectionDisabled                    NTStatus = 0xC01E000B
+	StatusGraphicsPresentUnoccluded                             NTStatus = 0xC01E000C
+	StatusGraphicsWindowdcNotAvailable                          NTStatus = 0xC01E000D
+	StatusGraphicsWindowlessPresentDisabled                     NTStatus = 0xC01E000E
+	StatusGraphicsPresentInvalidWindow                          NTStatus = 0xC01E000F
+	StatusGraphicsPresentBufferNotBound                         NTStatus = 0xC01E0010
+	StatusGraphicsVailStateChanged                              NTStatus = 0xC01E0011
+	StatusGraphicsIndirectDisplayAbandonSwapchain               NTStatus = 0xC01E0012
+	StatusGraphicsIndirectDisplayDeviceStopped                  NTStatus = 0xC01E0013
+	StatusGraphicsNoVideoMemory                                 NTStatus = 0xC01E0100
+	StatusGraphicsCantLockMemory                                NTStatus = 0xC01E0101
+	StatusGraphicsAllocationBusy                                NTStatus = 0xC01E0102
+	StatusGraphicsTooManyReferences                             NTStatus = 0xC01E0103
+	StatusGraphicsTryAgainLater                                 NTStatus = 0xC01E0104
+	StatusGraphicsTryAgainNow                                   NTStatus = 0xC01E0105
+	StatusGraphicsAllocationInvalid                             NTStatus = 0xC01E0106
+	StatusGraphicsUnswizzlingApertureUnavailable                NTStatus = 0xC01E0107
+	StatusGraphicsUnswizzlingApertureUnsupported                NTStatus = 0xC01E0108
+	StatusGraphicsCantEvictPinnedAllocation                     NTStatus = 0xC01E0109
+	StatusGraphicsInvalidAllocationUsage                        NTStatus = 0xC01E0110
+	StatusGraphicsCantRenderLockedAllocation                    NTStatus = 0xC01E0111
+	StatusGraphicsAllocationClosed                              NTStatus = 0xC01E0112
+	StatusGraphicsInvalidAllocationInstance                     NTStatus = 0xC01E0113
+	StatusGraphicsInvalidAllocationHandle                       NTStatus = 0xC01E0114
+	StatusGraphicsWrongAllocationDevice                         NTStatus = 0xC01E0115
+	StatusGraphicsAllocationContentLost                         NTStatus = 0xC01E0116
+	StatusGraphicsGpuExceptionOnDevice                          NTStatus = 0xC01E0200
+	StatusGraphicsInvalidVidpnTopology                          NTStatus = 0xC01E0300
+	StatusGraphicsVidpnTopologyNotSupported                     NTStatus = 0xC01E0301
+	StatusGraphicsVidpnTopologyCurrentlyNotSupported            NTStatus = 0xC01E0302
+	StatusGraphicsInvalidVidpn                                  NTStatus = 0xC01E0303
+	StatusGraphicsInvalidVideoPresentSource                     NTStatus = 0xC01E0304
+	StatusGraphicsInvalidVideoPresentTarget                     NTStatus = 0xC01E0305
+	StatusGraphicsVidpnModalityNotSupported                     NTStatus = 0xC01E0306
+	StatusGraphicsInvalidVidpnSourcemodeset                     NTStatus = 0xC01E0308
+	StatusGraphicsInvalidVidpnTargetmodeset                     NTStatus = 0xC01E0309
+	StatusGraphicsInvalidFrequency                              NTStatus = 0xC01E030A
+	StatusGraphicsInvalidActiveRegion                           NTStatus = 0xC01E030B
+	StatusGraphicsInvalidTotalRegion                            NTStatus = 0xC01E030C
+	StatusGraphicsInvalidVideoPresentSourceMode                 NTStatus = 0xC01E0310
+	StatusGraphicsInvalidVideoPresentTargetMode                 NTStatus = 0xC01E0311
+	StatusGraphicsPinnedModeMustRemainInSet                     NTStatus = 0xC01E0312
+	StatusGraphicsPathAlreadyInTopology                         NTStatus = 0xC01E0313
+	StatusGraphicsModeAlreadyInModeset                          NTStatus = 0xC01E0314
+	StatusGraphicsInvalidVideopresentsourceset                  NTStatus = 0xC01E0315
+	StatusGraphicsInvalidVideopresenttargetset                  NTStatus = 0xC01E0316
+	StatusGraphicsSourceAlreadyInSet                            NTStatus = 0xC01E0317
+	StatusGraphicsTargetAlreadyInSet                            NTStatus = 0xC01E0318
+	StatusGraphicsInvalidVidpnPresentPath                       NTStatus = 0xC01E0319
+	StatusGraphicsNoRecommendedVidpnTopology                    NTStatus = 0xC01E031A
+	StatusGraphicsInvalidMonitorFrequencyrangeset               NTStatus = 0xC01E031B
+	StatusGraphicsInvalidMonitorFrequencyrange                  NTStatus = 0xC01E031C
+	StatusGraphicsFrequencyrangeNotInSet                        NTStatus = 0xC01E031D
+	StatusGraphicsFrequencyrangeAlreadyInSet                    NTStatus = 0xC01E031F
+	StatusGraphicsStaleModeset                                  NTStatus = 0xC01E0320
+	StatusGraphicsInvalidMonitorSourcemodeset                   NTStatus = 0xC01E0321
+	StatusGraphicsInvalidMonitorSourceMode                      NTStatus = 0xC01E0322
+	StatusGraphicsNoRecommendedFunctionalVidpn                  NTStatus = 0xC01E0323
+	StatusGraphicsModeIdMustBeUnique                            NTStatus = 0xC01E0324
+	StatusGraphicsEmptyAdapterMonitorModeSupportIntersection    NTStatus = 0xC01E0325
+	StatusGraphicsVideoPresentTargetsLessThanSources            NTStatus = 0xC01E0326
+	StatusGraphicsPathNotInTopology                             NTStatus = 0xC01E0327
+	StatusGraphicsAdapterMustHaveAtLeastOneSource               NTStatus = 0xC01E0328
+	StatusGraphicsAdapterMustHaveAtLeastOneTarget               NTStatus = 0xC01E0329
+	StatusGraphicsInvalidMonitordescriptorset                   NTStatus = 0xC01E032A
+	StatusGraphicsInvalidMonitordescriptor                      NTStatus = 0xC01E032B
+	StatusGraphicsMonitordescriptorNotInSet                     NTStatus = 0xC01E032C
+	StatusGraphicsMonitordescriptorAlreadyInSet                 NTStatus = 0xC01E032D
+	StatusGraphicsMonitordescriptorIdMustBeUnique               NTStatus = 0xC01E032E
+	StatusGraphicsInvalidVidpnTargetSubsetType                  NTStatus = 0xC01E032F
+	StatusGraphicsResourcesNotRelated                           NTStatus = 0xC01E0330
+	StatusGraphicsSourceIdMustBeUnique                          NTStatus = 0xC01E0331
+	StatusGraphicsTargetIdMustBeUnique                          NTStatus = 0xC01E0332
+	StatusGraphicsNoAvailableVidpnTarget                        NTStatus = 0xC01E0333
+	StatusGraphicsMonitorCouldNotBeAssociatedWithAdapter        NTStatus = 0xC01E0334
+	StatusGraphicsNoVidpnmgr                                    NTStatus = 0xC01E0335
+	StatusGraphicsNoActiveVidpn                                 NTStatus = 0xC01E0336
+	StatusGraphicsStaleVidpnTopology                            NTStatus = 0xC01E0337
+	StatusGraphicsMonitorNotConnected                           NTStatus = 0xC01E0338
+	StatusGraphicsSourceNotInTopology                           NTStatus = 0xC01E0339
+	StatusGraphicsInvalidPrimarysurfaceSize                     NTStatus = 0xC01E033A
+	StatusGraphicsInvalidVisibleregionSize                      NTStatus = 0xC01E033B
+	StatusGraphicsInvalidStride                                 NTStatus = 0xC01E033C
+	StatusGraphicsInvalidPixelformat                            NTStatus = 0xC01E033D
+	StatusGraphicsInvalidColorbasis                             NTStatus = 0xC01E033E
+	StatusGraphicsInvalidPixelvalueaccessmode                   NTStatus = 0xC01E033F
+	StatusGraphicsTargetNotInTopology                           NTStatus = 0xC01E0340
+	StatusGraphicsNoDisplayModeManagementSupport                NTStatus = 0xC01E0341
+	StatusGraphicsVidpnSourceInUse                              NTStatus = 0xC01E0342
+	StatusGraphicsCantAccessActiveVidpn                         NTStatus = 0xC01E0343
+	StatusGraphicsInvalidPathImportanceOrdinal                  NTStatus = 0xC01E0344
+	StatusGraphicsInvalidPathContentGeometryTransformation      NTStatus = 0xC01E0345
+	StatusGraphicsPathContentGeometryTransformationNotSupported NTStatus = 0xC01E0346
+	StatusGraphicsInvalidGammaRamp                              NTStatus = 0xC01E0347
+	StatusGraphicsGammaRampNotSupported                         NTStatus = 0xC01E0348
+	StatusGraphicsMultisamplingNotSupported                     NTStatus = 0xC01E0349
+	StatusGraphicsModeNotInModeset                              NTStatus = 0xC01E034A
+	StatusGraphicsInvalidVidpnTopologyRecommendationReason      NTStatus = 0xC01E034D
+	StatusGraphicsInvalidPathContentType                        NTStatus = 0xC01E034E
+	StatusGraphicsInvalidCopyprotectionType                     NTStatus = 0xC01E034F
+	StatusGraphicsUnassignedModesetAlreadyExists                NTStatus = 0xC01E0350
+	StatusGraphicsInvalidScanlineOrdering                       NTStatus = 0xC01E0352
+	StatusGraphicsTopologyChangesNotAllowed                     NTStatus = 0xC01E0353
+	StatusGraphicsNoAvailableImportanceOrdinals                 NTStatus = 0xC01E0354
+	StatusGraphicsIncompatiblePrivateFormat                     NTStatus = 0xC01E0355
+	StatusGraphicsInvalidModePruningAlgorithm                   NTStatus = 0xC01E0356
+	StatusGraphicsInvalidMonitorCapabilityOrigin                NTStatus = 0xC01E0357
+	StatusGraphicsInvalidMonitorFrequencyrangeConstraint        NTStatus = 0xC01E0358
+	StatusGraphicsMaxNumPathsReached                            NTStatus = 0xC01E0359
+	StatusGraphicsCancelVidpnTopologyAugmentation               NTStatus = 0xC01E035A
+	StatusGraphicsInvalidClientType                             NTStatus = 0xC01E035B
+	StatusGraphicsClientvidpnNotSet                             NTStatus = 0xC01E035C
+	StatusGraphicsSpecifiedChildAlreadyConnected                NTStatus = 0xC01E0400
+	StatusGraphicsChildDescriptorNotSupported                   NTStatus = 0xC01E0401
+	StatusGraphicsNotALinkedAdapter                             NTStatus = 0xC01E0430
+	StatusGraphicsLeadlinkNotEnumerated                         NTStatus = 0xC01E0431
+	StatusGraphicsChainlinksNotEnumerated                       NTStatus = 0xC01E0432
+	StatusGraphicsAdapterChainNotReady                          NTStatus = 0xC01E0433
+	StatusGraphicsChainlinksNotStarted                          NTStatus = 0xC01E0434
+	StatusGraphicsChainlinksNotPoweredOn                        NTStatus = 0xC01E0435
+	StatusGraphicsInconsistentDeviceLinkState                   NTStatus = 0xC01E0436
+	StatusGraphicsNotPostDeviceDriver                           NTStatus = 0xC01E0438
+	StatusGraphicsAdapterAccessNotExcluded                      NTStatus = 0xC01E043B
+	StatusGraphicsOpmNotSupported                               NTStatus = 0xC01E0500
+	StatusGraphicsCoppNotSupported                              NTStatus = 0xC01E0501
+	StatusGraphicsUabNotSupported                               NTStatus = 0xC01E0502
+	StatusGraphicsOpmInvalidEncryptedParameters                 NTStatus = 0xC01E0503
+	StatusGraphicsOpmNoProtectedOutputsExist                    NTStatus = 0xC01E0505
+	StatusGraphicsOpmInternalError                              NTStatus = 0xC01E050B
+	StatusGraphicsOpmInvalidHandle                              NTStatus = 0xC01E050C
+	StatusGraphicsPvpInvalidCertificateLength                   NTStatus = 0xC01E050E
+	StatusGraphicsOpmSpanningModeEnabled                        NTStatus = 0xC01E050F
+	StatusGraphicsOpmTheaterModeEnabled                         NTStatus = 0xC01E0510
+	StatusGraphicsPvpHfsFailed                                  NTStatus = 0xC01E0511
+	StatusGraphicsOpmInvalidSrm                                 NTStatus = 0xC01E0512
+	StatusGraphicsOpmOutputDoesNotSupportHdcp                   NTStatus = 0xC01E0513
+	StatusGraphicsOpmOutputDoesNotSupportAcp                    NTStatus = 0xC01E0514
+	StatusGraphicsOpmOutputDoesNotSupportCgmsa                  NTStatus = 0xC01E0515
+	StatusGraphicsOpmHdcpSrmNeverSet                            NTStatus = 0xC01E0516
+	StatusGraphicsOpmResolutionTooHigh                          NTStatus = 0xC01E0517
+	StatusGraphicsOpmAllHdcpHardwareAlreadyInUse                NTStatus = 0xC01E0518
+	StatusGraphicsOpmProtectedOutputNoLongerExists              NTStatus = 0xC01E051A
+	StatusGraphicsOpmProtectedOutputDoesNotHaveCoppSemantics    NTStatus = 0xC01E051C
+	StatusGraphicsOpmInvalidInformationRequest                  NTStatus = 0xC01E051D
+	StatusGraphicsOpmDriverInternalError                        NTStatus = 0xC01E051E
+	StatusGraphicsOpmProtectedOutputDoesNotHaveOpmSemantics     NTStatus = 0xC01E051F
+	StatusGraphicsOpmSignalingNotSupported                      NTStatus = 0xC01E0520
+	StatusGraphicsOpmInvalidConfigurationRequest                NTStatus = 0xC01E0521
+	StatusGraphicsI2cNotSupported                               NTStatus = 0xC01E0580
+	StatusGraphicsI2cDeviceDoesNotExist                         NTStatus = 0xC01E0581
+	StatusGraphicsI2cErrorTransmittingData                      NTStatus = 0xC01E0582
+	StatusGraphicsI2cErrorReceivingData                         NTStatus = 0xC01E0583
+	StatusGraphicsDdcciVcpNotSupported                          NTStatus = 0xC01E0584
+	StatusGraphicsDdcciInvalidData                              NTStatus = 0xC01E0585
+	StatusGraphicsDdcciMonitorReturnedInvalidTimingStatusByte   NTStatus = 0xC01E0586
+	StatusGraphicsDdcciInvalidCapabilitiesString                NTStatus = 0xC01E0587
+	StatusGraphicsMcaInternalError                              NTStatus = 0xC01E0588
+	StatusGraphicsDdcciInvalidMessageCommand                    NTStatus = 0xC01E0589
+	StatusGraphicsDdcciInvalidMessageLength                     NTStatus = 0xC01E058A
+	StatusGraphicsDdcciInvalidMessageChecksum                   NTStatus = 0xC01E058B
+	StatusGraphicsInvalidPhysicalMonitorHandle                  NTStatus = 0xC01E058C
+	StatusGraphicsMonitorNoLongerExists                         NTStatus = 0xC01E058D
+	StatusGraphicsOnlyConsoleSessionSupported                   NTStatus = 0xC01E05E0
+	StatusGraphicsNoDisplayDeviceCorrespondsToName              NTStatus = 0xC01E05E1
+	StatusGraphicsDisplayDeviceNotAttachedToDesktop             NTStatus = 0xC01E05E2
+	StatusGraphicsMirroringDevicesNotSupported                  NTStatus = 0xC01E05E3
+	StatusGraphicsInvalidPointer                                NTStatus = 0xC01E05E4
+	StatusGraphicsNoMonitorsCorrespondToDisplayDevice           NTStatus = 0xC01E05E5
+	StatusGraphicsParameterArrayTooSmall                        NTStatus = 0xC01E05E6
+	StatusGraphicsInternalError                                 NTStatus = 0xC01E05E7
+	StatusGraphicsSessionTypeChangeInProgress                   NTStatus = 0xC01E05E8
+	StatusFveLockedVolume                                       NTStatus = 0xC0210000
+	StatusFveNotEncrypted                                       NTStatus = 0xC0210001
+	StatusFveBadInformation                                     NTStatus = 0xC0210002
+	StatusFveTooSmall                                           NTStatus = 0xC0210003
+	StatusFveFailedWrongFs                                      NTStatus = 0xC0210004
+	StatusFveBadPartitionSize                                   NTStatus = 0xC0210005
+	StatusFveFsNotExtended                                      NTStatus = 0xC0210006
+	StatusFveFsMounted                                          NTStatus = 0xC0210007
+	StatusFveNoLicense                                          NTStatus = 0xC0210008
+	StatusFveActionNotAllowed                                   NTStatus = 0xC0210009
+	StatusFveBadData                                            NTStatus = 0xC021000A
+	StatusFveVolumeNotBound                                     NTStatus = 0xC021000B
+	StatusFveNotDataVolume                                      NTStatus = 0xC021000C
+	StatusFveConvReadError                                      NTStatus = 0xC021000D
+	StatusFveConvWriteError                                     NTStatus = 0xC021000E
+	StatusFveOverlappedUpdate                                   NTStatus = 0xC021000F
+	StatusFveFailedSectorSize                                   NTStatus = 0xC0210010
+	StatusFveFailedAuthentication                               NTStatus = 0xC0210011
+	StatusFveNotOsVolume                                        NTStatus = 0xC0210012
+	StatusFveKeyfileNotFound                                    NTStatus = 0xC0210013
+	StatusFveKeyfileInvalid                                     NTStatus = 0xC0210014
+	StatusFveKeyfileNoVmk                                       NTStatus = 0xC0210015
+	StatusFveTpmDisabled                                        NTStatus = 0xC0210016
+	StatusFveTpmSrkAuthNotZero                                  NTStatus = 0xC0210017
+	StatusFveTpmInvalidPcr                                      NTStatus = 0xC0210018
+	StatusFveTpmNoVmk                                           NTStatus = 0xC0210019
+	StatusFvePinInvalid                                         NTStatus = 0xC021001A
+	StatusFveAuthInvalidApplication                             NTStatus = 0xC021001B
+	StatusFveAuthInvalidConfig                                  NTStatus = 0xC021001C
+	StatusFveDebuggerEnabled                                    NTStatus = 0xC021001D
+	StatusFveDryRunFailed                                       NTStatus = 0xC021001E
+	StatusFveBadMetadataPointer                                 NTStatus = 0xC021001F
+	StatusFveOldMetadataCopy                                    NTStatus = 0xC0210020
+	StatusFveRebootRequired                                     NTStatus = 0xC0210021
+	StatusFveRawAccess                                          NTStatus = 0xC0210022
+	StatusFveRawBlocked                                         NTStatus = 0xC0210023
+	StatusFveNoAutounlockMasterKey                              NTStatus = 0xC0210024
+	StatusFveMorFailed                                          NTStatus = 0xC0210025
+	StatusFveNoFeatureLicense                                   NTStatus = 0xC0210026
+	StatusFvePolicyUserDisableRdvNotAllowed                     NTStatus = 0xC0210027
+	StatusFveConvRecoveryFailed                                 NTStatus = 0xC0210028
+	StatusFveVirtualizedSpaceTooBig                             NTStatus = 0xC0210029
+	StatusFveInvalidDatumType                                   NTStatus = 0xC021002A
+	StatusFveVolumeTooSmall                                     NTStatus = 0xC0210030
+	StatusFveEnhPinInvalid                                      NTStatus = 0xC0210031
+	StatusFveFullEncryptionNotAllowedOnTpStorage                NTStatus = 0xC0210032
+	StatusFveWipeNotAllowedOnTpStorage                          NTStatus = 0xC0210033
+	StatusFveNotAllowedOnCsvStack                               NTStatus = 0xC0210034
+	StatusFveNotAllowedOnCluster                                NTStatus = 0xC0210035
+	StatusFveNotAllowedToUpgradeWhileConverting                 NTStatus = 0xC0210036
+	StatusFveWipeCancelNotApplicable                            NTStatus = 0xC0210037
+	StatusFveEdriveDryRunFailed                                 NTStatus = 0xC0210038
+	StatusFveSecurebootDisabled                                 NTStatus = 0xC0210039
+	StatusFveSecurebootConfigChange                             NTStatus = 0xC021003A
+	StatusFveDeviceLockedout                                    NTStatus = 0xC021003B
+	StatusFveVolumeExtendPreventsEowDecrypt                     NTStatus = 0xC021003C
+	StatusFveNotDeVolume                                        NTStatus = 0xC021003D
+	StatusFveProtectionDisabled                                 NTStatus = 0xC021003E
+	StatusFveProtectionCannotBeDisabled                         NTStatus = 0xC021003F
+	StatusFveOsvKsrNotAllowed                                   NTStatus = 0xC0210040
+	StatusFwpCalloutNotFound                                    NTStatus = 0xC0220001
+	StatusFwpConditionNotFound                                  NTStatus = 0xC0220002
+	StatusFwpFilterNotFound                                     NTStatus = 0xC0220003
+	StatusFwpLayerNotFound                                      NTStatus = 0xC0220004
+	StatusFwpProviderNotFound                                   NTStatus = 0xC0220005
+	StatusFwpProviderContextNotFound                            NTStatus = 0xC0220006
+	StatusFwpSublayerNotFound                                   NTStatus = 0xC0220007
+	StatusFwpNotFound                                           NTStatus = 0xC0220008
+	StatusFwpAlreadyExists                                      NTStatus = 0xC0220009
+	StatusFwpInUse                                              NTStatus = 0xC022000A
+	StatusFwpDynamicSessionInProgress                           NTStatus = 0xC022000B
+	StatusFwpWrongSession                                       NTStatus = 0xC022000C
+	StatusFwpNoTxnInProgress                                    NTStatus = 0xC022000D
+	StatusFwpTxnInProgress                                      NTStatus = 0xC022000E
+	StatusFwpTxnAborted                                         NTStatus = 0xC022000F
+	StatusFwpSessionAborted                                     NTStatus = 0xC0220010
+	StatusFwpIncompatibleTxn                                    NTStatus = 0xC0220011
+	StatusFwpTimeout                                            NTStatus = 0xC0220012
+	StatusFwpNetEventsDisabled                                  NTStatus = 0xC0220013
+	StatusFwpIncompatibleLayer                                  NTStatus = 0xC0220014
+	StatusFwpKmClientsOnly                                      NTStatus = 0xC0220015
+	StatusFwpLifetimeMismatch                                   NTStatus = 0xC0220016
+	StatusFwpBuiltinObject                                      NTStatus = 0xC0220017
+	StatusFwpTooManyCallouts                                    NTStatus = 0xC0220018
+	StatusFwpNotificationDropped                                NTStatus = 0xC0220019
+	StatusFwpTrafficMismatch                                    NTStatus = 0xC022001A
+	StatusFwpIncompatibleSaState                                NTStatus = 0xC022001B
+	StatusFwpNullPointer                                        NTStatus = 0xC022001C
+	StatusFwpInvalidEnumerator                                  NTStatus = 0xC022001D
+	StatusFwpInvalidFlags                                       NTStatus = 0xC022001E
+	StatusFwpInvalidNetMask                                     NTStatus = 0xC022001F
+	StatusFwpInvalidRange                                       NTStatus = 0xC0220020
+	StatusFwpInvalidInterval                                    NTStatus = 0xC0220021
+	StatusFwpZeroLengthArray                                    NTStatus = 0xC0220022
+	StatusFwpNullDisplayName                                    NTStatus = 0xC0220023
+	StatusFwpInvalidActionType                                  NTStatus = 0xC0220024
+	StatusFwpInvalidWeight                                      NTStatus = 0xC0220025
+	StatusFwpMatchTypeMismatch                                  NTStatus = 0xC0220026
+	StatusFwpTypeMismatch                                       NTStatus = 0xC0220027
+	StatusFwpOutOfBounds                                        NTStatus = 0xC0220028
+	StatusFwpReserved                                           NTStatus = 0xC0220029
+	StatusFwpDuplicateCondition                                 NTStatus = 0xC022002A
+	StatusFwpDuplicateKeymod                                    NTStatus = 0xC022002B
+	StatusFwpActionIncompatibleWithLayer                        NTStatus = 0xC022002C
+	StatusFwpActionIncompatibleWithSublayer                     NTStatus = 0xC022002D
+	StatusFwpContextIncompatibleWithLayer                       NTStatus = 0xC022002E
+	StatusFwpContextIncompatibleWithCallout                     NTStatus = 0xC022002F
+	StatusFwpIncompatibleAuthMethod                             NTStatus = 0xC0220030
+	StatusFwpIncompatibleDhGroup                                NTStatus = 0xC0220031
+	StatusFwpEmNotSupported                                     NTStatus = 0xC0220032
+	StatusFwpNeverMatch                                         NTStatus = 0xC0220033
+	StatusFwpProviderContextMismatch                            NTStatus = 0xC0220034
+	StatusFwpInvalidParameter                                   NTStatus = 0xC0220035
+	StatusFwpTooManySublayers                                   NTStatus = 0xC0220036
+	StatusFwpCalloutNotificationFailed                          NTStatus = 0xC0220037
+	StatusFwpInvalidAuthTransform                               NTStatus = 0xC0220038
+	StatusFwpInvalidCipherTransform                             NTStatus = 0xC0220039
+	StatusFwpIncompatibleCipherTransform                        NTStatus = 0xC022003A
+	StatusFwpInvalidTransformCombination                        NTStatus = 0xC022003B
+	StatusFwpDuplicateAuthMethod                                NTStatus = 0xC022003C
+	StatusFwpInvalidTunnelEndpoint                              NTStatus = 0xC022003D
+	StatusFwpL2DriverNotReady                                   NTStatus = 0xC022003E
+	StatusFwpKeyDictatorAlreadyRegistered                       NTStatus = 0xC022003F
+	StatusFwpKeyDictationInvalidKeyingMaterial                  NTStatus = 0xC0220040
+	StatusFwpConnectionsDisabled                                NTStatus = 0xC0220041
+	StatusFwpInvalidDnsName                                     NTStatus = 0xC0220042
+	StatusFwpStillOn                                            NTStatus = 0xC0220043
+	StatusFwpIkeextNotRunning                                   NTStatus = 0xC0220044
+	StatusFwpTcpipNotReady                                      NTStatus = 0xC0220100
+	StatusFwpInjectHandleClosing                                NTStatus = 0xC0220101
+	StatusFwpInjectHandleStale                                  NTStatus = 0xC0220102
+	StatusFwpCannotPend                                         NTStatus = 0xC0220103
+	StatusFwpDropNoicmp                                         NTStatus = 0xC0220104
+	StatusNdisClosing                                           NTStatus = 0xC0230002
+	StatusNdisBadVersion                                        NTStatus = 0xC0230004
+	StatusNdisBadCharacteristics                                NTStatus = 0xC0230005
+	StatusNdisAdapterNotFound                                   NTStatus = 0xC0230006
+	StatusNdisOpenFailed                                        NTStatus = 0xC0230007
+	StatusNdisDeviceFailed                                      NTStatus = 0xC0230008
+	StatusNdisMulticastFull                                     NTStatus = 0xC0230009
+	StatusNdisMulticastExists                                   NTStatus = 0xC023000A
+	StatusNdisMulticastNotFound                                 NTStatus = 0xC023000B
+	StatusNdisRequestAborted                                    NTStatus = 0xC023000C
+	StatusNdisResetInProgress                                   NTStatus = 0xC023000D
+	StatusNdisInvalidPacket                                     NTStatus = 0xC023000F
+	StatusNdisInvalidDeviceRequest                              NTStatus = 0xC0230010
+	StatusNdisAdapterNotReady                                   NTStatus = 0xC0230011
+	StatusNdisInvalidLength                                     NTStatus = 0xC0230014
+	StatusNdisInvalidData                                       NTStatus = 0xC0230015
+	StatusNdisBufferTooShort                                    NTStatus = 0xC0230016
+	StatusNdisInvalidOid                                        NTStatus = 0xC0230017
+	StatusNdisAdapterRemoved                                    NTStatus = 0xC0230018
+	StatusNdisUnsupportedMedia                                  NTStatus = 0xC0230019
+	StatusNdisGroupAddressInUse                                 NTStatus = 0xC023001A
+	StatusNdisFileNotFound                                      NTStatus = 0xC023001B
+	StatusNdisErrorReadingFile                                  NTStatus = 0xC023001C
+	StatusNdisAlreadyMapped                                     NTStatus = 0xC023001D
+	StatusNdisResourceConflict                                  NTStatus = 0xC023001E
+	StatusNdisMediaDisconnected                                 NTStatus = 0xC023001F
+	StatusNdisInvalidAddress                                    NTStatus = 0xC0230022
+	StatusNdisPaused                                            NTStatus = 0xC023002A
+	StatusNdisInterfaceNotFound                                 NTStatus = 0xC023002B
+	StatusNdisUnsupportedRevision                               NTStatus = 0xC023002C
+	StatusNdisInvalidPort                                       NTStatus = 0xC023002D
+	StatusNdisInvalidPortState                                  NTStatus = 0xC023002E
+	StatusNdisLowPowerState                                     NTStatus = 0xC023002F
+	StatusNdisReinitRequired                                    NTStatus = 0xC0230030
+	StatusNdisNoQueues                                          NTStatus = 0xC0230031
+	StatusNdisNotSupported                                      NTStatus = 0xC02300BB
+	StatusNdisOffloadPolicy                                     NTStatus = 0xC023100F
+	StatusNdisOffloadConnectionRejected                         NTStatus = 0xC0231012
+	StatusNdisOffloadPathRejected                               NTStatus = 0xC0231013
+	StatusNdisDot11AutoConfigEnabled                            NTStatus = 0xC0232000
+	StatusNdisDot11MediaInUse                                   NTStatus = 0xC0232001
+	StatusNdisDot11PowerStateInvalid                            NTStatus = 0xC0232002
+	StatusNdisPmWolPatternListFull                              NTStatus = 0xC0232003
+	StatusNdisPmProtocolOffloadListFull                         NTStatus = 0xC0232004
+	StatusNdisDot11ApChannelCurrentlyNotAvailable               NTStatus = 0xC0232005
+	StatusNdisDot11ApBandCurrentlyNotAvailable                  NTStatus = 0xC0232006
+	StatusNdisDot11ApChannelNotAllowed                          NTStatus = 0xC0232007
+	StatusNdisDot11ApBandNotAllowed                             NTStatus = 0xC0232008
+	StatusTpmErrorMask                                          NTStatus = 0xC0290000
+	StatusTpmAuthfail                                           NTStatus = 0xC0290001
+	StatusTpmBadindex                                           NTStatus = 0xC0290002
+	StatusTpmBadParameter                                       NTStatus = 0xC0290003
+	StatusTpmAuditfailure                                       NTStatus = 0xC0290004
+	StatusTpmClearDisabled                                      NTStatus = 0xC0290005
+	StatusTpmDeactivated                                        NTStatus = 0xC0290006
+	StatusTpmDisabled                                           NTStatus = 0xC0290007
+	StatusTpmDisabledCmd                                        NTStatus = 0xC0290008
+	StatusTpmFail                                               NTStatus = 0xC0290009
+	StatusTpmBadOrdinal                                         NTStatus = 0xC029000A
+	StatusTpmInstallDisabled                                    NTStatus = 0xC029000B
+	StatusTpmInvalidKeyhandle                                   NTStatus = 0xC029000C
+	StatusTpmKeynotfound                                        NTStatus = 0xC029000D
+	StatusTpmInappropriateEnc                                   NTStatus = 0xC029000E
+	StatusTpmMigratefail                                        NTStatus = 0xC029000F
+	StatusTpmInvalidPcrInfo                                     NTStatus = 0xC0290010
+	StatusTpmNospace                                            NTStatus = 0xC0290011
+	StatusTpmNosrk                                              NTStatus = 0xC0290012
+	StatusTpmNotsealedBlob                                      NTStatus = 0xC0290013
+	StatusTpmOwnerSet                                           NTStatus = 0xC0290014
+	StatusTpmResources                                          NTStatus = 0xC0290015
+	StatusTpmShortrandom                                        NTStatus = 0xC0290016
+	StatusTpmSize                                               NTStatus = 0xC0290017
+	StatusTpmWrongpcrval                                        NTStatus = 0xC0290018
+	StatusTpmBadParamSize                                       NTStatus = 0xC0290019
+	StatusTpmShaThread                                          NTStatus = 0xC029001A
+	StatusTpmShaError                                           NTStatus = 0xC029001B
+	StatusTpmFailedselftest                                     NTStatus = 0xC029001C
+	StatusTpmAuth2fail                                          NTStatus = 0xC029001D
+	StatusTpmBadtag                                             NTStatus = 0xC029001E
+	StatusTpmIoerror                                            NTStatus = 0xC029001F
+	StatusTpmEncryptError                                       NTStatus = 0xC0290020
+	StatusTpmDecryptError                                       NTStatus = 0xC0290021
+	StatusTpmInvalidAuthhandle                                  NTStatus = 0xC0290022
+	StatusTpmNoEndorsement                                      NTStatus = 0xC0290023
+	StatusTpmInvalidKeyusage                                    NTStatus = 0xC0290024
+	StatusTpmWrongEntitytype                                    NTStatus = 0xC0290025
+	StatusTpmInvalidPostinit                                    NTStatus = 0xC0290026
+	StatusTpmInappropriateSig                                   NTStatus = 0xC0290027
+	StatusTpmBadKeyProperty                                     NTStatus = 0xC0290028
+	StatusTpmBadMigration                                       NTStatus = 0xC0290029
+	StatusTpmBadScheme                                          NTStatus = 0xC029002A
+	StatusTpmBadDatasize                                        NTStatus = 0xC029002B
+	StatusTpmBadMode                                            NTStatus = 0xC029002C
+	StatusTpmBadPresence                                        NTStatus = 0xC029002D
+	StatusTpmBadVersion                                         NTStatus = 0xC029002E
+	StatusTpmNoWrapTransport                                    NTStatus = 0xC029002F
+	StatusTpmAuditfailUnsuccessful                              NTStatus = 0xC0290030
+	StatusTpmAuditfailSuccessful                                NTStatus = 0xC0290031
+	StatusTpmNotresetable                                       NTStatus = 0xC0290032
+	StatusTpmNotlocal                                           NTStatus = 0xC0290033
+	StatusTpmBadType                                            NTStatus = 0xC0290034
+	StatusTpmInvalidResource                                    NTStatus = 0xC0290035
+	StatusTpmNotfips                                            NTStatus = 0xC0290036
+	StatusTpmInvalidFamily                                      NTStatus = 0xC0290037
+	StatusTpmNoNvPermission                                     NTStatus = 0xC0290038
+	StatusTpmRequiresSign                                       NTStatus = 0xC0290039
+	StatusTpmKeyNotsupported                                    NTStatus = 0xC029003A
+	StatusTpmAuthConflict                                       NTStatus = 0xC029003B
+	StatusTpmAreaLocked                                         NTStatus = 0xC029003C
+	StatusTpmBadLocality                                        NTStatus = 0xC029003D
+	StatusTpmReadOnly                                           NTStatus = 0xC029003E
+	StatusTpmPerNowrite                                         NTStatus = 0xC029003F
+	StatusTpmFamilycount                                        NTStatus = 0xC0290040
+	StatusTpmWriteLocked                                        NTStatus = 0xC0290041
+	StatusTpmBadAttributes                                      NTStatus = 0xC0290042
+	StatusTpmInvalidStructure                                   NTStatus = 0xC0290043
+	StatusTpmKeyOwnerControl                                    NTStatus = 0xC0290044
+	StatusTpmBadCounter                                         NTStatus = 0xC0290045
+	StatusTpmNotFullwrite                                       NTStatus = 0xC0290046
+	StatusTpmContextGap                                         NTStatus = 0xC0290047
+	StatusTpmMaxnvwrites                                        NTStatus = 0xC0290048
+	StatusTpmNooperator                                         NTStatus = 0xC0290049
+	StatusTpmResourcemissing                                    NTStatus = 0xC029004A
+	StatusTpmDelegateLock                                       NTStatus = 0xC029004B
+	StatusTpmDelegateFamily                                     NTStatus = 0xC029004C
+	StatusTpmDelegateAdmin                                      NTStatus = 0xC029004D
+	StatusTpmTransportNotexclusive                              NTStatus = 0xC029004E
+	StatusTpmOwnerControl                                       NTStatus = 0xC029004F
+	StatusTpmDaaResources                                       NTStatus = 0xC0290050
+	StatusTpmDaaInputData0                                      NTStatus = 0xC0290051
+	StatusTpmDaaInputData1                                      NTStatus = 0xC0290052
+	StatusTpmDaaIssuerSettings                                  NTStatus = 0xC0290053
+	StatusTpmDaaTpmSettings                                     NTStatus = 0xC0290054
+	StatusTpmDaaStage                                           NTStatus = 0xC0290055
+	StatusTpmDaaIssuerValidity                                  NTStatus = 0xC0290056
+	StatusTpmDaaWrongW                                          NTStatus = 0xC0290057
+	StatusTpmBadHandle                                          NTStatus = 0xC0290058
+	StatusTpmBadDelegate                                        NTStatus = 0xC0290059
+	StatusTpmBadcontext                                         NTStatus = 0xC029005A
+	StatusTpmToomanycontexts                                    NTStatus = 0xC029005B
+	StatusTpmMaTicketSignature                                  NTStatus = 0xC029005C
+	StatusTpmMaDestination                                      NTStatus = 0xC029005D
+	StatusTpmMaSource                                           NTStatus = 0xC029005E
+	StatusTpmMaAuthority                                        NTStatus = 0xC029005F
+	StatusTpmPermanentek                                        NTStatus = 0xC0290061
+	StatusTpmBadSignature                                       NTStatus = 0xC0290062
+	StatusTpmNocontextspace                                     NTStatus = 0xC0290063
+	StatusTpm20EAsymmetric                                      NTStatus = 0xC0290081
+	StatusTpm20EAttributes                                      NTStatus = 0xC0290082
+	StatusTpm20EHash                                            NTStatus = 0xC0290083
+	StatusTpm20EValue                                           NTStatus = 0xC0290084
+	StatusTpm20EHierarchy                                       NTStatus = 0xC0290085
+	StatusTpm20EKeySize                                         NTStatus = 0xC0290087
+	StatusTpm20EMgf                                             NTStatus = 0xC0290088
+	StatusTpm20EMode                                            NTStatus = 0xC0290089
+	StatusTpm20EType                                            NTStatus = 0xC029008A
+	StatusTpm20EHandle                                          NTStatus = 0xC029008B
+	StatusTpm20EKdf                                             NTStatus = 0xC029008C
+	StatusTpm20ERange                                           NTStatus = 0xC029008D
+	StatusTpm20EAuthFail                                        NTStatus = 0xC029008E
+	StatusTpm20ENonce                                           NTStatus = 0xC029008F
+	StatusTpm20EPp                                              NTStatus = 0xC0290090
+	StatusTpm20EScheme                                          NTStatus = 0xC0290092
+	StatusTpm20ESize                                            NTStatus = 0xC0290095
+	StatusTpm20ESymmetric                                       NTStatus = 0xC0290096
+	StatusTpm20ETag                                             NTStatus = 0xC0290097
+	StatusTpm20ESelector                                        NTStatus = 0xC0290098
+	StatusTpm20EInsufficient                                    NTStatus = 0xC029009A
+	StatusTpm20ESignature                                       NTStatus = 0xC029009B
+	StatusTpm20EKey                                             NTStatus = 0xC029009C
+	StatusTpm20EPolicyFail                                      NTStatus = 0xC029009D
+	StatusTpm20EIntegrity                                       NTStatus = 0xC029009F
+	StatusTpm20ETicket                                          NTStatus = 0xC02900A0
+	StatusTpm20EReservedBits                                    NTStatus = 0xC02900A1
+	StatusTpm20EBadAuth                                         NTStatus = 0xC02900A2
+	StatusTpm20EExpired                                         NTStatus = 0xC02900A3
+	StatusTpm20EPolicyCc                                        NTStatus = 0xC02900A4
+	StatusTpm20EBinding                                         NTStatus = 0xC02900A5
+	StatusTpm20ECurve                                           NTStatus = 0xC02900A6
+	StatusTpm20EEccPoint                                        NTStatus = 0xC02900A7
+	StatusTpm20EInitialize                                      NTStatus = 0xC0290100
+	StatusTpm20EFailure                                         NTStatus = 0xC0290101
+	StatusTpm20ESequence                                        NTStatus = 0xC0290103
+	StatusTpm20EPrivate                                         NTStatus = 0xC029010B
+	StatusTpm20EHmac                                            NTStatus = 0xC0290119
+	StatusTpm20EDisabled                                        NTStatus = 0xC0290120
+	StatusTpm20EExclusive                                       NTStatus = 0xC0290121
+	StatusTpm20EEccCurve                                        NTStatus = 0xC0290123
+	StatusTpm20EAuthType                                        NTStatus = 0xC0290124
+	StatusTpm20EAuthMissing                                     NTStatus = 0xC0290125
+	StatusTpm20EPolicy                                          NTStatus = 0xC0290126
+	StatusTpm20EPcr                                             NTStatus = 0xC0290127
+	StatusTpm20EPcrChanged                                      NTStatus = 0xC0290128
+	StatusTpm20EUpgrade                                         NTStatus = 0xC029012D
+	StatusTpm20ETooManyContexts                                 NTStatus = 0xC029012E
+	StatusTpm20EAuthUnavailable                                 NTStatus = 0xC029012F
+	StatusTpm20EReboot                                          NTStatus = 0xC0290130
+	StatusTpm20EUnbalanced                                      NTStatus = 0xC0290131
+	StatusTpm20ECommandSize                                     NTStatus = 0xC0290142
+	StatusTpm20ECommandCode                                     NTStatus = 0xC0290143
+	StatusTpm20EAuthsize                                        NTStatus = 0xC0290144
+	StatusTpm20EAuthContext                                     NTStatus = 0xC0290145
+	StatusTpm20ENvRange                                         NTStatus = 0xC0290146
+	StatusTpm20ENvSize                                          NTStatus = 0xC0290147
+	StatusTpm20ENvLocked                                        NTStatus = 0xC0290148
+	StatusTpm20ENvAuthorization                                 NTStatus = 0xC0290149
+	StatusTpm20ENvUninitialized                                 NTStatus = 0xC029014A
+	StatusTpm20ENvSpace                                         NTStatus = 0xC029014B
+	StatusTpm20ENvDefined                                       NTStatus = 0xC029014C
+	StatusTpm20EBadContext                                      NTStatus = 0xC0290150
+	StatusTpm20ECphash                                          NTStatus = 0xC0290151
+	StatusTpm20EParent                                          NTStatus = 0xC0290152
+	StatusTpm20ENeedsTest                                       NTStatus = 0xC0290153
+	StatusTpm20ENoResult                                        NTStatus = 0xC0290154
+	StatusTpm20ESensitive                                       NTStatus = 0xC0290155
+	StatusTpmCommandBlocked                                     NTStatus = 0xC0290400
+	StatusTpmInvalidHandle                                      NTStatus = 0xC0290401
+	StatusTpmDuplicateVhandle                                   NTStatus = 0xC0290402
+	StatusTpmEmbeddedCommandBlocked                             NTStatus = 0xC0290403
+	StatusTpmEmbeddedCommandUnsupported                         NTStatus = 0xC0290404
+	StatusTpmRetry                                              NTStatus = 0xC0290800
+	StatusTpmNeedsSelftest                                      NTStatus = 0xC0290801
+	StatusTpmDoingSelftest                                      NTStatus = 0xC0290802
+	StatusTpmDefendLockRunning                                  NTStatus = 0xC0290803
+	StatusTpmCommandCanceled                                    NTStatus = 0xC0291001
+	StatusTpmTooManyContexts                                    NTStatus = 0xC0291002
+	StatusTpmNotFound                                           NTStatus = 0xC0291003
+	StatusTpmAccessDenied                                       NTStatus = 0xC0291004
+	StatusTpmInsufficientBuffer                                 NTStatus = 0xC0291005
+	StatusTpmPpiFunctionUnsupported                             NTStatus = 0xC0291006
+	StatusPcpErrorMask                                          NTStatus = 0xC0292000
+	StatusPcpDeviceNotReady                                     NTStatus = 0xC0292001
+	StatusPcpInvalidHandle                                      NTStatus = 0xC0292002
+	StatusPcpInvalidParameter                                   NTStatus = 0xC0292003
+	StatusPcpFlagNotSupported                                   NTStatus = 0xC0292004
+	StatusPcpNotSupported                                       NTStatus = 0xC0292005
+	StatusPcpBufferTooSmall                                     NTStatus = 0xC0292006
+	StatusPcpInternalError                                      NTStatus = 0xC0292007
+	StatusPcpAuthenticationFailed                               NTStatus = 0xC0292008
+	StatusPcpAuthenticationIgnored                              NTStatus = 0xC0292009
+	StatusPcpPolicyNotFound                                     NTStatus = 0xC029200A
+	StatusPcpProfileNotFound                                    NTStatus = 0xC029200B
+	StatusPcpValidationFailed                                   NTStatus = 0xC029200C
+	StatusPcpDeviceNotFound                                     NTStatus = 0xC029200D
+	StatusPcpWrongParent                                        NTStatus = 0xC029200E
+	StatusPcpKeyNotLoaded                                       NTStatus = 0xC029200F
+	StatusPcpNoKeyCertification                                 NTStatus = 0xC0292010
+	StatusPcpKeyNotFinalized                                    NTStatus = 0xC0292011
+	StatusPcpAttestationChallengeNotSet                         NTStatus = 0xC0292012
+	StatusPcpNotPcrBound                                        NTStatus = 0xC0292013
+	StatusPcpKeyAlreadyFinalized                                NTStatus = 0xC0292014
+	StatusPcpKeyUsagePolicyNotSupported                         NTStatus = 0xC0292015
+	StatusPcpKeyUsagePolicyInvalid                              NTStatus = 0xC0292016
+	StatusPcpSoftKeyError                                       NTStatus = 0xC0292017
+	StatusPcpKeyNotAuthenticated                                NTStatus = 0xC0292018
+	StatusPcpKeyNotAik                                          NTStatus = 0xC0292019
+	StatusPcpKeyNotSigningKey                                   NTStatus = 0xC029201A
+	StatusPcpLockedOut                                          NTStatus = 0xC029201B
+	StatusPcpClaimTypeNotSupported                              NTStatus = 0xC029201C
+	StatusPcpTpmVersionNotSupported                             NTStatus = 0xC029201D
+	StatusPcpBufferLengthMismatch                               NTStatus = 0xC029201E
+	StatusPcpIfxRsaKeyCreationBlocked                           NTStatus = 0xC029201F
+	StatusPcpTicketMissing                                      NTStatus = 0xC0292020
+	StatusPcpRawPolicyNotSupported                              NTStatus = 0xC0292021
+	StatusPcpKeyHandleInvalidated                               NTStatus = 0xC0292022
+	StatusRtpmNoResult                                          NTStatus = 0xC0293002
+	StatusRtpmPcrReadIncomplete                                 NTStatus = 0xC0293003
+	StatusRtpmInvalidContext                                    NTStatus = 0xC0293004
+	StatusRtpmUnsupportedCmd                                    NTStatus = 0xC0293005
+	StatusTpmZeroExhaustEnabled                                 NTStatus = 0xC0294000
+	StatusHvInvalidHypercallCode                                NTStatus = 0xC0350002
+	StatusHvInvalidHypercallInput                               NTStatus = 0xC0350003
+	StatusHvInvalidAlignment                                    NTStatus = 0xC0350004
+	StatusHvInvalidParameter                                    NTStatus = 0xC0350005
+	StatusHvAccessDenied                                        NTStatus = 0xC0350006
+	StatusHvInvalidPartitionState                               NTStatus = 0xC0350007
+	StatusHvOperationDenied                                     NTStatus = 0xC0350008
+	StatusHvUnknownProperty                                     NTStatus = 0xC0350009
+	StatusHvPropertyValueOutOfRange                             NTStatus = 0xC035000A
+	StatusHvInsufficientMemory                                  NTStatus = 0xC035000B
+	StatusHvPartitionTooDeep                                    NTStatus = 0xC035000C
+	StatusHvInvalidPartitionId                                  NTStatus = 0xC035000D
+	StatusHvInvalidVpIndex                                      NTStatus = 0xC035000E
+	StatusHvInvalidPortId                                       NTStatus = 0xC0350011
+	StatusHvInvalidConnectionId                                 NTStatus = 0xC0350012
+	StatusHvInsufficientBuffers                                 NTStatus = 0xC0350013
+	StatusHvNotAcknowledged                                     NTStatus = 0xC0350014
+	StatusHvInvalidVpState                                      NTStatus = 0xC0350015
+	StatusHvAcknowledged                                        NTStatus = 0xC0350016
+	StatusHvInvalidSaveRestoreState                             NTStatus = 0xC0350017
+	StatusHvInvalidSynicState                                   NTStatus = 0xC0350018
+	StatusHvObjectInUse                                         NTStatus = 0xC0350019
+	StatusHvInvalidProximityDomainInfo                          NTStatus = 0xC035001A
+	StatusHvNoData                                              NTStatus = 0xC035001B
+	StatusHvInactive                                            NTStatus = 0xC035001C
+	StatusHvNoResources                                         NTStatus = 0xC035001D
+	StatusHvFeatureUnavailable                                  NTStatus = 0xC035001E
+	StatusHvInsufficientBuffer                                  NTStatus = 0xC0350033
+	StatusHvInsufficientDeviceDomains                           NTStatus = 0xC0350038
+	StatusHvCpuidFeatureValidationError                         NTStatus = 0xC035003C
+	StatusHvCpuidXsaveFeatureValidationError                    NTStatus = 0xC035003D
+	StatusHvProcessorStartupTimeout                             NTStatus = 0xC035003E
+	StatusHvSmxEnabled                                          NTStatus = 0xC035003F
+	StatusHvInvalidLpIndex                                      NTStatus = 0xC0350041
+	StatusHvInvalidRegisterValue                                NTStatus = 0xC0350050
+	StatusHvInvalidVtlState                                     NTStatus = 0xC0350051
+	StatusHvNxNotDetected                                       NTStatus = 0xC0350055
+	StatusHvInvalidDeviceId                                     NTStatus = 0xC0350057
+	StatusHvInvalidDeviceState                                  NTStatus = 0xC0350058
+	StatusHvPageRequestInvalid                                  NTStatus = 0xC0350060
+	StatusHvInvalidCpuGroupId                                   NTStatus = 0xC035006F
+	StatusHvInvalidCpuGroupState                                NTStatus = 0xC0350070
+	StatusHvOperationFailed                                     NTStatus = 0xC0350071
+	StatusHvNotAllowedWithNestedVirtActive                      NTStatus = 0xC0350072
+	StatusHvInsufficientRootMemory                              NTStatus = 0xC0350073
+	StatusHvNotPresent                                          NTStatus = 0xC0351000
+	StatusIpsecBadSpi                                           NTStatus = 0xC0360001
+	StatusIpsecSaLifetimeExpired                                NTStatus = 0xC0360002
+	StatusIpsecWrongSa                                          NTStatus = 0xC0360003
+	StatusIpsecReplayCheckFailed                                NTStatus = 0xC0360004
+	StatusIpsecInvalidPacket                                    NTStatus = 0xC0360005
+	StatusIpsecIntegrityCheckFailed                             NTStatus = 0xC0360006
+	StatusIpsecClearTextDrop                                    NTStatus = 0xC0360007
+	StatusIpsecAuthFirewallDrop                                 NTStatus = 0xC0360008
+	StatusIpsecThrottleDrop                                     NTStatus = 0xC0360009
+	StatusIpsecDospBlock                                        NTStatus = 0xC0368000
+	StatusIpsecDospReceivedMulticast                            NTStatus = 0xC0368001
+	StatusIpsecDospInvalidPacket                                NTStatus = 0xC0368002
+	StatusIpsecDospStateLookupFailed                            NTStatus = 0xC0368003
+	StatusIpsecDospMaxEntries                                   NTStatus = 0xC0368004
+	StatusIpsecDospKeymodNotAllowed                             NTStatus = 0xC0368005
+	StatusIpsecDospMaxPerIpRatelimitQueues                      NTStatus = 0xC0368006
+	StatusVidDuplicateHandler                                   NTStatus = 0xC0370001
+	StatusVidTooManyHandlers                                    NTStatus = 0xC0370002
+	StatusVidQueueFull                                          NTStatus = 0xC0370003
+	StatusVidHandlerNotPresent                                  NTStatus = 0xC0370004
+	StatusVidInvalidObjectName                                  NTStatus = 0xC0370005
+	StatusVidPartitionNameTooLong                               NTStatus = 0xC0370006
+	StatusVidMessageQueueNameTooLong                            NTStatus = 0xC0370007
+	StatusVidPartitionAlreadyExists                             NTStatus = 0xC0370008
+	StatusVidPartitionDoesNotExist                              NTStatus = 0xC0370009
+	StatusVidPartitionNameNotFound                              NTStatus = 0xC037000A
+	StatusVidMessageQueueAlreadyExists                          NTStatus = 0xC037000B
+	StatusVidExceededMbpEntryMapLimit                           NTStatus = 0xC037000C
+	StatusVidMbStillReferenced                                  NTStatus = 0xC037000D
+	StatusVidChildGpaPageSetCorrupted                           NTStatus = 0xC037000E
+	StatusVidInvalidNumaSettings                                NTStatus = 0xC037000F
+	StatusVidInvalidNumaNodeIndex                               NTStatus = 0xC0370010
+	StatusVidNotificationQueueAlreadyAssociated                 NTStatus = 0xC0370011
+	StatusVidInvalidMemoryBlockHandle                           NTStatus = 0xC0370012
+	StatusVidPageRangeOverflow                                  NTStatus = 0xC0370013
+	StatusVidInvalidMessageQueueHandle                          NTStatus = 0xC0370014
+	StatusVidInvalidGpaRangeHandle                              NTStatus = 0xC0370015
+	StatusVidNoMemoryBlockNotificationQueue                     NTStatus = 0xC0370016
+	StatusVidMemoryBlockLockCountExceeded                       NTStatus = 0xC0370017
+	StatusVidInvalidPpmHandle                                   NTStatus = 0xC0370018
+	StatusVidMbpsAreLocked                                      NTStatus = 0xC0370019
+	StatusVidMessageQueueClosed                                 NTStatus = 0xC037001A
+	StatusVidVirtualProcessorLimitExceeded                      NTStatus = 0xC037001B
+	StatusVidStopPending                                        NTStatus = 0xC037001C
+	StatusVidInvalidProcessorState                              NTStatus = 0xC037001D
+	StatusVidExceededKmContextCountLimit                        NTStatus = 0xC037001E
+	StatusVidKmInterfaceAlreadyInitialized                      NTStatus = 0xC037001F
+	StatusVidMbPropertyAlreadySetReset                          NTStatus = 0xC0370020
+	StatusVidMmioRangeDestroyed                                 NTStatus = 0xC0370021
+	StatusVidInvalidChildGpaPageSet                             NTStatus = 0xC0370022
+	StatusVidReservePageSetIsBeingUsed                          NTStatus = 0xC0370023
+	StatusVidReservePageSetTooSmall                             NTStatus = 0xC0370024
+	StatusVidMbpAlreadyLockedUsingReservedPage                  NTStatus = 0xC0370025
+	StatusVidMbpCountExceededLimit                              NTStatus = 0xC0370026
+	StatusVidSavedStateCorrupt                                  NTStatus = 0xC0370027
+	StatusVidSavedStateUnrecognizedItem                         NTStatus = 0xC0370028
+	StatusVidSavedStateIncompatible                             NTStatus = 0xC0370029
+	StatusVidVtlAccessDenied                                    NTStatus = 0xC037002A
+	StatusVolmgrDatabaseFull                                    NTStatus = 0xC0380001
+	StatusVolmgrDiskConfigurationCorrupted                      NTStatus = 0xC0380002
+	StatusVolmgrDiskConfigurationNotInSync                      NTStatus = 0xC0380003
+	StatusVolmgrPackConfigUpdateFailed                          NTStatus = 0xC0380004
+	StatusVolmgrDiskContainsNonSimpleVolume                     NTStatus = 0xC0380005
+	StatusVolmgrDiskDuplicate                                   NTStatus = 0xC0380006
+	StatusVolmgrDiskDynamic                                     NTStatus = 0xC0380007
+	StatusVolmgrDiskIdInvalid                                   NTStatus = 0xC0380008
+	StatusVolmgrDiskInvalid                                     NTStatus = 0xC0380009
+	StatusVolmgrDiskLastVoter                                   NTStatus = 0xC038000A
+	StatusVolmgrDiskLayoutInvalid                               NTStatus = 0xC038000B
+	StatusVolmgrDiskLayoutNonBasicBetweenBasicPartitions        NTStatus = 0xC038000C
+	StatusVolmgrDiskLayoutNotCylinderAligned                    NTStatus = 0xC038000D
+	StatusVolmgrDiskLayoutPartitionsTooSmall                    NTStatus = 0xC038000E
+	StatusVolmgrDiskLayoutPrimaryBetweenLogicalPartitions       NTStatus = 0xC038000F
+	StatusVolmgrDiskLayoutTooManyPartitions                     NTStatus = 0xC0380010
+	StatusVolmgrDiskMissing                                     NTStatus = 0xC0380011
+	StatusVolmgrDiskNotEmpty                                    NTStatus = 0xC0380012
+	StatusVolmgrDiskNotEnoughSpace                              NTStatus = 0xC0380013
+	StatusVolmgrDiskRevectoringFailed                           NTStatus = 0xC0380014
+	StatusVolmgrDiskSectorSizeInvalid                           NTStatus = 0xC0380015
+	StatusVolmgrDiskSetNotContained                             NTStatus = 0xC0380016
+	StatusVolmgrDiskUsedByMultipleMembers                       NTStatus = 0xC0380017
+	StatusVolmgrDiskUsedByMultiplePlexes                        NTStatus = 0xC0380018
+	StatusVolmgrDynamicDiskNotSupported                         NTStatus = 0xC0380019
+	StatusVolmgrExtentAlreadyUsed                               NTStatus = 0xC038001A
+	StatusVolmgrExtentNotContiguous                             NTStatus = 0xC038001B
+	StatusVolmgrExtentNotInPublicRegion                         NTStatus = 0xC038001C
+	StatusVolmgrExtentNotSectorAligned                          NTStatus = 0xC038001D
+	StatusVolmgrExtentOverlapsEbrPartition                      NTStatus = 0xC038001E
+	StatusVolmgrExtentVolumeLengthsDoNotMatch                   NTStatus = 0xC038001F
+	StatusVolmgrFaultTolerantNotSupported                       NTStatus = 0xC0380020
+	StatusVolmgrInterleaveLengthInvalid                         NTStatus = 0xC0380021
+	StatusVolmgrMaximumRegisteredUsers                          NTStatus = 0xC0380022
+	StatusVolmgrMemberInSync                                    NTStatus = 0xC0380023
+	StatusVolmgrMemberIndexDuplicate                            NTStatus = 0xC0380024
+	StatusVolmgrMemberIndexInvalid                              NTStatus = 0xC0380025
+	StatusVolmgrMemberMissing                                   NTStatus = 0xC0380026
+	StatusVolmgrMemberNotDetached                               NTStatus = 0xC0380027
+	StatusVolmgrMemberRegenerating                              NTStatus = 0xC0380028
+	StatusVolmgrAllDisksFailed                                  NTStatus = 0xC0380029
+	StatusVolmgrNoRegisteredUsers                               NTStatus = 0xC038002A
+	StatusVolmgrNoSuchUser                                      NTStatus = 0xC038002B
+	StatusVolmgrNotificationReset                               NTStatus = 0xC038002C
+	StatusVolmgrNumberOfMembersInvalid                          NTStatus = 0xC038002D
+	StatusVolmgrNumberOfPlexesInvalid                           NTStatus = 0xC038002E
+	StatusVolmgrPackDuplicate                                   NTStatus = 0xC038002F
+	StatusVolmgrPackIdInvalid                                   NTStatus = 0xC0380030
+	StatusVolmgrPackInvalid                                     NTStatus = 0xC0380031
+	StatusVolmgrPackNameInvalid                                 NTStatus = 0xC0380032
+	StatusVolmgrPackOffline                                     NTStatus = 0xC0380033
+	StatusVolmgrPackHasQuorum                                   NTStatus = 0xC0380034
+	StatusVolmgrPackWithoutQuorum                               NTStatus = 0xC0380035
+	StatusVolmgrPartitionStyleInvalid                           NTStatus = 0xC0380036
+	StatusVolmgrPartitionUpdateFailed                           NTStatus = 0xC0380037
+	StatusVolmgrPlexInSync                                      NTStatus = 0xC0380038
+	StatusVolmgrPlexIndexDuplicate                              NTStatus = 0xC0380039
+	StatusVolmgrPlexIndexInvalid                                NTStatus = 0xC038003A
+	StatusVolmgrPlexLastActive                                  NTStatus = 0xC038003B
+	StatusVolmgrPlexMissing                                     NTStatus = 0xC038003C
+	StatusVolmgrPlexRegenerating                                NTStatus = 0xC038003D
+	StatusVolmgrPlexTypeInvalid                                 NTStatus = 0xC038003E
+	StatusVolmgrPlexNotRaid5                                    NTStatus = 0xC038003F
+	StatusVolmgrPlexNotSimple                                   NTStatus = 0xC0380040
+	StatusVolmgrStructureSizeInvalid                            NTStatus = 0xC0380041
+	StatusVolmgrTooManyNotificationRequests                     NTStatus = 0xC0380042
+	StatusVolmgrTransactionInProgress                           NTStatus = 0xC0380043
+	StatusVolmgrUnexpectedDiskLayoutChange                      NTStatus = 0xC0380044
+	StatusVolmgrVolumeContainsMissingDisk                       NTStatus = 0xC0380045
+	StatusVolmgrVolumeIdInvalid                                 NTStatus = 0xC0380046
+	StatusVolmgrVolumeLengthInvalid                             NTStatus = 0xC0380047
+	StatusVolmgrVolumeLengthNotSectorSizeMultiple               NTStatus = 0xC0380048
+	StatusVolmgrVolumeNotMirrored                               NTStatus = 0xC0380049
+	StatusVolmgrVolumeNotRetained                               NTStatus = 0xC038004A
+	StatusVolmgrVolumeOffline                                   NTStatus = 0xC038004B
+	StatusVolmgrVolumeRetained                                  NTStatus = 0xC038004C
+	StatusVolmgrNumberOfExtentsInvalid                          NTStatus = 0xC038004D
+	StatusVolmgrDifferentSectorSize                             NTStatus = 0xC038004E
+	StatusVolmgrBadBootDisk                                     NTStatus = 0xC038004F
+	StatusVolmgrPackConfigOffline                               NTStatus = 0xC0380050
+	StatusVolmgrPackConfigOnline                                NTStatus = 0xC0380051
+	StatusVolmgrNotPrimaryPack                                  NTStatus = 0xC0380052
+	StatusVolmgrPackLogUpdateFailed                             NTStatus = 0xC0380053
+	StatusVolmgrNumberOfDisksInPlexInvalid                      NTStatus = 0xC0380054
+	StatusVolmgrNumberOfDisksInMemberInvalid                    NTStatus = 0xC0380055
+	StatusVolmgrVolumeMirrored                                  NTStatus = 0xC0380056
+	StatusVolmgrPlexNotSimpleSpanned                            NTStatus = 0xC0380057
+	StatusVolmgrNoValidLogCopies                                NTStatus = 0xC0380058
+	StatusVolmgrPrimaryPackPresent                              NTStatus = 0xC0380059
+	StatusVolmgrNumberOfDisksInvalid                            NTStatus = 0xC038005A
+	StatusVolmgrMirrorNotSupported                              NTStatus = 0xC038005B
+	StatusVolmgrRaid5NotSupported                               NTStatus = 0xC038005C
+	StatusBcdTooManyElements                                    NTStatus = 0xC0390002
+	StatusVhdDriveFooterMissing                                 NTStatus = 0xC03A0001
+	StatusVhdDriveFooterChecksumMismatch                        NTStatus = 0xC03A0002
+	StatusVhdDriveFooterCorrupt                                 NTStatus = 0xC03A0003
+	StatusVhdFormatUnknown                                      NTStatus = 0xC03A0004
+	StatusVhdFormatUnsupportedVersion                           NTStatus = 0xC03A0005
+	StatusVhdSparseHeaderChecksumMismatch                       NTStatus = 0xC03A0006
+	StatusVhdSparseHeaderUnsupportedVersion                     NTStatus = 0xC03A0007
+	StatusVhdSparseHeaderCorrupt                                NTStatus = 0xC03A0008
+	StatusVhdBlockAllocationFailure                             NTStatus = 0xC03A0009
+	StatusVhdBlockAllocationTableCorrupt                        NTStatus = 0xC03A000A
+	StatusVhdInvalidBlockSize                                   NTStatus = 0xC03A000B
+	StatusVhdBitmapMismatch                                     NTStatus = 0xC03A000C
+	StatusVhdParentVhdNotFound                                  NTStatus = 0xC03A000D
+	StatusVhdChildParentIdMismatch                              NTStatus = 0xC03A000E
+	StatusVhdChildParentTimestampMismatch                       NTStatus = 0xC03A000F
+	StatusVhdMetadataReadFailure                                NTStatus = 0xC03A0010
+	StatusVhdMetadataWriteFailure                               NTStatus = 0xC03A0011
+	StatusVhdInvalidSize                                        NTStatus = 0xC03A0012
+	StatusVhdInvalidFileSize                                    NTStatus = 0xC03A0013
+	StatusVirtdiskProviderNotFound                              NTStatus = 0xC03A0014
+	StatusVirtdiskNotVirtualDisk                                NTStatus = 0xC03A0015
+	StatusVhdParentVhdAccessDenied                              NTStatus = 0xC03A0016
+	StatusVhdChildParentSizeMismatch                            NTStatus = 0xC03A0017
+	StatusVhdDifferencingChainCycleDetected                     NTStatus = 0xC03A0018
+	StatusVhdDifferencingChainErrorInParent                     NTStatus = 0xC03A0019
+	StatusVirtualDiskLimitation                                 NTStatus = 0xC03A001A
+	StatusVhdInvalidType                                        NTStatus = 0xC03A001B
+	StatusVhdInvalidState                                       NTStatus = 0xC03A001C
+	StatusVirtdiskUnsupportedDiskSectorSize                     NTStatus = 0xC03A001D
+	StatusVirtdiskDiskAlreadyOwned                              NTStatus = 0xC03A001E
+	StatusVirtdiskDiskOnlineAndWritable                         NTStatus = 0xC03A001F
+	StatusCtlogTrackingNotInitialized                           NTStatus = 0xC03A0020
+	StatusCtlogLogfileSizeExceededMaxsize                       NTStatus = 0xC03A0021
+	StatusCtlogVhdChangedOffline                                NTStatus = 0xC03A0022
+	StatusCtlogInvalidTrackingState                             NTStatus = 0xC03A0023
+	StatusCtlogInconsistentTrackingFile                         NTStatus = 0xC03A0024
+	StatusVhdMetadataFull                                       NTStatus = 0xC03A0028
+	StatusVhdInvalidChangeTrackingId                            NTStatus = 0xC03A0029
+	StatusVhdChangeTrackingDisabled                             NTStatus = 0xC03A002A
+	StatusVhdMissingChangeTrackingInformation                   NTStatus = 0xC03A0030
+	StatusVhdResizeWouldTruncateData                            NTStatus = 0xC03A0031
+	StatusVhdCouldNotComputeMinimumVirtualSize                  NTStatus = 0xC03A0032
+	StatusVhdAlreadyAtOrBelowMinimumVirtualSize                 NTStatus = 0xC03A0033
+	StatusRkfKeyNotFound                                        NTStatus = 0xC0400001
+	StatusRkfDuplicateKey                                       NTStatus = 0xC0400002
+	StatusRkfBlobFull                                           NTStatus = 0xC0400003
+	StatusRkfStoreFull                                          NTStatus = 0xC0400004
+	StatusRkfFileBlocked                                        NTStatus = 0xC0400005
+	StatusRkfActiveKey                                          NTStatus = 0xC0400006
+	StatusRdbssRestartOperation                                 NTStatus = 0xC0410001
+	StatusRdbssContinueOperation                                NTStatus = 0xC0410002
+	StatusRdbssPostOperation                                    NTStatus = 0xC0410003
+	StatusRdbssRetryLookup                                      NTStatus = 0xC0410004
+	StatusBthAttInvalidHandle                                   NTStatus = 0xC0420001
+	StatusBthAttReadNotPermitted                                NTStatus = 0xC0420002
+	StatusBthAttWriteNotPermitted                               NTStatus = 0xC0420003
+	StatusBthAttInvalidPdu                                      NTStatus = 0xC0420004
+	StatusBthAttInsufficientAuthentication                      NTStatus = 0xC0420005
+	StatusBthAttRequestNotSupported                             NTStatus = 0xC0420006
+	StatusBthAttInvalidOffset                                   NTStatus = 0xC0420007
+	StatusBthAttInsufficientAuthorization                       NTStatus = 0xC0420008
+	StatusBthAttPrepareQueueFull                                NTStatus = 0xC0420009
+	StatusBthAttAttributeNotFound                               NTStatus = 0xC042000A
+	StatusBthAttAttributeNotLong                                NTStatus = 0xC042000B
+	StatusBthAttInsufficientEncryptionKeySize                   NTStatus = 0xC042000C
+	StatusBthAttInvalidAttributeValueLength                     NTStatus = 0xC042000D
+	StatusBthAttUnlikely                                        NTStatus = 0xC042000E
+	StatusBthAttInsufficientEncryption                          NTStatus = 0xC042000F
+	StatusBthAttUnsupportedGroupType                            NTStatus = 0xC0420010
+	StatusBthAttInsufficientResources                           NTStatus = 0xC0420011
+	StatusBthAttUnknownError                                    NTStatus = 0xC0421000
+	StatusSecurebootRollbackDetected                            NTStatus = 0xC0430001
+	StatusSecurebootPolicyViolation                             NTStatus = 0xC0430002
+	StatusSecurebootInvalidPolicy                               NTStatus = 0xC0430003
+	StatusSecurebootPolicyPublisherNotFound                     NTStatus = 0xC0430004
+	StatusSecurebootPolicyNotSigned                             NTStatus = 0xC0430005
+	StatusSecurebootFileReplaced                                NTStatus = 0xC0430007
+	StatusSecurebootPolicyNotAuthorized                         NTStatus = 0xC0430008
+	StatusSecurebootPolicyUnknown                               NTStatus = 0xC0430009
+	StatusSecurebootPolicyMissingAntirollbackversion            NTStatus = 0xC043000A
+	StatusSecurebootPlatformIdMismatch                          NTStatus = 0xC043000B
+	StatusSecurebootPolicyRollbackDetected                      NTStatus = 0xC043000C
+	StatusSecurebootPolicyUpgradeMismatch                       NTStatus = 0xC043000D
+	StatusSecurebootRequiredPolicyFileMissing                   NTStatus = 0xC043000E
+	StatusSecurebootNotBasePolicy                               NTStatus = 0xC043000F
+	StatusSecurebootNotSupplementalPolicy                       NTStatus = 0xC0430010
+	StatusAudioEngineNodeNotFound                               NTStatus = 0xC0440001
+	StatusHdaudioEmptyConnectionList                            NTStatus = 0xC0440002
+	StatusHdaudioConnectionListNotSupported                     NTStatus = 0xC0440003
+	StatusHdaudioNoLogicalDevicesCreated                        NTStatus = 0xC0440004
+	StatusHdaudioNullLinkedListEntry                            NTStatus = 0xC0440005
+	StatusVsmNotInitialized                                     NTStatus = 0xC0450000
+	StatusVsmDmaProtectionNotInUse                              NTStatus = 0xC0450001
+	StatusVolsnapBootfileNotValid                               NTStatus = 0xC0500003
+	StatusVolsnapActivationTimeout                              NTStatus = 0xC0500004
+	StatusIoPreempted                                           NTStatus = 0xC0510001
+	StatusSvhdxErrorStored                                      NTStatus = 0xC05C0000
+	StatusSvhdxErrorNotAvailable                                NTStatus = 0xC05CFF00
+	StatusSvhdxUnitAttentionAvailable                           NTStatus = 0xC05CFF01
+	StatusSvhdxUnitAttentionCapacityDataChanged                 NTStatus = 0xC05CFF02
+	StatusSvhdxUnitAttentionReservationsPreempted               NTStatus = 0xC05CFF03
+	StatusSvhdxUnitAttentionReservationsReleased                NTStatus = 0xC05CFF04
+	StatusSvhdxUnitAttentionRegistrationsPreempted              NTStatus = 0xC05CFF05
+	StatusSvhdxUnitAttentionOperatingDefinitionChanged          NTStatus = 0xC05CFF06
+	StatusSvhdxReservationConflict                              NTStatus = 0xC05CFF07
+	StatusSvhdxWrongFileType                                    NTStatus = 0xC05CFF08
+	StatusSvhdxVersionMismatch                                  NTStatus = 0xC05CFF09
+	StatusVhdShared                                             NTStatus = 0xC05CFF0A
+	StatusSvhdxNoInitiator                                      NTStatus = 0xC05CFF0B
+	StatusVhdsetBackingStorageNotFound                          NTStatus = 0xC05CFF0C
+	StatusSmbNoPreauthIntegrityHashOverlap                      NTStatus = 0xC05D0000
+	StatusSmbBadClusterDialect                                  NTStatus = 0xC05D0001
+	StatusSmbGuestLogonBlocked                                  NTStatus = 0xC05D0002
+	StatusSpacesFaultDomainTypeInvalid                          NTStatus = 0xC0E70001
+	StatusSpacesResiliencyTypeInvalid                           NTStatus = 0xC0E70003
+	StatusSpacesDriveSectorSizeInvalid                          NTStatus = 0xC0E70004
+	StatusSpacesDriveRedundancyInvalid                          NTStatus = 0xC0E70006
+	StatusSpacesNumberOfDataCopiesInvalid                       NTStatus = 0xC0E70007
+	StatusSpacesInterleaveLengthInvalid                         NTStatus = 0xC0E70009
+	StatusSpacesNumberOfColumnsInvalid                          NTStatus = 0xC0E7000A
+	StatusSpacesNotEnoughDrives                                 NTStatus = 0xC0E7000B
+	StatusSpacesExtendedError                                   NTStatus = 0xC0E7000C
+	StatusSpacesProvisioningTypeInvalid                         NTStatus = 0xC0E7000D
+	StatusSpacesAllocationSizeInvalid                           NTStatus = 0xC0E7000E
+	StatusSpacesEnclosureAwareInvalid                           NTStatus = 0xC0E7000F
+	StatusSpacesWriteCacheSizeInvalid                           NTStatus = 0xC0E70010
+	StatusSpacesNumberOfGroupsInvalid                           NTStatus = 0xC0E70011
+	StatusSpacesDriveOperationalStateInvalid                    NTStatus = 0xC0E70012
+	StatusSpacesUpdateColumnState                               NTStatus = 0xC0E70013
+	StatusSpacesMapRequired                                     NTStatus = 0xC0E70014
+	StatusSpacesUnsupportedVersion                              NTStatus = 0xC0E70015
+	StatusSpacesCorruptMetadata                                 NTStatus = 0xC0E70016
+	StatusSpacesDrtFull                                         NTStatus = 0xC0E70017
+	StatusSpacesInconsistency                                   NTStatus = 0xC0E70018
+	StatusSpacesLogNotReady                                     NTStatus = 0xC0E70019
+	StatusSpacesNoRedundancy                                    NTStatus = 0xC0E7001A
+	StatusSpacesDriveNotReady                                   NTStatus = 0xC0E7001B
+	StatusSpacesDriveSplit                                      NTStatus = 0xC0E7001C
+	StatusSpacesDriveLostData                                   NTStatus = 0xC0E7001D
+	StatusSpacesEntryIncomplete                                 NTStatus = 0xC0E7001E
+	StatusSpacesEntryInvalid                                    NTStatus = 0xC0E7001F
+	StatusSpacesMarkDirty                                       NTStatus = 0xC0E70020
+	StatusSeccoreInvalidCommand                                 NTStatus = 0xC0E80000
+	StatusSystemIntegrityRollbackDetected                       NTStatus = 0xC0E90001
+	StatusSystemIntegrityPolicyViolation                        NTStatus = 0xC0E90002
+	StatusSystemIntegrityInvalidPolicy                          NTStatus = 0xC0E90003
+	StatusSystemIntegrityPolicyNotSigned                        NTStatus = 0xC0E90004
+	StatusSystemIntegrityTooManyPolicies                        NTStatus = 0xC0E90005
+	StatusSystemIntegritySupplementalPolicyNotAuthorized        NTStatus = 0xC0E90006
+	StatusNoApplicableAppLicensesFound                          NTStatus = 0xC0EA0001
+	StatusClipLicenseNotFound                                   NTStatus = 0xC0EA0002
+	StatusClipDeviceLicenseMissing                              NTStatus = 0xC0EA0003
+	StatusClipLicenseInvalidSignature                           NTStatus = 0xC0EA0004
+	StatusClipKeyholderLicenseMissingOrInvalid                  NTStatus = 0xC0EA0005
+	StatusClipLicenseExpired                                    NTStatus = 0xC0EA0006
+	StatusClipLicenseSignedByUnknownSource                      NTStatus = 0xC0EA0007
+	StatusClipLicenseNotSigned                                  NTStatus = 0xC0EA0008
+	StatusClipLicenseHardwareIdOutOfTolerance                   NTStatus = 0xC0EA0009
+	StatusClipLicenseDeviceIdMismatch                           NTStatus = 0xC0EA000A
+	StatusPlatformManifestNotAuthorized                         NTStatus = 0xC0EB0001
+	StatusPlatformManifestInvalid                               NTStatus = 0xC0EB0002
+	StatusPlatformManifestFileNotAuthorized                     NTStatus = 0xC0EB0003
+	StatusPlatformManifestCatalogNotAuthorized                  NTStatus = 0xC0EB0004
+	StatusPlatformManifestBinaryIdNotFound                      NTStatus = 0xC0EB0005
+	StatusPlatformManifestNotActive                             NTStatus = 0xC0EB0006
+	StatusPlatformManifestNotSigned                             NTStatus = 0xC0EB0007
+	StatusAppexecConditionNotSatisfied                          NTStatus = 0xC0EC0000
+	StatusAppexecHandleInvalidated                              NTStatus = 0xC0EC0001
+	StatusAppexecInvalidHostGeneration                          NTStatus = 0xC0EC0002
+	StatusAppexecUnexpectedProcessRegistration                  NTStatus = 0xC0EC0003
+	StatusAppexecInvalidHostState                               NTStatus = 0xC0EC0004
+	StatusAppexecNoDonor                                        NTStatus = 0xC0EC0005
+	StatusAppexecHostIdMismatch                                 NTStatus = 0xC0EC0006
+	StatusAppexecUnknownUser                                    NTStatus = 0xC0EC0007
+)
+
+// ntstatusWin32 maps NTSTATUS codes to Win32 error codes by name, or
+// by known exceptions, sorted by NTSTATUS code
+var ntstatusWin32 = []errMapping{
+	{0x00000000, 0},
+	{0x00000001, 731},
+	{0x00000002, 732},
+	{0x00000003, 733},
+	{0x0000003F, 734},
+	{0x00000080, 735},
+	{0x000000BF, 736},
+	{0x000000C0, 737},
+	{0x00000100, 738},
+	{0x00000101, 739},
+	{0x00000102, 258},
+	{0x00000103, 997},
+	{0x00000104, 741},
+	{0x00000106, 1300},
+	{0x00000107, 1301},
+	{0x00000108, 742},
+	{0x00000109, 743},
+	{0x0000010A, 744},
+	{0x0000010B, 745},
+	{0x0000010C, 1022},
+	{0x0000010D, 1302},
+	{0x0000010E, 746},
+	{0x00000110, 747},
+	{0x00000111, 748},
+	{0x00000112, 749},
+	{0x00000113, 750},
+	{0x00000114, 751},
+	{0x00000115, 752},
+	{0x00000116, 753},
+	{0x00000117, 754},
+	{0x00000118, 755},
+	{0x00000119, 756},
+	{0x00000120, 757},
+	{0x00000121, 8201},
+	{0x00000122, 758},
+	{0x00000123, 759},
+	{0x00000124, 760},
+	{0x00000125, 761},
+	{0x00000126, 762},
+	{0x00000127, 763},
+	{0x00000128, 764},
+	{0x00000202, 6707},
+	{0x00000215, 800},
+	{0x00000216, 803},
+	{0x00000367, 765},
+	{0x40000000, 698},
+	{0x40000001, 699},
+	{0x40000004, 701},
+	{0x40000005, 702},
+	{0x40000006, 1303},
+	{0x40000007, 703},
+	{0x40000009, 1014},
+	{0x4000000A, 704},
+	{0x4000000B, 705},
+	{0x4000000D, 1304},
+	{0x4000000E, 706},
+	{0x4000000F, 707},
+	{0x40000010, 708},
+	{0x40000011, 709},
+	{0x40000012, 710},
+	{0x40000013, 711},
+	{0x40000014, 712},
+	{0x40000015, 713},
+	{0x40000016, 714},
+	{0x40000017, 715},
+	{0x40000018, 716},
+	{0x40000019, 717},
+	{0x4000001A, 718},
+	{0x4000001B, 719},
+	{0x40000023, 720},
+	{0x40000024, 721},
+	{0x40000025, 722},
+	{0x40000026, 723},
+	{0x40000027, 724},
+	{0x40000029, 725},
+	{0x4000002A, 726},
+	{0x4000002B, 727},
+	{0x4000002C, 728},
+	{0x4000002D, 729},
+	{0x4000002F, 782},
+	{0x40000031, 783},
+	{0x40000032, 785},
+	{0x40000033, 787},
+	{0x40000294, 730},
+	{0x40000370, 8364},
+	{0x40000807, 792},
+	{0x4000A144, 15405},
+	{0x400A0004, 7066},
+	{0x400A0005, 7067},
+	{0x4015000D, 14088},
+	{0x40190001, 6731},
+	{0x40190034, 6821},
+	{0x40190035, 6822},
+	{0x401A000C, 6611},
+	{0x80000005, 234},
+	{0x80000006, 18},
+	{0x80000007, 675},
+	{0x8000000A, 676},
+	{0x8000000B, 1391},
+	{0x8000000C, 680},
+	{0x8000000D, 299},
+	{0x80000013, 254},
+	{0x80000014, 255},
+	{0x80000017, 677},
+	{0x80000018, 678},
+	{0x8000001B, 1101},
+	{0x8000001C, 1110},
+	{0x8000001D, 1111},
+	{0x8000001E, 1100},
+	{0x8000001F, 1102},
+	{0x80000020, 679},
+	{0x80000021, 1103},
+	{0x80000022, 1104},
+	{0x80000023, 1794},
+	{0x80000024, 1811},
+	{0x80000026, 682},
+	{0x80000027, 4340},
+	{0x80000028, 683},
+	{0x80000029, 684},
+	{0x8000002A, 685},
+	{0x8000002B, 686},
+	{0x8000002C, 687},
+	{0x8000002D, 681},
+	{0x8000002E, 801},
+	{0x8000002F, 804},
+	{0x80000030, 171},
+	{0x80000032, 235},
+	{0x80000288, 1165},
+	{0x80000289, 1166},
+	{0x80000803, 6843},
+	{0x8000A127, 15327},
+	{0x8000CF00, 366},
+	{0x8000CF04, 365},
+	{0x8000CF05, 374},
+	{0x80130001, 5061},
+	{0x80130002, 5062},
+	{0x80130003, 5063},
+	{0x80130004, 5064},
+	{0x80130005, 5065},
+	{0x80190009, 6629},
+	{0x80190029, 6816},
+	{0x80190031, 6818},
+	{0x80190041, 6835},
+	{0x80190042, 6836},
+	{0x803F0001, 373},
+	{0x80430006, 4425},
+	{0xC0000001, 31},
+	{0xC0000002, 1},
+	{0xC0000003, 87},
+	{0xC0000004, 24},
+	{0xC0000005, 998},
+	{0xC0000007, 1454},
+	{0xC0000008, 6},
+	{0xC000000B, 87},
+	{0xC000000C, 541},
+	{0xC000000D, 87},
+	{0xC000000E, 433},
+	{0xC000000F, 2},
+	{0xC0000010, 1},
+	{0xC0000011, 38},
+	{0xC0000014, 1785},
+	{0xC0000017, 8},
+	{0xC0000018, 487},
+	{0xC0000022, 5},
+	{0xC0000023, 122},
+	{0xC0000024, 6},
+	{0xC0000027, 542},
+	{0xC0000028, 543},
+	{0xC0000029, 544},
+	{0xC000002A, 158},
+	{0xC000002E, 545},
+	{0xC000002F, 546},
+	{0xC0000030, 87},
+	{0xC0000031, 547},
+	{0xC0000033, 123},
+	{0xC0000034, 2},
+	{0xC0000035, 183},
+	{0xC0000038, 548},
+	{0xC0000039, 161},
+	{0xC000003A, 3},
+	{0xC000003B, 161},
+	{0xC0000043, 32},
+	{0xC000004F, 282},
+	{0xC0000056, 5},
+	{0xC0000058, 1305},
+	{0xC0000059, 1306},
+	{0xC000005A, 1307},
+	{0xC000005B, 1308},
+	{0xC000005C, 1309},
+	{0xC000005D, 1310},
+	{0xC000005E, 1311},
+	{0xC000005F, 1312},
+	{0xC0000060, 1313},
+	{0xC0000061, 1314},
+	{0xC0000062, 1315},
+	{0xC0000063, 1316},
+	{0xC0000064, 1317},
+	{0xC0000065, 1318},
+	{0xC0000066, 1319},
+	{0xC0000067, 1320},
+	{0xC0000068, 1321},
+	{0xC0000069, 1322},
+	{0xC000006A, 1323},
+	{0xC000006B, 1324},
+	{0xC000006C, 1325},
+	{0xC000006D, 1326},
+	{0xC000006E, 1327},
+	{0xC000006F, 1328},
+	{0xC0000070, 1329},
+	{0xC0000071, 1330},
+	{0xC0000072, 1331},
+	{0xC0000073, 1332},
+	{0xC0000074, 1333},
+	{0xC0000075, 1334},
+	{0xC0000076, 1335},
+	{0xC0000077, 1336},
+	{0xC0000078, 1337},
+	{0xC0000079, 1338},
+	{0xC000007C, 1008},
+	{0xC000007D, 1340},
+	{0xC000007F, 112},
+	{0xC0000080, 1341},
+	{0xC0000081, 1342},
+	{0xC0000084, 1343},
+	{0xC0000089, 1812},
+	{0xC000008A, 1813},
+	{0xC000008B, 1814},
+	{0xC0000098, 1006},
+	{0xC0000099, 1344},
+	{0xC000009A, 1450},
+	{0xC000009D, 1167},
+	{0xC00000A1, 1453},
+	{0xC00000A4, 1345},
+	{0xC00000A5, 1346},
+	{0xC00000A6, 1347},
+	{0xC00000A7, 1348},
+	{0xC00000A8, 1349},
+	{0xC00000AA, 549},
+	{0xC00000AE, 231},
+	{0xC00000B2, 535},
+	{0xC00000B3, 536},
+	{0xC00000B7, 550},
+	{0xC00000B8, 551},
+	{0xC00000B9, 552},
+	{0xC00000BA, 5},
+	{0xC00000BB, 50},
+	{0xC00000BF, 54},
+	{0xC00000C7, 62},
+	{0xC00000C8, 63},
+	{0xC00000CA, 65},
+	{0xC00000CD, 68},
+	{0xC00000CF, 70},
+	{0xC00000D2, 88},
+	{0xC00000D3, 553},
+	{0xC00000D4, 17},
+	{0xC00000D7, 1350},
+	{0xC00000D8, 554},
+	{0xC00000DA, 1351},
+	{0xC00000DB, 555},
+	{0xC00000DC, 1352},
+	{0xC00000DD, 1353},
+	{0xC00000DE, 1354},
+	{0xC00000DF, 1355},
+	{0xC00000E0, 1356},
+	{0xC00000E1, 1357},
+	{0xC00000E2, 300},
+	{0xC00000E3, 301},
+	{0xC00000E4, 1358},
+	{0xC00000E5, 1359},
+	{0xC00000E6, 1360},
+	{0xC00000E7, 1361},
+	{0xC00000E8, 1784},
+	{0xC00000EA, 556},
+	{0xC00000EB, 557},
+	{0xC00000EC, 558},
+	{0xC00000ED, 1362},
+	{0xC00000EE, 1363},
+	{0xC00000EF, 87},
+	{0xC00000F0, 87},
+	{0xC00000F1, 87},
+	{0xC00000F2, 87},
+	{0xC00000F3, 87},
+	{0xC00000F4, 87},
+	{0xC00000F5, 87},
+	{0xC00000F6, 87},
+	{0xC00000F7, 87},
+	{0xC00000F8, 87},
+	{0xC00000F9, 87},
+	{0xC00000FA, 87},
+	{0xC00000FD, 1001},
+	{0xC00000FE, 1364},
+	{0xC00000FF, 559},
+	{0xC0000101, 145},
+	{0xC0000103, 267},
+	{0xC0000104, 1365},
+	{0xC0000105, 1366},
+	{0xC000010A, 5},
+	{0xC000010B, 1367},
+	{0xC000010C, 560},
+	{0xC000010D, 1368},
+	{0xC0000118, 561},
+	{0xC0000119, 563},
+	{0xC000011A, 564},
+	{0xC000011C, 1369},
+	{0xC000011D, 1370},
+	{0xC0000120, 1223},
+	{0xC0000121, 5},
+	{0xC0000124, 1371},
+	{0xC0000125, 1372},
+	{0xC0000126, 1373},
+	{0xC0000127, 1374},
+	{0xC0000129, 565},
+	{0xC000012A, 566},
+	{0xC000012B, 1375},
+	{0xC000012C, 567},
+	{0xC000012D, 1455},
+	{0xC0000132, 568},
+	{0xC0000134, 569},
+	{0xC0000135, 1157},
+	{0xC0000136, 110},
+	{0xC0000137, 571},
+	{0xC000013A, 572},
+	{0xC0000141, 487},
+	{0xC0000142, 1114},
+	{0xC0000143, 573},
+	{0xC0000144, 574},
+	{0xC0000145, 575},
+	{0xC0000146, 576},
+	{0xC0000147, 578},
+	{0xC0000148, 124},
+	{0xC000014A, 579},
+	{0xC000014C, 1015},
+	{0xC000014D, 1016},
+	{0xC000014E, 580},
+	{0xC000014F, 1005},
+	{0xC0000151, 1376},
+	{0xC0000152, 1377},
+	{0xC0000153, 1378},
+	{0xC0000154, 1379},
+	{0xC0000155, 1380},
+	{0xC0000156, 1381},
+	{0xC0000157, 1382},
+	{0xC0000158, 1383},
+	{0xC0000159, 1007},
+	{0xC000015A, 1384},
+	{0xC000015B, 1385},
+	{0xC000015C, 1017},
+	{0xC000015D, 1386},
+	{0xC000015E, 581},
+	{0xC0000161, 582},
+	{0xC0000163, 583},
+	{0xC0000164, 584},
+	{0xC0000165, 1122},
+	{0xC0000166, 1123},
+	{0xC0000167, 1124},
+	{0xC0000168, 1125},
+	{0xC0000169, 1126},
+	{0xC000016A, 1127},
+	{0xC000016B, 1128},
+	{0xC000016E, 585},
+	{0xC0000172, 1105},
+	{0xC0000173, 1106},
+	{0xC0000174, 1107},
+	{0xC0000175, 1108},
+	{0xC0000176, 1109},
+	{0xC0000177, 1129},
+	{0xC000017A, 1387},
+	{0xC000017B, 1388},
+	{0xC000017C, 1018},
+	{0xC000017D, 1019},
+	{0xC000017E, 1389},
+	{0xC000017F, 1390},
+	{0xC0000180, 1020},
+	{0xC0000181, 1021},
+	{0xC0000187, 586},
+	{0xC0000188, 1502},
+	{0xC000018A, 1786},
+	{0xC000018B, 1787},
+	{0xC000018C, 1788},
+	{0xC000018D, 1789},
+	{0xC000018E, 1500},
+	{0xC000018F, 1501},
+	{0xC0000190, 1790},
+	{0xC0000191, 587},
+	{0xC0000192, 1792},
+	{0xC0000193, 1793},
+	{0xC0000194, 1131},
+	{0xC0000197, 1503},
+	{0xC0000198, 1807},
+	{0xC0000199, 1808},
+	{0xC000019A, 1809},
+	{0xC000019B, 1810},
+	{0xC000019C, 588},
+	{0xC000019E, 304},
+	{0xC000019F, 305},
+	{0xC00001A0, 306},
+	{0xC00001A1, 307},
+	{0xC00001A2, 805},
+	{0xC00001A3, 308},
+	{0xC00001A4, 309},
+	{0xC00001A5, 310},
+	{0xC00001A6, 311},
+	{0xC00001A7, 313},
+	{0xC00001AB, 809},
+	{0xC00001AC, 1656},
+	{0xC00001AF, 813},
+	{0xC0000202, 1394},
+	{0xC0000204, 1815},
+	{0xC0000218, 589},
+	{0xC0000219, 590},
+	{0xC000021A, 591},
+	{0xC000021B, 592},
+	{0xC000021C, 6118},
+	{0xC000021D, 593},
+	{0xC000021E, 594},
+	{0xC000021F, 595},
+	{0xC0000220, 1132},
+	{0xC0000222, 596},
+	{0xC0000223, 597},
+	{0xC0000224, 1907},
+	{0xC0000225, 1168},
+	{0xC0000226, 598},
+	{0xC0000227, 1279},
+	{0xC0000228, 599},
+	{0xC000022C, 600},
+	{0xC000022D, 1237},
+	{0xC000022E, 601},
+	{0xC000022F, 602},
+	{0xC0000231, 603},
+	{0xC0000232, 604},
+	{0xC0000233, 1908},
+	{0xC0000234, 1909},
+	{0xC0000236, 1225},
+	{0xC0000237, 1226},
+	{0xC0000238, 1227},
+	{0xC0000239, 1228},
+	{0xC000023A, 1229},
+	{0xC000023B, 1230},
+	{0xC000023C, 1231},
+	{0xC000023D, 1232},
+	{0xC000023E, 1233},
+	{0xC000023F, 1234},
+	{0xC0000240, 1235},
+	{0xC0000241, 1236},
+	{0xC0000242, 605},
+	{0xC0000243, 1224},
+	{0xC0000244, 606},
+	{0xC0000245, 607},
+	{0xC0000246, 1238},
+	{0xC0000247, 1239},
+	{0xC0000248, 1240},
+	{0xC0000250, 608},
+	{0xC0000251, 609},
+	{0xC0000252, 610},
+	{0xC0000254, 611},
+	{0xC0000255, 612},
+	{0xC0000256, 613},
+	{0xC0000258, 614},
+	{0xC0000259, 1395},
+	{0xC000025A, 615},
+	{0xC000025B, 616},
+	{0xC000025C, 617},
+	{0xC000025F, 618},
+	{0xC0000260, 619},
+	{0xC0000261, 620},
+	{0xC0000265, 1142},
+	{0xC0000266, 621},
+	{0xC0000268, 622},
+	{0xC0000269, 623},
+	{0xC000026B, 624},
+	{0xC0000271, 625},
+	{0xC0000272, 1169},
+	{0xC0000273, 626},
+	{0xC0000275, 4390},
+	{0xC000027A, 657},
+	{0xC0000282, 627},
+	{0xC0000283, 1160},
+	{0xC0000284, 1161},
+	{0xC0000285, 1162},
+	{0xC0000286, 1163},
+	{0xC000028A, 6000},
+	{0xC000028B, 6001},
+	{0xC000028C, 644},
+	{0xC000028D, 6003},
+	{0xC000028E, 6004},
+	{0xC000028F, 6005},
+	{0xC0000290, 6006},
+	{0xC0000291, 6007},
+	{0xC0000292, 6008},
+	{0xC0000293, 6002},
+	{0xC0000295, 4200},
+	{0xC0000296, 4201},
+	{0xC0000297, 4202},
+	{0xC0000298, 4203},
+	{0xC0000299, 8218},
+	{0xC000029A, 8219},
+	{0xC000029B, 8220},
+	{0xC000029D, 4351},
+	{0xC000029E, 4352},
+	{0xC000029F, 1172},
+	{0xC00002A0, 628},
+	{0xC00002A1, 8202},
+	{0xC00002A2, 8203},
+	{0xC00002A3, 8204},
+	{0xC00002A4, 8205},
+	{0xC00002A5, 8206},
+	{0xC00002A6, 8207},
+	{0xC00002A7, 8208},
+	{0xC00002A8, 8209},
+	{0xC00002A9, 8210},
+	{0xC00002AA, 8211},
+	{0xC00002AB, 8212},
+	{0xC00002AC, 8213},
+	{0xC00002AD, 8214},
+	{0xC00002AE, 8215},
+	{0xC00002B0, 8217},
+	{0xC00002B2, 4391},
+	{0xC00002B3, 629},
+	{0xC00002B4, 630},
+	{0xC00002B5, 631},
+	{0xC00002B6, 1617},
+	{0xC00002B7, 1178},
+	{0xC00002B8, 1179},
+	{0xC00002B9, 632},
+	{0xC00002BA, 8263},
+	{0xC00002C1, 8228},
+	{0xC00002C2, 633},
+	{0xC00002C4, 634},
+	{0xC00002C6, 4213},
+	{0xC00002C7, 4214},
+	{0xC00002C8, 635},
+	{0xC00002C9, 1261},
+	{0xC00002CA, 4328},
+	{0xC00002CB, 8504},
+	{0xC00002CC, 1251},
+	{0xC00002CD, 8505},
+	{0xC00002CE, 636},
+	{0xC00002CF, 1181},
+	{0xC00002D0, 8506},
+	{0xC00002D1, 637},
+	{0xC00002D2, 638},
+	{0xC00002D4, 8513},
+	{0xC00002D5, 8514},
+	{0xC00002D6, 8515},
+	{0xC00002D7, 8516},
+	{0xC00002D8, 8517},
+	{0xC00002D9, 8518},
+	{0xC00002DA, 8519},
+	{0xC00002DB, 8520},
+	{0xC00002DC, 8521},
+	{0xC00002DE, 639},
+	{0xC00002E1, 8531},
+	{0xC00002E2, 8532},
+	{0xC00002E3, 8541},
+	{0xC00002E4, 8547},
+	{0xC00002E5, 8548},
+	{0xC00002E6, 8549},
+	{0xC00002E7, 8557},
+	{0xC00002E8, 640},
+	{0xC00002E9, 1399},
+	{0xC00002EA, 82},
+	{0xC00002EB, 641},
+	{0xC00002EC, 8561},
+	{0xC00002ED, 8562},
+	{0xC00002FE, 1115},
+	{0xC00002FF, 1255},
+	{0xC0000300, 1254},
+	{0xC0000301, 4207},
+	{0xC0000302, 4212},
+	{0xC0000303, 4206},
+	{0xC000030C, 1938},
+	{0xC000030D, 1939},
+	{0xC0000320, 1263},
+	{0xC0000321, 1264},
+	{0xC0000350, 1256},
+	{0xC0000352, 6013},
+	{0xC0000353, 642},
+	{0xC0000354, 1284},
+	{0xC0000355, 643},
+	{0xC0000358, 8578},
+	{0xC000035D, 1259},
+	{0xC000035F, 646},
+	{0xC0000365, 647},
+	{0xC0000366, 648},
+	{0xC0000368, 649},
+	{0xC0000369, 650},
+	{0xC000036A, 651},
+	{0xC000036C, 1275},
+	{0xC000036D, 652},
+	{0xC000036E, 653},
+	{0xC000036F, 1276},
+	{0xC0000371, 8620},
+	{0xC0000372, 786},
+	{0xC0000388, 1265},
+	{0xC000038E, 654},
+	{0xC0000401, 1932},
+	{0xC0000402, 1933},
+	{0xC0000403, 1934},
+	{0xC0000404, 8571},
+	{0xC0000405, 8605},
+	{0xC0000406, 8607},
+	{0xC0000407, 655},
+	{0xC0000409, 1282},
+	{0xC0000410, 1283},
+	{0xC0000411, 656},
+	{0xC0000412, 1285},
+	{0xC0000413, 1935},
+	{0xC0000414, 1286},
+	{0xC0000416, 781},
+	{0xC0000417, 1288},
+	{0xC0000418, 1937},
+	{0xC0000419, 8539},
+	{0xC000041A, 8634},
+	{0xC000041B, 8635},
+	{0xC000041C, 8636},
+	{0xC0000420, 668},
+	{0xC0000421, 537},
+	{0xC0000423, 768},
+	{0xC0000426, 769},
+	{0xC0000427, 665},
+	{0xC0000428, 577},
+	{0xC0000429, 775},
+	{0xC000042A, 776},
+	{0xC000042B, 1292},
+	{0xC000042C, 740},
+	{0xC0000432, 1289},
+	{0xC0000441, 6017},
+	{0xC0000442, 6018},
+	{0xC0000443, 6019},
+	{0xC0000444, 6020},
+	{0xC0000445, 6021},
+	{0xC0000446, 1299},
+	{0xC0000450, 1291},
+	{0xC0000451, 15250},
+	{0xC0000452, 15299},
+	{0xC0000459, 3050},
+	{0xC0000460, 312},
+	{0xC0000461, 314},
+	{0xC0000462, 15612},
+	{0xC0000463, 316},
+	{0xC0000464, 321},
+	{0xC0000465, 315},
+	{0xC0000469, 15616},
+	{0xC000046A, 337},
+	{0xC000046B, 338},
+	{0xC000046C, 339},
+	{0xC000046D, 342},
+	{0xC000046E, 343},
+	{0xC000046F, 344},
+	{0xC0000470, 323},
+	{0xC0000471, 324},
+	{0xC0000475, 328},
+	{0xC0000476, 329},
+	{0xC0000478, 332},
+	{0xC0000479, 333},
+	{0xC000047A, 334},
+	{0xC000047B, 335},
+	{0xC000047C, 336},
+	{0xC000047E, 15623},
+	{0xC000047F, 15624},
+	{0xC0000483, 483},
+	{0xC0000486, 345},
+	{0xC0000488, 346},
+	{0xC0000489, 15631},
+	{0xC000048A, 810},
+	{0xC000048B, 812},
+	{0xC000048C, 347},
+	{0xC000048D, 348},
+	{0xC000048E, 354},
+	{0xC000048F, 349},
+	{0xC0000496, 355},
+	{0xC0000499, 359},
+	{0xC000049A, 360},
+	{0xC000049C, 361},
+	{0xC000049D, 367},
+	{0xC000049E, 368},
+	{0xC000049F, 1183},
+	{0xC00004A0, 1184},
+	{0xC00004A1, 399},
+	{0xC00004A2, 815},
+	{0xC00004A3, 406},
+	{0xC00004A4, 407},
+	{0xC00004A5, 408},
+	{0xC00004A6, 409},
+	{0xC00004A7, 410},
+	{0xC00004A8, 411},
+	{0xC00004A9, 412},
+	{0xC00004AA, 413},
+	{0xC00004AB, 415},
+	{0xC00004AD, 416},
+	{0xC00004AE, 417},
+	{0xC00004AF, 418},
+	{0xC00004B0, 419},
+	{0xC00004B1, 420},
+	{0xC00004B2, 421},
+	{0xC00004B3, 424},
+	{0xC00004B4, 425},
+	{0xC00004B5, 429},
+	{0xC00004B6, 430},
+	{0xC00004B7, 431},
+	{0xC00004B8, 432},
+	{0xC0000500, 1550},
+	{0xC0000501, 1551},
+	{0xC0000502, 1552},
+	{0xC0000504, 319},
+	{0xC0000505, 320},
+	{0xC0000506, 1471},
+	{0xC0000508, 1504},
+	{0xC0000509, 1505},
+	{0xC000050B, 4395},
+	{0xC000050E, 4444},
+	{0xC0000510, 1247},
+	{0xC0000511, 814},
+	{0xC0000513, 384},
+	{0xC0000514, 4445},
+	{0xC0000602, 1653},
+	{0xC0000604, 1655},
+	{0xC0000606, 1657},
+	{0xC000060A, 1660},
+	{0xC000060B, 1661},
+	{0xC0000702, 1002},
+	{0xC0000712, 1293},
+	{0xC0000713, 784},
+	{0xC0000715, 1463},
+	{0xC0000717, 1113},
+	{0xC0000718, 1242},
+	{0xC0000800, 780},
+	{0xC0000801, 8612},
+	{0xC0000802, 1295},
+	{0xC0000804, 1296},
+	{0xC0000805, 6849},
+	{0xC0000806, 6851},
+	{0xC0000808, 793},
+	{0xC0000809, 794},
+	{0xC000080A, 795},
+	{0xC000080B, 796},
+	{0xC000080C, 797},
+	{0xC000080D, 798},
+	{0xC000080E, 799},
+	{0xC0000810, 808},
+	{0xC0000901, 220},
+	{0xC0000902, 221},
+	{0xC0000903, 222},
+	{0xC0000904, 223},
+	{0xC0000905, 224},
+	{0xC0000906, 225},
+	{0xC0000907, 226},
+	{0xC0000908, 791},
+	{0xC0000909, 802},
+	{0xC0000910, 806},
+	{0xC0009898, 670},
+	{0xC000A004, 340},
+	{0xC000A005, 341},
+	{0xC000A006, 811},
+	{0xC000A080, 788},
+	{0xC000A081, 789},
+	{0xC000A082, 790},
+	{0xC000A083, 1465},
+	{0xC000A084, 1466},
+	{0xC000A085, 1468},
+	{0xC000A086, 1469},
+	{0xC000A087, 8637},
+	{0xC000A088, 8638},
+	{0xC000A089, 8646},
+	{0xC000A100, 15300},
+	{0xC000A101, 15301},
+	{0xC000A121, 15321},
+	{0xC000A122, 15322},
+	{0xC000A123, 15323},
+	{0xC000A124, 15324},
+	{0xC000A125, 15325},
+	{0xC000A126, 15326},
+	{0xC000A141, 15400},
+	{0xC000A142, 15401},
+	{0xC000A143, 15402},
+	{0xC000A145, 15403},
+	{0xC000A146, 15404},
+	{0xC000A200, 4250},
+	{0xC000A201, 4252},
+	{0xC000A202, 4253},
+	{0xC000A281, 4400},
+	{0xC000A282, 4401},
+	{0xC000A283, 4402},
+	{0xC000A284, 4403},
+	{0xC000A285, 4404},
+	{0xC000A2A1, 4440},
+	{0xC000A2A2, 4441},
+	{0xC000A2A3, 4442},
+	{0xC000A2A4, 4443},
+	{0xC000A2A5, 4446},
+	{0xC000A2A6, 4447},
+	{0xC000A2A7, 4448},
+	{0xC000CE01, 369},
+	{0xC000CE02, 370},
+	{0xC000CE03, 371},
+	{0xC000CE04, 372},
+	{0xC000CE05, 385},
+	{0xC000CF00, 358},
+	{0xC000CF01, 362},
+	{0xC000CF02, 363},
+	{0xC000CF03, 364},
+	{0xC000CF06, 375},
+	{0xC000CF07, 376},
+	{0xC000CF08, 377},
+	{0xC000CF09, 378},
+	{0xC000CF0A, 379},
+	{0xC000CF0B, 380},
+	{0xC000CF0C, 381},
+	{0xC000CF0D, 382},
+	{0xC000CF0E, 383},
+	{0xC000CF0F, 386},
+	{0xC000CF10, 387},
+	{0xC000CF11, 388},
+	{0xC000CF12, 389},
+	{0xC000CF13, 390},
+	{0xC000CF14, 391},
+	{0xC000CF15, 392},
+	{0xC000CF16, 393},
+	{0xC000CF17, 394},
+	{0xC000CF18, 395},
+	{0xC000CF19, 396},
+	{0xC000CF1A, 397},
+	{0xC000CF1B, 398},
+	{0xC000CF1D, 404},
+	{0xC000CF1E, 405},
+	{0xC000CF1F, 426},
+	{0xC0040035, 671},
+	{0xC0040036, 672},
+	{0xC0040037, 673},
+	{0xC0040038, 674},
+	{0xC0040039, 3950},
+	{0xC00A0001, 7001},
+	{0xC00A0002, 7002},
+	{0xC00A0003, 7003},
+	{0xC00A0006, 7007},
+	{0xC00A0007, 7008},
+	{0xC00A0008, 7009},
+	{0xC00A0009, 7010},
+	{0xC00A000B, 7012},
+	{0xC00A000C, 7013},
+	{0xC00A000D, 7014},
+	{0xC00A000E, 7015},
+	{0xC00A000F, 7016},
+	{0xC00A0010, 7017},
+	{0xC00A0012, 7055},
+	{0xC00A0013, 7054},
+	{0xC00A0014, 7056},
+	{0xC00A0015, 7022},
+	{0xC00A0017, 7024},
+	{0xC00A0018, 7025},
+	{0xC00A0022, 7035},
+	{0xC00A0024, 7038},
+	{0xC00A0026, 7040},
+	{0xC00A0027, 7041},
+	{0xC00A0028, 7042},
+	{0xC00A002A, 7044},
+	{0xC00A002B, 7045},
+	{0xC00A002E, 7049},
+	{0xC00A002F, 7004},
+	{0xC00A0030, 7050},
+	{0xC00A0031, 7051},
+	{0xC00A0032, 7065},
+	{0xC00A0033, 7053},
+	{0xC00A0034, 7052},
+	{0xC00A0035, 7058},
+	{0xC00A0036, 7057},
+	{0xC00A0037, 7037},
+	{0xC00A0038, 7068},
+	{0xC00A0039, 7069},
+	{0xC00A003A, 7070},
+	{0xC00B0001, 15100},
+	{0xC00B0002, 15101},
+	{0xC00B0003, 15102},
+	{0xC00B0004, 15103},
+	{0xC00B0005, 15104},
+	{0xC00B0006, 15105},
+	{0xC00B0007, 15106},
+	{0xC0130001, 5039},
+	{0xC0130002, 5040},
+	{0xC0130003, 5041},
+	{0xC0130004, 5042},
+	{0xC0130005, 5043},
+	{0xC0130006, 5044},
+	{0xC0130007, 5045},
+	{0xC0130008, 5046},
+	{0xC0130009, 5047},
+	{0xC013000A, 5048},
+	{0xC013000B, 5049},
+	{0xC013000C, 5050},
+	{0xC013000D, 5051},
+	{0xC013000E, 5052},
+	{0xC013000F, 5053},
+	{0xC0130010, 5054},
+	{0xC0130011, 5906},
+	{0xC0130012, 5056},
+	{0xC0130013, 5070},
+	{0xC0130014, 5058},
+	{0xC0130015, 5059},
+	{0xC0130016, 5060},
+	{0xC0130017, 5907},
+	{0xC0130029, 5989},
+	{0xC0130030, 5990},
+	{0xC0150001, 14000},
+	{0xC0150002, 14001},
+	{0xC0150003, 14002},
+	{0xC0150004, 14003},
+	{0xC0150005, 14004},
+	{0xC0150006, 14005},
+	{0xC0150007, 14006},
+	{0xC0150008, 14007},
+	{0xC0150009, 14008},
+	{0xC015000A, 14009},
+	{0xC015000B, 14010},
+	{0xC015000C, 14081},
+	{0xC015000E, 14011},
+	{0xC015000F, 14084},
+	{0xC0150010, 14085},
+	{0xC0150011, 14086},
+	{0xC0150012, 14089},
+	{0xC0150013, 14087},
+	{0xC0150014, 14082},
+	{0xC0150015, 14083},
+	{0xC0150016, 14090},
+	{0xC0150017, 14091},
+	{0xC0150018, 14092},
+	{0xC0150019, 14093},
+	{0xC015001A, 14098},
+	{0xC015001B, 14028},
+	{0xC015001C, 14101},
+	{0xC015001D, 14102},
+	{0xC015001E, 14103},
+	{0xC015001F, 14104},
+	{0xC0150020, 14099},
+	{0xC0150021, 14100},
+	{0xC0150022, 14105},
+	{0xC0150023, 14106},
+	{0xC0150024, 14107},
+	{0xC0150025, 14108},
+	{0xC0150026, 14109},
+	{0xC0150027, 14110},
+	{0xC0190001, 6800},
+	{0xC0190002, 6700},
+	{0xC0190003, 6701},
+	{0xC0190004, 6706},
+	{0xC0190005, 6801},
+	{0xC0190006, 6802},
+	{0xC0190007, 6708},
+	{0xC0190008, 6803},
+	{0xC019000A, 6805},
+	{0xC019000B, 6806},
+	{0xC019000C, 6814},
+	{0xC019000F, 6710},
+	{0xC0190010, 6711},
+	{0xC0190011, 6712},
+	{0xC0190012, 6709},
+	{0xC0190013, 6702},
+	{0xC0190014, 6703},
+	{0xC0190015, 6704},
+	{0xC0190016, 6705},
+	{0xC0190017, 6713},
+	{0xC0190018, 6714},
+	{0xC0190019, 6833},
+	{0xC0190021, 6807},
+	{0xC0190022, 6808},
+	{0xC0190023, 6809},
+	{0xC0190024, 6810},
+	{0xC0190025, 6811},
+	{0xC0190026, 6812},
+	{0xC0190028, 6815},
+	{0xC0190030, 6817},
+	{0xC0190032, 6819},
+	{0xC0190033, 6820},
+	{0xC0190036, 6823},
+	{0xC0190037, 6824},
+	{0xC0190038, 6825},
+	{0xC0190039, 6826},
+	{0xC019003A, 6827},
+	{0xC019003B, 6828},
+	{0xC019003C, 6829},
+	{0xC019003D, 6830},
+	{0xC019003E, 6831},
+	{0xC019003F, 6832},
+	{0xC0190040, 6834},
+	{0xC0190043, 6837},
+	{0xC0190044, 6838},
+	{0xC0190045, 6839},
+	{0xC0190046, 6840},
+	{0xC0190047, 6841},
+	{0xC0190048, 6842},
+	{0xC0190049, 6844},
+	{0xC019004A, 6845},
+	{0xC019004B, 6846},
+	{0xC019004C, 6847},
+	{0xC019004D, 6848},
+	{0xC019004E, 6715},
+	{0xC019004F, 6716},
+	{0xC0190050, 6717},
+	{0xC0190051, 6718},
+	{0xC0190052, 6719},
+	{0xC0190053, 6720},
+	{0xC0190054, 6721},
+	{0xC0190055, 6722},
+	{0xC0190056, 6850},
+	{0xC0190057, 6723},
+	{0xC0190058, 6724},
+	{0xC0190059, 6852},
+	{0xC019005A, 6853},
+	{0xC019005B, 6726},
+	{0xC019005C, 6727},
+	{0xC019005D, 6728},
+	{0xC019005E, 6729},
+	{0xC019005F, 6730},
+	{0xC0190060, 6854},
+	{0xC0190061, 6855},
+	{0xC01A0001, 6600},
+	{0xC01A0002, 6601},
+	{0xC01A0003, 6602},
+	{0xC01A0004, 6603},
+	{0xC01A0005, 6604},
+	{0xC01A0006, 6605},
+	{0xC01A0007, 6606},
+	{0xC01A0008, 6607},
+	{0xC01A0009, 6608},
+	{0xC01A000A, 6609},
+	{0xC01A000B, 6610},
+	{0xC01A000D, 6612},
+	{0xC01A000E, 6613},
+	{0xC01A000F, 6614},
+	{0xC01A0010, 6615},
+	{0xC01A0011, 6616},
+	{0xC01A0012, 6617},
+	{0xC01A0013, 6618},
+	{0xC01A0014, 6619},
+	{0xC01A0015, 6620},
+	{0xC01A0016, 6621},
+	{0xC01A0017, 6622},
+	{0xC01A0018, 6623},
+	{0xC01A0019, 6624},
+	{0xC01A001A, 6625},
+	{0xC01A001B, 6626},
+	{0xC01A001C, 6627},
+	{0xC01A001D, 6628},
+	{0xC01A001E, 6630},
+	{0xC01A001F, 6631},
+	{0xC01A0020, 6632},
+	{0xC01A0021, 6633},
+	{0xC01A0022, 6634},
+	{0xC01A0023, 6635},
+	{0xC01A0024, 6636},
+	{0xC01A0025, 6637},
+	{0xC01A0026, 6638},
+	{0xC01A0027, 6639},
+	{0xC01A0028, 6640},
+	{0xC01A0029, 6641},
+	{0xC01A002A, 6642},
+	{0xC01A002B, 6643},
+	{0xC01A002C, 6644},
+	{0xC01A002D, 6645},
+	{0xC01A002E, 6646},
+	{0xC01A002F, 6647},
+	{0xC01A0030, 6648},
+	{0xC0360001, 13910},
+	{0xC0360002, 13911},
+	{0xC0360003, 13912},
+	{0xC0360004, 13913},
+	{0xC0360005, 13914},
+	{0xC0360006, 13915},
+	{0xC0360007, 13916},
+	{0xC0360008, 13917},
+	{0xC0360009, 13918},
+	{0xC0368000, 13925},
+	{0xC0368001, 13926},
+	{0xC0368002, 13927},
+	{0xC0368003, 13928},
+	{0xC0368004, 13929},
+	{0xC0368005, 13930},
+	{0xC0368006, 13932},
+	{0xC0430001, 4420},
+	{0xC0430002, 4421},
+	{0xC0430003, 4422},
+	{0xC0430004, 4423},
+	{0xC0430005, 4424},
+	{0xC0430007, 4426},
+	{0xC0430008, 4427},
+	{0xC0430009, 4428},
+	{0xC043000A, 4429},
+	{0xC043000B, 4430},
+	{0xC043000C, 4431},
+	{0xC043000D, 4432},
+	{0xC043000E, 4433},
+	{0xC043000F, 4434},
+	{0xC0430010, 4435},
+	{0xC0450000, 4560},
+	{0xC0450001, 4561},
+	{0xC05D0002, 1272},
+	{0xC0E90001, 4550},
+	{0xC0E90002, 4551},
+	{0xC0E90003, 4552},
+	{0xC0E90004, 4553},
+	{0xC0E90005, 4554},
+	{0xC0E90006, 4555},
+	{0xC0EB0001, 4570},
+	{0xC0EB0002, 4571},
+	{0xC0EB0003, 4572},
+	{0xC0EB0004, 4573},
+	{0xC0EB0005, 4574},
+	{0xC0EB0006, 4575},
+	{0xC0EB0007, 4576},
+	{0xC0EC0000, 3060},
+	{0xC0EC0001, 3061},
+	{0xC0EC0002, 3062},
+	{0xC0EC0003, 3063},
+	{0xC0EC0004, 3064},
+	{0xC0EC0005, 3065},
+	{0xC0EC0006, 3066},
+	{0xC0EC0007, 3067},
+}
@@ -0,0 +1,589 @@
+// Code generated by tools/defines.go; DO NOT EDIT.
+
+package api
+
+// Constants from winhttp.h
+const (
+	WinhttpApiGetProxyForUrl                               uintptr = 6
+	WinhttpApiQueryDataAvailable                           uintptr = 2
+	WinhttpApiReadData                                     uintptr = 3
+	WinhttpApiReceiveResponse                              uintptr = 1
+	WinhttpApiSendRequest                                  uintptr = 5
+	WinhttpApiWriteData                                    uintptr = 4
+	WinhttpErrorWinhttpAutodetectionFailed                 uintptr = 12180
+	WinhttpErrorWinhttpAutoProxyServiceError               uintptr = 12178
+	WinhttpErrorWinhttpBadAutoProxyScript                  uintptr = 12166
+	WinhttpErrorWinhttpCannotCallAfterOpen                 uintptr = 12103
+	WinhttpErrorWinhttpCannotCallAfterSend                 uintptr = 12102
+	WinhttpErrorWinhttpCannotCallBeforeOpen                uintptr = 12100
+	WinhttpErrorWinhttpCannotCallBeforeSend                uintptr = 12101
+	WinhttpErrorWinhttpCannotConnect                       uintptr = 12029
+	WinhttpErrorWinhttpChunkedEncodingHeaderSizeOverflow   uintptr = 12183
+	WinhttpErrorWinhttpClientAuthCertNeeded                uintptr = 12044
+	WinhttpErrorWinhttpClientAuthCertNeededProxy           uintptr = 12187
+	WinhttpErrorWinhttpClientCertNoAccessPrivateKey        uintptr = 12186
+	WinhttpErrorWinhttpClientCertNoPrivateKey              uintptr = 12185
+	WinhttpErrorWinhttpConnectionError                     uintptr = 12030
+	WinhttpErrorWinhttpHeaderAlreadyExists                 uintptr = 12155
+	WinhttpErrorWinhttpHeaderCountExceeded                 uintptr = 12181
+	WinhttpErrorWinhttpHeaderNotFound                      uintptr = 12150
+	WinhttpErrorWinhttpHeaderSizeOverflow                  uintptr = 12182
+	WinhttpErrorWinhttpHTTPProtocolMismatch                uintptr = 12190
+	WinhttpErrorWinhttpIncorrectHandleState                uintptr = 12019
+	WinhttpErrorWinhttpIncorrectHandleType                 uintptr = 12018
+	WinhttpErrorWinhttpInternalError                       uintptr = 12004
+	WinhttpErrorWinhttpInvalidHeader                       uintptr = 12153
+	WinhttpErrorWinhttpInvalidOption                       uintptr = 12009
+	WinhttpErrorWinhttpInvalidQueryRequest                 uintptr = 12154
+	WinhttpErrorWinhttpInvalidServerResponse               uintptr = 12152
+	WinhttpErrorWinhttpInvalidUrl                          uintptr = 12005
+	WinhttpErrorWinhttpLoginFailure                        uintptr = 12015
+	WinhttpErrorWinhttpNameNotResolved                     uintptr = 12007
+	WinhttpErrorWinhttpNotInitialized                      uintptr = 12172
+	WinhttpErrorWinhttpOperationCancelled                  uintptr = 12017
+	WinhttpErrorWinhttpOptionNotSettable                   uintptr = 12011
+	WinhttpErrorWinhttpOutOfHandles                        uintptr = 12001
+	WinhttpErrorWinhttpRedirectFailed                      uintptr = 12156
+	WinhttpErrorWinhttpResendRequest                       uintptr = 12032
+	WinhttpErrorWinhttpReserved189                         uintptr = 12189
+	WinhttpErrorWinhttpResponseDrainOverflow               uintptr = 12184
+	WinhttpErrorWinhttpScriptExecutionError                uintptr = 12177
+	WinhttpErrorWinhttpSecureCertCnInvalid                 uintptr = 12038
+	WinhttpErrorWinhttpSecureCertDateInvalid               uintptr = 12037
+	WinhttpErrorWinhttpSecureCertRevFailed                 uintptr = 12057
+	WinhttpErrorWinhttpSecureCertRevoked                   uintptr = 12170
+	WinhttpErrorWinhttpSecureCertWrongUsage                uintptr = 12179
+	WinhttpErrorWinhttpSecureChannelError                  uintptr = 12157
+	WinhttpErrorWinhttpSecureFailure                       uintptr = 12175
+	WinhttpErrorWinhttpSecureFailureProxy                  uintptr = 12188
+	WinhttpErrorWinhttpSecureInvalidCa                     uintptr = 12045
+	WinhttpErrorWinhttpSecureInvalidCert                   uintptr = 12169
+	WinhttpErrorWinhttpShutdown                            uintptr = 12012
+	WinhttpErrorWinhttpTimeout                             uintptr = 12002
+	WinhttpErrorWinhttpUnableToDownloadScript              uintptr = 12167
+	WinhttpErrorWinhttpUnhandledScriptType                 uintptr = 12176
+	WinhttpErrorWinhttpUnrecognizedScheme                  uintptr = 12006
+	WinhttpHTTPStatusAccepted                              uintptr = 202
+	WinhttpHTTPStatusAmbiguous                             uintptr = 300
+	WinhttpHTTPStatusBadGateway                            uintptr = 502
+	WinhttpHTTPStatusBadMethod                             uintptr = 405
+	WinhttpHTTPStatusBadRequest                            uintptr = 400
+	WinhttpHTTPStatusConflict                              uintptr = 409
+	WinhttpHTTPStatusContinue                              uintptr = 100
+	WinhttpHTTPStatusCreated                               uintptr = 201
+	WinhttpHTTPStatusDenied                                uintptr = 401
+	WinhttpHTTPStatusFirst                                 uintptr = 100
+	WinhttpHTTPStatusForbidden                             uintptr = 403
+	WinhttpHTTPStatusGatewayTimeout                        uintptr = 504
+	WinhttpHTTPStatusGone                                  uintptr = 410
+	WinhttpHTTPStatusLast                                  uintptr = 505
+	WinhttpHTTPStatusLengthRequired                        uintptr = 411
+	WinhttpHTTPStatusMoved                                 uintptr = 301
+	WinhttpHTTPStatusNoContent                             uintptr = 204
+	WinhttpHTTPStatusNoneAcceptable                        uintptr = 406
+	WinhttpHTTPStatusNotFound                              uintptr = 404
+	WinhttpHTTPStatusNotModified                           uintptr = 304
+	WinhttpHTTPStatusNotSupported                          uintptr = 501
+	WinhttpHTTPStatusOk                                    uintptr = 200
+	WinhttpHTTPStatusPartial                               uintptr = 203
+	WinhttpHTTPStatusPartialContent                        uintptr = 206
+	WinhttpHTTPStatusPaymentReq                            uintptr = 402
+	WinhttpHTTPStatusPermanentRedirect                     uintptr = 308
+	WinhttpHTTPStatusPrecondFailed                         uintptr = 412
+	WinhttpHTTPStatusProxyAuthReq                          uintptr = 407
+	WinhttpHTTPStatusRedirect                              uintptr = 302
+	WinhttpHTTPStatusRedirectKeepVerb                      uintptr = 307
+	WinhttpHTTPStatusRedirectMethod                        uintptr = 303
+	WinhttpHTTPStatusRequestTimeout                        uintptr = 408
+	WinhttpHTTPStatusRequestTooLarge                       uintptr = 413
+	WinhttpHTTPStatusResetContent                          uintptr = 205
+	WinhttpHTTPStatusRetryWith                             uintptr = 449
+	WinhttpHTTPStatusServerError                           uintptr = 500
+	WinhttpHTTPStatusServiceUnavail                        uintptr = 503
+	WinhttpHTTPStatusSwitchProtocols                       uintptr = 101
+	WinhttpHTTPStatusUnsupportedMedia                      uintptr = 415
+	WinhttpHTTPStatusUriTooLong                            uintptr = 414
+	WinhttpHTTPStatusUseProxy                              uintptr = 305
+	WinhttpHTTPStatusVersionNotSup                         uintptr = 505
+	WinhttpHTTPStatusWebdavMultiStatus                     uintptr = 207
+	WinhttpIcuBrowserMode                                  uintptr = 0x02000000
+	WinhttpIcuDecode                                       uintptr = 0x10000000
+	WinhttpIcuEncodePercent                                uintptr = 0x00001000
+	WinhttpIcuEncodeSpacesOnly                             uintptr = 0x04000000
+	WinhttpIcuEscape                                       uintptr = 0x80000000
+	WinhttpIcuEscapeAuthority                              uintptr = 0x00002000
+	WinhttpIcuNoEncode                                     uintptr = 0x20000000
+	WinhttpIcuNoMeta                                       uintptr = 0x08000000
+	WinhttpIcuRejectUserpwd                                uintptr = 0x00004000
+	WinhttpInternetDefaultHTTPPort                         uintptr = 80
+	WinhttpInternetDefaultHTTPsPort                        uintptr = 443
+	WinhttpInternetDefaultPort                             uintptr = 0
+	WinhttpInternetSchemeFTP                               uintptr = 3
+	WinhttpInternetSchemeHTTP                              uintptr = 1
+	WinhttpInternetSchemeHTTPs                             uintptr = 2
+	WinhttpInternetSchemeSocks                             uintptr = 4
+	WinhttpNetworkingKeyBufsize                            uintptr = 128
+	WinhttpSecurityFlagIgnoreCertCnInvalid                 uintptr = 0x00001000
+	WinhttpSecurityFlagIgnoreCertDateInvalid               uintptr = 0x00002000
+	WinhttpSecurityFlagIgnoreCertWrongUsage                uintptr = 0x00000200
+	WinhttpSecurityFlagIgnoreUnknownCa                     uintptr = 0x00000100
+	WinhttpSecurityFlagSecure                              uintptr = 0x00000001
+	WinhttpSecurityFlagStrengthMedium                      uintptr = 0x40000000
+	WinhttpSecurityFlagStrengthStrong                      uintptr = 0x20000000
+	WinhttpSecurityFlagStrengthWeak                        uintptr = 0x10000000
+	WinhttpAccessTypeAutomaticProxy                        uintptr = 4
+	WinhttpAccessTypeDefaultProxy                          uintptr = 0
+	WinhttpAccessTypeNamedProxy                            uintptr = 3
+	WinhttpAccessTypeNoProxy                               uintptr = 1
+	WinhttpAddreqFlagAdd                                   uintptr = 0x20000000
+	WinhttpAddreqFlagAddIfNew                              uintptr = 0x10000000
+	WinhttpAddreqFlagCoalesce                              uintptr = 0x40000000
+	WinhttpAddreqFlagCoalesceWithComma                     uintptr = 0x40000000
+	WinhttpAddreqFlagCoalesceWithSemicolon                 uintptr = 0x01000000
+	WinhttpAddreqFlagReplace                               uintptr = 0x80000000
+	WinhttpAddreqFlagsMask                                 uintptr = 0xFFFF0000
+	WinhttpAddreqIndexMask                                 uintptr = 0x0000FFFF
+	WinhttpAuthSchemeBasic                                 uintptr = 0x00000001
+	WinhttpAuthSchemeDigest                                uintptr = 0x00000008
+	WinhttpAuthSchemeNegotiate                             uintptr = 0x00000010
+	WinhttpAuthSchemeNtlm                                  uintptr = 0x00000002
+	WinhttpAuthSchemePassport                              uintptr = 0x00000004
+	WinhttpAuthTargetProxy                                 uintptr = 0x00000001
+	WinhttpAuthTargetServer                                uintptr = 0x00000000
+	WinhttpAutoDetectTypeDhcp                              uintptr = 0x00000001
+	WinhttpAutoDetectTypeDnsA                              uintptr = 0x00000002
+	WinhttpAutologonSecurityLevelDefault                   uintptr = 0
+	WinhttpAutologonSecurityLevelHigh                      uintptr = 2
+	WinhttpAutologonSecurityLevelLow                       uintptr = 1
+	WinhttpAutologonSecurityLevelMedium                    uintptr = 0
+	WinhttpAutoproxyAllowAutoconfig                        uintptr = 0x00000100
+	WinhttpAutoproxyAllowCm                                uintptr = 0x00000400
+	WinhttpAutoproxyAllowStatic                            uintptr = 0x00000200
+	WinhttpAutoproxyAutoDetect                             uintptr = 0x00000001
+	WinhttpAutoproxyConfigUrl                              uintptr = 0x00000002
+	WinhttpAutoproxyHostKeepcase                           uintptr = 0x00000004
+	WinhttpAutoproxyHostLowercase                          uintptr = 0x00000008
+	WinhttpAutoproxyNoCacheClient                          uintptr = 0x00080000
+	WinhttpAutoproxyNoCacheSvc                             uintptr = 0x00100000
+	WinhttpAutoproxyNoDirectaccess                         uintptr = 0x00040000
+	WinhttpAutoproxyRunInprocess                           uintptr = 0x00010000
+	WinhttpAutoproxyRunOutprocessOnly                      uintptr = 0x00020000
+	WinhttpAutoproxySortResults                            uintptr = 0x00400000
+	WinhttpCallbackFlagAllCompletions                      uintptr = 0x17e0000
+	WinhttpCallbackFlagAllNotifications                    uintptr = 0xffffffff
+	WinhttpCallbackFlagCloseConnection                     uintptr = 0x300
+	WinhttpCallbackFlagConnectToServer                     uintptr = 0xc
+	WinhttpCallbackFlagDataAvailable                       uintptr = 0x00040000
+	WinhttpCallbackFlagDetectingProxy                      uintptr = 0x00001000
+	WinhttpCallbackFlagGetproxyforurlComplete              uintptr = 0x01000000
+	WinhttpCallbackFlagHandles                             uintptr = 0xc00
+	WinhttpCallbackFlagHeadersAvailable                    uintptr = 0x00020000
+	WinhttpCallbackFlagIntermediateResponse                uintptr = 0x00008000
+	WinhttpCallbackFlagReadComplete                        uintptr = 0x00080000
+	WinhttpCallbackFlagReceiveResponse                     uintptr = 0xc0
+	WinhttpCallbackFlagRedirect                            uintptr = 0x00004000
+	WinhttpCallbackFlagRequestError                        uintptr = 0x00200000
+	WinhttpCallbackFlagResolveName                         uintptr = 0x3
+	WinhttpCallbackFlagSecureFailure                       uintptr = 0x00010000
+	WinhttpCallbackFlagSendRequest                         uintptr = 0x30
+	WinhttpCallbackFlagSendrequestComplete                 uintptr = 0x00400000
+	WinhttpCallbackFlagWriteComplete                       uintptr = 0x00100000
+	WinhttpCallbackStatusCloseComplete                     uintptr = 0x02000000
+	WinhttpCallbackStatusClosingConnection                 uintptr = 0x00000100
+	WinhttpCallbackStatusConnectedToServer                 uintptr = 0x00000008
+	WinhttpCallbackStatusConnectingToServer                uintptr = 0x00000004
+	WinhttpCallbackStatusConnectionClosed                  uintptr = 0x00000200
+	WinhttpCallbackStatusDataAvailable                     uintptr = 0x00040000
+	WinhttpCallbackStatusDetectingProxy                    uintptr = 0x00001000
+	WinhttpCallbackStatusFlagCertCnInvalid                 uintptr = 0x00000010
+	WinhttpCallbackStatusFlagCertDateInvalid               uintptr = 0x00000020
+	WinhttpCallbackStatusFlagCertRevFailed                 uintptr = 0x00000001
+	WinhttpCallbackStatusFlagCertRevoked                   uintptr = 0x00000004
+	WinhttpCallbackStatusFlagCertWrongUsage                uintptr = 0x00000040
+	WinhttpCallbackStatusFlagInvalidCa                     uintptr = 0x00000008
+	WinhttpCallbackStatusFlagInvalidCert                   uintptr = 0x00000002
+	WinhttpCallbackStatusFlagSecurityChannelError          uintptr = 0x80000000
+	WinhttpCallbackStatusGetproxyforurlComplete            uintptr = 0x01000000
+	WinhttpCallbackStatusHandleClosing                     uintptr = 0x00000800
+	WinhttpCallbackStatusHandleCreated                     uintptr = 0x00000400
+	WinhttpCallbackStatusHeadersAvailable                  uintptr = 0x00020000
+	WinhttpCallbackStatusIntermediateResponse              uintptr = 0x00008000
+	WinhttpCallbackStatusNameResolved                      uintptr = 0x00000002
+	WinhttpCallbackStatusReadComplete                      uintptr = 0x00080000
+	WinhttpCallbackStatusReceivingResponse                 uintptr = 0x00000040
+	WinhttpCallbackStatusRedirect                          uintptr = 0x00004000
+	WinhttpCallbackStatusRequestError                      uintptr = 0x00200000
+	WinhttpCallbackStatusRequestSent                       uintptr = 0x00000020
+	WinhttpCallbackStatusResolvingName                     uintptr = 0x00000001
+	WinhttpCallbackStatusResponseReceived                  uintptr = 0x00000080
+	WinhttpCallbackStatusSecureFailure                     uintptr = 0x00010000
+	WinhttpCallbackStatusSendingRequest                    uintptr = 0x00000010
+	WinhttpCallbackStatusSendrequestComplete               uintptr = 0x00400000
+	WinhttpCallbackStatusSettingsReadComplete              uintptr = 0x20000000
+	WinhttpCallbackStatusSettingsWriteComplete             uintptr = 0x10000000
+	WinhttpCallbackStatusShutdownComplete                  uintptr = 0x04000000
+	WinhttpCallbackStatusWriteComplete                     uintptr = 0x00100000
+	WinhttpWinHTTPConnectFailureCount                      uintptr = 0
+	WinhttpWinHTTPConnectionAcquireEnd                     uintptr = 4
+	WinhttpWinHTTPConnectionAcquireStart                   uintptr = 2
+	WinhttpWinHTTPConnectionAcquireWaitEnd                 uintptr = 3
+	WinhttpWinHTTPConnectionEstablishmentEnd               uintptr = 8
+	WinhttpWinHTTPConnectionEstablishmentStart             uintptr = 7
+	WinhttpConnsPerServerUnlimited                         uintptr = 0xFFFFFFFF
+	WinhttpDecompressionFlagAll                            uintptr = 0x3
+	WinhttpDecompressionFlagDeflate                        uintptr = 0x00000002
+	WinhttpDecompressionFlagGzip                           uintptr = 0x00000001
+	WinhttpDefaultAcceptTypes                              uintptr = 0
+	WinhttpDisableAuthentication                           uintptr = 0x00000004
+	WinhttpDisableCookies                                  uintptr = 0x00000001
+	WinhttpDisableKeepAlive                                uintptr = 0x00000008
+	WinhttpDisablePassportAuth                             uintptr = 0x00000000
+	WinhttpDisablePassportKeyring                          uintptr = 0x20000000
+	WinhttpDisableRedirects                                uintptr = 0x00000002
+	WinhttpDisableSpnServerPort                            uintptr = 0x00000000
+	WinhttpEnablePassportAuth                              uintptr = 0x10000000
+	WinhttpEnablePassportKeyring                           uintptr = 0x40000000
+	WinhttpEnableSpnServerPort                             uintptr = 0x00000001
+	WinhttpEnableSslRevertImpersonation                    uintptr = 0x00000002
+	WinhttpEnableSslRevocation                             uintptr = 0x00000001
+	WinhttpErrorBase                                       uintptr = 12000
+	WinhttpErrorLast                                       uintptr = 12188
+	WinhttpFirstOption                                     uintptr = 1
+	WinhttpFlagAsync                                       uintptr = 0x10000000
+	WinhttpFlagBypassProxyCache                            uintptr = 0x00000100
+	WinhttpFlagEscapeDisable                               uintptr = 0x00000040
+	WinhttpFlagEscapeDisableQuery                          uintptr = 0x00000080
+	WinhttpFlagEscapePercent                               uintptr = 0x00000004
+	WinhttpFlagNullCodepage                                uintptr = 0x00000008
+	WinhttpFlagRefresh                                     uintptr = 0x00000100
+	WinhttpFlagSecure                                      uintptr = 0x00800000
+	WinhttpFlagSecureProtocolAll                           uintptr = 0xa8
+	WinhttpFlagSecureProtocolSsl2                          uintptr = 0x00000008
+	WinhttpFlagSecureProtocolSsl3                          uintptr = 0x00000020
+	WinhttpFlagSecureProtocolTls1                          uintptr = 0x00000080
+	WinhttpFlagSecureProtocolTls11                         uintptr = 0x00000200
+	WinhttpFlagSecureProtocolTls12                         uintptr = 0x00000800
+	WinhttpFlagSecureProtocolTls13                         uintptr = 0x00002000
+	WinhttpHandleTypeConnect                               uintptr = 2
+	WinhttpHandleTypeRequest                               uintptr = 3
+	WinhttpHandleTypeSession                               uintptr = 1
+	WinhttpHeaderNameByIndex                               uintptr = 0
+	WinhttpIgnoreRequestTotalLength                        uintptr = 0
+	WinhttpInvalidStatusCallback                           int     = -1
+	WinhttpLastOption                                      uintptr = 209
+	WinhttpWinHTTPNameResolutionEnd                        uintptr = 6
+	WinhttpWinHTTPNameResolutionStart                      uintptr = 5
+	WinhttpNoAdditionalHeaders                             uintptr = 0
+	WinhttpNoClientCertContext                             uintptr = 0
+	WinhttpNoHeaderIndex                                   uintptr = 0
+	WinhttpNoOutputBuffer                                  uintptr = 0
+	WinhttpNoProxyBypass                                   uintptr = 0
+	WinhttpNoProxyName                                     uintptr = 0
+	WinhttpNoReferer                                       uintptr = 0
+	WinhttpNoRequestData                                   uintptr = 0
+	WinhttpOptionAssuredNonBlockingCallbacks               uintptr = 111
+	WinhttpOptionAutologonPolicy                           uintptr = 77
+	WinhttpOptionBackgroundConnections                     uintptr = 172
+	WinhttpOptionCallback                                  uintptr = 1
+	WinhttpOptionClientCertContext                         uintptr = 47
+	WinhttpOptionClientCertIssuerList                      uintptr = 94
+	WinhttpOptionCodepage                                  uintptr = 68
+	WinhttpOptionConfigurePassportAuth                     uintptr = 83
+	WinhttpOptionConnectionFilter                          uintptr = 131
+	WinhttpOptionConnectionGuid                            uintptr = 178
+	WinhttpOptionConnectionInfo                            uintptr = 93
+	WinhttpOptionConnectionStatsV0                         uintptr = 141
+	WinhttpOptionConnectionStatsV1                         uintptr = 150
+	WinhttpOptionConnectionStatsV2                         uintptr = 208
+	WinhttpOptionConnectRetries                            uintptr = 4
+	WinhttpOptionConnectTimeout                            uintptr = 3
+	WinhttpOptionContextValue                              uintptr = 45
+	WinhttpOptionDecompression                             uintptr = 118
+	WinhttpOptionDisableCertChainBuilding                  uintptr = 171
+	WinhttpOptionDisableFeature                            uintptr = 63
+	WinhttpOptionDisableGlobalPooling                      uintptr = 195
+	WinhttpOptionDisableProxyAuthSchemes                   uintptr = 193
+	WinhttpOptionDisableSecureProtocolFallback             uintptr = 144
+	WinhttpOptionDisableStreamQueue                        uintptr = 139
+	WinhttpOptionEnableFastForwarding                      uintptr = 205
+	WinhttpOptionEnableFeature                             uintptr = 79
+	WinhttpOptionEnableHTTP2PlusClientCert                 uintptr = 161
+	WinhttpOptionEnableHTTPProtocol                        uintptr = 133
+	WinhttpOptionEnabletracing                             uintptr = 85
+	WinhttpOptionEncodeExtra                               uintptr = 138
+	WinhttpOptionErrorLogGuid                              uintptr = 204
+	WinhttpOptionExpireConnection                          uintptr = 143
+	WinhttpOptionExtendedError                             uintptr = 24
+	WinhttpOptionFailedConnectionRetries                   uintptr = 162
+	WinhttpOptionFastForwardingResponseData                uintptr = 206
+	WinhttpOptionFastForwardingResponseStatus              uintptr = 209
+	WinhttpOptionFeatureSupported                          uintptr = 184
+	WinhttpOptionFirstAvailableConnection                  uintptr = 173
+	WinhttpOptionGlobalProxyCreds                          uintptr = 97
+	WinhttpOptionGlobalServerCreds                         uintptr = 98
+	WinhttpOptionHandleType                                uintptr = 9
+	WinhttpOptionHTTP2Keepalive                            uintptr = 164
+	WinhttpOptionHTTP2PlusTransferEncoding                 uintptr = 169
+	WinhttpOptionHTTP2ReceiveWindow                        uintptr = 183
+	WinhttpOptionHTTP3HandshakeTimeout                     uintptr = 189
+	WinhttpOptionHTTP3InitialRtt                           uintptr = 190
+	WinhttpOptionHTTP3Keepalive                            uintptr = 188
+	WinhttpOptionHTTP3StreamErrorCode                      uintptr = 191
+	WinhttpOptionHTTPProtocolRequired                      uintptr = 145
+	WinhttpOptionHTTPProtocolUsed                          uintptr = 134
+	WinhttpOptionHTTPVersion                               uintptr = 59
+	WinhttpOptionIgnoreCertRevocationOffline               uintptr = 155
+	WinhttpOptionIpv6FastFallback                          uintptr = 140
+	WinhttpOptionIsProxyConnectResponse                    uintptr = 104
+	WinhttpOptionKdcProxySettings                          uintptr = 136
+	WinhttpOptionMatchConnectionGuid                       uintptr = 179
+	WinhttpOptionMaxConnsPer10Server                       uintptr = 74
+	WinhttpOptionMaxConnsPerServer                         uintptr = 73
+	WinhttpOptionMaxHTTPAutomaticRedirects                 uintptr = 89
+	WinhttpOptionMaxHTTPStatusContinue                     uintptr = 90
+	WinhttpOptionMaxResponseDrainSize                      uintptr = 92
+	WinhttpOptionMaxResponseHeaderSize                     uintptr = 91
+	WinhttpOptionParentHandle                              uintptr = 21
+	WinhttpOptionPassportCobrandingText                    uintptr = 81
+	WinhttpOptionPassportCobrandingUrl                     uintptr = 82
+	WinhttpOptionPassportReturnUrl                         uintptr = 87
+	WinhttpOptionPassportSignOut                           uintptr = 86
+	WinhttpOptionPassword                                  uintptr = 0x1001
+	WinhttpOptionProxy                                     uintptr = 38
+	WinhttpOptionProxyPassword                             uintptr = 0x1003
+	WinhttpOptionProxyResultEntry                          uintptr = 39
+	WinhttpOptionProxySpnUsed                              uintptr = 107
+	WinhttpOptionProxyUsername                             uintptr = 0x1002
+	WinhttpOptionQuicStats                                 uintptr = 185
+	WinhttpOptionQuicStatsV2                               uintptr = 200
+	WinhttpOptionQuicStreamStats                           uintptr = 202
+	WinhttpOptionReadBufferSize                            uintptr = 12
+	WinhttpOptionReceiveProxyConnectResponse               uintptr = 103
+	WinhttpOptionReceiveResponseTimeout                    uintptr = 7
+	WinhttpOptionReceiveTimeout                            uintptr = 6
+	WinhttpOptionRedirectPolicy                            uintptr = 88
+	WinhttpOptionRedirectPolicyAlways                      uintptr = 2
+	WinhttpOptionRedirectPolicyDefault                     uintptr = 1
+	WinhttpOptionRedirectPolicyDisallowHTTPsToHTTP         uintptr = 1
+	WinhttpOptionRedirectPolicyLast                        uintptr = 2
+	WinhttpOptionRedirectPolicyNever                       uintptr = 0
+	WinhttpOptionRefererTokenBindingHostname               uintptr = 168
+	WinhttpOptionRejectUserpwdInUrl                        uintptr = 100
+	WinhttpOptionRequestAnnotation                         uintptr = 192
+	WinhttpOptionRequestPriority                           uintptr = 58
+	WinhttpOptionRequestStats                              uintptr = 146
+	WinhttpOptionRequestTimes                              uintptr = 142
+	WinhttpOptionRequireStreamEnd                          uintptr = 160
+	WinhttpOptionResolutionHostname                        uintptr = 165
+	WinhttpOptionResolverCacheConfig                       uintptr = 170
+	WinhttpOptionResolveTimeout                            uintptr = 2
+	WinhttpOptionRevertImpersonationServerCert             uintptr = 194
+	WinhttpOptionSecureProtocols                           uintptr = 84
+	WinhttpOptionSecurityCertificateStruct                 uintptr = 32
+	WinhttpOptionSecurityFlags                             uintptr = 31
+	WinhttpOptionSecurityInfo                              uintptr = 151
+	WinhttpOptionSecurityKeyBitness                        uintptr = 36
+	WinhttpOptionSendTimeout                               uintptr = 5
+	WinhttpOptionServerCbt                                 uintptr = 108
+	WinhttpOptionServerCertChainContext                    uintptr = 147
+	WinhttpOptionServerCertContext                         uintptr = 78
+	WinhttpOptionServerSpnUsed                             uintptr = 106
+	WinhttpOptionSetTokenBinding                           uintptr = 166
+	WinhttpOptionSpn                                       uintptr = 96
+	WinhttpOptionSpnMask                                   uintptr = 0x00000001
+	WinhttpOptionStreamErrorCode                           uintptr = 159
+	WinhttpOptionTcpFalseStart                             uintptr = 154
+	WinhttpOptionTcpFastOpen                               uintptr = 153
+	WinhttpOptionTcpKeepalive                              uintptr = 152
+	WinhttpOptionTcpPriorityHint                           uintptr = 128
+	WinhttpOptionTcpPriorityStatus                         uintptr = 177
+	WinhttpOptionTlsProtocolInsecureFallback               uintptr = 158
+	WinhttpOptionTokenBindingPublicKey                     uintptr = 167
+	WinhttpOptionUnloadNotifyEvent                         uintptr = 99
+	WinhttpOptionUnsafeHeaderParsing                       uintptr = 110
+	WinhttpOptionUpgradeToProtocol                         uintptr = 207
+	WinhttpOptionUpgradeToWebSocket                        uintptr = 114
+	WinhttpOptionUrl                                       uintptr = 34
+	WinhttpOptionUseGlobalServerCredentials                uintptr = 101
+	WinhttpOptionUseLookaside                              uintptr = 203
+	WinhttpOptionUserAgent                                 uintptr = 41
+	WinhttpOptionUsername                                  uintptr = 0x1000
+	WinhttpOptionUseSessionSchCred                         uintptr = 196
+	WinhttpOptionWebSocketCloseTimeout                     uintptr = 115
+	WinhttpOptionWebSocketKeepaliveInterval                uintptr = 116
+	WinhttpOptionWebSocketReceiveBufferSize                uintptr = 122
+	WinhttpOptionWebSocketSendBufferSize                   uintptr = 123
+	WinhttpOptionWorkerThreadCount                         uintptr = 80
+	WinhttpOptionWriteBufferSize                           uintptr = 13
+	WinhttpProtocolFlagHTTP2                               uintptr = 0x1
+	WinhttpProtocolFlagHTTP3                               uintptr = 0x2
+	WinhttpProtocolMask                                    uintptr = 0x3
+	WinhttpWinHTTPProxyDetectionEnd                        uintptr = 1
+	WinhttpWinHTTPProxyDetectionStart                      uintptr = 0
+	WinhttpWinHTTPProxyFailureCount                        uintptr = 1
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg1End           uintptr = 31
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg1Size          uintptr = 12
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg1Start         uintptr = 30
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg2End           uintptr = 33
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg2Size          uintptr = 14
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg2Start         uintptr = 32
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg3End           uintptr = 35
+	WinhttpWinHTTPProxyTlsHandshakeClientLeg3Start         uintptr = 34
+	WinhttpWinHTTPProxyTlsHandshakeServerLeg1Size          uintptr = 13
+	WinhttpWinHTTPProxyTlsHandshakeServerLeg2Size          uintptr = 15
+	WinhttpWinHTTPProxyTunnelEnd                           uintptr = 29
+	WinhttpWinHTTPProxyTunnelStart                         uintptr = 28
+	WinhttpQueryAccept                                     uintptr = 24
+	WinhttpQueryAcceptCharset                              uintptr = 25
+	WinhttpQueryAcceptEncoding                             uintptr = 26
+	WinhttpQueryAcceptLanguage                             uintptr = 27
+	WinhttpQueryAcceptRanges                               uintptr = 42
+	WinhttpQueryAge                                        uintptr = 48
+	WinhttpQueryAllow                                      uintptr = 7
+	WinhttpQueryAuthenticationInfo                         uintptr = 76
+	WinhttpQueryAuthorization                              uintptr = 28
+	WinhttpQueryCacheControl                               uintptr = 49
+	WinhttpQueryConnection                                 uintptr = 23
+	WinhttpQueryContentBase                                uintptr = 50
+	WinhttpQueryContentDescription                         uintptr = 4
+	WinhttpQueryContentDisposition                         uintptr = 47
+	WinhttpQueryContentEncoding                            uintptr = 29
+	WinhttpQueryContentId                                  uintptr = 3
+	WinhttpQueryContentLanguage                            uintptr = 6
+	WinhttpQueryContentLength                              uintptr = 5
+	WinhttpQueryContentLocation                            uintptr = 51
+	WinhttpQueryContentMd5                                 uintptr = 52
+	WinhttpQueryContentRange                               uintptr = 53
+	WinhttpQueryContentTransferEncoding                    uintptr = 2
+	WinhttpQueryContentType                                uintptr = 1
+	WinhttpQueryCookie                                     uintptr = 44
+	WinhttpQueryCost                                       uintptr = 15
+	WinhttpQueryCustom                                     uintptr = 65535
+	WinhttpQueryDate                                       uintptr = 9
+	WinhttpQueryDerivedFrom                                uintptr = 14
+	WinhttpQueryEtag                                       uintptr = 54
+	WinhttpQueryExpect                                     uintptr = 68
+	WinhttpQueryExpires                                    uintptr = 10
+	WinhttpQueryFlagNumber                                 uintptr = 0x20000000
+	WinhttpQueryFlagNumber64                               uintptr = 0x08000000
+	WinhttpQueryFlagRequestHeaders                         uintptr = 0x80000000
+	WinhttpQueryFlagSystemtime                             uintptr = 0x40000000
+	WinhttpQueryFlagTrailers                               uintptr = 0x02000000
+	WinhttpQueryForwarded                                  uintptr = 30
+	WinhttpQueryFrom                                       uintptr = 31
+	WinhttpQueryHost                                       uintptr = 55
+	WinhttpQueryIfMatch                                    uintptr = 56
+	WinhttpQueryIfModifiedSince                            uintptr = 32
+	WinhttpQueryIfNoneMatch                                uintptr = 57
+	WinhttpQueryIfRange                                    uintptr = 58
+	WinhttpQueryIfUnmodifiedSince                          uintptr = 59
+	WinhttpQueryLastModified                               uintptr = 11
+	WinhttpQueryLink                                       uintptr = 16
+	WinhttpQueryLocation                                   uintptr = 33
+	WinhttpQueryMax                                        uintptr = 78
+	WinhttpQueryMaxForwards                                uintptr = 60
+	WinhttpQueryMessageId                                  uintptr = 12
+	WinhttpQueryMimeVersion                                uintptr = 0
+	WinhttpQueryOrigUri                                    uintptr = 34
+	WinhttpQueryPassportConfig                             uintptr = 78
+	WinhttpQueryPassportUrls                               uintptr = 77
+	WinhttpQueryPragma                                     uintptr = 17
+	WinhttpQueryProxyAuthenticate                          uintptr = 41
+	WinhttpQueryProxyAuthorization                         uintptr = 61
+	WinhttpQueryProxyConnection                            uintptr = 69
+	WinhttpQueryProxySupport                               uintptr = 75
+	WinhttpQueryPublic                                     uintptr = 8
+	WinhttpQueryRange                                      uintptr = 62
+	WinhttpQueryRawHeaders                                 uintptr = 21
+	WinhttpQueryRawHeadersCRLF                             uintptr = 22
+	WinhttpQueryReferer                                    uintptr = 35
+	WinhttpQueryRefresh                                    uintptr = 46
+	WinhttpQueryRequestMethod                              uintptr = 45
+	WinhttpQueryRetryAfter                                 uintptr = 36
+	WinhttpQueryServer                                     uintptr = 37
+	WinhttpQuerySetCookie                                  uintptr = 43
+	WinhttpQueryStatusCode                                 uintptr = 19
+	WinhttpQueryStatusText                                 uintptr = 20
+	WinhttpQueryTitle                                      uintptr = 38
+	WinhttpQueryTransferEncoding                           uintptr = 63
+	WinhttpQueryUnlessModifiedSince                        uintptr = 70
+	WinhttpQueryUpgrade                                    uintptr = 64
+	WinhttpQueryUri                                        uintptr = 13
+	WinhttpQueryUserAgent                                  uintptr = 39
+	WinhttpQueryVary                                       uintptr = 65
+	WinhttpQueryVersion                                    uintptr = 18
+	WinhttpQueryVia                                        uintptr = 66
+	WinhttpQueryWarning                                    uintptr = 67
+	WinhttpQueryWwwAuthenticate                            uintptr = 40
+	WinhttpWinHTTPReceiveResponseBodyDecompressionDelta    uintptr = 26
+	WinhttpWinHTTPReceiveResponseEnd                       uintptr = 27
+	WinhttpWinHTTPReceiveResponseHeadersDecompressionEnd   uintptr = 24
+	WinhttpWinHTTPReceiveResponseHeadersDecompressionStart uintptr = 23
+	WinhttpWinHTTPReceiveResponseHeadersEnd                uintptr = 25
+	WinhttpWinHTTPReceiveResponseStart                     uintptr = 22
+	WinhttpWinHTTPRequestHeadersCompressedSize             uintptr = 7
+	WinhttpWinHTTPRequestHeadersSize                       uintptr = 6
+	WinhttpRequestStatFlagFirstRequest                     uintptr = 0x00000020
+	WinhttpRequestStatFlagProxyTlsFalseStart               uintptr = 0x00000010
+	WinhttpRequestStatFlagProxyTlsSessionResumption        uintptr = 0x00000008
+	WinhttpRequestStatFlagTcpFastOpen                      uintptr = 0x00000001
+	WinhttpRequestStatFlagTlsFalseStart                    uintptr = 0x00000004
+	WinhttpRequestStatFlagTlsSessionResumption             uintptr = 0x00000002
+	WinhttpWinHTTPRequestStatLast                          uintptr = 16
+	WinhttpWinHTTPRequestStatMax                           uintptr = 32
+	WinhttpWinHTTPRequestTimeLast                          uintptr = 36
+	WinhttpWinHTTPRequestTimeMax                           uintptr = 64
+	WinhttpResetAll                                        uintptr = 0x0000FFFF
+	WinhttpResetDiscardResolvers                           uintptr = 0x00040000
+	WinhttpResetNotifyNetworkChanged                       uintptr = 0x00010000
+	WinhttpResetOutOfProc                                  uintptr = 0x00020000
+	WinhttpResetScriptCache                                uintptr = 0x00000008
+	WinhttpResetState                                      uintptr = 0x00000001
+	WinhttpResetSwpadAll                                   uintptr = 0x00000004
+	WinhttpResetSwpadCurrentNetwork                        uintptr = 0x00000002
+	WinhttpWinHTTPResponseBodyCompressedSize               uintptr = 11
+	WinhttpWinHTTPResponseBodySize                         uintptr = 10
+	WinhttpWinHTTPResponseHeadersCompressedSize            uintptr = 9
+	WinhttpWinHTTPResponseHeadersSize                      uintptr = 8
+	WinhttpWinHTTPSendRequestEnd                           uintptr = 21
+	WinhttpWinHTTPSendRequestHeadersCompressionEnd         uintptr = 19
+	WinhttpWinHTTPSendRequestHeadersCompressionStart       uintptr = 18
+	WinhttpWinHTTPSendRequestHeadersEnd                    uintptr = 20
+	WinhttpWinHTTPSendRequestStart                         uintptr = 17
+	WinhttpWinHTTPStreamWaitEnd                            uintptr = 16
+	WinhttpWinHTTPStreamWaitStart                          uintptr = 15
+	WinhttpTimeFormatBufsize                               uintptr = 62
+	WinhttpWinHTTPTlsHandshakeClientLeg1End                uintptr = 10
+	WinhttpWinHTTPTlsHandshakeClientLeg1Size               uintptr = 2
+	WinhttpWinHTTPTlsHandshakeClientLeg1Start              uintptr = 9
+	WinhttpWinHTTPTlsHandshakeClientLeg2End                uintptr = 12
+	WinhttpWinHTTPTlsHandshakeClientLeg2Size               uintptr = 4
+	WinhttpWinHTTPTlsHandshakeClientLeg2Start              uintptr = 11
+	WinhttpWinHTTPTlsHandshakeClientLeg3End                uintptr = 14
+	WinhttpWinHTTPTlsHandshakeClientLeg3Start              uintptr = 13
+	WinhttpWinHTTPTlsHandshakeServerLeg1Size               uintptr = 3
+	WinhttpWinHTTPTlsHandshakeServerLeg2Size               uintptr = 5
+	WinhttpWebSocketAbortedCloseStatus                     uintptr = 1006
+	WinhttpWebSocketBinaryFragmentBufferType               uintptr = 1
+	WinhttpWebSocketBinaryMessageBufferType                uintptr = 0
+	WinhttpWebSocketCloseBufferType                        uintptr = 4
+	WinhttpWebSocketCloseOperation                         uintptr = 2
+	WinhttpWebSocketEmptyCloseStatus                       uintptr = 1005
+	WinhttpWebSocketEndpointTerminatedCloseStatus          uintptr = 1001
+	WinhttpWebSocketInvalidDataTypeCloseStatus             uintptr = 1003
+	WinhttpWebSocketInvalidPayloadCloseStatus              uintptr = 1007
+	WinhttpWebSocketMaxCloseReasonLength                   uintptr = 123
+	WinhttpWebSocketMessageTooBigCloseStatus               uintptr = 1009
+	WinhttpWebSocketMinKeepaliveValue                      uintptr = 15000
+	WinhttpWebSocketPolicyViolationCloseStatus             uintptr = 1008
+	WinhttpWebSocketProtocolErrorCloseStatus               uintptr = 1002
+	WinhttpWebSocketReceiveOperation                       uintptr = 1
+	WinhttpWebSocketSecureHandshakeErrorCloseStatus        uintptr = 1015
+	WinhttpWebSocketSendOperation                          uintptr = 0
+	WinhttpWebSocketServerErrorCloseStatus                 uintptr = 1011
+	WinhttpWebSocketShutdownOperation                      uintptr = 3
+	WinhttpWebSocketSuccessCloseStatus                     uintptr = 1000
+	WinhttpWebSocketUnsupportedExtensionsCloseStatus       uintptr = 1010
+	WinhttpWebSocketUtf8FragmentBufferType                 uintptr = 3
+	WinhttpWebSocketUtf8MessageBufferType                  uintptr = 2
+)
@@ -3,12 +3,11 @@
 package api
 
 import (
-	"strings"
+	goerrors "errors"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
 
-	"github.com/mjwhitta/errors"
 	"github.com/mjwhitta/win/types"
 )
 
@@ -20,22 +19,8 @@ func CopyFile2(
 	dst string,
 	params CopyFile2ExtendedParameters,
 ) error {
-	var e error
-	var ok uintptr
-	var proc string = "CopyFile2"
-
-	params.dwSize = uint32(unsafe.Sizeof(params)) // Always 32
-
-	ok, _, e = kernel32.NewProc(proc).Call(
-		types.LpCwstr(src),
-		types.LpCwstr(dst),
-		uintptr(unsafe.Pointer(&params)),
-	)
-	if ok != 0 {
-		return errors.Newf("%s: %w", proc, e)
-	}
-
-	return nil
+	params.dwSize = uint32(unsafe.Sizeof(params))
+	return copyFile2(types.Cwstr(src), types.Cwstr(dst), &params)
 }
 
 // CreateToolhelp32Snapshot from tlhelp32.h
@@ -43,16 +28,15 @@ func CreateToolhelp32Snapshot(
 	dwFlags uintptr,
 	th32ProcessID uintptr,
 ) (windows.Handle, error) {
-	var e error
-	var hndl uintptr
-	var proc string = "CreateToolhelp32Snapshot"
-
-	hndl, _, e = kernel32.NewProc(proc).Call(dwFlags, th32ProcessID)
-	if hndl == 0 {
-		return 0, errors.Newf("%s: %s", proc, e.Error())
-	}
+	return createToolhelp32Snapshot(
+		uint32(dwFlags),
+		uint32(th32ProcessID),
+	)
+}
 
-	return windows.Handle(hndl), nil
+// GlobalFree from winbase.h
+func GlobalFree(hndl uintptr) error {
+	return globalFree(windows.Handle(hndl))
 }
 
 // HeapAlloc from heapapi.h
@@ -61,20 +45,11 @@ func HeapAlloc(
 	dwFlags uintptr,
 	dwBytes uintptr,
 ) (uintptr, error) {
-	var e error
-	var addr uintptr
-	var proc string = "HeapAlloc"
-
-	addr, _, e = kernel32.NewProc(proc).Call(
-		heapHndl,
-		dwFlags,
+	return heapAlloc(
+		windows.Handle(heapHndl),
+		uint32(dwFlags),
 		dwBytes,
 	)
-	if addr == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
-	}
-
-	return addr, nil
 }
 
 // HeapCreate from heapapi.h
@@ -84,54 +59,31 @@ func HeapCreate(
 	dwMaximumSize uintptr,
 ) (uintptr, error) {
 	var e error
-	var hndl uintptr
-	var proc string = "HeapCreate"
+	var hndl windows.Handle
 
-	hndl, _, e = kernel32.NewProc(proc).Call(
-		flOptions,
+	hndl, e = heapCreate(
+		uint32(flOptions),
 		dwInitialSize,
 		dwMaximumSize,
 	)
-	if hndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
-	}
 
-	return hndl, nil
+	return uintptr(hndl), e
 }
 
 // HeapDestroy from heapapi.h
 func HeapDestroy(hndl uintptr) error {
-	var e error
-	var ok uintptr
-	var proc string = "HeapDestroy"
-
-	if ok, _, e = kernel32.NewProc(proc).Call(hndl); ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
-	}
-
-	return nil
+	return heapDestroy(windows.Handle(hndl))
 }
 
 // HeapFree from heapapi.h
 func HeapFree(heapHndl uintptr, dwFlags uintptr, addr uintptr) error {
-	var e error
-	var ok uintptr
-	var proc string = "HeapFree"
-
-	ok, _, e = kernel32.NewProc(proc).Call(heapHndl, dwFlags, addr)
-	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
-	}
-
-	return nil
+	return heapFree(windows.Handle(heapHndl), uint32(dwFlags), addr)
 }
 
 // OutputDebugStringW will print a string that Dbgview.exe and
 // dbgview64.exe will display. Useful for debugging DLLs.
 func OutputDebugStringW(out string) {
-	var proc string = "OutputDebugStringW"
-
-	_, _, _ = kernel32.NewProc(proc).Call(types.LpCwstr(out))
+	outputDebugString(types.Cwstr(out))
 }
 
 // Process32First from tlhelp32.h
@@ -139,23 +91,17 @@ func Process32First(
 	snapHndl windows.Handle,
 ) (*ProcessEntry32, error) {
 	var e error
-	var ok uintptr
 	var pe ProcessEntry32
-	var proc string = "Process32First"
 
-	pe.dwSize = uint32(unsafe.Sizeof(pe)) // Always 304
+	pe.dwSize = uint32(unsafe.Sizeof(pe))
 
-	ok, _, e = kernel32.NewProc(proc).Call(
-		uintptr(snapHndl),
-		uintptr(unsafe.Pointer(&pe)),
-	)
-	if ok == 0 {
-		if strings.Contains(e.Error(), "There are no more files") {
+	if e = process32First(snapHndl, &pe); e != nil {
+		if goerrors.Is(e, windows.ERROR_NO_MORE_FILES) {
 			//nolint:nilnil // Not a real error, but we are done
 			return nil, nil
 		}
 
-		return nil, errors.Newf("%s: %s", proc, e.Error())
+		return nil, e
 	}
 
 	return &pe, nil
@@ -164,23 +110,17 @@ func Process32First(
 // Process32Next from tlhelp32.h
 func Process32Next(snapHndl windows.Handle) (*ProcessEntry32, error) {
 	var e error
-	var ok uintptr
 	var pe ProcessEntry32
-	var proc string = "Process32Next"
 
-	pe.dwSize = uint32(unsafe.Sizeof(pe)) // Always 304
+	pe.dwSize = uint32(unsafe.Sizeof(pe))
 
-	ok, _, e = kernel32.NewProc(proc).Call(
-		uintptr(snapHndl),
-		uintptr(unsafe.Pointer(&pe)),
-	)
-	if ok == 0 {
-		if strings.Contains(e.Error(), "There are no more files") {
+	if e = process32Next(snapHndl, &pe); e != nil {
+		if goerrors.Is(e, windows.ERROR_NO_MORE_FILES) {
 			//nolint:nilnil // Not a real error, but we are done
 			return nil, nil
 		}
 
-		return nil, errors.Newf("%s: %s", proc, e.Error())
+		return nil, e
 	}
 
 	return &pe, nil
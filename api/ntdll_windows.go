@@ -3,7 +3,6 @@
 package api
 
 import (
-	"fmt"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
@@ -11,6 +10,29 @@ import (
 	"github.com/mjwhitta/errors"
 )
 
+// The procs themselves are generated from tools/syscalls.json into
+// generated_syscalls_windows.go. Pointers passed to them follow the
+// unsafe.Pointer rules for system calls:
+//
+//   - The generated wrappers take typed Go pointers and convert them
+//     with uintptr(unsafe.Pointer(x)) in the argument list of Call()
+//     itself. Call() is marked go:uintptrescapes, so the pointee is
+//     moved to the heap and kept alive until the call returns. A
+//     uintptr stored in a variable first is just a number, so the GC
+//     may move or free it before the kernel writes to it.
+//   - Out params must be the same size as the C type, such as
+//     uintptr for SIZE_T and ULONG_PTR, and int64 for LARGE_INTEGER,
+//     or the kernel will read or write past the Go value on 32-bit.
+//   - Only read outputs after Call() returns, from the variable
+//     whose address was passed, never from a copy.
+//
+// Failures are returned as an NTStatus, wrapped with the name of the
+// proc, so they can be compared with errors.Is(), such as:
+//
+//	if errors.Is(e, w32.StatusAccessDenied) {
+//		// Handle access denied
+//	}
+
 type clientID struct {
 	UniqueProcess uintptr
 	UniqueThread  uintptr
@@ -35,28 +57,25 @@ func NtAllocateVirtualMemory(
 	protection uintptr,
 ) (uintptr, error) {
 	var addr uintptr
-	var err uintptr
-	var proc string = "NtAllocateVirtualMemory"
+	var e error
+	var regionSize uintptr = uintptr(size) // SIZE_T, in and out
 
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(pHndl),
-		uintptr(unsafe.Pointer(&addr)),
+	e = ntAllocateVirtualMemory(
+		pHndl,
+		&addr,
 		0,
-		uintptr(unsafe.Pointer(&size)),
-		allocType,
-		protection,
+		&regionSize,
+		uint32(allocType),
+		uint32(protection),
 	)
-	if err != 0 {
-		return 0, errors.Newf("%s returned %0x", proc, uint32(err))
+	if e != nil {
+		return 0, e
 	} else if addr == 0 {
-		return 0, errors.Newf("%s failed for unknown reason", proc)
+		return 0, errors.New(
+			"NtAllocateVirtualMemory returned no address",
+		)
 	}
 
-	// WTF?! Why is a Printf needed?! time.Sleep() doesn't work?
-	// Printf("") doesn't work? Oh well, print newline and escape
-	// sequence for "go up 1 line"
-	fmt.Printf("\n\x1b[1A")
-
 	return addr, nil
 }
 
@@ -68,22 +87,22 @@ func NtCreateSection(
 	pagePerms uintptr,
 	secPerms uintptr,
 ) error {
-	var err uintptr
-	var proc string = "NtCreateSection"
-
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(unsafe.Pointer(sHndl)),
-		access,
-		0,
-		uintptr(unsafe.Pointer(&size)),
-		pagePerms,
-		secPerms,
+	var e error
+	var maxSize int64 = int64(size) // LARGE_INTEGER
+
+	e = ntCreateSection(
+		sHndl,
+		uint32(access),
+		nil,
+		&maxSize,
+		uint32(pagePerms),
+		uint32(secPerms),
 		0,
 	)
-	if err != 0 {
-		return errors.Newf("%s returned %0x", proc, uint32(err))
+	if e != nil {
+		return e
 	} else if *sHndl == 0 {
-		return errors.Newf("%s failed for unknown reason", proc)
+		return errors.New("NtCreateSection returned no handle")
 	}
 
 	return nil
@@ -95,20 +114,19 @@ func NtCreateThreadEx(
 	addr uintptr,
 	sspnd bool,
 ) (windows.Handle, error) {
-	var err uintptr
-	var proc string = "NtCreateThreadEx"
-	var suspend uintptr
+	var e error
+	var suspend uint32
 	var tHndl windows.Handle
 
 	if sspnd {
 		suspend = 1
 	}
 
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(unsafe.Pointer(&tHndl)),
-		Winnt.ThreadAllAccess,
-		0,
-		uintptr(pHndl),
+	e = ntCreateThreadEx(
+		&tHndl,
+		uint32(WinntThreadAllAccess),
+		nil,
+		pHndl,
 		addr,
 		0,
 		suspend,
@@ -117,10 +135,10 @@ func NtCreateThreadEx(
 		0,
 		0,
 	)
-	if err != 0 {
-		return 0, errors.Newf("%s returned %0x", proc, uint32(err))
+	if e != nil {
+		return 0, e
 	} else if tHndl == 0 {
-		return 0, errors.Newf("%s failed for unknown reason", proc)
+		return 0, errors.New("NtCreateThreadEx returned no handle")
 	}
 
 	return tHndl, nil
@@ -134,27 +152,27 @@ func NtMapViewOfSection(
 	inheritPerms uintptr,
 	pagePerms uintptr,
 ) (uintptr, error) {
-	var err uintptr
-	var proc string = "NtMapViewOfSection"
+	var e error
 	var scBase uintptr
-	var scOffset uintptr
+	var scOffset int64                   // LARGE_INTEGER
+	var viewSize uintptr = uintptr(size) // SIZE_T, in and out
 
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(sHndl),
-		uintptr(pHndl),
-		uintptr(unsafe.Pointer(&scBase)),
+	e = ntMapViewOfSection(
+		sHndl,
+		pHndl,
+		&scBase,
 		0,
 		0,
-		uintptr(unsafe.Pointer(&scOffset)),
-		uintptr(unsafe.Pointer(&size)),
-		inheritPerms,
+		&scOffset,
+		&viewSize,
+		uint32(inheritPerms),
 		0,
-		pagePerms,
+		uint32(pagePerms),
 	)
-	if err != 0 {
-		return 0, errors.Newf("%s returned %0x", proc, uint32(err))
+	if e != nil {
+		return 0, e
 	} else if scBase == 0 {
-		return 0, errors.Newf("%s failed for unknown reason", proc)
+		return 0, errors.New("NtMapViewOfSection returned no address")
 	}
 
 	return scBase, nil
@@ -165,23 +183,21 @@ func NtOpenProcess(
 	pid uint32,
 	access uintptr,
 ) (windows.Handle, error) {
-	var err uintptr
+	var attrs objectAttrs
+	var cid clientID = clientID{UniqueProcess: uintptr(pid)}
+	var e error
 	var pHndl windows.Handle
-	var proc string = "NtOpenProcess"
+
+	attrs.Length = uintptr(unsafe.Sizeof(attrs))
 
 	//nolint:godox // I'll address the TODO's later
 	// TODO allow objectAttrs to be passed in
 	// TODO allow clientID to be passed in
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(unsafe.Pointer(&pHndl)),
-		access,
-		uintptr(unsafe.Pointer(&objectAttrs{0, 0, 0, 0, 0, 0})),
-		uintptr(unsafe.Pointer(&clientID{uintptr(pid), 0})),
-	)
-	if err != 0 {
-		return 0, errors.Newf("%s returned %0x", proc, uint32(err))
+	e = ntOpenProcess(&pHndl, uint32(access), &attrs, &cid)
+	if e != nil {
+		return 0, e
 	} else if pHndl == 0 {
-		return 0, errors.Newf("%s failed for unknown reason", proc)
+		return 0, errors.New("NtOpenProcess returned no handle")
 	}
 
 	return pHndl, nil
@@ -192,21 +208,13 @@ func NtQueueApcThread(
 	tHndl windows.Handle,
 	apcRoutine uintptr,
 ) error {
-	var err uintptr
-	var proc string = "NtQueueApcThread"
-
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(tHndl),
+	return ntQueueApcThread(
+		tHndl,
 		apcRoutine,
 		0, // arg1
 		0, // arg2
 		0, // arg3
 	)
-	if err != 0 {
-		return errors.Newf("%s returned %0x", proc, uint32(err))
-	}
-
-	return nil
 }
 
 // NtQueueApcThreadEx from ntdll.
@@ -214,38 +222,19 @@ func NtQueueApcThreadEx(
 	tHndl windows.Handle,
 	apcRoutine uintptr,
 ) error {
-	var err uintptr
-	var proc string = "NtQueueApcThreadEx"
-
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(tHndl),
+	return ntQueueApcThreadEx(
+		tHndl,
 		0x1, //nolint:mnd // userApcReservedHandle
 		apcRoutine,
 		0, // arg1
 		0, // arg2
 		0, // arg3
 	)
-	if err != 0 {
-		return errors.Newf("%s returned %0x", proc, uint32(err))
-	}
-
-	return nil
 }
 
 // NtResumeThread from ntdll.
 func NtResumeThread(tHndl windows.Handle) error {
-	var err uintptr
-	var proc string = "NtResumeThread"
-
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(tHndl),
-		0, // previousSuspendCount
-	)
-	if err != 0 {
-		return errors.Newf("%s returned %0x", proc, uint32(err))
-	}
-
-	return nil
+	return ntResumeThread(tHndl, nil) // previousSuspendCount
 }
 
 // NtWriteVirtualMemory from ntdll.
@@ -254,20 +243,13 @@ func NtWriteVirtualMemory(
 	dst uintptr,
 	b []byte,
 ) error {
-	var err uintptr
-	var proc string = "NtWriteVirtualMemory"
-
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(pHndl),
+	return ntWriteVirtualMemory(
+		pHndl,
 		dst,
-		uintptr(unsafe.Pointer(&b[0])),
+		&b[0],
 		uintptr(len(b)),
+		nil, // numberOfBytesWritten
 	)
-	if err != 0 {
-		return errors.Newf("%s returned %0x", proc, uint32(err))
-	}
-
-	return nil
 }
 
 // RtlCreateUserThread from ntdll.
@@ -276,17 +258,16 @@ func RtlCreateUserThread(
 	addr uintptr,
 	sspnd bool,
 ) (windows.Handle, error) {
-	var err uintptr
-	var proc string = "RtlCreateUserThread"
-	var suspend uintptr
+	var e error
+	var suspend uint8
 	var tHndl windows.Handle
 
 	if sspnd {
 		suspend = 1
 	}
 
-	err, _, _ = ntdll.NewProc(proc).Call(
-		uintptr(pHndl),
+	e = rtlCreateUserThread(
+		pHndl,
 		0,
 		suspend,
 		0,
@@ -294,13 +275,13 @@ func RtlCreateUserThread(
 		0,
 		addr,
 		0,
-		uintptr(unsafe.Pointer(&tHndl)),
-		0,
+		&tHndl,
+		nil,
 	)
-	if err != 0 {
-		return 0, errors.Newf("%s returned %0x", proc, uint32(err))
+	if e != nil {
+		return 0, e
 	} else if tHndl == 0 {
-		return 0, errors.Newf("%s failed for unknown reason", proc)
+		return 0, errors.New("RtlCreateUserThread returned no handle")
 	}
 
 	return tHndl, nil
@@ -9,7 +9,37 @@ import (
 	"github.com/mjwhitta/win/types"
 )
 
-var user32 *windows.LazyDLL = windows.NewLazySystemDLL("user32")
+var (
+	user32 *windows.LazyDLL = windows.NewLazySystemDLL("user32")
+
+	procCloseWindowStation    = user32.NewProc("CloseWindowStation")
+	procCreateDesktopW        = user32.NewProc("CreateDesktopW")
+	procEnumDesktopsW         = user32.NewProc("EnumDesktopsW")
+	procEnumWindowStationsW   = user32.NewProc("EnumWindowStationsW")
+	procOpenWindowStationW    = user32.NewProc("OpenWindowStationW")
+	procSwitchDesktop         = user32.NewProc("SwitchDesktop")
+	procSystemParametersInfoA = user32.NewProc(
+		"SystemParametersInfoA",
+	)
+)
+
+// CloseWindowStation from winuser.h
+func CloseWindowStation(station windows.Handle) error {
+	var e error
+	var proc *windows.LazyProc = procCloseWindowStation
+	var success uintptr
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(uintptr(station))
+	if success == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
 
 // CreateDesktopW from winuser.h
 func CreateDesktopW(
@@ -19,9 +49,13 @@ func CreateDesktopW(
 ) (windows.Handle, error) {
 	var desktop uintptr
 	var e error
-	var proc string = "CreateDesktopW"
+	var proc *windows.LazyProc = procCreateDesktopW
 
-	desktop, _, e = user32.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	desktop, _, e = proc.Call(
 		types.LpCwstr(name),
 		0,
 		0,
@@ -30,59 +64,139 @@ func CreateDesktopW(
 		0,
 	)
 	if desktop == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return windows.Handle(desktop), nil
 }
 
-// EnumWindowStationsW from winuser.h
-//
-// This function accepts an enumeration function, which must be
-// declared in C with cgo, but can optionally be defined in Go.
-//
-// To declare your callback function in C with cgo:
-//
-//	// unsigned char cb(short unsigned int*, long long unsigned int);
-//	import "C"
-//
-// To optionally define in Go (otherwise define in C above):
-//
-//	//export cb
-//	func cb(name *uint16, params uintptr) bool {
-//		println(windows.UTF16PtrToString(name))
-//		return true
-//	}
-//
-// To call this function in Go:
+// EnumDesktops will return the names of the desktops in a window
+// station, such as "WinSta0".
+func EnumDesktops(station string) ([]string, error) {
+	var e error
+	var hndl windows.Handle
+
+	hndl, e = OpenWindowStationW(
+		station,
+		false,
+		WinuserWinstaEnumdesktops,
+	)
+	if e != nil {
+		return nil, e
+	}
+	defer func() {
+		_ = CloseWindowStation(hndl)
+	}()
+
+	return enumNames(
+		func(h uintptr) error {
+			return EnumDesktopsW(hndl, enumNameCallback, h)
+		},
+	)
+}
+
+// EnumDesktopsW from winuser.h
 //
-//	func main() {
-//		_ = w32.EnumWindowStationsW(uintptr(unsafe.Pointer(C.cb)), 0)
-//	}
+// This function accepts an enumeration function, which can be
+// created with windows.NewCallback(). EnumDesktops() is simpler to
+// use.
+func EnumDesktopsW(
+	station windows.Handle,
+	enumFunc uintptr,
+	params uintptr,
+) error {
+	var e error
+	var proc *windows.LazyProc = procEnumDesktopsW
+	var success uintptr
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(uintptr(station), enumFunc, params)
+	if success == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// EnumWindowStations will return the names of the window stations
+// in the current session.
+func EnumWindowStations() ([]string, error) {
+	return enumNames(
+		func(h uintptr) error {
+			return EnumWindowStationsW(enumNameCallback, h)
+		},
+	)
+}
+
+// EnumWindowStationsW from winuser.h
 //
-//nolint:dupword,lll // C being C, go doc stuff uses tabs
+// This function accepts an enumeration function, which can be
+// created with windows.NewCallback(). EnumWindowStations() is
+// simpler to use.
 func EnumWindowStationsW(enumFunc uintptr, params uintptr) error {
 	var e error
-	var proc string = "EnumWindowStationsW"
+	var proc *windows.LazyProc = procEnumWindowStationsW
 	var success uintptr
 
-	success, _, _ = user32.NewProc(proc).Call(enumFunc, params)
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(enumFunc, params)
 	if success == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
 }
 
+// OpenWindowStationW from winuser.h
+func OpenWindowStationW(
+	name string,
+	inherit bool,
+	access uintptr,
+) (windows.Handle, error) {
+	var e error
+	var inheritHndl uintptr
+	var proc *windows.LazyProc = procOpenWindowStationW
+	var station uintptr
+
+	if inherit {
+		inheritHndl = 1
+	}
+
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	station, _, e = proc.Call(
+		types.LpCwstr(name),
+		inheritHndl,
+		access,
+	)
+	if station == 0 {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return windows.Handle(station), nil
+}
+
 // SwitchDesktop from winuser.h
 func SwitchDesktop(desktop windows.Handle) error {
 	var e error
-	var proc string = "SwitchDesktop"
+	var proc *windows.LazyProc = procSwitchDesktop
 	var success uintptr
 
-	success, _, e = user32.NewProc(proc).Call(uintptr(desktop))
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(uintptr(desktop))
 	if success == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -96,17 +210,21 @@ func SystemParametersInfoA(
 	fWinIni uintptr,
 ) error {
 	var e error
-	var proc string = "SystemParametersInfoA"
+	var proc *windows.LazyProc = procSystemParametersInfoA
 	var success uintptr
 
-	success, _, e = user32.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	success, _, e = proc.Call(
 		uiAction,
 		uiParam,
 		pvParam,
 		fWinIni,
 	)
 	if success == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
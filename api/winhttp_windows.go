@@ -12,7 +12,50 @@ import (
 	"github.com/mjwhitta/win/types"
 )
 
-var winhttp *windows.LazyDLL = windows.NewLazySystemDLL("Winhttp")
+var (
+	winhttp *windows.LazyDLL = windows.NewLazySystemDLL("Winhttp")
+
+	procWinHttpAddRequestHeaders = winhttp.NewProc(
+		"WinHttpAddRequestHeaders",
+	)
+	procWinHttpCloseHandle = winhttp.NewProc(
+		"WinHttpCloseHandle",
+	)
+	procWinHttpConnect = winhttp.NewProc(
+		"WinHttpConnect",
+	)
+	procWinHttpDetectAutoProxyConfigUrl = winhttp.NewProc(
+		"WinHttpDetectAutoProxyConfigUrl",
+	)
+	procWinHttpGetIEProxyConfigForCurrentUser = winhttp.NewProc(
+		"WinHttpGetIEProxyConfigForCurrentUser",
+	)
+	procWinHttpGetProxyForUrl = winhttp.NewProc(
+		"WinHttpGetProxyForUrl",
+	)
+	procWinHttpOpen        = winhttp.NewProc("WinHttpOpen")
+	procWinHttpOpenRequest = winhttp.NewProc(
+		"WinHttpOpenRequest",
+	)
+	procWinHttpQueryDataAvailable = winhttp.NewProc(
+		"WinHttpQueryDataAvailable",
+	)
+	procWinHttpQueryHeaders = winhttp.NewProc(
+		"WinHttpQueryHeaders",
+	)
+	procWinHttpReadData        = winhttp.NewProc("WinHttpReadData")
+	procWinHttpReceiveResponse = winhttp.NewProc(
+		"WinHttpReceiveResponse",
+	)
+	procWinHttpSendRequest = winhttp.NewProc(
+		"WinHttpSendRequest",
+	)
+	procWinHttpSetOption         = winhttp.NewProc("WinHttpSetOption")
+	procWinHttpSetStatusCallback = winhttp.NewProc(
+		"WinHttpSetStatusCallback",
+	)
+	procWinHttpWriteData = winhttp.NewProc("WinHttpWriteData")
+)
 
 // WinHTTPAddRequestHeaders is WinHttpAddRequestHeaders from winhttp.h
 func WinHTTPAddRequestHeaders(
@@ -22,7 +65,7 @@ func WinHTTPAddRequestHeaders(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpAddRequestHeaders"
+	var proc *windows.LazyProc = procWinHttpAddRequestHeaders
 
 	if header == "" {
 		// Weird, just do nothing
@@ -31,14 +74,18 @@ func WinHTTPAddRequestHeaders(
 
 	header = strings.TrimSpace(header) + "\r\n"
 
-	ok, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		types.LpCwstr(header),
 		uintptr(len(header)),
 		addMethod,
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -48,10 +95,14 @@ func WinHTTPAddRequestHeaders(
 func WinHTTPCloseHandle(reqHndl uintptr) error {
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpCloseHandle"
+	var proc *windows.LazyProc = procWinHttpCloseHandle
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
 
-	if ok, _, e = winhttp.NewProc(proc).Call(reqHndl); ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+	if ok, _, e = proc.Call(reqHndl); ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -65,21 +116,105 @@ func WinHTTPConnect(
 ) (uintptr, error) {
 	var connHndl uintptr
 	var e error
-	var proc string = "WinHttpConnect"
+	var proc *windows.LazyProc = procWinHttpConnect
 
-	connHndl, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	connHndl, _, e = proc.Call(
 		sessionHndl,
 		types.LpCwstr(serverName),
 		uintptr(serverPort),
 		0,
 	)
 	if connHndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return connHndl, nil
 }
 
+// WinHTTPDetectAutoProxyConfigURL is WinHttpDetectAutoProxyConfigUrl
+// from winhttp.h
+func WinHTTPDetectAutoProxyConfigURL(flags uintptr) (string, error) {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procWinHttpDetectAutoProxyConfigUrl
+	var url *uint16
+
+	if e = proc.Find(); e != nil {
+		return "", errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		flags,
+		uintptr(unsafe.Pointer(&url)),
+	)
+	if ok == 0 {
+		return "", errors.Newf("%s: %w", proc.Name, e)
+	}
+	defer freeString(&url)
+
+	return windows.UTF16PtrToString(url), nil
+}
+
+// WinHTTPGetIEProxyConfigForCurrentUser is
+// WinHttpGetIEProxyConfigForCurrentUser from winhttp.h. The returned
+// strings must be freed with Free().
+func WinHTTPGetIEProxyConfigForCurrentUser(
+	cfg *WinHTTPCurrentUserIEProxyConfig,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc
+
+	// Name is too long for a one-line declaration
+	proc = procWinHttpGetIEProxyConfigForCurrentUser
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		uintptr(unsafe.Pointer(cfg)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// WinHTTPGetProxyForURL is WinHttpGetProxyForUrl from winhttp.h. The
+// returned strings must be freed with Free().
+func WinHTTPGetProxyForURL(
+	sessionHndl uintptr,
+	url string,
+	opts *WinHTTPAutoProxyOptions,
+	info *WinHTTPProxyInfo,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procWinHttpGetProxyForUrl
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		sessionHndl,
+		types.LpCwstr(url),
+		uintptr(unsafe.Pointer(opts)),
+		uintptr(unsafe.Pointer(info)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
 // WinHTTPOpen is WinHttpOpen from winhttp.h
 func WinHTTPOpen(
 	userAgent string,
@@ -89,10 +224,14 @@ func WinHTTPOpen(
 	flags uintptr,
 ) (uintptr, error) {
 	var e error
-	var proc string = "WinHttpOpen"
+	var proc *windows.LazyProc = procWinHttpOpen
 	var sessionHndl uintptr
 
-	sessionHndl, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	sessionHndl, _, e = proc.Call(
 		types.LpCwstr(userAgent),
 		accessType,
 		types.LpCwstr(proxy),
@@ -100,7 +239,7 @@ func WinHTTPOpen(
 		flags,
 	)
 	if sessionHndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return sessionHndl, nil
@@ -118,7 +257,7 @@ func WinHTTPOpenRequest(
 ) (uintptr, error) {
 	var e error
 	var ppwszAcceptTypes []*uint16
-	var proc string = "WinHttpOpenRequest"
+	var proc *windows.LazyProc = procWinHttpOpenRequest
 	var reqHndl uintptr
 
 	// Convert to Windows types
@@ -137,7 +276,11 @@ func WinHTTPOpenRequest(
 		ppwszAcceptTypes = make([]*uint16, 1)
 	}
 
-	reqHndl, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	reqHndl, _, e = proc.Call(
 		connHndl,
 		types.LpCwstr(verb),
 		types.LpCwstr(objectName),
@@ -147,7 +290,7 @@ func WinHTTPOpenRequest(
 		flags,
 	)
 	if reqHndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return reqHndl, nil
@@ -161,14 +304,18 @@ func WinHTTPQueryDataAvailable(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpQueryDataAvailable"
+	var proc *windows.LazyProc = procWinHttpQueryDataAvailable
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
 
-	ok, _, e = winhttp.NewProc(proc).Call(
+	ok, _, e = proc.Call(
 		reqHndl,
 		uintptr(unsafe.Pointer(bytesToRead)),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -186,7 +333,7 @@ func WinHTTPQueryHeaders(
 	var b []uint16
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpQueryHeaders"
+	var proc *windows.LazyProc = procWinHttpQueryHeaders
 	var pwszName uintptr
 
 	// Convert to Windows types
@@ -196,13 +343,17 @@ func WinHTTPQueryHeaders(
 		b = make([]uint16, 1)
 	}
 
-	if (name != "") && (info == Winhttp.WinhttpQueryCustom) {
+	if (name != "") && (info == WinhttpQueryCustom) {
 		pwszName = types.LpCwstr(name)
 	} else {
-		pwszName = Winhttp.WinhttpHeaderNameByIndex
+		pwszName = WinhttpHeaderNameByIndex
 	}
 
-	ok, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		info,
 		pwszName,
@@ -211,7 +362,7 @@ func WinHTTPQueryHeaders(
 		uintptr(unsafe.Pointer(index)),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	*buffer = []byte(windows.UTF16ToString(b))
@@ -229,7 +380,7 @@ func WinHTTPReadData(
 	var b []byte
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpReadData"
+	var proc *windows.LazyProc = procWinHttpReadData
 
 	if bytesToRead > 0 {
 		b = make([]byte, bytesToRead)
@@ -237,14 +388,18 @@ func WinHTTPReadData(
 		b = make([]byte, 1)
 	}
 
-	ok, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		uintptr(unsafe.Pointer(&b[0])),
 		uintptr(bytesToRead),
 		uintptr(unsafe.Pointer(bytesRead)),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	*buffer = b
@@ -256,10 +411,14 @@ func WinHTTPReadData(
 func WinHTTPReceiveResponse(reqHndl uintptr) error {
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpReceiveResponse"
+	var proc *windows.LazyProc = procWinHttpReceiveResponse
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
 
-	if ok, _, e = winhttp.NewProc(proc).Call(reqHndl, 0); ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+	if ok, _, e = proc.Call(reqHndl, 0); ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -272,27 +431,32 @@ func WinHTTPSendRequest(
 	headersLen int,
 	data []byte,
 	dataLen int,
+	totalLen int,
 ) error {
 	var body uintptr
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpSendRequest"
+	var proc *windows.LazyProc = procWinHttpSendRequest
 
 	// Pointer to data if provided
 	if (data != nil) && (len(data) > 0) {
 		body = uintptr(unsafe.Pointer(&data[0]))
 	}
 
-	ok, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		types.LpCwstr(headers),
 		uintptr(headersLen),
 		body,
 		uintptr(dataLen),
-		uintptr(dataLen),
+		uintptr(totalLen),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -307,21 +471,84 @@ func WinHTTPSetOption(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "WinHttpSetOption"
+	var proc *windows.LazyProc = procWinHttpSetOption
 
 	// Pointer to data if provided
 	if valLen == 0 {
 		val = make([]byte, 1)
 	}
 
-	ok, _, e = winhttp.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		hndl,
 		opt,
 		uintptr(unsafe.Pointer(&val[0])),
 		uintptr(valLen),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// WinHTTPSetStatusCallback is WinHttpSetStatusCallback from winhttp.h
+func WinHTTPSetStatusCallback(
+	hndl uintptr,
+	callback uintptr,
+	notificationFlags uintptr,
+) error {
+	var e error
+	var proc *windows.LazyProc = procWinHttpSetStatusCallback
+	var prev uintptr
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	prev, _, e = proc.Call(
+		hndl,
+		callback,
+		notificationFlags,
+		0,
+	)
+	if prev == ^uintptr(0) { // WINHTTP_INVALID_STATUS_CALLBACK
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// WinHTTPWriteData is WinHttpWriteData from winhttp.h
+func WinHTTPWriteData(
+	reqHndl uintptr,
+	data []byte,
+	bytesWritten *uint32,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procWinHttpWriteData
+
+	if len(data) == 0 {
+		// Nothing to write
+		return nil
+	}
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(&data[0])),
+		uintptr(len(data)),
+		uintptr(unsafe.Pointer(bytesWritten)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
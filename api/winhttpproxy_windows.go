@@ -0,0 +1,27 @@
+//go:build windows
+
+package api
+
+import "unsafe"
+
+// freeString will free a WinHTTP allocated string and clear the
+// pointer, so it can't be freed twice.
+func freeString(str **uint16) {
+	if *str != nil {
+		_ = GlobalFree(uintptr(unsafe.Pointer(*str)))
+		*str = nil
+	}
+}
+
+// Free will free the strings allocated by WinHTTP.
+func (c *WinHTTPCurrentUserIEProxyConfig) Free() {
+	freeString(&c.AutoConfigURL)
+	freeString(&c.Proxy)
+	freeString(&c.ProxyBypass)
+}
+
+// Free will free the strings allocated by WinHTTP.
+func (i *WinHTTPProxyInfo) Free() {
+	freeString(&i.Proxy)
+	freeString(&i.ProxyBypass)
+}
@@ -12,7 +12,107 @@ import (
 	"github.com/mjwhitta/win/types"
 )
 
-var wininet *windows.LazyDLL = windows.NewLazySystemDLL("Wininet")
+var (
+	wininet *windows.LazyDLL = windows.NewLazySystemDLL("Wininet")
+
+	procDeleteUrlCacheEntryW = wininet.NewProc(
+		"DeleteUrlCacheEntryW",
+	)
+	procGetUrlCacheEntryInfoW = wininet.NewProc(
+		"GetUrlCacheEntryInfoW",
+	)
+	procHttpAddRequestHeadersW = wininet.NewProc(
+		"HttpAddRequestHeadersW",
+	)
+	procHttpEndRequestW = wininet.NewProc(
+		"HttpEndRequestW",
+	)
+	procHttpOpenRequestW = wininet.NewProc(
+		"HttpOpenRequestW",
+	)
+	procHttpQueryInfoW     = wininet.NewProc("HttpQueryInfoW")
+	procHttpSendRequestExW = wininet.NewProc(
+		"HttpSendRequestExW",
+	)
+	procHttpSendRequestW = wininet.NewProc(
+		"HttpSendRequestW",
+	)
+	procInternetCloseHandle = wininet.NewProc(
+		"InternetCloseHandle",
+	)
+	procInternetConnectW = wininet.NewProc(
+		"InternetConnectW",
+	)
+	procInternetGetCookieExW = wininet.NewProc(
+		"InternetGetCookieExW",
+	)
+	procInternetGetCookieW = wininet.NewProc(
+		"InternetGetCookieW",
+	)
+	procInternetOpenW              = wininet.NewProc("InternetOpenW")
+	procInternetQueryDataAvailable = wininet.NewProc(
+		"InternetQueryDataAvailable",
+	)
+	procInternetQueryOptionW = wininet.NewProc("InternetQueryOptionW")
+	procInternetReadFile     = wininet.NewProc("InternetReadFile")
+	procInternetSetCookieExW = wininet.NewProc("InternetSetCookieExW")
+	procInternetSetOptionW   = wininet.NewProc("InternetSetOptionW")
+	procInternetWriteFile    = wininet.NewProc("InternetWriteFile")
+)
+
+// DeleteURLCacheEntryW from wininet.h
+func DeleteURLCacheEntryW(url string) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procDeleteUrlCacheEntryW
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(types.LpCwstr(url))
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// GetURLCacheEntryInfoW from wininet.h
+func GetURLCacheEntryInfoW(
+	url string,
+	buffer *[]byte,
+	bufferLen *int,
+) error {
+	var b []byte
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procGetUrlCacheEntryInfoW
+
+	// Buffer holds the struct followed by the strings it points to
+	if *bufferLen > 0 {
+		b = make([]byte, *bufferLen)
+	} else {
+		b = make([]byte, 1)
+	}
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		types.LpCwstr(url),
+		uintptr(unsafe.Pointer(&b[0])),
+		uintptr(unsafe.Pointer(bufferLen)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	*buffer = b
+
+	return nil
+}
 
 // HTTPAddRequestHeadersW from wininet.h
 func HTTPAddRequestHeadersW(
@@ -22,7 +122,7 @@ func HTTPAddRequestHeadersW(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "HttpAddRequestHeadersW"
+	var proc *windows.LazyProc = procHttpAddRequestHeadersW
 
 	if header == "" {
 		// Weird, just do nothing
@@ -31,14 +131,36 @@ func HTTPAddRequestHeadersW(
 
 	header = strings.TrimSpace(header) + "\r\n"
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		types.LpCwstr(header),
 		uintptr(len(header)),
 		addMethod,
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// HTTPEndRequestW from wininet.h
+func HTTPEndRequestW(reqHndl uintptr) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procHttpEndRequestW
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(reqHndl, 0, 0, 0)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -57,7 +179,7 @@ func HTTPOpenRequestW(
 ) (uintptr, error) {
 	var e error
 	var lplpcwstrAcceptTypes []*uint16
-	var proc string = "HttpOpenRequestW"
+	var proc *windows.LazyProc = procHttpOpenRequestW
 	var reqHndl uintptr
 
 	// Convert to Windows types
@@ -76,7 +198,11 @@ func HTTPOpenRequestW(
 		lplpcwstrAcceptTypes = make([]*uint16, 1)
 	}
 
-	reqHndl, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	reqHndl, _, e = proc.Call(
 		connHndl,
 		types.LpCwstr(verb),
 		types.LpCwstr(objectName),
@@ -87,7 +213,7 @@ func HTTPOpenRequestW(
 		context,
 	)
 	if reqHndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return reqHndl, nil
@@ -104,7 +230,7 @@ func HTTPQueryInfoW(
 	var b []uint16
 	var e error
 	var ok uintptr
-	var proc string = "HttpQueryInfoW"
+	var proc *windows.LazyProc = procHttpQueryInfoW
 	var tmp string
 
 	if *bufferLen > 0 {
@@ -113,7 +239,11 @@ func HTTPQueryInfoW(
 		b = make([]uint16, 1)
 	}
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		info,
 		uintptr(unsafe.Pointer(&b[0])),
@@ -121,7 +251,7 @@ func HTTPQueryInfoW(
 		uintptr(unsafe.Pointer(index)),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	tmp = windows.UTF16ToString(b)
@@ -141,14 +271,18 @@ func HTTPSendRequestW(
 	var body uintptr
 	var e error
 	var ok uintptr
-	var proc string = "HttpSendRequestW"
+	var proc *windows.LazyProc = procHttpSendRequestW
 
 	// Pointer to data if provided
 	if (data != nil) && (len(data) > 0) {
 		body = uintptr(unsafe.Pointer(&data[0]))
 	}
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		types.LpCwstr(headers),
 		uintptr(headersLen),
@@ -156,7 +290,36 @@ func HTTPSendRequestW(
 		uintptr(dataLen),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// HTTPSendRequestExW from wininet.h
+func HTTPSendRequestExW(
+	reqHndl uintptr,
+	buffersIn *InternetBuffers,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procHttpSendRequestExW
+
+	buffersIn.dwStructSize = uint32(unsafe.Sizeof(*buffersIn))
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(buffersIn)),
+		0,
+		0,
+		0,
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -166,10 +329,14 @@ func HTTPSendRequestW(
 func InternetCloseHandle(reqHndl uintptr) error {
 	var e error
 	var ok uintptr
-	var proc string = "InternetCloseHandle"
+	var proc *windows.LazyProc = procInternetCloseHandle
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
 
-	if ok, _, e = wininet.NewProc(proc).Call(reqHndl); ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+	if ok, _, e = proc.Call(reqHndl); ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
@@ -188,9 +355,13 @@ func InternetConnectW(
 ) (uintptr, error) {
 	var connHndl uintptr
 	var e error
-	var proc string = "InternetConnectW"
+	var proc *windows.LazyProc = procInternetConnectW
 
-	connHndl, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	connHndl, _, e = proc.Call(
 		sessionHndl,
 		types.LpCwstr(serverName),
 		uintptr(serverPort),
@@ -201,7 +372,7 @@ func InternetConnectW(
 		context,
 	)
 	if connHndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return connHndl, nil
@@ -216,7 +387,7 @@ func InternetGetCookieW(
 	var b []uint16
 	var e error
 	var ok uintptr
-	var proc string = "InternetGetCookieW"
+	var proc *windows.LazyProc = procInternetGetCookieW
 	var tmp string
 
 	if *bufferLen > 0 {
@@ -225,14 +396,18 @@ func InternetGetCookieW(
 		b = make([]uint16, 1)
 	}
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		types.LpCwstr(url),
 		0,
 		uintptr(unsafe.Pointer(&b[0])),
 		uintptr(unsafe.Pointer(bufferLen)),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	tmp = windows.UTF16ToString(b)
@@ -252,7 +427,7 @@ func InternetGetCookieExW(
 	var b []uint16
 	var e error
 	var ok uintptr
-	var proc string = "InternetGetCookieExW"
+	var proc *windows.LazyProc = procInternetGetCookieExW
 	var tmp string
 
 	if *bufferLen > 0 {
@@ -261,7 +436,11 @@ func InternetGetCookieExW(
 		b = make([]uint16, 1)
 	}
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		types.LpCwstr(url),
 		types.LpCwstr(name),
 		uintptr(unsafe.Pointer(&b[0])),
@@ -270,7 +449,7 @@ func InternetGetCookieExW(
 		0,
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	tmp = windows.UTF16ToString(b)
@@ -288,10 +467,14 @@ func InternetOpenW(
 	flags uintptr,
 ) (uintptr, error) {
 	var e error
-	var proc string = "InternetOpenW"
+	var proc *windows.LazyProc = procInternetOpenW
 	var sessionHndl uintptr
 
-	sessionHndl, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	sessionHndl, _, e = proc.Call(
 		types.LpCwstr(userAgent),
 		accessType,
 		types.LpCwstr(proxy),
@@ -299,7 +482,7 @@ func InternetOpenW(
 		flags,
 	)
 	if sessionHndl == 0 {
-		return 0, errors.Newf("%s: %w", proc, e)
+		return 0, errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return sessionHndl, nil
@@ -312,21 +495,62 @@ func InternetQueryDataAvailable(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "InternetQueryDataAvailable"
+	var proc *windows.LazyProc = procInternetQueryDataAvailable
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		uintptr(unsafe.Pointer(bytesAvailable)),
 		0,
 		0,
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
 }
 
+// InternetQueryOptionW from wininet.h
+func InternetQueryOptionW(
+	hndl uintptr,
+	opt uintptr,
+	buffer *[]byte,
+	bufferLen *int,
+) error {
+	var b []byte
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procInternetQueryOptionW
+
+	if *bufferLen > 0 {
+		b = make([]byte, *bufferLen)
+	} else {
+		b = make([]byte, 1)
+	}
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		hndl,
+		opt,
+		uintptr(unsafe.Pointer(&b[0])),
+		uintptr(unsafe.Pointer(bufferLen)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	*buffer = b
+
+	return nil
+}
+
 // InternetReadFile from wininet.h
 func InternetReadFile(
 	reqHndl uintptr,
@@ -337,7 +561,7 @@ func InternetReadFile(
 	var b []byte
 	var e error
 	var ok uintptr
-	var proc string = "InternetReadFile"
+	var proc *windows.LazyProc = procInternetReadFile
 
 	if bytesToRead > 0 {
 		b = make([]byte, bytesToRead)
@@ -345,14 +569,18 @@ func InternetReadFile(
 		b = make([]byte, 1)
 	}
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		reqHndl,
 		uintptr(unsafe.Pointer(&b[0])),
 		uintptr(bytesToRead),
 		uintptr(unsafe.Pointer(bytesRead)),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	*buffer = b
@@ -360,6 +588,35 @@ func InternetReadFile(
 	return nil
 }
 
+// InternetSetCookieExW from wininet.h
+func InternetSetCookieExW(
+	url string,
+	name string,
+	data string,
+	flags uintptr,
+) (uintptr, error) {
+	var e error
+	var proc *windows.LazyProc = procInternetSetCookieExW
+	var state uintptr
+
+	if e = proc.Find(); e != nil {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	state, _, e = proc.Call(
+		types.LpCwstr(url),
+		types.LpCwstr(name),
+		types.LpCwstr(data),
+		flags,
+		0,
+	)
+	if state == 0 {
+		return 0, errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return state, nil
+}
+
 // InternetSetOptionW from wininet.h
 func InternetSetOptionW(
 	hndl uintptr,
@@ -369,21 +626,57 @@ func InternetSetOptionW(
 ) error {
 	var e error
 	var ok uintptr
-	var proc string = "InternetSetOptionW"
+	var proc *windows.LazyProc = procInternetSetOptionW
 
 	// Pointer to data if provided
 	if valLen == 0 {
 		val = make([]byte, 1)
 	}
 
-	ok, _, e = wininet.NewProc(proc).Call(
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
 		hndl,
 		opt,
 		uintptr(unsafe.Pointer(&val[0])),
 		uintptr(valLen),
 	)
 	if ok == 0 {
-		return errors.Newf("%s: %w", proc, e)
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	return nil
+}
+
+// InternetWriteFile from wininet.h
+func InternetWriteFile(
+	reqHndl uintptr,
+	data []byte,
+	bytesWritten *uint32,
+) error {
+	var e error
+	var ok uintptr
+	var proc *windows.LazyProc = procInternetWriteFile
+
+	if len(data) == 0 {
+		// Nothing to write
+		return nil
+	}
+
+	if e = proc.Find(); e != nil {
+		return errors.Newf("%s: %w", proc.Name, e)
+	}
+
+	ok, _, e = proc.Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(&data[0])),
+		uintptr(len(data)),
+		uintptr(unsafe.Pointer(bytesWritten)),
+	)
+	if ok == 0 {
+		return errors.Newf("%s: %w", proc.Name, e)
 	}
 
 	return nil
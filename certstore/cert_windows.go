@@ -0,0 +1,239 @@
+//go:build windows
+
+package certstore
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec // Thumbprints are SHA-1
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	goerrors "errors"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+)
+
+// Certificate is a certificate from a store. Context can be passed
+// to Windows APIs expecting a PCCERT_CONTEXT, such as
+// WINHTTP_OPTION_CLIENT_CERT_CONTEXT. A Certificate must be closed
+// with Close().
+type Certificate struct {
+	Context    *windows.CertContext
+	Key        *KeyProvInfo
+	Raw        []byte
+	Thumbprint string
+	// X509 is nil if Go can't parse the certificate
+	X509 *x509.Certificate
+}
+
+// KeyProvInfo describes where the private key of a certificate is
+// stored.
+type KeyProvInfo struct {
+	Container string
+	Flags     uint32
+	KeySpec   uint32
+	Provider  string
+	ProvType  uint32
+}
+
+// cryptKeyProvInfo is a CRYPT_KEY_PROV_INFO.
+type cryptKeyProvInfo struct {
+	ContainerName *uint16
+	ProvName      *uint16
+	ProvType      uint32
+	Flags         uint32
+	ProvParamCnt  uint32
+	ProvParams    uintptr
+	KeySpec       uint32
+}
+
+// Length of a SHA-1 thumbprint
+const sha1Len int = 20
+
+// ExportPEM will return the PEM encoding of certificates.
+func ExportPEM(certs ...*Certificate) []byte {
+	var b bytes.Buffer
+
+	for _, c := range certs {
+		_ = pem.Encode(
+			&b,
+			&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw},
+		)
+	}
+
+	return b.Bytes()
+}
+
+// ExportPFX will return the PFX encoding of certificates, protected
+// by a password. Private keys are included if withKeys is true, and
+// exporting fails if a key isn't exportable.
+func ExportPFX(
+	password string,
+	withKeys bool,
+	certs ...*Certificate,
+) ([]byte, error) {
+	var blob windows.CryptDataBlob
+	var e error
+	var flags uintptr
+	var pfx []byte
+	var pw *uint16
+	var tmp windows.Handle
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates to export")
+	}
+
+	if withKeys {
+		flags = w32.WincryptExportPrivateKeys |
+			w32.WincryptReportNotAbleToExportPrivateKey
+	}
+
+	if pw, e = windows.UTF16PtrFromString(password); e != nil {
+		return nil, errors.Newf("invalid password: %w", e)
+	}
+
+	tmp, e = windows.CertOpenStore(
+		w32.WincryptCertStoreProvMemory,
+		0,
+		0,
+		0,
+		0,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create store: %w", e)
+	}
+	defer func() {
+		_ = windows.CertCloseStore(tmp, 0)
+	}()
+
+	for _, c := range certs {
+		if (c == nil) || (c.Context == nil) {
+			return nil, errors.New("certificate is closed")
+		}
+
+		// Copies keep their key provider info
+		e = windows.CertAddCertificateContextToStore(
+			tmp,
+			c.Context,
+			uint32(w32.WincryptCertStoreAddAlways),
+			nil,
+		)
+		if e != nil {
+			return nil, errors.Newf(
+				"failed to add %s to PFX: %w",
+				c.Thumbprint,
+				e,
+			)
+		}
+	}
+
+	// First call only gets the size
+	if e = w32.PFXExportCertStoreEx(tmp, &blob, pw, flags); e != nil {
+		return nil, errors.Newf("failed to export PFX: %w", e)
+	}
+
+	pfx = make([]byte, blob.Size)
+	blob.Data = &pfx[0]
+
+	if e = w32.PFXExportCertStoreEx(tmp, &blob, pw, flags); e != nil {
+		return nil, errors.Newf("failed to export PFX: %w", e)
+	}
+
+	return pfx[:blob.Size], nil
+}
+
+// keyProvInfo will return the key provider info of a certificate
+// context, or nil if it doesn't have a private key.
+func keyProvInfo(ctx *windows.CertContext) (*KeyProvInfo, error) {
+	var b []byte
+	var e error
+	var info *cryptKeyProvInfo
+	var n uint32
+
+	e = w32.CertGetCertificateContextProperty(
+		ctx,
+		w32.WincryptCertKeyProvInfoPropId,
+		nil,
+		&n,
+	)
+	if goerrors.Is(e, errCryptNotFound) {
+		return nil, nil
+	} else if e != nil {
+		return nil, errors.Newf("failed to get key info: %w", e)
+	} else if n < uint32(unsafe.Sizeof(*info)) {
+		return nil, errors.New("failed to get key info: short read")
+	}
+
+	b = make([]byte, n)
+
+	e = w32.CertGetCertificateContextProperty(
+		ctx,
+		w32.WincryptCertKeyProvInfoPropId,
+		&b[0],
+		&n,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to get key info: %w", e)
+	}
+
+	// Strings point into b, so they are copied while b is alive
+	info = (*cryptKeyProvInfo)(unsafe.Pointer(&b[0]))
+
+	return &KeyProvInfo{
+		Container: windows.UTF16PtrToString(info.ContainerName),
+		Flags:     info.Flags,
+		KeySpec:   info.KeySpec,
+		Provider:  windows.UTF16PtrToString(info.ProvName),
+		ProvType:  info.ProvType,
+	}, nil
+}
+
+// newCertificate will wrap a certificate context, which is owned by
+// the returned Certificate.
+func newCertificate(ctx *windows.CertContext) (*Certificate, error) {
+	var c *Certificate = &Certificate{Context: ctx}
+	var e error
+	var sum [sha1Len]byte
+
+	// Copy, as the encoding is freed with the context
+	c.Raw = bytes.Clone(
+		unsafe.Slice(ctx.EncodedCert, ctx.Length),
+	)
+
+	sum = sha1.Sum(c.Raw) //nolint:gosec // Thumbprints are SHA-1
+	c.Thumbprint = strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	if c.Key, e = keyProvInfo(ctx); e != nil {
+		return nil, errors.Newf("%s: %w", c.Thumbprint, e)
+	}
+
+	// Not all certificates in Windows stores are parsable by Go
+	c.X509, _ = x509.ParseCertificate(c.Raw)
+
+	return c, nil
+}
+
+// Close will free the certificate context.
+func (c *Certificate) Close() error {
+	if c.Context == nil {
+		return nil
+	}
+
+	if e := windows.CertFreeCertificateContext(c.Context); e != nil {
+		return errors.Newf("failed to free %s: %w", c.Thumbprint, e)
+	}
+
+	c.Context = nil
+
+	return nil
+}
+
+// PEM will return the PEM encoding of the certificate.
+func (c *Certificate) PEM() []byte {
+	return ExportPEM(c)
+}
@@ -0,0 +1,474 @@
+//go:build windows
+
+package certstore
+
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	goerrors "errors"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+)
+
+// Location is a system store location, such as CurrentUser.
+type Location uintptr
+
+// Store is an open certificate store. A Store must be closed with
+// Close().
+type Store struct {
+	Location Location
+	Name     string
+
+	hndl windows.Handle
+}
+
+// System store locations
+//
+//nolint:lll // Wincrypt constant names are long
+const (
+	CurrentService          Location = Location(w32.WincryptCertSystemStoreCurrentService)
+	CurrentUser             Location = Location(w32.WincryptCertSystemStoreCurrentUser)
+	CurrentUserGroupPolicy  Location = Location(w32.WincryptCertSystemStoreCurrentUserGroupPolicy)
+	LocalMachine            Location = Location(w32.WincryptCertSystemStoreLocalMachine)
+	LocalMachineEnterprise  Location = Location(w32.WincryptCertSystemStoreLocalMachineEnterprise)
+	LocalMachineGroupPolicy Location = Location(w32.WincryptCertSystemStoreLocalMachineGroupPolicy)
+	Services                Location = Location(w32.WincryptCertSystemStoreServices)
+	Users                   Location = Location(w32.WincryptCertSystemStoreUsers)
+)
+
+// Encoding of all certificates
+const encoding uint32 = uint32(
+	w32.WincryptX509AsnEncoding | w32.WincryptPkcs7AsnEncoding,
+)
+
+var (
+	// ErrNotFound is returned when a certificate isn't in a store.
+	ErrNotFound error = errors.New("certificate not found")
+
+	// Returned by CryptoAPI when there are no more certificates
+	errCryptNotFound error = windows.Errno(windows.CRYPT_E_NOT_FOUND)
+)
+
+// Open will open a system store, such as "My" or "Root", in a
+// location. Stores are opened read-only, unless writable is true, as
+// writing to machine stores requires admin.
+func Open(loc Location, name string, writable bool) (*Store, error) {
+	return open(w32.WincryptCertStoreProvSystemW, loc, name, writable)
+}
+
+// OpenPhysical will open a physical store of a system store, such as
+// ".Default" of "Root". Stores are opened read-only, unless writable
+// is true.
+func OpenPhysical(
+	loc Location,
+	name string,
+	physical string,
+	writable bool,
+) (*Store, error) {
+	return open(
+		w32.WincryptCertStoreProvPhysicalW,
+		loc,
+		name+`\`+physical,
+		writable,
+	)
+}
+
+// PhysicalStores will return the names of the physical stores of a
+// system store in a location.
+func PhysicalStores(loc Location, name string) ([]string, error) {
+	return w32.EnumPhysicalStores(name, uintptr(loc))
+}
+
+// Stores will return the names of the system stores in a location.
+func Stores(loc Location) ([]string, error) {
+	return w32.EnumSystemStores(uintptr(loc))
+}
+
+func closeAll(certs []*Certificate) {
+	for _, c := range certs {
+		_ = c.Close()
+	}
+}
+
+func open(
+	provider uintptr,
+	loc Location,
+	name string,
+	writable bool,
+) (*Store, error) {
+	var e error
+	var flags uint32
+	var hndl windows.Handle
+	var para *uint16
+
+	flags = uint32(loc) |
+		uint32(w32.WincryptCertStoreOpenExistingFlag)
+	if !writable {
+		flags |= uint32(w32.WincryptCertStoreReadonlyFlag)
+	}
+
+	if para, e = windows.UTF16PtrFromString(name); e != nil {
+		return nil, errors.Newf("invalid store name %s: %w", name, e)
+	}
+
+	hndl, e = windows.CertOpenStore(
+		provider,
+		0,
+		0,
+		flags,
+		uintptr(unsafe.Pointer(para)),
+	)
+	runtime.KeepAlive(para)
+
+	if e != nil {
+		return nil, errors.Newf("failed to open %s: %w", name, e)
+	}
+
+	return &Store{Location: loc, Name: name, hndl: hndl}, nil
+}
+
+// add will add an encoded certificate to the store, replacing it if
+// it already exists.
+func (s *Store) add(der []byte) (*Certificate, error) {
+	var ctx *windows.CertContext
+	var e error
+
+	if len(der) == 0 {
+		return nil, errors.New("empty certificate")
+	}
+
+	ctx, e = windows.CertCreateCertificateContext(
+		encoding,
+		&der[0],
+		uint32(len(der)),
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create context: %w", e)
+	}
+	defer func() {
+		_ = windows.CertFreeCertificateContext(ctx)
+	}()
+
+	return s.addContext(ctx)
+}
+
+// addContext will add a copy of a certificate context to the store,
+// replacing it if it already exists.
+func (s *Store) addContext(
+	ctx *windows.CertContext,
+) (*Certificate, error) {
+	var added *windows.CertContext
+	var c *Certificate
+	var e error
+
+	e = windows.CertAddCertificateContextToStore(
+		s.hndl,
+		ctx,
+		uint32(w32.WincryptCertStoreAddReplaceExisting),
+		&added,
+	)
+	if e != nil {
+		return nil, errors.Newf(
+			"failed to add certificate to %s: %w",
+			s.Name,
+			e,
+		)
+	}
+
+	if c, e = newCertificate(added); e != nil {
+		_ = windows.CertFreeCertificateContext(added)
+		return nil, e
+	}
+
+	return c, nil
+}
+
+// Certificates will return every certificate in the store. Each
+// Certificate must be closed with Close().
+func (s *Store) Certificates() ([]*Certificate, error) {
+	return s.find(w32.WincryptCertFindAny, nil)
+}
+
+// Close will close the store.
+func (s *Store) Close() error {
+	if s.hndl == 0 {
+		return nil
+	}
+
+	if e := windows.CertCloseStore(s.hndl, 0); e != nil {
+		return errors.Newf("failed to close store %s: %w", s.Name, e)
+	}
+
+	s.hndl = 0
+
+	return nil
+}
+
+// Delete will delete a certificate from the store. The certificate
+// can be from any store, as it is matched by its encoding.
+func (s *Store) Delete(c *Certificate) error {
+	var e error
+	var found *windows.CertContext
+
+	if (c == nil) || (c.Context == nil) {
+		return errors.New("certificate is closed")
+	}
+
+	found, e = windows.CertFindCertificateInStore(
+		s.hndl,
+		encoding,
+		0,
+		uint32(w32.WincryptCertFindExisting),
+		unsafe.Pointer(c.Context),
+		nil,
+	)
+	if goerrors.Is(e, errCryptNotFound) {
+		return ErrNotFound
+	} else if e != nil {
+		return errors.Newf("failed to find certificate: %w", e)
+	}
+
+	// Frees the found context, even on failure
+	if e = windows.CertDeleteCertificateFromStore(found); e != nil {
+		return errors.Newf(
+			"failed to delete certificate from %s: %w",
+			s.Name,
+			e,
+		)
+	}
+
+	return nil
+}
+
+// find will return the certificates that match a CERT_FIND_* type.
+func (s *Store) find(
+	findType uintptr,
+	para unsafe.Pointer,
+) ([]*Certificate, error) {
+	var c *Certificate
+	var certs []*Certificate
+	var ctx *windows.CertContext
+	var dup *windows.CertContext
+	var e error
+
+	for {
+		// Frees the previous context
+		ctx, e = windows.CertFindCertificateInStore(
+			s.hndl,
+			encoding,
+			0,
+			uint32(findType),
+			para,
+			ctx,
+		)
+		if goerrors.Is(e, errCryptNotFound) {
+			return certs, nil
+		} else if e != nil {
+			closeAll(certs)
+			return nil, errors.Newf(
+				"failed to search %s: %w",
+				s.Name,
+				e,
+			)
+		}
+
+		// Duplicate, as the next call frees ctx
+		dup = windows.CertDuplicateCertificateContext(ctx)
+
+		if c, e = newCertificate(dup); e != nil {
+			// Free both the duplicate and ctx, as searching stops
+			_ = windows.CertFreeCertificateContext(dup)
+			_ = windows.CertFreeCertificateContext(ctx)
+			closeAll(certs)
+
+			return nil, e
+		}
+
+		certs = append(certs, c)
+	}
+}
+
+// FindBySubject will return the certificates with a subject that
+// contains the provided string, ignoring case. Each Certificate must
+// be closed with Close().
+func (s *Store) FindBySubject(
+	subject string,
+) ([]*Certificate, error) {
+	var certs []*Certificate
+	var e error
+	var para *uint16
+
+	if para, e = windows.UTF16PtrFromString(subject); e != nil {
+		return nil, errors.Newf("invalid subject %s: %w", subject, e)
+	}
+
+	certs, e = s.find(
+		w32.WincryptCertFindSubjectStrW,
+		unsafe.Pointer(para),
+	)
+	runtime.KeepAlive(para)
+
+	return certs, e
+}
+
+// FindByThumbprint will return the certificate with a SHA-1
+// thumbprint, in hex. Spaces and colons are ignored. The Certificate
+// must be closed with Close().
+func (s *Store) FindByThumbprint(
+	thumbprint string,
+) (*Certificate, error) {
+	var b []byte
+	var blob windows.CryptHashBlob
+	var certs []*Certificate
+	var e error
+
+	thumbprint = strings.NewReplacer(" ", "", ":", "").Replace(
+		thumbprint,
+	)
+
+	if b, e = hex.DecodeString(thumbprint); e != nil {
+		return nil, errors.Newf("invalid thumbprint: %w", e)
+	} else if len(b) != sha1Len {
+		return nil, errors.Newf("invalid thumbprint %s", thumbprint)
+	}
+
+	blob = windows.CryptHashBlob{Size: uint32(len(b)), Data: &b[0]}
+
+	certs, e = s.find(
+		w32.WincryptCertFindSha1Hash,
+		unsafe.Pointer(&blob),
+	)
+	runtime.KeepAlive(b)
+
+	if e != nil {
+		return nil, e
+	} else if len(certs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	// Thumbprints are unique within a store
+	closeAll(certs[1:])
+
+	return certs[0], nil
+}
+
+// Import will add a certificate to the store, replacing it if it
+// already exists. The returned Certificate must be closed with
+// Close().
+func (s *Store) Import(cert *x509.Certificate) (*Certificate, error) {
+	return s.add(cert.Raw)
+}
+
+// ImportPEM will add every certificate in PEM encoded data to the
+// store, replacing them if they already exist. Each Certificate must
+// be closed with Close().
+func (s *Store) ImportPEM(data []byte) ([]*Certificate, error) {
+	var blk *pem.Block
+	var c *Certificate
+	var certs []*Certificate
+	var e error
+
+	for {
+		if blk, data = pem.Decode(data); blk == nil {
+			break
+		}
+
+		if blk.Type != "CERTIFICATE" {
+			continue
+		}
+
+		if c, e = s.add(blk.Bytes); e != nil {
+			closeAll(certs)
+			return nil, e
+		}
+
+		certs = append(certs, c)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found in PEM data")
+	}
+
+	return certs, nil
+}
+
+// ImportPFX will add every certificate in PFX data, with their
+// private keys, to the store, replacing them if they already exist.
+// Keys can only be exported again if exportable is true. Each
+// Certificate must be closed with Close().
+func (s *Store) ImportPFX(
+	pfx []byte,
+	password string,
+	exportable bool,
+) ([]*Certificate, error) {
+	var blob windows.CryptDataBlob
+	var c *Certificate
+	var certs []*Certificate
+	var ctx *windows.CertContext
+	var e error
+	var flags uint32 = uint32(w32.WincryptCryptUserKeyset)
+	var pw *uint16
+	var tmp windows.Handle
+
+	if len(pfx) == 0 {
+		return nil, errors.New("empty PFX")
+	}
+
+	switch s.Location {
+	case CurrentService, LocalMachine, LocalMachineEnterprise,
+		LocalMachineGroupPolicy, Services:
+		flags = uint32(w32.WincryptCryptMachineKeyset)
+	}
+
+	if exportable {
+		flags |= uint32(w32.WincryptCryptExportable)
+	}
+
+	if pw, e = windows.UTF16PtrFromString(password); e != nil {
+		return nil, errors.Newf("invalid password: %w", e)
+	}
+
+	blob.Data = &pfx[0]
+	blob.Size = uint32(len(pfx))
+
+	tmp, e = windows.PFXImportCertStore(&blob, pw, flags)
+	if e != nil {
+		return nil, errors.Newf("failed to import PFX: %w", e)
+	}
+	defer func() {
+		_ = windows.CertCloseStore(tmp, 0)
+	}()
+
+	for {
+		// Frees the previous context
+		ctx, e = windows.CertEnumCertificatesInStore(tmp, ctx)
+		if goerrors.Is(e, errCryptNotFound) {
+			break
+		} else if e != nil {
+			closeAll(certs)
+			return nil, errors.Newf("failed to enumerate PFX: %w", e)
+		}
+
+		if c, e = s.addContext(ctx); e != nil {
+			_ = windows.CertFreeCertificateContext(ctx)
+			closeAll(certs)
+
+			return nil, e
+		}
+
+		certs = append(certs, c)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found in PFX data")
+	}
+
+	return certs, nil
+}
@@ -0,0 +1,253 @@
+package certstore
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/windows"
+
+	w32 "github.com/mjwhitta/win/api"
+)
+
+const password string = "password"
+
+// emptyPFX will return a PFX without any certificates.
+func emptyPFX(t *testing.T) []byte {
+	var blob windows.CryptDataBlob
+	var e error
+	var pfx []byte
+	var pw *uint16
+	var s *Store = newMemoryStore(t)
+
+	if pw, e = windows.UTF16PtrFromString(password); e != nil {
+		t.Fatal(e)
+	}
+
+	// First call only gets the size
+	if e = w32.PFXExportCertStoreEx(s.hndl, &blob, pw, 0); e != nil {
+		t.Fatal(e)
+	}
+
+	pfx = make([]byte, blob.Size)
+	blob.Data = &pfx[0]
+
+	if e = w32.PFXExportCertStoreEx(s.hndl, &blob, pw, 0); e != nil {
+		t.Fatal(e)
+	}
+
+	return pfx[:blob.Size]
+}
+
+// newCert will return a self-signed certificate.
+func newCert(t *testing.T) *x509.Certificate {
+	var cert *x509.Certificate
+	var der []byte
+	var e error
+	var key *ecdsa.PrivateKey
+	var tmpl *x509.Certificate = &x509.Certificate{
+		NotAfter:     time.Now().Add(time.Hour),
+		NotBefore:    time.Now().Add(-time.Hour),
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "certstore test"},
+	}
+
+	key, e = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	der, e = x509.CreateCertificate(
+		rand.Reader,
+		tmpl,
+		tmpl,
+		key.Public(),
+		key,
+	)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if cert, e = x509.ParseCertificate(der); e != nil {
+		t.Fatal(e)
+	}
+
+	return cert
+}
+
+// newMemoryStore will return an empty in-memory store, which is
+// closed when the test finishes.
+func newMemoryStore(t *testing.T) *Store {
+	var e error
+	var hndl windows.Handle
+	var s *Store
+
+	hndl, e = windows.CertOpenStore(
+		w32.WincryptCertStoreProvMemory,
+		0,
+		0,
+		0,
+		0,
+	)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	s = &Store{Name: "Memory", hndl: hndl}
+	t.Cleanup(func() { _ = s.Close() })
+
+	return s
+}
+
+func TestDelete(t *testing.T) {
+	var c *Certificate
+	var e error
+	var s *Store = newMemoryStore(t)
+
+	if c, e = s.Import(newCert(t)); e != nil {
+		t.Fatal(e)
+	}
+	defer func() { _ = c.Close() }()
+
+	if e = s.Delete(c); e != nil {
+		t.Fatal(e)
+	}
+
+	if e = s.Delete(c); !errors.Is(e, ErrNotFound) {
+		t.Errorf("got %v, want %v", e, ErrNotFound)
+	}
+
+	_, e = s.FindByThumbprint(c.Thumbprint)
+	if !errors.Is(e, ErrNotFound) {
+		t.Errorf("got %v, want %v", e, ErrNotFound)
+	}
+
+	// Closed certificates have no context to match
+	_ = c.Close()
+
+	if e = s.Delete(c); e == nil {
+		t.Error("expected error for closed certificate")
+	}
+
+	if e = s.Delete(nil); e == nil {
+		t.Error("expected error for nil certificate")
+	}
+}
+
+func TestImport(t *testing.T) {
+	var c *Certificate
+	var cert *x509.Certificate = newCert(t)
+	var certs []*Certificate
+	var e error
+	var found *Certificate
+	var s *Store = newMemoryStore(t)
+
+	if c, e = s.Import(cert); e != nil {
+		t.Fatal(e)
+	}
+	defer func() { _ = c.Close() }()
+
+	if !bytes.Equal(c.Raw, cert.Raw) {
+		t.Error("imported certificate doesn't match")
+	}
+
+	if found, e = s.FindByThumbprint(c.Thumbprint); e != nil {
+		t.Fatal(e)
+	}
+	defer func() { _ = found.Close() }()
+
+	if (found.X509 == nil) || !found.X509.Equal(cert) {
+		t.Errorf("got %v, want %v", found.X509, cert)
+	}
+
+	if certs, e = s.Certificates(); e != nil {
+		t.Fatal(e)
+	}
+	defer closeAll(certs)
+
+	if len(certs) != 1 {
+		t.Errorf("got %d certificates, want 1", len(certs))
+	}
+}
+
+func TestImportPEM(t *testing.T) {
+	var c *Certificate
+	var certs []*Certificate
+	var e error
+	var s *Store = newMemoryStore(t)
+
+	if c, e = s.Import(newCert(t)); e != nil {
+		t.Fatal(e)
+	}
+	defer func() { _ = c.Close() }()
+
+	if certs, e = newMemoryStore(t).ImportPEM(c.PEM()); e != nil {
+		t.Fatal(e)
+	}
+	defer closeAll(certs)
+
+	if (len(certs) != 1) || (certs[0].Thumbprint != c.Thumbprint) {
+		t.Errorf("got %v, want %s", certs, c.Thumbprint)
+	}
+
+	for _, data := range [][]byte{nil, []byte("bogus")} {
+		if _, e = s.ImportPEM(data); e == nil {
+			t.Errorf("%q: expected error", data)
+		}
+	}
+}
+
+func TestImportPFX(t *testing.T) {
+	var c *Certificate
+	var certs []*Certificate
+	var e error
+	var pfx []byte
+	var s *Store = newMemoryStore(t)
+
+	if c, e = s.Import(newCert(t)); e != nil {
+		t.Fatal(e)
+	}
+	defer func() { _ = c.Close() }()
+
+	if pfx, e = ExportPFX(password, false, c); e != nil {
+		t.Fatal(e)
+	}
+
+	certs, e = newMemoryStore(t).ImportPFX(pfx, password, false)
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer closeAll(certs)
+
+	if (len(certs) != 1) || (certs[0].Thumbprint != c.Thumbprint) {
+		t.Errorf("got %v, want %s", certs, c.Thumbprint)
+	}
+
+	if _, e = s.ImportPFX(pfx, "wrong", false); e == nil {
+		t.Error("expected error for wrong password")
+	}
+
+	for _, data := range [][]byte{nil, []byte("bogus"), emptyPFX(t)} {
+		if _, e = s.ImportPFX(data, password, false); e == nil {
+			t.Errorf("%q: expected error", data)
+		}
+	}
+
+	// Closed certificates have no context to export
+	_ = c.Close()
+
+	if _, e = ExportPFX(password, false, c); e == nil {
+		t.Error("expected error for closed certificate")
+	}
+
+	if _, e = ExportPFX(password, false, nil); e == nil {
+		t.Error("expected error for nil certificate")
+	}
+}
@@ -2,22 +2,33 @@
 
 package main
 
-// unsigned char cb(short unsigned int*, long long unsigned int);
-import "C"
-
 import (
-	"unsafe"
+	"fmt"
+	"os"
 
 	w32 "github.com/mjwhitta/win/api"
-	"golang.org/x/sys/windows"
 )
 
-//export cb
-func cb(name *uint16, _ uintptr) bool {
-	println(windows.UTF16PtrToString(name))
-	return true
-}
-
 func main() {
-	_ = w32.EnumWindowStationsW(uintptr(unsafe.Pointer(C.cb)), 0)
+	var desktops []string
+	var e error
+	var stations []string
+
+	if stations, e = w32.EnumWindowStations(); e != nil {
+		fmt.Fprintln(os.Stderr, e)
+		os.Exit(1)
+	}
+
+	for _, station := range stations {
+		fmt.Println(station)
+
+		// Access is often denied for other window stations
+		if desktops, e = w32.EnumDesktops(station); e != nil {
+			continue
+		}
+
+		for _, desktop := range desktops {
+			fmt.Println("  " + desktop)
+		}
+	}
 }
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/mjwhitta/cli"
+	"github.com/mjwhitta/win"
+)
+
+// Exit status
+const (
+	Good = iota
+	InvalidOption
+	MissingOption
+	InvalidArgument
+	MissingArgument
+	ExtraArgument
+	Exception
+)
+
+// Archs with values that may differ
+var archs []string = []string{"386", "amd64", "arm64"}
+
+// Flags
+var flags struct {
+	arch    string
+	decode  bool
+	header  string
+	match   string
+	regex   bool
+	verbose bool
+	version bool
+}
+
+func init() {
+	// Configure cli package
+	cli.Align = true // Defaults to false
+	cli.Authors = []string{"Miles Whittaker <mj@whitta.dev>"}
+	cli.Banner = filepath.Base(os.Args[0]) + " [OPTIONS] <name|value>"
+	cli.BugEmail = "wconst.bugs@whitta.dev"
+
+	cli.ExitStatus(
+		"Normally the exit status is 0. In the event of an error the",
+		"exit status will be one of the below:\n\n",
+		fmt.Sprintf("  %d: Invalid option\n", InvalidOption),
+		fmt.Sprintf("  %d: Missing option\n", MissingOption),
+		fmt.Sprintf("  %d: Invalid argument\n", InvalidArgument),
+		fmt.Sprintf("  %d: Missing argument\n", MissingArgument),
+		fmt.Sprintf("  %d: Extra argument\n", ExtraArgument),
+		fmt.Sprintf("  %d: Exception", Exception),
+	)
+	cli.Info(
+		"Looks up Windows constants by name or value, and prints",
+		"the header they are from. Names can be in C or Go spelling",
+		"and match as a case-insensitive substring, unless --regex",
+		"is used. Values can be decimal, hex, or octal.",
+	)
+	cli.Section(
+		"BITMASKS",
+		"Use --decode with --header to decode a value into the flags",
+		"from that header. Use --match to only use flags with names",
+		"that contain a substring, such as WINHTTP_FLAG_.",
+	)
+
+	cli.Title = "Windows Constant Lookup"
+
+	// Parse cli flags
+	cli.Flag(
+		&flags.arch,
+		"a",
+		"arch",
+		"",
+		"Only show values for this arch (386, amd64, or arm64).",
+	)
+	cli.Flag(
+		&flags.decode,
+		"d",
+		"decode",
+		false,
+		"Decode the value as a bitmask of flags.",
+	)
+	cli.Flag(
+		&flags.header,
+		"H",
+		"header",
+		"",
+		"Only use constants from this header (ex: winhttp).",
+	)
+	cli.Flag(
+		&flags.match,
+		"m",
+		"match",
+		"",
+		"Only decode into flags with names that contain this.",
+	)
+	cli.Flag(
+		&flags.regex,
+		"r",
+		"regex",
+		false,
+		"Search for names matching a regular expression.",
+	)
+	cli.Flag(
+		&flags.verbose,
+		"v",
+		"verbose",
+		false,
+		"Show stacktrace, if error.",
+	)
+	cli.Flag(&flags.version, "V", "version", false, "Show version.")
+}
+
+// Process cli flags and ensure no issues
+func validate() {
+	// Short circuit, if version was requested
+	if flags.version {
+		fmt.Println(
+			filepath.Base(os.Args[0]) + " version " + win.Version,
+		)
+		os.Exit(Good)
+	}
+
+	// Validate cli flags
+	switch {
+	case cli.NArg() == 0:
+		cli.Usage(MissingArgument)
+	case cli.NArg() > 1:
+		cli.Usage(ExtraArgument)
+	}
+
+	if (flags.arch != "") && !slices.Contains(archs, flags.arch) {
+		cli.Usage(InvalidOption)
+	}
+
+	if flags.decode {
+		if flags.header == "" {
+			cli.Usage(MissingOption)
+		}
+
+		if flags.regex {
+			cli.Usage(InvalidOption)
+		}
+
+		if _, ok := parseValue(cli.Arg(0)); !ok {
+			cli.Usage(InvalidArgument)
+		}
+	}
+}
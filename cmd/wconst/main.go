@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mjwhitta/cli"
+	"github.com/mjwhitta/errors"
+	"github.com/mjwhitta/log"
+	w32 "github.com/mjwhitta/win/api"
+)
+
+// candidates will return the constants from the requested header,
+// with values for the requested arch.
+func candidates() []w32.Constant {
+	var hdr string
+	var out []w32.Constant
+	var want string = strings.TrimSuffix(flags.header, ".h")
+
+	for _, c := range w32.Constants() {
+		hdr = strings.TrimSuffix(c.Header, ".h")
+
+		if (flags.header != "") && !strings.EqualFold(hdr, want) {
+			continue
+		}
+
+		if (flags.arch != "") && (c.Arch != "") {
+			if c.Arch != flags.arch {
+				continue
+			}
+		}
+
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// decode will return the flags set in a value, sorted by value, and
+// any bits that aren't a known flag.
+func decode(v uint64) ([]w32.Constant, uint64) {
+	var found []w32.Constant
+	var known uint64
+	var match string = normalize(flags.match)
+	var ok bool
+	var u uint64
+
+	for _, c := range candidates() {
+		if !strings.Contains(normalize(c.C), match) &&
+			!strings.Contains(normalize(c.Go), match) {
+			continue
+		}
+
+		// Only single bits are flags
+		if u, ok = c.Uint(); !ok || (u == 0) || ((u & (u - 1)) != 0) {
+			continue
+		}
+
+		if (v & u) == 0 {
+			continue
+		}
+
+		found = append(found, c)
+		known |= u
+	}
+
+	sort.SliceStable(
+		found,
+		func(i int, j int) bool {
+			var l uint64
+			var r uint64
+
+			l, _ = found[i].Uint()
+			r, _ = found[j].Uint()
+
+			return l < r
+		},
+	)
+
+	return found, v &^ known
+}
+
+// format will return the value of a constant for display.
+func format(c w32.Constant) string {
+	var u uint64
+
+	switch c.Type {
+	case "string":
+		return strconv.Quote(c.Value)
+	case "uint64", "uintptr":
+		u, _ = c.Uint()
+		return fmt.Sprintf("0x%x (%s)", u, c.Value)
+	}
+
+	return c.Value
+}
+
+func main() {
+	defer func() {
+		if r := recover(); r != nil {
+			if flags.verbose {
+				panic(r)
+			}
+
+			switch r := r.(type) {
+			case error:
+				log.ErrX(Exception, r.Error())
+			case string:
+				log.ErrX(Exception, r)
+			}
+		}
+	}()
+
+	var e error
+	var found []w32.Constant
+	var ok bool
+	var query string
+	var unknown uint64
+	var v uint64
+
+	// Parse here, rather than in init(), so tests can use the flags
+	cli.Parse()
+	validate()
+
+	query = cli.Arg(0)
+
+	switch {
+	case flags.decode:
+		v, _ = parseValue(query)
+
+		if found, unknown = decode(v); len(found) == 0 {
+			panic(errors.Newf("no flags found in 0x%x", v))
+		}
+
+		fmt.Printf("0x%x (%d)\n\n", v, v)
+		show(found)
+
+		if unknown != 0 {
+			fmt.Printf("\nUnknown bits: 0x%x\n", unknown)
+		}
+
+		return
+	case flags.regex:
+		if found, e = searchRegex(query); e != nil {
+			panic(e)
+		}
+	default:
+		if v, ok = parseValue(query); ok {
+			found = searchValue(v)
+		} else {
+			found = searchName(query)
+		}
+	}
+
+	if len(found) == 0 {
+		panic(errors.Newf("no constants found for %s", query))
+	}
+
+	show(found)
+}
+
+// normalize will return a name in lowercase without underscores, so
+// C and Go spellings can be compared.
+func normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// parseValue will parse a decimal, hex, or octal value. Negative
+// values are returned in two's complement.
+func parseValue(s string) (uint64, bool) {
+	if n, e := strconv.ParseInt(s, 0, 64); (e == nil) && (n < 0) {
+		return uint64(n), true
+	}
+
+	if u, e := strconv.ParseUint(s, 0, 64); e == nil {
+		return u, true
+	}
+
+	return 0, false
+}
+
+// searchName will return the constants with a C or Go name that
+// contains the query, in either spelling.
+func searchName(query string) []w32.Constant {
+	var found []w32.Constant
+
+	query = normalize(query)
+
+	for _, c := range candidates() {
+		if strings.Contains(normalize(c.C), query) ||
+			strings.Contains(normalize(c.Go), query) {
+			found = append(found, c)
+		}
+	}
+
+	return found
+}
+
+// searchRegex will return the constants with a C or Go name that
+// matches the regular expression.
+func searchRegex(query string) ([]w32.Constant, error) {
+	var e error
+	var found []w32.Constant
+	var re *regexp.Regexp
+
+	if re, e = regexp.Compile(query); e != nil {
+		return nil, errors.Newf("invalid regex %s: %w", query, e)
+	}
+
+	for _, c := range candidates() {
+		switch {
+		case (c.C != "") && re.MatchString(c.C):
+			found = append(found, c)
+		case re.MatchString(c.Go):
+			found = append(found, c)
+		}
+	}
+
+	return found, nil
+}
+
+// searchValue will return the integer constants with a value. Values
+// of negative constants also match as a 32-bit value, as they are
+// often passed as a DWORD.
+func searchValue(v uint64) []w32.Constant {
+	var found []w32.Constant
+	var ok bool
+	var u uint64
+
+	for _, c := range candidates() {
+		if u, ok = c.Uint(); !ok {
+			continue
+		}
+
+		switch {
+		case u == v:
+			found = append(found, c)
+		case (c.Type == "int") && (uint64(uint32(u)) == v):
+			found = append(found, c)
+		}
+	}
+
+	return found
+}
+
+// show will print a table of constants.
+func show(found []w32.Constant) {
+	var arch bool
+	var name string
+	var w *tabwriter.Writer
+
+	//nolint:mnd // Tab width and padding
+	w = tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+	for _, c := range found {
+		if c.Arch != "" {
+			arch = true
+		}
+	}
+
+	for _, c := range found {
+		// C names are only known if the headers were processed
+		if name = c.C; name == "" {
+			name = "-"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t", c.Header, name, c.Go)
+
+		if arch {
+			_, _ = fmt.Fprintf(w, "%s\t", c.Arch)
+		}
+
+		_, _ = fmt.Fprintln(w, format(c))
+	}
+
+	_ = w.Flush()
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	w32 "github.com/mjwhitta/win/api"
+)
+
+// find will return whether a constant with the Go name and arch was
+// found.
+func find(found []w32.Constant, g string, arch string) bool {
+	for _, c := range found {
+		if (c.Go == g) && (c.Arch == arch) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestSearchArch(t *testing.T) {
+	var found []w32.Constant
+
+	defer func() {
+		flags.arch = ""
+	}()
+
+	for _, arch := range archs {
+		flags.arch = arch
+
+		found = searchName("IMAGE_ORDINAL_FLAG")
+		if !find(found, "WinntImageOrdinalFlag", arch) {
+			t.Errorf("%s: IMAGE_ORDINAL_FLAG not found", arch)
+		}
+
+		for _, c := range found {
+			if (c.Arch != "") && (c.Arch != arch) {
+				t.Errorf("%s: got %s value", arch, c.Arch)
+			}
+		}
+	}
+
+	flags.arch = "386"
+
+	found = searchName("MEMORY_ALLOCATION_ALIGNMENT")
+	if (len(found) != 1) || (found[0].Value != "8") {
+		t.Errorf("386: got %v, want 8", found)
+	}
+}
+
+func TestSearchName(t *testing.T) {
+	var found []w32.Constant
+
+	// C and Go spellings
+	for _, name := range []string{"ICU_ESCAPE", "IcuEscape"} {
+		found = searchName(name)
+		if !find(found, "WinhttpIcuEscape", "") {
+			t.Errorf("%s: not found", name)
+		}
+	}
+}
+
+func TestSearchRegex(t *testing.T) {
+	var e error
+	var found []w32.Constant
+
+	if found, e = searchRegex("^ICU_"); e != nil {
+		t.Fatal(e)
+	} else if !find(found, "WinhttpIcuEscape", "") {
+		t.Errorf("ICU_ESCAPE not found in %v", found)
+	}
+
+	for _, c := range found {
+		if !strings.HasPrefix(c.C, "ICU_") {
+			t.Errorf("got %s (%s)", c.Go, c.C)
+		}
+	}
+
+	// Go names still match
+	if found, e = searchRegex("^WinhttpIcuEscape$"); e != nil {
+		t.Fatal(e)
+	} else if len(found) != 1 {
+		t.Errorf("got %v", found)
+	}
+
+	if _, e = searchRegex("("); e == nil {
+		t.Error("expected error for invalid regex")
+	}
+}
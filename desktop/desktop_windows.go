@@ -104,10 +104,10 @@ func SetWallpaper(img string, style uint) error {
 	// return an error. That does not, however, appear to be the case.
 	// It never returns an error.
 	e = w32.SystemParametersInfoA(
-		w32.Winuser.SpiSetdeskwallpaper,
+		w32.WinuserSpiSetdeskwallpaper,
 		0,
 		fn,
-		w32.Winuser.SpifSendchange|w32.Winuser.SpifUpdateinifile,
+		w32.WinuserSpifSendchange|w32.WinuserSpifUpdateinifile,
 	)
 	if e != nil {
 		return errors.Newf(
@@ -0,0 +1,94 @@
+package filejar
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Entry is a single stored cookie.
+type Entry struct {
+	Name     string        `json:"name"`
+	Value    string        `json:"value"`
+	Domain   string        `json:"domain"`
+	Path     string        `json:"path"`
+	Expires  time.Time     `json:"expires,omitzero"`
+	HostOnly bool          `json:"hostOnly"`
+	HTTPOnly bool          `json:"httpOnly"`
+	Secure   bool          `json:"secure"`
+	SameSite http.SameSite `json:"sameSite,omitempty"`
+	Created  time.Time     `json:"created"`
+}
+
+func canonicalHost(host string) string {
+	if h, _, e := net.SplitHostPort(host); e == nil {
+		host = h
+	}
+
+	host = strings.TrimSuffix(host, ".")
+	host = strings.Trim(host, "[]")
+
+	return strings.ToLower(host)
+}
+
+func defaultPath(path string) string {
+	var i int
+
+	if (path == "") || (path[0] != '/') {
+		return "/"
+	}
+
+	if i = strings.LastIndex(path, "/"); i == 0 {
+		return "/"
+	}
+
+	return path[:i]
+}
+
+func isIP(host string) bool {
+	return net.ParseIP(host) != nil
+}
+
+// cookie will return the cookie to send in a request.
+func (e *Entry) cookie() *http.Cookie {
+	return &http.Cookie{Name: e.Name, Value: e.Value}
+}
+
+func (e *Entry) domainMatch(host string) bool {
+	if host == e.Domain {
+		return true
+	}
+
+	return !e.HostOnly && !isIP(host) &&
+		strings.HasSuffix(host, "."+e.Domain)
+}
+
+func (e *Entry) expired(now time.Time) bool {
+	return !e.Expires.IsZero() && !e.Expires.After(now)
+}
+
+func (e *Entry) id() string {
+	return e.Domain + ";" + e.Path + ";" + e.Name
+}
+
+func (e *Entry) pathMatch(path string) bool {
+	if path == e.Path {
+		return true
+	}
+
+	if !strings.HasPrefix(path, e.Path) {
+		return false
+	}
+
+	if strings.HasSuffix(e.Path, "/") {
+		return true
+	}
+
+	return path[len(e.Path)] == '/'
+}
+
+func (e *Entry) shouldSend(https bool, host, path string) bool {
+	return e.domainMatch(host) && e.pathMatch(path) &&
+		(https || !e.Secure)
+}
@@ -0,0 +1,172 @@
+package filejar
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjwhitta/errors"
+)
+
+// Format is a cookie file format.
+type Format int
+
+// Supported formats
+const (
+	// Netscape is the cookies.txt format used by curl, wget, and
+	// browser export extensions.
+	Netscape Format = iota
+
+	// JSON is a list of Entry values.
+	JSON
+)
+
+const httpOnlyPrefix string = "#HttpOnly_"
+
+func readJSON(r io.Reader) ([]Entry, error) {
+	var e error
+	var entries []Entry
+
+	if e = json.NewDecoder(r).Decode(&entries); e != nil {
+		if e == io.EOF {
+			return nil, nil
+		}
+
+		return nil, errors.Newf("failed to parse JSON: %w", e)
+	}
+
+	return entries, nil
+}
+
+func readNetscape(r io.Reader) ([]Entry, error) {
+	var e error
+	var entries []Entry
+	var entry Entry
+	var expires int64
+	var fields []string
+	var line string
+	var n int
+	var s *bufio.Scanner = bufio.NewScanner(r)
+
+	for s.Scan() {
+		n++
+		line = strings.TrimRight(s.Text(), "\r")
+		entry = Entry{}
+
+		if strings.HasPrefix(line, httpOnlyPrefix) {
+			entry.HTTPOnly = true
+			line = strings.TrimPrefix(line, httpOnlyPrefix)
+		}
+
+		if (strings.TrimSpace(line) == "") || (line[0] == '#') {
+			continue
+		}
+
+		// Netscape format has 7 fields, but empty values are often
+		// trimmed
+		fields = strings.Split(line, "\t")
+		switch len(fields) {
+		case 6: //nolint:mnd // Missing value
+			fields = append(fields, "")
+		case 7: //nolint:mnd // All fields
+		default:
+			return nil, errors.Newf("line %d: invalid cookie", n)
+		}
+
+		entry.Domain = strings.ToLower(fields[0])
+		entry.HostOnly = !strings.EqualFold(fields[1], "TRUE")
+		entry.Path = fields[2]
+		entry.Secure = strings.EqualFold(fields[3], "TRUE")
+		entry.Name = fields[5]
+		entry.Value = fields[6]
+
+		// A leading dot also means subdomains are included
+		if strings.HasPrefix(entry.Domain, ".") {
+			entry.Domain = entry.Domain[1:]
+			entry.HostOnly = false
+		}
+
+		expires, e = strconv.ParseInt(fields[4], 10, 64)
+		if e != nil {
+			return nil, errors.Newf("line %d: invalid expiry", n)
+		}
+
+		if expires > 0 {
+			entry.Expires = time.Unix(expires, 0).UTC()
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if e = s.Err(); e != nil {
+		return nil, errors.Newf("failed to read cookies: %w", e)
+	}
+
+	return entries, nil
+}
+
+func writeJSON(w io.Writer, entries []Entry) error {
+	var enc *json.Encoder = json.NewEncoder(w)
+
+	if entries == nil {
+		entries = []Entry{}
+	}
+
+	enc.SetIndent("", "  ")
+
+	if e := enc.Encode(entries); e != nil {
+		return errors.Newf("failed to write JSON: %w", e)
+	}
+
+	return nil
+}
+
+func writeNetscape(w io.Writer, entries []Entry) error {
+	var bw *bufio.Writer = bufio.NewWriter(w)
+	var domain string
+	var expires int64
+	var prefix string
+
+	_, _ = bw.WriteString("# Netscape HTTP Cookie File\n\n")
+
+	for _, entry := range entries {
+		domain = entry.Domain
+		expires = 0
+		prefix = ""
+
+		if !entry.HostOnly {
+			domain = "." + domain
+		}
+
+		if !entry.Expires.IsZero() {
+			expires = entry.Expires.Unix()
+		}
+
+		if entry.HTTPOnly {
+			prefix = httpOnlyPrefix
+		}
+
+		_, _ = fmt.Fprintf(
+			bw,
+			"%s%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			prefix,
+			domain,
+			strings.ToUpper(strconv.FormatBool(!entry.HostOnly)),
+			entry.Path,
+			strings.ToUpper(strconv.FormatBool(entry.Secure)),
+			expires,
+			entry.Name,
+			entry.Value,
+		)
+	}
+
+	if e := bw.Flush(); e != nil {
+		return errors.Newf("failed to write cookies: %w", e)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package filejar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// expires is a fixed, whole second expiry, as Netscape files only
+// store seconds.
+var expires time.Time = time.Date(
+	2030, time.January, 2, 3, 4, 5, 0, time.UTC,
+)
+
+// same will return whether two entries match, ignoring fields the
+// Netscape format doesn't store.
+func same(a Entry, b Entry) bool {
+	return (a.Name == b.Name) && (a.Value == b.Value) &&
+		(a.Domain == b.Domain) && (a.Path == b.Path) &&
+		a.Expires.Equal(b.Expires) && (a.HostOnly == b.HostOnly) &&
+		(a.HTTPOnly == b.HTTPOnly) && (a.Secure == b.Secure)
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	var entries []Entry = []Entry{
+		{
+			Domain:   "example.com",
+			Expires:  expires,
+			HostOnly: true,
+			Name:     "a",
+			Path:     "/",
+			Value:    "1",
+		},
+		{
+			Domain:   "example.com",
+			Expires:  expires,
+			HTTPOnly: true,
+			Name:     "b",
+			Path:     "/api",
+			Secure:   true,
+			Value:    "",
+		},
+		{
+			Domain: "example.org",
+			Name:   "session",
+			Path:   "/",
+			Value:  "x",
+		},
+	}
+	var tests = map[string]struct {
+		read  func(r *bytes.Buffer) ([]Entry, error)
+		write func(w *bytes.Buffer, entries []Entry) error
+	}{
+		"JSON": {
+			read: func(r *bytes.Buffer) ([]Entry, error) {
+				return readJSON(r)
+			},
+			write: func(w *bytes.Buffer, entries []Entry) error {
+				return writeJSON(w, entries)
+			},
+		},
+		"Netscape": {
+			read: func(r *bytes.Buffer) ([]Entry, error) {
+				return readNetscape(r)
+			},
+			write: func(w *bytes.Buffer, entries []Entry) error {
+				return writeNetscape(w, entries)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var buf bytes.Buffer
+		var e error
+		var got []Entry
+
+		if e = test.write(&buf, entries); e != nil {
+			t.Fatalf("%s: %s", name, e)
+		}
+
+		if got, e = test.read(&buf); e != nil {
+			t.Fatalf("%s: %s", name, e)
+		}
+
+		if len(got) != len(entries) {
+			t.Fatalf("%s: got %d entries", name, len(got))
+		}
+
+		for i := range entries {
+			if !same(got[i], entries[i]) {
+				t.Errorf(
+					"%s: got %+v, want %+v",
+					name,
+					got[i],
+					entries[i],
+				)
+			}
+		}
+	}
+}
+
+func TestReadNetscape(t *testing.T) {
+	var tests = map[string]Entry{
+		// Host-only
+		"example.com\tFALSE\t/\tFALSE\t0\ta\t1": {
+			Domain:   "example.com",
+			HostOnly: true,
+			Name:     "a",
+			Path:     "/",
+			Value:    "1",
+		},
+		// Leading dot means subdomains are included
+		".Example.com\tFALSE\t/\tTRUE\t1893553445\ta\t1": {
+			Domain:  "example.com",
+			Expires: expires,
+			Name:    "a",
+			Path:    "/",
+			Secure:  true,
+			Value:   "1",
+		},
+		// HttpOnly prefix, with trimmed empty value
+		"#HttpOnly_.example.com\tTRUE\t/\tFALSE\t0\ta": {
+			Domain:   "example.com",
+			HTTPOnly: true,
+			Name:     "a",
+			Path:     "/",
+		},
+	}
+
+	for line, want := range tests {
+		var e error
+		var got []Entry
+		var r *strings.Reader = strings.NewReader(
+			"# Netscape HTTP Cookie File\n\n" + line + "\r\n",
+		)
+
+		if got, e = readNetscape(r); e != nil {
+			t.Errorf("%q: %s", line, e)
+		} else if (len(got) != 1) || !same(got[0], want) {
+			t.Errorf("%q: got %+v, want %+v", line, got, want)
+		}
+	}
+
+	for _, line := range []string{
+		"example.com\tFALSE\t/",
+		"example.com\tFALSE\t/\tFALSE\tsoon\ta\t1",
+	} {
+		if _, e := readNetscape(strings.NewReader(line)); e == nil {
+			t.Errorf("%q: expected error", line)
+		}
+	}
+}
+
+func TestWriteNetscape(t *testing.T) {
+	var buf bytes.Buffer
+	var e error
+	var want string = "#HttpOnly_.example.com\tTRUE\t/\tFALSE\t0\t" +
+		"a\t1\n"
+
+	e = writeNetscape(
+		&buf,
+		[]Entry{
+			{
+				Domain:   "example.com",
+				HTTPOnly: true,
+				Name:     "a",
+				Path:     "/",
+				Value:    "1",
+			},
+		},
+	)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if !strings.HasSuffix(buf.String(), "\n"+want) {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
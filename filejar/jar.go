@@ -0,0 +1,418 @@
+package filejar
+
+import (
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mjwhitta/errors"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Jar is a platform-independent http.CookieJar, which can be saved to
+// and loaded from disk. It follows the RFC 6265 rules, including
+// rejecting cookies set for public suffixes. A Jar is safe for
+// concurrent use.
+type Jar struct {
+	// KeepSession determines whether session cookies (those without
+	// an expiration) are also saved.
+	KeepSession bool
+
+	entries map[string]*Entry
+	format  Format
+	mutex   sync.Mutex
+	path    string
+	psl     cookiejar.PublicSuffixList
+}
+
+// New will return a pointer to a new Jar instance, backed by the
+// provided file. If the file exists, it is loaded. The format is
+// JSON if the file has a .json extension, otherwise it is the
+// Netscape cookies.txt format. An empty path results in a Jar that
+// is only kept in memory.
+func New(path string) (*Jar, error) {
+	var e error
+	var f *os.File
+	var j *Jar = &Jar{
+		entries: map[string]*Entry{},
+		format:  Netscape,
+		path:    path,
+		psl:     publicsuffix.List,
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		j.format = JSON
+	}
+
+	if path == "" {
+		return j, nil
+	}
+
+	if f, e = os.Open(filepath.Clean(path)); e != nil {
+		if os.IsNotExist(e) {
+			return j, nil
+		}
+
+		return nil, errors.Newf("failed to open %s: %w", path, e)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	if e = j.Import(f, j.format); e != nil {
+		return nil, errors.Newf("failed to load %s: %w", path, e)
+	}
+
+	return j, nil
+}
+
+// Clear will remove all cookies.
+func (j *Jar) Clear() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.entries = map[string]*Entry{}
+}
+
+// Cookies will return the cookies to send in a request to the
+// provided URL.
+func (j *Jar) Cookies(u *url.URL) []*http.Cookie {
+	var cookies []*http.Cookie
+	var host string
+	var https bool
+	var matches []*Entry
+	var now time.Time = time.Now()
+	var path string
+
+	if (u.Scheme != "http") && (u.Scheme != "https") {
+		return nil
+	}
+
+	host = canonicalHost(u.Host)
+	https = u.Scheme == "https"
+
+	if path = u.Path; path == "" {
+		path = "/"
+	}
+
+	j.mutex.Lock()
+
+	for id, entry := range j.entries {
+		if entry.expired(now) {
+			delete(j.entries, id)
+			continue
+		}
+
+		if entry.shouldSend(https, host, path) {
+			matches = append(matches, entry)
+		}
+	}
+
+	// Longer paths first, then older cookies first
+	sort.Slice(
+		matches,
+		func(a, b int) bool {
+			if len(matches[a].Path) != len(matches[b].Path) {
+				return len(matches[a].Path) > len(matches[b].Path)
+			}
+
+			return matches[a].Created.Before(matches[b].Created)
+		},
+	)
+
+	for _, entry := range matches {
+		cookies = append(cookies, entry.cookie())
+	}
+
+	j.mutex.Unlock()
+
+	return cookies
+}
+
+// Entries will return a copy of all unexpired cookies, sorted by
+// domain, path, and name.
+func (j *Jar) Entries() []Entry {
+	var entries []Entry
+	var now time.Time = time.Now()
+
+	j.mutex.Lock()
+
+	for _, entry := range j.entries {
+		if !entry.expired(now) {
+			entries = append(entries, *entry)
+		}
+	}
+
+	j.mutex.Unlock()
+
+	sort.Slice(
+		entries,
+		func(a, b int) bool {
+			return entries[a].id() < entries[b].id()
+		},
+	)
+
+	return entries
+}
+
+// Export will write all unexpired cookies in the provided format.
+// Session cookies are only included if KeepSession is true.
+func (j *Jar) Export(w io.Writer, f Format) error {
+	var entries []Entry
+
+	for _, entry := range j.Entries() {
+		if j.KeepSession || !entry.Expires.IsZero() {
+			entries = append(entries, entry)
+		}
+	}
+
+	switch f {
+	case JSON:
+		return writeJSON(w, entries)
+	case Netscape:
+		return writeNetscape(w, entries)
+	default:
+		return errors.Newf("unknown format %d", f)
+	}
+}
+
+// Import will read cookies in the provided format, replacing any
+// existing cookies with the same domain, path, and name. Expired
+// cookies are ignored, as are cookies that SetCookies would reject
+// (e.g. cookies for a public suffix).
+func (j *Jar) Import(r io.Reader, f Format) error {
+	var e error
+	var entries []Entry
+	var now time.Time = time.Now()
+
+	switch f {
+	case JSON:
+		entries, e = readJSON(r)
+	case Netscape:
+		entries, e = readNetscape(r)
+	default:
+		e = errors.Newf("unknown format %d", f)
+	}
+
+	if e != nil {
+		return e
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for _, entry := range entries {
+		if entry.expired(now) {
+			continue
+		}
+
+		if e = j.validate(&entry); e != nil {
+			continue
+		}
+
+		if entry.Created.IsZero() {
+			entry.Created = now
+		}
+
+		j.entries[entry.id()] = &entry
+	}
+
+	return nil
+}
+
+// newEntry will return the Entry for a cookie received from the
+// provided URL, and whether the cookie deletes any existing Entry.
+func (j *Jar) newEntry(
+	u *url.URL,
+	c *http.Cookie,
+	now time.Time,
+) (*Entry, bool, error) {
+	var e error
+	var entry *Entry = &Entry{
+		Created:  now,
+		HTTPOnly: c.HttpOnly,
+		Name:     c.Name,
+		Path:     c.Path,
+		SameSite: c.SameSite,
+		Secure:   c.Secure,
+		Value:    c.Value,
+	}
+	var host string = canonicalHost(u.Host)
+
+	if (entry.Path == "") || (entry.Path[0] != '/') {
+		entry.Path = defaultPath(u.Path)
+	}
+
+	if e = j.setDomain(entry, host, c.Domain); e != nil {
+		return nil, false, e
+	}
+
+	switch {
+	case c.MaxAge < 0:
+		return entry, true, nil
+	case c.MaxAge > 0:
+		entry.Expires = now.Add(time.Duration(c.MaxAge) * time.Second)
+	case !c.Expires.IsZero():
+		if !c.Expires.After(now) {
+			return entry, true, nil
+		}
+
+		entry.Expires = c.Expires.UTC()
+	}
+
+	return entry, false, nil
+}
+
+// Save will write the cookies to the backing file, replacing it
+// atomically.
+func (j *Jar) Save() (e error) {
+	var tmp *os.File
+
+	if j.path == "" {
+		return errors.New("no file to save to")
+	}
+
+	tmp, e = os.CreateTemp(filepath.Dir(j.path), ".cookies-*")
+	if e != nil {
+		return errors.Newf("failed to create temp file: %w", e)
+	}
+	defer func() {
+		if e != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if e = j.Export(tmp, j.format); e != nil {
+		return e
+	}
+
+	if e = tmp.Close(); e != nil {
+		return errors.Newf("failed to close %s: %w", tmp.Name(), e)
+	}
+
+	if e = os.Rename(tmp.Name(), j.path); e != nil {
+		return errors.Newf("failed to save %s: %w", j.path, e)
+	}
+
+	return nil
+}
+
+// SetCookies will store the cookies received from the provided URL.
+// Invalid cookies are ignored, per the http.CookieJar interface.
+func (j *Jar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	var del bool
+	var e error
+	var entry *Entry
+	var now time.Time = time.Now()
+
+	if (u.Scheme != "http") && (u.Scheme != "https") {
+		return
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for _, c := range cookies {
+		if entry, del, e = j.newEntry(u, c, now); e != nil {
+			continue
+		}
+
+		if del {
+			delete(j.entries, entry.id())
+			continue
+		}
+
+		// Keep original creation time for ordering
+		if old, ok := j.entries[entry.id()]; ok {
+			entry.Created = old.Created
+		}
+
+		j.entries[entry.id()] = entry
+	}
+}
+
+func (j *Jar) setDomain(entry *Entry, host, domain string) error {
+	domain = strings.ToLower(strings.TrimPrefix(domain, "."))
+	domain = strings.TrimSuffix(domain, ".")
+
+	// No Domain attribute means host-only
+	if domain == "" {
+		entry.Domain = host
+		entry.HostOnly = true
+
+		return nil
+	}
+
+	if isIP(host) {
+		if domain != host {
+			return errors.Newf("cookie domain %s invalid", domain)
+		}
+
+		entry.Domain = host
+		entry.HostOnly = true
+
+		return nil
+	}
+
+	// Cookies can't be set for a public suffix, unless it is the
+	// host, in which case they are host-only
+	if (j.psl != nil) && (j.psl.PublicSuffix(domain) == domain) {
+		if domain != host {
+			return errors.Newf("cookie domain %s invalid", domain)
+		}
+
+		entry.Domain = host
+		entry.HostOnly = true
+
+		return nil
+	}
+
+	if (host != domain) && !strings.HasSuffix(host, "."+domain) {
+		return errors.Newf("cookie domain %s invalid", domain)
+	}
+
+	entry.Domain = domain
+
+	return nil
+}
+
+// validate will check an imported Entry with the same domain rules
+// as SetCookies. The host that set the cookie is unknown, so domain
+// cookies must not be for an IP or a public suffix.
+func (j *Jar) validate(entry *Entry) error {
+	var domain string = strings.TrimPrefix(entry.Domain, ".")
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	if domain == "" {
+		return errors.New("cookie domain missing")
+	}
+
+	entry.Domain = domain
+
+	if (entry.Path == "") || (entry.Path[0] != '/') {
+		entry.Path = "/"
+	}
+
+	if entry.HostOnly {
+		return nil
+	}
+
+	if isIP(domain) {
+		return errors.Newf("cookie domain %s invalid", domain)
+	}
+
+	if (j.psl != nil) && (j.psl.PublicSuffix(domain) == domain) {
+		return errors.Newf("cookie domain %s invalid", domain)
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package filejar
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newJar will return an in-memory Jar.
+func newJar(t *testing.T) *Jar {
+	var e error
+	var j *Jar
+
+	if j, e = New(""); e != nil {
+		t.Fatal(e)
+	}
+
+	return j
+}
+
+// names will return the names of the cookies to send to a URL.
+func names(j *Jar, uri string) string {
+	var out []string
+	var u *url.URL
+
+	u, _ = url.Parse(uri)
+
+	for _, c := range j.Cookies(u) {
+		out = append(out, c.Name)
+	}
+
+	return strings.Join(out, ",")
+}
+
+func TestExpired(t *testing.T) {
+	var e error
+	var j *Jar = newJar(t)
+	var now time.Time = time.Now()
+
+	j.entries = map[string]*Entry{}
+
+	for i, exp := range []time.Time{
+		now.Add(-time.Minute),
+		{},
+		now.Add(time.Hour),
+	} {
+		var entry *Entry = &Entry{
+			Created: now.Add(time.Duration(i) * time.Second),
+			Domain:  "example.com",
+			Expires: exp,
+			Name:    []string{"expired", "session", "valid"}[i],
+			Path:    "/",
+		}
+
+		j.entries[entry.id()] = entry
+	}
+
+	if got := len(j.Entries()); got != 2 {
+		t.Errorf("got %d entries, want 2", got)
+	}
+
+	// Expired entries are pruned when cookies are matched
+	got := names(j, "http://example.com/")
+	if got != "session,valid" {
+		t.Errorf("got %s, want session,valid", got)
+	}
+
+	if _, ok := j.entries["example.com;/;expired"]; ok {
+		t.Error("expired entry not pruned")
+	}
+
+	// Expired entries aren't imported
+	e = j.Import(
+		strings.NewReader(
+			"example.com\tFALSE\t/\tFALSE\t1\told\t1\n",
+		),
+		Netscape,
+	)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if _, ok := j.entries["example.com;/;old"]; ok {
+		t.Error("expired entry imported")
+	}
+}
+
+func TestImportPublicSuffix(t *testing.T) {
+	var e error
+	var j *Jar = newJar(t)
+	var tests = map[string]bool{
+		".co.uk\tTRUE\t/\tFALSE\t0\tpsl\t1":        false,
+		".com\tTRUE\t/\tFALSE\t0\tpsl\t1":          false,
+		".10.0.0.1\tTRUE\t/\tFALSE\t0\tip\t1":      false,
+		"\tFALSE\t/\tFALSE\t0\tnodomain\t1":        false,
+		".example.co.uk\tTRUE\t/\tFALSE\t0\tok\t1": true,
+		"10.0.0.1\tFALSE\t/\tFALSE\t0\thostip\t1":  true,
+	}
+
+	j.KeepSession = true
+
+	for line, want := range tests {
+		var got bool
+
+		j.Clear()
+
+		e = j.Import(strings.NewReader(line+"\n"), Netscape)
+		if e != nil {
+			t.Fatalf("%q: %s", line, e)
+		}
+
+		if got = len(j.Entries()) == 1; got != want {
+			t.Errorf("%q: imported %v, want %v", line, got, want)
+		}
+	}
+
+	// Imported domains and paths are normalized like SetCookies
+	j.Clear()
+
+	e = j.Import(
+		strings.NewReader("Example.COM.\tFALSE\t\tFALSE\t0\tnorm\t1"),
+		Netscape,
+	)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	if got := names(j, "http://example.com/a/b"); got != "norm" {
+		t.Errorf("got %q, want norm", got)
+	}
+}
+
+func TestJarRoundTrip(t *testing.T) {
+	var dir string = t.TempDir()
+	var e error
+	var future time.Time = time.Now().Add(time.Hour)
+	var u *url.URL
+
+	u, _ = url.Parse("https://www.example.com/a/b")
+
+	for _, name := range []string{"cookies.txt", "cookies.json"} {
+		var j *Jar
+		var loaded *Jar
+		var path string = filepath.Join(dir, name)
+
+		if j, e = New(path); e != nil {
+			t.Fatal(e)
+		}
+
+		j.SetCookies(
+			u,
+			[]*http.Cookie{
+				{Expires: future, Name: "a", Value: "1"},
+				{
+					Domain:   "example.com",
+					Expires:  future,
+					HttpOnly: true,
+					Name:     "b",
+					Path:     "/",
+					Secure:   true,
+					Value:    "2",
+				},
+				{Name: "session", Value: "3"},
+			},
+		)
+
+		if e = j.Save(); e != nil {
+			t.Fatalf("%s: %s", name, e)
+		}
+
+		if _, e = os.Stat(path); e != nil {
+			t.Fatalf("%s: %s", name, e)
+		}
+
+		if loaded, e = New(path); e != nil {
+			t.Fatalf("%s: %s", name, e)
+		}
+
+		// Session cookies aren't saved, by default
+		if got := names(loaded, u.String()); got != "a,b" {
+			t.Errorf("%s: got %q, want a,b", name, got)
+		}
+
+		if got := names(loaded, "http://example.com/"); got != "" {
+			t.Errorf("%s: got %q for insecure request", name, got)
+		}
+
+		for _, entry := range loaded.Entries() {
+			if (entry.Name == "b") && !entry.HTTPOnly {
+				t.Errorf("%s: HttpOnly not kept", name)
+			}
+		}
+	}
+}
+
+func TestSetCookiesDomain(t *testing.T) {
+	var tests = []struct {
+		uri    string
+		domain string
+		want   string
+	}{
+		// Host-only
+		{"http://www.example.com/", "", "www.example.com"},
+		{"http://www.example.com/", "example.com", "example.com"},
+		{"http://www.example.com/", ".Example.com", "example.com"},
+		// Not a parent domain
+		{"http://www.example.com/", "other.com", ""},
+		// Public suffixes
+		{"http://www.example.co.uk/", "co.uk", ""},
+		{"http://www.example.com/", "com", ""},
+		// Unless it is the host, as a host-only cookie
+		{"http://co.uk/", "co.uk", "co.uk"},
+		// IPs
+		{"http://10.0.0.1/", "10.0.0.1", "10.0.0.1"},
+		{"http://10.0.0.1/", "0.0.1", ""},
+	}
+
+	for _, test := range tests {
+		var entries []Entry
+		var j *Jar = newJar(t)
+		var u *url.URL
+
+		u, _ = url.Parse(test.uri)
+		j.SetCookies(
+			u,
+			[]*http.Cookie{
+				{Domain: test.domain, Name: "a", Value: "1"},
+			},
+		)
+
+		entries = j.Entries()
+
+		switch {
+		case test.want == "":
+			if len(entries) != 0 {
+				t.Errorf(
+					"%s %s: got %s",
+					test.uri,
+					test.domain,
+					entries[0].Domain,
+				)
+			}
+		case len(entries) != 1:
+			t.Errorf("%s %s: not set", test.uri, test.domain)
+		case entries[0].Domain != test.want:
+			t.Errorf(
+				"%s %s: got %s, want %s",
+				test.uri,
+				test.domain,
+				entries[0].Domain,
+				test.want,
+			)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package formdata
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mjwhitta/errors"
+)
+
+// Body is a multipart/form-data request body, which streams files
+// from disk as it is read, rather than buffering them in memory.
+type Body struct {
+	// ContentLength is the exact length of the encoded body.
+	ContentLength int64
+
+	boundary string
+	fields   url.Values
+	files    []*file
+	pr       *io.PipeReader
+	pw       *io.PipeWriter
+	start    sync.Once
+}
+
+type counter int64
+
+type file struct {
+	contentType string
+	field       string
+	path        string
+	size        int64
+}
+
+var quoteEscaper *strings.Replacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"\"", "\\\"",
+)
+
+// New will return a pointer to a new Body instance for the provided
+// form fields and files. Files are indexed by form field name, like
+// fields, so a field can have multiple files. Their content types
+// are detected from their extensions or contents.
+func New(
+	fields url.Values,
+	files map[string][]string,
+) (*Body, error) {
+	var b *Body = &Body{fields: fields}
+	var e error
+	var f *file
+	var names []string
+
+	b.boundary = multipart.NewWriter(io.Discard).Boundary()
+
+	// Create the pipe now, so Read and Close never race to create it
+	b.pr, b.pw = io.Pipe()
+
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, path := range files[name] {
+			if f, e = newFile(name, path); e != nil {
+				return nil, e
+			}
+
+			b.files = append(b.files, f)
+		}
+	}
+
+	if b.ContentLength, e = b.length(); e != nil {
+		return nil, e
+	}
+
+	return b, nil
+}
+
+func detectContentType(path string) (string, error) {
+	var b []byte = make([]byte, 512) //nolint:mnd // Sniff length
+	var e error
+	var f *os.File
+	var n int
+
+	if t := mime.TypeByExtension(filepath.Ext(path)); t != "" {
+		return t, nil
+	}
+
+	if f, e = os.Open(filepath.Clean(path)); e != nil {
+		return "", errors.Newf("failed to open %s: %w", path, e)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	if n, e = io.ReadFull(f, b); (e != nil) && (n == 0) {
+		if e == io.EOF {
+			return "application/octet-stream", nil
+		}
+
+		return "", errors.Newf("failed to read %s: %w", path, e)
+	}
+
+	return http.DetectContentType(b[:n]), nil
+}
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+func newFile(field string, path string) (*file, error) {
+	var e error
+	var f *file = &file{field: field, path: path}
+	var info os.FileInfo
+
+	if info, e = os.Stat(path); e != nil {
+		return nil, errors.Newf("file %s not accessible: %w", path, e)
+	} else if info.IsDir() {
+		return nil, errors.Newf("file %s is a directory", path)
+	}
+
+	f.size = info.Size()
+
+	if f.contentType, e = detectContentType(path); e != nil {
+		return nil, e
+	}
+
+	return f, nil
+}
+
+// Close will stop streaming the body. It is safe to call while
+// another goroutine is reading.
+func (b *Body) Close() error {
+	return b.pr.Close()
+}
+
+// ContentType will return the Content-Type header value, including
+// the boundary.
+func (b *Body) ContentType() string {
+	return "multipart/form-data; boundary=" + b.boundary
+}
+
+// length will return the exact length of the encoded body, by
+// encoding everything but the file contents.
+func (b *Body) length() (int64, error) {
+	var c counter
+	var e error
+	var size int64
+
+	if e = b.write(&c, false); e != nil {
+		return 0, e
+	}
+
+	for _, f := range b.files {
+		size += f.size
+	}
+
+	return int64(c) + size, nil
+}
+
+// Read will read the next chunk of the encoded body. Encoding starts
+// on the first call.
+func (b *Body) Read(p []byte) (int, error) {
+	b.start.Do(
+		func() {
+			go func() {
+				_ = b.pw.CloseWithError(b.write(b.pw, true))
+			}()
+		},
+	)
+
+	return b.pr.Read(p)
+}
+
+func (b *Body) write(w io.Writer, withFiles bool) error {
+	var e error
+	var keys []string
+	var mw *multipart.Writer = multipart.NewWriter(w)
+	var part io.Writer
+
+	if e = mw.SetBoundary(b.boundary); e != nil {
+		return errors.Newf("invalid boundary: %w", e)
+	}
+
+	for k := range b.fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range b.fields[k] {
+			if e = mw.WriteField(k, v); e != nil {
+				return errors.Newf("failed to write %s: %w", k, e)
+			}
+		}
+	}
+
+	for _, f := range b.files {
+		if part, e = mw.CreatePart(f.header()); e != nil {
+			return errors.Newf("failed to create part: %w", e)
+		}
+
+		if !withFiles {
+			continue
+		}
+
+		if e = f.copyTo(part); e != nil {
+			return e
+		}
+	}
+
+	if e = mw.Close(); e != nil {
+		return errors.Newf("failed to finish multipart body: %w", e)
+	}
+
+	return nil
+}
+
+// Write will count the number of bytes written.
+func (c *counter) Write(p []byte) (int, error) {
+	*c += counter(len(p))
+	return len(p), nil
+}
+
+func (f *file) copyTo(w io.Writer) (e error) {
+	var fh *os.File
+	var n int64
+
+	if fh, e = os.Open(filepath.Clean(f.path)); e != nil {
+		return errors.Newf("failed to open %s: %w", f.path, e)
+	}
+	defer func() {
+		// Always close, but keep the first error
+		if ec := fh.Close(); (ec != nil) && (e == nil) {
+			e = errors.Newf("failed to close %s: %w", f.path, ec)
+		}
+	}()
+
+	// Copy exactly the stat'd size, so Content-Length is accurate
+	if n, e = io.CopyN(w, fh, f.size); e != nil {
+		return errors.Newf(
+			"failed to read %s (%d of %d bytes): %w",
+			f.path,
+			n,
+			f.size,
+			e,
+		)
+	}
+
+	return nil
+}
+
+func (f *file) header() textproto.MIMEHeader {
+	var h textproto.MIMEHeader = textproto.MIMEHeader{}
+
+	h.Set(
+		"Content-Disposition",
+		"form-data; name=\""+escapeQuotes(f.field)+"\"; "+
+			"filename=\""+escapeQuotes(filepath.Base(f.path))+"\"",
+	)
+	h.Set("Content-Type", f.contentType)
+
+	return h
+}
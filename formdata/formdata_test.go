@@ -0,0 +1,241 @@
+package formdata
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// part is a decoded multipart part.
+type part struct {
+	contentType string
+	data        string
+	filename    string
+	name        string
+}
+
+// newBody will return a Body for fields and files, which are created
+// in a temp dir from a map of file names to contents.
+func newBody(
+	t *testing.T,
+	fields url.Values,
+	files map[string][]string,
+	contents map[string]string,
+) *Body {
+	var b *Body
+	var dir string = t.TempDir()
+	var e error
+	var paths map[string][]string = map[string][]string{}
+
+	for name, data := range contents {
+		name = filepath.Join(dir, name)
+
+		if e = os.WriteFile(name, []byte(data), 0o600); e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	for field, names := range files {
+		for _, name := range names {
+			name = filepath.Join(dir, name)
+			paths[field] = append(paths[field], name)
+		}
+	}
+
+	if b, e = New(fields, paths); e != nil {
+		t.Fatal(e)
+	}
+
+	return b
+}
+
+// parse will decode an encoded body with mime/multipart.
+func parse(t *testing.T, b *Body, data []byte) []part {
+	var boundary string
+	var e error
+	var mr *multipart.Reader
+	var p *multipart.Part
+	var params map[string]string
+	var parts []part
+	var typ string
+
+	typ, params, e = mime.ParseMediaType(b.ContentType())
+	if e != nil {
+		t.Fatal(e)
+	} else if typ != "multipart/form-data" {
+		t.Fatalf("got %s, want multipart/form-data", typ)
+	}
+
+	if boundary = params["boundary"]; boundary == "" {
+		t.Fatal("missing boundary")
+	}
+
+	mr = multipart.NewReader(bytes.NewReader(data), boundary)
+
+	for {
+		var b []byte
+
+		if p, e = mr.NextPart(); errors.Is(e, io.EOF) {
+			break
+		} else if e != nil {
+			t.Fatal(e)
+		}
+
+		if b, e = io.ReadAll(p); e != nil {
+			t.Fatal(e)
+		}
+
+		parts = append(
+			parts,
+			part{
+				contentType: p.Header.Get("Content-Type"),
+				data:        string(b),
+				filename:    p.FileName(),
+				name:        p.FormName(),
+			},
+		)
+	}
+
+	return parts
+}
+
+func TestBody(t *testing.T) {
+	var b *Body
+	var data []byte
+	var e error
+	var got []part
+	var want []part = []part{
+		{data: "1", name: "a"},
+		{data: "2", name: "a"},
+		{data: `q"uote`, name: "b"},
+		{
+			contentType: "text/plain; charset=utf-8",
+			data:        "first",
+			filename:    "one",
+			name:        "doc",
+		},
+		{
+			contentType: "text/html; charset=utf-8",
+			data:        "<html></html>",
+			filename:    "two",
+			name:        "doc",
+		},
+		{
+			contentType: "application/octet-stream",
+			data:        "",
+			filename:    "empty",
+			name:        "x",
+		},
+	}
+
+	b = newBody(
+		t,
+		url.Values{"b": {`q"uote`}, "a": {"1", "2"}},
+		map[string][]string{
+			"x":   {"empty"},
+			"doc": {"one", "two"},
+		},
+		map[string]string{
+			"empty": "",
+			"one":   "first",
+			"two":   "<html></html>",
+		},
+	)
+	defer func() { _ = b.Close() }()
+
+	if data, e = io.ReadAll(b); e != nil {
+		t.Fatal(e)
+	}
+
+	if int64(len(data)) != b.ContentLength {
+		t.Errorf(
+			"got %d bytes, want ContentLength %d",
+			len(data),
+			b.ContentLength,
+		)
+	}
+
+	// Fields are sorted by name, then files by field name, in order.
+	// Content types are sniffed, as the files have no extensions.
+	got = parse(t, b, data)
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d parts, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("part %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBodyClose(t *testing.T) {
+	var b *Body
+	var buf []byte = make([]byte, 1024)
+	var done chan error = make(chan error, 1)
+	var e error
+
+	b = newBody(
+		t,
+		nil,
+		map[string][]string{"f": {"big"}},
+		map[string]string{"big": string(make([]byte, 1024*1024))},
+	)
+
+	if _, e = io.ReadFull(b, buf); e != nil {
+		t.Fatal(e)
+	}
+
+	// Closing mid-stream, from another goroutine, stops the writer
+	go func() {
+		_, e := io.Copy(io.Discard, b)
+		done <- e
+	}()
+
+	if e = b.Close(); e != nil {
+		t.Fatal(e)
+	}
+
+	// The reader may have finished first
+	if e = <-done; (e != nil) && !errors.Is(e, io.ErrClosedPipe) {
+		t.Errorf("got %v, want %v", e, io.ErrClosedPipe)
+	}
+
+	if _, e = b.Read(buf); !errors.Is(e, io.ErrClosedPipe) {
+		t.Errorf("got %v, want %v", e, io.ErrClosedPipe)
+	}
+
+	// Closing before reading is also fine
+	b = newBody(t, url.Values{"a": {"1"}}, nil, nil)
+
+	if e = b.Close(); e != nil {
+		t.Fatal(e)
+	}
+
+	if _, e = b.Read(buf); !errors.Is(e, io.ErrClosedPipe) {
+		t.Errorf("got %v, want %v", e, io.ErrClosedPipe)
+	}
+}
+
+func TestNew(t *testing.T) {
+	var dir string = t.TempDir()
+	var e error
+	var tests = map[string][]string{
+		"directory": {dir},
+		"missing":   {filepath.Join(dir, "missing")},
+	}
+
+	for name, paths := range tests {
+		_, e = New(nil, map[string][]string{"f": paths})
+		if e == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
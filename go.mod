@@ -3,11 +3,19 @@ module github.com/mjwhitta/win
 go 1.24.0
 
 require (
+	github.com/dop251/goja v0.0.0-20250309171923-bcd7cc6bf64c
 	github.com/mjwhitta/cli v1.14.0
 	github.com/mjwhitta/errors v1.0.7
 	github.com/mjwhitta/log v1.8.8
 	github.com/mjwhitta/pathname v1.3.1
+	golang.org/x/net v0.47.0
 	golang.org/x/sys v0.38.0
 )
 
-require github.com/mjwhitta/hilighter v1.15.0 // indirect
+require (
+	github.com/dlclark/regexp2 v1.11.4 // indirect
+	github.com/go-sourcemap/sourcemap v2.1.3+incompatible // indirect
+	github.com/google/pprof v0.0.0-20230207041349-798e818bf904 // indirect
+	github.com/mjwhitta/hilighter v1.15.0 // indirect
+	golang.org/x/text v0.31.0 // indirect
+)
@@ -0,0 +1,422 @@
+package pac
+
+import (
+	"encoding/binary"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/mjwhitta/errors"
+)
+
+var (
+	months []string = []string{
+		"JAN", "FEB", "MAR", "APR", "MAY", "JUN",
+		"JUL", "AUG", "SEP", "OCT", "NOV", "DEC",
+	}
+	weekdays []string = []string{
+		"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT",
+	}
+)
+
+// args will return the arguments as strings, and whether the last
+// one was "GMT".
+func args(call goja.FunctionCall) ([]string, bool) {
+	var out []string
+
+	for _, arg := range call.Arguments {
+		out = append(out, arg.String())
+	}
+
+	if (len(out) > 0) && strings.EqualFold(out[len(out)-1], "GMT") {
+		return out[:len(out)-1], true
+	}
+
+	return out, false
+}
+
+// convertAddr is convert_addr(), which converts an IPv4 address to
+// an integer.
+func convertAddr(ip string) uint32 {
+	var parsed net.IP
+
+	if parsed = net.ParseIP(ip).To4(); parsed == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(parsed)
+}
+
+// dateInRange will return true if the date is within the range,
+// using only the provided kinds of date parts.
+func dateInRange(now time.Time, kinds []byte, start, end []int) bool {
+	var cur int
+	var from int
+	var to int
+
+	// Encode each date as a comparable number, most significant first
+	for _, kind := range []byte{'y', 'm', 'd'} {
+		for i, k := range kinds {
+			if k != kind {
+				continue
+			}
+
+			switch kind {
+			case 'y':
+				cur = cur*10000 + now.Year()
+				from = from*10000 + start[i]
+				to = to*10000 + end[i]
+			case 'm':
+				cur = cur*100 + int(now.Month())
+				from = from*100 + start[i]
+				to = to*100 + end[i]
+			case 'd':
+				cur = cur*100 + now.Day()
+				from = from*100 + start[i]
+				to = to*100 + end[i]
+			}
+		}
+	}
+
+	// Without a year, the range can wrap around
+	if strings.IndexByte(string(kinds), 'y') < 0 {
+		return inRange(cur, from, to)
+	}
+
+	return (from <= cur) && (cur <= to)
+}
+
+// dnsDomainIs is dnsDomainIs(), which returns true if the host is in
+// the domain.
+func dnsDomainIs(host string, domain string) bool {
+	return strings.HasSuffix(
+		strings.ToLower(host),
+		strings.ToLower(domain),
+	)
+}
+
+// dnsDomainLevels is dnsDomainLevels(), which returns the number of
+// dots in the host.
+func dnsDomainLevels(host string) int {
+	return strings.Count(host, ".")
+}
+
+// indexOf will return the index of the provided string, ignoring
+// case, or -1.
+func indexOf(list []string, s string) int {
+	for i, item := range list {
+		if strings.EqualFold(item, s) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// inRange will return true if val is between start and end
+// (inclusive), wrapping around if end is before start.
+func inRange(val int, start int, end int) bool {
+	if start <= end {
+		return (start <= val) && (val <= end)
+	}
+
+	return (val >= start) || (val <= end)
+}
+
+// isPlainHostName is isPlainHostName(), which returns true if the
+// host has no domain.
+func isPlainHostName(host string) bool {
+	return !strings.Contains(host, ".")
+}
+
+// localHostOrDomainIs is localHostOrDomainIs(), which returns true if
+// the host matches exactly, or if it has no domain and matches the
+// hostname part.
+func localHostOrDomainIs(host string, hostdom string) bool {
+	host = strings.ToLower(host)
+	hostdom = strings.ToLower(hostdom)
+
+	if host == hostdom {
+		return true
+	}
+
+	if !isPlainHostName(host) {
+		return false
+	}
+
+	return strings.HasPrefix(hostdom, host+".")
+}
+
+// shExpMatch is shExpMatch(), which returns true if the string
+// matches the shell expression (using * and ?).
+func shExpMatch(str string, shexp string) bool {
+	var e error
+	var r *regexp.Regexp
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	for _, c := range shexp {
+		switch c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	if r, e = regexp.Compile(sb.String()); e != nil {
+		return false
+	}
+
+	return r.MatchString(str)
+}
+
+// dateRange is dateRange(), which returns true if the current date
+// is within the range. Days, months, and years can be provided.
+func (p *PAC) dateRange(call goja.FunctionCall) goja.Value {
+	var a []string
+	var end []int
+	var gmt bool
+	var half int
+	var kinds []byte
+	var now time.Time
+	var start []int
+	var vals []int
+
+	a, gmt = args(call)
+	now = p.now(gmt)
+
+	// Classify each argument as a day, month, or year
+	for _, arg := range a {
+		if m := indexOf(months, arg); m >= 0 {
+			kinds = append(kinds, 'm')
+			vals = append(vals, m+1)
+		} else if n, e := strconv.Atoi(arg); e != nil {
+			return p.vm.ToValue(false)
+		} else if n > 31 { //nolint:mnd // Max days in a month
+			kinds = append(kinds, 'y')
+			vals = append(vals, n)
+		} else {
+			kinds = append(kinds, 'd')
+			vals = append(vals, n)
+		}
+	}
+
+	half = len(vals) / 2 //nolint:mnd // Start and end dates
+
+	switch {
+	case len(vals) == 0:
+		return p.vm.ToValue(false)
+	case (len(vals)%2 == 0) &&
+		(string(kinds[:half]) == string(kinds[half:])):
+		// Two dates of the same shape
+		start = vals[:half]
+		end = vals[half:]
+		kinds = kinds[:half]
+	default:
+		// A single date, so match each provided part
+		start = vals
+		end = vals
+	}
+
+	return p.vm.ToValue(dateInRange(now, kinds, start, end))
+}
+
+// dnsResolve is dnsResolve(), which returns the first IPv4 address
+// of the host, or null.
+func (p *PAC) dnsResolve(host string) goja.Value {
+	if ip := p.resolve(host); ip != "" {
+		return p.vm.ToValue(ip)
+	}
+
+	return goja.Null()
+}
+
+// isInNet is isInNet(), which returns true if the IP of the host is
+// in the subnet.
+func (p *PAC) isInNet(host string, pattern string, mask string) bool {
+	var ip net.IP
+	var m net.IP
+	var n net.IP
+
+	if ip = net.ParseIP(p.resolve(host)).To4(); ip == nil {
+		return false
+	}
+
+	if m = net.ParseIP(mask).To4(); m == nil {
+		return false
+	}
+
+	if n = net.ParseIP(pattern).To4(); n == nil {
+		return false
+	}
+
+	return ip.Mask(net.IPMask(m)).Equal(n.Mask(net.IPMask(m)))
+}
+
+// isResolvable is isResolvable(), which returns true if the host can
+// be resolved.
+func (p *PAC) isResolvable(host string) bool {
+	return p.resolve(host) != ""
+}
+
+// myIPAddress is myIpAddress(), which returns the local IP address.
+func (p *PAC) myIPAddress() string {
+	if p.MyIPAddress == nil {
+		return "127.0.0.1"
+	}
+
+	return p.MyIPAddress()
+}
+
+func (p *PAC) now(gmt bool) time.Time {
+	var now time.Time = time.Now()
+
+	if p.Now != nil {
+		now = p.Now()
+	}
+
+	if gmt {
+		return now.UTC()
+	}
+
+	return now
+}
+
+func (p *PAC) register() error {
+	var funcs map[string]any = map[string]any{
+		"alert":               func(string) {},
+		"convert_addr":        convertAddr,
+		"dateRange":           p.dateRange,
+		"dnsDomainIs":         dnsDomainIs,
+		"dnsDomainLevels":     dnsDomainLevels,
+		"dnsResolve":          p.dnsResolve,
+		"isInNet":             p.isInNet,
+		"isPlainHostName":     isPlainHostName,
+		"isResolvable":        p.isResolvable,
+		"localHostOrDomainIs": localHostOrDomainIs,
+		"myIpAddress":         p.myIPAddress,
+		"shExpMatch":          shExpMatch,
+		"timeRange":           p.timeRange,
+		"weekdayRange":        p.weekdayRange,
+	}
+
+	for name, fn := range funcs {
+		if e := p.vm.Set(name, fn); e != nil {
+			return errors.Newf("failed to register %s: %w", name, e)
+		}
+	}
+
+	return nil
+}
+
+// resolve will return the first IPv4 address of the host, or an
+// empty string.
+func (p *PAC) resolve(host string) string {
+	var addrs []string
+	var e error
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
+	}
+
+	if p.LookupHost == nil {
+		return ""
+	}
+
+	if addrs, e = p.LookupHost(host); e != nil {
+		return ""
+	}
+
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr).To4(); ip != nil {
+			return ip.String()
+		}
+	}
+
+	return ""
+}
+
+// timeRange is timeRange(), which returns true if the current time
+// is within the range. Hours, minutes, and seconds can be provided.
+// The end of the range is inclusive.
+func (p *PAC) timeRange(call goja.FunctionCall) goja.Value {
+	var a []string
+	var e error
+	var end int
+	var gmt bool
+	var n int
+	var now time.Time
+	var start int
+	var t int
+	var vals []int
+
+	a, gmt = args(call)
+	now = p.now(gmt)
+	t = now.Hour()*3600 + now.Minute()*60 + now.Second()
+
+	for _, arg := range a {
+		if n, e = strconv.Atoi(arg); e != nil {
+			return p.vm.ToValue(false)
+		}
+
+		vals = append(vals, n)
+	}
+
+	//nolint:mnd // Hours, minutes, and seconds
+	switch len(vals) {
+	case 1:
+		return p.vm.ToValue(now.Hour() == vals[0])
+	case 2:
+		start = vals[0] * 3600
+		end = vals[1]*3600 + 3599
+	case 4:
+		start = vals[0]*3600 + vals[1]*60
+		end = vals[2]*3600 + vals[3]*60 + 59
+	case 6:
+		start = vals[0]*3600 + vals[1]*60 + vals[2]
+		end = vals[3]*3600 + vals[4]*60 + vals[5]
+	default:
+		return p.vm.ToValue(false)
+	}
+
+	return p.vm.ToValue(inRange(t, start, end))
+}
+
+// weekdayRange is weekdayRange(), which returns true if the current
+// day is within the range (e.g. "MON", "FRI").
+func (p *PAC) weekdayRange(call goja.FunctionCall) goja.Value {
+	var a []string
+	var end int
+	var gmt bool
+	var start int
+	var today int
+
+	a, gmt = args(call)
+	today = int(p.now(gmt).Weekday())
+
+	switch len(a) {
+	case 1:
+		start = indexOf(weekdays, a[0])
+		end = start
+	case 2: //nolint:mnd // Range
+		start = indexOf(weekdays, a[0])
+		end = indexOf(weekdays, a[1])
+	default:
+		return p.vm.ToValue(false)
+	}
+
+	if (start < 0) || (end < 0) {
+		return p.vm.ToValue(false)
+	}
+
+	return p.vm.ToValue(inRange(today, start, end))
+}
@@ -0,0 +1,236 @@
+package pac
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestPAC will return a PAC with fake DNS, local IP, and clock.
+// The clock is Wednesday, 2024-03-13 14:30:15 UTC.
+func newTestPAC(t *testing.T, script string) *PAC {
+	var e error
+	var p *PAC
+
+	if p, e = New(script); e != nil {
+		t.Fatal(e)
+	}
+
+	p.LookupHost = func(host string) ([]string, error) {
+		switch host {
+		case "intranet.corp.example":
+			return []string{"::1", "10.1.2.3"}, nil
+		case "v6only.example":
+			return []string{"::1"}, nil
+		}
+
+		return nil, errors.New("no such host")
+	}
+	p.MyIPAddress = func() string {
+		return "192.168.1.10"
+	}
+	p.Now = func() time.Time {
+		return time.Date(
+			2024, time.March, 13, 14, 30, 15, 0, time.UTC,
+		)
+	}
+
+	return p
+}
+
+// eval will evaluate a JS expression with the helpers registered.
+func eval(t *testing.T, p *PAC, expr string) any {
+	t.Helper()
+
+	v, e := p.vm.RunString(expr)
+	if e != nil {
+		t.Fatalf("%s: %s", expr, e)
+	}
+
+	return v.Export()
+}
+
+func TestConvertAddr(t *testing.T) {
+	var tests = map[string]uint32{
+		"0.0.0.0":         0,
+		"10.0.0.1":        0x0a000001,
+		"255.255.255.255": 0xffffffff,
+		"::1":             0,
+		"bogus":           0,
+	}
+
+	for ip, want := range tests {
+		if got := convertAddr(ip); got != want {
+			t.Errorf("convertAddr(%q) = %#x, want %#x", ip, got, want)
+		}
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	var p *PAC = newTestPAC(t, "function FindProxyForURL() {}")
+	var tests = map[string]bool{
+		`dateRange(13)`:                               true,
+		`dateRange(14)`:                               false,
+		`dateRange("MAR")`:                            true,
+		`dateRange(2024)`:                             true,
+		`dateRange(1, 15)`:                            true,
+		`dateRange(14, 12)`:                           false,
+		`dateRange(20, 14)`:                           true,
+		`dateRange("JAN", "MAR")`:                     true,
+		`dateRange("NOV", "FEB")`:                     false,
+		`dateRange("OCT", "MAR")`:                     true,
+		`dateRange(2020, 2023)`:                       false,
+		`dateRange(1, "MAR", 2024, 31, "DEC", 2024)`:  true,
+		`dateRange(14, "MAR", 2024, 31, "DEC", 2024)`: false,
+		`dateRange("MAR", 2024, "APR", 2024)`:         true,
+		`dateRange(13, "GMT")`:                        true,
+		`dateRange()`:                                 false,
+		`dateRange("bogus")`:                          false,
+	}
+
+	for expr, want := range tests {
+		if got := eval(t, p, expr); got != want {
+			t.Errorf("%s = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestDNSHelpers(t *testing.T) {
+	var p *PAC = newTestPAC(t, "function FindProxyForURL() {}")
+	//nolint:lll // Table of JS expressions
+	var tests = map[string]any{
+		`dnsDomainIs("www.example.com", ".example.com")`:              true,
+		`dnsDomainIs("WWW.EXAMPLE.COM", ".example.com")`:              true,
+		`dnsDomainIs("www.example.org", ".example.com")`:              false,
+		`dnsDomainLevels("www")`:                                      int64(0),
+		`dnsDomainLevels("www.example.com")`:                          int64(2),
+		`dnsResolve("intranet.corp.example")`:                         "10.1.2.3",
+		`dnsResolve("10.9.8.7")`:                                      "10.9.8.7",
+		`dnsResolve("v6only.example")`:                                nil,
+		`dnsResolve("missing.example")`:                               nil,
+		`isInNet("intranet.corp.example", "10.0.0.0", "255.0.0.0")`:   true,
+		`isInNet("intranet.corp.example", "10.2.0.0", "255.255.0.0")`: false,
+		`isInNet("missing.example", "10.0.0.0", "255.0.0.0")`:         false,
+		`isInNet("10.1.2.3", "bogus", "255.0.0.0")`:                   false,
+		`isPlainHostName("www")`:                                      true,
+		`isPlainHostName("www.example.com")`:                          false,
+		`isResolvable("intranet.corp.example")`:                       true,
+		`isResolvable("missing.example")`:                             false,
+		`localHostOrDomainIs("www", "www.example.com")`:               true,
+		`localHostOrDomainIs("www.example.com", "www.example.com")`:   true,
+		`localHostOrDomainIs("www.example.org", "www.example.com")`:   false,
+		`localHostOrDomainIs("home", "www.example.com")`:              false,
+		`myIpAddress()`:            "192.168.1.10",
+		`convert_addr("10.0.0.1")`: int64(0x0a000001),
+	}
+
+	for expr, want := range tests {
+		if got := eval(t, p, expr); got != want {
+			t.Errorf("%s = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestInRange(t *testing.T) {
+	var tests = []struct {
+		val   int
+		start int
+		end   int
+		want  bool
+	}{
+		{5, 1, 10, true},
+		{1, 1, 10, true},
+		{10, 1, 10, true},
+		{11, 1, 10, false},
+		// Wraps around
+		{23, 22, 2, true},
+		{1, 22, 2, true},
+		{12, 22, 2, false},
+	}
+
+	for _, test := range tests {
+		got := inRange(test.val, test.start, test.end)
+		if got != test.want {
+			t.Errorf(
+				"inRange(%d, %d, %d) = %v",
+				test.val,
+				test.start,
+				test.end,
+				got,
+			)
+		}
+	}
+}
+
+func TestShExpMatch(t *testing.T) {
+	var tests = []struct {
+		str   string
+		shexp string
+		want  bool
+	}{
+		{"http://www.example.com/a", "*.example.com/*", true},
+		{"http://www.example.com/a", "*.example.org/*", false},
+		{"abc", "a?c", true},
+		{"abbc", "a?c", false},
+		// Regexp metacharacters are literal
+		{"a.c", "a.c", true},
+		{"abc", "a.c", false},
+		{"a+c", "a+c", true},
+	}
+
+	for _, test := range tests {
+		if got := shExpMatch(test.str, test.shexp); got != test.want {
+			t.Errorf(
+				"shExpMatch(%q, %q) = %v",
+				test.str,
+				test.shexp,
+				got,
+			)
+		}
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+	var p *PAC = newTestPAC(t, "function FindProxyForURL() {}")
+	var tests = map[string]bool{
+		`timeRange(14)`:                     true,
+		`timeRange(15)`:                     false,
+		`timeRange(9, 17)`:                  true,
+		`timeRange(15, 17)`:                 false,
+		`timeRange(22, 15)`:                 true,
+		`timeRange(14, 0, 14, 30)`:          true,
+		`timeRange(14, 31, 15, 0)`:          false,
+		`timeRange(14, 30, 15, 14, 30, 15)`: true,
+		`timeRange(14, 30, 16, 14, 30, 20)`: false,
+		`timeRange(14, "GMT")`:              true,
+		`timeRange()`:                       false,
+		`timeRange(1, 2, 3)`:                false,
+		`timeRange("bogus")`:                false,
+	}
+
+	for expr, want := range tests {
+		if got := eval(t, p, expr); got != want {
+			t.Errorf("%s = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestWeekdayRange(t *testing.T) {
+	var p *PAC = newTestPAC(t, "function FindProxyForURL() {}")
+	var tests = map[string]bool{
+		`weekdayRange("WED")`:        true,
+		`weekdayRange("thu")`:        false,
+		`weekdayRange("MON", "FRI")`: true,
+		`weekdayRange("THU", "TUE")`: false,
+		`weekdayRange("FRI", "WED")`: true,
+		`weekdayRange("WED", "GMT")`: true,
+		`weekdayRange()`:             false,
+		`weekdayRange("bogus")`:      false,
+	}
+
+	for expr, want := range tests {
+		if got := eval(t, p, expr); got != want {
+			t.Errorf("%s = %v, want %v", expr, got, want)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package pac
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/mjwhitta/errors"
+)
+
+// PAC is a parsed proxy auto-config script. It is evaluated in pure
+// Go, so it works on any OS. The DNS, local IP, and clock used by
+// the PAC helper functions can be replaced (e.g. for testing). A PAC
+// is safe for concurrent use.
+type PAC struct {
+	// LookupHost resolves a hostname to IP addresses. It defaults to
+	// net.LookupHost.
+	LookupHost func(host string) ([]string, error)
+
+	// MyIPAddress returns the local IP address. It defaults to the
+	// address of the interface used to reach the Internet.
+	MyIPAddress func() string
+
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	// Timeout is the maximum time to evaluate the script. It
+	// defaults to 5 seconds.
+	Timeout time.Duration
+
+	fn    goja.Callable
+	mutex sync.Mutex
+	vm    *goja.Runtime
+}
+
+// Default timeout for script evaluation
+const defaultTimeout time.Duration = 5 * time.Second
+
+// Fetch will return a pointer to a new PAC instance, using the
+// script at the provided URL. The file scheme is supported, as are
+// plain file paths.
+func Fetch(ctx context.Context, uri string) (*PAC, error) {
+	var b []byte
+	var e error
+	var req *http.Request
+	var res *http.Response
+	var u *url.URL
+
+	if u, e = url.Parse(uri); (e != nil) || (len(u.Scheme) <= 1) {
+		// Not a URL, or a Windows drive letter
+		return Open(uri)
+	} else if u.Scheme == "file" {
+		return Open(u.Path)
+	}
+
+	req, e = http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	// PAC scripts must be fetched without a proxy
+	res, e = (&http.Client{Transport: &http.Transport{}}).Do(req)
+	if e != nil {
+		return nil, errors.Newf("failed to fetch %s: %w", uri, e)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		e = errors.Newf("failed to fetch %s: %s", uri, res.Status)
+		return nil, e
+	}
+
+	if b, e = io.ReadAll(res.Body); e != nil {
+		return nil, errors.Newf("failed to read %s: %w", uri, e)
+	}
+
+	return New(string(b))
+}
+
+// New will return a pointer to a new PAC instance, using the
+// provided script. The script must define FindProxyForURL. Like
+// FindProxyForURL, the script is interrupted if it runs longer than
+// the default Timeout.
+func New(script string) (*PAC, error) {
+	return newPAC(script, defaultTimeout)
+}
+
+// Open will return a pointer to a new PAC instance, using the script
+// in the provided file.
+func Open(path string) (*PAC, error) {
+	var b []byte
+	var e error
+
+	if b, e = os.ReadFile(filepath.Clean(path)); e != nil {
+		return nil, errors.Newf("failed to read %s: %w", path, e)
+	}
+
+	return New(string(b))
+}
+
+// myIPAddress will return the IP of the interface used to reach the
+// Internet. No packets are sent.
+func myIPAddress() string {
+	var addr *net.UDPAddr
+	var conn net.Conn
+	var e error
+	var ok bool
+
+	if conn, e = net.Dial("udp4", "198.51.100.1:53"); e != nil {
+		return "127.0.0.1"
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if addr, ok = conn.LocalAddr().(*net.UDPAddr); !ok {
+		return "127.0.0.1"
+	}
+
+	return addr.IP.String()
+}
+
+// newPAC will return a pointer to a new PAC instance, using the
+// provided script and timeout.
+func newPAC(script string, timeout time.Duration) (*PAC, error) {
+	var e error
+	var ok bool
+	var p *PAC = &PAC{
+		LookupHost:  net.LookupHost,
+		MyIPAddress: myIPAddress,
+		Now:         time.Now,
+		Timeout:     timeout,
+		vm:          goja.New(),
+	}
+
+	if e = p.register(); e != nil {
+		return nil, e
+	}
+
+	_, e = p.eval(
+		func() (goja.Value, error) {
+			return p.vm.RunString(script)
+		},
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to parse PAC: %w", e)
+	}
+
+	p.fn, ok = goja.AssertFunction(p.vm.Get("FindProxyForURL"))
+	if !ok {
+		return nil, errors.New("FindProxyForURL not defined")
+	}
+
+	return p, nil
+}
+
+// eval will call fn, interrupting the script if it runs longer than
+// the Timeout. The mutex must be held, unless the PAC isn't shared
+// yet.
+func (p *PAC) eval(
+	fn func() (goja.Value, error),
+) (goja.Value, error) {
+	var done bool
+	var e error
+	var guard sync.Mutex
+	var result goja.Value
+	var timer *time.Timer
+
+	if p.Timeout > 0 {
+		timer = time.AfterFunc(
+			p.Timeout,
+			func() {
+				guard.Lock()
+				defer guard.Unlock()
+
+				if !done {
+					p.vm.Interrupt("timeout")
+				}
+			},
+		)
+	}
+
+	result, e = fn()
+
+	// Ensure a late timeout can't interrupt the next call
+	guard.Lock()
+	done = true
+	guard.Unlock()
+
+	if timer != nil {
+		timer.Stop()
+	}
+
+	p.vm.ClearInterrupt()
+
+	return result, e
+}
+
+// FindProxyForURL will evaluate the script for the provided URL and
+// return the ordered list of proxies to try. As is done by browsers,
+// the path and query of https URLs are not exposed to the script.
+func (p *PAC) FindProxyForURL(uri string) ([]Proxy, error) {
+	var e error
+	var result goja.Value
+	var u *url.URL
+
+	if u, e = url.Parse(uri); e != nil {
+		return nil, errors.Newf("failed to parse URL: %w", e)
+	}
+
+	if strings.EqualFold(u.Scheme, "https") {
+		u = &url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/"}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	result, e = p.eval(
+		func() (goja.Value, error) {
+			return p.fn(
+				goja.Undefined(),
+				p.vm.ToValue(u.String()),
+				p.vm.ToValue(strings.ToLower(u.Hostname())),
+			)
+		},
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to evaluate PAC: %w", e)
+	}
+
+	if goja.IsUndefined(result) || goja.IsNull(result) {
+		return []Proxy{Direct}, nil
+	}
+
+	return ParseResult(result.String()), nil
+}
+
+// Proxies will return the ordered list of proxies for the provided
+// request, where a nil URL means no proxy (DIRECT). It is compatible
+// with the Proxies of the winhttp and wininet clients, which use the
+// first entry they support.
+func (p *PAC) Proxies(req *http.Request) ([]*url.URL, error) {
+	var e error
+	var proxies []Proxy
+	var urls []*url.URL
+
+	if proxies, e = p.FindProxyForURL(req.URL.String()); e != nil {
+		return nil, e
+	}
+
+	for _, proxy := range proxies {
+		urls = append(urls, proxy.URL())
+	}
+
+	return urls, nil
+}
+
+// Proxy will return the first proxy for the provided request that
+// net/http supports, so it is compatible with
+// net/http.Transport.Proxy. SOCKS4 entries are skipped. A nil URL
+// means no proxy should be used.
+func (p *PAC) Proxy(req *http.Request) (*url.URL, error) {
+	var e error
+	var proxies []*url.URL
+
+	if proxies, e = p.Proxies(req); e != nil {
+		return nil, e
+	}
+
+	for _, u := range proxies {
+		if u == nil {
+			return nil, nil
+		}
+
+		switch u.Scheme {
+		case "http", "https", "socks5":
+			return u, nil
+		}
+	}
+
+	return nil, errors.Newf("no supported proxy in %v", proxies)
+}
@@ -0,0 +1,286 @@
+package pac
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const script string = `
+function FindProxyForURL(url, host) {
+	if (host === "loop") {
+		while (true) {}
+	}
+
+	if (host === "echo.example") {
+		return "PROXY " + url;
+	}
+
+	if (isPlainHostName(host) || dnsDomainIs(host, ".corp.example")) {
+		return "DIRECT";
+	}
+
+	if (isInNet(dnsResolve(host), "10.0.0.0", "255.0.0.0")) {
+		return "DIRECT";
+	}
+
+	if (shExpMatch(url, "*/socks/*")) {
+		return "SOCKS s:1080";
+	}
+
+	if (weekdayRange("MON", "FRI") && timeRange(9, 17)) {
+		return "PROXY work:8080; DIRECT";
+	}
+
+	return "PROXY home:8080";
+}
+`
+
+func TestFetch(t *testing.T) {
+	var e error
+	var p *PAC
+	var path string = filepath.Join(t.TempDir(), "proxy.pac")
+	var srv *httptest.Server
+
+	srv = httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/proxy.pac" {
+					http.NotFound(w, req)
+					return
+				}
+
+				_, _ = w.Write([]byte(script))
+			},
+		),
+	)
+	defer srv.Close()
+
+	if e = os.WriteFile(path, []byte(script), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, uri := range []string{
+		path,
+		(&url.URL{Scheme: "file", Path: path}).String(),
+		srv.URL + "/proxy.pac",
+	} {
+		if p, e = Fetch(context.Background(), uri); e != nil {
+			t.Errorf("Fetch(%q): %s", uri, e)
+		} else if p.fn == nil {
+			t.Errorf("Fetch(%q): FindProxyForURL not loaded", uri)
+		}
+	}
+
+	_, e = Fetch(context.Background(), srv.URL+"/missing")
+	if e == nil {
+		t.Error("expected error for 404")
+	}
+}
+
+func TestFindProxyForURL(t *testing.T) {
+	var p *PAC = newTestPAC(t, script)
+	//nolint:lll // Table of URLs
+	var tests = map[string]string{
+		"http://intranet/":                         "DIRECT",
+		"http://wiki.corp.example/":                "DIRECT",
+		"http://intranet.corp.example/":            "DIRECT",
+		"http://www.example.com/socks/a":           "SOCKS s:1080",
+		"http://www.example.com/":                  "PROXY work:8080; DIRECT",
+		"http://echo.example/path?q=1":             "PROXY http://echo.example/path?q=1",
+		"https://echo.example/secret/path?token=1": "PROXY https://echo.example/",
+	}
+
+	for uri, want := range tests {
+		var e error
+		var got []Proxy
+		var s []string
+
+		if got, e = p.FindProxyForURL(uri); e != nil {
+			t.Errorf("%s: %s", uri, e)
+			continue
+		}
+
+		for _, proxy := range got {
+			s = append(s, proxy.String())
+		}
+
+		if strings.Join(s, "; ") != want {
+			t.Errorf("%s: got %v, want %s", uri, s, want)
+		}
+	}
+
+	// Outside of work hours
+	p.Now = func() time.Time {
+		return time.Date(2024, time.March, 16, 20, 0, 0, 0, time.UTC)
+	}
+
+	got, e := p.FindProxyForURL("http://www.example.com/")
+	if e != nil {
+		t.Fatal(e)
+	} else if !slices.Equal(got, ParseResult("PROXY home:8080")) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestFindProxyForURLTimeout(t *testing.T) {
+	var e error
+	var p *PAC = newTestPAC(t, script)
+	var start time.Time = time.Now()
+
+	p.Timeout = 50 * time.Millisecond
+
+	if _, e = p.FindProxyForURL("http://loop/"); e == nil {
+		t.Fatal("expected timeout")
+	} else if !strings.Contains(e.Error(), "timeout") {
+		t.Errorf("unexpected error: %s", e)
+	}
+
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("timeout took %s", time.Since(start))
+	}
+
+	// The interrupt must not leak into the next evaluation
+	if _, e = p.FindProxyForURL("http://intranet/"); e != nil {
+		t.Errorf("after timeout: %s", e)
+	}
+}
+
+func TestNew(t *testing.T) {
+	var e error
+
+	if _, e = New("function FindProxyForURL("); e == nil {
+		t.Error("expected error for invalid script")
+	}
+
+	if _, e = New("var x = 1;"); e == nil {
+		t.Error("expected error for missing FindProxyForURL")
+	}
+
+	_, e = Open(filepath.Join(t.TempDir(), "missing.pac"))
+	if e == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestNewTimeout(t *testing.T) {
+	var e error
+	var start time.Time = time.Now()
+
+	// Top-level code is interrupted, too
+	_, e = newPAC("while (true) {}", 50*time.Millisecond)
+	if e == nil {
+		t.Fatal("expected timeout")
+	} else if !strings.Contains(e.Error(), "timeout") {
+		t.Errorf("unexpected error: %s", e)
+	}
+
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("timeout took %s", time.Since(start))
+	}
+}
+
+func TestProxies(t *testing.T) {
+	var p *PAC = newTestPAC(t, script)
+	var tests = map[string][]string{
+		"http://intranet/":               {""},
+		"http://www.example.com/":        {"http://work:8080", ""},
+		"http://www.example.com/socks/a": {"socks4://s:1080"},
+	}
+
+	for uri, want := range tests {
+		var e error
+		var got []string
+		var proxies []*url.URL
+		var req *http.Request
+
+		req = httptest.NewRequest(http.MethodGet, uri, nil)
+
+		if proxies, e = p.Proxies(req); e != nil {
+			t.Errorf("%s: %s", uri, e)
+			continue
+		}
+
+		for _, u := range proxies {
+			if u == nil {
+				got = append(got, "")
+			} else {
+				got = append(got, u.String())
+			}
+		}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: got %q, want %q", uri, got, want)
+		}
+	}
+}
+
+func TestProxy(t *testing.T) {
+	var e error
+	var p *PAC = newTestPAC(t, script)
+	var req *http.Request
+	var u *url.URL
+
+	req = httptest.NewRequest(http.MethodGet, "http://intranet/", nil)
+
+	if u, e = p.Proxy(req); e != nil {
+		t.Fatal(e)
+	} else if u != nil {
+		t.Errorf("DIRECT: got %v, want nil", u)
+	}
+
+	req = httptest.NewRequest(
+		http.MethodGet, "http://a.example/", nil,
+	)
+
+	if u, e = p.Proxy(req); e != nil {
+		t.Fatal(e)
+	} else if (u == nil) || (u.String() != "http://work:8080") {
+		t.Errorf("got %v, want http://work:8080", u)
+	}
+
+	// Undefined means DIRECT
+	if p, e = New("function FindProxyForURL() {}"); e != nil {
+		t.Fatal(e)
+	}
+
+	if u, e = p.Proxy(req); e != nil {
+		t.Fatal(e)
+	} else if u != nil {
+		t.Errorf("undefined: got %v, want nil", u)
+	}
+
+	// SOCKS4 isn't supported by net/http, so it is skipped
+	for result, want := range map[string]string{
+		"SOCKS a:1; PROXY b:2": "http://b:2",
+		"SOCKS a:1; DIRECT":    "",
+		"SOCKS5 a:1; DIRECT":   "socks5://a:1",
+		"SOCKS a:1":            "error",
+	} {
+		var got string
+
+		p, e = New(
+			`function FindProxyForURL() { return "` + result + `"; }`,
+		)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		if u, e = p.Proxy(req); e != nil {
+			got = "error"
+		} else if u != nil {
+			got = u.String()
+		}
+
+		if got != want {
+			t.Errorf("%s: got %q, want %q", result, got, want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package pac
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Proxy is a single entry of a FindProxyForURL result.
+type Proxy struct {
+	// Type is DIRECT, PROXY, HTTP, HTTPS, SOCKS, SOCKS4, or SOCKS5.
+	Type string
+
+	// Host is the proxy host and port. It is empty for DIRECT.
+	Host string
+}
+
+// Direct is the DIRECT entry, meaning no proxy.
+var Direct Proxy = Proxy{Type: "DIRECT"}
+
+// ParseResult will parse a FindProxyForURL result (e.g.
+// "PROXY a:8080; SOCKS b:1080; DIRECT") into an ordered list of
+// proxies. Unknown entries are skipped. An empty result means DIRECT.
+func ParseResult(result string) []Proxy {
+	var host string
+	var proxies []Proxy
+	var typ string
+
+	for _, entry := range strings.Split(result, ";") {
+		typ, host, _ = strings.Cut(strings.TrimSpace(entry), " ")
+		typ = strings.ToUpper(typ)
+		host = strings.TrimSpace(host)
+
+		switch typ {
+		case "DIRECT":
+			proxies = append(proxies, Direct)
+		case "HTTP", "HTTPS", "PROXY", "SOCKS", "SOCKS4", "SOCKS5":
+			if host == "" {
+				continue
+			}
+
+			proxies = append(proxies, Proxy{Host: host, Type: typ})
+		}
+	}
+
+	if len(proxies) == 0 {
+		proxies = append(proxies, Direct)
+	}
+
+	return proxies
+}
+
+// IsDirect will return true if no proxy should be used.
+func (p Proxy) IsDirect() bool {
+	return p.Type == "DIRECT"
+}
+
+// String will return the proxy in PAC result format.
+func (p Proxy) String() string {
+	if p.IsDirect() {
+		return p.Type
+	}
+
+	return p.Type + " " + p.Host
+}
+
+// URL will return the proxy as a URL, as used by
+// net/http.Transport.Proxy. It returns nil for DIRECT. SOCKS means
+// SOCKS4 in PAC results, as it does in the Windows proxy settings.
+func (p Proxy) URL() *url.URL {
+	var scheme string
+
+	switch p.Type {
+	case "HTTP", "PROXY":
+		scheme = "http"
+	case "HTTPS":
+		scheme = "https"
+	case "SOCKS", "SOCKS4":
+		scheme = "socks4"
+	case "SOCKS5":
+		scheme = "socks5"
+	default:
+		return nil
+	}
+
+	return &url.URL{Scheme: scheme, Host: p.Host}
+}
@@ -0,0 +1,82 @@
+package pac
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseResult(t *testing.T) {
+	var tests = []struct {
+		result string
+		want   []Proxy
+	}{
+		{"", []Proxy{Direct}},
+		{"DIRECT", []Proxy{Direct}},
+		{"  direct  ", []Proxy{Direct}},
+		{
+			"PROXY a:8080; SOCKS b:1080; DIRECT",
+			[]Proxy{
+				{Host: "a:8080", Type: "PROXY"},
+				{Host: "b:1080", Type: "SOCKS"},
+				Direct,
+			},
+		},
+		{
+			"https a:443;socks4 b:1080;SOCKS5   c:1080",
+			[]Proxy{
+				{Host: "a:443", Type: "HTTPS"},
+				{Host: "b:1080", Type: "SOCKS4"},
+				{Host: "c:1080", Type: "SOCKS5"},
+			},
+		},
+		// Unknown entries and missing hosts are skipped
+		{"BOGUS a:1; PROXY", []Proxy{Direct}},
+		{
+			"BOGUS a:1; HTTP b:80",
+			[]Proxy{{Host: "b:80", Type: "HTTP"}},
+		},
+	}
+
+	for _, test := range tests {
+		var got []Proxy = ParseResult(test.result)
+
+		if !slices.Equal(got, test.want) {
+			t.Errorf("ParseResult(%q) = %v", test.result, got)
+		}
+	}
+}
+
+func TestProxyString(t *testing.T) {
+	var p Proxy = Proxy{Host: "a:8080", Type: "PROXY"}
+
+	if s := p.String(); s != "PROXY a:8080" {
+		t.Errorf("got %q", s)
+	}
+
+	if s := Direct.String(); s != "DIRECT" {
+		t.Errorf("got %q", s)
+	}
+}
+
+func TestProxyURL(t *testing.T) {
+	var tests = map[string]string{
+		"HTTP":   "http://h:1",
+		"HTTPS":  "https://h:1",
+		"PROXY":  "http://h:1",
+		"SOCKS":  "socks4://h:1",
+		"SOCKS4": "socks4://h:1",
+		"SOCKS5": "socks5://h:1",
+	}
+
+	for typ, want := range tests {
+		var p Proxy = Proxy{Host: "h:1", Type: typ}
+
+		if u := p.URL(); (u == nil) || (u.String() != want) {
+			t.Errorf("%s: got %v, want %s", typ, u, want)
+		}
+	}
+
+	if u := Direct.URL(); u != nil {
+		t.Errorf("DIRECT: got %v, want nil", u)
+	}
+}
@@ -17,7 +17,7 @@ func Get() (procs []*w32.ProcessEntry32, e error) {
 	var snapHndl windows.Handle
 
 	snapHndl, e = w32.CreateToolhelp32Snapshot(
-		w32.Tlhelp32.SnapProcess,
+		w32.Tlhelp32SnapProcess,
 		0,
 	)
 	if e != nil {
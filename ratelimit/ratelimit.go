@@ -0,0 +1,277 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limit is the rate limit and concurrency cap for a single host. The
+// zero value means no limit.
+type Limit struct {
+	// Burst is the maximum number of requests that can be sent at
+	// once, before Rate applies. It defaults to 1.
+	Burst int
+
+	// MaxConcurrent is the maximum number of requests in flight. Zero
+	// means unlimited.
+	MaxConcurrent int
+
+	// Rate is the number of requests per second. Zero means
+	// unlimited.
+	Rate float64
+}
+
+// Limiter enforces a Limit per host using a token bucket and a
+// semaphore. A Limiter is safe for concurrent use. The state for
+// each host is dropped once it is idle (nothing in flight or waiting,
+// and the bucket is full again), so the Limiter doesn't grow with
+// the number of hosts seen over time.
+type Limiter struct {
+	dflt   Limit
+	hosts  map[string]*host
+	limits map[string]Limit
+	mutex  sync.Mutex
+	now    func() time.Time
+	swept  time.Time
+	timer  func(d time.Duration) (<-chan time.Time, func() bool)
+}
+
+// host is the state of the token bucket and semaphore for a single
+// host.
+type host struct {
+	last   time.Time
+	limit  Limit
+	mutex  sync.Mutex
+	sem    chan struct{}
+	tokens float64
+	users  int
+}
+
+// sweepInterval is how often idle hosts are dropped.
+const sweepInterval time.Duration = time.Minute
+
+// New will return a pointer to a new Limiter instance, which applies
+// the provided Limit to every host without its own Limit.
+func New(dflt Limit) *Limiter {
+	return &Limiter{
+		dflt:   dflt,
+		hosts:  map[string]*host{},
+		limits: map[string]Limit{},
+		now:    time.Now,
+		timer: func(d time.Duration) (<-chan time.Time, func() bool) {
+			var t *time.Timer = time.NewTimer(d)
+
+			return t.C, t.Stop
+		},
+	}
+}
+
+func newHost(l Limit) *host {
+	var h *host = &host{limit: l}
+
+	if h.limit.Burst < 1 {
+		h.limit.Burst = 1
+	}
+
+	h.tokens = float64(h.limit.Burst)
+
+	if h.limit.MaxConcurrent > 0 {
+		h.sem = make(chan struct{}, h.limit.MaxConcurrent)
+	}
+
+	return h
+}
+
+// done will mark a request, or waiter, as no longer using the host.
+func (l *Limiter) done(h *host) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	h.users--
+}
+
+// host will return the state for the provided hostname, creating it
+// if needed. Each call must be followed by a call to done.
+func (l *Limiter) host(name string, now time.Time) *host {
+	var h *host
+	var lim Limit
+	var ok bool
+
+	name = strings.ToLower(name)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if now.Sub(l.swept) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	if h, ok = l.hosts[name]; !ok {
+		if lim, ok = l.limits[name]; !ok {
+			lim = l.dflt
+		}
+
+		h = newHost(lim)
+		l.hosts[name] = h
+	}
+
+	h.users++
+
+	return h
+}
+
+// Set will configure the Limit for the provided hostname (without a
+// port), replacing any previous Limit. Requests already waiting keep
+// the previous Limit.
+func (l *Limiter) Set(hostname string, lim Limit) {
+	hostname = strings.ToLower(hostname)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.limits[hostname] = lim
+	delete(l.hosts, hostname)
+}
+
+// sweep will drop idle hosts. A new host starts with a full bucket,
+// so dropping one doesn't change how requests are limited. The
+// Limiter's mutex must be held.
+func (l *Limiter) sweep(now time.Time) {
+	for name, h := range l.hosts {
+		if (h.users == 0) && h.idle(now) {
+			delete(l.hosts, name)
+		}
+	}
+
+	l.swept = now
+}
+
+// Wait will block until a request to the provided hostname is
+// allowed, or the context is done. It returns how long it blocked
+// (zero if it didn't) and a func to release the concurrency slot once
+// the request is done. The release func is safe to call more than
+// once.
+func (l *Limiter) Wait(
+	ctx context.Context,
+	hostname string,
+) (func(), time.Duration, error) {
+	var blocked bool
+	var delay time.Duration
+	var expired <-chan time.Time
+	var h *host
+	var once sync.Once
+	var release func()
+	var start time.Time = l.now()
+	var stop func() bool
+
+	h = l.host(hostname, start)
+
+	if delay = h.reserve(start); delay > 0 {
+		blocked = true
+		expired, stop = l.timer(delay)
+		defer stop()
+
+		select {
+		case <-ctx.Done():
+			h.cancel()
+			l.done(h)
+
+			return nil, l.now().Sub(start), ctx.Err()
+		case <-expired:
+		}
+	}
+
+	if h.sem != nil {
+		select {
+		case h.sem <- struct{}{}:
+		default:
+			blocked = true
+
+			select {
+			case <-ctx.Done():
+				l.done(h)
+				return nil, l.now().Sub(start), ctx.Err()
+			case h.sem <- struct{}{}:
+			}
+		}
+	}
+
+	release = func() {
+		once.Do(
+			func() {
+				if h.sem != nil {
+					<-h.sem
+				}
+
+				l.done(h)
+			},
+		)
+	}
+
+	if !blocked {
+		return release, 0, nil
+	}
+
+	return release, l.now().Sub(start), nil
+}
+
+// cancel will return an unused token to the bucket.
+func (h *host) cancel() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.tokens++
+}
+
+// idle will return whether the bucket is full again, as of now.
+func (h *host) idle(now time.Time) bool {
+	var tokens float64
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if (h.limit.Rate <= 0) || h.last.IsZero() {
+		return true
+	}
+
+	tokens = h.tokens + now.Sub(h.last).Seconds()*h.limit.Rate
+
+	return tokens >= float64(h.limit.Burst)
+}
+
+// reserve will take a token from the bucket and return how long to
+// wait before using it. The bucket may go negative, so concurrent
+// waiters are queued in order.
+func (h *host) reserve(now time.Time) time.Duration {
+	var wait float64
+
+	if h.limit.Rate <= 0 {
+		return 0
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	// Refill
+	if !h.last.IsZero() {
+		h.tokens += now.Sub(h.last).Seconds() * h.limit.Rate
+	}
+
+	h.last = now
+
+	if h.tokens > float64(h.limit.Burst) {
+		h.tokens = float64(h.limit.Burst)
+	}
+
+	h.tokens--
+
+	if h.tokens >= 0 {
+		return 0
+	}
+
+	wait = -h.tokens / h.limit.Rate
+
+	return time.Duration(wait * float64(time.Second))
+}
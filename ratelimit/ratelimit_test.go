@@ -0,0 +1,362 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// clock is a fake clock, whose timers only fire when it is advanced.
+type clock struct {
+	mutex   sync.Mutex
+	now     time.Time
+	started chan time.Duration
+	timers  []*timer
+}
+
+// result is the return value of a Wait call.
+type result struct {
+	delay   time.Duration
+	e       error
+	release func()
+}
+
+// timer is a fake timer.
+type timer struct {
+	c        chan time.Time
+	deadline time.Time
+	stopped  bool
+}
+
+// blocked will fail the test if a result is already available.
+func blocked(t *testing.T, out chan result) {
+	t.Helper()
+
+	select {
+	case r := <-out:
+		t.Fatalf("got %+v, want blocked", r)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// newLimiter will return a Limiter that uses a fake clock.
+func newLimiter(dflt Limit) (*Limiter, *clock) {
+	var c *clock = &clock{
+		now:     time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		started: make(chan time.Duration, 16),
+	}
+	var l *Limiter = New(dflt)
+
+	l.now = c.Now
+	l.timer = c.Timer
+
+	return l, c
+}
+
+// wait will call Wait in a goroutine and return a channel for the
+// result.
+func wait(ctx context.Context, l *Limiter, name string) chan result {
+	var out chan result = make(chan result, 1)
+
+	go func() {
+		var r result
+
+		r.release, r.delay, r.e = l.Wait(ctx, name)
+		out <- r
+	}()
+
+	return out
+}
+
+// Advance will move the clock forward and fire any expired timers.
+func (c *clock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+
+	for _, t := range c.timers {
+		if !t.stopped && !t.deadline.After(c.now) {
+			t.stopped = true
+			t.c <- c.now
+		}
+	}
+}
+
+// Now will return the current fake time.
+func (c *clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+// Timer will return a fake timer, and report its duration on the
+// started channel.
+func (c *clock) Timer(
+	d time.Duration,
+) (<-chan time.Time, func() bool) {
+	var t *timer = &timer{c: make(chan time.Time, 1)}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t.deadline = c.now.Add(d)
+	c.timers = append(c.timers, t)
+	c.started <- d
+
+	return t.c,
+		func() bool {
+			var active bool
+
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+
+			active = !t.stopped
+			t.stopped = true
+
+			return active
+		}
+}
+
+func TestReserve(t *testing.T) {
+	var h *host = newHost(Limit{Burst: 2, Rate: 10})
+	var now time.Time = time.Now()
+	var tests = []struct {
+		elapsed time.Duration
+		want    time.Duration
+	}{
+		// Burst, then queued at the rate
+		{0, 0},
+		{0, 0},
+		{0, 100 * time.Millisecond},
+		{0, 200 * time.Millisecond},
+		// Refilled, but capped at Burst
+		{time.Second, 0},
+		{0, 0},
+		{0, 100 * time.Millisecond},
+		// Partial refill
+		{250 * time.Millisecond, 0},
+		{0, 50 * time.Millisecond},
+	}
+
+	for i, test := range tests {
+		now = now.Add(test.elapsed)
+
+		if got := h.reserve(now); got != test.want {
+			t.Errorf("%d: got %s, want %s", i, got, test.want)
+		}
+	}
+
+	// No Rate means no waiting
+	h = newHost(Limit{})
+
+	for range 3 {
+		if got := h.reserve(now); got != 0 {
+			t.Errorf("got %s, want 0", got)
+		}
+	}
+}
+
+func TestSweep(t *testing.T) {
+	var c *clock
+	var e error
+	var l *Limiter
+	var release func()
+
+	l, c = newLimiter(Limit{Burst: 1, Rate: 1})
+
+	for _, name := range []string{"a", "b", "c"} {
+		if release, _, e = l.Wait(t.Context(), name); e != nil {
+			t.Fatal(e)
+		}
+
+		if name != "c" {
+			release()
+		}
+	}
+
+	// Only hosts with nothing in flight and a full bucket are dropped
+	c.Advance(sweepInterval)
+	l.done(l.host("d", c.Now()))
+
+	if len(l.hosts) != 2 {
+		t.Errorf("got %d hosts, want 2", len(l.hosts))
+	}
+
+	for _, name := range []string{"c", "d"} {
+		if _, ok := l.hosts[name]; !ok {
+			t.Errorf("%s dropped too early", name)
+		}
+	}
+
+	release()
+}
+
+func TestWaitCancel(t *testing.T) {
+	var c *clock
+	var cancel context.CancelFunc
+	var ctx context.Context
+	var e error
+	var l *Limiter
+	var out chan result
+	var r result
+	var release func()
+
+	l, c = newLimiter(Limit{Rate: 1})
+
+	if _, _, e = l.Wait(t.Context(), "a"); e != nil {
+		t.Fatal(e)
+	}
+
+	// Cancelled while waiting for a token
+	ctx, cancel = context.WithCancel(t.Context())
+	out = wait(ctx, l, "a")
+
+	<-c.started
+	cancel()
+
+	if r = <-out; !errors.Is(r.e, context.Canceled) {
+		t.Fatalf("got %v, want %v", r.e, context.Canceled)
+	}
+
+	// The token was returned, so the next wait is still only 1s
+	out = wait(t.Context(), l, "a")
+
+	if d := <-c.started; d != time.Second {
+		t.Errorf("got %s, want 1s", d)
+	}
+
+	c.Advance(time.Second)
+
+	if r = <-out; r.e != nil {
+		t.Fatal(r.e)
+	}
+
+	// Cancelled while waiting for a slot
+	l.Set("b", Limit{MaxConcurrent: 1})
+
+	if release, _, e = l.Wait(t.Context(), "b"); e != nil {
+		t.Fatal(e)
+	}
+
+	ctx, cancel = context.WithCancel(t.Context())
+	out = wait(ctx, l, "b")
+
+	blocked(t, out)
+	cancel()
+
+	if r = <-out; !errors.Is(r.e, context.Canceled) {
+		t.Fatalf("got %v, want %v", r.e, context.Canceled)
+	}
+
+	// The slot wasn't taken
+	release()
+
+	if _, r.delay, e = l.Wait(t.Context(), "b"); e != nil {
+		t.Fatal(e)
+	} else if r.delay != 0 {
+		t.Errorf("got %s, want 0", r.delay)
+	}
+
+	if h := l.hosts["b"]; h.users != 1 {
+		t.Errorf("got %d users, want 1", h.users)
+	}
+}
+
+func TestWaitConcurrent(t *testing.T) {
+	var e error
+	var l *Limiter
+	var out chan result
+	var r result
+	var releases []func()
+
+	l, _ = newLimiter(Limit{MaxConcurrent: 2})
+	l.Set("Other", Limit{})
+
+	for range 2 {
+		var release func()
+
+		if release, _, e = l.Wait(t.Context(), "a"); e != nil {
+			t.Fatal(e)
+		}
+
+		releases = append(releases, release)
+	}
+
+	// Hosts are capped separately, and per-host Limits apply
+	for range 3 {
+		if _, _, e = l.Wait(t.Context(), "OTHER"); e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	out = wait(t.Context(), l, "A")
+	blocked(t, out)
+
+	// Releasing more than once only frees one slot
+	releases[0]()
+	releases[0]()
+
+	if r = <-out; r.e != nil {
+		t.Fatal(r.e)
+	}
+
+	out = wait(t.Context(), l, "a")
+	blocked(t, out)
+
+	releases[1]()
+	r.release()
+
+	if r = <-out; r.e != nil {
+		t.Fatal(r.e)
+	}
+}
+
+func TestWaitRate(t *testing.T) {
+	var c *clock
+	var e error
+	var l *Limiter
+	var out chan result
+	var r result
+
+	l, c = newLimiter(Limit{Burst: 2, Rate: 2})
+
+	// Burst doesn't wait
+	for range 2 {
+		if _, r.delay, e = l.Wait(t.Context(), "a"); e != nil {
+			t.Fatal(e)
+		} else if r.delay != 0 {
+			t.Errorf("got %s, want 0", r.delay)
+		}
+	}
+
+	// Then it waits for the refill
+	out = wait(t.Context(), l, "a")
+
+	if d := <-c.started; d != 500*time.Millisecond {
+		t.Errorf("got %s, want 500ms", d)
+	}
+
+	blocked(t, out)
+	c.Advance(500 * time.Millisecond)
+
+	if r = <-out; r.e != nil {
+		t.Fatal(r.e)
+	} else if r.delay != 500*time.Millisecond {
+		t.Errorf("got %s, want 500ms", r.delay)
+	}
+
+	// After a full refill, the burst is available again
+	c.Advance(time.Second)
+
+	for range 2 {
+		if _, r.delay, e = l.Wait(t.Context(), "a"); e != nil {
+			t.Fatal(e)
+		} else if r.delay != 0 {
+			t.Errorf("got %s, want 0", r.delay)
+		}
+	}
+}
@@ -0,0 +1,1672 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mjwhitta/errors"
+)
+
+// Kinds of C tokens and values
+const (
+	cChar   string = "char"
+	cFloat  string = "float"
+	cIdent  string = "ident"
+	cInt    string = "int"
+	cList   string = "list"
+	cNumber string = "num"
+	cPunct  string = "punct"
+	cString string = "string"
+)
+
+// cMacro is a #define, or a typedef enum member.
+type cMacro struct {
+	Body   string   // Replacement list
+	Func   bool     // Function-like
+	Params []string // Parameter names, if function-like
+	toks   []cToken // Lexed replacement list, once needed
+}
+
+// cNum is the type of an evaluated C number.
+type cNum struct {
+	Bits   int  // Width, or 0 if not a number
+	Float  bool // Floating point
+	Signed bool // Signed integer
+}
+
+// cParser evaluates a C constant expression, after any macros have
+// been expanded.
+type cParser struct {
+	i    int
+	toks []cToken
+}
+
+// cToken is a C token.
+type cToken struct {
+	Kind string
+	Text string
+}
+
+// cValue is an evaluated C constant.
+type cValue struct {
+	Float float64   // Value, if a float
+	Hex   bool      // Hex is preferred when formatting
+	Int   uint64    // Value, if an int, truncated to the type
+	Kind  string    // Kind of value
+	List  []*cValue // Values, if a brace-enclosed list
+	Lit   string    // Spelling of a single literal, without suffix
+	Str   string    // Value, if a string
+	Type  cNum      // Type, if a number
+}
+
+var (
+	// Binary operators, by precedence
+	cBinaryOps = map[string]int{
+		"||": 1,
+		"&&": 2,
+		"|":  3,
+		"^":  4,
+		"&":  5,
+		"==": 6,
+		"!=": 6,
+		"<":  7,
+		">":  7,
+		"<=": 7,
+		">=": 7,
+		"<<": 8,
+		">>": 8,
+		"+":  9,
+		"-":  9,
+		"*":  10,
+		"/":  10,
+		"%":  10,
+	}
+	// Builtin C types, after removing qualifiers, "signed" and
+	// redundant "int"s. Windows is LLP64, so long is 32 bits.
+	cKeywordTypes = map[string]cNum{
+		"__int16":            {Bits: 16, Signed: true},
+		"__int32":            {Bits: 32, Signed: true},
+		"__int64":            {Bits: 64, Signed: true},
+		"__int8":             {Bits: 8, Signed: true},
+		"char":               {Bits: 8, Signed: true},
+		"double":             {Bits: 64, Float: true},
+		"float":              {Bits: 32, Float: true},
+		"int":                {Bits: 32, Signed: true},
+		"long":               {Bits: 32, Signed: true},
+		"long double":        {Bits: 64, Float: true},
+		"long long":          {Bits: 64, Signed: true},
+		"short":              {Bits: 16, Signed: true},
+		"unsigned __int16":   {Bits: 16},
+		"unsigned __int32":   {Bits: 32},
+		"unsigned __int64":   {Bits: 64},
+		"unsigned __int8":    {Bits: 8},
+		"unsigned char":      {Bits: 8},
+		"unsigned int":       {Bits: 32},
+		"unsigned long":      {Bits: 32},
+		"unsigned long long": {Bits: 64},
+		"unsigned short":     {Bits: 16},
+	}
+	// Macros, indexed by name. Only the first definition is kept,
+	// like the cache.
+	cMacros = map[string]*cMacro{}
+	// Multi-character punctuators, longest first
+	cPuncts = []string{
+		"<<=", ">>=", "...",
+		"!=", "##", "&&", "++", "--", "->", "<<", "<=", "==", ">=",
+		">>", "||",
+	}
+	// Pointer-sized types that are signed, as cTypes only knows the
+	// Go type
+	cSignedPtrTypes = []string{
+		"INT_PTR",
+		"LONG_PTR",
+		"LPARAM",
+		"LRESULT",
+		"SSIZE_T",
+	}
+	// Simple typedefs, indexed by name
+	cTypeAliases = map[string]string{}
+	// Max depth of nested macro expansions
+	maxExpandDepth int = 64
+	// Constants that could not be evaluated, for the report
+	unhandled = map[string]bool{}
+)
+
+// addTypeAlias will record a simple typedef, such as
+// "typedef DWORD ALG_ID;" or "typedef ULONG_PTR X, *PX;".
+func addTypeAlias(line string) {
+	var base string
+	var name string
+	var words []string
+
+	line = strings.TrimPrefix(line, "typedef ")
+	line = strings.TrimSpace(strings.TrimSuffix(line, ";"))
+
+	for i, decl := range strings.Split(line, ",") {
+		if i == 0 {
+			decl = strings.ReplaceAll(decl, "*", " * ")
+			words = strings.Fields(decl)
+			if len(words) < 2 { //nolint:mnd // Type and name
+				return
+			}
+
+			base = strings.Join(words[:len(words)-1], " ")
+			decl = words[len(words)-1]
+		}
+
+		name = strings.TrimSpace(strings.TrimLeft(decl, "* "))
+		if !reIdentifier.MatchString(name) {
+			continue
+		}
+
+		if _, ok := cTypeAliases[name]; ok {
+			continue
+		}
+
+		if strings.HasPrefix(strings.TrimSpace(decl), "*") {
+			cTypeAliases[name] = base + " *"
+		} else {
+			cTypeAliases[name] = base
+		}
+	}
+}
+
+// arithmetic will convert numbers to a common type, like the usual
+// arithmetic conversions of C.
+func arithmetic(a *cValue, b *cValue) (*cValue, *cValue, error) {
+	var e error
+	var t cNum
+
+	a = a.promote()
+	b = b.promote()
+
+	switch {
+	case a.Type.Float || b.Type.Float:
+		t = cNum{Bits: 64, Float: true} //nolint:mnd // double
+	case a.Type.Signed == b.Type.Signed:
+		t = a.Type
+		if b.Type.Bits > a.Type.Bits {
+			t = b.Type
+		}
+	case !a.Type.Signed && (a.Type.Bits >= b.Type.Bits):
+		t = a.Type
+	case !b.Type.Signed && (b.Type.Bits >= a.Type.Bits):
+		t = b.Type
+	case a.Type.Signed:
+		// Signed type is wider, so can represent every value
+		t = a.Type
+	default:
+		t = b.Type
+	}
+
+	if a, e = a.convert(t); e != nil {
+		return nil, nil, e
+	}
+
+	if b, e = b.convert(t); e != nil {
+		return nil, nil, e
+	}
+
+	return a, b, nil
+}
+
+// binaryOp will evaluate a binary operator.
+func binaryOp(op string, l *cValue, r *cValue) (*cValue, error) {
+	var e error
+	var hex bool = l.Hex || r.Hex
+	var out *cValue
+
+	if !l.isNum() || !r.isNum() {
+		return nil, errors.Newf("unsupported %s of non-numbers", op)
+	}
+
+	switch op {
+	case "&&":
+		return newBool(l.truthy() && r.truthy()), nil
+	case "||":
+		return newBool(l.truthy() || r.truthy()), nil
+	case "<<", ">>":
+		return shiftOp(op, l, r)
+	}
+
+	if l, r, e = arithmetic(l, r); e != nil {
+		return nil, e
+	}
+
+	if l.Kind == cFloat {
+		return floatOp(op, l.Float, r.Float)
+	}
+
+	out = &cValue{Hex: hex, Kind: cInt, Type: l.Type}
+
+	switch op {
+	case "!=", "<", "<=", "==", ">", ">=":
+		return compareOp(op, l, r), nil
+	case "%", "/":
+		if r.Int == 0 {
+			return nil, errors.New("division by zero")
+		}
+
+		if l.Type.Signed {
+			if (r.signed() == -1) && (l.signed() == math.MinInt64) {
+				return nil, errors.New("division overflow")
+			}
+
+			out.Int = uint64(l.signed() / r.signed())
+			if op == "%" {
+				out.Int = uint64(l.signed() % r.signed())
+			}
+		} else {
+			out.Int = l.Int / r.Int
+			if op == "%" {
+				out.Int = l.Int % r.Int
+			}
+		}
+	case "&":
+		out.Int = l.Int & r.Int
+	case "*":
+		out.Int = l.Int * r.Int
+	case "+":
+		out.Int = l.Int + r.Int
+	case "-":
+		out.Int = l.Int - r.Int
+	case "^":
+		out.Int = l.Int ^ r.Int
+	case "|":
+		out.Int = l.Int | r.Int
+	}
+
+	out.Int &= mask(out.Type.Bits)
+
+	return out, nil
+}
+
+// cParam will return the index of a macro parameter, or -1.
+func cParam(params []string, tok cToken) int {
+	if tok.Kind != cIdent {
+		return -1
+	}
+
+	if tok.Text == "__VA_ARGS__" {
+		return slices.Index(params, "...")
+	}
+
+	return slices.Index(params, tok.Text)
+}
+
+// compareOp will evaluate a comparison of ints of the same type.
+func compareOp(op string, l *cValue, r *cValue) *cValue {
+	var cmp int
+
+	switch {
+	case l.Type.Signed && (l.signed() < r.signed()),
+		!l.Type.Signed && (l.Int < r.Int):
+		cmp = -1
+	case l.Int != r.Int:
+		cmp = 1
+	}
+
+	switch op {
+	case "!=":
+		return newBool(cmp != 0)
+	case "<":
+		return newBool(cmp < 0)
+	case "<=":
+		return newBool(cmp <= 0)
+	case "==":
+		return newBool(cmp == 0)
+	case ">":
+		return newBool(cmp > 0)
+	}
+
+	return newBool(cmp >= 0)
+}
+
+// decodeCString will decode the contents of a C string or char
+// literal.
+func decodeCString(s string, wide bool) (string, error) {
+	var e error
+	var end int
+	var n uint64
+	var out []rune
+	var raw []byte
+
+	for i := 0; i < len(s); {
+		if s[i] != '\\' {
+			if wide {
+				r, size := utf8.DecodeRuneInString(s[i:])
+				out = append(out, r)
+				i += size
+			} else {
+				raw = append(raw, s[i])
+				i++
+			}
+
+			continue
+		}
+
+		if i+1 >= len(s) {
+			return "", errors.Newf("invalid escape in %q", s)
+		}
+
+		end = i + 2
+		n = 0
+
+		switch c := s[i+1]; c {
+		case 'a':
+			n = '\a'
+		case 'b':
+			n = '\b'
+		case 'f':
+			n = '\f'
+		case 'n':
+			n = '\n'
+		case 'r':
+			n = '\r'
+		case 't':
+			n = '\t'
+		case 'v':
+			n = '\v'
+		case '\\', '\'', '"', '?':
+			n = uint64(c)
+		case 'x', 'u', 'U':
+			for (end < len(s)) && isHexDigit(s[end]) {
+				end++
+			}
+
+			n, e = strconv.ParseUint(s[i+2:end], 16, 32)
+			if e != nil {
+				return "", errors.Newf("invalid escape in %q", s)
+			}
+		default:
+			if (c < '0') || (c > '7') {
+				return "", errors.Newf("invalid escape \\%c", c)
+			}
+
+			end = i + 1
+			for (end < len(s)) && (end < i+4) && isOctDigit(s[end]) {
+				end++
+			}
+
+			n, _ = strconv.ParseUint(s[i+1:end], 8, 32)
+		}
+
+		if wide {
+			out = append(out, rune(n))
+		} else {
+			raw = append(raw, byte(n))
+		}
+
+		i = end
+	}
+
+	if wide {
+		return string(out), nil
+	}
+
+	return string(raw), nil
+}
+
+// decodeQuoted will decode a C string or char literal, including any
+// prefix and quotes.
+func decodeQuoted(lit string) (string, error) {
+	var i int = strings.IndexAny(lit, "\"'")
+
+	return decodeCString(lit[i+1:len(lit)-1], i > 0)
+}
+
+// defineMacro will record a #define, without the leading "#define ".
+func defineMacro(line string) {
+	var end int
+	var i int
+	var m *cMacro = &cMacro{}
+	var name string
+
+	for (i < len(line)) && isIdentChar(line[i]) {
+		i++
+	}
+
+	if name = line[:i]; name == "" {
+		return
+	}
+
+	if _, ok := cMacros[name]; ok {
+		return
+	}
+
+	// Function-like macros have no space before the parameters
+	if (i < len(line)) && (line[i] == '(') {
+		if end = strings.Index(line[i:], ")"); end < 0 {
+			return
+		}
+
+		m.Func = true
+
+		for p := range strings.SplitSeq(line[i+1:i+end], ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				m.Params = append(m.Params, p)
+			}
+		}
+
+		i += end + 1
+	}
+
+	m.Body = strings.TrimSpace(line[i:])
+	cMacros[name] = m
+}
+
+// evalC will evaluate a C constant expression or brace-enclosed list,
+// after expanding any macros, for the current target. The name of
+// the macro being evaluated, if any, is not expanded again.
+func evalC(expr string, name string) (*cValue, error) {
+	var e error
+	var hide map[string]bool = map[string]bool{}
+	var p *cParser
+	var toks []cToken
+	var v *cValue
+
+	if toks, e = lexC(expr); e != nil {
+		return nil, e
+	}
+
+	if name != "" {
+		hide[name] = true
+	}
+
+	if toks, e = expandMacros(toks, hide, 0); e != nil {
+		return nil, e
+	}
+
+	if len(toks) == 0 {
+		return nil, errors.New("empty value")
+	}
+
+	p = &cParser{toks: toks}
+
+	if p.peek() == "{" {
+		v, e = p.list()
+	} else {
+		v, e = p.ternary()
+	}
+
+	if e != nil {
+		return nil, e
+	}
+
+	if p.i < len(p.toks) {
+		return nil, errors.Newf("unexpected %s", p.toks[p.i].Text)
+	}
+
+	return v, nil
+}
+
+// expandMacros will expand any macros, except those being expanded
+// already.
+func expandMacros(
+	toks []cToken,
+	hide map[string]bool,
+	depth int,
+) ([]cToken, error) {
+	var args [][]cToken
+	var body []cToken
+	var e error
+	var end int
+	var m *cMacro
+	var name string
+	var ok bool
+	var out []cToken
+
+	if depth > maxExpandDepth {
+		return nil, errors.New("macro expansion too deep")
+	}
+
+	for i := 0; i < len(toks); i++ {
+		if toks[i].Kind != cIdent || hide[toks[i].Text] {
+			out = append(out, toks[i])
+			continue
+		}
+
+		if m, ok = cMacros[toks[i].Text]; !ok {
+			out = append(out, toks[i])
+			continue
+		}
+
+		name = toks[i].Text
+
+		if m.Func {
+			// Function-like macros without arguments aren't expanded
+			if (i+1 >= len(toks)) || (toks[i+1].Text != "(") {
+				out = append(out, toks[i])
+				continue
+			}
+
+			if args, end, e = macroArgs(toks, i+1); e != nil {
+				return nil, e
+			}
+
+			body, e = m.substitute(name, args, hide, depth)
+			i = end
+		} else {
+			body, e = m.substitute(name, nil, hide, depth)
+		}
+
+		if e != nil {
+			return nil, e
+		}
+
+		body, e = expandMacros(body, with(hide, name), depth+1)
+		if e != nil {
+			return nil, e
+		}
+
+		out = append(out, body...)
+	}
+
+	return out, nil
+}
+
+// floatOp will evaluate a binary operator on floats.
+func floatOp(op string, l float64, r float64) (*cValue, error) {
+	var v *cValue = &cValue{Kind: cFloat, Type: cNum{64, true, false}}
+
+	switch op {
+	case "!=":
+		return newBool(l != r), nil
+	case "*":
+		v.Float = l * r
+	case "+":
+		v.Float = l + r
+	case "-":
+		v.Float = l - r
+	case "/":
+		v.Float = l / r
+	case "<":
+		return newBool(l < r), nil
+	case "<=":
+		return newBool(l <= r), nil
+	case "==":
+		return newBool(l == r), nil
+	case ">":
+		return newBool(l > r), nil
+	case ">=":
+		return newBool(l >= r), nil
+	default:
+		return nil, errors.Newf("unsupported %s of floats", op)
+	}
+
+	return v, nil
+}
+
+// intLiteral will evaluate a C integer literal, choosing its type
+// the way a C compiler targeting Windows would.
+func intLiteral(text string) (*cValue, error) {
+	var candidates []cNum
+	var digits string = text
+	var e error
+	var hex bool
+	var n uint64
+	var suffix string
+
+	if i := strings.IndexAny(text, "uUlLiI"); i > 0 {
+		digits = text[:i]
+		suffix = strings.ToLower(text[i:])
+	}
+
+	hex = strings.HasPrefix(strings.ToLower(digits), "0x")
+
+	if n, e = strconv.ParseUint(digits, 0, 64); e != nil {
+		return nil, errors.Newf("invalid integer %s", text)
+	}
+
+	switch suffix {
+	case "", "i16", "i32", "i8", "l":
+		candidates = []cNum{{32, false, true}, {64, false, true}}
+		if hex || (digits[0] == '0') {
+			candidates = []cNum{
+				{32, false, true},
+				{32, false, false},
+				{64, false, true},
+				{64, false, false},
+			}
+		}
+	case "i64", "ll":
+		candidates = []cNum{{64, false, true}}
+		if hex || (digits[0] == '0') {
+			candidates = append(candidates, cNum{64, false, false})
+		}
+	case "llu", "lu", "u", "ui16", "ui32", "ui8", "ul":
+		candidates = []cNum{{32, false, false}, {64, false, false}}
+	case "ui64", "ull":
+		candidates = []cNum{{64, false, false}}
+	default:
+		return nil, errors.Newf("invalid integer suffix %s", text)
+	}
+
+	for _, t := range candidates {
+		if n <= maxInt(t) {
+			return &cValue{
+				Hex:  hex,
+				Int:  n,
+				Kind: cInt,
+				Lit:  digits,
+				Type: t,
+			}, nil
+		}
+	}
+
+	return nil, errors.Newf("integer %s is too large", text)
+}
+
+func isDigit(c byte) bool {
+	return (c >= '0') && (c <= '9')
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || ((c|0x20 >= 'a') && (c|0x20 <= 'f'))
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isIdentStart(c byte) bool {
+	return (c == '_') || ((c|0x20 >= 'a') && (c|0x20 <= 'z'))
+}
+
+// isNumChar will return true if s[i] continues a preprocessing
+// number.
+func isNumChar(s string, i int) bool {
+	switch {
+	case isIdentChar(s[i]), s[i] == '.':
+		return true
+	case (s[i] == '+') || (s[i] == '-'):
+		return strings.ContainsRune("EePp", rune(s[i-1]))
+	}
+
+	return false
+}
+
+func isOctDigit(c byte) bool {
+	return (c >= '0') && (c <= '7')
+}
+
+// lexC will split C source into tokens, ignoring comments.
+func lexC(s string) ([]cToken, error) {
+	var e error
+	var i int
+	var j int
+	var kind string
+	var toks []cToken
+
+	for i < len(s) {
+		switch c := s[i]; {
+		case strings.ContainsRune(" \t\r\n\f\v", rune(c)):
+			i++
+			continue
+		case strings.HasPrefix(s[i:], "/*"):
+			if j = strings.Index(s[i+2:], "*/"); j < 0 {
+				return nil, errors.New("unterminated comment")
+			}
+
+			i += j + 4 //nolint:mnd // Length of "/*" and "*/"
+
+			continue
+		case strings.HasPrefix(s[i:], "//"):
+			i = len(s)
+			continue
+		case isIdentStart(c):
+			j = i + 1
+			kind = cIdent
+
+			for (j < len(s)) && isIdentChar(s[j]) {
+				j++
+			}
+
+			// Prefixed char and string literals, such as L"foo"
+			switch s[i:j] {
+			case "L", "U", "u", "u8":
+				if (j < len(s)) && ((s[j] == '"') || (s[j] == '\'')) {
+					kind = cString
+					if s[j] == '\'' {
+						kind = cChar
+					}
+
+					if j, e = scanQuoted(s, j); e != nil {
+						return nil, e
+					}
+				}
+			}
+		case isDigit(c) ||
+			((c == '.') && (i+1 < len(s)) && isDigit(s[i+1])):
+			j = i + 1
+			kind = cNumber
+
+			// Preprocessing numbers include exponent signs
+			for (j < len(s)) && isNumChar(s, j) {
+				j++
+			}
+		case (c == '"') || (c == '\''):
+			kind = cString
+			if c == '\'' {
+				kind = cChar
+			}
+
+			if j, e = scanQuoted(s, i); e != nil {
+				return nil, e
+			}
+		default:
+			j = i + 1
+			kind = cPunct
+
+			for _, p := range cPuncts {
+				if strings.HasPrefix(s[i:], p) {
+					j = i + len(p)
+					break
+				}
+			}
+		}
+
+		toks = append(toks, cToken{Kind: kind, Text: s[i:j]})
+		i = j
+	}
+
+	return toks, nil
+}
+
+// lookupCType will return the type and size of a C type name, such as
+// "DWORD", "unsigned long" or "LPVOID". The type has 0 Bits if it is
+// not a number.
+func lookupCType(name string) (cNum, int, error) {
+	var ptr bool = strings.HasSuffix(name, "*")
+	var t cNum
+	var words []string
+
+	if ptr {
+		//nolint:mnd // Bytes
+		return cNum{Bits: ptrBits()}, ptrBits() / 8, nil
+	}
+
+	for _, w := range strings.Fields(name) {
+		switch w {
+		case "const", "signed", "volatile":
+		case "int":
+			// Redundant after short, long, or unsigned
+			if len(words) == 0 {
+				words = append(words, w)
+			}
+		default:
+			if (len(words) == 1) && (words[0] == "int") {
+				words = nil
+			}
+
+			words = append(words, w)
+		}
+	}
+
+	name = strings.Join(words, " ")
+	if (name == "unsigned") || (name == "") {
+		name += " int"
+		name = strings.TrimSpace(name)
+	}
+
+	if t, ok := cKeywordTypes[name]; ok {
+		return t, t.Bits / 8, nil //nolint:mnd // Bytes
+	}
+
+	if ct, ok := cTypes[name]; ok {
+		t = cNum{Bits: ct.Size * 8} //nolint:mnd // Bits
+
+		switch {
+		case ct.Size == 0:
+			t.Bits = ptrBits()
+			t.Signed = slices.Contains(cSignedPtrTypes, name)
+		case strings.HasPrefix(ct.Go, "float"):
+			t.Float = true
+		case strings.HasPrefix(ct.Go, "int"):
+			t.Signed = true
+		case !strings.HasPrefix(ct.Go, "uint"):
+			// Structs
+			return cNum{}, ct.Size, nil
+		}
+
+		return t, t.Bits / 8, nil //nolint:mnd // Bytes
+	}
+
+	if alias, ok := cTypeAliases[name]; ok {
+		return lookupCType(alias)
+	}
+
+	return cNum{}, 0, errors.Newf("unknown type %s", name)
+}
+
+// macroArgs will return the arguments of a function-like macro call,
+// and the index of the closing paren.
+func macroArgs(toks []cToken, open int) ([][]cToken, int, error) {
+	var args [][]cToken = [][]cToken{{}}
+	var depth int
+
+	for i := open; i < len(toks); i++ {
+		switch toks[i].Text {
+		case "(":
+			if depth++; depth == 1 {
+				continue
+			}
+		case ")":
+			if depth--; depth == 0 {
+				return args, i, nil
+			}
+		case ",":
+			if depth == 1 {
+				args = append(args, []cToken{})
+				continue
+			}
+		}
+
+		args[len(args)-1] = append(args[len(args)-1], toks[i])
+	}
+
+	return nil, 0, errors.New("unterminated macro arguments")
+}
+
+// mask will return a mask of the low bits.
+func mask(bits int) uint64 {
+	if bits >= 64 { //nolint:mnd // Bits in uint64
+		return math.MaxUint64
+	}
+
+	return (1 << bits) - 1
+}
+
+// maxInt will return the max value of an integer type.
+func maxInt(t cNum) uint64 {
+	if t.Signed {
+		return mask(t.Bits) >> 1
+	}
+
+	return mask(t.Bits)
+}
+
+// newBool will return 1 or 0 as a C int.
+func newBool(b bool) *cValue {
+	var v *cValue = &cValue{Kind: cInt}
+
+	v.Type = cNum{Bits: 32, Signed: true} //nolint:mnd // int
+
+	if b {
+		v.Int = 1
+	}
+
+	return v
+}
+
+// numLiteral will evaluate a C number literal.
+func numLiteral(text string) (*cValue, error) {
+	var e error
+	var f float64
+	var hex bool = strings.HasPrefix(strings.ToLower(text), "0x")
+	var lit string
+
+	if (!hex && !strings.ContainsAny(text, ".Ee")) ||
+		(hex && !strings.ContainsAny(text, ".Pp")) {
+		return intLiteral(text)
+	}
+
+	lit = strings.TrimRight(text, "FfLl")
+
+	if f, e = strconv.ParseFloat(lit, 64); e != nil {
+		return nil, errors.Newf("invalid float %s", text)
+	}
+
+	return &cValue{
+		Float: f,
+		Kind:  cFloat,
+		Lit:   lit,
+		Type:  cNum{64, true, false},
+	}, nil
+}
+
+// pasteTokens will apply any ## operators.
+func pasteTokens(toks []cToken) ([]cToken, error) {
+	var e error
+	var out []cToken
+	var pasted []cToken
+
+	for i := 0; i < len(toks); i++ {
+		if (toks[i].Text != "##") || (toks[i].Kind != cPunct) {
+			out = append(out, toks[i])
+			continue
+		}
+
+		if (len(out) == 0) || (i+1 >= len(toks)) {
+			return nil, errors.New("## at edge of macro")
+		}
+
+		i++
+
+		pasted, e = lexC(out[len(out)-1].Text + toks[i].Text)
+		if (e != nil) || (len(pasted) != 1) {
+			return nil, errors.Newf(
+				"invalid paste of %s and %s",
+				out[len(out)-1].Text,
+				toks[i].Text,
+			)
+		}
+
+		out[len(out)-1] = pasted[0]
+	}
+
+	return out, nil
+}
+
+// ptrBits will return the pointer size of the current target.
+func ptrBits() int {
+	if curTarget == nil {
+		return 64 //nolint:mnd // Default to 64-bit
+	}
+
+	return curTarget.Bits
+}
+
+// scanQuoted will return the index after the closing quote of a
+// string or char literal.
+func scanQuoted(s string, open int) (int, error) {
+	for i := open + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case s[open]:
+			return i + 1, nil
+		}
+	}
+
+	return 0, errors.Newf("unterminated literal %s", s[open:])
+}
+
+// shiftOp will evaluate a shift, which has the type of the promoted
+// left operand.
+func shiftOp(op string, l *cValue, r *cValue) (*cValue, error) {
+	var n int64
+	var out *cValue
+
+	if (l.Kind != cInt) || (r.Kind != cInt) {
+		return nil, errors.Newf("unsupported %s of floats", op)
+	}
+
+	l = l.promote()
+	r = r.promote()
+
+	if n = int64(r.Int); r.Type.Signed {
+		n = r.signed()
+	}
+
+	if (n < 0) || (n >= int64(l.Type.Bits)) {
+		return nil, errors.Newf("shift count %d out of range", n)
+	}
+
+	out = &cValue{Hex: l.Hex || r.Hex, Kind: cInt, Type: l.Type}
+
+	switch {
+	case op == "<<":
+		out.Int = (l.Int << n) & mask(l.Type.Bits)
+	case l.Type.Signed:
+		out.Int = uint64(l.signed()>>n) & mask(l.Type.Bits)
+	default:
+		out.Int = l.Int >> n
+	}
+
+	return out, nil
+}
+
+// sizeValue will return a size as a size_t.
+func sizeValue(n int) *cValue {
+	return &cValue{
+		Int:  uint64(n),
+		Kind: cInt,
+		Lit:  strconv.Itoa(n),
+		Type: cNum{Bits: ptrBits()},
+	}
+}
+
+// unaryOp will evaluate a unary operator.
+func unaryOp(op string, v *cValue) (*cValue, error) {
+	var out *cValue
+
+	if !v.isNum() {
+		return nil, errors.Newf("unsupported %s of non-number", op)
+	}
+
+	if op == "!" {
+		return newBool(!v.truthy()), nil
+	}
+
+	v = v.promote()
+
+	if v.Kind == cFloat {
+		if op == "~" {
+			return nil, errors.New("unsupported ~ of float")
+		}
+
+		out = &cValue{Float: v.Float, Kind: cFloat, Type: v.Type}
+
+		if op == "-" {
+			out.Float = -v.Float
+		}
+
+		if v.Lit != "" {
+			out.Lit = strings.TrimPrefix(op, "+") + v.Lit
+		}
+
+		return out, nil
+	}
+
+	out = &cValue{Hex: v.Hex, Int: v.Int, Kind: cInt, Type: v.Type}
+
+	switch op {
+	case "+":
+		out.Lit = v.Lit
+	case "-":
+		out.Int = (0 - v.Int) & mask(v.Type.Bits)
+
+		// Keep the spelling of negative literals, like -1
+		if (v.Lit != "") && out.negative() && !v.negative() {
+			out.Lit = "-" + v.Lit
+		}
+
+		// Negated unsigned values are bit patterns
+		if !out.negative() && (out.Int != 0) {
+			out.Hex = true
+		}
+	case "~":
+		out.Hex = true
+		out.Int = ^v.Int & mask(v.Type.Bits)
+	}
+
+	return out, nil
+}
+
+// with will return a copy of a set, with name added.
+func with(set map[string]bool, name string) map[string]bool {
+	var out map[string]bool = map[string]bool{name: true}
+
+	for k := range set {
+		out[k] = true
+	}
+
+	return out
+}
+
+func (m *cMacro) substitute(
+	name string,
+	args [][]cToken,
+	hide map[string]bool,
+	depth int,
+) ([]cToken, error) {
+	var arg []cToken
+	var e error
+	var expanded []cToken
+	var n int
+	var out []cToken
+	var text []string
+	var variadic bool = slices.Contains(m.Params, "...")
+
+	if m.toks == nil {
+		if m.toks, e = lexC(m.Body); e != nil {
+			return nil, e
+		}
+	}
+
+	if m.Func {
+		// Calls like FOO() have one empty argument
+		if (len(m.Params) == 0) && (len(args) == 1) &&
+			(len(args[0]) == 0) {
+			args = nil
+		}
+
+		// Extra arguments are part of __VA_ARGS__
+		if variadic && (len(args) > len(m.Params)) {
+			n = len(m.Params) - 1
+
+			for _, a := range args[n+1:] {
+				args[n] = append(args[n], cToken{cPunct, ","})
+				args[n] = append(args[n], a...)
+			}
+
+			args = args[:n+1]
+		}
+
+		if len(args) != len(m.Params) {
+			return nil, errors.Newf(
+				"%s expects %d arguments, got %d",
+				name,
+				len(m.Params),
+				len(args),
+			)
+		}
+	}
+
+	for i := 0; i < len(m.toks); i++ {
+		// Stringize
+		if (m.toks[i].Text == "#") && m.Func && (i+1 < len(m.toks)) {
+			if n = cParam(m.Params, m.toks[i+1]); n >= 0 {
+				text = nil
+				for _, t := range args[n] {
+					text = append(text, t.Text)
+				}
+
+				out = append(
+					out,
+					cToken{
+						cString,
+						strconv.Quote(strings.Join(text, " ")),
+					},
+				)
+				i++
+
+				continue
+			}
+		}
+
+		if n = cParam(m.Params, m.toks[i]); (n < 0) || !m.Func {
+			out = append(out, m.toks[i])
+			continue
+		}
+
+		arg = args[n]
+
+		// Arguments are expanded first, unless pasted
+		if ((i > 0) && (m.toks[i-1].Text == "##")) ||
+			((i+1 < len(m.toks)) && (m.toks[i+1].Text == "##")) {
+			out = append(out, arg...)
+			continue
+		}
+
+		if expanded, e = expandMacros(arg, hide, depth+1); e != nil {
+			return nil, e
+		}
+
+		out = append(out, expanded...)
+	}
+
+	return pasteTokens(out)
+}
+
+func (p *cParser) binary(minPrec int) (*cValue, error) {
+	var e error
+	var l *cValue
+	var op string
+	var prec int
+	var r *cValue
+
+	if l, e = p.unary(); e != nil {
+		return nil, e
+	}
+
+	for {
+		op = p.peek()
+
+		prec = cBinaryOps[op]
+		if (prec == 0) || (prec < minPrec) ||
+			(p.toks[p.i].Kind != cPunct) {
+			return l, nil
+		}
+
+		p.i++
+
+		if r, e = p.binary(prec + 1); e != nil {
+			return nil, e
+		}
+
+		if l, e = binaryOp(op, l, r); e != nil {
+			return nil, e
+		}
+	}
+}
+
+func (p *cParser) cast() (cNum, bool, error) {
+	var e error
+	var end int
+	var t cNum
+	var words []string
+
+	// Type names are identifiers, then optionally pointers
+	end = p.i + 1
+	for (end < len(p.toks)) && (p.toks[end].Kind == cIdent) {
+		words = append(words, p.toks[end].Text)
+		end++
+	}
+
+	if len(words) == 0 {
+		return cNum{}, false, nil
+	}
+
+	for (end < len(p.toks)) && (p.toks[end].Text == "*") {
+		words = append(words, "*")
+		end++
+	}
+
+	if (end >= len(p.toks)) || (p.toks[end].Text != ")") {
+		return cNum{}, false, nil
+	}
+
+	// After macros are expanded, a lone identifier in parens is only
+	// valid as a type name
+	if t, _, e = lookupCType(strings.Join(words, " ")); e != nil {
+		return cNum{}, true, e
+	}
+
+	if t.Bits == 0 {
+		return cNum{}, true, errors.Newf(
+			"unsupported cast to %s",
+			strings.Join(words, " "),
+		)
+	}
+
+	p.i = end + 1
+
+	return t, true, nil
+}
+
+func (p *cParser) list() (*cValue, error) {
+	var e error
+	var elem *cValue
+	var v *cValue = &cValue{Kind: cList}
+
+	p.i++ // Skip {
+
+	for p.peek() != "}" {
+		if p.peek() == "{" {
+			elem, e = p.list()
+		} else {
+			elem, e = p.ternary()
+		}
+
+		if e != nil {
+			return nil, e
+		}
+
+		v.List = append(v.List, elem)
+
+		if p.peek() != "," {
+			break
+		}
+
+		p.i++
+	}
+
+	if p.next() != "}" {
+		return nil, errors.New("unterminated list")
+	}
+
+	return v, nil
+}
+
+func (p *cParser) next() string {
+	var tok string = p.peek()
+
+	if p.i < len(p.toks) {
+		p.i++
+	}
+
+	return tok
+}
+
+func (p *cParser) peek() string {
+	if p.i >= len(p.toks) {
+		return ""
+	}
+
+	return p.toks[p.i].Text
+}
+
+func (p *cParser) primary() (*cValue, error) {
+	var e error
+	var s string
+	var tok cToken
+	var v *cValue
+
+	if p.i >= len(p.toks) {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	tok = p.toks[p.i]
+	p.i++
+
+	switch tok.Kind {
+	case cChar:
+		if s, e = decodeQuoted(tok.Text); e != nil {
+			return nil, e
+		}
+
+		v = newBool(false)
+
+		for _, r := range s {
+			//nolint:mnd // Multi-char constants
+			v.Int = (v.Int << 8) | uint64(r)
+		}
+
+		v.Int &= mask(v.Type.Bits)
+		v.Lit = strconv.FormatUint(v.Int, 10)
+
+		return v, nil
+	case cIdent:
+		return nil, errors.Newf("unknown identifier %s", tok.Text)
+	case cNumber:
+		return numLiteral(tok.Text)
+	case cString:
+		v = &cValue{Kind: cString}
+
+		// Adjacent strings are concatenated
+		for {
+			if s, e = decodeQuoted(tok.Text); e != nil {
+				return nil, e
+			}
+
+			v.Str += s
+
+			if (p.i >= len(p.toks)) || (p.toks[p.i].Kind != cString) {
+				return v, nil
+			}
+
+			tok = p.toks[p.i]
+			p.i++
+		}
+	}
+
+	if tok.Text != "(" {
+		return nil, errors.Newf("unexpected %s", tok.Text)
+	}
+
+	if v, e = p.ternary(); e != nil {
+		return nil, e
+	}
+
+	if p.next() != ")" {
+		return nil, errors.New("missing )")
+	}
+
+	return v, nil
+}
+
+func (p *cParser) sizeof() (*cValue, error) {
+	var e error
+	var end int
+	var n int
+	var v *cValue
+	var words []string
+
+	if p.peek() == "(" {
+		end = p.i + 1
+		for (end < len(p.toks)) && (p.toks[end].Kind == cIdent) {
+			words = append(words, p.toks[end].Text)
+			end++
+		}
+
+		for (end < len(p.toks)) && (p.toks[end].Text == "*") {
+			words = append(words, "*")
+			end++
+		}
+
+		if (len(words) > 0) && (end < len(p.toks)) &&
+			(p.toks[end].Text == ")") {
+			_, n, e = lookupCType(strings.Join(words, " "))
+			if e != nil {
+				return nil, e
+			}
+
+			if n == 0 {
+				return nil, errors.Newf(
+					"unknown size of %s",
+					strings.Join(words, " "),
+				)
+			}
+
+			p.i = end + 1
+
+			return sizeValue(n), nil
+		}
+	}
+
+	if v, e = p.unary(); e != nil {
+		return nil, e
+	}
+
+	switch v.Kind {
+	case cFloat, cInt:
+		return sizeValue(v.Type.Bits / 8), nil //nolint:mnd // Bytes
+	case cString:
+		return sizeValue(len(v.Str) + 1), nil
+	}
+
+	return nil, errors.New("unsupported sizeof")
+}
+
+func (p *cParser) ternary() (*cValue, error) {
+	var a *cValue
+	var b *cValue
+	var cond *cValue
+	var e error
+
+	if cond, e = p.binary(1); (e != nil) || (p.peek() != "?") {
+		return cond, e
+	}
+
+	p.i++
+
+	if a, e = p.ternary(); e != nil {
+		return nil, e
+	}
+
+	if p.next() != ":" {
+		return nil, errors.New("missing : in ternary")
+	}
+
+	if b, e = p.ternary(); e != nil {
+		return nil, e
+	}
+
+	if !cond.isNum() {
+		return nil, errors.New("unsupported ternary condition")
+	}
+
+	// Numbers are converted to a common type
+	if a.isNum() && b.isNum() {
+		if a, b, e = arithmetic(a, b); e != nil {
+			return nil, e
+		}
+	}
+
+	if cond.truthy() {
+		return a, nil
+	}
+
+	return b, nil
+}
+
+func (p *cParser) unary() (*cValue, error) {
+	var e error
+	var isCast bool
+	var t cNum
+	var v *cValue
+
+	switch op := p.peek(); op {
+	case "!", "+", "-", "~":
+		p.i++
+
+		if v, e = p.unary(); e != nil {
+			return nil, e
+		}
+
+		return unaryOp(op, v)
+	case "sizeof":
+		p.i++
+		return p.sizeof()
+	case "(":
+		if t, isCast, e = p.cast(); e != nil {
+			return nil, e
+		} else if isCast {
+			if v, e = p.unary(); e != nil {
+				return nil, e
+			}
+
+			return v.convert(t)
+		}
+	}
+
+	return p.primary()
+}
+
+func (v *cValue) convert(t cNum) (*cValue, error) {
+	var out *cValue
+
+	if !v.isNum() {
+		return nil, errors.Newf("unsupported cast of %s", v.Kind)
+	}
+
+	out = &cValue{Hex: v.Hex, Kind: cInt, Type: t}
+
+	switch {
+	case t.Float && (v.Kind == cFloat):
+		out.Float = v.Float
+	case t.Float:
+		out.Float = float64(v.signed())
+		if !v.Type.Signed {
+			out.Float = float64(v.Int)
+		}
+	case v.Kind == cFloat:
+		out.Int = uint64(int64(v.Float)) & mask(t.Bits)
+	case v.Type.Signed:
+		out.Int = uint64(v.signed()) & mask(t.Bits)
+	default:
+		out.Int = v.Int & mask(t.Bits)
+	}
+
+	if t.Float {
+		out.Kind = cFloat
+	}
+
+	// Keep the spelling, if the value didn't change
+	if out.sameAs(v) {
+		out.Lit = v.Lit
+	} else if v.negative() {
+		out.Hex = true
+	}
+
+	return out, nil
+}
+
+// goValue will return the Go type and value of an evaluated C
+// constant.
+func (v *cValue) goValue() (string, string, error) {
+	var e error
+	var elems []string
+	var t string
+	var val string
+
+	switch v.Kind {
+	case cFloat:
+		if v.Lit != "" {
+			return "float64", v.Lit, nil
+		}
+
+		//nolint:mnd // Shortest representation of a float64
+		val = strconv.FormatFloat(v.Float, 'g', -1, 64)
+
+		return "float64", val, nil
+	case cInt:
+		switch {
+		case v.Lit != "":
+			val = v.Lit
+		case v.negative():
+			val = strconv.FormatInt(v.signed(), 10)
+		case v.Hex:
+			val = fmt.Sprintf("0x%x", v.Int)
+		default:
+			val = strconv.FormatUint(v.Int, 10)
+		}
+
+		if v.negative() {
+			return "int", val, nil
+		}
+
+		return "uintptr", val, nil
+	case cList:
+		for _, elem := range v.List {
+			if t, val, e = elem.goValue(); e != nil {
+				return "", "", e
+			}
+
+			if t != "uintptr" {
+				return "", "", errors.Newf(
+					"unsupported %s in list",
+					t,
+				)
+			}
+
+			elems = append(elems, val)
+		}
+
+		val = "[]uintptr{" + strings.Join(elems, ", ") + "}"
+
+		return "[]uintptr", val, nil
+	}
+
+	return "string", strconv.Quote(v.Str), nil
+}
+
+func (v *cValue) isNum() bool {
+	return (v.Kind == cFloat) || (v.Kind == cInt)
+}
+
+func (v *cValue) negative() bool {
+	switch v.Kind {
+	case cFloat:
+		return v.Float < 0
+	case cInt:
+		return v.Type.Signed && (v.signed() < 0)
+	}
+
+	return false
+}
+
+func (v *cValue) promote() *cValue {
+	var out *cValue
+
+	if (v.Kind != cInt) || (v.Type.Bits >= 32) { //nolint:mnd // int
+		return v
+	}
+
+	out, _ = v.convert(cNum{Bits: 32, Signed: true})
+
+	return out
+}
+
+func (v *cValue) sameAs(other *cValue) bool {
+	switch {
+	case (v.Kind == cFloat) || (other.Kind == cFloat):
+		return (v.Kind == other.Kind) && (v.Float == other.Float)
+	case v.negative() || other.negative():
+		return v.negative() && other.negative() &&
+			(v.signed() == other.signed())
+	}
+
+	return v.Int == other.Int
+}
+
+// signed will return the value of a signed int.
+func (v *cValue) signed() int64 {
+	var shift int = 64 - v.Type.Bits
+
+	return int64(v.Int<<shift) >> shift
+}
+
+func (v *cValue) truthy() bool {
+	if v.Kind == cFloat {
+		return v.Float != 0
+	}
+
+	return v.Int != 0
+}
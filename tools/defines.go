@@ -1,14 +1,15 @@
-//nolint:godox // I'll do the one todo later maybe
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
+	gofmt "go/format"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/mjwhitta/errors"
@@ -23,6 +24,10 @@ type cacheEntry struct {
 }
 
 var (
+	// Copied before processing each target, as these are modified
+	baseGlobals       = copyEntries(cache[""])
+	baseSkipRContains = copySkips(skipRContains)
+	baseSkipRStarts   = copySkips(skipRStarts)
 	// Cache, indexed by scope
 	cache = map[string][]*cacheEntry{
 		"": { // Global scope
@@ -66,36 +71,28 @@ var (
 		"winsock.h",
 		"winuser.h",
 	}
-	lookup = map[string]*cacheEntry{}
+	// Additional headers to process
+	extraHeaders = flag.String(
+		"headers",
+		"",
+		"Comma-separated list of additional headers to process",
+	)
+	// Where to find the mingw headers
+	include = flag.String(
+		"include",
+		"/usr/x86_64-w64-mingw32/include/",
+		"Directory containing the mingw headers",
+	)
 	// Regular expressions
-	reBitwisenot = regexp.MustCompile(`\~`)
-	reCamel      = regexp.MustCompile(`[A-Z][a-z]+[A-Z][a-z]+`)
-	reComment    = regexp.MustCompile(`\s*\/\*.*\*\/\s*`)
-	reFixCast    = regexp.MustCompile(
-		`\([A-Za-z_]+\)\s*(\(?\-?[0-9A-Fa-fXx]+|NULL)`,
+	reCamel   = regexp.MustCompile(`[A-Z][a-z]+[A-Z][a-z]+`)
+	reComment = regexp.MustCompile(`\s*\/\*.*\*\/\s*`)
+	reSpaces  = regexp.MustCompile(`\s+`)
+	// File to write constants that could not be evaluated to
+	report = flag.String(
+		"report",
+		"",
+		"Write constants that could not be evaluated to this file",
 	)
-	reFixHex        = regexp.MustCompile(`(0[Xx][0-9A-Fa-f]+)[LlUu]+`)
-	reFixLen        = regexp.MustCompile(`(len\(.+?\))`)
-	reFixMSABI      = regexp.MustCompile(`__MSABI_LONG([^)]+)`)
-	reFixNum        = regexp.MustCompile(`(\d+)[LlUu]+`)
-	reFixSizeOf     = regexp.MustCompile(`sizeof\s*\(`)
-	reSpaces        = regexp.MustCompile(`\s+`)
-	reUselessOpRepl = []*regexp.Regexp{
-		regexp.MustCompile(`\s*\^\s*0([^x])`), // ^ 0
-		regexp.MustCompile(`\s*\|\s*0([^x])`), // | 0
-	}
-	reUselessOpRm = []*regexp.Regexp{
-		regexp.MustCompile(`\s*\^\s*\(0\)`),         // ^ (0)
-		regexp.MustCompile(`\s*\|\s*\(0\)`),         // | (0)
-		regexp.MustCompile(`(0x0{8}\s*\|\s*)+`),     // 0x00000000 |
-		regexp.MustCompile(`(\(0x0{8}\)\s*\|\s*)+`), // (0x00000000) |
-		// ((0x0...0)) |
-		regexp.MustCompile(`(\(\(0x0{8}\)\)\s*\|\s*)+`),
-		regexp.MustCompile(`(\s*\|\s*0x0{8})+`),     // | 0x00000000
-		regexp.MustCompile(`(\s*\|\s*\(0x0{8}\))+`), // | (0x00000000)
-		// | ((0x0...0))
-		regexp.MustCompile(`(\s*\|\s*\(\(0x0{8}\)\))+`),
-	}
 	// Skips
 	skipLContains = map[string][]string{
 		"": {
@@ -122,9 +119,6 @@ var (
 			"__MINGW_NAME",
 			"DECLSPEC",
 			"HRESULT",
-			"len(double)",
-			"len(DWORD)",
-			"len(ULONGLONG)",
 			"WINAPI",
 		},
 		"mciapi.h": {
@@ -158,14 +152,12 @@ var (
 			"MAKELCID(",
 		},
 		"winuser.h": {
-			"len(LRESULT)",
 			"MAKEINTATOM(",
 		},
 	}
 	skipRStarts = map[string][]string{
 		"": {
 			":",
-			"_",
 			"extern",
 			"void",
 		},
@@ -205,23 +197,28 @@ var (
 	}
 )
 
-func buildLookup() {
-	for scope, entries := range cache {
-		if scope == "" {
-			continue
-		}
+// allHeaders will return the default headers and any requested with
+// -headers.
+func allHeaders() []string {
+	var all []string = append([]string{}, headers...)
 
-		for _, entry := range entries {
-			lookup[format(scope)+"."+entry.Go] = entry
+	for header := range strings.SplitSeq(*extraHeaders, ",") {
+		header = strings.TrimSpace(header)
+
+		if (header != "") && !slices.Contains(all, header) {
+			all = append(all, header)
 		}
 	}
+
+	return all
 }
 
 // This only caches the first result, which may not be the best idea.
+// Values are evaluated later, as they may reference #defines from
+// headers that haven't been processed yet.
 func cacheVar(fn string, c string, v string) {
 	var g string
 	var scope string = strings.TrimSuffix(filepath.Base(fn), ".h")
-	var t string = "uintptr"
 
 	// Remove leading and trailing whitespace, just in case
 	c = strings.TrimSpace(c)
@@ -236,52 +233,98 @@ func cacheVar(fn string, c string, v string) {
 		}
 	}
 
-	if reFixLen.MatchString(v) {
-		v = reFixLen.ReplaceAllString(v, "uintptr($1)")
+	// No previous entry found
+	cache[scope] = append(cache[scope], &cacheEntry{c, g, "", v})
+}
+
+// constName will return the Go name of a constant from a header,
+// which is prefixed with the header unless it already is.
+func constName(scope string, g string) string {
+	var prefix string = format(scope)
+
+	if strings.HasPrefix(g, prefix) {
+		return g
 	}
 
-	switch {
-	case strings.HasPrefix(v, "\""):
-		t = "string"
-	case strings.HasPrefix(v, "L\""):
-		v = v[1:]
-		t = "string"
-	case strings.HasPrefix(v, "TEXT("):
-		v = strings.Replace(v[5:], ")", "", 1)
-		t = "string"
-	case strings.Contains(v, "L\""):
-		v = "[]string{" + strings.ReplaceAll(v, " L\"", ", \"") + "}"
-		t = "[]string"
-	case strings.Contains(v, "-"):
-		t = "int"
-	case strings.Contains(v, ".") && strings.HasSuffix(v, "f"):
-		v = strings.TrimSuffix(v, "f")
-		t = "float64"
-	case strings.HasPrefix(v, "{"):
-		v = "[]uintptr" + v
-		t = "[]uintptr"
+	return prefix + g
+}
+
+// copyEntries will return a deep copy of cache entries.
+func copyEntries(entries []*cacheEntry) []*cacheEntry {
+	var out []*cacheEntry
+
+	for _, entry := range entries {
+		c := *entry
+		out = append(out, &c)
 	}
 
-	// No previous entry found
-	cache[scope] = append(cache[scope], &cacheEntry{c, g, t, v})
+	return out
+}
+
+// copySkips will return a deep copy of skips.
+func copySkips(skips map[string][]string) map[string][]string {
+	var out map[string][]string = map[string][]string{}
+
+	for fn, s := range skips {
+		out[fn] = append([]string{}, s...)
+	}
+
+	return out
 }
 
-func fixVarTypes() {
-	for _, entries := range cache {
+// evalCache will evaluate the cached constants for the current
+// target, and return the cache. Constants that can't be evaluated
+// are removed and recorded for the report.
+func evalCache() map[string][]*cacheEntry {
+	var e error
+	var keep []*cacheEntry
+	var v *cValue
+
+	for scope, entries := range cache {
+		if scope == "" {
+			continue
+		}
+
+		keep = nil
+
 		for _, entry := range entries {
-			// Fix types
-			switch {
-			case strings.HasPrefix(entry.Val, "\""):
-				entry.Type = "string"
-			case strings.HasPrefix(entry.Val, "[]string{"):
-				entry.Type = "[]string"
-			case strings.HasPrefix(entry.Val, "[]uintptr{"):
-				entry.Type = "[]uintptr"
-			case strings.Contains(entry.Val, "-"):
-				entry.Type = "int"
+			if v, e = evalC(entry.Val, entry.C); e == nil {
+				entry.Type, entry.Val, e = v.goValue()
+			}
+
+			if e != nil {
+				// Errors are only prefixed with the package name
+				// when built as a test, so drop it
+				msg := strings.TrimPrefix(e.Error(), "tools: ")
+
+				unhandled[scope+".h: "+entry.C+": "+msg] = true
+
+				continue
 			}
+
+			keep = append(keep, entry)
+		}
+
+		cache[scope] = keep
+	}
+
+	return cache
+}
+
+// fitEntries will return copies of entries for an arch with the
+// provided pointer size, with values too large for a 32-bit uintptr
+// made uint64.
+func fitEntries(entries []*cacheEntry, bits int) []*cacheEntry {
+	var out []*cacheEntry = copyEntries(entries)
+
+	for _, entry := range out {
+		if (entry.Type == "uintptr") && (bits == 32) &&
+			!fits32(entry.Val) {
+			entry.Type = "uint64"
 		}
 	}
+
+	return out
 }
 
 func format(str string) string {
@@ -348,161 +391,296 @@ func format(str string) string {
 	return strings.Join(out, " ")
 }
 
-func genFile() (e error) {
-	var entries []*cacheEntry
-	var f *os.File
-	var scopes []string
+// genConsts will generate a Go file for the constants of a header,
+// with an optional build constraint and a note added to the
+// description. Lists can't be constants, so they are vars.
+func genConsts(
+	scope string,
+	tags string,
+	note string,
+	entries []*cacheEntry,
+) ([]byte, error) {
+	var b []byte
+	var consts bytes.Buffer
+	var desc string = "Constants used by every header"
+	var e error
+	var out bytes.Buffer
+	var vars bytes.Buffer
 
-	// Build lookup table and replace C-style var names with Go-style
-	// var names
-	buildLookup()
-	replaceVars()
-	fixVarTypes()
+	if scope != "" {
+		desc = "Constants from " + scope + ".h"
+	}
 
-	// Open file to write
-	if f, e = genHeader(); e != nil {
-		return e
+	if note != "" {
+		desc += ", " + note
 	}
-	defer func() {
-		if e == nil {
-			e = f.Close()
-		}
-	}()
 
-	// Get all scopes
-	for scope := range cache {
-		if scope == "" {
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Type, "[]") {
+			_, _ = fmt.Fprintf(
+				&vars,
+				"\t%s %s = %s\n",
+				constName(scope, entry.Go),
+				entry.Type,
+				entry.Val,
+			)
+
 			continue
 		}
 
-		scopes = append(scopes, scope)
+		_, _ = fmt.Fprintf(
+			&consts,
+			"\t%s %s = %s\n",
+			constName(scope, entry.Go),
+			entry.Type,
+			entry.Val,
+		)
 	}
 
-	// Sort alphabetically, case-insensitive
-	sort.Slice(
-		scopes,
-		func(i int, j int) bool {
-			var l string = strings.ToLower(scopes[i])
-			var r string = strings.ToLower(scopes[j])
-
-			if l == r {
-				return scopes[i] < scopes[j]
-			}
-
-			return l < r
-		},
+	_, _ = fmt.Fprintln(
+		&out,
+		"// Code generated by tools/defines.go; DO NOT EDIT.",
 	)
 
-	// Loop thru scopes
-	for _, scope := range scopes {
-		entries = cache[scope]
-
-		// Sort alphabetically, case-insensitive
-		sort.Slice(
-			entries,
-			func(i int, j int) bool {
-				var l string = strings.ToLower(entries[i].Go)
-				var r string = strings.ToLower(entries[j].Go)
+	if tags != "" {
+		_, _ = fmt.Fprintf(&out, "\n//go:build %s\n", tags)
+	}
 
-				if l == r {
-					return entries[i].Go < entries[j].Go
-				}
+	_, _ = fmt.Fprintf(&out, "\npackage api\n")
 
-				return l < r
-			},
+	if consts.Len() > 0 {
+		_, _ = fmt.Fprintf(
+			&out,
+			"\n%s\nconst (\n%s)\n",
+			wrapComment(desc),
+			consts.String(),
 		)
+	}
 
-		// Start scoped section
+	if vars.Len() > 0 {
 		_, _ = fmt.Fprintf(
-			f,
-			"\n// %s contains constants from %s.h\n",
-			format(scope),
-			scope,
+			&out,
+			"\n%s\nvar (\n%s)\n",
+			wrapComment(
+				strings.Replace(desc, "Constants", "Lists", 1)+
+					", which can't be constants",
+			),
+			vars.String(),
 		)
-		_, _ = fmt.Fprintf(f, "var %s = struct {\n", format(scope))
+	}
 
-		// Define struct
-		for _, entry := range entries {
-			_, _ = fmt.Fprintf(f, "\t%s %s\n", entry.Go, entry.Type)
+	if b, e = gofmt.Source(out.Bytes()); e != nil {
+		return nil, errors.Newf("failed to format defines: %w", e)
+	}
+
+	return b, nil
+}
+
+// genDefines will generate Go source for the cached constants of
+// each target, with a file per header. Constants with the same value
+// for every target are generated in generated_<header>.go, the
+// others in a file per arch.
+func genDefines(
+	tgts []*target,
+	caches []map[string][]*cacheEntry,
+) (map[string][]byte, error) {
+	var archEntries [][]*cacheEntry
+	var b []byte
+	var common []*cacheEntry
+	var e error
+	var fn string
+	var merged *cacheEntry
+	var outputs map[string][]byte = map[string][]byte{}
+	var perArch []*cacheEntry
+	var seen map[string]bool
+	var t int
+	var tags string
+
+	sortEntries(caches[0][""]) // Global scope
+
+	if b, e = genConsts("", "", "", caches[0][""]); e != nil {
+		return nil, e
+	}
+
+	outputs["generated.go"] = b
+
+	for _, scope := range scopeNames(caches) {
+		archEntries = make([][]*cacheEntry, len(tgts))
+		common = nil
+		fn = "generated_" + strings.ToLower(scope)
+		seen = map[string]bool{}
+
+		for _, entry := range scopeEntries(scope, caches) {
+			// Headers may define the same name with and without
+			// the header as a prefix
+			if seen[constName(scope, entry.Go)] {
+				return nil, errors.Newf(
+					"duplicate constant %s in %s.h",
+					constName(scope, entry.Go),
+					scope,
+				)
+			}
+
+			seen[constName(scope, entry.Go)] = true
+
+			merged, perArch = mergeEntry(tgts, scope, entry, caches)
+			if perArch == nil {
+				common = append(common, merged)
+				continue
+			}
+
+			for i, a := range perArch {
+				archEntries[i] = append(archEntries[i], a)
+			}
 		}
 
-		_, _ = fmt.Fprintln(f, "}{")
+		if len(common) > 0 {
+			if b, e = genConsts(scope, "", "", common); e != nil {
+				return nil, e
+			}
 
-		// Create struct
-		for _, entry := range entries {
-			_, _ = fmt.Fprintf(f, "\t%s: %s,\n", entry.Go, entry.Val)
+			outputs[fn+".go"] = b
+		}
+
+		// Only generate per-arch files if something differs
+		if len(archEntries[0]) == 0 {
+			continue
+		}
+
+		for i, tgt := range tgts {
+			b, e = genConsts(scope, tgt.GOARCH, "", archEntries[i])
+			if e != nil {
+				return nil, e
+			}
+
+			outputs[fn+"_"+tgt.GOARCH+".go"] = b
 		}
 
-		_, _ = fmt.Fprintln(f, "}")
+		// Any other arch gets the values of the first target with
+		// the same pointer size
+		for _, bits := range []int{32, 64} {
+			if tags = otherArchs(tgts, bits); tags == "" {
+				continue
+			}
+
+			t = otherTarget(tgts, bits)
+			b, e = genConsts(
+				scope,
+				tags,
+				fmt.Sprintf(
+					"with the values of %s for any other %d-bit arch",
+					tgts[t].Name,
+					bits,
+				),
+				fitEntries(archEntries[t], bits),
+			)
+			if e != nil {
+				return nil, e
+			}
+
+			outputs[fmt.Sprintf("%s_other%d.go", fn, bits)] = b
+		}
 	}
 
-	return nil
+	return outputs, nil
 }
 
-func genHeader() (*os.File, error) {
+// genFile will generate the constants files for all targets.
+func genFile(
+	tgts []*target,
+	caches []map[string][]*cacheEntry,
+) error {
 	var e error
-	var f *os.File
-	var fn string = "generated.go"
+	var outputs map[string][]byte
 
-	// Open file to write
-	if f, e = os.Create(fn); e != nil {
-		return nil, errors.Newf("failed to create %s: %w", fn, e)
+	if outputs, e = genDefines(tgts, caches); e != nil {
+		return e
 	}
 
-	// Create header
-	_, _ = fmt.Fprintln(
-		f,
-		"// Code generated by tools/defines.go; DO NOT EDIT.",
-	)
-	_, _ = fmt.Fprintf(f, "package api\n\n")
+	for fn, b := range outputs {
+		if e = os.WriteFile(fn, b, 0o600); e != nil {
+			return errors.Newf("failed to write %s: %w", fn, e)
+		}
+	}
 
-	sort.Slice(
-		cache[""], // Global scope
-		func(i int, j int) bool {
-			var l string = strings.ToLower(cache[""][i].Go)
-			var r string = strings.ToLower(cache[""][j].Go)
+	return nil
+}
 
-			return l < r
-		},
-	)
+// genOthers will process the headers with wanted structs, funcs and
+// error codes, and generate everything other than the constants.
+func genOthers() error {
+	var e error
 
-	_, _ = fmt.Fprintln(f, "const (")
+	// Process headers with wanted structs or funcs, after all the
+	// #defines are known, as array lengths may need them
+	for _, header := range declHeaders() {
+		header = filepath.Join(*include, header)
 
-	for _, entry := range cache[""] {
-		_, _ = fmt.Fprintf(
-			f,
-			"\t%s %s = %s\n",
-			entry.Go,
-			entry.Type,
-			entry.Val,
+		if e = processFileDecls(header); e != nil {
+			return e
+		}
+	}
+
+	// Then process headers with error codes, sorted so the first
+	// name defined for a code is stable
+	for _, header := range errHeaderNames() {
+		e = processFileErrors(
+			filepath.Join(*include, header),
+			errHeaders[header],
 		)
+		if e != nil {
+			return e
+		}
+	}
+
+	// Enums need the cache in the order things were defined, which
+	// is kept until the constants are generated
+	if e = genEnumFile(); e != nil {
+		return e
+	}
+
+	if e = genErrorFile(); e != nil {
+		return e
 	}
 
-	_, _ = fmt.Fprintln(f, ")")
+	if e = genStructFiles(); e != nil {
+		return e
+	}
 
-	return f, nil
+	return genSyscallFile()
 }
 
 func ignoreType(fn string, line string, sep string) {
 	for _, t := range strings.Split(line, sep) {
 		t = strings.TrimSpace(t)
 		t = strings.TrimPrefix(t, "*")
-		skipRContains[fn] = append(skipRContains[fn], "len("+t+")")
+		skipRContains[fn] = append(skipRContains[fn], "sizeof("+t+")")
 		skipRStarts[fn] = append(skipRStarts[fn], t)
 	}
 }
 
-func init() {
-	flag.Parse()
-}
-
 // This is by no means perfect, but I try to grab as many constants as
 // possible.
 func main() {
-	// Find all the things to ignore/skip first (probably can remove
-	// later after implementing struct parsing)
-	for _, header := range headers {
-		header = "/usr/x86_64-w64-mingw32/include/" + header
+	var caches []map[string][]*cacheEntry
+	var e error
+	var tgts []*target
+
+	// Parse here, rather than in init(), so tests can use the flags
+	flag.Parse()
+
+	if tgts, e = selectedTargets(); e != nil {
+		fmt.Println(e.Error())
+		os.Exit(1)
+	}
+
+	if funcs, e = loadFuncs(funcsJSON); e != nil {
+		fmt.Println(e.Error())
+		os.Exit(1)
+	}
+
+	for _, header := range allHeaders() {
+		header = filepath.Join(*include, header)
 
 		if ok, e := pathname.DoesExist(header); e != nil {
 			fmt.Println(e.Error())
@@ -511,73 +689,211 @@ func main() {
 			fmt.Printf("file %s not found\n", header)
 			os.Exit(1)
 		}
+	}
 
-		if e := processFileSkips(header); e != nil {
+	// Process each target separately, as #defines may differ by arch
+	for i, t := range tgts {
+		if e = processDefines(t); e != nil {
 			panic(e)
 		}
-	}
 
-	// Then process each file for #defines and enums
-	for _, header := range headers {
-		header = "/usr/x86_64-w64-mingw32/include/" + header
+		cache = evalCache()
 
-		if e := processFileDefines(header); e != nil {
-			panic(e)
+		// Everything else only needs the #defines of the first target
+		if i == 0 {
+			if e = genOthers(); e != nil {
+				panic(e)
+			}
 		}
 
-		// TODO process structs too
+		caches = append(caches, cache)
+	}
 
-		if e := processFileTypedefs(header); e != nil {
-			panic(e)
-		}
+	if e = genFile(tgts, caches); e != nil {
+		panic(e)
+	}
+
+	if e = genExports(tgts, caches); e != nil {
+		panic(e)
 	}
 
-	if e := genFile(); e != nil {
+	if e = genLookupFile(tgts, caches); e != nil {
+		panic(e)
+	}
+
+	if e = writeReport(); e != nil {
 		panic(e)
 	}
 }
 
-func processDefine(fn string, line string) {
-	if !strings.HasPrefix(line, "#define") {
-		return
+// mergeEntry will compare an entry of the first target with the
+// other targets. If the value differs, the entries for each target
+// are also returned, typed for that target. Otherwise, values too
+// large for a 32-bit uintptr are made uint64.
+func mergeEntry(
+	tgts []*target,
+	scope string,
+	entry *cacheEntry,
+	caches []map[string][]*cacheEntry,
+) (*cacheEntry, []*cacheEntry) {
+	var all []*cacheEntry
+	var differs bool
+	var found *cacheEntry
+	var merged cacheEntry = *entry
+
+	for _, c := range caches {
+		found = nil
+
+		for _, other := range c[scope] {
+			if other.Go == entry.Go {
+				found = other
+				break
+			}
+		}
+
+		// Missing for some target, or the type differs, so keep the
+		// first target's value
+		if (found == nil) || (found.Type != entry.Type) {
+			all = []*cacheEntry{entry}
+			break
+		}
+
+		if found.Val != entry.Val {
+			differs = true
+		}
+
+		all = append(all, found)
+	}
+
+	for _, a := range all {
+		if (merged.Type == "uintptr") && !fits32(a.Val) {
+			merged.Type = "uint64"
+		}
+	}
+
+	if !differs {
+		return &merged, nil
+	}
+
+	for i, a := range all {
+		a = &cacheEntry{a.C, a.Go, entry.Type, a.Val}
+
+		// Each target's value fits its own uintptr, unless 32-bit
+		if (a.Type == "uintptr") && (tgts[i].Bits == 32) &&
+			!fits32(a.Val) {
+			a.Type = "uint64"
+		}
+
+		all[i] = a
 	}
 
-	// Remove define
-	line = strings.Replace(line, "#define ", "", 1)
+	return &merged, all
+}
 
-	// Remove comments
-	if reComment.MatchString(line) {
-		line = reComment.ReplaceAllString(line, "")
+// otherArchs will return a build constraint for any arch with the
+// provided pointer size that isn't a target, or an empty string if
+// there are none.
+func otherArchs(tgts []*target, bits int) string {
+	var skip []string
+	var tags []string
+
+	for _, t := range tgts {
+		skip = append(skip, t.GOARCH)
 	}
 
-	// Fix some Windows-isms
-	if reBitwisenot.MatchString(line) {
-		line = reBitwisenot.ReplaceAllString(line, "0xffffffff^")
+	if bits == 32 {
+		for _, goarch := range goarch32 {
+			if !slices.Contains(skip, goarch) {
+				tags = append(tags, goarch)
+			}
+		}
+
+		sort.Strings(tags)
+
+		return strings.Join(tags, " || ")
 	}
 
-	if reFixSizeOf.MatchString(line) {
-		line = reFixSizeOf.ReplaceAllString(line, "len(")
+	for _, goarch := range append(skip, goarch32...) {
+		if !slices.Contains(tags, "!"+goarch) {
+			tags = append(tags, "!"+goarch)
+		}
 	}
 
-	for _, r := range []*regexp.Regexp{
-		reFixHex,
-		reFixCast,
-		reFixMSABI,
-		reFixNum,
-	} {
-		if r.MatchString(line) {
-			line = r.ReplaceAllString(line, "$1")
+	sort.Strings(tags)
+
+	return strings.Join(tags, " && ")
+}
+
+// otherTarget will return the index of the first target with the
+// provided pointer size, or of the first target if there are none.
+func otherTarget(tgts []*target, bits int) int {
+	for i, t := range tgts {
+		if t.Bits == bits {
+			return i
 		}
 	}
 
+	return 0
+}
+
+func processDefine(fn string, line string) {
+	var k string
+	var v string
+
+	if !strings.HasPrefix(line, "#define ") {
+		return
+	}
+
+	// Remove define and comments
+	line = strings.TrimPrefix(line, "#define ")
+	line = strings.TrimSpace(reComment.ReplaceAllString(line, " "))
+
+	// Every macro may be referenced by another, even if skipped
+	defineMacro(line)
+
 	// Cut and attempt to skip things we don't want
-	if k, v, _ := strings.Cut(line, " "); !skip(fn, k, v) {
+	k, v, _ = strings.Cut(line, " ")
+	v = strings.ReplaceAll(v, "sizeof (", "sizeof(")
+
+	if !skip(fn, k, v) {
 		cacheVar(fn, k, v)
 	}
 }
 
+// processDefines will process all headers for #defines and enums,
+// for the provided target.
+func processDefines(t *target) error {
+	resetDefines(t)
+
+	// Find all the things to ignore/skip first (probably can remove
+	// later after implementing struct parsing)
+	for _, header := range allHeaders() {
+		header = filepath.Join(*include, header)
+
+		if e := processFileSkips(header); e != nil {
+			return e
+		}
+	}
+
+	// Then process each file for #defines and enums
+	for _, header := range allHeaders() {
+		header = filepath.Join(*include, header)
+
+		if e := processFileDefines(header); e != nil {
+			return e
+		}
+
+		if e := processFileTypedefs(header); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
 func processFileDefines(path string) error {
 	var b []byte
+	var conds condStack
 	var e error
 	var fn string = filepath.Base(path)
 	var tmp string
@@ -587,11 +903,6 @@ func processFileDefines(path string) error {
 	}
 
 	for _, line := range strings.Split(string(b), "\n") {
-		line = strings.ReplaceAll(
-			line,
-			"| INTERNET_FLAG_BGUPDATE",
-			"",
-		)
 		line = strings.TrimSpace(reSpaces.ReplaceAllString(line, " "))
 
 		if strings.HasSuffix(line, "\\") {
@@ -599,10 +910,14 @@ func processFileDefines(path string) error {
 			continue
 		}
 
-		processDefine(fn, strings.TrimSpace(tmp+line))
+		line = strings.TrimSpace(tmp + line)
 
 		// Reset
 		tmp = ""
+
+		if !conds.skip(line) {
+			processDefine(fn, line)
+		}
 	}
 
 	return nil
@@ -610,6 +925,7 @@ func processFileDefines(path string) error {
 
 func processFileSkips(path string) error {
 	var b []byte
+	var conds condStack
 	var e error
 	var fn string = filepath.Base(path)
 	var inStructOrTypedef bool
@@ -621,6 +937,10 @@ func processFileSkips(path string) error {
 	for _, line := range strings.Split(string(b), "\n") {
 		line = strings.TrimSpace(reSpaces.ReplaceAllString(line, " "))
 
+		if conds.skip(line) {
+			continue
+		}
+
 		switch {
 		case strings.HasPrefix(line, "#define"):
 			line = strings.TrimPrefix(line, "#define")
@@ -671,10 +991,13 @@ func processFileSkips(path string) error {
 
 func processFileTypedefs(path string) error {
 	var b []byte
+	var conds condStack
 	var e error
 	var fn string = filepath.Base(path)
-	var tmp string
 	var inTypedef bool
+	var members []*cacheEntry
+	var name string
+	var tmp string
 
 	if b, e = os.ReadFile(filepath.Clean(path)); e != nil {
 		return errors.Newf("failed to open %s: %w", path, e)
@@ -683,6 +1006,10 @@ func processFileTypedefs(path string) error {
 	for _, line := range strings.Split(string(b), "\n") {
 		line = strings.TrimSpace(reSpaces.ReplaceAllString(line, " "))
 
+		if conds.skip(line) {
+			continue
+		}
+
 		// Ignore non-defines unless typedef enum
 		switch {
 		case line == "{":
@@ -702,8 +1029,20 @@ func processFileTypedefs(path string) error {
 			}
 
 			inTypedef = false
+
+			// Extract type
+			name = strings.TrimSpace(strings.TrimPrefix(line, "}"))
+			name, _, _ = strings.Cut(name, ",")
+			name = strings.TrimSuffix(strings.TrimSpace(name), ";")
 		case strings.HasPrefix(line, "typedef enum"):
 			inTypedef = true
+			continue
+		case strings.HasPrefix(line, "typedef "):
+			// Simple aliases, for casts and sizeof
+			if !strings.ContainsAny(line, "({") {
+				addTypeAlias(line)
+			}
+
 			continue
 		default:
 			if inTypedef {
@@ -718,7 +1057,16 @@ func processFileTypedefs(path string) error {
 			tmp = reComment.ReplaceAllString(tmp, "")
 		}
 
-		processTypedef(fn, tmp)
+		members = processTypedef(fn, tmp)
+
+		// Keep members for generating enum types
+		if (name != "") && (len(members) > 0) {
+			if _, ok := enumBlocks[fn]; !ok {
+				enumBlocks[fn] = map[string][]*cacheEntry{}
+			}
+
+			enumBlocks[fn][name] = members
+		}
 
 		// Reset
 		tmp = ""
@@ -727,11 +1075,13 @@ func processFileTypedefs(path string) error {
 	return nil
 }
 
-func processTypedef(fn string, line string) {
-	var lhs string
-	var next string = "0"
-	var rhs string
-	var vals map[string]string = map[string]string{}
+// processTypedef will cache the members of a typedef enum. Members
+// without a value are one more than the previous member.
+func processTypedef(fn string, line string) []*cacheEntry {
+	var c string
+	var members []*cacheEntry
+	var prev string
+	var v string
 
 	for _, d := range strings.Split(line, ",") {
 		d = strings.TrimSpace(d)
@@ -740,112 +1090,114 @@ func processTypedef(fn string, line string) {
 			continue
 		}
 
-		if strings.Contains(d, "=") {
-			lhs, rhs, _ = strings.Cut(d, "=")
-
-			lhs = format(strings.TrimSpace(lhs))
-			rhs = format(strings.TrimSpace(rhs))
+		c, v, _ = strings.Cut(d, "=")
+		c = strings.TrimSpace(c)
+		v = strings.TrimSpace(v)
 
-			// Determine if next needs to be looked up from prev vals
-			next = rhs
-			if _, ok := vals[next]; ok {
-				next = vals[next]
-			}
-		} else {
-			lhs = format(d)
-			rhs = next
+		switch {
+		case v != "":
+		case prev == "":
+			v = "0"
+		default:
+			v = "(" + prev + " + 1)"
 		}
 
-		// Store in case we need to lookup
-		vals[lhs] = rhs
+		prev = c
 
-		// Prepare next enum value
-		if strings.HasPrefix(next, "0x") {
-			i, _ := strconv.ParseInt(next[2:], 16, 64)
-			next = fmt.Sprintf("0x%x", i+1)
-		} else {
-			i, _ := strconv.Atoi(next)
-			next = strconv.Itoa(i + 1)
+		// Members may be referenced like any other macro
+		if _, ok := cMacros[c]; !ok {
+			cMacros[c] = &cMacro{Body: v}
 		}
 
-		// Kkip things we don't want
-		if !skip(fn, lhs, rhs) {
-			cacheVar(fn, lhs, rhs)
+		// Skip things we don't want
+		if !skip(fn, format(c), v) {
+			cacheVar(fn, c, v)
+			members = append(
+				members,
+				&cacheEntry{C: c, Go: format(c)},
+			)
 		}
 	}
+
+	return members
 }
 
-func replaceVars() {
-	for scope, entries := range cache {
-		if scope == "" {
-			continue
+// resetDefines will reset anything built while processing headers,
+// before processing the provided target.
+func resetDefines(t *target) {
+	cache = map[string][]*cacheEntry{"": copyEntries(baseGlobals)}
+	cMacros = map[string]*cMacro{}
+	cTypeAliases = map[string]string{}
+	curTarget = t
+	enumBlocks = map[string]map[string][]*cacheEntry{}
+	skipRContains = copySkips(baseSkipRContains)
+	skipRStarts = copySkips(baseSkipRStarts)
+
+	// Globals and the macros predefined by the compiler
+	for _, entry := range baseGlobals {
+		defineMacro(entry.C + " " + entry.Val)
+	}
+
+	if t != nil {
+		for _, m := range t.Macros {
+			defineMacro(m + " 1")
 		}
+	}
 
-		// Sort C-style var names by longest to shortest
-		sort.Slice(
-			entries,
-			func(i int, j int) bool {
-				return len(entries[i].C) > len(entries[j].C)
-			},
-		)
+	defineMacro("__MSABI_LONG(x) x")
+}
 
-		// Replace C-style var names with actual values
-		for _, entry := range entries {
-			// Dumb hack for recursive lookups
-			for range 8 {
-				for _, repl := range entries {
-					entry.Val = strings.ReplaceAll(
-						entry.Val,
-						repl.C,
-						repl.Val,
-					)
-					entry.Val = strings.ReplaceAll(
-						entry.Val,
-						repl.Go,
-						repl.Val,
-					)
-				}
-			}
+// scopeEntries will return the entries of a scope for all targets,
+// sorted. Entries are from the first target that defines them.
+func scopeEntries(
+	scope string,
+	caches []map[string][]*cacheEntry,
+) []*cacheEntry {
+	var entries []*cacheEntry
+	var seen map[string]bool = map[string]bool{}
 
-			// Global-scoped vars
-			for _, repl := range cache[""] {
-				entry.Val = strings.ReplaceAll(
-					entry.Val,
-					repl.C,
-					repl.Go,
-				)
+	for _, c := range caches {
+		for _, entry := range c[scope] {
+			if !seen[entry.Go] {
+				entries = append(entries, entry)
+				seen[entry.Go] = true
 			}
+		}
+	}
 
-			// Other scopes via lookup table
-			for ref, repl := range lookup {
-				if !strings.HasPrefix(ref, scope) {
-					entry.Val = strings.ReplaceAll(
-						entry.Val,
-						repl.C,
-						ref,
-					)
-				}
-			}
+	sortEntries(entries)
 
-			for _, reUselessOp := range reUselessOpRepl {
-				if reUselessOp.MatchString(entry.Val) {
-					entry.Val = reUselessOp.ReplaceAllString(
-						entry.Val,
-						"$1",
-					)
-				}
-			}
+	return entries
+}
 
-			for _, reUselessOp := range reUselessOpRm {
-				if reUselessOp.MatchString(entry.Val) {
-					entry.Val = reUselessOp.ReplaceAllString(
-						entry.Val,
-						"",
-					)
-				}
+// scopeNames will return the scopes of all targets, other than the
+// global scope, sorted alphabetically and case-insensitive.
+func scopeNames(caches []map[string][]*cacheEntry) []string {
+	var scopes []string
+
+	for _, c := range caches {
+		for scope := range c {
+			if (scope != "") && !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
 			}
 		}
 	}
+
+	sort.Slice(
+		scopes,
+		func(i int, j int) bool {
+			var l string = strings.ToLower(scopes[i])
+			var r string = strings.ToLower(scopes[j])
+
+			if l == r {
+				return scopes[i] < scopes[j]
+			}
+
+			return l < r
+		},
+	)
+
+	return scopes
 }
 
 func skip(fn string, k string, v string) bool {
@@ -888,3 +1240,51 @@ func skip(fn string, k string, v string) bool {
 
 	return false
 }
+
+// sortEntries will sort entries alphabetically, case-insensitive.
+func sortEntries(entries []*cacheEntry) {
+	sort.Slice(
+		entries,
+		func(i int, j int) bool {
+			var l string = strings.ToLower(entries[i].Go)
+			var r string = strings.ToLower(entries[j].Go)
+
+			if l == r {
+				return entries[i].Go < entries[j].Go
+			}
+
+			return l < r
+		},
+	)
+}
+
+// writeReport will write the constants that could not be evaluated
+// to the file provided with -report.
+func writeReport() error {
+	var keys []string
+
+	for k := range unhandled {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	if len(keys) > 0 {
+		fmt.Printf("%d constants could not be evaluated\n", len(keys))
+	}
+
+	if *report == "" {
+		return nil
+	}
+
+	e := os.WriteFile(
+		*report,
+		[]byte(strings.Join(append(keys, ""), "\n")),
+		0o600,
+	)
+	if e != nil {
+		return errors.Newf("failed to write %s: %w", *report, e)
+	}
+
+	return nil
+}
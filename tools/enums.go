@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	gofmt "go/format"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// enumCfg describes how to generate an enum or flag type.
+type enumCfg struct {
+	Flags bool   // Values are bit flags
+	Go    string // Go type name
+}
+
+// enumType is an enum or flag type with its members.
+type enumType struct {
+	C       string        // Typedef enum name or #define prefix
+	Cfg     *enumCfg      // Generation config
+	Header  string        // Header it was parsed from
+	Members []*cacheEntry // Members in the order they were defined
+}
+
+var (
+	// Typedef enum members, indexed by header then typedef name
+	enumBlocks = map[string]map[string][]*cacheEntry{}
+	// Enum and flag types to generate, indexed by header then
+	// typedef enum name or #define prefix (ending in "_"). #defines
+	// belong to the longest matching prefix.
+	enums = map[string]map[string]*enumCfg{
+		"accctrl.h": {
+			"ACCESS_MODE":    {Go: "AccessMode"},
+			"SE_OBJECT_TYPE": {Go: "SeObjectType"},
+			"TRUSTEE_FORM":   {Go: "TrusteeForm"},
+			"TRUSTEE_TYPE":   {Go: "TrusteeType"},
+		},
+		"tlhelp32.h": {
+			"TH32CS_": {Flags: true, Go: "SnapshotFlags"},
+		},
+		"winhttp.h": {
+			"SECURITY_FLAG_": {
+				Flags: true,
+				Go:    "WinhttpSecurityFlags",
+			},
+			"WINHTTP_ACCESS_TYPE_": {Go: "WinhttpAccessType"},
+			"WINHTTP_CALLBACK_STATUS_": {
+				Flags: true,
+				Go:    "WinhttpCallbackStatus",
+			},
+			"WINHTTP_CALLBACK_STATUS_FLAG_": {
+				Flags: true,
+				Go:    "WinhttpCallbackStatusFlags",
+			},
+			"WINHTTP_FLAG_": {Flags: true, Go: "WinhttpFlags"},
+			"WINHTTP_FLAG_SECURE_PROTOCOL_": {
+				Flags: true,
+				Go:    "WinhttpSecureProtocols",
+			},
+			"WINHTTP_OPTION_": {Go: "WinhttpOption"},
+		},
+		"wininet.h": {
+			"INTERNET_FLAG_":      {Flags: true, Go: "InternetFlags"},
+			"INTERNET_OPEN_TYPE_": {Go: "InternetOpenType"},
+			"INTERNET_OPTION_":    {Go: "InternetOption"},
+			"SECURITY_FLAG_": {
+				Flags: true,
+				Go:    "InternetSecurityFlags",
+			},
+		},
+	}
+)
+
+// enumMembers will return the members of a typedef enum, or the
+// #defines that belong to a prefix.
+func enumMembers(
+	header string,
+	c string,
+	cfgs map[string]*enumCfg,
+) []*cacheEntry {
+	var members []*cacheEntry
+	var scope string = strings.TrimSuffix(header, ".h")
+	var types map[string]string = map[string]string{}
+
+	for _, entry := range cache[scope] {
+		types[entry.Go] = entry.Type
+	}
+
+	if !strings.HasSuffix(c, "_") {
+		for _, entry := range enumBlocks[header][c] {
+			if types[entry.Go] == "uintptr" {
+				members = append(members, entry)
+			}
+		}
+
+		return members
+	}
+
+	for _, entry := range cache[scope] {
+		if entry.Type != "uintptr" {
+			continue
+		}
+
+		if longestPrefix(entry.C, cfgs) == c {
+			members = append(members, entry)
+		}
+	}
+
+	return members
+}
+
+// genEnum will generate a Go type, with a lookup table and methods,
+// for an enum or flag type.
+func genEnum(w *bytes.Buffer, t *enumType) {
+	var kind string = "values"
+	var of string = t.C
+	var scope string = strings.TrimSuffix(t.Header, ".h")
+	var table string = lowerFirst(t.Cfg.Go) + "Table"
+
+	if t.Cfg.Flags {
+		kind = "flags"
+	}
+
+	if strings.HasSuffix(of, "_") {
+		of += "*"
+	}
+
+	_, _ = fmt.Fprintf(
+		w,
+		"\n%s\ntype %s uintptr\n\n",
+		wrapComment(
+			fmt.Sprintf(
+				"%s contains %s %s from %s",
+				t.Cfg.Go,
+				of,
+				kind,
+				t.Header,
+			),
+		),
+		t.Cfg.Go,
+	)
+
+	_, _ = fmt.Fprintf(
+		w,
+		"var %s *enumTable = newEnumTable(\n\t%q,\n\t%t,\n",
+		table,
+		t.Cfg.Go,
+		t.Cfg.Flags,
+	)
+	_, _ = fmt.Fprintln(w, "\t[]enumName{")
+
+	for _, m := range t.Members {
+		_, _ = fmt.Fprintf(
+			w,
+			"\t\t{%q, %s},\n",
+			m.C,
+			constName(scope, m.Go),
+		)
+	}
+
+	_, _ = fmt.Fprintf(w, "\t},\n)\n")
+
+	if t.Cfg.Flags {
+		_, _ = fmt.Fprint(w, "\n// Decode will return the names of ")
+		_, _ = fmt.Fprintln(w, "the flags set in v.")
+		_, _ = fmt.Fprintf(
+			w,
+			"func (v %s) Decode() []string {\n",
+			t.Cfg.Go,
+		)
+		_, _ = fmt.Fprintf(
+			w,
+			"\treturn %s.decode(uintptr(v))\n}\n",
+			table,
+		)
+	}
+
+	_, _ = fmt.Fprintf(w, "\n// String will return the name of v.\n")
+	_, _ = fmt.Fprintf(w, "func (v %s) String() string {\n", t.Cfg.Go)
+	_, _ = fmt.Fprintf(w, "\treturn %s.str(uintptr(v))\n}\n", table)
+}
+
+// genEnumFile will generate a file of enum and flag types.
+func genEnumFile() error {
+	var b []byte
+	var e error
+	var fn string = "generated_enums.go"
+
+	if b, e = genEnums(enums); e != nil {
+		return e
+	}
+
+	if e = os.WriteFile(fn, b, 0o600); e != nil {
+		return errors.Newf("failed to write %s: %w", fn, e)
+	}
+
+	return nil
+}
+
+// genEnums will generate Go source for the configured enum and flag
+// types.
+func genEnums(cfgs map[string]map[string]*enumCfg) ([]byte, error) {
+	var b []byte
+	var e error
+	var out bytes.Buffer
+	var types []*enumType
+
+	for header, wanted := range cfgs {
+		for c, cfg := range wanted {
+			types = append(
+				types,
+				&enumType{
+					C:       c,
+					Cfg:     cfg,
+					Header:  header,
+					Members: enumMembers(header, c, wanted),
+				},
+			)
+
+			if len(types[len(types)-1].Members) == 0 {
+				return nil, errors.Newf(
+					"no members of %s found in %s",
+					c,
+					header,
+				)
+			}
+		}
+	}
+
+	// Sort alphabetically, case-insensitive
+	sort.Slice(
+		types,
+		func(i int, j int) bool {
+			var l string = strings.ToLower(types[i].Cfg.Go)
+			var r string = strings.ToLower(types[j].Cfg.Go)
+
+			return l < r
+		},
+	)
+
+	_, _ = fmt.Fprintln(
+		&out,
+		"// Code generated by tools/enums.go; DO NOT EDIT.",
+	)
+	_, _ = fmt.Fprintf(&out, "\npackage api\n")
+
+	for _, t := range types {
+		genEnum(&out, t)
+	}
+
+	if b, e = gofmt.Source(out.Bytes()); e != nil {
+		return nil, errors.Newf("failed to format enums: %w", e)
+	}
+
+	return b, nil
+}
+
+// longestPrefix will return the longest configured prefix of a
+// #define, if any.
+func longestPrefix(c string, cfgs map[string]*enumCfg) string {
+	var longest string
+
+	for prefix := range cfgs {
+		if !strings.HasSuffix(prefix, "_") {
+			continue
+		}
+
+		if !strings.HasPrefix(c, prefix) {
+			continue
+		}
+
+		if len(prefix) > len(longest) {
+			longest = prefix
+		}
+	}
+
+	return longest
+}
+
+// lowerFirst will lowercase the first letter of a Go name.
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
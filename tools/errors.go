@@ -0,0 +1,533 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	gofmt "go/format"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// errCfg describes which #defines in a header are error codes.
+// HRESULT and NTSTATUS codes are always found by their casts.
+type errCfg struct {
+	Prefixes []string // Only #defines with these prefixes, if any
+	Win32    bool     // Plain integers are Win32 error codes
+}
+
+// errDef is an error code parsed from a header.
+type errDef struct {
+	Code   uint32
+	Header string // Header it was parsed from
+	Kind   string // HRESULT, NTSTATUS or WIN32
+	Name   string // C-style name
+}
+
+var (
+	// Prefixes of the canonical names of each kind, which are
+	// preferred over other names for the same code, such as S_OK
+	// over SEC_E_OK
+	canonicalErrPrefixes = map[string][]string{
+		"HRESULT":  {"E_", "S_"},
+		"NTSTATUS": {"STATUS_"},
+		"WIN32":    {"ERROR_"},
+	}
+	// Headers with error codes
+	errHeaders = map[string]*errCfg{
+		"ntstatus.h": {},
+		"winerror.h": {Win32: true},
+		"winhttp.h": {
+			Prefixes: []string{"ERROR_WINHTTP_"},
+			Win32:    true,
+		},
+		"wininet.h": {
+			Prefixes: []string{
+				"ERROR_FTP_",
+				"ERROR_GOPHER_",
+				"ERROR_HTTP_",
+				"ERROR_INTERNET_",
+			},
+			Win32: true,
+		},
+	}
+	// Parsed error codes, in the order they were defined
+	errDefs []*errDef
+	// Parsed error codes, indexed by the last definition of a name
+	errNames = map[string]*errDef{}
+	// NTSTATUS codes that don't map to the Win32 error of the same
+	// name, per RtlNtStatusToDosError()
+	ntWin32 = map[string]string{
+		"STATUS_ACCESS_VIOLATION":       "ERROR_NOACCESS",
+		"STATUS_BUFFER_OVERFLOW":        "ERROR_MORE_DATA",
+		"STATUS_BUFFER_TOO_SMALL":       "ERROR_INSUFFICIENT_BUFFER",
+		"STATUS_CANNOT_DELETE":          "ERROR_ACCESS_DENIED",
+		"STATUS_CONFLICTING_ADDRESSES":  "ERROR_INVALID_ADDRESS",
+		"STATUS_DELETE_PENDING":         "ERROR_ACCESS_DENIED",
+		"STATUS_DIRECTORY_NOT_EMPTY":    "ERROR_DIR_NOT_EMPTY",
+		"STATUS_END_OF_FILE":            "ERROR_HANDLE_EOF",
+		"STATUS_FILE_IS_A_DIRECTORY":    "ERROR_ACCESS_DENIED",
+		"STATUS_INFO_LENGTH_MISMATCH":   "ERROR_BAD_LENGTH",
+		"STATUS_INSUFFICIENT_RESOURCES": "ERROR_NO_SYSTEM_RESOURCES",
+		"STATUS_INVALID_CID":            "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_DEVICE_REQUEST": "ERROR_INVALID_FUNCTION",
+		"STATUS_INVALID_INFO_CLASS":     "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_1":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_2":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_3":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_4":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_5":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_6":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_7":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_8":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_9":    "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_10":   "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_11":   "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_12":   "ERROR_INVALID_PARAMETER",
+		"STATUS_INVALID_PARAMETER_MIX":  "ERROR_INVALID_PARAMETER",
+		"STATUS_NOT_A_DIRECTORY":        "ERROR_DIRECTORY",
+		"STATUS_NOT_IMPLEMENTED":        "ERROR_INVALID_FUNCTION",
+		"STATUS_NO_MEMORY":              "ERROR_NOT_ENOUGH_MEMORY",
+		"STATUS_NO_SUCH_FILE":           "ERROR_FILE_NOT_FOUND",
+		"STATUS_OBJECT_NAME_COLLISION":  "ERROR_ALREADY_EXISTS",
+		"STATUS_OBJECT_NAME_INVALID":    "ERROR_INVALID_NAME",
+		"STATUS_OBJECT_NAME_NOT_FOUND":  "ERROR_FILE_NOT_FOUND",
+		"STATUS_OBJECT_PATH_INVALID":    "ERROR_BAD_PATHNAME",
+		"STATUS_OBJECT_PATH_NOT_FOUND":  "ERROR_PATH_NOT_FOUND",
+		"STATUS_OBJECT_PATH_SYNTAX_BAD": "ERROR_BAD_PATHNAME",
+		"STATUS_OBJECT_TYPE_MISMATCH":   "ERROR_INVALID_HANDLE",
+		"STATUS_PENDING":                "ERROR_IO_PENDING",
+		"STATUS_PROCESS_IS_TERMINATING": "ERROR_ACCESS_DENIED",
+		"STATUS_TIMEOUT":                "WAIT_TIMEOUT",
+		"STATUS_UNSUCCESSFUL":           "ERROR_GEN_FAILURE",
+	}
+	// Regular expressions
+	reErrCast = regexp.MustCompile(
+		`^\(\s*(DWORD|HRESULT|LONG|NTSTATUS|SCODE)\s*\)\s*(.+)$`,
+	)
+	reErrMacro = regexp.MustCompile(
+		`^(HRESULT_FROM_WIN32|_HRESULT_TYPEDEF_|__MSABI_LONG|` +
+			`_NDIS_ERROR_TYPEDEF_)\s*\((.+)\)$`,
+	)
+)
+
+// errHeaderNames will return the headers with error codes, sorted.
+func errHeaderNames() []string {
+	var headers []string
+
+	for header := range errHeaders {
+		headers = append(headers, header)
+	}
+
+	sort.Strings(headers)
+
+	return headers
+}
+
+// errKind will return the kind of error code for a cast or macro.
+func errKind(castOrMacro string) string {
+	switch castOrMacro {
+	case "_HRESULT_TYPEDEF_", "_NDIS_ERROR_TYPEDEF_":
+		return "HRESULT"
+	case "HRESULT", "SCODE":
+		return "HRESULT"
+	case "NTSTATUS":
+		return "NTSTATUS"
+	default:
+		return ""
+	}
+}
+
+// errRank will return 0 for canonical names of a kind, else 1.
+func errRank(def *errDef) int {
+	for _, prefix := range canonicalErrPrefixes[def.Kind] {
+		if strings.HasPrefix(def.Name, prefix) {
+			return 0
+		}
+	}
+
+	return 1
+}
+
+// errTable will return the parsed error codes of a kind, sorted by
+// code, then canonical names first, then in the order they were
+// defined. The first name for a code is used by Error().
+func errTable(kind string) []*errDef {
+	var seen map[string]bool = map[string]bool{}
+	var table []*errDef
+
+	for _, def := range errDefs {
+		if (def.Kind != kind) || seen[def.Name] {
+			continue
+		}
+
+		seen[def.Name] = true
+		table = append(table, def)
+	}
+
+	sort.SliceStable(
+		table,
+		func(i int, j int) bool {
+			if table[i].Code != table[j].Code {
+				return table[i].Code < table[j].Code
+			}
+
+			return errRank(table[i]) < errRank(table[j])
+		},
+	)
+
+	return table
+}
+
+// evalErr will evaluate the value of an error code #define. The
+// kind is empty if unknown from casts or macros.
+func evalErr(v string) (string, uint32, error) {
+	var code uint32
+	var e error
+	var kind string
+	var m []string
+	var val *cValue
+
+	v = strings.TrimSpace(v)
+
+	// Strip parens, casts and macros
+	for {
+		switch {
+		case strings.HasPrefix(v, "(") && isWrapped(v):
+			v = strings.TrimSpace(v[1 : len(v)-1])
+			continue
+		case reErrCast.MatchString(v):
+			m = reErrCast.FindStringSubmatch(v)
+		case reErrMacro.MatchString(v):
+			m = reErrMacro.FindStringSubmatch(v)
+		default:
+			m = nil
+		}
+
+		if m == nil {
+			break
+		}
+
+		if m[1] == "HRESULT_FROM_WIN32" {
+			if _, code, e = evalErr(m[2]); e != nil {
+				return "", 0, e
+			}
+
+			if code != 0 {
+				//nolint:mnd // SEVERITY_ERROR and FACILITY_WIN32
+				code = (code & 0xffff) | 0x80070000
+			}
+
+			return "HRESULT", code, nil
+		}
+
+		if k := errKind(m[1]); k != "" {
+			kind = k
+		}
+
+		v = strings.TrimSpace(m[2])
+	}
+
+	// Aliases of previous error codes
+	if def, ok := errNames[v]; ok {
+		if kind == "" {
+			kind = def.Kind
+		}
+
+		return kind, def.Code, nil
+	}
+
+	// Numbers, or expressions such as (INTERNET_ERROR_BASE + 1)
+	if val, e = evalC(v, ""); e != nil {
+		return "", 0, e
+	}
+
+	if (val.Kind != cInt) || (val.Int > math.MaxUint32) {
+		return "", 0, errors.Newf("unsupported value %s", v)
+	}
+
+	return kind, uint32(val.Int), nil
+}
+
+// genErrorFile will generate a file of error code tables.
+func genErrorFile() error {
+	var b []byte
+	var e error
+	var fn string = "generated_errors.go"
+
+	if b, e = genErrors(errHeaders); e != nil {
+		return e
+	}
+
+	if e = os.WriteFile(fn, b, 0o600); e != nil {
+		return errors.Newf("failed to write %s: %w", fn, e)
+	}
+
+	return nil
+}
+
+// genErrors will generate Go source for the error code tables.
+func genErrors(cfgs map[string]*errCfg) ([]byte, error) {
+	var b []byte
+	var e error
+	var headers []string
+	var hexFmt string = "\t{0x%08X, %q},\n"
+	var out bytes.Buffer
+
+	for header := range cfgs {
+		headers = append(headers, header)
+	}
+
+	sort.Strings(headers)
+
+	_, _ = fmt.Fprintln(
+		&out,
+		"// Code generated by tools/errors.go; DO NOT EDIT.",
+	)
+	_, _ = fmt.Fprintf(&out, "\npackage api\n")
+
+	for _, t := range []struct {
+		Desc string
+		Fmt  string
+		Kind string
+		Name string
+	}{
+		{"HRESULT", hexFmt, "HRESULT", "hresultCodes"},
+		{"NTSTATUS", hexFmt, "NTSTATUS", "ntstatusCodes"},
+		{"Win32 error", "\t{%d, %q},\n", "WIN32", "win32Codes"},
+	} {
+		_, _ = fmt.Fprintf(
+			&out,
+			"\n%s\nvar %s = []errCode{\n",
+			wrapComment(
+				fmt.Sprintf(
+					"%s contains %s codes from %s, sorted by code",
+					t.Name,
+					t.Desc,
+					strings.Join(headers, ", "),
+				),
+			),
+			t.Name,
+		)
+
+		for _, def := range errTable(t.Kind) {
+			_, _ = fmt.Fprintf(&out, t.Fmt, def.Code, def.Name)
+		}
+
+		_, _ = fmt.Fprintln(&out, "}")
+	}
+
+	if e = genStatusConsts(&out); e != nil {
+		return nil, e
+	}
+
+	_, _ = fmt.Fprintf(
+		&out,
+		"\n%s\nvar ntstatusWin32 = []errMapping{\n",
+		wrapComment(
+			"ntstatusWin32 maps NTSTATUS codes to Win32 error "+
+				"codes by name, or by known exceptions, sorted by "+
+				"NTSTATUS code",
+		),
+	)
+
+	for _, m := range ntstatusMappings() {
+		_, _ = fmt.Fprintf(&out, "\t{0x%08X, %d},\n", m[0], m[1])
+	}
+
+	_, _ = fmt.Fprintln(&out, "}")
+
+	if b, e = gofmt.Source(out.Bytes()); e != nil {
+		return nil, errors.Newf("failed to format errors: %w", e)
+	}
+
+	return b, nil
+}
+
+// genStatusConsts will generate typed constants for NTSTATUS codes,
+// so returned errors can be compared with errors.Is().
+func genStatusConsts(out *bytes.Buffer) error {
+	var name string
+	var seen map[string]string = map[string]string{}
+
+	_, _ = fmt.Fprintf(
+		out,
+		"\n%s\nconst (\n",
+		wrapComment(
+			"NTSTATUS codes, sorted by code, which can be compared "+
+				"with errors.Is()",
+		),
+	)
+
+	for _, def := range errTable("NTSTATUS") {
+		name = format(def.Name)
+
+		if c, ok := seen[name]; ok {
+			return errors.Newf(
+				"%s and %s are both %s",
+				c,
+				def.Name,
+				name,
+			)
+		}
+
+		seen[name] = def.Name
+
+		_, _ = fmt.Fprintf(
+			out,
+			"\t%s NTStatus = 0x%08X\n",
+			name,
+			def.Code,
+		)
+	}
+
+	_, _ = fmt.Fprintln(out, ")")
+
+	return nil
+}
+
+// isWrapped will return true if s is entirely wrapped in parens.
+func isWrapped(s string) bool {
+	return matchingParen(s) == len(s)-1
+}
+
+// matchingParen will return the index of the paren closing the
+// first paren in s, or -1.
+func matchingParen(s string) int {
+	var depth int
+
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// ntstatusMappings will return NTSTATUS to Win32 error code pairs,
+// sorted by NTSTATUS code.
+func ntstatusMappings() [][2]uint32 {
+	var mapped map[uint32]bool = map[uint32]bool{}
+	var out [][2]uint32
+	var win32 map[string]uint32 = map[string]uint32{}
+	var want string
+
+	for _, def := range errTable("WIN32") {
+		if _, ok := win32[def.Name]; !ok {
+			win32[def.Name] = def.Code
+		}
+	}
+
+	for _, def := range errTable("NTSTATUS") {
+		if mapped[def.Code] {
+			continue
+		}
+
+		if want = ntWin32[def.Name]; want == "" {
+			want = "ERROR_" + strings.TrimPrefix(def.Name, "STATUS_")
+		}
+
+		if code, ok := win32[want]; ok {
+			mapped[def.Code] = true
+			out = append(out, [2]uint32{def.Code, code})
+		}
+	}
+
+	return out
+}
+
+// processErrorDefine will parse a #define, if it is an error code.
+func processErrorDefine(fn string, cfg *errCfg, line string) {
+	var code uint32
+	var e error
+	var kind string
+	var k string
+	var v string
+
+	line = strings.TrimPrefix(line, "#define ")
+	line = reBlockComment.ReplaceAllString(line, "")
+	line = reLineComment.ReplaceAllString(line, "")
+	k, v, _ = strings.Cut(strings.TrimSpace(line), " ")
+
+	if (v == "") || !isIdent(k) || skipError(k, cfg) {
+		return
+	}
+
+	if kind, code, e = evalErr(v); e != nil {
+		return
+	}
+
+	if kind == "" {
+		//nolint:mnd // Win32 error codes are 16 bits
+		if !cfg.Win32 || (code > 0xffff) {
+			return
+		}
+
+		kind = "WIN32"
+	}
+
+	errNames[k] = &errDef{
+		Code:   code,
+		Header: fn,
+		Kind:   kind,
+		Name:   k,
+	}
+	errDefs = append(errDefs, errNames[k])
+}
+
+// processFileErrors will parse the error codes in a header.
+// MessageText comments are ignored, as the messages come from the
+// system on Windows.
+func processFileErrors(path string, cfg *errCfg) error {
+	var b []byte
+	var e error
+	var fn string = filepath.Base(path)
+
+	if b, e = os.ReadFile(filepath.Clean(path)); e != nil {
+		return errors.Newf("failed to read %s: %w", path, e)
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(reSpaces.ReplaceAllString(line, " "))
+
+		if strings.HasPrefix(line, "#define ") {
+			processErrorDefine(fn, cfg, line)
+		}
+	}
+
+	return nil
+}
+
+// skipError will return true if a #define is never an error code,
+// or doesn't match the configured prefixes.
+func skipError(k string, cfg *errCfg) bool {
+	switch {
+	case strings.HasPrefix(k, "FACILITY_"),
+		strings.Contains(k, "SEVERITY"),
+		strings.HasSuffix(k, "_BASE"),
+		strings.HasSuffix(k, "_FIRST"),
+		strings.HasSuffix(k, "_LAST"):
+		return true
+	case len(cfg.Prefixes) == 0:
+		return false
+	}
+
+	for _, prefix := range cfg.Prefixes {
+		if strings.HasPrefix(k, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
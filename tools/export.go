@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	gofmt "go/format"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// exportEntry is a constant, as exported for tooling in other
+// languages and the lookup table. Values are the evaluated C values,
+// with integers in decimal and lists comma-separated. The arch is
+// only set if the value differs by arch.
+type exportEntry struct {
+	Arch   string `json:"arch,omitempty"`
+	C      string `json:"c"`
+	Go     string `json:"go"`
+	Header string `json:"header,omitempty"`
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+}
+
+var (
+	// Flags
+	csvFlag = flag.String(
+		"csv",
+		"",
+		"Export all constants as CSV to this file",
+	)
+	jsonFlag = flag.String(
+		"json",
+		"",
+		"Export all constants as JSON to this file",
+	)
+)
+
+// exportCSV will return the exported constants as CSV.
+func exportCSV(entries []*exportEntry) ([]byte, error) {
+	var header []string = []string{
+		"header", "c", "go", "type", "arch", "value",
+	}
+	var out bytes.Buffer
+	var w *csv.Writer = csv.NewWriter(&out)
+
+	_ = w.Write(header)
+
+	for _, entry := range entries {
+		_ = w.Write(
+			[]string{
+				entry.Header,
+				entry.C,
+				entry.Go,
+				entry.Type,
+				entry.Arch,
+				entry.Value,
+			},
+		)
+	}
+
+	w.Flush()
+
+	if e := w.Error(); e != nil {
+		return nil, errors.Newf("failed to export CSV: %w", e)
+	}
+
+	return out.Bytes(), nil
+}
+
+// exportEntries will return the constants of all targets, in the
+// same order as the generated files. Constants that differ by arch
+// have an entry per target.
+func exportEntries(
+	tgts []*target,
+	caches []map[string][]*cacheEntry,
+) ([]*exportEntry, error) {
+	var e error
+	var entries []*exportEntry
+	var ex *exportEntry
+	var merged *cacheEntry
+	var perArch []*cacheEntry
+
+	sortEntries(caches[0][""]) // Global scope
+
+	for _, entry := range caches[0][""] {
+		if ex, e = newExportEntry("", entry, entry.Type); e != nil {
+			return nil, e
+		}
+
+		entries = append(entries, ex)
+	}
+
+	for _, scope := range scopeNames(caches) {
+		for _, entry := range scopeEntries(scope, caches) {
+			merged, perArch = mergeEntry(tgts, scope, entry, caches)
+			if perArch == nil {
+				perArch = []*cacheEntry{merged}
+			}
+
+			for i, a := range perArch {
+				ex, e = newExportEntry(scope, a, a.Type)
+				if e != nil {
+					return nil, e
+				}
+
+				if len(perArch) > 1 {
+					ex.Arch = tgts[i].GOARCH
+				}
+
+				entries = append(entries, ex)
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// exportJSON will return the exported constants as JSON.
+func exportJSON(entries []*exportEntry) ([]byte, error) {
+	var b []byte
+	var e error
+
+	if b, e = json.MarshalIndent(entries, "", "  "); e != nil {
+		return nil, errors.Newf("failed to export JSON: %w", e)
+	}
+
+	return append(b, '\n'), nil
+}
+
+// exportValue will convert a generated Go value back to the value
+// of the C constant.
+func exportValue(typ string, val string) (string, error) {
+	var e error
+	var elems []string
+	var n int64
+	var u uint64
+
+	switch typ {
+	case "[]uintptr":
+		val = strings.TrimPrefix(val, "[]uintptr{")
+		val = strings.TrimSuffix(val, "}")
+
+		for elem := range strings.SplitSeq(val, ",") {
+			if elem, e = exportValue("uintptr", elem); e != nil {
+				return "", e
+			}
+
+			elems = append(elems, elem)
+		}
+
+		return strings.Join(elems, ","), nil
+	case "float64":
+		return val, nil
+	case "int":
+		n, e = strconv.ParseInt(strings.TrimSpace(val), 0, 64)
+		if e != nil {
+			return "", errors.Newf("invalid value %s: %w", val, e)
+		}
+
+		return strconv.FormatInt(n, 10), nil
+	case "string":
+		return strconv.Unquote(val)
+	}
+
+	u, e = strconv.ParseUint(strings.TrimSpace(val), 0, 64)
+	if e != nil {
+		return "", errors.Newf("invalid value %s: %w", val, e)
+	}
+
+	return strconv.FormatUint(u, 10), nil
+}
+
+// genExports will export all constants to the files provided with
+// -csv and -json, if any.
+func genExports(
+	tgts []*target,
+	caches []map[string][]*cacheEntry,
+) error {
+	var b []byte
+	var e error
+	var entries []*exportEntry
+
+	if (*csvFlag == "") && (*jsonFlag == "") {
+		return nil
+	}
+
+	if entries, e = exportEntries(tgts, caches); e != nil {
+		return e
+	}
+
+	if *csvFlag != "" {
+		if b, e = exportCSV(entries); e != nil {
+			return e
+		}
+
+		if e = os.WriteFile(*csvFlag, b, 0o600); e != nil {
+			return errors.Newf("failed to write %s: %w", *csvFlag, e)
+		}
+	}
+
+	if *jsonFlag != "" {
+		if b, e = exportJSON(entries); e != nil {
+			return e
+		}
+
+		if e = os.WriteFile(*jsonFlag, b, 0o600); e != nil {
+			return errors.Newf("failed to write %s: %w", *jsonFlag, e)
+		}
+	}
+
+	return nil
+}
+
+// genLookup will generate Go source for a table of all constants,
+// which is used to look up names and values at runtime.
+func genLookup(entries []*exportEntry) ([]byte, error) {
+	var b []byte
+	var e error
+	var out bytes.Buffer
+
+	_, _ = fmt.Fprintln(
+		&out,
+		"// Code generated by tools/defines.go; DO NOT EDIT.",
+	)
+	_, _ = fmt.Fprintf(&out, "\npackage api\n\n")
+	_, _ = fmt.Fprintln(&out, "var constants = []Constant{")
+
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(
+			&out,
+			"\t{%q, %q, %q, %q, %q, %q},\n",
+			entry.Arch,
+			entry.C,
+			entry.Go,
+			entry.Header,
+			entry.Type,
+			entry.Value,
+		)
+	}
+
+	_, _ = fmt.Fprintln(&out, "}")
+
+	if b, e = gofmt.Source(out.Bytes()); e != nil {
+		return nil, errors.Newf("failed to format lookup: %w", e)
+	}
+
+	return b, nil
+}
+
+// genLookupFile will generate the lookup table of all constants.
+func genLookupFile(
+	tgts []*target,
+	caches []map[string][]*cacheEntry,
+) error {
+	var b []byte
+	var e error
+	var entries []*exportEntry
+	var fn string = "generated_lookup.go"
+
+	if entries, e = exportEntries(tgts, caches); e != nil {
+		return e
+	}
+
+	if b, e = genLookup(entries); e != nil {
+		return e
+	}
+
+	if e = os.WriteFile(fn, b, 0o600); e != nil {
+		return errors.Newf("failed to write %s: %w", fn, e)
+	}
+
+	return nil
+}
+
+// newExportEntry will create an export entry for a cached constant.
+func newExportEntry(
+	scope string,
+	entry *cacheEntry,
+	typ string,
+) (*exportEntry, error) {
+	var e error
+	var ex *exportEntry = &exportEntry{
+		C:    entry.C,
+		Go:   constName(scope, entry.Go),
+		Type: typ,
+	}
+
+	if scope != "" {
+		ex.Header = scope + ".h"
+	}
+
+	if ex.Value, e = exportValue(typ, entry.Val); e != nil {
+		return nil, errors.Newf("%s: %w", entry.C, e)
+	}
+
+	return ex, nil
+}
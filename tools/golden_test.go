@@ -0,0 +1,370 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var (
+	// Enum and flag types to generate for the golden files, indexed
+	// by header
+	goldenEnums = map[string]map[string]*enumCfg{
+		"enums.h": {
+			"COLOR":           {Go: "Color"},
+			"MODE_":           {Go: "Mode"},
+			"OPEN_FLAG_":      {Flags: true, Go: "OpenFlags"},
+			"OPEN_FLAG_SYNC_": {Flags: true, Go: "SyncFlags"},
+		},
+	}
+	// Headers with error codes for the golden files
+	goldenErrHeaders = map[string]*errCfg{
+		"errors.h": {Win32: true},
+	}
+	// Structs to generate for the golden files, indexed by header
+	goldenStructs = map[string]map[string]*structCfg{
+		"structs.h": {
+			"INTERNET_CACHE_ENTRY_INFOW": {
+				Go: "InternetCacheEntryInfo",
+				Fields: map[string]string{
+					"dwExemptDelta": "ExemptDelta",
+				},
+			},
+			"COPYFILE2_EXTENDED_PARAMETERS": {
+				Go: "CopyFile2ExtendedParameters",
+			},
+			"MIXED": {Go: "Mixed"},
+			"PROCESSENTRY32": {
+				Go: "ProcessEntry32",
+				Fields: map[string]string{
+					"dwSize":        "dwSize",
+					"th32ProcessID": "PID",
+				},
+			},
+			"WINHTTP_PROXY_INFO": {Go: "WinHTTPProxyInfo"},
+		},
+	}
+	// Flags
+	update = flag.Bool(
+		"update",
+		false,
+		"Update the golden files instead of checking them",
+	)
+)
+
+// compareGolden will compare output to a golden file in testdata, or
+// update the golden file if requested.
+func compareGolden(t *testing.T, fn string, out []byte) {
+	var b []byte
+	var e error
+
+	t.Helper()
+
+	fn = filepath.Join("testdata", fn)
+
+	if *update {
+		if e = os.WriteFile(fn, out, 0o600); e != nil {
+			t.Fatal(e)
+		}
+
+		return
+	}
+
+	if b, e = os.ReadFile(filepath.Clean(fn)); e != nil {
+		t.Fatal(e)
+	}
+
+	if !bytes.Equal(b, out) {
+		t.Errorf("%s is out of date:\n%s", fn, firstDiff(b, out))
+	}
+}
+
+func firstDiff(expected []byte, actual []byte) string {
+	var a []string = strings.Split(string(actual), "\n")
+	var x []string = strings.Split(string(expected), "\n")
+
+	for i := range max(len(a), len(x)) {
+		switch {
+		case i >= len(a):
+			return fmt.Sprintf("line %d: missing %q", i+1, x[i])
+		case i >= len(x):
+			return fmt.Sprintf("line %d: extra %q", i+1, a[i])
+		case a[i] != x[i]:
+			return fmt.Sprintf(
+				"line %d: expected %q, got %q",
+				i+1,
+				x[i],
+				a[i],
+			)
+		}
+	}
+
+	return ""
+}
+
+// genGoldenDefines will generate per-arch defines from testdata
+// fixtures for every target, indexed by golden file. Constants that
+// could not be evaluated are added to a report, if any.
+func genGoldenDefines(
+	t *testing.T,
+	name string,
+	fixtures ...string,
+) map[string][]byte {
+	var b []byte
+	var caches []map[string][]*cacheEntry
+	var defines map[string][]byte
+	var e error
+	var entries []*exportEntry
+	var keys []string
+	var outputs map[string][]byte = map[string][]byte{}
+
+	t.Helper()
+
+	unhandled = map[string]bool{}
+
+	for _, tgt := range targets {
+		resetDefines(tgt)
+
+		for _, fn := range fixtures {
+			fn = filepath.Join("testdata", fn)
+
+			if e = processFileSkips(fn); e != nil {
+				t.Fatal(e)
+			}
+		}
+
+		for _, fn := range fixtures {
+			fn = filepath.Join("testdata", fn)
+
+			if e = processFileDefines(fn); e != nil {
+				t.Fatal(e)
+			}
+
+			if e = processFileTypedefs(fn); e != nil {
+				t.Fatal(e)
+			}
+		}
+
+		caches = append(caches, evalCache())
+	}
+
+	if defines, e = genDefines(targets, caches); e != nil {
+		t.Fatal(e)
+	}
+
+	// Same name as the generated file, so generated_arch_386.go is
+	// arch_386.golden and the global constants are globals.golden
+	for fn, out := range defines {
+		fn = strings.TrimSuffix(fn, ".go")
+		fn = strings.TrimPrefix(fn, "generated")
+		fn = strings.TrimPrefix(fn, "_")
+
+		if fn == "" {
+			fn = "globals"
+		}
+
+		outputs[fn+".golden"] = out
+	}
+
+	if entries, e = exportEntries(targets, caches); e != nil {
+		t.Fatal(e)
+	}
+
+	if b, e = exportCSV(entries); e != nil {
+		t.Fatal(e)
+	}
+
+	outputs[name+"_csv.golden"] = b
+
+	if b, e = exportJSON(entries); e != nil {
+		t.Fatal(e)
+	}
+
+	outputs[name+"_json.golden"] = b
+
+	if b, e = genLookup(entries); e != nil {
+		t.Fatal(e)
+	}
+
+	outputs[name+"_lookup.golden"] = b
+
+	for k := range unhandled {
+		keys = append(keys, k)
+	}
+
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		keys = append(keys, "")
+		outputs[name+"_report.golden"] = []byte(
+			strings.Join(keys, "\n"),
+		)
+	}
+
+	return outputs
+}
+
+// loadGolden will parse the testdata fixtures for the enums, errors,
+// structs, and syscalls generators.
+func loadGolden(t *testing.T) {
+	var e error
+
+	t.Helper()
+
+	resetDefines(nil)
+
+	for _, fn := range []string{
+		"enums.h",
+		"errors.h",
+		"structs.h",
+		"syscalls.h",
+	} {
+		fn = filepath.Join("testdata", fn)
+
+		if e = processFileDefines(fn); e != nil {
+			t.Fatal(e)
+		}
+
+		if e = processFileTypedefs(fn); e != nil {
+			t.Fatal(e)
+		}
+
+		if e = processFileDecls(fn); e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	// Enums need the evaluated values
+	_ = evalCache()
+
+	for fn, cfg := range goldenErrHeaders {
+		fn = filepath.Join("testdata", fn)
+
+		if e = processFileErrors(fn, cfg); e != nil {
+			t.Fatal(e)
+		}
+	}
+}
+
+// TestGolden will fail if the generator output for the testdata
+// fixtures has drifted from the golden files. This runs on any OS, as
+// it doesn't need the mingw headers. Run with -args -update to update
+// them.
+func TestGolden(t *testing.T) {
+	loadGolden(t)
+
+	t.Run(
+		"enums",
+		func(t *testing.T) {
+			var b []byte
+			var e error
+
+			if b, e = genEnums(goldenEnums); e != nil {
+				t.Fatal(e)
+			}
+
+			compareGolden(t, "enums.golden", b)
+		},
+	)
+
+	t.Run(
+		"errors",
+		func(t *testing.T) {
+			var b []byte
+			var e error
+
+			if b, e = genErrors(goldenErrHeaders); e != nil {
+				t.Fatal(e)
+			}
+
+			compareGolden(t, "errors.golden", b)
+		},
+	)
+
+	t.Run(
+		"structs",
+		func(t *testing.T) {
+			for _, a := range archs {
+				var b []byte
+				var e error
+
+				if b, e = genStructs(a, goldenStructs); e != nil {
+					t.Fatal(e)
+				}
+
+				compareGolden(
+					t,
+					fmt.Sprintf("structs%d.golden", a.Bits),
+					b,
+				)
+			}
+		},
+	)
+
+	t.Run(
+		"syscalls",
+		func(t *testing.T) {
+			var b []byte
+			var e error
+			var funcs []*funcCfg
+
+			b, e = os.ReadFile(
+				filepath.Join("testdata", "syscalls.json"),
+			)
+			if e != nil {
+				t.Fatal(e)
+			}
+
+			if funcs, e = loadFuncs(b); e != nil {
+				t.Fatal(e)
+			}
+
+			if b, e = genSyscalls(funcs, goldenStructs); e != nil {
+				t.Fatal(e)
+			}
+
+			compareGolden(t, "syscalls.golden", b)
+		},
+	)
+
+	// Defines are processed per target, which resets the cache, so do
+	// them last. Macros may reference a header that is processed
+	// later.
+	t.Run(
+		"defines",
+		func(t *testing.T) {
+			for name, fixtures := range map[string][]string{
+				"arch":  {"arch.h"},
+				"cexpr": {"cexpr.h", "cexprbase.h"},
+			} {
+				t.Run(
+					name,
+					func(t *testing.T) {
+						var names []string
+						var outputs map[string][]byte
+
+						outputs = genGoldenDefines(
+							t,
+							name,
+							fixtures...,
+						)
+
+						for fn := range outputs {
+							names = append(names, fn)
+						}
+
+						sort.Strings(names)
+
+						for _, fn := range names {
+							compareGolden(t, fn, outputs[fn])
+						}
+					},
+				)
+			}
+		},
+	)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // Digest auth requires MD5
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	ntlmNegotiate uint32 = 1
+	ntlmChallenge uint32 = 2
+	ntlmAuth      uint32 = 3
+	ntlmTargInfo  uint32 = 0x00800000
+	ntlmVersion   uint32 = 0x02000000
+	realm         string = "httpserver"
+)
+
+var ntlmSignature []byte = []byte("NTLMSSP\x00")
+
+// basicHandler will require Basic auth with the configured
+// credentials, then echo the request.
+func basicHandler(w http.ResponseWriter, req *http.Request) {
+	var ok bool
+	var p string
+	var u string
+
+	if u, p, ok = req.BasicAuth(); ok && (u == user) && (p == pass) {
+		echoHandler(w, req)
+		return
+	}
+
+	w.Header().Set(
+		"WWW-Authenticate",
+		"Basic realm=\""+realm+"\", charset=\"UTF-8\"",
+	)
+	w.WriteHeader(http.StatusUnauthorized)
+}
+
+// digestHandler will require Digest auth (MD5, qop=auth) with the
+// configured credentials, then echo the request.
+func digestHandler(w http.ResponseWriter, req *http.Request) {
+	var auth string = req.Header.Get("Authorization")
+	var nonce []byte = make([]byte, 16) //nolint:mnd // 128 bits
+
+	if scheme, params, ok := strings.Cut(auth, " "); ok {
+		if strings.EqualFold(scheme, "Digest") {
+			if validDigest(req.Method, parseParams(params)) {
+				echoHandler(w, req)
+				return
+			}
+		}
+	}
+
+	_, _ = rand.Read(nonce)
+
+	w.Header().Set(
+		"WWW-Authenticate",
+		fmt.Sprintf(
+			"Digest realm=%q, qop=\"auth\", algorithm=MD5, nonce=%q",
+			realm,
+			hex.EncodeToString(nonce),
+		),
+	)
+	w.WriteHeader(http.StatusUnauthorized)
+}
+
+func md5Hex(parts ...string) string {
+	var sum [md5.Size]byte = md5.Sum( //nolint:gosec // Digest auth
+		[]byte(strings.Join(parts, ":")),
+	)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ntlmChallengeMsg will build an NTLM CHALLENGE_MESSAGE with a random
+// server challenge and minimal target info. The optional version
+// field is omitted.
+func ntlmChallengeMsg(flags uint32) []byte {
+	var challenge []byte = make([]byte, 8) //nolint:mnd // 64 bits
+	var info bytes.Buffer
+	var msg bytes.Buffer
+	var name []byte = utf16le(strings.ToUpper(realm))
+
+	_, _ = rand.Read(challenge)
+
+	flags = (flags | ntlmTargInfo) &^ ntlmVersion
+
+	// Target info: MsvAvNbDomainName, then MsvAvEOL
+	_ = binary.Write(&info, binary.LittleEndian, uint16(2))
+	_ = binary.Write(&info, binary.LittleEndian, uint16(len(name)))
+	info.Write(name)
+	_ = binary.Write(&info, binary.LittleEndian, uint32(0))
+
+	msg.Write(ntlmSignature)
+	_ = binary.Write(&msg, binary.LittleEndian, ntlmChallenge)
+
+	// Target name security buffer (len, max len, offset)
+	_ = binary.Write(&msg, binary.LittleEndian, uint16(len(name)))
+	_ = binary.Write(&msg, binary.LittleEndian, uint16(len(name)))
+	//nolint:mnd // Header size
+	_ = binary.Write(&msg, binary.LittleEndian, uint32(48))
+
+	_ = binary.Write(&msg, binary.LittleEndian, flags)
+	msg.Write(challenge)
+	msg.Write(make([]byte, 8)) //nolint:mnd // Reserved
+
+	// Target info security buffer (len, max len, offset)
+	_ = binary.Write(&msg, binary.LittleEndian, uint16(info.Len()))
+	_ = binary.Write(&msg, binary.LittleEndian, uint16(info.Len()))
+	_ = binary.Write(
+		&msg,
+		binary.LittleEndian,
+		uint32(48+len(name)), //nolint:mnd // Header size
+	)
+
+	msg.Write(name)
+	msg.Write(info.Bytes())
+
+	return msg.Bytes()
+}
+
+// ntlmHandler will perform an NTLM handshake and accept the
+// configured username, then echo the request. The NTLM response is
+// not verified, as only the client side of the handshake is being
+// tested.
+func ntlmHandler(w http.ResponseWriter, req *http.Request) {
+	var auth string = req.Header.Get("Authorization")
+	var e error
+	var msg []byte
+	var token string
+
+	for _, scheme := range []string{"NTLM ", "Negotiate "} {
+		if strings.HasPrefix(auth, scheme) {
+			token = strings.TrimPrefix(auth, scheme)
+		}
+	}
+
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", "NTLM")
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	msg, e = base64.StdEncoding.DecodeString(token)
+	if (e != nil) || (len(msg) < 16) || //nolint:mnd // Header size
+		!bytes.Equal(msg[:8], ntlmSignature) {
+		http.Error(w, "invalid NTLM message", http.StatusBadRequest)
+		return
+	}
+
+	switch binary.LittleEndian.Uint32(msg[8:]) {
+	case ntlmNegotiate:
+		w.Header().Set(
+			"WWW-Authenticate",
+			"NTLM "+base64.StdEncoding.EncodeToString(
+				ntlmChallengeMsg(
+					binary.LittleEndian.Uint32(msg[12:]),
+				),
+			),
+		)
+		w.WriteHeader(http.StatusUnauthorized)
+	case ntlmAuth:
+		if !strings.EqualFold(ntlmUser(msg), user) {
+			w.Header().Set("WWW-Authenticate", "NTLM")
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		echoHandler(w, req)
+	default:
+		http.Error(w, "unexpected NTLM msg", http.StatusBadRequest)
+	}
+}
+
+// ntlmUser will return the username from an NTLM
+// AUTHENTICATE_MESSAGE.
+func ntlmUser(msg []byte) string {
+	var b []byte
+	var flags uint32
+	var n int
+	var off int
+	var u16 []uint16
+
+	if len(msg) < 64 { //nolint:mnd // Header size
+		return ""
+	}
+
+	n = int(binary.LittleEndian.Uint16(msg[36:]))
+	off = int(binary.LittleEndian.Uint32(msg[40:]))
+	flags = binary.LittleEndian.Uint32(msg[60:])
+
+	if off+n > len(msg) {
+		return ""
+	}
+
+	b = msg[off : off+n]
+
+	// NTLMSSP_NEGOTIATE_UNICODE
+	if flags&1 == 0 {
+		return string(b)
+	}
+
+	for i := 0; i+1 < len(b); i += 2 {
+		u16 = append(u16, binary.LittleEndian.Uint16(b[i:]))
+	}
+
+	return string(utf16.Decode(u16))
+}
+
+// parseParams will parse comma-separated key=value pairs, as used by
+// the Digest scheme.
+func parseParams(s string) map[string]string {
+	var k string
+	var params map[string]string = map[string]string{}
+	var v string
+
+	for _, param := range strings.Split(s, ",") {
+		k, v, _ = strings.Cut(strings.TrimSpace(param), "=")
+		params[strings.ToLower(k)] = strings.Trim(v, "\"")
+	}
+
+	return params
+}
+
+func utf16le(s string) []byte {
+	var b []byte
+
+	for _, c := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, c)
+	}
+
+	return b
+}
+
+func validDigest(method string, params map[string]string) bool {
+	var expected string
+	var ha1 string
+	var ha2 string
+
+	if params["username"] != user {
+		return false
+	}
+
+	ha1 = md5Hex(user, params["realm"], pass)
+	ha2 = md5Hex(method, params["uri"])
+
+	if params["qop"] == "" {
+		expected = md5Hex(ha1, params["nonce"], ha2)
+	} else {
+		expected = md5Hex(
+			ha1,
+			params["nonce"],
+			params["nc"],
+			params["cnonce"],
+			params["qop"],
+			ha2,
+		)
+	}
+
+	return subtle.ConstantTimeCompare(
+		[]byte(expected),
+		[]byte(params["response"]),
+	) == 1
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mjwhitta/errors"
+)
+
+// pki is a throwaway CA along with a server and a client cert signed
+// by it.
+type pki struct {
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	client tls.Certificate
+	server tls.Certificate
+}
+
+func newCert(
+	tmpl *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	var cert *x509.Certificate
+	var der []byte
+	var e error
+	var key *ecdsa.PrivateKey
+	var serial *big.Int
+
+	key, e = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if e != nil {
+		return nil, nil, errors.Newf("failed to generate key: %w", e)
+	}
+
+	serial, e = rand.Int(rand.Reader, big.NewInt(1<<62)) //nolint:mnd
+	if e != nil {
+		e = errors.Newf("failed to generate serial: %w", e)
+		return nil, nil, e
+	}
+
+	tmpl.NotAfter = time.Now().Add(24 * time.Hour) //nolint:mnd // 1d
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.SerialNumber = serial
+
+	// Self-signed
+	if parent == nil {
+		parent = tmpl
+		parentKey = key
+	}
+
+	der, e = x509.CreateCertificate(
+		rand.Reader,
+		tmpl,
+		parent,
+		&key.PublicKey,
+		parentKey,
+	)
+	if e != nil {
+		return nil, nil, errors.Newf("failed to create cert: %w", e)
+	}
+
+	if cert, e = x509.ParseCertificate(der); e != nil {
+		return nil, nil, errors.Newf("failed to parse cert: %w", e)
+	}
+
+	return cert, key, nil
+}
+
+// newPKI will generate a CA, a server cert valid for localhost and
+// the local hostname, and a client cert for mTLS.
+func newPKI() (*pki, error) {
+	var cert *x509.Certificate
+	var e error
+	var host string
+	var key *ecdsa.PrivateKey
+	var p *pki = &pki{}
+
+	p.ca, p.caKey, e = newCert(
+		&x509.Certificate{
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+			KeyUsage: x509.KeyUsageCertSign |
+				x509.KeyUsageDigitalSignature,
+			Subject: pkix.Name{CommonName: "httpserver CA"},
+		},
+		nil,
+		nil,
+	)
+	if e != nil {
+		return nil, e
+	}
+
+	host, _ = os.Hostname()
+
+	cert, key, e = newCert(
+		&x509.Certificate{
+			DNSNames: []string{"localhost", host},
+			ExtKeyUsage: []x509.ExtKeyUsage{
+				x509.ExtKeyUsageServerAuth,
+			},
+			IPAddresses: []net.IP{
+				net.IPv4(127, 0, 0, 1), //nolint:mnd // Loopback
+				net.IPv6loopback,
+			},
+			KeyUsage: x509.KeyUsageDigitalSignature,
+			Subject:  pkix.Name{CommonName: "localhost"},
+		},
+		p.ca,
+		p.caKey,
+	)
+	if e != nil {
+		return nil, e
+	}
+
+	p.server = tls.Certificate{
+		Certificate: [][]byte{cert.Raw, p.ca.Raw},
+		Leaf:        cert,
+		PrivateKey:  key,
+	}
+
+	cert, key, e = newCert(
+		&x509.Certificate{
+			ExtKeyUsage: []x509.ExtKeyUsage{
+				x509.ExtKeyUsageClientAuth,
+			},
+			KeyUsage: x509.KeyUsageDigitalSignature,
+			Subject:  pkix.Name{CommonName: "httpserver client"},
+		},
+		p.ca,
+		p.caKey,
+	)
+	if e != nil {
+		return nil, e
+	}
+
+	p.client = tls.Certificate{
+		Certificate: [][]byte{cert.Raw, p.ca.Raw},
+		Leaf:        cert,
+		PrivateKey:  key,
+	}
+
+	return p, nil
+}
+
+// tlsConfig will return the server TLS config. Client certs signed
+// by the CA are required if mtls is true.
+func (p *pki) tlsConfig(mtls bool) *tls.Config {
+	var cfg *tls.Config = &tls.Config{
+		Certificates: []tls.Certificate{p.server},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if mtls {
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		cfg.ClientCAs = x509.NewCertPool()
+		cfg.ClientCAs.AddCert(p.ca)
+	}
+
+	return cfg
+}
+
+// write will save the CA cert, and the client cert and key, as PEM
+// files in the provided directory, so clients can trust the server
+// and authenticate with mTLS.
+func (p *pki) write(dir string) error {
+	var der []byte
+	var e error
+	var files map[string]*pem.Block
+
+	der, e = x509.MarshalPKCS8PrivateKey(p.client.PrivateKey)
+	if e != nil {
+		return errors.Newf("failed to marshal key: %w", e)
+	}
+
+	files = map[string]*pem.Block{
+		"ca.pem":         {Bytes: p.ca.Raw, Type: "CERTIFICATE"},
+		"client-key.pem": {Bytes: der, Type: "PRIVATE KEY"},
+		"client.pem": {
+			Bytes: p.client.Leaf.Raw,
+			Type:  "CERTIFICATE",
+		},
+	}
+
+	if e = os.MkdirAll(dir, 0o700); e != nil {
+		return errors.Newf("failed to create %s: %w", dir, e)
+	}
+
+	for name, block := range files {
+		e = os.WriteFile(
+			filepath.Join(dir, name),
+			pem.EncodeToMemory(block),
+			0o600,
+		)
+		if e != nil {
+			return errors.Newf("failed to write %s: %w", name, e)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/mjwhitta/win/winhttp"
+	inet "github.com/mjwhitta/win/wininet"
+)
+
+// client is the subset of the winhttp and wininet clients under
+// test.
+type client interface {
+	CloseIdleConnections()
+	Get(url string) (*http.Response, error)
+}
+
+const (
+	chunks   int = 256
+	requests int = 25
+	size     int = 64 * 1024
+	workers  int = 8
+)
+
+// get will GET the /echo scenario and assert on the echoed request.
+func get(c client, base string, worker int, i int) error {
+	var e error
+	var echo Echo
+	var res *http.Response
+	var uri string = fmt.Sprintf("/echo?worker=%d&i=%d", worker, i)
+
+	if res, e = c.Get(base + uri); e != nil {
+		return e
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: got status %s", uri, res.Status)
+	}
+
+	if e = json.NewDecoder(res.Body).Decode(&echo); e != nil {
+		return fmt.Errorf("%s: invalid echo: %w", uri, e)
+	}
+
+	if (echo.Method != http.MethodGet) || (echo.URL != uri) {
+		return fmt.Errorf(
+			"%s: got echo of %s %s",
+			uri,
+			echo.Method,
+			echo.URL,
+		)
+	}
+
+	return nil
+}
+
+func newClients(t testing.TB) map[string]client {
+	var e error
+	var h *winhttp.Client
+	var i *inet.Client
+	var jar http.CookieJar
+
+	if h, e = winhttp.NewClient(); e != nil {
+		t.Fatal(e)
+	}
+
+	if i, e = inet.NewClient(); e != nil {
+		t.Fatal(e)
+	}
+
+	// Shared jar to also exercise concurrent cookie handling
+	jar, _ = cookiejar.New(nil)
+	h.Jar = jar
+	i.Jar = jar
+
+	return map[string]client{"winhttp": h, "wininet": i}
+}
+
+// BenchmarkClients will measure reading large response bodies, which
+// is dominated by the read loop, so ReadData/ReadFile overhead shows
+// up in ns/op and allocs/op.
+func BenchmarkClients(b *testing.B) {
+	var srv *httptest.Server = httptest.NewServer(newMux())
+	var uri string
+
+	defer srv.Close()
+
+	uri = fmt.Sprintf(
+		"%s/chunked?n=%d&size=%d",
+		srv.URL,
+		chunks,
+		size,
+	)
+
+	for name, c := range newClients(b) {
+		b.Run(
+			name,
+			func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(chunks * size))
+
+				for b.Loop() {
+					var e error
+					var res *http.Response
+
+					if res, e = c.Get(uri); e != nil {
+						b.Fatal(e)
+					}
+
+					_, e = io.Copy(io.Discard, res.Body)
+					_ = res.Body.Close()
+
+					if e != nil {
+						b.Fatal(e)
+					}
+				}
+			},
+		)
+	}
+}
+
+// TestConcurrentClients will share each client across goroutines,
+// while periodically swapping sessions mid-flight. Run with -race.
+func TestConcurrentClients(t *testing.T) {
+	var srv *httptest.Server = httptest.NewServer(newMux())
+
+	defer srv.Close()
+
+	for name, c := range newClients(t) {
+		t.Run(
+			name,
+			func(t *testing.T) {
+				var wg sync.WaitGroup
+
+				for w := range workers {
+					wg.Add(1)
+
+					go func() {
+						defer wg.Done()
+
+						for i := range requests {
+							if i%10 == 0 { //nolint:mnd // Arbitrary
+								c.CloseIdleConnections()
+							}
+
+							if e := get(c, srv.URL, w, i); e != nil {
+								t.Error(e)
+								return
+							}
+						}
+					}()
+				}
+
+				wg.Wait()
+			},
+		)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package main
+
+import "github.com/mjwhitta/errors"
+
+const defaultClient string = "net"
+
+var clients []string = []string{"net"}
+
+// newClient will return the named client. Only the net client is
+// available on this OS.
+func newClient(name string) (doer, error) {
+	if name == "net" {
+		return newNetClient()
+	}
+
+	return nil, errors.Newf("unsupported client %s", name)
+}
@@ -0,0 +1,44 @@
+//go:build windows
+
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/mjwhitta/errors"
+	"github.com/mjwhitta/win/winhttp"
+	inet "github.com/mjwhitta/win/wininet"
+)
+
+const defaultClient string = "winhttp"
+
+var clients []string = []string{"net", "winhttp", "wininet"}
+
+// newClient will return the named client. Client certs are only
+// supported by the net client, so the winhttp and wininet clients
+// skip the mTLS check.
+func newClient(name string) (doer, error) {
+	if insecure {
+		if t, ok := http.DefaultTransport.(*http.Transport); ok {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{}
+			}
+
+			t.TLSClientConfig.InsecureSkipVerify = true
+		}
+	}
+
+	switch name {
+	case "net":
+		return newNetClient()
+	case "winhttp":
+		mtls = false
+		return winhttp.NewClient()
+	case "wininet":
+		mtls = false
+		return inet.NewClient()
+	}
+
+	return nil, errors.Newf("unsupported client %s", name)
+}
@@ -0,0 +1,822 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5" //nolint:gosec // Digest auth requires MD5
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mjwhitta/cli"
+	"github.com/mjwhitta/errors"
+	"github.com/mjwhitta/log"
+)
+
+// check is a single scenario and the assertions on its result.
+type check struct {
+	name string
+	run  func(c doer) error
+}
+
+// doer is implemented by net/http.Client and by the winhttp and
+// wininet clients.
+type doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// echo is the JSON returned by the /echo endpoint of the server.
+type echo struct {
+	Body   string      `json:"body"`
+	Header http.Header `json:"header"`
+	Method string      `json:"method"`
+	TLS    *struct {
+		ClientCerts []string `json:"clientCerts"`
+		Version     string   `json:"version"`
+	} `json:"tls"`
+	URL string `json:"url"`
+}
+
+// errSkip is returned by checks that don't apply to the client or
+// server configuration.
+var errSkip error = errors.New("skipped")
+
+var (
+	base     *url.URL
+	certs    string
+	client   string
+	insecure bool
+	mtls     bool
+	pass     string
+	user     string
+	verbose  bool
+)
+
+func init() {
+	var e error
+
+	cli.Align = true
+	cli.Banner = filepath.Base(os.Args[0]) + " [OPTIONS] <url>"
+
+	cli.Info(
+		"Run each httpserver scenario against the provided server",
+		"(e.g. http://localhost:8080) and assert on the results.",
+		"Exits non-zero if any scenario fails.",
+	)
+
+	cli.Flag(
+		&certs,
+		"certs",
+		"certs",
+		"Dir with the server's CA and client cert (default: certs).",
+	)
+	cli.Flag(
+		&client,
+		"c",
+		"client",
+		defaultClient,
+		"Client to test: "+strings.Join(clients, ", ")+" (default: "+
+			defaultClient+").",
+	)
+	cli.Flag(&insecure, "k", "insecure", false, "Skip TLS verify.")
+	cli.Flag(&mtls, "m", "mtls", false, "Server wants client certs.")
+	cli.Flag(
+		&pass,
+		"pass",
+		"pass",
+		"Password for auth scenarios (default: pass).",
+	)
+	cli.Flag(
+		&user,
+		"user",
+		"user",
+		"Username for auth scenarios (default: user).",
+	)
+	cli.Flag(&verbose, "v", "verbose", false, "Show skip reasons.")
+	cli.Parse()
+
+	if cli.NArg() != 1 {
+		cli.Usage(1)
+	}
+
+	base, e = url.Parse(strings.TrimSuffix(cli.Arg(0), "/"))
+	if e != nil {
+		log.ErrX(1, e.Error())
+	}
+}
+
+func checkAuthBasic(c doer) error {
+	var auth string
+	var e error
+	var req *http.Request
+	var res *http.Response
+
+	if res, e = get(c, "/auth/basic", nil); e != nil {
+		return e
+	}
+
+	_ = res.Body.Close()
+
+	if e = expectStatus(res, http.StatusUnauthorized); e != nil {
+		return e
+	}
+
+	auth = res.Header.Get("WWW-Authenticate")
+	if !strings.HasPrefix(auth, "Basic") {
+		return errors.New("missing Basic challenge")
+	}
+
+	req, e = newRequest(http.MethodGet, "/auth/basic", nil)
+	if e != nil {
+		return e
+	}
+
+	req.SetBasicAuth(user, pass)
+
+	_, e = doEcho(c, req)
+
+	return e
+}
+
+func checkAuthDigest(c doer) error {
+	var e error
+	var ha1 string
+	var ha2 string
+	var params map[string]string
+	var req *http.Request
+	var res *http.Response
+
+	if res, e = get(c, "/auth/digest", nil); e != nil {
+		return e
+	}
+
+	_ = res.Body.Close()
+
+	if e = expectStatus(res, http.StatusUnauthorized); e != nil {
+		return e
+	}
+
+	params = parseParams(
+		strings.TrimPrefix(
+			res.Header.Get("WWW-Authenticate"),
+			"Digest ",
+		),
+	)
+	if params["nonce"] == "" {
+		return errors.New("missing Digest challenge")
+	}
+
+	ha1 = md5Hex(user, params["realm"], pass)
+	ha2 = md5Hex(http.MethodGet, "/auth/digest")
+
+	req, e = newRequest(http.MethodGet, "/auth/digest", nil)
+	if e != nil {
+		return e
+	}
+
+	req.Header.Set(
+		"Authorization",
+		fmt.Sprintf(
+			"Digest username=%q, realm=%q, nonce=%q, uri=%q, "+
+				"qop=auth, nc=00000001, cnonce=%q, response=%q",
+			user,
+			params["realm"],
+			params["nonce"],
+			"/auth/digest",
+			"0a4f113b",
+			md5Hex(
+				ha1,
+				params["nonce"],
+				"00000001",
+				"0a4f113b",
+				"auth",
+				ha2,
+			),
+		),
+	)
+
+	_, e = doEcho(c, req)
+
+	return e
+}
+
+// checkAuthNTLM will perform the NTLM handshake by hand, unless the
+// client already handled it (e.g. with the logged-in user).
+func checkAuthNTLM(c doer) error {
+	var b []byte
+	var e error
+	var res *http.Response
+
+	if res, e = get(c, "/auth/ntlm", nil); e != nil {
+		return e
+	}
+
+	_ = res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	if e = expectStatus(res, http.StatusUnauthorized); e != nil {
+		return e
+	}
+
+	res, e = get(
+		c,
+		"/auth/ntlm",
+		http.Header{"Authorization": {"NTLM " + ntlmNegotiate()}},
+	)
+	if e != nil {
+		return e
+	}
+
+	_ = res.Body.Close()
+
+	if e = expectStatus(res, http.StatusUnauthorized); e != nil {
+		return e
+	}
+
+	b, e = base64.StdEncoding.DecodeString(
+		strings.TrimPrefix(
+			res.Header.Get("WWW-Authenticate"),
+			"NTLM ",
+		),
+	)
+	if (e != nil) || (len(b) < 12) || //nolint:mnd // Header size
+		(string(b[:8]) != "NTLMSSP\x00") ||
+		(binary.LittleEndian.Uint32(b[8:]) != 2) {
+		return errors.New("invalid NTLM challenge")
+	}
+
+	res, e = get(
+		c,
+		"/auth/ntlm",
+		http.Header{"Authorization": {"NTLM " + ntlmAuthenticate()}},
+	)
+	if e != nil {
+		return e
+	}
+
+	_ = res.Body.Close()
+
+	return expectStatus(res, http.StatusOK)
+}
+
+func checkChunked(c doer) error {
+	var b []byte
+	var e error
+	var res *http.Response
+
+	if res, e = get(c, "/chunked?n=4&size=1000", nil); e != nil {
+		return e
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if e = expectStatus(res, http.StatusOK); e != nil {
+		return e
+	}
+
+	if b, e = io.ReadAll(res.Body); e != nil {
+		return errors.Newf("failed to read body: %w", e)
+	}
+
+	if !bytes.Equal(b, bytes.Repeat(pattern(1000), 4)) {
+		return errors.Newf("unexpected body (%d bytes)", len(b))
+	}
+
+	return nil
+}
+
+func checkEcho(c doer) error {
+	var e error
+	var out *echo
+	var req *http.Request
+
+	req, e = newRequest(
+		http.MethodPost,
+		"/echo?a=b",
+		strings.NewReader("hello"),
+	)
+	if e != nil {
+		return e
+	}
+
+	req.Header.Set("X-Test", "driver")
+
+	if out, e = doEcho(c, req); e != nil {
+		return e
+	}
+
+	switch {
+	case out.Method != http.MethodPost:
+		return errors.Newf("unexpected method %s", out.Method)
+	case out.Body != "hello":
+		return errors.Newf("unexpected body %q", out.Body)
+	case out.Header.Get("X-Test") != "driver":
+		return errors.New("missing X-Test header")
+	case out.URL != "/echo?a=b":
+		return errors.Newf("unexpected URL %s", out.URL)
+	}
+
+	return nil
+}
+
+func checkGzip(c doer) error {
+	var b []byte
+	var body io.Reader
+	var e error
+	var res *http.Response
+
+	if res, e = get(c, "/gzip?size=4096", nil); e != nil {
+		return e
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if e = expectStatus(res, http.StatusOK); e != nil {
+		return e
+	}
+
+	body = res.Body
+
+	// Decode, unless the client already did
+	if res.Header.Get("Content-Encoding") == "gzip" {
+		if body, e = gzip.NewReader(res.Body); e != nil {
+			return errors.Newf("failed to decode body: %w", e)
+		}
+	}
+
+	if b, e = io.ReadAll(body); e != nil {
+		return errors.Newf("failed to read body: %w", e)
+	}
+
+	if !bytes.Equal(b, pattern(4096)) {
+		return errors.Newf("unexpected body (%d bytes)", len(b))
+	}
+
+	return nil
+}
+
+func checkRedirect(
+	code int,
+	method string,
+	abs bool,
+) func(c doer) error {
+	return func(c doer) error {
+		var body io.Reader
+		var e error
+		var out *echo
+		var path string = fmt.Sprintf("/redirect/%d/3", code)
+		var req *http.Request
+		var want echo = echo{Method: http.MethodGet}
+
+		if abs {
+			path += "?abs=true"
+		}
+
+		if method == http.MethodPost {
+			body = strings.NewReader("hello")
+
+			// Only 307 and 308 keep the method and body
+			switch code {
+			case http.StatusTemporaryRedirect,
+				http.StatusPermanentRedirect:
+				want = echo{Body: "hello", Method: method}
+			}
+		}
+
+		if req, e = newRequest(method, path, body); e != nil {
+			return e
+		}
+
+		if out, e = doEcho(c, req); e != nil {
+			return e
+		}
+
+		if !strings.HasPrefix(out.URL, "/echo") {
+			return errors.Newf("landed on %s", out.URL)
+		}
+
+		if (out.Method != want.Method) || (out.Body != want.Body) {
+			return errors.Newf(
+				"got %s %q, want %s %q",
+				out.Method,
+				out.Body,
+				want.Method,
+				want.Body,
+			)
+		}
+
+		return nil
+	}
+}
+
+func checkSlow(c doer) error {
+	var b []byte
+	var cancel context.CancelFunc
+	var ctx context.Context
+	var e error
+	var res *http.Response
+
+	ctx, cancel = context.WithTimeout(
+		context.Background(),
+		5*time.Second, //nolint:mnd // Much longer than the delays
+	)
+	defer cancel()
+
+	res, e = getWithContext(ctx, c, "/slow?delay=200ms&n=3", nil)
+	if e != nil {
+		return e
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if b, e = io.ReadAll(res.Body); e != nil {
+		return errors.Newf("failed to read body: %w", e)
+	}
+
+	if string(b) != "chunk 0\nchunk 1\nchunk 2\n" {
+		return errors.Newf("unexpected body %q", string(b))
+	}
+
+	return nil
+}
+
+func checkSlowTimeout(c doer) error {
+	return expectTimeout(c, "/slow?delay=2s&n=3")
+}
+
+func checkStallTimeout(c doer) error {
+	return expectTimeout(c, "/stall?delay=5s")
+}
+
+// checkTrailers will verify the declared trailers are present and,
+// if the client provides values, that they're correct.
+func checkTrailers(c doer) error {
+	var b []byte
+	var e error
+	var res *http.Response
+
+	if res, e = get(c, "/trailers", nil); e != nil {
+		return e
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if b, e = io.ReadAll(res.Body); e != nil {
+		return errors.Newf("failed to read body: %w", e)
+	}
+
+	if string(b) != "body with trailers" {
+		return errors.Newf("unexpected body %q", string(b))
+	}
+
+	if _, ok := res.Trailer["X-Checksum"]; !ok {
+		return errors.New("missing X-Checksum trailer")
+	}
+
+	switch res.Trailer.Get("X-Checksum") {
+	case "":
+		if client == "net" {
+			return errors.New("missing X-Checksum value")
+		}
+	case "abc123":
+	default:
+		return errors.Newf(
+			"unexpected X-Checksum %s",
+			res.Trailer.Get("X-Checksum"),
+		)
+	}
+
+	return nil
+}
+
+func checkTLS(c doer) error {
+	var e error
+	var out *echo
+	var req *http.Request
+
+	if base.Scheme != "https" {
+		return errSkip
+	}
+
+	if req, e = newRequest(http.MethodGet, "/echo", nil); e != nil {
+		return e
+	}
+
+	if out, e = doEcho(c, req); e != nil {
+		return e
+	}
+
+	switch {
+	case out.TLS == nil:
+		return errors.New("server saw no TLS")
+	case mtls && (len(out.TLS.ClientCerts) == 0):
+		return errors.New("server saw no client cert")
+	}
+
+	return nil
+}
+
+// checkUpload will verify a large upload with "Expect:
+// 100-continue" gets the server's early 413, rather than an error.
+func checkUpload(c doer) error {
+	var body []byte = pattern(1 << 20) //nolint:mnd // 1MB
+	var e error
+	var req *http.Request
+	var res *http.Response
+
+	req, e = newRequest(
+		http.MethodPost,
+		"/upload?max=1024",
+		bytes.NewReader(body),
+	)
+	if e != nil {
+		return e
+	}
+
+	req.Header.Set("Expect", "100-continue")
+
+	if res, e = c.Do(req); e != nil {
+		return e
+	}
+
+	_ = res.Body.Close()
+
+	return expectStatus(res, http.StatusRequestEntityTooLarge)
+}
+
+// doEcho will send the request and decode the echoed request.
+func doEcho(c doer, req *http.Request) (*echo, error) {
+	var e error
+	var out *echo = &echo{}
+	var res *http.Response
+
+	if res, e = c.Do(req); e != nil {
+		return nil, e
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if e = expectStatus(res, http.StatusOK); e != nil {
+		return nil, e
+	}
+
+	if e = json.NewDecoder(res.Body).Decode(out); e != nil {
+		return nil, errors.Newf("failed to decode echo: %w", e)
+	}
+
+	return out, nil
+}
+
+func expectStatus(res *http.Response, code int) error {
+	if res.StatusCode != code {
+		return errors.Newf("expected %d, got %s", code, res.Status)
+	}
+
+	return nil
+}
+
+// expectTimeout will return an error unless the request, or reading
+// its body, fails with a 1 second deadline.
+func expectTimeout(c doer, path string) error {
+	var cancel context.CancelFunc
+	var ctx context.Context
+	var e error
+	var res *http.Response
+
+	ctx, cancel = context.WithTimeout(
+		context.Background(),
+		time.Second,
+	)
+	defer cancel()
+
+	if res, e = getWithContext(ctx, c, path, nil); e != nil {
+		return nil
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if _, e = io.ReadAll(res.Body); e != nil {
+		return nil
+	}
+
+	return errors.New("expected timeout")
+}
+
+func get(
+	c doer,
+	path string,
+	hdrs http.Header,
+) (*http.Response, error) {
+	return getWithContext(context.Background(), c, path, hdrs)
+}
+
+func getWithContext(
+	ctx context.Context,
+	c doer,
+	path string,
+	hdrs http.Header,
+) (*http.Response, error) {
+	var e error
+	var req *http.Request
+
+	req, e = http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		base.String()+path,
+		nil,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	for k, v := range hdrs {
+		req.Header[k] = v
+	}
+
+	return c.Do(req)
+}
+
+func main() {
+	var c doer
+	var checks []check
+	var e error
+	var failed int
+
+	if c, e = newClient(client); e != nil {
+		log.ErrX(1, e.Error())
+	}
+
+	checks = []check{
+		{"auth/basic", checkAuthBasic},
+		{"auth/digest", checkAuthDigest},
+		{"auth/ntlm", checkAuthNTLM},
+		{"chunked", checkChunked},
+		{"echo", checkEcho},
+		{"gzip", checkGzip},
+		{"slow", checkSlow},
+		{"slow/timeout", checkSlowTimeout},
+		{"stall/timeout", checkStallTimeout},
+		{"tls", checkTLS},
+		{"trailers", checkTrailers},
+		{"upload/expect", checkUpload},
+	}
+
+	for _, code := range []int{301, 302, 307, 308} {
+		checks = append(
+			checks,
+			check{
+				fmt.Sprintf("redirect/%d/relative", code),
+				checkRedirect(code, http.MethodGet, false),
+			},
+			check{
+				fmt.Sprintf("redirect/%d/absolute", code),
+				checkRedirect(code, http.MethodGet, true),
+			},
+			check{
+				fmt.Sprintf("redirect/%d/post", code),
+				checkRedirect(code, http.MethodPost, false),
+			},
+		)
+	}
+
+	for _, chk := range checks {
+		switch e = chk.run(c); {
+		case e == nil:
+			log.Goodf("PASS %s", chk.name)
+		case goerrors.Is(e, errSkip):
+			if verbose {
+				log.Infof("SKIP %s", chk.name)
+			}
+		default:
+			failed++
+
+			log.Errf("FAIL %s: %s", chk.name, e)
+		}
+	}
+
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func md5Hex(parts ...string) string {
+	var sum [md5.Size]byte = md5.Sum( //nolint:gosec // Digest auth
+		[]byte(strings.Join(parts, ":")),
+	)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newRequest(
+	method string,
+	path string,
+	body io.Reader,
+) (*http.Request, error) {
+	var e error
+	var req *http.Request
+
+	req, e = http.NewRequest(method, base.String()+path, body)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	return req, nil
+}
+
+// ntlmAuthenticate will return a minimal NTLM AUTHENTICATE_MESSAGE
+// with empty responses, which is enough for the server to read the
+// username.
+func ntlmAuthenticate() string {
+	var b bytes.Buffer
+	var name []byte
+
+	for _, c := range user {
+		name = binary.LittleEndian.AppendUint16(name, uint16(c))
+	}
+
+	b.WriteString("NTLMSSP\x00")
+	_ = binary.Write(&b, binary.LittleEndian, uint32(3)) //nolint:mnd
+
+	// LM, NT, and domain security buffers (empty)
+	for range 3 {
+		_ = binary.Write(&b, binary.LittleEndian, uint64(64)<<32)
+	}
+
+	// User security buffer
+	_ = binary.Write(&b, binary.LittleEndian, uint16(len(name)))
+	_ = binary.Write(&b, binary.LittleEndian, uint16(len(name)))
+	_ = binary.Write(&b, binary.LittleEndian, uint32(64)) //nolint:mnd
+
+	// Workstation and session key security buffers (empty)
+	for range 2 {
+		_ = binary.Write(
+			&b,
+			binary.LittleEndian,
+			uint64(64+len(name))<<32, //nolint:mnd // Header size
+		)
+	}
+
+	// Flags: NTLMSSP_NEGOTIATE_UNICODE
+	_ = binary.Write(&b, binary.LittleEndian, uint32(1))
+
+	b.Write(name)
+
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}
+
+// ntlmNegotiate will return a minimal NTLM NEGOTIATE_MESSAGE.
+func ntlmNegotiate() string {
+	var b bytes.Buffer
+
+	b.WriteString("NTLMSSP\x00")
+	_ = binary.Write(&b, binary.LittleEndian, uint32(1))
+
+	// NTLMSSP_NEGOTIATE_UNICODE | NTLMSSP_NEGOTIATE_NTLM
+	_ = binary.Write(&b, binary.LittleEndian, uint32(0x201))
+
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}
+
+func parseParams(s string) map[string]string {
+	var k string
+	var params map[string]string = map[string]string{}
+	var v string
+
+	for _, param := range strings.Split(s, ",") {
+		k, v, _ = strings.Cut(strings.TrimSpace(param), "=")
+		params[strings.ToLower(k)] = strings.Trim(v, "\"")
+	}
+
+	return params
+}
+
+// pattern will return the same predictable data as the server.
+func pattern(size int) []byte {
+	var b []byte = make([]byte, size)
+
+	for i := range b {
+		b[i] = "0123456789abcdef"[i%16]
+	}
+
+	return b
+}
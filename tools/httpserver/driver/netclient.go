@@ -0,0 +1,53 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/mjwhitta/errors"
+)
+
+// newNetClient will return a net/http.Client, which trusts the
+// server's CA and presents the client cert, if they are found in the
+// certs dir.
+func newNetClient() (doer, error) {
+	var b []byte
+	var cert tls.Certificate
+	var e error
+	var t *http.Transport
+	var ok bool
+
+	if t, ok = http.DefaultTransport.(*http.Transport); !ok {
+		return nil, errors.New("unsupported default transport")
+	}
+
+	t = t.Clone()
+	t.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecure, //nolint:gosec // User choice
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	b, e = os.ReadFile(filepath.Join(certs, "ca.pem"))
+	if e == nil {
+		t.TLSClientConfig.RootCAs = x509.NewCertPool()
+		t.TLSClientConfig.RootCAs.AppendCertsFromPEM(b)
+	}
+
+	if mtls {
+		cert, e = tls.LoadX509KeyPair(
+			filepath.Join(certs, "client.pem"),
+			filepath.Join(certs, "client-key.pem"),
+		)
+		if e != nil {
+			e = errors.Newf("failed to load client cert: %w", e)
+			return nil, e
+		}
+
+		t.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &http.Client{Transport: t}, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"net/http/httptest"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// buildDriver will compile the driver into a temp dir and return its
+// path.
+func buildDriver(t *testing.T) string {
+	var b []byte
+	var e error
+	var gobin string
+	var out string = filepath.Join(t.TempDir(), "driver")
+
+	if gobin, e = exec.LookPath("go"); e != nil {
+		t.Skip("go not found")
+	}
+
+	if runtime.GOOS == "windows" {
+		out += ".exe"
+	}
+
+	b, e = exec.Command(gobin, "build", "-o", out, "./driver").
+		CombinedOutput()
+	if e != nil {
+		t.Fatalf("failed to build driver: %s\n%s", e, b)
+	}
+
+	return out
+}
+
+// TestDriver will run every driver scenario, with the net/http
+// client, against an in-process server over HTTP, TLS, and mTLS.
+func TestDriver(t *testing.T) {
+	var certs string = t.TempDir()
+	var driver string
+	var e error
+	var p *pki
+
+	if testing.Short() {
+		t.Skip("builds and runs the driver")
+	}
+
+	driver = buildDriver(t)
+
+	if p, e = newPKI(); e != nil {
+		t.Fatal(e)
+	}
+
+	if e = p.write(certs); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, test := range []struct {
+		name string
+		tls  bool
+		mtls bool
+	}{
+		{"http", false, false},
+		{"tls", true, false},
+		{"mtls", true, true},
+	} {
+		t.Run(
+			test.name,
+			func(t *testing.T) {
+				var args []string = []string{"-c", "net", "-v"}
+				var b []byte
+				var e error
+				var srv *httptest.Server
+
+				srv = httptest.NewUnstartedServer(newMux())
+
+				if test.tls {
+					srv.TLS = p.tlsConfig(test.mtls)
+					srv.StartTLS()
+
+					args = append(args, "--certs", certs)
+				} else {
+					srv.Start()
+				}
+				defer srv.Close()
+
+				if test.mtls {
+					args = append(args, "-m")
+				}
+
+				b, e = exec.Command(
+					driver,
+					append(args, srv.URL)...,
+				).CombinedOutput()
+				if e != nil {
+					t.Errorf("%s\n%s", e, b)
+				}
+			},
+		)
+	}
+}
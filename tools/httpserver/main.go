@@ -12,14 +12,56 @@ import (
 	"github.com/mjwhitta/log"
 )
 
-var port uint
+var (
+	certs  string
+	mtls   bool
+	pass   string
+	port   uint
+	useTLS bool
+	user   string
+)
 
 func init() {
 	cli.Align = true
 	cli.Banner = filepath.Base(os.Args[0]) + " [OPTIONS]"
 
-	cli.Info("Super simple HTTP listener.")
+	cli.Info(
+		"Super simple HTTP listener, with scenarios for testing the",
+		"winhttp and wininet clients.",
+	)
+
+	cli.SectionAligned(
+		"SCENARIOS",
+		"|",
+		"/auth/basic|Require Basic auth.\n",
+		"/auth/digest|Require Digest auth (MD5, qop=auth).\n",
+		"/auth/ntlm|Require an NTLM handshake (user is checked).\n",
+		"/chunked?n=4&size=1024|Chunked body.\n",
+		"/echo|Return the received request as JSON.\n",
+		"/gzip?size=1024|Gzip encoded body.\n",
+		"/path|Cookie test.\n",
+		"/path/to/login|Cookie test (302 to /path).\n",
+		"/redirect/{code}/{n}?abs=true|Redirect n times to /echo.\n",
+		"/slow?delay=1s&n=3|One chunk per delay.\n",
+		"/stall?delay=30s|No response until delay.\n",
+		"/trailers|Chunked body with trailers.\n",
+		"/upload?max=1024|413 if larger (for Expect: 100-continue).",
+	)
 
+	cli.Flag(
+		&certs,
+		"c",
+		"certs",
+		"certs",
+		"Write CA and client cert PEMs to this dir (default: certs).",
+	)
+	cli.Flag(&mtls, "m", "mtls", false, "Require client certs (TLS).")
+	cli.Flag(
+		&pass,
+		"pass",
+		"pass",
+		"Password for auth scenarios (default: pass).",
+	)
 	cli.Flag(
 		&port,
 		"p",
@@ -27,7 +69,13 @@ func init() {
 		8080, //nolint:mnd // Default non-privileged HTTP port
 		"Listen on specified port (default: 8080).",
 	)
-	cli.Parse()
+	cli.Flag(&useTLS, "t", "tls", false, "Use a self-signed cert.")
+	cli.Flag(
+		&user,
+		"user",
+		"user",
+		"Username for auth scenarios (default: user).",
+	)
 }
 
 func loginHandler(w http.ResponseWriter, req *http.Request) {
@@ -70,24 +118,40 @@ func loginHandler(w http.ResponseWriter, req *http.Request) {
 func main() {
 	var addr string
 	var e error
-	var mux *http.ServeMux
+	var p *pki
 	var server *http.Server
 
-	addr = fmt.Sprintf("0.0.0.0:%d", port)
+	// Parse here, rather than in init(), so tests can use newMux()
+	cli.Parse()
 
-	mux = http.NewServeMux()
-	mux.HandleFunc("/path", rootHandler)
-	mux.HandleFunc("/path/to/login", loginHandler)
+	addr = fmt.Sprintf("0.0.0.0:%d", port)
 
 	server = &http.Server{
 		Addr:              addr,
-		Handler:           mux,
+		Handler:           newMux(),
 		ReadHeaderTimeout: 10 * time.Second, //nolint:mnd // 10 secs
 	}
 
-	log.Infof("Listening on %s", addr)
+	if useTLS || mtls {
+		if p, e = newPKI(); e != nil {
+			panic(e)
+		}
+
+		if e = p.write(certs); e != nil {
+			panic(e)
+		}
+
+		server.TLSConfig = p.tlsConfig(mtls)
+
+		log.Infof("Wrote CA and client cert to %s", certs)
+		log.Infof("Listening on %s (TLS)", addr)
+
+		e = server.ListenAndServeTLS("", "")
+	} else {
+		log.Infof("Listening on %s", addr)
 
-	e = server.ListenAndServe()
+		e = server.ListenAndServe()
+	}
 
 	switch e {
 	case nil, http.ErrServerClosed:
@@ -96,6 +160,27 @@ func main() {
 	}
 }
 
+// newMux will return a mux with every scenario.
+func newMux() *http.ServeMux {
+	var mux *http.ServeMux = http.NewServeMux()
+
+	mux.HandleFunc("/auth/basic", basicHandler)
+	mux.HandleFunc("/auth/digest", digestHandler)
+	mux.HandleFunc("/auth/ntlm", ntlmHandler)
+	mux.HandleFunc("/chunked", chunkedHandler)
+	mux.HandleFunc("/echo", echoHandler)
+	mux.HandleFunc("/gzip", gzipHandler)
+	mux.HandleFunc("/path", rootHandler)
+	mux.HandleFunc("/path/to/login", loginHandler)
+	mux.HandleFunc("/redirect/{code}/{n}", redirectHandler)
+	mux.HandleFunc("/slow", slowHandler)
+	mux.HandleFunc("/stall", stallHandler)
+	mux.HandleFunc("/trailers", trailersHandler)
+	mux.HandleFunc("/upload", uploadHandler)
+
+	return mux
+}
+
 func rootHandler(w http.ResponseWriter, req *http.Request) {
 	var cookie *http.Cookie = &http.Cookie{
 		HttpOnly: true,
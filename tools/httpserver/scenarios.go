@@ -0,0 +1,274 @@
+package main
+
+import (
+	"compress/gzip"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Echo is the JSON returned by the /echo endpoint.
+type Echo struct {
+	Body       string      `json:"body"`
+	Header     http.Header `json:"header"`
+	Host       string      `json:"host"`
+	Method     string      `json:"method"`
+	Proto      string      `json:"proto"`
+	RemoteAddr string      `json:"remoteAddr"`
+	TLS        *EchoTLS    `json:"tls,omitempty"`
+	Trailer    http.Header `json:"trailer,omitempty"`
+	URL        string      `json:"url"`
+}
+
+// EchoTLS is the TLS state returned by the /echo endpoint.
+type EchoTLS struct {
+	ClientCerts []string `json:"clientCerts,omitempty"`
+	Version     string   `json:"version"`
+}
+
+// chunkedHandler will write n chunks of size bytes, flushing after
+// each one (e.g. /chunked?n=4&size=1024).
+func chunkedHandler(w http.ResponseWriter, req *http.Request) {
+	var chunk []byte
+	var n int = queryInt(req, "n", 4)          //nolint:mnd // Default
+	var size int = queryInt(req, "size", 1024) //nolint:mnd // Default
+
+	chunk = pattern(size)
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+
+	for range n {
+		_, _ = w.Write(chunk)
+
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}
+}
+
+// echoHandler will return the received request as JSON.
+func echoHandler(w http.ResponseWriter, req *http.Request) {
+	var b []byte
+	var e error
+	var echo *Echo
+
+	if b, e = io.ReadAll(req.Body); e != nil {
+		http.Error(w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	echo = &Echo{
+		Body:       string(b),
+		Header:     req.Header,
+		Host:       req.Host,
+		Method:     req.Method,
+		Proto:      req.Proto,
+		RemoteAddr: req.RemoteAddr,
+		Trailer:    req.Trailer,
+		URL:        req.URL.String(),
+	}
+
+	if req.TLS != nil {
+		echo.TLS = &EchoTLS{
+			Version: tls.VersionName(req.TLS.Version),
+		}
+
+		for _, cert := range req.TLS.PeerCertificates {
+			echo.TLS.ClientCerts = append(
+				echo.TLS.ClientCerts,
+				cert.Subject.String(),
+			)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	_ = json.NewEncoder(w).Encode(echo)
+}
+
+// gzipHandler will write a gzip encoded body of size bytes
+// (e.g. /gzip?size=1024).
+func gzipHandler(w http.ResponseWriter, req *http.Request) {
+	var gz *gzip.Writer
+	var size int = queryInt(req, "size", 1024) //nolint:mnd // Default
+
+	w.Header().Set("Content-Encoding", "gzip")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	gz = gzip.NewWriter(w)
+	_, _ = gz.Write(pattern(size))
+	_ = gz.Close()
+}
+
+// pattern will return size bytes of predictable data, so clients can
+// verify what they received.
+func pattern(size int) []byte {
+	var b []byte = make([]byte, size)
+
+	for i := range b {
+		b[i] = "0123456789abcdef"[i%16]
+	}
+
+	return b
+}
+
+func queryDuration(
+	req *http.Request,
+	key string,
+	fallback time.Duration,
+) time.Duration {
+	var d time.Duration
+	var e error
+
+	if d, e = time.ParseDuration(req.URL.Query().Get(key)); e == nil {
+		return d
+	}
+
+	return fallback
+}
+
+func queryInt(req *http.Request, key string, fallback int) int {
+	if n, e := strconv.Atoi(req.URL.Query().Get(key)); e == nil {
+		return n
+	}
+
+	return fallback
+}
+
+// redirectHandler will redirect n times with the provided status code
+// before landing on /echo (e.g. /redirect/307/3). Locations are
+// relative unless abs=true is provided.
+func redirectHandler(w http.ResponseWriter, req *http.Request) {
+	var abs bool = req.URL.Query().Get("abs") == "true"
+	var code int
+	var e error
+	var loc string
+	var n int
+
+	code, e = strconv.Atoi(req.PathValue("code"))
+	if (e != nil) || (code < 300) || (code > 399) {
+		http.Error(w, "invalid redirect code", http.StatusBadRequest)
+		return
+	}
+
+	n, e = strconv.Atoi(req.PathValue("n"))
+	if (e != nil) || (n < 0) {
+		http.Error(w, "invalid redirect count", http.StatusBadRequest)
+		return
+	}
+
+	loc = "/echo"
+	if n > 1 {
+		loc = fmt.Sprintf("/redirect/%d/%d", code, n-1)
+	}
+
+	if req.URL.RawQuery != "" {
+		loc += "?" + req.URL.RawQuery
+	}
+
+	if abs {
+		loc = scheme(req) + "://" + req.Host + loc
+	}
+
+	if n == 0 {
+		echoHandler(w, req)
+		return
+	}
+
+	w.Header().Set("Location", loc)
+	w.WriteHeader(code)
+}
+
+func scheme(req *http.Request) string {
+	if req.TLS != nil {
+		return "https"
+	}
+
+	return "http"
+}
+
+// slowHandler will send the headers immediately, then one chunk per
+// delay (e.g. /slow?delay=1s&n=3).
+func slowHandler(w http.ResponseWriter, req *http.Request) {
+	var delay time.Duration = queryDuration(req, "delay", time.Second)
+	var n int = queryInt(req, "n", 3) //nolint:mnd // Default
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+
+	for i := range n {
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-time.After(delay):
+		}
+
+		_, _ = fmt.Fprintf(w, "chunk %d\n", i)
+	}
+}
+
+// stallHandler will not respond at all until the delay has passed or
+// the client gives up (e.g. /stall?delay=30s).
+func stallHandler(w http.ResponseWriter, req *http.Request) {
+	var delay time.Duration = queryDuration(
+		req,
+		"delay",
+		30*time.Second, //nolint:mnd // Longer than most timeouts
+	)
+
+	select {
+	case <-req.Context().Done():
+		return
+	case <-time.After(delay):
+	}
+
+	_, _ = w.Write([]byte("Too late"))
+}
+
+// trailersHandler will write a chunked body followed by the
+// X-Checksum and X-Count trailers.
+func trailersHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Trailer", "X-Checksum, X-Count")
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = w.Write([]byte("body with trailers"))
+
+	w.Header().Set("X-Checksum", "abc123")
+	w.Header().Set("X-Count", "1")
+}
+
+// uploadHandler will reject bodies larger than max bytes without
+// reading them, so no 100 Continue is sent if the client expects one
+// (e.g. /upload?max=1024). Otherwise, it returns the body length.
+func uploadHandler(w http.ResponseWriter, req *http.Request) {
+	var e error
+	var limit int64 = int64(queryInt(req, "max", 1024)) //nolint:mnd
+	var n int64
+
+	if req.ContentLength > limit {
+		http.Error(
+			w,
+			http.StatusText(http.StatusRequestEntityTooLarge),
+			http.StatusRequestEntityTooLarge,
+		)
+
+		return
+	}
+
+	if n, e = io.Copy(io.Discard, req.Body); e != nil {
+		http.Error(w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%d", n)
+}
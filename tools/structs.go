@@ -0,0 +1,991 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	gofmt "go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// arch is a pointer size, which determines struct layout. The build
+// constraint is made from the targets with that pointer size.
+type arch struct {
+	Bits int // Pointer size in bits
+}
+
+// cField is a field of a C struct. If Union is not empty, the field
+// is an anonymous union of those fields.
+type cField struct {
+	C     string    // C-style field name
+	Count int       // Array length, or 0 if not an array
+	Ptr   bool      // Declared as a pointer
+	Type  string    // C type
+	Union []*cField // Union members
+}
+
+// cStruct is a typedef struct parsed from a header.
+type cStruct struct {
+	C      string    // C-style type name
+	Err    error     // Set if the struct could not be parsed
+	Fields []*cField // Fields in order
+	Header string    // Header it was parsed from
+}
+
+// cType is the Go equivalent of a C type. A size or alignment of 0
+// means pointer-sized.
+type cType struct {
+	Align int
+	Go    string
+	Size  int
+}
+
+// structCfg describes how to generate a C struct.
+type structCfg struct {
+	Fields map[string]string // Go field names, indexed by C name
+	Go     string            // Go type name
+}
+
+var (
+	// Type aliases from typedefs, indexed by new name
+	aliases = map[string]string{}
+	// Architectures to generate struct layouts for
+	archs = []*arch{
+		{Bits: 32},
+		{Bits: 64},
+	}
+	// Known C types
+	cTypes = map[string]*cType{
+		"ACCESS_MASK":        {4, "uint32", 4},
+		"ATOM":               {2, "uint16", 2},
+		"BOOL":               {4, "int32", 4},
+		"BOOLEAN":            {1, "uint8", 1},
+		"BYTE":               {1, "uint8", 1},
+		"CHAR":               {1, "uint8", 1},
+		"char":               {1, "uint8", 1},
+		"COLORREF":           {4, "uint32", 4},
+		"double":             {8, "float64", 8},
+		"DWORD":              {4, "uint32", 4},
+		"DWORD32":            {4, "uint32", 4},
+		"DWORD64":            {8, "uint64", 8},
+		"DWORDLONG":          {8, "uint64", 8},
+		"DWORD_PTR":          {0, "uintptr", 0},
+		"FILETIME":           {4, "windows.Filetime", 8},
+		"float":              {4, "float32", 4},
+		"FLOAT":              {4, "float32", 4},
+		"GUID":               {4, "windows.GUID", 16},
+		"HANDLE":             {0, "uintptr", 0},
+		"HRESULT":            {4, "int32", 4},
+		"INT":                {4, "int32", 4},
+		"int":                {4, "int32", 4},
+		"INT_PTR":            {0, "uintptr", 0},
+		"LANGID":             {2, "uint16", 2},
+		"LARGE_INTEGER":      {8, "int64", 8},
+		"LCID":               {4, "uint32", 4},
+		"LONG":               {4, "int32", 4},
+		"long":               {4, "int32", 4},
+		"LONG64":             {8, "int64", 8},
+		"LONGLONG":           {8, "int64", 8},
+		"long long":          {8, "int64", 8},
+		"LONG_PTR":           {0, "uintptr", 0},
+		"LPARAM":             {0, "uintptr", 0},
+		"LPCSTR":             {0, "*uint8", 0},
+		"LPCVOID":            {0, "uintptr", 0},
+		"LPCWSTR":            {0, "*uint16", 0},
+		"LPSTR":              {0, "*uint8", 0},
+		"LPVOID":             {0, "uintptr", 0},
+		"LPWSTR":             {0, "*uint16", 0},
+		"LRESULT":            {0, "uintptr", 0},
+		"NTSTATUS":           {4, "int32", 4},
+		"PCSTR":              {0, "*uint8", 0},
+		"PCWSTR":             {0, "*uint16", 0},
+		"PSTR":               {0, "*uint8", 0},
+		"PVOID":              {0, "uintptr", 0},
+		"PWSTR":              {0, "*uint16", 0},
+		"SHORT":              {2, "int16", 2},
+		"short":              {2, "int16", 2},
+		"SIZE_T":             {0, "uintptr", 0},
+		"SSIZE_T":            {0, "uintptr", 0},
+		"SYSTEMTIME":         {2, "windows.Systemtime", 16},
+		"UCHAR":              {1, "uint8", 1},
+		"UINT":               {4, "uint32", 4},
+		"UINT_PTR":           {0, "uintptr", 0},
+		"ULARGE_INTEGER":     {8, "uint64", 8},
+		"ULONG":              {4, "uint32", 4},
+		"ULONG64":            {8, "uint64", 8},
+		"ULONGLONG":          {8, "uint64", 8},
+		"ULONG_PTR":          {0, "uintptr", 0},
+		"unsigned char":      {1, "uint8", 1},
+		"unsigned int":       {4, "uint32", 4},
+		"unsigned long":      {4, "uint32", 4},
+		"unsigned long long": {8, "uint64", 8},
+		"unsigned short":     {2, "uint16", 2},
+		"USHORT":             {2, "uint16", 2},
+		"WCHAR":              {2, "uint16", 2},
+		"wchar_t":            {2, "uint16", 2},
+		"WINBOOL":            {4, "int32", 4},
+		"WORD":               {2, "uint16", 2},
+		"WPARAM":             {0, "uintptr", 0},
+	}
+	// Parsed structs, indexed by C name
+	parsed = map[string]*cStruct{}
+	// Pointer types from typedefs, to the type they point to. Seeded
+	// with those from minwindef.h and winnt.h.
+	pointers = map[string]string{
+		"LPBOOL":     "BOOL",
+		"LPBYTE":     "BYTE",
+		"LPDWORD":    "DWORD",
+		"LPHANDLE":   "HANDLE",
+		"LPINT":      "INT",
+		"LPLONG":     "LONG",
+		"LPWORD":     "WORD",
+		"PBOOL":      "BOOL",
+		"PBYTE":      "BYTE",
+		"PDWORD":     "DWORD",
+		"PHANDLE":    "HANDLE",
+		"PINT":       "INT",
+		"PLONG":      "LONG",
+		"PSIZE_T":    "SIZE_T",
+		"PUCHAR":     "UCHAR",
+		"PUINT":      "UINT",
+		"PULONG":     "ULONG",
+		"PULONG_PTR": "ULONG_PTR",
+		"PUSHORT":    "USHORT",
+		"PWORD":      "WORD",
+	}
+	// Regular expressions
+	reBlockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	reHandle       = regexp.MustCompile(`^H[A-Z0-9]+$`)
+	reHungarian    = regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z].*)$`)
+	reIdentifier   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	reLineComment  = regexp.MustCompile(`//[^\n]*`)
+	reSAL          = regexp.MustCompile(
+		`^(_[A-Z][A-Za-z_]*_|__(in|out|inout|reserved)(_\w+)?)$`,
+	)
+	reTokens = regexp.MustCompile(
+		`[A-Za-z_][A-Za-z0-9_]*|[0-9][0-9A-Fa-fXxLlUu]*|\S`,
+	)
+	// Structs to generate, indexed by header
+	structs = map[string]map[string]*structCfg{
+		"tlhelp32.h": {
+			"PROCESSENTRY32": {
+				Go: "ProcessEntry32",
+				Fields: map[string]string{
+					"cntThreads":          "ThreadCount",
+					"cntUsage":            "cntUsage",
+					"dwFlags":             "dwFlags",
+					"dwSize":              "dwSize",
+					"pcPriClassBase":      "PriorityClassBase",
+					"szExeFile":           "exeFile",
+					"th32DefaultHeapID":   "defaultHeapID",
+					"th32ModuleID":        "moduleID",
+					"th32ParentProcessID": "ParentPID",
+					"th32ProcessID":       "PID",
+				},
+			},
+		},
+		"winbase.h": {
+			"COPYFILE2_EXTENDED_PARAMETERS": {
+				Go: "CopyFile2ExtendedParameters",
+				Fields: map[string]string{
+					"dwSize":   "dwSize",
+					"pfCancel": "CancelPtr",
+				},
+			},
+		},
+		"winhttp.h": {
+			"WINHTTP_AUTOPROXY_OPTIONS": {
+				Go: "WinHTTPAutoProxyOptions",
+				Fields: map[string]string{
+					"dwReserved":  "dwReserved",
+					"lpvReserved": "reserved",
+				},
+			},
+			"WINHTTP_CURRENT_USER_IE_PROXY_CONFIG": {
+				Go: "WinHTTPCurrentUserIEProxyConfig",
+			},
+			"WINHTTP_PROXY_INFO": {Go: "WinHTTPProxyInfo"},
+		},
+		"wininet.h": {
+			"INTERNET_BUFFERSW": {
+				Go: "InternetBuffers",
+				Fields: map[string]string{
+					"dwStructSize": "dwStructSize",
+				},
+			},
+			"INTERNET_CACHE_ENTRY_INFOW": {
+				Go: "InternetCacheEntryInfo",
+				Fields: map[string]string{
+					"dwExemptDelta": "ExemptDelta",
+					"dwStructSize":  "StructSize",
+				},
+			},
+		},
+	}
+)
+
+func alignTo(off int, align int) int {
+	return (off + align - 1) / align * align
+}
+
+func bytesStr(n int) string {
+	if n == 1 {
+		return "1 byte"
+	}
+
+	return strconv.Itoa(n) + " bytes"
+}
+
+// cDecl will return the C type of a field, for comments.
+func cDecl(f *cField) string {
+	var decl string = f.Type
+
+	if f.Ptr {
+		decl += "*"
+	}
+
+	if f.Count > 0 {
+		decl += fmt.Sprintf("[%d]", f.Count)
+	}
+
+	return decl
+}
+
+// evalSize will evaluate an array length, which may reference
+// #defines from any processed header.
+func evalSize(toks []string) (int, error) {
+	var e error
+	var v *cValue
+
+	if v, e = evalC(strings.Join(toks, " "), ""); e != nil {
+		return 0, e
+	}
+
+	if (v.Kind != cInt) || v.negative() || (v.Int == 0) {
+		return 0, errors.Newf("invalid array length %v", toks)
+	}
+
+	return int(v.Int), nil
+}
+
+// fieldName will convert a C field name to a Go field name, by
+// stripping any Hungarian notation prefix.
+func fieldName(c string, cfg *structCfg) string {
+	if g, ok := cfg.Fields[c]; ok {
+		return g
+	}
+
+	if m := reHungarian.FindStringSubmatch(c); m != nil {
+		c = m[1]
+	}
+
+	c = strings.ToUpper(c[:1]) + c[1:]
+	c = strings.ReplaceAll(c, "Url", "URL")
+
+	return c
+}
+
+// genStruct will write a Go struct with explicit padding, so Go's
+// alignment rules (which differ from MSVC's on 32-bit, where 8-byte
+// types are 8-byte aligned) never come into play. It returns the size
+// of the struct.
+func genStruct(
+	w *bytes.Buffer,
+	a *arch,
+	s *cStruct,
+	cfgs map[string]map[string]*structCfg,
+) (int, error) {
+	var cfg *structCfg = cfgs[s.Header][s.C]
+	var e error
+	var fields []string
+	var size int
+
+	if s.Err != nil {
+		return 0, errors.Newf("failed to parse %s: %w", s.C, s.Err)
+	}
+
+	if fields, size, _, e = layout(a, s, cfg, cfgs); e != nil {
+		return 0, errors.Newf("failed to generate %s: %w", s.C, e)
+	}
+
+	_, _ = fmt.Fprintf(
+		w,
+		"\n%s\ntype %s struct {\n",
+		wrapComment(
+			fmt.Sprintf("%s is %s from %s", cfg.Go, s.C, s.Header),
+		),
+		cfg.Go,
+	)
+
+	for _, field := range fields {
+		_, _ = fmt.Fprintf(w, "\t%s\n", field)
+	}
+
+	_, _ = fmt.Fprintln(w, "}")
+
+	return size, nil
+}
+
+// genStructFiles will generate a file of structs per arch.
+func genStructFiles() error {
+	var b []byte
+	var e error
+	var fn string
+
+	for _, a := range archs {
+		fn = fmt.Sprintf("generated_structs%d_windows.go", a.Bits)
+
+		if b, e = genStructs(a, structs); e != nil {
+			return e
+		}
+
+		if e = os.WriteFile(fn, b, 0o600); e != nil {
+			return errors.Newf("failed to write %s: %w", fn, e)
+		}
+	}
+
+	return nil
+}
+
+// genStructs will generate Go source for the configured structs,
+// laid out for the provided arch.
+func genStructs(
+	a *arch,
+	cfgs map[string]map[string]*structCfg,
+) ([]byte, error) {
+	var b []byte
+	var body bytes.Buffer
+	var e error
+	var names []string
+	var out bytes.Buffer
+	var size int
+	var sizes map[string]int = map[string]int{}
+	var types map[string]string = map[string]string{}
+
+	for header, wanted := range cfgs {
+		for c, cfg := range wanted {
+			if _, ok := parsed[c]; !ok {
+				return nil, errors.Newf(
+					"%s not found in %s",
+					c,
+					header,
+				)
+			}
+
+			names = append(names, cfg.Go)
+			types[cfg.Go] = c
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		size, e = genStruct(&body, a, parsed[types[name]], cfgs)
+		if e != nil {
+			return nil, e
+		}
+
+		sizes[name] = size
+	}
+
+	_, _ = fmt.Fprintln(
+		&out,
+		"// Code generated by tools/structs.go; DO NOT EDIT.",
+	)
+	_, _ = fmt.Fprintf(&out, "\n//go:build %s\n\n", a.tags())
+	_, _ = fmt.Fprintf(&out, "package api\n\nimport (\n")
+	_, _ = fmt.Fprintf(&out, "\t\"unsafe\"\n")
+
+	if bytes.Contains(body.Bytes(), []byte("windows.")) {
+		_, _ = fmt.Fprintf(&out, "\n\t\"golang.org/x/sys/windows\"\n")
+	}
+
+	_, _ = fmt.Fprintln(&out, ")")
+	_, _ = out.Write(body.Bytes())
+
+	// Sizes are checked at compile time
+	_, _ = fmt.Fprintln(&out, "\n// Ensure sizes match the C structs")
+	_, _ = fmt.Fprintln(&out, "var (")
+
+	for _, name := range names {
+		_, _ = fmt.Fprintf(
+			&out,
+			"\t_ [%d]byte = [unsafe.Sizeof(%s{})]byte{}\n",
+			sizes[name],
+			name,
+		)
+	}
+
+	_, _ = fmt.Fprintln(&out, ")")
+
+	if b, e = gofmt.Source(out.Bytes()); e != nil {
+		return nil, errors.Newf("failed to format structs: %w", e)
+	}
+
+	return b, nil
+}
+
+// index will return the index of the first tok at or after start, or
+// -1.
+func index(toks []string, tok string, start int) int {
+	for i := start; i < len(toks); i++ {
+		if toks[i] == tok {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isIdent(tok string) bool {
+	return reIdentifier.MatchString(tok)
+}
+
+func isPointer(typ string) bool {
+	var ok bool
+
+	_, ok = pointers[typ]
+
+	return ok
+}
+
+// layout will return the Go fields, size and alignment of a struct
+// for the provided arch.
+func layout(
+	a *arch,
+	s *cStruct,
+	cfg *structCfg,
+	cfgs map[string]map[string]*structCfg,
+) ([]string, int, int, error) {
+	var e error
+	var fields []string
+	var maxAlign int = 1
+	var member *cField
+	var off int
+	var t *cType
+	var used int
+
+	pad := func(n int) {
+		if n > 0 {
+			fields = append(
+				fields,
+				fmt.Sprintf("_ [%d]byte // Padding", n),
+			)
+		}
+	}
+
+	for _, f := range s.Fields {
+		member = f
+
+		// Unions are emitted as a single member, plus padding
+		if len(f.Union) > 0 {
+			member, t, used, e = resolveUnion(a, f, cfg, cfgs)
+		} else if t, e = resolveType(a, f, cfgs); e == nil {
+			used = t.Size
+		}
+
+		if e != nil {
+			return nil, 0, 0, e
+		}
+
+		maxAlign = max(maxAlign, t.Align)
+
+		pad(alignTo(off, t.Align) - off)
+		off = alignTo(off, t.Align)
+
+		fields = append(
+			fields,
+			fmt.Sprintf(
+				"%s %s // %s, %s",
+				fieldName(member.C, cfg),
+				t.Go,
+				cDecl(member),
+				bytesStr(used),
+			),
+		)
+
+		pad(t.Size - used)
+		off += t.Size
+	}
+
+	pad(alignTo(off, maxAlign) - off)
+
+	return fields, alignTo(off, maxAlign), maxAlign, nil
+}
+
+// matching will return the index of the bracket that closes the one
+// at open, or -1.
+func matching(toks []string, open int) int {
+	var depth int
+	var pairs map[string]string = map[string]string{
+		"(": ")",
+		"[": "]",
+		"{": "}",
+	}
+
+	for i := open; i < len(toks); i++ {
+		switch toks[i] {
+		case toks[open]:
+			depth++
+		case pairs[toks[open]]:
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// parseDecl will parse a declaration such as "DWORD a, *b, c[4]"
+// into fields.
+func parseDecl(fn string, toks []string) ([]*cField, error) {
+	var e error
+	var end int
+	var f *cField
+	var fields []*cField
+	var i int
+	var t []string
+	var typ []string
+
+	for _, tok := range toks {
+		switch tok {
+		case "__C89_NAMELESS", "__MINGW_EXTENSION", "const", "CONST",
+			"enum", "struct", "union", "volatile":
+		default:
+			// Also skip SAL annotations like _In_ and __out
+			if !reSAL.MatchString(tok) {
+				t = append(t, tok)
+			}
+		}
+	}
+
+	// Type is every identifier up to the first declarator
+	for (i+1 < len(t)) && isIdent(t[i]) && isIdent(t[i+1]) {
+		typ = append(typ, t[i])
+		i++
+	}
+
+	if (i+1 < len(t)) && isIdent(t[i]) && (t[i+1] == "*") {
+		typ = append(typ, t[i])
+		i++
+	}
+
+	if len(typ) == 0 {
+		return nil, errors.Newf(
+			"unsupported declaration %s",
+			strings.Join(toks, " "),
+		)
+	}
+
+	for i < len(t) {
+		f = &cField{Type: strings.Join(typ, " ")}
+
+		for (i < len(t)) && (t[i] == "*") {
+			f.Ptr = true
+			i++
+		}
+
+		if (i >= len(t)) || !isIdent(t[i]) {
+			break
+		}
+
+		f.C = t[i]
+		i++
+
+		for (i < len(t)) && (t[i] == "[") {
+			if end = matching(t, i); end < 0 {
+				return nil, errors.Newf("unterminated array %s", f.C)
+			}
+
+			if f.Count, e = evalSize(t[i+1 : end]); e != nil {
+				return nil, errors.Newf("%s: %w", f.C, e)
+			}
+
+			i = end + 1
+		}
+
+		fields = append(fields, f)
+
+		if (i < len(t)) && (t[i] == ",") {
+			i++
+			continue
+		}
+
+		break
+	}
+
+	if i < len(t) {
+		return nil, errors.Newf(
+			"unsupported declaration %s",
+			strings.Join(toks, " "),
+		)
+	}
+
+	return fields, nil
+}
+
+// parseFields will parse the body of a struct or union.
+func parseFields(fn string, toks []string) ([]*cField, error) {
+	var e error
+	var end int
+	var fields []*cField
+	var members []*cField
+	var open int
+	var semi int
+
+	for i := 0; i < len(toks); i = semi + 1 {
+		if semi = index(toks, ";", i); semi < 0 {
+			return nil, errors.New("missing ;")
+		}
+
+		switch open = index(toks, "{", i); {
+		case (open < 0) || (open > semi):
+			members, e = parseDecl(fn, toks[i:semi])
+			if e != nil {
+				return nil, e
+			}
+
+			fields = append(fields, members...)
+		case index(toks[:open], "union", i) < 0:
+			return nil, errors.New("nested structs are not supported")
+		default:
+			if end = matching(toks, open); end < 0 {
+				return nil, errors.New("unterminated union")
+			}
+
+			members, e = parseFields(fn, toks[open+1:end])
+			if e != nil {
+				return nil, e
+			}
+
+			for _, m := range members {
+				if len(m.Union) > 0 {
+					return nil, errors.New(
+						"nested unions are not supported",
+					)
+				}
+			}
+
+			fields = append(fields, &cField{Union: members})
+
+			// Skip union name, if any
+			if semi = index(toks, ";", end); semi < 0 {
+				return nil, errors.New("missing ;")
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+// parseTypedef will parse a typedef statement, which starts after the
+// typedef keyword. Structs are parsed, and other typedefs are kept so
+// fields can be resolved to known types. It returns the index of the
+// terminating semicolon.
+func parseTypedef(fn string, toks []string, start int) int {
+	var decls []*cField
+	var e error
+	var end int = start
+	var kind string
+	var open int
+	var s *cStruct
+	var stmt []string
+	var stop int
+
+	for depth := 0; end < len(toks); end++ {
+		if (toks[end] == ";") && (depth == 0) {
+			break
+		}
+
+		switch toks[end] {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		}
+	}
+
+	stmt = toks[start:min(end, len(toks))]
+
+	if len(stmt) > 0 {
+		kind = stmt[0]
+	}
+
+	switch open = index(stmt, "{", 0); {
+	case (open > 0) && (open <= 2):
+		// struct, union or enum with a body
+		if stop = matching(stmt, open); stop > 0 {
+			s = &cStruct{Header: fn}
+			s.Fields, s.Err = parseFields(fn, stmt[open+1:stop])
+			typedefNames(kind, stmt[stop+1:], s)
+
+			if (kind == "struct") && (open == 2) {
+				parsed[stmt[1]] = s
+			}
+		}
+	case index(stmt, "(", 0) >= 0:
+		// Function pointers
+		for i, tok := range stmt[:len(stmt)-1] {
+			if (tok == "*") && isIdent(stmt[i+1]) {
+				pointers[stmt[i+1]] = ""
+
+				break
+			}
+		}
+	default:
+		if decls, e = parseDecl(fn, stmt); e != nil {
+			return end
+		}
+
+		for _, d := range decls {
+			switch {
+			case d.Ptr:
+				pointers[d.C] = d.Type
+			case d.Count == 0:
+				aliases[d.C] = d.Type
+			}
+		}
+	}
+
+	return end
+}
+
+// processFileDecls will parse every typedef and function prototype
+// in a header, keeping structs, type aliases and prototypes.
+func processFileDecls(path string) error {
+	var b []byte
+	var e error
+	var fn string = filepath.Base(path)
+	var lines []string
+	var toks []string
+
+	if b, e = os.ReadFile(filepath.Clean(path)); e != nil {
+		return errors.Newf("failed to read %s: %w", path, e)
+	}
+
+	b = reBlockComment.ReplaceAll(b, nil)
+	b = reLineComment.ReplaceAll(b, nil)
+
+	// Remove preprocessor directives, including continued lines
+	for cont := false; len(b) > 0; {
+		line, rest, _ := bytes.Cut(b, []byte("\n"))
+		b = rest
+		line = bytes.TrimSpace(line)
+
+		if cont || bytes.HasPrefix(line, []byte("#")) {
+			cont = bytes.HasSuffix(line, []byte("\\"))
+			continue
+		}
+
+		lines = append(lines, string(line))
+	}
+
+	toks = reTokens.FindAllString(strings.Join(lines, "\n"), -1)
+
+	for i := 0; i < len(toks); i++ {
+		switch {
+		case toks[i] == "typedef":
+			i = parseTypedef(fn, toks, i+1)
+		case isIdent(toks[i]) && (i+1 < len(toks)) &&
+			(toks[i+1] == "("):
+			i = parsePrototype(fn, toks, i)
+		}
+	}
+
+	return nil
+}
+
+// resolveAlias will follow typedefs until a known type.
+func resolveAlias(typ string) string {
+	for range 8 {
+		if (cTypes[typ] != nil) || (parsed[typ] != nil) {
+			break
+		}
+
+		if _, ok := aliases[typ]; !ok {
+			break
+		}
+
+		typ = aliases[typ]
+	}
+
+	return typ
+}
+
+// resolveType will return the Go type, size and alignment of a field
+// for the provided arch.
+func resolveType(
+	a *arch,
+	f *cField,
+	cfgs map[string]map[string]*structCfg,
+) (*cType, error) {
+	var align int
+	var cfg *structCfg
+	var e error
+	var ptr int = a.Bits / 8 //nolint:mnd // Bits to bytes
+	var s *cStruct
+	var size int
+	var t cType
+	var typ string = resolveAlias(f.Type)
+
+	if s = parsed[typ]; s != nil {
+		cfg = cfgs[s.Header][s.C]
+	}
+
+	switch {
+	case f.Ptr:
+		t = cType{Go: "uintptr"}
+
+		switch typ {
+		case "CHAR", "char":
+			t.Go = "*uint8"
+		case "WCHAR", "wchar_t":
+			t.Go = "*uint16"
+		}
+	case cTypes[typ] != nil:
+		t = *cTypes[typ]
+	case cfg != nil:
+		if s.Err != nil {
+			return nil, errors.Newf("%s: %w", s.C, s.Err)
+		}
+
+		if _, size, align, e = layout(a, s, cfg, cfgs); e != nil {
+			return nil, e
+		}
+
+		t = cType{Align: align, Go: cfg.Go, Size: size}
+	case isPointer(typ), reHandle.MatchString(typ):
+		t = cType{Go: "uintptr"}
+	default:
+		return nil, errors.Newf("unknown type %s for %s", typ, f.C)
+	}
+
+	if t.Align == 0 {
+		t.Align = ptr
+	}
+
+	if t.Size == 0 {
+		t.Size = ptr
+	}
+
+	if f.Count > 0 {
+		t.Go = fmt.Sprintf("[%d]%s", f.Count, t.Go)
+		t.Size *= f.Count
+	}
+
+	return &t, nil
+}
+
+// resolveUnion will return the union member to emit, along with the
+// Go type, size and alignment of the whole union, and the size of the
+// member. The first member with a configured field name is emitted,
+// otherwise the first member.
+func resolveUnion(
+	a *arch,
+	f *cField,
+	cfg *structCfg,
+	cfgs map[string]map[string]*structCfg,
+) (*cField, *cType, int, error) {
+	var align int
+	var chosen int = -1
+	var e error
+	var size int
+	var t *cType
+	var types []*cType
+
+	for i, m := range f.Union {
+		if t, e = resolveType(a, m, cfgs); e != nil {
+			return nil, nil, 0, e
+		}
+
+		align = max(align, t.Align)
+		size = max(size, t.Size)
+		types = append(types, t)
+
+		if _, ok := cfg.Fields[m.C]; ok && (chosen < 0) {
+			chosen = i
+		}
+	}
+
+	chosen = max(chosen, 0)
+
+	return f.Union[chosen],
+		&cType{
+			Align: align,
+			Go:    types[chosen].Go,
+			Size:  alignTo(size, align),
+		},
+		types[chosen].Size,
+		nil
+}
+
+// typedefNames will register the names declared after the body of a
+// struct, union or enum typedef.
+func typedefNames(kind string, toks []string, s *cStruct) {
+	var ptr bool
+
+	for _, tok := range toks {
+		switch {
+		case tok == "*":
+			ptr = true
+		case tok == ",":
+			ptr = false
+		case !isIdent(tok):
+		case ptr:
+			pointers[tok] = s.C
+		case kind == "enum":
+			aliases[tok] = "int"
+		case kind == "struct":
+			if s.C == "" {
+				s.C = tok
+			}
+
+			parsed[tok] = s
+		}
+	}
+}
+
+// wrapComment will wrap a comment at 70 columns.
+func wrapComment(s string) string {
+	var line string = "//"
+	var lines []string
+
+	for _, word := range strings.Fields(s) {
+		//nolint:mnd // Max line length
+		if (len(line) > 2) && (len(line)+len(word)+1 > 70) {
+			lines = append(lines, line)
+			line = "//"
+		}
+
+		line += " " + word
+	}
+
+	return strings.Join(append(lines, line), "\n")
+}
+
+// tags will return the build constraint for the targets with the
+// arch's pointer size.
+func (a *arch) tags() string {
+	var goarchs []string
+
+	for _, t := range targets {
+		if t.Bits == a.Bits {
+			goarchs = append(goarchs, t.GOARCH)
+		}
+	}
+
+	sort.Strings(goarchs)
+
+	if len(goarchs) == 1 {
+		return "windows && " + goarchs[0]
+	}
+
+	return "windows && (" + strings.Join(goarchs, " || ") + ")"
+}
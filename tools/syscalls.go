@@ -0,0 +1,724 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	gofmt "go/format"
+	"go/token"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// cFunc is a function prototype parsed from a header.
+type cFunc struct {
+	C      string    // C-style func name
+	Err    error     // Set if the prototype could not be parsed
+	Header string    // Header it was parsed from
+	Params []*cField // Parameters in order
+	Ret    string    // C return type
+}
+
+// funcCfg describes how to generate a syscall wrapper.
+type funcCfg struct {
+	// C func name
+	C string `json:"c"`
+
+	// Go var name of the *windows.LazyDLL
+	DLL string `json:"dll"`
+
+	// Failure convention (see genSyscall)
+	Fail string `json:"fail,omitempty"`
+
+	// Go func name
+	Go string `json:"go"`
+
+	// Header with the prototype
+	Header string `json:"header,omitempty"`
+
+	// Go types for params, by C name, overriding the C types (e.g.
+	// for pointers to Go structs)
+	Params map[string]string `json:"params,omitempty"`
+
+	// C prototype, for functions that aren't in any header (e.g.
+	// undocumented ntdll functions), instead of a Header
+	Proto string `json:"proto,omitempty"`
+}
+
+var (
+	// Functions to generate, from syscalls.json
+	funcs []*funcCfg
+	//go:embed syscalls.json
+	funcsJSON []byte
+	// Parsed prototypes, indexed by C name
+	prototypes = map[string]*cFunc{}
+	// Regular expressions
+	reDecorator = regexp.MustCompile(
+		`^([A-Z_]+API|APIENTRY|CALLBACK|DECLSPEC_IMPORT|extern|` +
+			`__kernel_entry|__stdcall|WINAPI_INLINE)$`,
+	)
+)
+
+// argExpr will return the expression to pass a param to Call().
+func argExpr(name string, goType string) string {
+	switch {
+	case strings.HasPrefix(goType, "*"):
+		return "uintptr(unsafe.Pointer(" + name + "))"
+	case goType == "uintptr":
+		return name
+	default:
+		return "uintptr(" + name + ")"
+	}
+}
+
+// declHeaders will return the headers with wanted structs or funcs.
+func declHeaders() []string {
+	var headers []string
+	var seen map[string]bool = map[string]bool{}
+
+	for header := range structs {
+		seen[header] = true
+	}
+
+	for _, cfg := range funcs {
+		if cfg.Header != "" {
+			seen[cfg.Header] = true
+		}
+	}
+
+	for header := range seen {
+		headers = append(headers, header)
+	}
+
+	sort.Strings(headers)
+
+	return headers
+}
+
+// genProcs will write a var block with a LazyProc for each function,
+// so each proc is only resolved once, rather than on every call.
+// Procs are declared on 1 line, if it fits within 70 columns after
+// gofmt aligns the names.
+func genProcs(
+	w *bytes.Buffer,
+	names []string,
+	cfgs map[string]*funcCfg,
+	protos map[string]*cFunc,
+) {
+	var multi map[string]bool = map[string]bool{}
+	var overflow string
+
+	for {
+		overflow = procOverflow(names, cfgs, protos, multi)
+		if overflow == "" {
+			break
+		}
+
+		multi[overflow] = true
+	}
+
+	_, _ = fmt.Fprintf(w, "\nvar (\n")
+
+	for _, name := range names {
+		if multi[name] {
+			_, _ = fmt.Fprintf(
+				w,
+				"\tproc%s = %s.NewProc(\n\t\t%q,\n\t)\n",
+				protos[name].C,
+				cfgs[name].DLL,
+				protos[name].C,
+			)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(
+			w,
+			"\tproc%s = %s.NewProc(%q)\n",
+			protos[name].C,
+			cfgs[name].DLL,
+			protos[name].C,
+		)
+	}
+
+	_, _ = fmt.Fprintln(w, ")")
+}
+
+// genSyscall will write a Go wrapper for a C function, which checks
+// the return value according to its failure convention:
+//
+//   - BOOL fails if zero
+//   - HANDLE fails if NULL or INVALID_HANDLE_VALUE, else returns it
+//   - HRESULT fails if negative, returning it as an HRESULT
+//   - NONNULL fails if not NULL (e.g. GlobalFree)
+//   - NTSTATUS fails if negative, returning it as an NTStatus
+//   - empty means there is nothing to check
+//
+// Params are Go types from the C types, unless overridden by name.
+func genSyscall(
+	w *bytes.Buffer,
+	f *cFunc,
+	cfg *funcCfg,
+	cfgs map[string]map[string]*structCfg,
+) error {
+	var args []string
+	var assign string = "r, _, e"
+	var cond string
+	var e error
+	var fail string = "e"
+	var goType string
+	var name string
+	var ok string = "nil"
+	var params []string
+	var ret string = " error"
+	var vars []string = []string{
+		"e error",
+		"proc *windows.LazyProc",
+		"r uintptr",
+	}
+	var zero string
+
+	if f.Err != nil {
+		return errors.Newf("failed to parse %s: %w", f.C, f.Err)
+	}
+
+	for c := range cfg.Params {
+		if !slices.ContainsFunc(
+			f.Params,
+			func(p *cField) bool { return p.C == c },
+		) {
+			return errors.Newf("%s has no param %s", f.C, c)
+		}
+	}
+
+	for _, p := range f.Params {
+		if t, ok := cfg.Params[p.C]; ok {
+			goType = t
+		} else if goType, e = goParam(p, cfgs); e != nil {
+			return errors.Newf("failed to generate %s: %w", f.C, e)
+		}
+
+		name = paramName(p.C)
+		args = append(args, argExpr(name, goType))
+		params = append(params, name+" "+goType)
+	}
+
+	switch cfg.Fail {
+	case "":
+		assign = "_, _, _"
+		ret = ""
+		vars = []string{"proc *windows.LazyProc"}
+	case "BOOL":
+		cond = "r == 0"
+	case "HANDLE":
+		cond = "(r == 0) || (r == uintptr(windows.InvalidHandle))"
+		ok = "r, nil"
+		zero = "0, "
+		ret = " (" + handleType(f.Ret) + ", error)"
+
+		if handleType(f.Ret) != "uintptr" {
+			ok = handleType(f.Ret) + "(r), nil"
+		}
+	case "HRESULT", "NTSTATUS":
+		// Status codes are negative on failure
+		assign = "r, _, _"
+		cond = "int32(r) < 0"
+		fail = "HRESULT(r)"
+
+		if cfg.Fail == "NTSTATUS" {
+			fail = "NTStatus(r)"
+		}
+	case "NONNULL":
+		cond = "r != 0"
+	default:
+		return errors.Newf(
+			"unknown failure convention %s for %s",
+			cfg.Fail,
+			f.C,
+		)
+	}
+
+	_, _ = fmt.Fprintf(
+		w,
+		"\n%s\n%s",
+		wrapComment(
+			fmt.Sprintf("%s is %s from %s", cfg.Go, f.C, f.Header),
+		),
+		wrapList("func "+cfg.Go+"(", params, ")"+ret+" {", 0),
+	)
+
+	for _, v := range vars {
+		if strings.HasPrefix(v, "proc ") {
+			v += " = proc" + f.C
+		}
+
+		_, _ = fmt.Fprintf(w, "\tvar %s\n", v)
+	}
+
+	// Find the proc first, so a missing proc returns an error,
+	// rather than panicking in Call()
+	if cfg.Fail == "" {
+		_, _ = fmt.Fprintf(
+			w,
+			"\n\t// Nowhere to report a missing proc\n"+
+				"\tif proc.Find() != nil {\n\t\treturn\n\t}\n",
+		)
+	} else {
+		_, _ = fmt.Fprintf(
+			w,
+			"\n\tif e = proc.Find(); e != nil {\n%s\t}\n",
+			wrapErr(zero, "e"),
+		)
+	}
+
+	_, _ = fmt.Fprintf(
+		w,
+		"\n%s",
+		wrapList(
+			assign+" = proc.Call(",
+			args,
+			")",
+			1,
+		),
+	)
+
+	if cond != "" {
+		_, _ = fmt.Fprintf(
+			w,
+			"\tif %s {\n%s\t}\n\n\treturn %s\n",
+			cond,
+			wrapErr(zero, fail),
+			ok,
+		)
+	}
+
+	_, _ = fmt.Fprintln(w, "}")
+
+	return nil
+}
+
+// genSyscallFile will generate a file of syscall wrappers.
+func genSyscallFile() error {
+	var b []byte
+	var e error
+	var fn string = "generated_syscalls_windows.go"
+
+	if b, e = genSyscalls(funcs, structs); e != nil {
+		return e
+	}
+
+	if e = os.WriteFile(fn, b, 0o600); e != nil {
+		return errors.Newf("failed to write %s: %w", fn, e)
+	}
+
+	return nil
+}
+
+// genSyscalls will generate Go source for the configured functions.
+func genSyscalls(
+	fCfgs []*funcCfg,
+	sCfgs map[string]map[string]*structCfg,
+) ([]byte, error) {
+	var b []byte
+	var body bytes.Buffer
+	var cfgs map[string]*funcCfg = map[string]*funcCfg{}
+	var e error
+	var imports []string
+	var names []string
+	var out bytes.Buffer
+	var protos map[string]*cFunc = map[string]*cFunc{}
+
+	for _, cfg := range fCfgs {
+		if cfg.Proto != "" {
+			if e = parseProto(cfg); e != nil {
+				return nil, e
+			}
+		} else if _, ok := prototypes[cfg.C]; !ok {
+			return nil, errors.Newf(
+				"%s not found in %s",
+				cfg.C,
+				cfg.Header,
+			)
+		}
+
+		cfgs[cfg.Go] = cfg
+		names = append(names, cfg.Go)
+		protos[cfg.Go] = prototypes[cfg.C]
+	}
+
+	// Sort alphabetically, case-insensitive
+	sort.Slice(
+		names,
+		func(i int, j int) bool {
+			var l string = strings.ToLower(names[i])
+			var r string = strings.ToLower(names[j])
+
+			return l < r
+		},
+	)
+
+	for _, name := range names {
+		e = genSyscall(&body, protos[name], cfgs[name], sCfgs)
+		if e != nil {
+			return nil, e
+		}
+	}
+
+	_, _ = fmt.Fprintln(
+		&out,
+		"// Code generated by tools/syscalls.go; DO NOT EDIT.",
+	)
+	_, _ = fmt.Fprintf(&out, "\n//go:build windows\n\n")
+	_, _ = fmt.Fprintf(&out, "package api\n\nimport (\n")
+
+	// Only import what is used
+	for pkg, sel := range map[string]string{
+		"\"unsafe\"":                     "unsafe.",
+		"\"golang.org/x/sys/windows\"":   "windows.",
+		"\"github.com/mjwhitta/errors\"": "errors.",
+	} {
+		if bytes.Contains(body.Bytes(), []byte(sel)) {
+			imports = append(imports, pkg)
+		}
+	}
+
+	sort.Slice(
+		imports,
+		func(i int, j int) bool {
+			// Stdlib, then x/, then everything else
+			return importRank(imports[i]) < importRank(imports[j])
+		},
+	)
+
+	for i, pkg := range imports {
+		if (i > 0) && (importRank(pkg) != importRank(imports[i-1])) {
+			_, _ = fmt.Fprintln(&out)
+		}
+
+		_, _ = fmt.Fprintf(&out, "\t%s\n", pkg)
+	}
+
+	_, _ = fmt.Fprintln(&out, ")")
+
+	genProcs(&out, names, cfgs, protos)
+	_, _ = out.Write(body.Bytes())
+
+	if b, e = gofmt.Source(out.Bytes()); e != nil {
+		return nil, errors.Newf("failed to format syscalls: %w", e)
+	}
+
+	return b, nil
+}
+
+// goParam will return the Go type of a function parameter.
+func goParam(
+	f *cField,
+	cfgs map[string]map[string]*structCfg,
+) (string, error) {
+	var pointee string
+	var s *cStruct
+	var t *cType
+	var typ string = resolveAlias(f.Type)
+
+	switch {
+	case f.Ptr:
+		pointee = typ
+	case isPointer(typ):
+		pointee = resolveAlias(pointers[typ])
+	case typ == "HANDLE":
+		return "windows.Handle", nil
+	case cTypes[typ] != nil:
+		// Would need 2 args on 32-bit
+		//nolint:mnd // 64-bit
+		if cTypes[typ].Size == 8 {
+			return "", errors.Newf("unsupported 64-bit param %s", f.C)
+		}
+
+		return cTypes[typ].Go, nil
+	case reHandle.MatchString(typ):
+		return "windows.Handle", nil
+	default:
+		return "", errors.Newf("unknown type %s for %s", typ, f.C)
+	}
+
+	if (pointee == "HANDLE") || reHandle.MatchString(pointee) {
+		return "*windows.Handle", nil
+	}
+
+	if s = parsed[pointee]; s != nil {
+		if cfg, ok := cfgs[s.Header][s.C]; ok {
+			return "*" + cfg.Go, nil
+		}
+	}
+
+	// Pointer to a known type, unless it's a pointer to a pointer
+	t = cTypes[pointee]
+	if (t != nil) && !strings.HasPrefix(t.Go, "*") {
+		return "*" + t.Go, nil
+	}
+
+	return "uintptr", nil
+}
+
+// handleType will return the Go type of a HANDLE-like return value.
+func handleType(ret string) string {
+	switch ret = resolveAlias(ret); {
+	case ret == "HANDLE":
+		return "windows.Handle"
+	case cTypes[ret] != nil:
+		return "uintptr"
+	case reHandle.MatchString(ret):
+		return "windows.Handle"
+	default:
+		return "uintptr"
+	}
+}
+
+func importRank(pkg string) int {
+	switch {
+	case !strings.Contains(pkg, "."):
+		return 0
+	case strings.Contains(pkg, "golang.org/x/"):
+		return 1
+	default:
+		return 2 //nolint:mnd // Everything else
+	}
+}
+
+// loadFuncs will parse the functions to generate from JSON. Each
+// needs either the Header with its prototype, or its Proto.
+func loadFuncs(b []byte) ([]*funcCfg, error) {
+	var cfgs []*funcCfg
+
+	if e := json.Unmarshal(b, &cfgs); e != nil {
+		return nil, errors.Newf("failed to parse funcs: %w", e)
+	}
+
+	for _, cfg := range cfgs {
+		switch {
+		case (cfg.C == "") || (cfg.DLL == "") || (cfg.Go == ""):
+			return nil, errors.Newf("incomplete func %+v", *cfg)
+		case (cfg.Header == "") == (cfg.Proto == ""):
+			return nil, errors.Newf(
+				"%s needs a header or a proto, not both",
+				cfg.C,
+			)
+		}
+	}
+
+	return cfgs, nil
+}
+
+// paramName will return a Go param name that is unexported and won't
+// collide with keywords or the local vars in generated wrappers.
+func paramName(c string) string {
+	c = lowerFirst(c)
+
+	switch {
+	case token.IsKeyword(c), (c == "e"), (c == "proc"), (c == "r"):
+		return c + "Arg"
+	default:
+		return c
+	}
+}
+
+// parseParams will parse a parameter list. Unnamed params are given
+// a name based on their position.
+func parseParams(fn string, toks []string) ([]*cField, error) {
+	var decl []*cField
+	var depth int
+	var e error
+	var params []*cField
+	var parts [][]string = [][]string{nil}
+
+	if (len(toks) == 0) || ((len(toks) == 1) && (toks[0] == "void")) {
+		return nil, nil
+	}
+
+	for _, tok := range toks {
+		switch tok {
+		case "(", "[":
+			depth++
+		case ")", "]":
+			depth--
+		case ",":
+			if depth == 0 {
+				parts = append(parts, nil)
+				continue
+			}
+		}
+
+		parts[len(parts)-1] = append(parts[len(parts)-1], tok)
+	}
+
+	for i, part := range parts {
+		if decl, e = parseDecl(fn, part); e != nil {
+			// Try again, assuming it's unnamed
+			decl, e = parseDecl(
+				fn,
+				append(part, fmt.Sprintf("arg%d", i)),
+			)
+		}
+
+		if (e != nil) || (len(decl) != 1) {
+			return nil, errors.Newf(
+				"unsupported param %s",
+				strings.Join(part, " "),
+			)
+		}
+
+		params = append(params, decl[0])
+	}
+
+	return params, nil
+}
+
+// parsePrototype will parse a function prototype, starting at the
+// function name. It returns the index of the closing paren, or the
+// provided index if it's not a prototype.
+func parsePrototype(fn string, toks []string, i int) int {
+	var end int = matching(toks, i+1)
+	var f *cFunc
+	var ret []string
+	var start int = i
+
+	// Prototypes end with a semicolon, calls in macros and inline
+	// funcs don't
+	if (end < 0) || (end+1 >= len(toks)) || (toks[end+1] != ";") {
+		return i
+	}
+
+	if _, ok := prototypes[toks[i]]; ok {
+		return end
+	}
+
+	// Return type is everything since the previous statement
+	for (start > 0) && !strings.Contains(";{}", toks[start-1]) {
+		start--
+	}
+
+	for _, tok := range toks[start:i] {
+		switch {
+		case tok == "*":
+			ret = append(ret, tok)
+		case isIdent(tok) && !reDecorator.MatchString(tok):
+			ret = append(ret, tok)
+		}
+	}
+
+	f = &cFunc{C: toks[i], Header: fn, Ret: strings.Join(ret, " ")}
+	f.Params, f.Err = parseParams(fn, toks[i+2:end])
+	prototypes[f.C] = f
+
+	return end
+}
+
+// parseProto will parse the Proto of a function, as if it were from
+// a header named after its DLL. It replaces any prototype of the same
+// name.
+func parseProto(cfg *funcCfg) error {
+	var toks []string = reTokens.FindAllString(cfg.Proto, -1)
+
+	delete(prototypes, cfg.C)
+
+	for i := range len(toks) - 1 {
+		if (toks[i] == cfg.C) && (toks[i+1] == "(") {
+			parsePrototype(cfg.DLL+".dll", toks, i)
+			break
+		}
+	}
+
+	if _, ok := prototypes[cfg.C]; !ok {
+		return errors.Newf("invalid prototype for %s", cfg.C)
+	}
+
+	return nil
+}
+
+// procOverflow will return the first proc declared on 1 line that
+// would be wider than 70 columns, once gofmt aligns the names. Names
+// are aligned in runs of 1-line declarations, which end with the
+// first line of a multi-line declaration.
+func procOverflow(
+	names []string,
+	cfgs map[string]*funcCfg,
+	protos map[string]*cFunc,
+	multi map[string]bool,
+) string {
+	var call string
+	var end int
+	var pad int
+
+	for start := 0; start < len(names); start = end + 1 {
+		pad = 0
+
+		for end = start; end < len(names)-1; end++ {
+			if multi[names[end]] {
+				break
+			}
+		}
+
+		for _, name := range names[start : end+1] {
+			pad = max(pad, len(protos[name].C))
+		}
+
+		for _, name := range names[start : end+1] {
+			call = cfgs[name].DLL + ".NewProc(" + strconv.Quote(
+				protos[name].C,
+			) + ")"
+
+			// Tab, "proc", padded name, " = ", call
+			//nolint:mnd // Tabs are 4 columns, max length is 70
+			if !multi[name] && (4+4+pad+3+len(call) > 70) {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+// wrapErr will return a return statement for a failed call, wrapped
+// with the name of the proc.
+func wrapErr(zero string, e string) string {
+	return wrapList(
+		"return "+zero+"errors.Newf(",
+		[]string{"\"%s: %w\"", "proc.Name", e},
+		")",
+		2, //nolint:mnd // Inside an if block
+	)
+}
+
+// wrapList will join items into a single line if it fits within 70
+// columns, otherwise one item per line.
+func wrapList(
+	prefix string,
+	items []string,
+	suffix string,
+	indent int,
+) string {
+	var line string
+	var tabs string = strings.Repeat("\t", indent)
+
+	line = tabs + prefix + strings.Join(items, ", ") + suffix
+
+	//nolint:mnd // Tabs are 4 columns, max line length is 70
+	if (len(items) == 0) || (len(line)+indent*3 <= 70) {
+		return line + "\n"
+	}
+
+	line = tabs + prefix + "\n"
+
+	for _, item := range items {
+		line += tabs + "\t" + item + ",\n"
+	}
+
+	return line + tabs + suffix + "\n"
+}
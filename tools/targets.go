@@ -0,0 +1,364 @@
+package main
+
+import (
+	"flag"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// cond is an #if block.
+type cond struct {
+	Active bool // Current branch is processed
+	Known  bool // Condition could be evaluated
+	Taken  bool // A previous branch was processed
+}
+
+// condParser evaluates preprocessor conditions that only use the
+// arch macros.
+type condParser struct {
+	i    int
+	ok   bool
+	toks []string
+}
+
+// condStack tracks the #if blocks of a header, so only the branches
+// for the current target are processed.
+type condStack []*cond
+
+// target is an arch to generate constants for.
+type target struct {
+	Bits   int      // Pointer size in bits
+	GOARCH string   // Go arch, used for build tags and file names
+	Macros []string // Macros predefined by the compiler
+	Name   string   // Name used with -arch
+}
+
+var (
+	// Flags
+	archFlag = flag.String(
+		"arch",
+		"amd64,arm64,x86",
+		"Comma-separated list of archs to generate constants for",
+	)
+	// Target currently being processed, nil to keep all branches
+	curTarget *target
+	// GOARCHes with 32-bit pointers, per "go tool dist list"
+	goarch32 = []string{"386", "arm", "mips", "mipsle"}
+	// Regular expressions
+	reCondToken = regexp.MustCompile(
+		`[A-Za-z_]\w*|0[Xx][0-9A-Fa-f]+|\d+|&&|\|\||\S`,
+	)
+	// Supported targets
+	targets = []*target{
+		{
+			Bits:   64,
+			GOARCH: "amd64",
+			Macros: []string{
+				"_AMD64_",
+				"_M_AMD64",
+				"_M_X64",
+				"_WIN32",
+				"_WIN64",
+				"__x86_64",
+				"__x86_64__",
+			},
+			Name: "amd64",
+		},
+		{
+			Bits:   64,
+			GOARCH: "arm64",
+			Macros: []string{
+				"_ARM64_",
+				"_M_ARM64",
+				"_WIN32",
+				"_WIN64",
+				"__aarch64__",
+			},
+			Name: "arm64",
+		},
+		{
+			Bits:   32,
+			GOARCH: "386",
+			Macros: []string{
+				"_M_IX86",
+				"_WIN32",
+				"_X86_",
+				"__i386",
+				"__i386__",
+			},
+			Name: "x86",
+		},
+	}
+)
+
+// evalCond will evaluate a preprocessor condition for the current
+// target. The second return value is false if the condition uses
+// anything other than the arch macros.
+func evalCond(expr string) (bool, bool) {
+	var p *condParser
+	var v bool
+
+	if curTarget == nil {
+		return false, false
+	}
+
+	// Remove comments
+	expr = reComment.ReplaceAllString(expr, " ")
+	expr, _, _ = strings.Cut(expr, "//")
+
+	p = &condParser{ok: true}
+	p.toks = reCondToken.FindAllString(expr, -1)
+	v = p.or()
+
+	if !p.ok || (p.i != len(p.toks)) {
+		return false, false
+	}
+
+	return v, true
+}
+
+// fits32 will return false if a value is a constant too large for a
+// 32-bit uintptr.
+func fits32(v string) bool {
+	var e error
+	var tv types.TypeAndValue
+
+	tv, e = types.Eval(token.NewFileSet(), nil, token.NoPos, v)
+	if (e != nil) || (tv.Value == nil) {
+		return true
+	}
+
+	if tv.Value.Kind() != constant.Int {
+		return true
+	}
+
+	return constant.Compare(
+		tv.Value,
+		token.LEQ,
+		constant.MakeUint64(math.MaxUint32),
+	)
+}
+
+// knownMacro will return true if a macro is predefined for any
+// target.
+func knownMacro(name string) bool {
+	for _, t := range targets {
+		if slices.Contains(t.Macros, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectedTargets will return the targets requested with -arch, in
+// the order provided. The first target is used for any constants
+// that are not defined for every target.
+func selectedTargets() ([]*target, error) {
+	var found bool
+	var tgts []*target
+
+	for name := range strings.SplitSeq(*archFlag, ",") {
+		found = false
+		name = strings.TrimSpace(name)
+
+		for _, t := range targets {
+			if (t.Name == name) && !slices.Contains(tgts, t) {
+				found = true
+				tgts = append(tgts, t)
+			}
+		}
+
+		if !found {
+			return nil, errors.Newf(
+				"unsupported or duplicate arch %s",
+				name,
+			)
+		}
+	}
+
+	return tgts, nil
+}
+
+func (p *condParser) and() bool {
+	var v bool = p.unary()
+
+	for p.peek() == "&&" {
+		p.i++
+
+		// Evaluate both sides, so any unknown macro is noticed
+		if r := p.unary(); !r {
+			v = false
+		}
+	}
+
+	return v
+}
+
+func (p *condParser) macro(name string) bool {
+	if !knownMacro(name) {
+		p.ok = false
+		return false
+	}
+
+	return slices.Contains(curTarget.Macros, name)
+}
+
+func (p *condParser) next() string {
+	var tok string = p.peek()
+
+	if p.i >= len(p.toks) {
+		p.ok = false
+		return ""
+	}
+
+	p.i++
+
+	return tok
+}
+
+func (p *condParser) or() bool {
+	var v bool = p.and()
+
+	for p.peek() == "||" {
+		p.i++
+
+		// Evaluate both sides, so any unknown macro is noticed
+		if r := p.and(); r {
+			v = true
+		}
+	}
+
+	return v
+}
+
+func (p *condParser) peek() string {
+	if p.i >= len(p.toks) {
+		return ""
+	}
+
+	return p.toks[p.i]
+}
+
+func (p *condParser) primary() bool {
+	var paren bool
+	var tok string = p.next()
+	var v bool
+
+	switch tok {
+	case "(":
+		v = p.or()
+
+		if p.next() != ")" {
+			p.ok = false
+		}
+
+		return v
+	case "defined":
+		if paren = (p.peek() == "("); paren {
+			p.i++
+		}
+
+		v = p.macro(p.next())
+
+		if paren && (p.next() != ")") {
+			p.ok = false
+		}
+
+		return v
+	}
+
+	if n, e := strconv.ParseInt(tok, 0, 64); e == nil {
+		return n != 0
+	}
+
+	// Arch macros are all defined as 1
+	return p.macro(tok)
+}
+
+func (p *condParser) unary() bool {
+	if p.peek() == "!" {
+		p.i++
+		return !p.unary()
+	}
+
+	return p.primary()
+}
+
+func (s *condStack) active() bool {
+	for _, c := range *s {
+		if !c.Active {
+			return false
+		}
+	}
+
+	return true
+}
+
+// skip will track preprocessor conditionals and return true if a
+// line should be skipped for the current target. Branches with
+// conditions that can't be evaluated are all processed.
+func (s *condStack) skip(line string) bool {
+	var c *cond
+	var directive string
+	var expr string
+	var v bool
+
+	if !strings.HasPrefix(line, "#") {
+		return !s.active()
+	}
+
+	line = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+	directive, expr, _ = strings.Cut(line, " ")
+
+	if len(*s) > 0 {
+		c = (*s)[len(*s)-1]
+	}
+
+	switch directive {
+	case "if", "ifdef", "ifndef":
+		switch directive {
+		case "ifdef":
+			expr = "defined(" + expr + ")"
+		case "ifndef":
+			expr = "!defined(" + expr + ")"
+		}
+
+		c = &cond{Active: true}
+
+		if v, c.Known = evalCond(expr); c.Known {
+			c.Active = v
+			c.Taken = v
+		}
+
+		*s = append(*s, c)
+	case "elif":
+		if c == nil {
+			return true
+		}
+
+		v, c.Known = evalCond(expr)
+		c.Active = !c.Taken && (v || !c.Known)
+		c.Taken = c.Taken || (c.Known && v)
+	case "else":
+		if c != nil {
+			c.Active = !c.Taken
+		}
+	case "endif":
+		if c != nil {
+			*s = (*s)[:len(*s)-1]
+		}
+	default:
+		return !s.active()
+	}
+
+	return true
+}
@@ -0,0 +1,61 @@
+/**
+ * Fixture for the per-arch defines golden files. From the tools
+ * directory, run "go test" to check the golden files, or add
+ * "-args -update" to regenerate them.
+ */
+#ifndef _ARCH_H
+#define _ARCH_H
+
+/* Same for every arch, one too large for a 32-bit uintptr */
+#define COMMON_VALUE 0x10
+#define HUGE_VALUE 0x7fffffffffffffffLL
+#define ORDINAL_FLAG64 0x8000000000000000ull
+#define ORDINAL_FLAG32 0x80000000
+
+/* Differs by arch */
+#ifdef _WIN64
+#define ORDINAL_FLAG ORDINAL_FLAG64
+#else
+#define ORDINAL_FLAG ORDINAL_FLAG32
+#endif
+
+#if defined(__x86_64__)
+#define CONTEXT_FLAG 0x00100000
+#elif defined(__aarch64__)
+#define CONTEXT_FLAG 0x00400000
+#elif defined(__i386__)
+#define CONTEXT_FLAG 0x00010000
+#endif
+
+/* Only defined for some archs */
+#ifndef _WIN64
+#define ONLY_X86 0x1
+#endif
+
+/* Not an arch condition, so every branch is kept */
+#if (_WIN32_WINNT >= 0x0600)
+#define UNKNOWN_VALUE 0x2
+#else
+#define UNKNOWN_VALUE 0x3
+#endif
+
+/* Combined conditions */
+#if defined(_WIN64) && !defined(_ARM64_)
+#define PAGE_SIZE_VALUE 0x1000
+#elif defined(_ARM64_)
+#define PAGE_SIZE_VALUE 0x4000
+#else
+#define PAGE_SIZE_VALUE 0x1000
+#endif
+
+/* Conditional typedef enum members */
+typedef enum _PROC_MODE {
+#ifdef _WIN64
+  ProcMode64 = 64,
+#else
+  ProcMode32 = 32,
+#endif
+  ProcModeLast
+} PROC_MODE;
+
+#endif
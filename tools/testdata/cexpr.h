@@ -0,0 +1,70 @@
+/**
+ * Fixture for the C expression golden files. From the tools
+ * directory, run "go test" to check the golden files, or add
+ * "-args -update" to regenerate them.
+ */
+#ifndef _CEXPR_H
+#define _CEXPR_H
+
+typedef DWORD ALG_ID;
+
+/* References a header that is processed later */
+#define FROM_BASE (BASE_VALUE + 1)
+
+/* Literals and suffixes */
+#define CHAR_VALUE 'A'
+#define FLOAT_VALUE 1.5f
+#define LIST_VALUE { 0x1, 0x2, 0x3 }
+#define LONG_VALUE __MSABI_LONG(0x10)
+#define OCTAL_VALUE 0755
+#define STRING_VALUE "text\twith \"escapes\""
+#define UNSIGNED_VALUE 42u
+#define WIDE_STRING L"wide"
+
+/* Casts, truncated or sign-extended to the type */
+#define ALIAS_CAST ((ALG_ID)0x8003)
+#define INVALID_PTR ((ULONG_PTR)-1)
+#define INVALID_VALUE ((DWORD)-1)
+#define NEGATIVE_VALUE ((LONG)-2)
+#define TRUNCATED ((BYTE)0x1ff)
+
+/* Operators */
+#define BITWISE_NOT (~0x1u)
+#define COMPARE (3 > 2)
+#define LOGICAL (1 && 0 || !0)
+#define MIXED_OPS ((2 + 3) * 4 - 10 / 3 % 2)
+#define SHIFT_LEFT (1u << 31)
+#define SHIFT_RIGHT (0x80000000 >> 4)
+#define TERNARY (sizeof(void *) == 8 ? 64 : 32)
+#define WRAPPED (0U - 1)
+
+/* Sizes of known types */
+#define SIZEOF_ALIAS sizeof(ALG_ID)
+#define SIZEOF_DWORD sizeof(DWORD)
+#define SIZEOF_PTR sizeof (ULONG_PTR)
+
+/* Function-like macros */
+#define CLASS(x) ((x) << 13)
+#define PASTE(a, b) a##b
+#define STR(x) #x
+#define SUM(...) (0 + __VA_ARGS__)
+#define CLASS_HASH CLASS(4)
+#define PASTED PASTE(0x, 20)
+#define STRINGIZED STR(hello)
+#define SUMMED SUM(1 + 2)
+
+/* Unsupported, so in the report */
+#define DIVIDE_BY_ZERO (1 / 0)
+#define FLOAT_SHIFT (1.5 << 1)
+#define SELF_REFERENCE (SELF_REFERENCE + 1)
+#define UNKNOWN_MACRO (NOT_DEFINED + 1)
+#define UNKNOWN_TYPE ((FOO)1)
+
+/* Members may reference #defines and each other */
+typedef enum _CEXPR_KIND {
+  KindFirst = FROM_BASE,
+  KindSecond,
+  KindThird = KindSecond << 1
+} CEXPR_KIND;
+
+#endif
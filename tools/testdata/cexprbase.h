@@ -0,0 +1,11 @@
+/**
+ * Fixture for the C expression golden files, with macros that are
+ * referenced by cexpr.h.
+ */
+#ifndef _CEXPRBASE_H
+#define _CEXPRBASE_H
+
+#define BASE_VALUE 0x100
+#define BASE_TWICE (UNSIGNED_VALUE * 2)
+
+#endif
@@ -0,0 +1,35 @@
+/**
+ * Fixture for the enum generator golden file. From the tools
+ * directory, run "go test" to check the golden files, or add
+ * "-args -update" to regenerate them.
+ */
+#ifndef _ENUMS_H
+#define _ENUMS_H
+
+/* Typedef enum, members in definition order */
+typedef enum _COLOR {
+  COLOR_RED = 1,
+  COLOR_GREEN,
+  COLOR_BLUE = 0x10,
+  COLOR_DEFAULT = COLOR_RED
+} COLOR, *PCOLOR;
+
+/* Plain values */
+#define MODE_READ 0
+#define MODE_WRITE 1
+#define MODE_APPEND 2
+#define MODE_INVALID -1
+
+/* Flags, with a combination and an alias */
+#define OPEN_FLAG_CREATE 0x00000001
+#define OPEN_FLAG_TRUNCATE 0x00000002
+#define OPEN_FLAG_EXCLUSIVE 0x00000004
+#define OPEN_FLAG_NEW (OPEN_FLAG_CREATE | OPEN_FLAG_EXCLUSIVE)
+#define OPEN_FLAG_RECREATE OPEN_FLAG_NEW
+#define OPEN_FLAG_UNKNOWNBIT 0x80000000
+
+/* Longer prefixes win */
+#define OPEN_FLAG_SYNC_DATA 0x00000001
+#define OPEN_FLAG_SYNC_META 0x00000002
+
+#endif
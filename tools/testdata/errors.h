@@ -0,0 +1,60 @@
+/**
+ * Fixture for the error code generator golden file. The codes are in
+ * the styles of the mingw-w64 and Windows SDK headers. From the tools
+ * directory, run "go test" to check the golden files, or add
+ * "-args -update" to regenerate them.
+ */
+#ifndef _ERRORS_H
+#define _ERRORS_H
+
+/* Never error codes */
+#define FACILITY_WIN32 7
+#define ERROR_SEVERITY_ERROR 0xC0000000
+#define HRESULT_FROM_WIN32(x) ((HRESULT)(x) <= 0 ? ((HRESULT)(x)) : ((HRESULT) (((x) & 0x0000FFFF) | (FACILITY_WIN32 << 16) | 0x80000000)))
+
+/* mingw-w64 style */
+#define NO_ERROR __MSABI_LONG(0)
+#define ERROR_SUCCESS __MSABI_LONG(0)
+#define ERROR_FILE_NOT_FOUND __MSABI_LONG(2)
+#define ERROR_ACCESS_DENIED __MSABI_LONG(5)
+#define ERROR_NOT_FOUND __MSABI_LONG(1168)
+#define WAIT_TIMEOUT 258L
+#define DRAGDROP_E_FIRST 0x80040100L
+
+/* Canonical names are preferred, regardless of order */
+#define SEC_E_OK ((HRESULT)0x00000000L)
+#define S_OK ((HRESULT)0x00000000)
+#define E_ACCESSDENIED _HRESULT_TYPEDEF_(0x80070005L)
+#define E_NOT_SET HRESULT_FROM_WIN32(ERROR_NOT_FOUND)
+
+#define STATUS_SUCCESS ((NTSTATUS)0x00000000)
+#define STATUS_WAIT_0 ((NTSTATUS)0x00000000L)
+#define STATUS_TIMEOUT ((NTSTATUS)0x00000102L)
+#define STATUS_OBJECT_NAME_NOT_FOUND ((NTSTATUS)0xC0000034L)
+
+/* Windows SDK style, MessageText comments are ignored */
+//
+// MessageId: STATUS_ACCESS_DENIED
+//
+// MessageText:
+//
+// {Access Denied}
+// A process has requested access to an object, but has not been
+// granted those access rights.
+//
+#define STATUS_ACCESS_DENIED             ((NTSTATUS)0xC0000022L)
+
+//
+// MessageId: ERROR_INVALID_FUNCTION
+//
+// MessageText:
+//
+// Incorrect function.
+//
+#define ERROR_INVALID_FUNCTION           1L    // dderror
+
+/* Bases, as in wininet.h */
+#define INTERNET_ERROR_BASE 12000
+#define ERROR_INTERNET_TIMEOUT (INTERNET_ERROR_BASE + 2)
+
+#endif
@@ -0,0 +1,112 @@
+/**
+ * Fixture for the struct generator golden files. The structs are
+ * copied from mingw-w64 headers, plus a few edge cases. From the tools
+ * directory, run "go test" to check the golden files, or add
+ * "-args -update" to regenerate them.
+ */
+#ifndef _STRUCTS_H
+#define _STRUCTS_H
+
+#include <windef.h>
+
+#define MAX_PATH 260
+#define NAME_LEN 15
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+/* From tlhelp32.h */
+  typedef struct tagPROCESSENTRY32 {
+    DWORD dwSize;
+    DWORD cntUsage;
+    DWORD th32ProcessID;
+    ULONG_PTR th32DefaultHeapID;
+    DWORD th32ModuleID;
+    DWORD cntThreads;
+    DWORD th32ParentProcessID;
+    LONG pcPriClassBase;
+    DWORD dwFlags;
+    CHAR szExeFile[MAX_PATH];
+  } PROCESSENTRY32;
+  typedef PROCESSENTRY32 *PPROCESSENTRY32;
+  typedef PROCESSENTRY32 *LPPROCESSENTRY32;
+
+/* From winbase.h */
+  typedef struct COPYFILE2_EXTENDED_PARAMETERS {
+    DWORD dwSize;
+    DWORD dwCopyFlags;
+    WINBOOL *pfCancel;
+    PCOPYFILE2_PROGRESS_ROUTINE pProgressRoutine;
+    PVOID pvCallbackContext;
+  } COPYFILE2_EXTENDED_PARAMETERS;
+
+/* From wininet.h */
+  typedef struct _INTERNET_CACHE_ENTRY_INFOW {
+    DWORD dwStructSize;
+    LPWSTR lpszSourceUrlName;
+    LPWSTR lpszLocalFileName;
+    DWORD CacheEntryType;
+    DWORD dwUseCount;
+    DWORD dwHitRate;
+    DWORD dwSizeLow;
+    DWORD dwSizeHigh;
+    FILETIME LastModifiedTime;
+    FILETIME ExpireTime;
+    FILETIME LastAccessTime;
+    FILETIME LastSyncTime;
+    LPWSTR lpHeaderInfo;
+    DWORD dwHeaderInfoSize;
+    LPWSTR lpszFileExtension;
+    __C89_NAMELESS union {
+      DWORD dwReserved;
+      DWORD dwExemptDelta;
+    };
+  } INTERNET_CACHE_ENTRY_INFOW,*LPINTERNET_CACHE_ENTRY_INFOW;
+
+/* From winhttp.h */
+typedef struct
+{
+  DWORD dwAccessType;
+  LPWSTR lpszProxy;
+  LPWSTR lpszProxyBypass;
+} WINHTTP_PROXY_INFO, *LPWINHTTP_PROXY_INFO;
+
+/* Edge cases */
+typedef enum _MIXED_KIND {
+  MixedNone = 0,
+  MixedSome
+} MIXED_KIND;
+
+typedef DWORD (WINAPI *PMIXED_ROUTINE)(LPVOID lpParam);
+
+typedef WORD MIXED_ID;
+
+typedef struct _MIXED {
+  BYTE bFlag;
+  ULONGLONG ullValue; // 8-byte aligned on 386 too
+  WORD wFirst, wSecond;
+  MIXED_ID idMixed;
+  MIXED_KIND Kind;
+  PMIXED_ROUTINE pfnRoutine;
+  struct _MIXED *pNext;
+  WCHAR szName[NAME_LEN + 1];
+  union {
+    BYTE Bytes[6];
+    LPVOID lpvData;
+  } DUMMYUNIONNAME;
+  PROCESSENTRY32 Entry;
+  BOOLEAN fLast;
+} MIXED, *PMIXED;
+
+/* Not generated, as bit fields are not supported */
+typedef struct _BITS {
+  DWORD Low : 16;
+  DWORD High : 16;
+} BITS;
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif
@@ -0,0 +1,49 @@
+/**
+ * Fixture for the syscall generator golden file. The prototypes are
+ * copied from mingw-w64 headers, and use types from structs.h. From
+ * the tools directory, run "go test" to check the golden files, or
+ * add "-args -update" to regenerate them.
+ */
+#ifndef _SYSCALLS_H
+#define _SYSCALLS_H
+
+#define BOOLAPI INTERNETAPI_(WINBOOL)
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct COPYFILE2_MESSAGE COPYFILE2_MESSAGE;
+typedef DWORD (WINAPI *PCOPYFILE2_PROGRESS_ROUTINE) (const COPYFILE2_MESSAGE *pMessage, PVOID pvCallbackContext);
+typedef LPVOID HINTERNET;
+
+/* From winbase.h, which fails if it doesn't return NULL */
+  WINBASEAPI HGLOBAL WINAPI GlobalFree (HGLOBAL hMem);
+
+/* From heapapi.h */
+  WINBASEAPI LPVOID WINAPI HeapAlloc (HANDLE hHeap, DWORD dwFlags, SIZE_T dwBytes);
+
+/* From debugapi.h */
+  WINBASEAPI VOID WINAPI OutputDebugStringW (LPCWSTR lpOutputString);
+
+/* From tlhelp32.h */
+  HANDLE WINAPI CreateToolhelp32Snapshot(DWORD dwFlags,DWORD th32ProcessID);
+  WINBOOL WINAPI Process32First(HANDLE hSnapshot,LPPROCESSENTRY32 lppe);
+
+/* From winbase.h */
+  WINBASEAPI HRESULT WINAPI CopyFile2 (PCWSTR pwszExistingFileName, PCWSTR pwszNewFileName, COPYFILE2_EXTENDED_PARAMETERS *pExtendedParameters);
+
+/* From winhttp.h, with an unnamed param */
+BOOLAPI WinHttpQueryDataAvailable (HINTERNET, LPDWORD lpdwNumberOfBytesAvailable);
+
+/* From winternl.h */
+  __kernel_entry NTSTATUS NTAPI NtClose(_In_ HANDLE Handle);
+
+/* Not a prototype */
+#define HeapAlloc(h, f, b) HeapAlloc(h, f, b)
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif
@@ -0,0 +1,187 @@
+//go:build windows
+
+package winhttp
+
+import (
+	goerrors "errors"
+	"net/http"
+	"sort"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+)
+
+// Error is returned when WinHTTP fails to make a request. It
+// implements net.Error and can be compared to the Err* sentinels
+// using errors.Is.
+type Error struct {
+	// Code is the ERROR_WINHTTP_* value returned by WinHTTP, or the
+	// HTTP status code for ErrProxyAuthRequired.
+	Code uintptr
+
+	// Flags are the WINHTTP_CALLBACK_STATUS_FLAG_* values describing
+	// why a secure connection failed, if any.
+	Flags uintptr
+
+	err  error
+	kind error
+}
+
+// Sentinel errors for common WinHTTP failures
+var (
+	ErrCannotConnect     error = errors.New("cannot connect")
+	ErrNameNotResolved   error = errors.New("name not resolved")
+	ErrProxyAuthRequired error = errors.New("proxy auth required")
+	ErrSecureFailure     error = errors.New("secure failure")
+	ErrTimeout           error = errors.New("timeout")
+)
+
+//nolint:lll // WinHTTP constant names are long
+var (
+	// Cert error flags, indexed by value
+	certErrors map[uintptr]string = map[uintptr]string{
+		w32.WinhttpCallbackStatusFlagCertCnInvalid:        "CERT_CN_INVALID",
+		w32.WinhttpCallbackStatusFlagCertDateInvalid:      "CERT_DATE_INVALID",
+		w32.WinhttpCallbackStatusFlagCertRevFailed:        "CERT_REV_FAILED",
+		w32.WinhttpCallbackStatusFlagCertRevoked:          "CERT_REVOKED",
+		w32.WinhttpCallbackStatusFlagCertWrongUsage:       "CERT_WRONG_USAGE",
+		w32.WinhttpCallbackStatusFlagInvalidCa:            "INVALID_CA",
+		w32.WinhttpCallbackStatusFlagInvalidCert:          "INVALID_CERT",
+		w32.WinhttpCallbackStatusFlagSecurityChannelError: "SECURITY_CHANNEL_ERROR",
+	}
+	// Cert error flags implied by specific error codes
+	certErrorCodes map[uintptr]uintptr = map[uintptr]uintptr{
+		w32.WinhttpErrorWinhttpSecureCertCnInvalid:   w32.WinhttpCallbackStatusFlagCertCnInvalid,
+		w32.WinhttpErrorWinhttpSecureCertDateInvalid: w32.WinhttpCallbackStatusFlagCertDateInvalid,
+		w32.WinhttpErrorWinhttpSecureCertRevFailed:   w32.WinhttpCallbackStatusFlagCertRevFailed,
+		w32.WinhttpErrorWinhttpSecureCertRevoked:     w32.WinhttpCallbackStatusFlagCertRevoked,
+		w32.WinhttpErrorWinhttpSecureCertWrongUsage:  w32.WinhttpCallbackStatusFlagCertWrongUsage,
+		w32.WinhttpErrorWinhttpSecureChannelError:    w32.WinhttpCallbackStatusFlagSecurityChannelError,
+		w32.WinhttpErrorWinhttpSecureInvalidCa:       w32.WinhttpCallbackStatusFlagInvalidCa,
+		w32.WinhttpErrorWinhttpSecureInvalidCert:     w32.WinhttpCallbackStatusFlagInvalidCert,
+	}
+	// Sentinel errors, indexed by ERROR_WINHTTP_* value
+	errKinds map[uintptr]error = map[uintptr]error{
+		w32.WinhttpErrorWinhttpCannotConnect:         ErrCannotConnect,
+		w32.WinhttpErrorWinhttpConnectionError:       ErrCannotConnect,
+		w32.WinhttpErrorWinhttpNameNotResolved:       ErrNameNotResolved,
+		w32.WinhttpErrorWinhttpSecureCertCnInvalid:   ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureCertDateInvalid: ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureCertRevFailed:   ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureCertRevoked:     ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureCertWrongUsage:  ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureChannelError:    ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureFailure:         ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureFailureProxy:    ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureInvalidCa:       ErrSecureFailure,
+		w32.WinhttpErrorWinhttpSecureInvalidCert:     ErrSecureFailure,
+		w32.WinhttpErrorWinhttpTimeout:               ErrTimeout,
+	}
+	// Cert error flags reported by the status callback, indexed by
+	// request handle
+	secureFailures sync.Map
+	// Status callback to capture cert error flags
+	statusCallback uintptr = windows.NewCallback(
+		func(
+			hndl uintptr,
+			_ uintptr,
+			status uintptr,
+			info *uint32,
+			_ uintptr,
+		) uintptr {
+			status = uintptr(uint32(status))
+
+			if info == nil {
+				return 0
+			}
+
+			if status == w32.WinhttpCallbackStatusSecureFailure {
+				secureFailures.Store(hndl, uintptr(*info))
+			}
+
+			return 0
+		},
+	)
+)
+
+// newError will wrap the provided error with an Error, if it
+// contains a WinHTTP error code. Otherwise, the error is returned
+// unchanged.
+func newError(reqHndl uintptr, e error) error {
+	var code syscall.Errno
+	var flags any
+	var winErr *Error
+
+	if (e == nil) || !goerrors.As(e, &code) {
+		return e
+	}
+
+	winErr = &Error{Code: uintptr(code), err: e}
+	winErr.kind = errKinds[winErr.Code]
+
+	if winErr.kind == ErrSecureFailure {
+		flags, _ = secureFailures.LoadAndDelete(reqHndl)
+		if flags != nil {
+			winErr.Flags, _ = flags.(uintptr)
+		}
+
+		winErr.Flags |= certErrorCodes[winErr.Code]
+	}
+
+	return winErr
+}
+
+func newProxyAuthError(res *http.Response) error {
+	_ = res.Body.Close()
+
+	return &Error{
+		Code: uintptr(res.StatusCode),
+		err:  errors.New(res.Status),
+		kind: ErrProxyAuthRequired,
+	}
+}
+
+// CertErrors will return the names of the cert error flags, if any.
+func (e *Error) CertErrors() []string {
+	var out []string
+
+	for flag, name := range certErrors {
+		if e.Flags&flag == flag {
+			out = append(out, name)
+		}
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// Error will return the error message.
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Is will return true if the target is the matching sentinel error.
+func (e *Error) Is(target error) bool {
+	return (e.kind != nil) && (target == e.kind)
+}
+
+// Temporary is deprecated in net.Error, but is implemented to
+// satisfy the interface. It returns the same value as Timeout().
+func (e *Error) Temporary() bool {
+	return e.Timeout()
+}
+
+// Timeout will return true if the request timed out.
+func (e *Error) Timeout() bool {
+	return e.kind == ErrTimeout
+}
+
+// Unwrap will return the underlying error.
+func (e *Error) Unwrap() error {
+	return e.err
+}
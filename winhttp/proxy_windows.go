@@ -0,0 +1,371 @@
+//go:build windows
+
+package winhttp
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+	"golang.org/x/sys/windows"
+)
+
+// ProxyResult is the proxy configuration that Windows resolved for
+// a URL.
+type ProxyResult struct {
+	// Bypass is the list of hosts that shouldn't be proxied.
+	Bypass []string
+
+	// Direct is true if no proxy should be used.
+	Direct bool
+
+	// PACError is why the PAC script couldn't be used, if at all. The
+	// static settings are used instead.
+	PACError error
+
+	// PACURL is the proxy auto-config script URL, if any. It is
+	// either configured or discovered using WPAD.
+	PACURL string
+
+	// Proxies is the ordered list of proxies to try.
+	Proxies []*url.URL
+}
+
+// cachedPAC is a cached WPAD discovery result.
+type cachedPAC struct {
+	e       error
+	expires time.Time
+	url     string
+}
+
+// cachedProxy is a cached ProxyFromWindows result.
+type cachedProxy struct {
+	expires time.Time
+	proxy   *url.URL
+}
+
+// ieConfig is the current user's Internet Options proxy config.
+type ieConfig struct {
+	autoDetect bool
+	bypass     string
+	pacURL     string
+	proxy      string
+}
+
+// proxyTTL is how long WPAD discovery and ProxyFromWindows results
+// are cached, as both can take seconds.
+const proxyTTL time.Duration = 5 * time.Minute
+
+var (
+	// WPAD discovery result, if any
+	pacCache *cachedPAC
+	// Synchronizes WPAD discovery, which only one request needs to do
+	pacMutex sync.Mutex
+	// ProxyFromWindows results, indexed by scheme and host
+	proxyCache sync.Map
+	proxyErr   error
+	proxyHndl  uintptr
+	proxyOnce  sync.Once
+)
+
+// ProxyFromWindows will return the proxy to use for the provided
+// request, as resolved by ResolveProxy. It is compatible with
+// net/http.Transport.Proxy, so pure-Go clients can honor the Windows
+// proxy settings. A nil URL means no proxy should be used. Results
+// are cached per scheme and host for 5 minutes, so PAC rules that
+// depend on the path or query aren't honored.
+func ProxyFromWindows(req *http.Request) (*url.URL, error) {
+	var c *cachedProxy
+	var e error
+	var key string = req.URL.Scheme + "://" + req.URL.Host
+	var ok bool
+	var res *ProxyResult
+	var v any
+
+	if v, ok = proxyCache.Load(key); ok {
+		c, _ = v.(*cachedProxy)
+	}
+
+	if (c != nil) && time.Now().Before(c.expires) {
+		return c.proxy, nil
+	}
+
+	if res, e = ResolveProxy(req.URL.String()); e != nil {
+		return nil, e
+	}
+
+	c = &cachedProxy{
+		expires: time.Now().Add(proxyTTL),
+		proxy:   usableProxy(res),
+	}
+
+	proxyCache.Store(key, c)
+
+	return c.proxy, nil
+}
+
+// ResolveProxy will return the proxy configuration Windows would use
+// for the provided URL. The current user's Internet Options are
+// used. If a PAC script is configured or discovered with WPAD, it is
+// evaluated. Otherwise, the static proxy and bypass list are used.
+// WPAD discovery is cached for 5 minutes.
+func ResolveProxy(uri string) (*ProxyResult, error) {
+	var bypass string
+	var cfg *ieConfig
+	var e error
+	var proxy string
+	var res *ProxyResult = &ProxyResult{}
+	var u *url.URL
+
+	if u, e = url.Parse(uri); e != nil {
+		return nil, errors.Newf("failed to parse URL: %w", e)
+	}
+
+	// No Internet Options (e.g. a service account) means auto-detect
+	if cfg, e = getIEConfig(); e != nil {
+		cfg = &ieConfig{autoDetect: true}
+	}
+
+	res.PACURL = cfg.pacURL
+
+	// Discover PAC script with WPAD
+	if (res.PACURL == "") && cfg.autoDetect {
+		res.PACURL, res.PACError = detectPAC()
+	}
+
+	if res.PACURL != "" {
+		proxy, bypass, res.PACError = evalPAC(uri, res.PACURL)
+		if res.PACError == nil {
+			res.Bypass = splitList(bypass)
+			res.Proxies = parseProxies(proxy, u.Scheme)
+			res.Direct = len(res.Proxies) == 0
+
+			return res, nil
+		}
+	}
+
+	// Fallback to static config
+	res.Bypass = splitList(cfg.bypass)
+
+	if !bypassed(u.Hostname(), res.Bypass) {
+		res.Proxies = parseProxies(cfg.proxy, u.Scheme)
+	}
+
+	res.Direct = len(res.Proxies) == 0
+
+	return res, nil
+}
+
+func bypassed(host string, bypass []string) bool {
+	var local bool
+
+	host = strings.ToLower(host)
+	local = !strings.Contains(host, ".") && (net.ParseIP(host) == nil)
+
+	for _, pattern := range bypass {
+		pattern = strings.ToLower(pattern)
+
+		// Strip scheme, if provided
+		if _, after, ok := strings.Cut(pattern, "://"); ok {
+			pattern = after
+		}
+
+		switch {
+		case pattern == "<local>":
+			// Hosts without a dot, which aren't IPs
+			if local {
+				return true
+			}
+		case strings.HasPrefix(pattern, "."):
+			if strings.HasSuffix(host, pattern) {
+				return true
+			}
+		default:
+			if ok, _ := path.Match(pattern, host); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// detectPAC will return the PAC script URL discovered with WPAD,
+// using DHCP and DNS. Results, including failures, are cached, as
+// discovery can take seconds when there is no PAC script.
+func detectPAC() (string, error) {
+	var e error
+	var flags uintptr
+	var now time.Time = time.Now()
+
+	pacMutex.Lock()
+	defer pacMutex.Unlock()
+
+	if (pacCache != nil) && now.Before(pacCache.expires) {
+		return pacCache.url, pacCache.e
+	}
+
+	flags = w32.WinhttpAutoDetectTypeDhcp
+	flags |= w32.WinhttpAutoDetectTypeDnsA
+
+	pacCache = &cachedPAC{expires: now.Add(proxyTTL)}
+
+	pacCache.url, e = w32.WinHTTPDetectAutoProxyConfigURL(flags)
+	if e != nil {
+		pacCache.e = errors.Newf("failed to detect PAC: %w", e)
+	}
+
+	return pacCache.url, pacCache.e
+}
+
+// evalPAC will evaluate the PAC script at the provided URL, using
+// WinHTTP, and return the proxy and bypass lists.
+func evalPAC(uri string, pacURL string) (string, string, error) {
+	var access uintptr
+	var e error
+	var info w32.WinHTTPProxyInfo
+	var opts w32.WinHTTPAutoProxyOptions
+
+	opts.AutoConfigURL = windows.StringToUTF16Ptr(pacURL)
+	opts.AutoLogonIfChallenged = 1
+	opts.Flags = uint32(w32.WinhttpAutoproxyConfigUrl)
+
+	proxyOnce.Do(
+		func() {
+			proxyHndl, proxyErr = w32.WinHTTPOpen(
+				"",
+				w32.WinhttpAccessTypeNoProxy,
+				"",
+				"",
+				0,
+			)
+		},
+	)
+
+	if proxyErr != nil {
+		e = errors.Newf("failed to create session: %w", proxyErr)
+		return "", "", e
+	}
+
+	e = w32.WinHTTPGetProxyForURL(proxyHndl, uri, &opts, &info)
+	if e != nil {
+		return "", "", errors.Newf("failed to evaluate PAC: %w", e)
+	}
+	defer info.Free()
+
+	// No proxy means direct
+	access = uintptr(info.AccessType)
+	if access == w32.WinhttpAccessTypeNoProxy {
+		return "", "", nil
+	}
+
+	return windows.UTF16PtrToString(info.Proxy),
+		windows.UTF16PtrToString(info.ProxyBypass),
+		nil
+}
+
+func getIEConfig() (*ieConfig, error) {
+	var cfg w32.WinHTTPCurrentUserIEProxyConfig
+	var e error
+
+	if e = w32.WinHTTPGetIEProxyConfigForCurrentUser(&cfg); e != nil {
+		return nil, errors.Newf("failed to get proxy config: %w", e)
+	}
+	defer cfg.Free()
+
+	return &ieConfig{
+		autoDetect: cfg.AutoDetect != 0,
+		bypass:     windows.UTF16PtrToString(cfg.ProxyBypass),
+		pacURL:     windows.UTF16PtrToString(cfg.AutoConfigURL),
+		proxy:      windows.UTF16PtrToString(cfg.Proxy),
+	}, nil
+}
+
+// parseProxies will parse a Windows proxy list, which may contain
+// per-scheme entries (e.g. "http=a:8080;https=b:8080"), and return
+// the proxies for the provided scheme. A "socks=" entry is a SOCKS4
+// proxy.
+func parseProxies(list string, scheme string) []*url.URL {
+	var key string
+	var ok bool
+	var proxies []*url.URL
+	var socks []*url.URL
+	var u *url.URL
+	var val string
+
+	for _, entry := range splitList(list) {
+		if key, val, ok = strings.Cut(entry, "="); !ok {
+			key, val = "", entry
+		}
+
+		switch strings.ToLower(key) {
+		case "", strings.ToLower(scheme):
+			if u = proxyURL(val, "http"); u != nil {
+				proxies = append(proxies, u)
+			}
+		case "socks":
+			if u = proxyURL(val, "socks4"); u != nil {
+				socks = append(socks, u)
+			}
+		}
+	}
+
+	// Only use SOCKS if nothing else is configured
+	if len(proxies) == 0 {
+		return socks
+	}
+
+	return proxies
+}
+
+func proxyURL(proxy string, scheme string) *url.URL {
+	var e error
+	var u *url.URL
+
+	if !strings.Contains(proxy, "://") {
+		proxy = scheme + "://" + proxy
+	}
+
+	if u, e = url.Parse(proxy); (e != nil) || (u.Host == "") {
+		return nil
+	}
+
+	return u
+}
+
+// splitList will split a Windows list, which may be separated by
+// semicolons or whitespace.
+func splitList(list string) []string {
+	return strings.FieldsFunc(
+		list,
+		func(r rune) bool {
+			return (r == ';') || (r == ' ') || (r == '\t') ||
+				(r == '\r') || (r == '\n')
+		},
+	)
+}
+
+// usableProxy will return the first proxy that net/http supports, or
+// nil if no proxy should be used. SOCKS4 proxies aren't supported, so
+// they are skipped, like WinHTTP skips proxies it can't use. If none
+// are usable, the request is sent directly.
+func usableProxy(res *ProxyResult) *url.URL {
+	if res.Direct {
+		return nil
+	}
+
+	for _, u := range res.Proxies {
+		switch u.Scheme {
+		case "http", "https", "socks5", "socks5h":
+			return u
+		}
+	}
+
+	return nil
+}
@@ -6,22 +6,32 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
+	"unsafe"
 
 	"github.com/mjwhitta/errors"
 	w32 "github.com/mjwhitta/win/api"
+	"golang.org/x/sys/windows"
 )
 
+// dataWriter is an io.Writer for streaming a request body.
+type dataWriter uintptr
+
+// maxWrite is the largest write that fits in a DWORD and an int on
+// all archs.
+const maxWrite int = math.MaxInt32
+
 func buildRequest(
 	sessionHndl uintptr,
 	req *http.Request,
 	timeout time.Duration,
-) (uintptr, error) {
+) (uintptr, uintptr, error) {
 	var b []byte
 	var connHndl uintptr
 	var e error
@@ -36,7 +46,7 @@ func buildRequest(
 	}
 
 	if req.URL.Scheme == "https" {
-		flags = w32.Winhttp.WinhttpFlagSecure
+		flags = w32.WinhttpFlagSecure
 	}
 
 	// Create connection
@@ -46,7 +56,8 @@ func buildRequest(
 		int(port),
 	)
 	if e != nil {
-		return 0, errors.Newf("failed to create connection: %w", e)
+		e = errors.Newf("failed to create connection: %w", e)
+		return 0, 0, e
 	}
 
 	// Send query string too
@@ -70,43 +81,48 @@ func buildRequest(
 		flags,
 	)
 	if e != nil {
-		return 0, errors.Newf("failed to open request: %w", e)
+		_ = w32.WinHTTPCloseHandle(connHndl)
+		return 0, 0, errors.Newf("failed to open request: %w", e)
 	}
 
 	// Don't redirect
-	flags = w32.Winhttp.WinhttpDisableRedirects
+	flags = w32.WinhttpDisableRedirects
 
 	// Don't let Windows handle cookies
-	flags |= w32.Winhttp.WinhttpDisableCookies
+	flags |= w32.WinhttpDisableCookies
 
 	b = make([]byte, 4) //nolint:mnd // Size of uint32
 	binary.LittleEndian.PutUint32(b, uint32(flags))
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionDisableFeature,
+		w32.WinhttpOptionDisableFeature,
 		b,
 		len(b),
 	)
 	if e != nil {
-		e = errors.Newf("failed to set options: %w", e)
-		return 0, e
+		_ = w32.WinHTTPCloseHandle(reqHndl)
+		_ = w32.WinHTTPCloseHandle(connHndl)
+
+		return 0, 0, errors.Newf("failed to set options: %w", e)
 	}
 
 	if e = setTimeouts(reqHndl, timeout); e != nil {
-		return 0, e
+		_ = w32.WinHTTPCloseHandle(reqHndl)
+		_ = w32.WinHTTPCloseHandle(connHndl)
+
+		return 0, 0, e
 	}
 
-	return reqHndl, nil
+	return connHndl, reqHndl, nil
 }
 
 func buildResponse(
 	reqHndl uintptr,
 	req *http.Request,
 ) (*http.Response, error) {
-	var b []byte
 	var body io.ReadCloser
-	var code int64
+	var code int
 	var contentLen int64
 	var e error
 	var hdrs http.Header
@@ -121,33 +137,10 @@ func buildResponse(
 		return nil, errors.Newf("failed to get response: %w", e)
 	}
 
-	// Get status code
-	b, e = queryResponse(
-		reqHndl,
-		w32.Winhttp.WinhttpQueryStatusCode,
-		0,
-	)
-	if e != nil {
+	if code, status, e = getStatus(reqHndl); e != nil {
 		return nil, e
 	}
 
-	status = string(b)
-	if code, e = strconv.ParseInt(status, 10, 64); e != nil {
-		return nil, errors.Newf("status %s invalid: %w", status, e)
-	}
-
-	// Get status text
-	b, e = queryResponse(
-		reqHndl,
-		w32.Winhttp.WinhttpQueryStatusText,
-		0,
-	)
-	if e != nil {
-		return nil, e
-	} else if len(b) > 0 {
-		status += " " + string(b)
-	}
-
 	// Parse headers and proto
 	if proto, major, minor, hdrs, e = getHeaders(reqHndl); e != nil {
 		return nil, e
@@ -167,7 +160,8 @@ func buildResponse(
 		ProtoMinor:    minor,
 		Request:       req,
 		Status:        status,
-		StatusCode:    int(code),
+		StatusCode:    code,
+		Trailer:       getTrailers(reqHndl, hdrs),
 	}
 
 	return res, nil
@@ -182,6 +176,8 @@ func dbgLog(debug bool, thing any) {
 	}
 
 	switch thing := thing.(type) {
+	case string:
+		println(thing)
 	case *http.Request:
 		if b, e = httputil.DumpRequestOut(thing, true); e == nil {
 			println(string(b))
@@ -196,21 +192,43 @@ func dbgLog(debug bool, thing any) {
 	}
 }
 
+// declaredTrailers will return the trailers declared by the Trailer
+// header, without values.
+func declaredTrailers(hdrs http.Header) http.Header {
+	var trailer http.Header = http.Header{}
+
+	for k, vals := range hdrs {
+		if !strings.EqualFold(k, "Trailer") {
+			continue
+		}
+
+		for _, v := range vals {
+			for _, name := range strings.Split(v, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					trailer[http.CanonicalHeaderKey(name)] = nil
+				}
+			}
+		}
+	}
+
+	return trailer
+}
+
 func disableTLS(reqHndl uintptr) error {
 	var b []byte = make([]byte, 4) //nolint:mnd // Size of uint32
 	var e error
 	var tmp uintptr
 
-	tmp |= w32.Winhttp.SecurityFlagIgnoreUnknownCa
-	tmp |= w32.Winhttp.SecurityFlagIgnoreCertDateInvalid
-	tmp |= w32.Winhttp.SecurityFlagIgnoreCertCnInvalid
-	tmp |= w32.Winhttp.SecurityFlagIgnoreCertWrongUsage
+	tmp |= w32.WinhttpSecurityFlagIgnoreUnknownCa
+	tmp |= w32.WinhttpSecurityFlagIgnoreCertDateInvalid
+	tmp |= w32.WinhttpSecurityFlagIgnoreCertCnInvalid
+	tmp |= w32.WinhttpSecurityFlagIgnoreCertWrongUsage
 
 	binary.LittleEndian.PutUint32(b, uint32(tmp))
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionSecurityFlags,
+		w32.WinhttpOptionSecurityFlags,
 		b,
 		len(b),
 	)
@@ -222,6 +240,71 @@ func disableTLS(reqHndl uintptr) error {
 	return nil
 }
 
+// expectContinue will return true if the request has an
+// "Expect: 100-continue" header.
+func expectContinue(req *http.Request) bool {
+	return strings.EqualFold(
+		strings.TrimSpace(req.Header.Get("Expect")),
+		"100-continue",
+	)
+}
+
+// getProxy will return the proxy selected by the Client's Proxies or
+// Proxy func, or else the Transport's, in the Windows proxy format.
+// The first supported entry is used, so unsupported proxies fall back
+// to the next one. A nil URL from the Client's means no proxy, so the
+// proxy is empty. A nil URL from the Transport's (e.g.
+// http.ProxyFromEnvironment with no env vars) is ignored. If ok is
+// false, the system settings are used.
+func getProxy(
+	proxies func(req *http.Request) ([]*url.URL, error),
+	proxy func(req *http.Request) (*url.URL, error),
+	trans http.RoundTripper,
+	req *http.Request,
+) (string, bool, error) {
+	var e error
+	var list []*url.URL
+	var ok bool
+	var s string
+	var t *http.Transport
+	var u *url.URL
+
+	switch {
+	case proxies != nil:
+		list, e = proxies(req)
+	case proxy != nil:
+		u, e = proxy(req)
+		list = []*url.URL{u}
+	default:
+		// Only the Client's funcs can choose no proxy
+		if t, ok = trans.(*http.Transport); !ok || (t.Proxy == nil) {
+			return "", false, nil
+		}
+
+		if u, e = t.Proxy(req); (e == nil) && (u == nil) {
+			return "", false, nil
+		}
+
+		list = []*url.URL{u}
+	}
+
+	if e != nil {
+		return "", false, errors.Newf("failed to get proxy: %w", e)
+	}
+
+	for _, u = range list {
+		if u == nil {
+			return "", true, nil
+		}
+
+		if s, ok = proxyString(u); ok {
+			return s, true, nil
+		}
+	}
+
+	return "", false, errors.Newf("no supported proxy in %v", list)
+}
+
 func getHeaders(
 	reqHndl uintptr,
 ) (string, int, int, http.Header, error) {
@@ -235,7 +318,7 @@ func getHeaders(
 	// Get headers
 	b, e = queryResponse(
 		reqHndl,
-		w32.Winhttp.WinhttpQueryRawHeadersCRLF,
+		w32.WinhttpQueryRawHeadersCRLF,
 		0,
 	)
 	if e != nil {
@@ -271,6 +354,71 @@ func getHeaders(
 	return proto, int(major), int(minor), hdrs, nil
 }
 
+func getStatus(reqHndl uintptr) (int, string, error) {
+	var b []byte
+	var code int64
+	var e error
+	var status string
+
+	// Get status code
+	b, e = queryResponse(
+		reqHndl,
+		w32.WinhttpQueryStatusCode,
+		0,
+	)
+	if e != nil {
+		return 0, "", e
+	}
+
+	status = string(b)
+	if code, e = strconv.ParseInt(status, 10, 64); e != nil {
+		return 0, "", errors.Newf("status %s invalid: %w", status, e)
+	}
+
+	// Get status text
+	b, e = queryResponse(
+		reqHndl,
+		w32.WinhttpQueryStatusText,
+		0,
+	)
+	if e != nil {
+		return 0, "", e
+	} else if len(b) > 0 {
+		status += " " + string(b)
+	}
+
+	return int(code), status, nil
+}
+
+// getTrailers will return the trailers, which are only available
+// once the body has been read. Trailers declared by the Trailer
+// header are included, even if WinHTTP can't provide their values
+// (trailers require Windows 10 2004 or newer).
+func getTrailers(reqHndl uintptr, hdrs http.Header) http.Header {
+	var b []byte
+	var e error
+	var trailer http.Header = declaredTrailers(hdrs)
+
+	b, e = queryResponse(
+		reqHndl,
+		w32.WinhttpQueryRawHeadersCRLF|w32.WinhttpQueryFlagTrailers,
+		0,
+	)
+	if e == nil {
+		for _, line := range strings.Split(string(b), "\r\n") {
+			if k, v, ok := strings.Cut(line, ":"); ok {
+				trailer.Add(k, strings.TrimSpace(v))
+			}
+		}
+	}
+
+	if len(trailer) == 0 {
+		return nil
+	}
+
+	return trailer
+}
+
 func loadCookies(jar http.CookieJar, req *http.Request) {
 	if jar == nil {
 		return
@@ -281,6 +429,46 @@ func loadCookies(jar http.CookieJar, req *http.Request) {
 	}
 }
 
+func openSession(agent string) (uintptr, error) {
+	var e error
+	var hndl uintptr
+
+	hndl, e = w32.WinHTTPOpen(
+		agent,
+		w32.WinhttpAccessTypeAutomaticProxy,
+		"",
+		"",
+		0,
+	)
+	if e != nil {
+		return 0, errors.Newf("failed to create session: %w", e)
+	}
+
+	// Capture cert errors for secure failures
+	e = w32.WinHTTPSetStatusCallback(
+		hndl,
+		statusCallback,
+		w32.WinhttpCallbackFlagSecureFailure,
+	)
+	if e != nil {
+		_ = w32.WinHTTPCloseHandle(hndl)
+		return 0, errors.Newf("failed to set callback: %w", e)
+	}
+
+	return hndl, nil
+}
+
+// proxyString will return a proxy URL in the WinHTTP proxy format, or
+// false if WinHTTP doesn't support it.
+func proxyString(u *url.URL) (string, bool) {
+	switch u.Scheme {
+	case "", "http":
+		return u.Host, true
+	default:
+		return "", false
+	}
+}
+
 func queryResponse(reqHndl, info uintptr, idx int) ([]byte, error) {
 	var buffer []byte
 	var e error
@@ -363,18 +551,72 @@ func readResponse(reqHndl uintptr) (io.ReadCloser, int64, error) {
 	return io.NopCloser(bytes.NewReader(b)), contentLen, nil
 }
 
+// redirectRequest will return the request to send for a redirect,
+// or nil if it shouldn't be followed. Like net/http, 301, 302, and
+// 303 are followed with GET (or HEAD), and 307 and 308 keep the
+// method and body, but only if the body can be sent again (see
+// http.Request.GetBody).
+func redirectRequest(
+	req *http.Request,
+	code int,
+	loc *url.URL,
+) (*http.Request, error) {
+	var body io.ReadCloser
+	var e error
+	var method string = req.Method
+	var next *http.Request
+
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound,
+		http.StatusSeeOther:
+		if method != http.MethodHead {
+			method = http.MethodGet
+		}
+	case http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		if req.GetBody != nil {
+			if body, e = req.GetBody(); e != nil {
+				return nil, errors.Newf("failed to reset body: %w", e)
+			}
+		} else if (req.Body != nil) && (req.Body != http.NoBody) {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	next, e = http.NewRequestWithContext(
+		req.Context(),
+		method,
+		loc.String(),
+		body,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	if body != nil {
+		next.ContentLength = req.ContentLength
+		next.GetBody = req.GetBody
+
+		if v := req.Header.Get("Content-Type"); v != "" {
+			next.Header.Set("Content-Type", v)
+		}
+	}
+
+	return next, nil
+}
+
 func sendRequest(
 	reqHndl uintptr,
 	req *http.Request,
 ) (*http.Response, error) {
-	var b []byte
 	var e error
 	var method uintptr
 	var res *http.Response
 
 	// Process cookies
-	method = w32.Winhttp.WinhttpAddreqFlagAdd
-	method |= w32.Winhttp.WinhttpAddreqFlagCoalesceWithSemicolon
+	method = w32.WinhttpAddreqFlagAdd
+	method |= w32.WinhttpAddreqFlagCoalesceWithSemicolon
 
 	for _, c := range req.Cookies() {
 		e = w32.WinHTTPAddRequestHeaders(
@@ -388,8 +630,8 @@ func sendRequest(
 	}
 
 	// Process headers
-	method = w32.Winhttp.WinhttpAddreqFlagAdd
-	method |= w32.Winhttp.WinhttpAddreqFlagReplace
+	method = w32.WinhttpAddreqFlagAdd
+	method |= w32.WinhttpAddreqFlagReplace
 
 	for k := range req.Header {
 		e = w32.WinHTTPAddRequestHeaders(
@@ -403,22 +645,22 @@ func sendRequest(
 		}
 	}
 
-	if req.Body != nil {
-		if b, e = io.ReadAll(req.Body); e != nil {
-			e = errors.Newf("failed to read request body: %w", e)
-			return nil, e
-		}
-
-		if e = req.Body.Close(); e != nil {
-			e = errors.Newf("failed to close request body: %w", e)
-			return nil, e
-		}
+	// Send HTTP request, streaming body if length is known
+	if (req.Body != nil) && (req.ContentLength > 0) {
+		e = streamRequest(reqHndl, req)
+	} else {
+		e = writeRequest(reqHndl, req)
 	}
 
-	// Send HTTP request
-	e = w32.WinHTTPSendRequest(reqHndl, "", 0, b, len(b))
 	if e != nil {
-		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		// With "Expect: 100-continue", the server may reject the
+		// request before reading the body, so return its response
+		if expectContinue(req) {
+			if res, err := buildResponse(reqHndl, req); err == nil {
+				return res, nil
+			}
+		}
+
 		return nil, e
 	}
 
@@ -429,6 +671,62 @@ func sendRequest(
 	return res, nil
 }
 
+// setClientCert will set the client certificate of a request. The
+// context is copied, so it only needs to be valid for this call.
+func setClientCert(reqHndl uintptr, ctx *windows.CertContext) error {
+	var b []byte = unsafe.Slice(
+		(*byte)(unsafe.Pointer(ctx)),
+		unsafe.Sizeof(*ctx),
+	)
+	var e error
+
+	e = w32.WinHTTPSetOption(
+		reqHndl,
+		w32.WinhttpOptionClientCertContext,
+		b,
+		len(b),
+	)
+	if e != nil {
+		return errors.Newf("failed to set client certificate: %w", e)
+	}
+
+	return nil
+}
+
+// setProxy will set the proxy for the request. An empty proxy means
+// no proxy.
+func setProxy(reqHndl uintptr, proxy string) error {
+	var access uintptr = w32.WinhttpAccessTypeNoProxy
+	var b []byte
+	var e error
+	var info w32.WinHTTPProxyInfo
+
+	if proxy != "" {
+		access = w32.WinhttpAccessTypeNamedProxy
+		info.Proxy = windows.StringToUTF16Ptr(proxy)
+	}
+
+	info.AccessType = uint32(access)
+
+	// Pass the struct as bytes
+	b = unsafe.Slice(
+		(*byte)(unsafe.Pointer(&info)),
+		unsafe.Sizeof(info),
+	)
+
+	e = w32.WinHTTPSetOption(
+		reqHndl,
+		w32.WinhttpOptionProxy,
+		b,
+		len(b),
+	)
+	if e != nil {
+		return errors.Newf("failed to set proxy: %w", e)
+	}
+
+	return nil
+}
+
 func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 	var b []byte
 	var e error
@@ -443,7 +741,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionConnectTimeout,
+		w32.WinhttpOptionConnectTimeout,
 		b,
 		len(b),
 	)
@@ -453,7 +751,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionReceiveResponseTimeout,
+		w32.WinhttpOptionReceiveResponseTimeout,
 		b,
 		len(b),
 	)
@@ -463,7 +761,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionReceiveTimeout,
+		w32.WinhttpOptionReceiveTimeout,
 		b,
 		len(b),
 	)
@@ -473,7 +771,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionResolveTimeout,
+		w32.WinhttpOptionResolveTimeout,
 		b,
 		len(b),
 	)
@@ -483,7 +781,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.WinHTTPSetOption(
 		reqHndl,
-		w32.Winhttp.WinhttpOptionSendTimeout,
+		w32.WinhttpOptionSendTimeout,
 		b,
 		len(b),
 	)
@@ -494,6 +792,50 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 	return nil
 }
 
+func streamRequest(reqHndl uintptr, req *http.Request) (e error) {
+	var n int64
+
+	defer func() {
+		if err := req.Body.Close(); (err != nil) && (e == nil) {
+			e = errors.Newf("failed to close request body: %w", err)
+		}
+	}()
+
+	if req.ContentLength > math.MaxUint32 {
+		return errors.Newf(
+			"request body length %d exceeds 4GiB",
+			req.ContentLength,
+		)
+	}
+
+	// Send headers and total length, but no body yet
+	e = w32.WinHTTPSendRequest(
+		reqHndl,
+		"",
+		0,
+		nil,
+		0,
+		int(req.ContentLength),
+	)
+	if e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return e
+	}
+
+	// Stream body
+	if n, e = io.Copy(dataWriter(reqHndl), req.Body); e != nil {
+		return errors.Newf("failed to write request body: %w", e)
+	} else if n != req.ContentLength {
+		return errors.Newf(
+			"request body length %d does not match %d",
+			n,
+			req.ContentLength,
+		)
+	}
+
+	return nil
+}
+
 func storeCookies(
 	jar http.CookieJar,
 	uri *url.URL,
@@ -517,3 +859,53 @@ func storeCookies(
 
 	return nil
 }
+
+func writeRequest(reqHndl uintptr, req *http.Request) error {
+	var b []byte
+	var e error
+
+	if req.Body != nil {
+		if b, e = io.ReadAll(req.Body); e != nil {
+			return errors.Newf("failed to read request body: %w", e)
+		}
+
+		if e = req.Body.Close(); e != nil {
+			return errors.Newf("failed to close request body: %w", e)
+		}
+	}
+
+	// Send HTTP request
+	e = w32.WinHTTPSendRequest(reqHndl, "", 0, b, len(b), len(b))
+	if e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return e
+	}
+
+	return nil
+}
+
+// Write will write data to the request using WinHttpWriteData, in
+// chunks that fit in a DWORD.
+func (w dataWriter) Write(p []byte) (int, error) {
+	var chunk []byte
+	var e error
+	var n uint32
+	var total int
+
+	for len(p) > 0 {
+		chunk = p[:min(len(p), maxWrite)]
+
+		if e = w32.WinHTTPWriteData(uintptr(w), chunk, &n); e != nil {
+			return total + int(n), e
+		}
+
+		if n == 0 {
+			return total, io.ErrShortWrite
+		}
+
+		total += int(n)
+		p = p[n:]
+	}
+
+	return total, nil
+}
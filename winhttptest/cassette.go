@@ -0,0 +1,272 @@
+package winhttptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/mjwhitta/errors"
+)
+
+// Cassette is a list of recorded HTTP interactions. It implements
+// http.Handler, so it can be served by a Client to replay the
+// recorded traffic. Interactions are matched by method and URL, in
+// the order they were recorded. A request without a match receives a
+// 501 Not Implemented response. A Cassette is safe for concurrent
+// use.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+
+	mutex sync.Mutex
+	used  []bool
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the recorded part of an HTTP request.
+type RecordedRequest struct {
+	Body   string      `json:"body,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+}
+
+// RecordedResponse is the recorded part of an HTTP response.
+type RecordedResponse struct {
+	Body       string      `json:"body,omitempty"`
+	Header     http.Header `json:"header,omitempty"`
+	Proto      string      `json:"proto,omitempty"`
+	Status     string      `json:"status"`
+	StatusCode int         `json:"statusCode"`
+}
+
+// Doer is any client that can send an HTTP request, such as a
+// winhttp.Client, wininet.Client, or http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Recorder wraps a real client and records each interaction, so it
+// can be saved to a cassette file and replayed later. Headers listed
+// in Redact are replaced with "REDACTED" before they are recorded. A
+// Recorder is safe for concurrent use.
+type Recorder struct {
+	Cassette *Cassette
+	Client   Doer
+	Redact   []string
+
+	mutex sync.Mutex
+	path  string
+}
+
+// Load will return a pointer to a new Cassette instance, using the
+// interactions recorded in the provided file.
+func Load(path string) (*Cassette, error) {
+	var b []byte
+	var c *Cassette = &Cassette{}
+	var e error
+
+	if b, e = os.ReadFile(filepath.Clean(path)); e != nil {
+		return nil, errors.Newf("failed to read %s: %w", path, e)
+	}
+
+	if e = json.Unmarshal(b, c); e != nil {
+		return nil, errors.Newf("failed to parse %s: %w", path, e)
+	}
+
+	return c, nil
+}
+
+// NewRecorder will return a pointer to a new Recorder instance,
+// which records traffic sent by the provided client. Save writes the
+// cassette to the provided path. The Authorization and
+// Proxy-Authorization headers are redacted by default.
+func NewRecorder(client Doer, path string) *Recorder {
+	return &Recorder{
+		Cassette: &Cassette{},
+		Client:   client,
+		Redact: []string{
+			"Authorization",
+			"Proxy-Authorization",
+		},
+		path: path,
+	}
+}
+
+// Replay will return a pointer to a new Client instance, which serves
+// requests from the cassette in the provided file.
+func Replay(path string, ua ...string) (*Client, error) {
+	var c *Cassette
+	var e error
+
+	if c, e = Load(path); e != nil {
+		return nil, e
+	}
+
+	return NewClient(c, ua...), nil
+}
+
+func redact(hdrs http.Header, names []string) http.Header {
+	var out http.Header = hdrs.Clone()
+
+	for _, name := range names {
+		if _, ok := out[http.CanonicalHeaderKey(name)]; ok {
+			out.Set(name, "REDACTED")
+		}
+	}
+
+	return out
+}
+
+// Rewind will allow all interactions to be replayed again.
+func (c *Cassette) Rewind() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.used = nil
+}
+
+// ServeHTTP will reply with the next unused interaction matching the
+// request method and URL.
+func (c *Cassette) ServeHTTP(
+	w http.ResponseWriter,
+	req *http.Request,
+) {
+	var i *Interaction
+
+	if i = c.next(req); i == nil {
+		http.Error(
+			w,
+			"no recorded interaction for "+req.Method+" "+
+				fullURL(req),
+			http.StatusNotImplemented,
+		)
+
+		return
+	}
+
+	for k, vals := range i.Response.Header {
+		for _, v := range vals {
+			w.Header().Add(k, v)
+		}
+	}
+
+	w.WriteHeader(i.Response.StatusCode)
+	_, _ = io.WriteString(w, i.Response.Body)
+}
+
+func (c *Cassette) next(req *http.Request) *Interaction {
+	var uri string = fullURL(req)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.used) != len(c.Interactions) {
+		c.used = append(
+			c.used,
+			make([]bool, len(c.Interactions)-len(c.used))...,
+		)
+	}
+
+	for n, i := range c.Interactions {
+		if c.used[n] {
+			continue
+		}
+
+		if !strings.EqualFold(i.Request.Method, req.Method) {
+			continue
+		}
+
+		if i.Request.URL != uri {
+			continue
+		}
+
+		c.used[n] = true
+
+		return i
+	}
+
+	return nil
+}
+
+// Do will send the HTTP request using the wrapped client and record
+// the interaction. The response body is buffered, so it can still be
+// read by the caller.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	var e error
+	var i *Interaction = &Interaction{}
+	var reqBody []byte
+	var res *http.Response
+	var resBody []byte
+
+	if req.Body != nil {
+		if reqBody, e = io.ReadAll(req.Body); e != nil {
+			return nil, errors.Newf("failed to read request: %w", e)
+		}
+
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	if res, e = r.Client.Do(req); e != nil {
+		return nil, e
+	}
+
+	resBody, e = io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	if e != nil {
+		return nil, errors.Newf("failed to read response: %w", e)
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	i.Request = RecordedRequest{
+		Body:   string(reqBody),
+		Header: redact(req.Header, r.Redact),
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	i.Response = RecordedResponse{
+		Body:       string(resBody),
+		Header:     redact(res.Header, r.Redact),
+		Proto:      res.Proto,
+		Status:     res.Status,
+		StatusCode: res.StatusCode,
+	}
+
+	r.mutex.Lock()
+	r.Cassette.Interactions = append(r.Cassette.Interactions, i)
+	r.mutex.Unlock()
+
+	return res, nil
+}
+
+// Save will write the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	var b []byte
+	var e error
+
+	r.mutex.Lock()
+	b, e = json.MarshalIndent(r.Cassette, "", "  ")
+	r.mutex.Unlock()
+
+	if e != nil {
+		return errors.Newf("failed to encode cassette: %w", e)
+	}
+
+	if e = os.WriteFile(r.path, append(b, '\n'), 0o600); e != nil {
+		return errors.Newf("failed to write %s: %w", r.path, e)
+	}
+
+	return nil
+}
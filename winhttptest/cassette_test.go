@@ -0,0 +1,235 @@
+package winhttptest
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// body will read and close the response body.
+func body(t *testing.T, res *http.Response) string {
+	var b []byte
+	var e error
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if b, e = io.ReadAll(res.Body); e != nil {
+		t.Fatal(e)
+	}
+
+	return string(b)
+}
+
+// record will return a cassette file, recorded from a handler that
+// counts the requests for each path.
+func record(t *testing.T) string {
+	var count map[string]int = map[string]int{}
+	var e error
+	var path string = filepath.Join(t.TempDir(), "cassette.json")
+	var rec *Recorder
+	var req *http.Request
+	var res *http.Response
+
+	rec = NewRecorder(
+		NewClient(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					var b []byte
+
+					b, _ = io.ReadAll(r.Body)
+					count[r.Method+" "+r.URL.Path]++
+
+					w.Header().Set("X-Count", "yes")
+					w.WriteHeader(http.StatusCreated)
+					_, _ = io.WriteString(
+						w,
+						r.Method+" "+r.URL.Path+" "+
+							strings.Repeat(
+								"#",
+								count[r.Method+" "+r.URL.Path],
+							)+" "+string(b),
+					)
+				},
+			),
+		),
+		path,
+	)
+
+	for _, test := range []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodGet, "/a", ""},
+		{http.MethodPost, "/a", "data"},
+		{http.MethodGet, "/a", ""},
+		{http.MethodGet, "/b?q=1", ""},
+	} {
+		req, e = http.NewRequest(
+			test.method,
+			"http://example.com"+test.path,
+			strings.NewReader(test.body),
+		)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		req.Header.Set("Authorization", "secret")
+
+		if res, e = rec.Do(req); e != nil {
+			t.Fatal(e)
+		}
+
+		// The body can still be read by the caller
+		if b := body(t, res); !strings.HasSuffix(b, test.body) {
+			t.Errorf("got %q, want suffix %q", b, test.body)
+		}
+	}
+
+	if e = rec.Save(); e != nil {
+		t.Fatal(e)
+	}
+
+	return path
+}
+
+func TestCassetteRecord(t *testing.T) {
+	var c *Cassette
+	var e error
+	var i *Interaction
+
+	if c, e = Load(record(t)); e != nil {
+		t.Fatal(e)
+	}
+
+	if len(c.Interactions) != 4 {
+		t.Fatalf("got %d interactions, want 4", len(c.Interactions))
+	}
+
+	i = c.Interactions[1]
+
+	if (i.Request.Method != http.MethodPost) ||
+		(i.Request.URL != "http://example.com/a") ||
+		(i.Request.Body != "data") {
+		t.Errorf("got %+v", i.Request)
+	}
+
+	if v := i.Request.Header.Get("Authorization"); v != "REDACTED" {
+		t.Errorf("got Authorization %q, want REDACTED", v)
+	}
+
+	if (i.Response.StatusCode != http.StatusCreated) ||
+		(i.Response.Body != "POST /a # data") ||
+		(i.Response.Header.Get("X-Count") != "yes") {
+		t.Errorf("got %+v", i.Response)
+	}
+}
+
+func TestCassetteReplay(t *testing.T) {
+	var c *Client
+	var e error
+	var res *http.Response
+
+	if c, e = Replay(record(t)); e != nil {
+		t.Fatal(e)
+	}
+
+	// Matched by method and URL, in recorded order
+	for _, test := range []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{http.MethodGet, "/a", "GET /a # "},
+		{http.MethodGet, "/b?q=1", "GET /b # "},
+		{http.MethodGet, "/a", "GET /a ## "},
+		{http.MethodPost, "/a", "POST /a # data"},
+		// Used up
+		{http.MethodGet, "/a", ""},
+		// Query must match
+		{http.MethodGet, "/b", ""},
+	} {
+		var req *http.Request
+
+		req, e = http.NewRequest(
+			test.method,
+			"http://example.com"+test.path,
+			nil,
+		)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		if res, e = c.Do(req); e != nil {
+			t.Fatal(e)
+		}
+
+		if test.want == "" {
+			if res.StatusCode != http.StatusNotImplemented {
+				t.Errorf(
+					"%s %s: got %s, want 501",
+					test.method,
+					test.path,
+					res.Status,
+				)
+			}
+
+			_ = res.Body.Close()
+
+			continue
+		}
+
+		if res.StatusCode != http.StatusCreated {
+			t.Errorf(
+				"%s %s: got %s",
+				test.method,
+				test.path,
+				res.Status,
+			)
+		}
+
+		if b := body(t, res); b != test.want {
+			t.Errorf(
+				"%s %s: got %q, want %q",
+				test.method,
+				test.path,
+				b,
+				test.want,
+			)
+		}
+	}
+
+	// Rewind allows replaying again
+	if h, ok := c.Handler.(*Cassette); !ok {
+		t.Fatal("handler isn't a Cassette")
+	} else {
+		h.Rewind()
+	}
+
+	if res, e = c.Get("http://example.com/a"); e != nil {
+		t.Fatal(e)
+	} else if b := body(t, res); b != "GET /a # " {
+		t.Errorf("got %q after Rewind", b)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	var dir string = t.TempDir()
+	var e error
+	var path string = filepath.Join(dir, "bad.json")
+
+	if e = os.WriteFile(path, []byte("{"), 0o600); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, p := range []string{path, filepath.Join(dir, "missing")} {
+		if _, e = Load(p); e == nil {
+			t.Errorf("%s: expected error", p)
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package winhttptest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mjwhitta/errors"
+	"github.com/mjwhitta/win/formdata"
+	"github.com/mjwhitta/win/ratelimit"
+)
+
+// Client is a fake of winhttp.Client and wininet.Client, which serves
+// requests from an http.Handler in-process. It works on any OS, so
+// code built on those clients can be tested on Linux. Like the real
+// clients, it sets the User-Agent, uses the Jar and Limiter, follows
+// redirects like net/http (307 and 308 keep the method and body),
+// and honors the request context and Timeout.
+type Client struct {
+	Debug   bool
+	Handler http.Handler
+	Jar     http.CookieJar
+	Limiter *ratelimit.Limiter
+	Timeout time.Duration
+
+	// Proxies, Proxy, and Transport are ignored, but exist for
+	// parity with the real clients.
+	Proxies   func(req *http.Request) ([]*url.URL, error)
+	Proxy     func(req *http.Request) (*url.URL, error)
+	Transport http.RoundTripper
+
+	ua string
+}
+
+// HTTPClient is the API shared by winhttp.Client, wininet.Client,
+// and Client. Accept it, rather than a concrete client, so that
+// tests can substitute a Client.
+type HTTPClient interface {
+	CloseIdleConnections()
+	Delete(url string) (*http.Response, error)
+	DeleteWithContext(
+		ctx context.Context,
+		url string,
+	) (*http.Response, error)
+	Do(req *http.Request) (*http.Response, error)
+	Get(url string) (*http.Response, error)
+	GetWithContext(
+		ctx context.Context,
+		url string,
+	) (*http.Response, error)
+	Head(url string) (*http.Response, error)
+	HeadWithContext(
+		ctx context.Context,
+		url string,
+	) (*http.Response, error)
+	Options(url string) (*http.Response, error)
+	OptionsWithContext(
+		ctx context.Context,
+		url string,
+	) (*http.Response, error)
+	Patch(
+		url string,
+		contentType string,
+		body io.Reader,
+	) (*http.Response, error)
+	PatchWithContext(
+		ctx context.Context,
+		url string,
+		contentType string,
+		body io.Reader,
+	) (*http.Response, error)
+	Post(
+		url string,
+		contentType string,
+		body io.Reader,
+	) (*http.Response, error)
+	PostForm(url string, data url.Values) (*http.Response, error)
+	PostFormWithContext(
+		ctx context.Context,
+		url string,
+		data url.Values,
+	) (*http.Response, error)
+	PostMultipart(
+		url string,
+		fields url.Values,
+		files map[string][]string,
+	) (*http.Response, error)
+	PostMultipartWithContext(
+		ctx context.Context,
+		url string,
+		fields url.Values,
+		files map[string][]string,
+	) (*http.Response, error)
+	PostWithContext(
+		ctx context.Context,
+		url string,
+		contentType string,
+		body io.Reader,
+	) (*http.Response, error)
+	Put(
+		url string,
+		contentType string,
+		body io.Reader,
+	) (*http.Response, error)
+	PutWithContext(
+		ctx context.Context,
+		url string,
+		contentType string,
+		body io.Reader,
+	) (*http.Response, error)
+}
+
+// NewClient will return a pointer to a new Client instance that
+// serves requests from the provided handler.
+func NewClient(h http.Handler, ua ...string) *Client {
+	if len(ua) == 0 {
+		ua = []string{"Go-http-client/1.1"}
+	}
+
+	return &Client{Handler: h, ua: ua[0]}
+}
+
+// CloseIdleConnections does nothing, as there are no connections.
+func (c *Client) CloseIdleConnections() {}
+
+// Delete will make a DELETE request using the Handler.
+func (c *Client) Delete(url string) (*http.Response, error) {
+	return c.DeleteWithContext(context.Background(), url)
+}
+
+// DeleteWithContext will make a DELETE request with the provided
+// context using the Handler.
+func (c *Client) DeleteWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodDelete, url, "", nil)
+}
+
+// Do will send the HTTP request to the Handler and return its HTTP
+// response.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	var cancel context.CancelFunc
+	var ctx context.Context = req.Context()
+	var e error
+	var next *http.Request
+	var redirect *url.URL
+	var release func()
+	var res *http.Response
+
+	if c.Handler == nil {
+		return nil, errors.New("no handler")
+	}
+
+	if c.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	// Load cookies from cookie jar
+	if c.Jar != nil {
+		for _, cookie := range c.Jar.Cookies(req.URL) {
+			req.AddCookie(cookie)
+		}
+	}
+
+	// Set configured user-agent
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.ua)
+	}
+
+	// Wait for the per-host rate limit and concurrency cap
+	if release, e = c.wait(req); e != nil {
+		return nil, e
+	}
+	defer release()
+
+	dbgLog(c.Debug, req)
+
+	if res, e = serve(ctx, c.Handler, req); e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return nil, e
+	}
+
+	dbgLog(c.Debug, res)
+
+	// Store cookies into cookie jar
+	if c.Jar != nil {
+		if cookies := res.Cookies(); len(cookies) > 0 {
+			c.Jar.SetCookies(req.URL, cookies)
+		}
+	}
+
+	// Follow redirects, which are limited separately
+	if redirect, e = res.Location(); e == nil {
+		next, e = redirectRequest(req, res.StatusCode, redirect)
+		if e != nil {
+			_ = res.Body.Close()
+			return nil, e
+		} else if next != nil {
+			_ = res.Body.Close()
+			release()
+
+			return c.Do(next)
+		}
+	}
+
+	return res, nil
+}
+
+// Get will make a GET request using the Handler.
+func (c *Client) Get(url string) (*http.Response, error) {
+	return c.GetWithContext(context.Background(), url)
+}
+
+// GetWithContext will make a GET request with the provided context
+// using the Handler.
+func (c *Client) GetWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodGet, url, "", nil)
+}
+
+// Head will make a HEAD request using the Handler.
+func (c *Client) Head(url string) (*http.Response, error) {
+	return c.HeadWithContext(context.Background(), url)
+}
+
+// HeadWithContext will make a HEAD request with the provided context
+// using the Handler.
+func (c *Client) HeadWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodHead, url, "", nil)
+}
+
+// Options will make an OPTIONS request using the Handler.
+func (c *Client) Options(url string) (*http.Response, error) {
+	return c.OptionsWithContext(context.Background(), url)
+}
+
+// OptionsWithContext will make an OPTIONS request with the provided
+// context using the Handler.
+func (c *Client) OptionsWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodOptions, url, "", nil)
+}
+
+// Patch will make a PATCH request using the Handler.
+func (c *Client) Patch(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.PatchWithContext(
+		context.Background(),
+		url,
+		contentType,
+		body,
+	)
+}
+
+// PatchWithContext will make a PATCH request with the provided
+// context using the Handler.
+func (c *Client) PatchWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodPatch, url, contentType, body)
+}
+
+// Post will make a POST request using the Handler.
+func (c *Client) Post(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.PostWithContext(
+		context.Background(),
+		url,
+		contentType,
+		body,
+	)
+}
+
+// PostForm will make a POST request using the Handler.
+func (c *Client) PostForm(
+	url string,
+	data url.Values,
+) (*http.Response, error) {
+	return c.PostFormWithContext(context.Background(), url, data)
+}
+
+// PostFormWithContext will make a POST request with the provided
+// context using the Handler.
+func (c *Client) PostFormWithContext(
+	ctx context.Context,
+	url string,
+	data url.Values,
+) (*http.Response, error) {
+	return c.send(
+		ctx,
+		http.MethodPost,
+		url,
+		"application/x-www-form-urlencoded",
+		bytes.NewReader([]byte(data.Encode())),
+	)
+}
+
+// PostMultipart will make a multipart/form-data POST request using
+// the Handler. Files are indexed by form field name.
+func (c *Client) PostMultipart(
+	url string,
+	fields url.Values,
+	files map[string][]string,
+) (*http.Response, error) {
+	return c.PostMultipartWithContext(
+		context.Background(),
+		url,
+		fields,
+		files,
+	)
+}
+
+// PostMultipartWithContext will make a multipart/form-data POST
+// request with the provided context using the Handler. Files are
+// indexed by form field name.
+func (c *Client) PostMultipartWithContext(
+	ctx context.Context,
+	url string,
+	fields url.Values,
+	files map[string][]string,
+) (*http.Response, error) {
+	var body *formdata.Body
+	var e error
+	var req *http.Request
+
+	if body, e = formdata.New(fields, files); e != nil {
+		return nil, errors.Newf("failed to create body: %w", e)
+	}
+
+	req, e = http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		url,
+		body,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	req.ContentLength = body.ContentLength
+	req.Header.Set("Content-Type", body.ContentType())
+
+	return c.Do(req)
+}
+
+// PostWithContext will make a POST request with the provided context
+// using the Handler.
+func (c *Client) PostWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodPost, url, contentType, body)
+}
+
+// Put will make a PUT request using the Handler.
+func (c *Client) Put(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.PutWithContext(
+		context.Background(),
+		url,
+		contentType,
+		body,
+	)
+}
+
+// PutWithContext will make a PUT request with the provided context
+// using the Handler.
+func (c *Client) PutWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodPut, url, contentType, body)
+}
+
+func (c *Client) send(
+	ctx context.Context,
+	method string,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	var e error
+	var req *http.Request
+
+	req, e = http.NewRequestWithContext(ctx, method, url, body)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return c.Do(req)
+}
+
+// wait will block until the Limiter, if any, allows the request. It
+// returns a func to release the request's concurrency slot.
+func (c *Client) wait(req *http.Request) (func(), error) {
+	var e error
+	var release func()
+	var waited time.Duration
+
+	if c.Limiter == nil {
+		return func() {}, nil
+	}
+
+	release, waited, e = c.Limiter.Wait(
+		req.Context(),
+		req.URL.Hostname(),
+	)
+	if e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return nil, e
+	}
+
+	if waited > 0 {
+		dbgLog(
+			c.Debug,
+			fmt.Sprintf(
+				"Waited %s for rate limit of %s",
+				waited.Round(time.Millisecond),
+				req.URL.Hostname(),
+			),
+		)
+	}
+
+	return release, nil
+}
@@ -0,0 +1,226 @@
+package winhttptest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Ensure the fake satisfies HTTPClient
+var _ HTTPClient = (*Client)(nil)
+
+// newMux will return a handler for the client tests.
+func newMux() *http.ServeMux {
+	var mux *http.ServeMux = http.NewServeMux()
+
+	mux.HandleFunc(
+		"/echo",
+		func(w http.ResponseWriter, r *http.Request) {
+			var b []byte
+
+			b, _ = io.ReadAll(r.Body)
+			_, _ = io.WriteString(
+				w,
+				r.Method+" "+r.Host+r.URL.RequestURI()+" "+
+					r.Header.Get("User-Agent")+" "+
+					r.Header.Get("Content-Type")+" "+string(b),
+			)
+		},
+	)
+	mux.HandleFunc(
+		"/cookie",
+		func(w http.ResponseWriter, r *http.Request) {
+			if c, e := r.Cookie("a"); e == nil {
+				_, _ = io.WriteString(w, c.Value)
+				return
+			}
+
+			http.SetCookie(w, &http.Cookie{Name: "a", Value: "1"})
+		},
+	)
+	mux.HandleFunc(
+		"/panic",
+		func(http.ResponseWriter, *http.Request) {
+			panic("oops")
+		},
+	)
+	mux.HandleFunc(
+		"/redirect",
+		func(w http.ResponseWriter, r *http.Request) {
+			var code int = http.StatusFound
+
+			if v := r.URL.Query().Get("code"); v != "" {
+				code, _ = strconv.Atoi(v)
+			}
+
+			http.Redirect(w, r, "/echo?from=redirect", code)
+		},
+	)
+	mux.HandleFunc(
+		"/slow",
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		},
+	)
+
+	return mux
+}
+
+func TestClient(t *testing.T) {
+	var c *Client = NewClient(newMux(), "test/1.0")
+	var e error
+	var res *http.Response
+	var tests = []struct {
+		want string
+		fn   func() (*http.Response, error)
+	}{
+		{
+			"GET example.com/echo test/1.0  ",
+			func() (*http.Response, error) {
+				return c.Get("http://example.com/echo")
+			},
+		},
+		{
+			"POST example.com/echo test/1.0 text/plain data",
+			func() (*http.Response, error) {
+				return c.Post(
+					"http://example.com/echo",
+					"text/plain",
+					strings.NewReader("data"),
+				)
+			},
+		},
+		{
+			"POST example.com/echo test/1.0 " +
+				"application/x-www-form-urlencoded a=1",
+			func() (*http.Response, error) {
+				return c.PostForm(
+					"http://example.com/echo",
+					url.Values{"a": {"1"}},
+				)
+			},
+		},
+		// 302 redirects are followed with GET
+		{
+			"GET example.com/echo?from=redirect test/1.0  ",
+			func() (*http.Response, error) {
+				return c.Post(
+					"http://example.com/redirect",
+					"text/plain",
+					strings.NewReader("data"),
+				)
+			},
+		},
+		// 307 and 308 redirects keep the method and body
+		{
+			"POST example.com/echo?from=redirect test/1.0 " +
+				"text/plain data",
+			func() (*http.Response, error) {
+				return c.Post(
+					"http://example.com/redirect?code=307",
+					"text/plain",
+					strings.NewReader("data"),
+				)
+			},
+		},
+		{
+			"PUT example.com/echo?from=redirect test/1.0 " +
+				"text/plain data",
+			func() (*http.Response, error) {
+				return c.Put(
+					"http://example.com/redirect?code=308",
+					"text/plain",
+					strings.NewReader("data"),
+				)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		if res, e = test.fn(); e != nil {
+			t.Errorf("%s: %s", test.want, e)
+		} else if b := body(t, res); b != test.want {
+			t.Errorf("got %q, want %q", b, test.want)
+		}
+	}
+
+	// Bodies that can't be sent again aren't redirected
+	res, e = c.Post(
+		"http://example.com/redirect?code=307",
+		"text/plain",
+		io.MultiReader(strings.NewReader("data")),
+	)
+	if e != nil {
+		t.Fatal(e)
+	} else if res.StatusCode != http.StatusTemporaryRedirect {
+		t.Errorf("got %s, want 307", res.Status)
+	}
+
+	_ = res.Body.Close()
+
+	// HEAD responses have no body
+	if res, e = c.Head("http://example.com/echo"); e != nil {
+		t.Fatal(e)
+	} else if b := body(t, res); b != "" {
+		t.Errorf("HEAD: got %q", b)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	var c *Client = NewClient(newMux())
+	var cancel context.CancelFunc
+	var ctx context.Context
+	var e error
+
+	if _, e = c.Get("http://example.com/panic"); e == nil {
+		t.Error("expected error for panic")
+	}
+
+	c.Timeout = 50 * time.Millisecond
+
+	_, e = c.Get("http://example.com/slow")
+	if !errors.Is(e, context.DeadlineExceeded) {
+		t.Errorf("got %v, want %v", e, context.DeadlineExceeded)
+	}
+
+	c.Timeout = 0
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	_, e = c.GetWithContext(ctx, "http://example.com/echo")
+	if !errors.Is(e, context.Canceled) {
+		t.Errorf("got %v, want %v", e, context.Canceled)
+	}
+
+	if _, e = (&Client{}).Get("http://example.com/"); e == nil {
+		t.Error("expected error for missing handler")
+	}
+}
+
+func TestClientJar(t *testing.T) {
+	var c *Client = NewClient(newMux())
+	var e error
+	var res *http.Response
+
+	if c.Jar, e = cookiejar.New(nil); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, want := range []string{"", "1"} {
+		if res, e = c.Get("http://example.com/cookie"); e != nil {
+			t.Fatal(e)
+		} else if b := body(t, res); b != want {
+			t.Errorf("got %q, want %q", b, want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package winhttptest
+
+import (
+	"github.com/mjwhitta/win/winhttp"
+	inet "github.com/mjwhitta/win/wininet"
+)
+
+// Ensure the real clients satisfy HTTPClient
+var (
+	_ HTTPClient = (*inet.Client)(nil)
+	_ HTTPClient = (*winhttp.Client)(nil)
+)
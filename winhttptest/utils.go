@@ -0,0 +1,170 @@
+package winhttptest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+func dbgLog(debug bool, thing any) {
+	var b []byte
+	var e error
+
+	if !debug {
+		return
+	}
+
+	switch thing := thing.(type) {
+	case string:
+		println(thing)
+	case *http.Request:
+		if b, e = httputil.DumpRequestOut(thing, true); e == nil {
+			println(string(b))
+		}
+	case *http.Response:
+		if b, e = httputil.DumpResponse(thing, true); e == nil {
+			println()
+			println(string(b))
+		}
+	default:
+		println(thing)
+	}
+}
+
+// fullURL will return the absolute URL of the request, as seen by a
+// client.
+func fullURL(req *http.Request) string {
+	var u url.URL
+
+	if req.URL.IsAbs() {
+		return req.URL.String()
+	}
+
+	u = *req.URL
+	u.Host = req.Host
+	u.Scheme = "http"
+
+	if req.TLS != nil {
+		u.Scheme = "https"
+	}
+
+	return u.String()
+}
+
+// redirectRequest will return the request to send for a redirect,
+// or nil if it shouldn't be followed. Like net/http, 301, 302, and
+// 303 are followed with GET (or HEAD), and 307 and 308 keep the
+// method and body, but only if the body can be sent again (see
+// http.Request.GetBody).
+func redirectRequest(
+	req *http.Request,
+	code int,
+	loc *url.URL,
+) (*http.Request, error) {
+	var body io.ReadCloser
+	var e error
+	var method string = req.Method
+	var next *http.Request
+
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound,
+		http.StatusSeeOther:
+		if method != http.MethodHead {
+			method = http.MethodGet
+		}
+	case http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		if req.GetBody != nil {
+			if body, e = req.GetBody(); e != nil {
+				return nil, errors.Newf("failed to reset body: %w", e)
+			}
+		} else if (req.Body != nil) && (req.Body != http.NoBody) {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	next, e = http.NewRequestWithContext(
+		req.Context(),
+		method,
+		loc.String(),
+		body,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	if body != nil {
+		next.ContentLength = req.ContentLength
+		next.GetBody = req.GetBody
+
+		if v := req.Header.Get("Content-Type"); v != "" {
+			next.Header.Set("Content-Type", v)
+		}
+	}
+
+	return next, nil
+}
+
+// serve will run the handler for the request, as a server would see
+// it, and return the recorded response.
+func serve(
+	ctx context.Context,
+	h http.Handler,
+	req *http.Request,
+) (*http.Response, error) {
+	var done chan struct{} = make(chan struct{})
+	var panicked any
+	var rec *httptest.ResponseRecorder = httptest.NewRecorder()
+	var res *http.Response
+	var sreq *http.Request
+
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+
+	// Server-side view of the request
+	sreq = req.Clone(ctx)
+	sreq.Host = req.URL.Host
+	sreq.RemoteAddr = "192.0.2.1:1234"
+	sreq.RequestURI = req.URL.RequestURI()
+
+	if sreq.Body == nil {
+		sreq.Body = http.NoBody
+	}
+
+	go func() {
+		defer close(done)
+		defer func() {
+			panicked = recover()
+		}()
+
+		h.ServeHTTP(rec, sreq)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-done:
+	}
+
+	if panicked != nil {
+		return nil, errors.Newf("handler panicked: %v", panicked)
+	}
+
+	res = rec.Result()
+	res.Request = req
+
+	// HEAD responses have no body
+	if strings.EqualFold(req.Method, http.MethodHead) {
+		res.Body = http.NoBody
+	}
+
+	return res, nil
+}
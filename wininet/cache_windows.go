@@ -0,0 +1,159 @@
+//go:build windows
+
+package wininet
+
+import (
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+	"golang.org/x/sys/windows"
+)
+
+// CacheEntry is the metadata for a URL in the WinINet cache.
+type CacheEntry struct {
+	Expires      time.Time
+	Header       http.Header
+	HitCount     uint32
+	LastAccess   time.Time
+	LastModified time.Time
+	LastSync     time.Time
+	LocalFile    string
+	Size         int64
+	Type         uint32
+	URL          string
+}
+
+// CacheMode controls how requests use the WinINet URL cache. Modes
+// can be combined (e.g. CacheReload | CacheNoWrite).
+type CacheMode uint
+
+// CacheDefault lets WinINet decide, using the system settings.
+const CacheDefault CacheMode = 0
+
+// Supported cache modes
+const (
+	// CacheReload always fetches from the server, ignoring the
+	// cache (INTERNET_FLAG_RELOAD).
+	CacheReload CacheMode = 1 << iota
+
+	// CacheNoWrite doesn't add the response to the cache
+	// (INTERNET_FLAG_NO_CACHE_WRITE).
+	CacheNoWrite
+
+	// CacheResynchronize revalidates cached entries with the
+	// server, using If-Modified-Since
+	// (INTERNET_FLAG_RESYNCHRONIZE).
+	CacheResynchronize
+
+	// CacheOffline only uses the cache and never contacts the
+	// server (INTERNET_FLAG_FROM_CACHE). Requests for URLs that are
+	// not cached will fail.
+	CacheOffline
+)
+
+// DeleteCacheEntry will remove the provided URL from the WinINet
+// cache.
+func DeleteCacheEntry(uri string) error {
+	if e := w32.DeleteURLCacheEntryW(uri); e != nil {
+		return errors.Newf("failed to delete cache entry: %w", e)
+	}
+
+	return nil
+}
+
+// GetCacheEntry will return the WinINet cache metadata for the
+// provided URL.
+func GetCacheEntry(uri string) (*CacheEntry, error) {
+	var b []byte
+	var ce *CacheEntry
+	var e error
+	var info *w32.InternetCacheEntryInfo
+	var size int
+
+	// First call will fail, but provide the needed size
+	e = w32.GetURLCacheEntryInfoW(uri, &b, &size)
+	if (e != nil) && (size > 0) {
+		e = w32.GetURLCacheEntryInfoW(uri, &b, &size)
+	}
+
+	if e != nil {
+		return nil, errors.Newf("failed to get cache entry: %w", e)
+	}
+
+	if len(b) < int(unsafe.Sizeof(*info)) {
+		return nil, errors.New("invalid cache entry")
+	}
+
+	info = (*w32.InternetCacheEntryInfo)(unsafe.Pointer(&b[0]))
+
+	ce = &CacheEntry{
+		Expires:      filetime(info.ExpireTime),
+		Header:       parseHeader(info.HeaderInfo),
+		HitCount:     info.HitRate,
+		LastAccess:   filetime(info.LastAccessTime),
+		LastModified: filetime(info.LastModifiedTime),
+		LastSync:     filetime(info.LastSyncTime),
+		LocalFile:    windows.UTF16PtrToString(info.LocalFileName),
+		Size:         int64(info.SizeHigh)<<32 | int64(info.SizeLow),
+		Type:         info.CacheEntryType,
+		URL:          windows.UTF16PtrToString(info.SourceURLName),
+	}
+
+	return ce, nil
+}
+
+func filetime(ft windows.Filetime) time.Time {
+	if (ft.HighDateTime == 0) && (ft.LowDateTime == 0) {
+		return time.Time{}
+	}
+
+	return time.Unix(0, ft.Nanoseconds())
+}
+
+// parseHeader will parse the cached raw response headers.
+func parseHeader(raw *uint16) http.Header {
+	var hdrs http.Header = http.Header{}
+	var k string
+	var ok bool
+	var v string
+
+	for _, line := range strings.Split(
+		windows.UTF16PtrToString(raw),
+		"\r\n",
+	) {
+		if k, v, ok = strings.Cut(line, ":"); ok {
+			k = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(k))
+			hdrs.Add(k, strings.TrimSpace(v))
+		}
+	}
+
+	return hdrs
+}
+
+// flags will return the INTERNET_FLAG_* values for the cache mode.
+func (m CacheMode) flags() uintptr {
+	var flags uintptr
+
+	if m&CacheReload != 0 {
+		flags |= w32.WininetInternetFlagReload
+	}
+
+	if m&CacheNoWrite != 0 {
+		flags |= w32.WininetInternetFlagNoCacheWrite
+	}
+
+	if m&CacheResynchronize != 0 {
+		flags |= w32.WininetInternetFlagResynchronize
+	}
+
+	if m&CacheOffline != 0 {
+		flags |= w32.WininetInternetFlagFromCache
+	}
+
+	return flags
+}
@@ -4,26 +4,70 @@ package wininet
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/sys/windows"
+
 	"github.com/mjwhitta/errors"
 	w32 "github.com/mjwhitta/win/api"
+	"github.com/mjwhitta/win/formdata"
+	"github.com/mjwhitta/win/ratelimit"
 )
 
 // Client is a struct containing relevant metadata to make HTTP
-// requests.
+// requests. Like net/http.Client, a Client is safe for concurrent use
+// by multiple goroutines, so it should be created once and reused.
+// The exported fields should be configured before first use and not
+// modified while requests are in flight. The Jar, if provided, must
+// also be safe for concurrent use (net/http/cookiejar is).
+//
+// If Proxies is set, it returns the ordered list of proxies for each
+// request (e.g. pac.PAC.Proxies). The first supported entry is used,
+// so unsupported proxies fall back to the next, and a nil URL means
+// no proxy. Proxy is the same, but only returns one URL. Otherwise,
+// the Transport's Proxy func is used only when it returns a URL, so
+// the Windows proxy settings still apply to a Transport cloned from
+// http.DefaultTransport.
+//
+// If ClientCert is set, it is sent to servers that request a client
+// certificate (e.g. certstore.Certificate.Context). It must not be
+// freed while the Client is in use.
+//
+// Response.Trailer only has the keys declared by the Trailer header,
+// as WinINet never provides their values.
 type Client struct {
-	Debug     bool
-	Jar       http.CookieJar
-	Timeout   time.Duration
-	Transport http.RoundTripper
+	Cache      CacheMode
+	ClientCert *windows.CertContext
+	Debug      bool
+	Jar        http.CookieJar
+	Limiter    *ratelimit.Limiter
+	Proxies    func(req *http.Request) ([]*url.URL, error)
+	Proxy      func(req *http.Request) (*url.URL, error)
+	Timeout    time.Duration
+	Transport  http.RoundTripper
+
+	agent string
+	mutex sync.RWMutex
+	sess  *session
+	ua    string
+}
 
-	hndl uintptr
-	ua   string
+// session is a WinINet session handle, plus a session handle per
+// proxy selected by the Transport. They are closed once all in-flight
+// requests using them are done.
+type session struct {
+	agent    string
+	hndl     uintptr
+	inflight sync.WaitGroup
+	mutex    sync.Mutex
+	proxies  map[string]uintptr
 }
 
 // NewClient will return a pointer to a new Client instance that
@@ -31,39 +75,109 @@ type Client struct {
 func NewClient(ua ...string) (*Client, error) {
 	var c *Client = &Client{}
 	var e error
+	var hndl uintptr
 
 	if len(ua) == 0 {
 		ua = []string{"Go-http-client/1.1"}
 	}
 
 	// Store User-Agent
+	c.agent = strings.Join(ua, " ")
 	c.ua = ua[0]
 
 	// Create session
-	c.hndl, e = w32.InternetOpenW(
-		strings.Join(ua, " "),
-		w32.Wininet.InternetOpenTypePreconfig,
-		"",
-		"",
-		0,
-	)
-	if e != nil {
-		return nil, errors.Newf("failed to create session: %w", e)
+	if hndl, e = openSession(c.agent); e != nil {
+		return nil, e
 	}
 
+	c.sess = &session{agent: c.agent, hndl: hndl}
+
 	return c, nil
 }
 
-// Do will send the HTTP request and return an HTTP response.
+// acquire will return the current session, which must be released
+// once the request is done.
+func (c *Client) acquire() *session {
+	var s *session
+
+	c.mutex.RLock()
+	s = c.sess
+	s.inflight.Add(1)
+	c.mutex.RUnlock()
+
+	return s
+}
+
+// CloseIdleConnections will close any idle connections by replacing
+// the underlying WinINet session. In-flight requests are not
+// interrupted and the old session is closed once they are done.
+func (c *Client) CloseIdleConnections() {
+	var e error
+	var hndl uintptr
+	var old *session
+
+	if hndl, e = openSession(c.agent); e != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	old = c.sess
+	c.sess = &session{agent: c.agent, hndl: hndl}
+	c.mutex.Unlock()
+
+	go func() {
+		old.inflight.Wait()
+		old.close()
+	}()
+}
+
+// Delete will make a DELETE request using WinINet.dll.
+func (c *Client) Delete(url string) (*http.Response, error) {
+	return c.DeleteWithContext(context.Background(), url)
+}
+
+// DeleteWithContext will make a DELETE request with the provided
+// context using WinINet.dll.
+func (c *Client) DeleteWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodDelete, url, "", nil)
+}
+
+// Do will send the HTTP request and return an HTTP response. WinINet
+// failures are returned as an *Error. A 407 response is returned as
+// ErrProxyAuthRequired, since WinINet has already failed to
+// authenticate with the proxy. The request is cancelled if its
+// context is done. The proxy is chosen by Client.Proxies or
+// Client.Proxy, if set. If not, the Transport's Proxy func (if the
+// Transport is an *http.Transport) is used when it returns a URL.
+// Otherwise, the Windows proxy settings are used. Redirects are
+// followed like net/http, so 307 and 308 keep the method and body.
 func (c *Client) Do(req *http.Request) (res *http.Response, e error) {
+	var connHndl uintptr
+	var ctx context.Context = req.Context()
+	var hndl uintptr
+	var next *http.Request
+	var ok bool
+	var proxy string
 	var redirect *url.URL
+	var release func()
 	var reqHndl uintptr
+	var sess *session
+	var stop func() bool
 	var trans http.RoundTripper = c.Transport
 
 	if trans == nil {
 		trans = http.DefaultTransport
 	}
 
+	// Short circuit, if context is already done
+	if e = ctx.Err(); e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return nil, e
+	}
+
 	// Load cookies from cookie jar
 	loadCookies(c.Jar, req)
 
@@ -72,13 +186,56 @@ func (c *Client) Do(req *http.Request) (res *http.Response, e error) {
 		req.Header.Set("User-Agent", c.ua)
 	}
 
-	// Build the underlying WinINet request
-	if reqHndl, e = buildRequest(c.hndl, req, c.Timeout); e != nil {
+	// Wait for the per-host rate limit and concurrency cap
+	if release, e = c.wait(req); e != nil {
+		return nil, e
+	}
+	defer release()
+
+	// Use the current session until done
+	sess = c.acquire()
+	defer sess.inflight.Done()
+
+	hndl = sess.hndl
+
+	// Use the proxy selected by the Client or Transport, if any
+	proxy, ok, e = getProxy(c.Proxies, c.Proxy, c.Transport, req)
+	if e != nil {
 		return nil, e
+	} else if ok {
+		if hndl, e = sess.proxy(proxy); e != nil {
+			return nil, newError(0, e)
+		}
+	}
+
+	// Build the underlying WinINet request
+	connHndl, reqHndl, e = buildRequest(
+		hndl,
+		req,
+		c.Timeout,
+		c.Cache,
+	)
+	if e != nil {
+		return nil, newError(reqHndl, e)
 	}
+
+	// Closing the handle will cancel any blocking WinINet calls
+	stop = context.AfterFunc(
+		ctx,
+		func() {
+			_ = w32.InternetCloseHandle(reqHndl)
+		},
+	)
 	defer func() {
-		if e == nil {
-			e = w32.InternetCloseHandle(reqHndl)
+		// Close handle, unless the context already did
+		if stop() {
+			if err := w32.InternetCloseHandle(reqHndl); e == nil {
+				e = err
+			}
+		}
+
+		if err := w32.InternetCloseHandle(connHndl); e == nil {
+			e = err
 		}
 	}()
 
@@ -87,29 +244,56 @@ func (c *Client) Do(req *http.Request) (res *http.Response, e error) {
 		if t.TLSClientConfig != nil {
 			if t.TLSClientConfig.InsecureSkipVerify {
 				if e = disableTLS(reqHndl); e != nil {
-					return nil, e
+					return nil, newError(reqHndl, e)
 				}
 			}
 		}
 	}
 
+	// Send a client certificate, if configured to do so
+	if c.ClientCert != nil {
+		if e = setClientCert(reqHndl, c.ClientCert); e != nil {
+			return nil, newError(reqHndl, e)
+		}
+	}
+
 	dbgLog(c.Debug, req)
 
-	// Send request using WinINET
+	// Send request using WinINet
 	if res, e = sendRequest(reqHndl, req); e != nil {
-		return nil, e
+		if ctx.Err() != nil {
+			e = ctx.Err()
+			e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+
+			return nil, e
+		}
+
+		return nil, newError(reqHndl, e)
 	}
 
 	dbgLog(c.Debug, res)
 
+	if res.StatusCode == http.StatusProxyAuthRequired {
+		return nil, newProxyAuthError(res)
+	}
+
 	// Store cookies into cookie jar
 	if e = storeCookies(c.Jar, req.URL, res.Cookies()); e != nil {
 		return nil, e
 	}
 
-	// Follow redirects
+	// Follow redirects, which are limited separately
 	if redirect, e = res.Location(); e == nil {
-		return c.Get(redirect.String())
+		next, e = redirectRequest(req, res.StatusCode, redirect)
+		if e != nil {
+			_ = res.Body.Close()
+			return nil, e
+		} else if next != nil {
+			_ = res.Body.Close()
+			release()
+
+			return c.Do(next)
+		}
 	}
 
 	return res, nil
@@ -117,30 +301,197 @@ func (c *Client) Do(req *http.Request) (res *http.Response, e error) {
 
 // Get will make a GET request using WinINet.dll.
 func (c *Client) Get(url string) (*http.Response, error) {
-	var e error
-	var req *http.Request
-
-	if req, e = http.NewRequest(http.MethodGet, url, nil); e != nil {
-		return nil, errors.Newf("failed to create request: %w", e)
-	}
+	return c.GetWithContext(context.Background(), url)
+}
 
-	return c.Do(req)
+// GetWithContext will make a GET request with the provided context
+// using WinINet.dll.
+func (c *Client) GetWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodGet, url, "", nil)
 }
 
 // Head will make a HEAD request using WinINet.dll.
 func (c *Client) Head(url string) (*http.Response, error) {
+	return c.HeadWithContext(context.Background(), url)
+}
+
+// HeadWithContext will make a HEAD request with the provided context
+// using WinINet.dll.
+func (c *Client) HeadWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodHead, url, "", nil)
+}
+
+// Options will make an OPTIONS request using WinINet.dll.
+func (c *Client) Options(url string) (*http.Response, error) {
+	return c.OptionsWithContext(context.Background(), url)
+}
+
+// OptionsWithContext will make an OPTIONS request with the provided
+// context using WinINet.dll.
+func (c *Client) OptionsWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodOptions, url, "", nil)
+}
+
+// Patch will make a PATCH request using WinINet.dll.
+func (c *Client) Patch(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.PatchWithContext(
+		context.Background(),
+		url,
+		contentType,
+		body,
+	)
+}
+
+// PatchWithContext will make a PATCH request with the provided
+// context using WinINet.dll.
+func (c *Client) PatchWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodPatch, url, contentType, body)
+}
+
+// Post will make a POST request using WinINet.dll.
+func (c *Client) Post(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.PostWithContext(
+		context.Background(),
+		url,
+		contentType,
+		body,
+	)
+}
+
+// PostForm will make a POST request using WinINet.dll.
+func (c *Client) PostForm(
+	url string,
+	data url.Values,
+) (*http.Response, error) {
+	return c.PostFormWithContext(context.Background(), url, data)
+}
+
+// PostFormWithContext will make a POST request with the provided
+// context using WinINet.dll.
+func (c *Client) PostFormWithContext(
+	ctx context.Context,
+	url string,
+	data url.Values,
+) (*http.Response, error) {
+	return c.send(
+		ctx,
+		http.MethodPost,
+		url,
+		"application/x-www-form-urlencoded",
+		bytes.NewReader([]byte(data.Encode())),
+	)
+}
+
+// PostMultipart will make a multipart/form-data POST request using
+// WinINet.dll. Files are indexed by form field name and are
+// streamed from disk.
+func (c *Client) PostMultipart(
+	url string,
+	fields url.Values,
+	files map[string][]string,
+) (*http.Response, error) {
+	return c.PostMultipartWithContext(
+		context.Background(),
+		url,
+		fields,
+		files,
+	)
+}
+
+// PostMultipartWithContext will make a multipart/form-data POST
+// request with the provided context using WinINet.dll. Files are
+// indexed by form field name and are streamed from disk.
+func (c *Client) PostMultipartWithContext(
+	ctx context.Context,
+	url string,
+	fields url.Values,
+	files map[string][]string,
+) (*http.Response, error) {
+	var body *formdata.Body
 	var e error
 	var req *http.Request
 
-	if req, e = http.NewRequest(http.MethodHead, url, nil); e != nil {
+	if body, e = formdata.New(fields, files); e != nil {
+		return nil, errors.Newf("failed to create body: %w", e)
+	}
+
+	req, e = http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		url,
+		body,
+	)
+	if e != nil {
 		return nil, errors.Newf("failed to create request: %w", e)
 	}
 
+	req.ContentLength = body.ContentLength
+	req.Header.Set("Content-Type", body.ContentType())
+
 	return c.Do(req)
 }
 
-// Post will make a POST request using WinINet.dll.
-func (c *Client) Post(
+// PostWithContext will make a POST request with the provided context
+// using WinINet.dll.
+func (c *Client) PostWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodPost, url, contentType, body)
+}
+
+// Put will make a PUT request using WinINet.dll.
+func (c *Client) Put(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.PutWithContext(
+		context.Background(),
+		url,
+		contentType,
+		body,
+	)
+}
+
+// PutWithContext will make a PUT request with the provided context
+// using WinINet.dll.
+func (c *Client) PutWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.send(ctx, http.MethodPut, url, contentType, body)
+}
+
+func (c *Client) send(
+	ctx context.Context,
+	method string,
 	url string,
 	contentType string,
 	body io.Reader,
@@ -148,7 +499,7 @@ func (c *Client) Post(
 	var e error
 	var req *http.Request
 
-	req, e = http.NewRequest(http.MethodPost, url, body)
+	req, e = http.NewRequestWithContext(ctx, method, url, body)
 	if e != nil {
 		return nil, errors.Newf("failed to create request: %w", e)
 	}
@@ -160,24 +511,76 @@ func (c *Client) Post(
 	return c.Do(req)
 }
 
-// PostForm will make a POST request using WinINet.dll.
-func (c *Client) PostForm(
-	url string,
-	data url.Values,
-) (*http.Response, error) {
-	var body io.Reader = bytes.NewReader([]byte(data.Encode()))
+// close will close the session handles.
+func (s *session) close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, hndl := range s.proxies {
+		_ = w32.InternetCloseHandle(hndl)
+	}
+
+	_ = w32.InternetCloseHandle(s.hndl)
+	s.proxies = nil
+}
+
+// proxy will return the session handle for the provided proxy,
+// creating it if needed. An empty proxy means no proxy.
+func (s *session) proxy(proxy string) (uintptr, error) {
 	var e error
-	var req *http.Request
+	var hndl uintptr
+	var ok bool
 
-	req, e = http.NewRequest(http.MethodPost, url, body)
-	if e != nil {
-		return nil, errors.Newf("failed to create request: %w", e)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if hndl, ok = s.proxies[proxy]; ok {
+		return hndl, nil
 	}
 
-	req.Header.Set(
-		"Content-Type",
-		"application/x-www-form-urlencoded",
+	if hndl, e = openProxySession(s.agent, proxy); e != nil {
+		return 0, e
+	}
+
+	if s.proxies == nil {
+		s.proxies = map[string]uintptr{}
+	}
+
+	s.proxies[proxy] = hndl
+
+	return hndl, nil
+}
+
+// wait will block until the Limiter, if any, allows the request. It
+// returns a func to release the request's concurrency slot.
+func (c *Client) wait(req *http.Request) (func(), error) {
+	var e error
+	var release func()
+	var waited time.Duration
+
+	if c.Limiter == nil {
+		return func() {}, nil
+	}
+
+	release, waited, e = c.Limiter.Wait(
+		req.Context(),
+		req.URL.Hostname(),
 	)
+	if e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return nil, e
+	}
 
-	return c.Do(req)
+	if waited > 0 {
+		dbgLog(
+			c.Debug,
+			fmt.Sprintf(
+				"Waited %s for rate limit of %s",
+				waited.Round(time.Millisecond),
+				req.URL.Hostname(),
+			),
+		)
+	}
+
+	return release, nil
 }
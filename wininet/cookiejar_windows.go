@@ -0,0 +1,122 @@
+//go:build windows
+
+package wininet
+
+import (
+	goerrors "errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+	"golang.org/x/sys/windows"
+)
+
+// CookieJar is an http.CookieJar backed by the system WinINet cookie
+// store, which is shared with other WinINet applications run by the
+// same user. This allows reuse of existing session cookies (e.g. from
+// SSO logins). Cookies with an expiration are persisted to disk by
+// WinINet, while session cookies only last as long as the process.
+// A CookieJar is safe for concurrent use.
+type CookieJar struct{}
+
+// NewCookieJar will return a pointer to a new CookieJar instance.
+func NewCookieJar() *CookieJar {
+	return &CookieJar{}
+}
+
+// Cookies will return the cookies stored by WinINet for the provided
+// URL. Errors are ignored, per the http.CookieJar interface.
+func (j *CookieJar) Cookies(u *url.URL) []*http.Cookie {
+	var cookies []*http.Cookie
+
+	cookies, _ = j.GetCookies(u)
+
+	return cookies
+}
+
+// GetCookies will return the cookies, including HttpOnly cookies,
+// stored by WinINet for the provided URL.
+func (j *CookieJar) GetCookies(u *url.URL) ([]*http.Cookie, error) {
+	var b []byte
+	var e error
+	var flags uintptr = w32.WininetInternetCookieHTTPonly
+	var req *http.Request
+	var size int
+
+	if (u == nil) || ((u.Scheme != "http") && (u.Scheme != "https")) {
+		return nil, nil
+	}
+
+	// First call will fail, but provide the needed size
+	e = w32.InternetGetCookieExW(u.String(), "", &b, &size, flags)
+	if (e != nil) && (size > 0) {
+		e = w32.InternetGetCookieExW(u.String(), "", &b, &size, flags)
+	}
+
+	if goerrors.Is(e, windows.ERROR_NO_MORE_ITEMS) {
+		return nil, nil
+	} else if e != nil {
+		return nil, errors.Newf("failed to get cookies: %w", e)
+	}
+
+	// WinINet returns a Cookie header value, so parse it as one
+	req = &http.Request{Header: http.Header{}}
+	req.Header.Set("Cookie", string(b))
+
+	return req.Cookies(), nil
+}
+
+// SetCookie will store the provided cookie with WinINet for the
+// provided URL. HttpOnly cookies are stored as such. A cookie with a
+// negative MaxAge is deleted.
+func (j *CookieJar) SetCookie(u *url.URL, cookie *http.Cookie) error {
+	var c http.Cookie
+	var data string
+	var e error
+	var flags uintptr
+	var maxAge time.Duration
+	var state uintptr
+
+	if (u == nil) || (cookie == nil) {
+		return nil
+	}
+
+	// WinINet only honors Expires, so convert Max-Age
+	c = *cookie
+	if c.MaxAge > 0 {
+		maxAge = time.Duration(c.MaxAge) * time.Second
+		c.Expires = time.Now().Add(maxAge)
+	} else if c.MaxAge < 0 {
+		c.Expires = time.Unix(1, 0)
+	}
+
+	c.MaxAge = 0
+
+	if data = c.String(); data == "" {
+		return errors.Newf("cookie %s invalid", c.Name)
+	}
+
+	if c.HttpOnly {
+		flags = w32.WininetInternetCookieHTTPonly
+	}
+
+	state, e = w32.InternetSetCookieExW(u.String(), "", data, flags)
+	if e != nil {
+		return errors.Newf("failed to set cookie %s: %w", c.Name, e)
+	} else if state == w32.WininetCookieStateReject {
+		return errors.Newf("cookie %s rejected", c.Name)
+	}
+
+	return nil
+}
+
+// SetCookies will store the provided cookies with WinINet for the
+// provided URL. Errors are ignored, per the http.CookieJar
+// interface.
+func (j *CookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	for _, cookie := range cookies {
+		_ = j.SetCookie(u, cookie)
+	}
+}
@@ -0,0 +1,181 @@
+//go:build windows
+
+package wininet
+
+import (
+	"encoding/binary"
+	goerrors "errors"
+	"net/http"
+	"sort"
+	"syscall"
+
+	"github.com/mjwhitta/errors"
+	w32 "github.com/mjwhitta/win/api"
+)
+
+// Error is returned when WinINet fails to make a request. It
+// implements net.Error and can be compared to the Err* sentinels
+// using errors.Is.
+type Error struct {
+	// Code is the ERROR_INTERNET_* value returned by WinINet, or the
+	// HTTP status code for ErrProxyAuthRequired.
+	Code uintptr
+
+	// Flags are the SECURITY_FLAG_IGNORE_* values describing why a
+	// secure connection failed, if any.
+	Flags uintptr
+
+	err  error
+	kind error
+}
+
+// Sentinel errors for common WinINet failures
+var (
+	ErrCannotConnect     error = errors.New("cannot connect")
+	ErrNameNotResolved   error = errors.New("name not resolved")
+	ErrProxyAuthRequired error = errors.New("proxy auth required")
+	ErrSecureFailure     error = errors.New("secure failure")
+	ErrTimeout           error = errors.New("timeout")
+)
+
+//nolint:lll // WinINet constant names are long
+var (
+	// Cert error flags, indexed by value
+	certErrors map[uintptr]string = map[uintptr]string{
+		w32.WininetSecurityFlagIgnoreCertCnInvalid:   "CERT_CN_INVALID",
+		w32.WininetSecurityFlagIgnoreCertDateInvalid: "CERT_DATE_INVALID",
+		w32.WininetSecurityFlagIgnoreRevocation:      "CERT_REV_FAILED",
+		w32.WininetSecurityFlagIgnoreUnknownCa:       "INVALID_CA",
+		w32.WininetSecurityFlagIgnoreWeakSignature:   "WEAK_SIGNATURE",
+		w32.WininetSecurityFlagIgnoreWrongUsage:      "CERT_WRONG_USAGE",
+	}
+	// Cert error flags implied by specific error codes
+	certErrorCodes map[uintptr]uintptr = map[uintptr]uintptr{
+		w32.WininetErrorInternetInvalidCa:          w32.WininetSecurityFlagIgnoreUnknownCa,
+		w32.WininetErrorInternetSecCertCnInvalid:   w32.WininetSecurityFlagIgnoreCertCnInvalid,
+		w32.WininetErrorInternetSecCertDateInvalid: w32.WininetSecurityFlagIgnoreCertDateInvalid,
+		w32.WininetErrorInternetSecCertNoRev:       w32.WininetSecurityFlagIgnoreRevocation,
+		w32.WininetErrorInternetSecCertRevFailed:   w32.WininetSecurityFlagIgnoreRevocation,
+	}
+	// Sentinel errors, indexed by ERROR_INTERNET_* value
+	errKinds map[uintptr]error = map[uintptr]error{
+		w32.WininetErrorInternetCannotConnect:          ErrCannotConnect,
+		w32.WininetErrorInternetConnectionAborted:      ErrCannotConnect,
+		w32.WininetErrorInternetConnectionReset:        ErrCannotConnect,
+		w32.WininetErrorInternetInvalidCa:              ErrSecureFailure,
+		w32.WininetErrorInternetNameNotResolved:        ErrNameNotResolved,
+		w32.WininetErrorInternetProxyServerUnreachable: ErrCannotConnect,
+		w32.WininetErrorInternetSecCertCnInvalid:       ErrSecureFailure,
+		w32.WininetErrorInternetSecCertDateInvalid:     ErrSecureFailure,
+		w32.WininetErrorInternetSecCertErrors:          ErrSecureFailure,
+		w32.WininetErrorInternetSecCertNoRev:           ErrSecureFailure,
+		w32.WininetErrorInternetSecCertRevFailed:       ErrSecureFailure,
+		w32.WininetErrorInternetSecCertRevoked:         ErrSecureFailure,
+		w32.WininetErrorInternetSecInvalidCert:         ErrSecureFailure,
+		w32.WininetErrorInternetSecurityChannelError:   ErrSecureFailure,
+		w32.WininetErrorInternetServerUnreachable:      ErrCannotConnect,
+		w32.WininetErrorInternetTimeout:                ErrTimeout,
+	}
+)
+
+// newError will wrap the provided error with an Error, if it
+// contains a WinINet error code. Otherwise, the error is returned
+// unchanged.
+func newError(reqHndl uintptr, e error) error {
+	var code syscall.Errno
+	var winErr *Error
+
+	if (e == nil) || !goerrors.As(e, &code) {
+		return e
+	}
+
+	winErr = &Error{Code: uintptr(code), err: e}
+	winErr.kind = errKinds[winErr.Code]
+
+	if winErr.kind == ErrSecureFailure {
+		// WinINet reports the cert errors as the security flags
+		// that would be needed to ignore them, if the request was
+		// opened
+		if reqHndl != 0 {
+			winErr.Flags = querySecurityFlags(reqHndl)
+		}
+
+		winErr.Flags |= certErrorCodes[winErr.Code]
+	}
+
+	return winErr
+}
+
+func newProxyAuthError(res *http.Response) error {
+	_ = res.Body.Close()
+
+	return &Error{
+		Code: uintptr(res.StatusCode),
+		err:  errors.New(res.Status),
+		kind: ErrProxyAuthRequired,
+	}
+}
+
+func querySecurityFlags(reqHndl uintptr) uintptr {
+	var b []byte
+	var e error
+	var mask uintptr
+	var size int = 4 //nolint:mnd // Size of uint32
+
+	e = w32.InternetQueryOptionW(
+		reqHndl,
+		w32.WininetInternetOptionSecurityFlags,
+		&b,
+		&size,
+	)
+	if (e != nil) || (len(b) < 4) { //nolint:mnd // Size of uint32
+		return 0
+	}
+
+	for flag := range certErrors {
+		mask |= flag
+	}
+
+	return uintptr(binary.LittleEndian.Uint32(b)) & mask
+}
+
+// CertErrors will return the names of the cert error flags, if any.
+func (e *Error) CertErrors() []string {
+	var out []string
+
+	for flag, name := range certErrors {
+		if e.Flags&flag == flag {
+			out = append(out, name)
+		}
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// Error will return the error message.
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Is will return true if the target is the matching sentinel error.
+func (e *Error) Is(target error) bool {
+	return (e.kind != nil) && (target == e.kind)
+}
+
+// Temporary is deprecated in net.Error, but is implemented to
+// satisfy the interface. It returns the same value as Timeout().
+func (e *Error) Temporary() bool {
+	return e.Timeout()
+}
+
+// Timeout will return true if the request timed out.
+func (e *Error) Timeout() bool {
+	return e.kind == ErrTimeout
+}
+
+// Unwrap will return the underlying error.
+func (e *Error) Unwrap() error {
+	return e.err
+}
@@ -3,26 +3,188 @@
 package wininet
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+
+	"github.com/mjwhitta/errors"
 )
 
-// DefaultClient is the default client similar to net/http.
+// DefaultClient is the default client similar to net/http. It is
+// created on first use while nil, so setting it to nil will create a
+// new one. Like net/http, it should only be replaced before making
+// requests, as replacing it races with requests in flight.
 var DefaultClient *Client
 
+// Synchronizes creating the DefaultClient
+var defaultMutex sync.Mutex
+
+// defaultClient will return the DefaultClient, creating it if nil. A
+// creation failure is retried on the next call.
+func defaultClient() (*Client, error) {
+	var e error
+
+	defaultMutex.Lock()
+	defer defaultMutex.Unlock()
+
+	if DefaultClient == nil {
+		if DefaultClient, e = NewClient(); e != nil {
+			return nil, errors.Newf(
+				"failed to create default client: %w",
+				e,
+			)
+		}
+	}
+
+	return DefaultClient, nil
+}
+
+// Delete will make a DELETE request using the DefaultClient.
+func Delete(url string) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Delete(url)
+}
+
+// DeleteWithContext will make a DELETE request with the provided
+// context using the DefaultClient.
+func DeleteWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.DeleteWithContext(ctx, url)
+}
+
 // Get will make a GET request using the DefaultClient.
 func Get(url string) (*http.Response, error) {
-	return DefaultClient.Get(url)
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Get(url)
+}
+
+// GetWithContext will make a GET request with the provided context
+// using the DefaultClient.
+func GetWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.GetWithContext(ctx, url)
 }
 
 // Head will make a HEAD request using the DefaultClient.
 func Head(url string) (*http.Response, error) {
-	return DefaultClient.Head(url)
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Head(url)
+}
+
+// HeadWithContext will make a HEAD request with the provided context
+// using the DefaultClient.
+func HeadWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.HeadWithContext(ctx, url)
+}
+
+// Options will make an OPTIONS request using the DefaultClient.
+func Options(url string) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Options(url)
+}
+
+// OptionsWithContext will make an OPTIONS request with the provided
+// context using the DefaultClient.
+func OptionsWithContext(
+	ctx context.Context,
+	url string,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.OptionsWithContext(ctx, url)
 }
 
-func init() {
-	DefaultClient, _ = NewClient()
+// Patch will make a PATCH request using the DefaultClient.
+func Patch(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Patch(url, contentType, body)
+}
+
+// PatchWithContext will make a PATCH request with the provided
+// context using the DefaultClient.
+func PatchWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PatchWithContext(ctx, url, contentType, body)
 }
 
 // Post will make a POST request using the DefaultClient.
@@ -31,10 +193,133 @@ func Post(
 	contentType string,
 	body io.Reader,
 ) (*http.Response, error) {
-	return DefaultClient.Post(url, contentType, body)
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Post(url, contentType, body)
 }
 
 // PostForm will make a POST request using the DefaultClient.
 func PostForm(url string, data url.Values) (*http.Response, error) {
-	return DefaultClient.PostForm(url, data)
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PostForm(url, data)
+}
+
+// PostFormWithContext will make a POST request with the provided
+// context using the DefaultClient.
+func PostFormWithContext(
+	ctx context.Context,
+	url string,
+	data url.Values,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PostFormWithContext(ctx, url, data)
+}
+
+// PostMultipart will make a multipart/form-data POST request using
+// the DefaultClient.
+func PostMultipart(
+	url string,
+	fields url.Values,
+	files map[string][]string,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PostMultipart(url, fields, files)
+}
+
+// PostMultipartWithContext will make a multipart/form-data POST
+// request with the provided context using the DefaultClient.
+func PostMultipartWithContext(
+	ctx context.Context,
+	url string,
+	fields url.Values,
+	files map[string][]string,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PostMultipartWithContext(
+		ctx,
+		url,
+		fields,
+		files,
+	)
+}
+
+// PostWithContext will make a POST request with the provided context
+// using the DefaultClient.
+func PostWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PostWithContext(ctx, url, contentType, body)
+}
+
+// Put will make a PUT request using the DefaultClient.
+func Put(
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.Put(url, contentType, body)
+}
+
+// PutWithContext will make a PUT request with the provided context
+// using the DefaultClient.
+func PutWithContext(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body io.Reader,
+) (*http.Response, error) {
+	var c *Client
+	var e error
+
+	if c, e = defaultClient(); e != nil {
+		return nil, e
+	}
+
+	return c.PutWithContext(ctx, url, contentType, body)
 }
@@ -6,22 +6,34 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
 
 	"github.com/mjwhitta/errors"
 	w32 "github.com/mjwhitta/win/api"
 )
 
+// dataWriter is an io.Writer for streaming a request body.
+type dataWriter uintptr
+
+// maxWrite is the largest write that fits in a DWORD and an int on
+// all archs.
+const maxWrite int = math.MaxInt32
+
 func buildRequest(
 	sessionHndl uintptr,
 	req *http.Request,
 	timeout time.Duration,
-) (uintptr, error) {
+	cache CacheMode,
+) (uintptr, uintptr, error) {
 	var connHndl uintptr
 	var e error
 	var flags uintptr
@@ -38,7 +50,7 @@ func buildRequest(
 	}
 
 	if req.URL.Scheme == "https" {
-		flags = w32.Wininet.InternetFlagSecure
+		flags = w32.WininetInternetFlagSecure
 	}
 
 	// Create connection
@@ -48,12 +60,13 @@ func buildRequest(
 		int(port),
 		req.URL.User.Username(),
 		passwd,
-		w32.Wininet.InternetServiceHTTP,
+		w32.WininetInternetServiceHTTP,
 		flags,
 		0,
 	)
 	if e != nil {
-		return 0, errors.Newf("failed to create connection: %w", e)
+		e = errors.Newf("failed to create connection: %w", e)
+		return 0, 0, e
 	}
 
 	// Send query string too
@@ -67,13 +80,16 @@ func buildRequest(
 	}
 
 	// Allow NTLM auth
-	flags |= w32.Wininet.InternetFlagKeepConnection
+	flags |= w32.WininetInternetFlagKeepConnection
 
 	// Don't redirect
-	flags |= w32.Wininet.InternetFlagNoAutoRedirect
+	flags |= w32.WininetInternetFlagNoAutoRedirect
 
 	// Don't let Windows handle cookies
-	flags |= w32.Wininet.InternetFlagNoCookies
+	flags |= w32.WininetInternetFlagNoCookies
+
+	// Configure cache behavior
+	flags |= cache.flags()
 
 	// Create HTTP request
 	reqHndl, e = w32.HTTPOpenRequestW(
@@ -87,14 +103,18 @@ func buildRequest(
 		0,
 	)
 	if e != nil {
-		return 0, errors.Newf("failed to open request: %w", e)
+		_ = w32.InternetCloseHandle(connHndl)
+		return 0, 0, errors.Newf("failed to open request: %w", e)
 	}
 
 	if e = setTimeouts(reqHndl, timeout); e != nil {
-		return 0, e
+		_ = w32.InternetCloseHandle(reqHndl)
+		_ = w32.InternetCloseHandle(connHndl)
+
+		return 0, 0, e
 	}
 
-	return reqHndl, nil
+	return connHndl, reqHndl, nil
 }
 
 func buildResponse(
@@ -114,7 +134,7 @@ func buildResponse(
 	var status string
 
 	// Get status code
-	b, e = queryResponse(reqHndl, w32.Wininet.HTTPQueryStatusCode, 0)
+	b, e = queryResponse(reqHndl, w32.WininetHTTPQueryStatusCode, 0)
 	if e != nil {
 		return nil, e
 	}
@@ -125,7 +145,7 @@ func buildResponse(
 	}
 
 	// Get status text
-	b, e = queryResponse(reqHndl, w32.Wininet.HTTPQueryStatusText, 0)
+	b, e = queryResponse(reqHndl, w32.WininetHTTPQueryStatusText, 0)
 	if e != nil {
 		return nil, e
 	} else if len(b) > 0 {
@@ -152,6 +172,7 @@ func buildResponse(
 		Request:       req,
 		Status:        status,
 		StatusCode:    int(code),
+		Trailer:       declaredTrailers(hdrs),
 	}
 
 	return res, nil
@@ -166,6 +187,8 @@ func dbgLog(debug bool, thing any) {
 	}
 
 	switch thing := thing.(type) {
+	case string:
+		println(thing)
 	case *http.Request:
 		if b, e = httputil.DumpRequestOut(thing, true); e == nil {
 			println(string(b))
@@ -180,18 +203,45 @@ func dbgLog(debug bool, thing any) {
 	}
 }
 
+// declaredTrailers will return the trailers declared by the Trailer
+// header, without values, as WinINet doesn't provide them. It returns
+// nil if none are declared.
+func declaredTrailers(hdrs http.Header) http.Header {
+	var trailer http.Header = http.Header{}
+
+	for k, vals := range hdrs {
+		if !strings.EqualFold(k, "Trailer") {
+			continue
+		}
+
+		for _, v := range vals {
+			for _, name := range strings.Split(v, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					trailer[http.CanonicalHeaderKey(name)] = nil
+				}
+			}
+		}
+	}
+
+	if len(trailer) == 0 {
+		return nil
+	}
+
+	return trailer
+}
+
 func disableTLS(reqHndl uintptr) error {
 	var b []byte = make([]byte, 4) //nolint:mnd // Size of uint32
 	var e error
 
 	binary.LittleEndian.PutUint32(
 		b,
-		uint32(w32.Wininet.SecuritySetMask),
+		uint32(w32.WininetSecuritySetMask),
 	)
 
 	e = w32.InternetSetOptionW(
 		reqHndl,
-		w32.Wininet.InternetOptionSecurityFlags,
+		w32.WininetInternetOptionSecurityFlags,
 		b,
 		len(b),
 	)
@@ -203,30 +253,70 @@ func disableTLS(reqHndl uintptr) error {
 	return nil
 }
 
-// func getCookies(uri string) (string, error) {
-// 	var buffer []byte
-// 	var e error
-// 	var flags uintptr = w32.Wininet.InternetCookieHTTPonly
-// 	var size int
+// expectContinue will return true if the request has an
+// "Expect: 100-continue" header.
+func expectContinue(req *http.Request) bool {
+	return strings.EqualFold(
+		strings.TrimSpace(req.Header.Get("Expect")),
+		"100-continue",
+	)
+}
+
+// getProxy will return the proxy selected by the Client's Proxies or
+// Proxy func, or else the Transport's, in the Windows proxy format.
+// The first supported entry is used, so unsupported proxies fall back
+// to the next one. A nil URL from the Client's means no proxy, so the
+// proxy is empty. A nil URL from the Transport's (e.g.
+// http.ProxyFromEnvironment with no env vars) is ignored. If ok is
+// false, the system settings are used.
+func getProxy(
+	proxies func(req *http.Request) ([]*url.URL, error),
+	proxy func(req *http.Request) (*url.URL, error),
+	trans http.RoundTripper,
+	req *http.Request,
+) (string, bool, error) {
+	var e error
+	var list []*url.URL
+	var ok bool
+	var s string
+	var t *http.Transport
+	var u *url.URL
+
+	switch {
+	case proxies != nil:
+		list, e = proxies(req)
+	case proxy != nil:
+		u, e = proxy(req)
+		list = []*url.URL{u}
+	default:
+		// Only the Client's funcs can choose no proxy
+		if t, ok = trans.(*http.Transport); !ok || (t.Proxy == nil) {
+			return "", false, nil
+		}
 
-// 	e = w32.InternetGetCookieExW(uri, "", &buffer, &size, flags)
-// 	if e == nil {
-// 		return string(buffer), nil
-// 	} else if strings.HasSuffix(e.Error(), errNoMoreItems) {
-// 		return string(buffer), nil
-// 	} else if size == 0 {
-// 		return "", errors.Newf("failed to get cookies: %w", e)
-// 	}
+		if u, e = t.Proxy(req); (e == nil) && (u == nil) {
+			return "", false, nil
+		}
 
-// 	buffer = make([]byte, size)
+		list = []*url.URL{u}
+	}
 
-// 	e = w32.InternetGetCookieExW(uri, "", &buffer, &size, flags)
-// 	if e != nil {
-// 		return "", errors.Newf("failed to get cookies: %w", e)
-// 	}
+	if e != nil {
+		return "", false, errors.Newf("failed to get proxy: %w", e)
+	}
 
-// 	return string(buffer), nil
-// }
+	for _, u = range list {
+		if u == nil {
+			return "", true, nil
+		}
+
+		if s, ok = proxyString(u); ok {
+			return s, true, nil
+		}
+	}
+
+	return "", false, errors.Newf("no supported proxy in %v", list)
+}
 
 func getHeaders(
 	reqHndl uintptr,
@@ -241,7 +331,7 @@ func getHeaders(
 	// Get headers
 	b, e = queryResponse(
 		reqHndl,
-		w32.Wininet.HTTPQueryRawHeadersCRLF,
+		w32.WininetHTTPQueryRawHeadersCRLF,
 		0,
 	)
 	if e != nil {
@@ -287,6 +377,56 @@ func loadCookies(jar http.CookieJar, req *http.Request) {
 	}
 }
 
+func openSession(agent string) (uintptr, error) {
+	var e error
+	var hndl uintptr
+
+	hndl, e = w32.InternetOpenW(
+		agent,
+		w32.WininetInternetOpenTypePreconfig,
+		"",
+		"",
+		0,
+	)
+	if e != nil {
+		return 0, errors.Newf("failed to create session: %w", e)
+	}
+
+	return hndl, nil
+}
+
+// openProxySession will create a session using the provided proxy,
+// instead of the system settings. An empty proxy means no proxy.
+func openProxySession(agent string, proxy string) (uintptr, error) {
+	var access uintptr = w32.WininetInternetOpenTypeDirect
+	var e error
+	var hndl uintptr
+
+	if proxy != "" {
+		access = w32.WininetInternetOpenTypeProxy
+	}
+
+	hndl, e = w32.InternetOpenW(agent, access, proxy, "", 0)
+	if e != nil {
+		return 0, errors.Newf("failed to create session: %w", e)
+	}
+
+	return hndl, nil
+}
+
+// proxyString will return a proxy URL in the WinINet proxy format, or
+// false if WinINet doesn't support it.
+func proxyString(u *url.URL) (string, bool) {
+	switch u.Scheme {
+	case "", "http":
+		return u.Host, true
+	case "socks4":
+		return "socks=" + u.Host, true
+	default:
+		return "", false
+	}
+}
+
 func queryResponse(reqHndl, info uintptr, idx int) ([]byte, error) {
 	var buffer []byte
 	var e error
@@ -355,18 +495,72 @@ func readResponse(reqHndl uintptr) (io.ReadCloser, int64, error) {
 	return io.NopCloser(bytes.NewReader(b)), contentLen, nil
 }
 
+// redirectRequest will return the request to send for a redirect,
+// or nil if it shouldn't be followed. Like net/http, 301, 302, and
+// 303 are followed with GET (or HEAD), and 307 and 308 keep the
+// method and body, but only if the body can be sent again (see
+// http.Request.GetBody).
+func redirectRequest(
+	req *http.Request,
+	code int,
+	loc *url.URL,
+) (*http.Request, error) {
+	var body io.ReadCloser
+	var e error
+	var method string = req.Method
+	var next *http.Request
+
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound,
+		http.StatusSeeOther:
+		if method != http.MethodHead {
+			method = http.MethodGet
+		}
+	case http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		if req.GetBody != nil {
+			if body, e = req.GetBody(); e != nil {
+				return nil, errors.Newf("failed to reset body: %w", e)
+			}
+		} else if (req.Body != nil) && (req.Body != http.NoBody) {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	next, e = http.NewRequestWithContext(
+		req.Context(),
+		method,
+		loc.String(),
+		body,
+	)
+	if e != nil {
+		return nil, errors.Newf("failed to create request: %w", e)
+	}
+
+	if body != nil {
+		next.ContentLength = req.ContentLength
+		next.GetBody = req.GetBody
+
+		if v := req.Header.Get("Content-Type"); v != "" {
+			next.Header.Set("Content-Type", v)
+		}
+	}
+
+	return next, nil
+}
+
 func sendRequest(
 	reqHndl uintptr,
 	req *http.Request,
 ) (*http.Response, error) {
-	var b []byte
 	var e error
 	var method uintptr
 	var res *http.Response
 
 	// Process cookies
-	method = w32.Wininet.HTTPAddreqFlagAdd
-	method |= w32.Wininet.HTTPAddreqFlagCoalesceWithSemicolon
+	method = w32.WininetHTTPAddreqFlagAdd
+	method |= w32.WininetHTTPAddreqFlagCoalesceWithSemicolon
 
 	for _, c := range req.Cookies() {
 		e = w32.HTTPAddRequestHeadersW(
@@ -380,8 +574,8 @@ func sendRequest(
 	}
 
 	// Process headers
-	method = w32.Wininet.HTTPAddreqFlagAdd
-	method |= w32.Wininet.HTTPAddreqFlagReplace
+	method = w32.WininetHTTPAddreqFlagAdd
+	method |= w32.WininetHTTPAddreqFlagReplace
 
 	for k := range req.Header {
 		e = w32.HTTPAddRequestHeadersW(
@@ -395,22 +589,22 @@ func sendRequest(
 		}
 	}
 
-	if req.Body != nil {
-		if b, e = io.ReadAll(req.Body); e != nil {
-			e = errors.Newf("failed to read request body: %w", e)
-			return nil, e
-		}
-
-		if e = req.Body.Close(); e != nil {
-			e = errors.Newf("failed to close request body: %w", e)
-			return nil, e
-		}
+	// Send HTTP request, streaming body if length is known
+	if (req.Body != nil) && (req.ContentLength > 0) {
+		e = streamRequest(reqHndl, req)
+	} else {
+		e = writeRequest(reqHndl, req)
 	}
 
-	// Send HTTP request
-	e = w32.HTTPSendRequestW(reqHndl, "", 0, b, len(b))
 	if e != nil {
-		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		// With "Expect: 100-continue", the server may reject the
+		// request before reading the body, so return its response
+		if expectContinue(req) {
+			if res, err := buildResponse(reqHndl, req); err == nil {
+				return res, nil
+			}
+		}
+
 		return nil, e
 	}
 
@@ -421,6 +615,28 @@ func sendRequest(
 	return res, nil
 }
 
+// setClientCert will set the client certificate of a request. The
+// context is copied, so it only needs to be valid for this call.
+func setClientCert(reqHndl uintptr, ctx *windows.CertContext) error {
+	var b []byte = unsafe.Slice(
+		(*byte)(unsafe.Pointer(ctx)),
+		unsafe.Sizeof(*ctx),
+	)
+	var e error
+
+	e = w32.InternetSetOptionW(
+		reqHndl,
+		w32.WininetInternetOptionClientCertContext,
+		b,
+		len(b),
+	)
+	if e != nil {
+		return errors.Newf("failed to set client certificate: %w", e)
+	}
+
+	return nil
+}
+
 func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 	var b []byte
 	var e error
@@ -435,7 +651,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.InternetSetOptionW(
 		reqHndl,
-		w32.Wininet.InternetOptionConnectTimeout,
+		w32.WininetInternetOptionConnectTimeout,
 		b,
 		len(b),
 	)
@@ -445,7 +661,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.InternetSetOptionW(
 		reqHndl,
-		w32.Wininet.InternetOptionReceiveTimeout,
+		w32.WininetInternetOptionReceiveTimeout,
 		b,
 		len(b),
 	)
@@ -455,7 +671,7 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 
 	e = w32.InternetSetOptionW(
 		reqHndl,
-		w32.Wininet.InternetOptionSendTimeout,
+		w32.WininetInternetOptionSendTimeout,
 		b,
 		len(b),
 	)
@@ -466,6 +682,52 @@ func setTimeouts(reqHndl uintptr, timeout time.Duration) error {
 	return nil
 }
 
+func streamRequest(reqHndl uintptr, req *http.Request) (e error) {
+	var n int64
+
+	defer func() {
+		if err := req.Body.Close(); (err != nil) && (e == nil) {
+			e = errors.Newf("failed to close request body: %w", err)
+		}
+	}()
+
+	if req.ContentLength > math.MaxUint32 {
+		return errors.Newf(
+			"request body length %d exceeds 4GiB",
+			req.ContentLength,
+		)
+	}
+
+	// Send headers and total length, but no body yet
+	e = w32.HTTPSendRequestExW(
+		reqHndl,
+		&w32.InternetBuffers{BufferTotal: uint32(req.ContentLength)},
+	)
+	if e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return e
+	}
+
+	// Stream body
+	if n, e = io.Copy(dataWriter(reqHndl), req.Body); e != nil {
+		return errors.Newf("failed to write request body: %w", e)
+	} else if n != req.ContentLength {
+		return errors.Newf(
+			"request body length %d does not match %d",
+			n,
+			req.ContentLength,
+		)
+	}
+
+	// Finish request
+	if e = w32.HTTPEndRequestW(reqHndl); e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return e
+	}
+
+	return nil
+}
+
 func storeCookies(
 	jar http.CookieJar,
 	uri *url.URL,
@@ -489,3 +751,54 @@ func storeCookies(
 
 	return nil
 }
+
+func writeRequest(reqHndl uintptr, req *http.Request) error {
+	var b []byte
+	var e error
+
+	if req.Body != nil {
+		if b, e = io.ReadAll(req.Body); e != nil {
+			return errors.Newf("failed to read request body: %w", e)
+		}
+
+		if e = req.Body.Close(); e != nil {
+			return errors.Newf("failed to close request body: %w", e)
+		}
+	}
+
+	// Send HTTP request
+	e = w32.HTTPSendRequestW(reqHndl, "", 0, b, len(b))
+	if e != nil {
+		e = errors.Newf("%s \"%s\": %w", req.Method, req.URL, e)
+		return e
+	}
+
+	return nil
+}
+
+// Write will write data to the request using InternetWriteFile, in
+// chunks that fit in a DWORD.
+func (w dataWriter) Write(p []byte) (int, error) {
+	var chunk []byte
+	var e error
+	var n uint32
+	var total int
+
+	for len(p) > 0 {
+		chunk = p[:min(len(p), maxWrite)]
+
+		e = w32.InternetWriteFile(uintptr(w), chunk, &n)
+		if e != nil {
+			return total + int(n), e
+		}
+
+		if n == 0 {
+			return total, io.ErrShortWrite
+		}
+
+		total += int(n)
+		p = p[n:]
+	}
+
+	return total, nil
+}